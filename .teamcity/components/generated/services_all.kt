@@ -28,6 +28,7 @@ val services = mapOf(
     "bcmdataexports" to ServiceSpec("BCM Data Exports"),
     "bedrock" to ServiceSpec("Amazon Bedrock"),
     "bedrockagent" to ServiceSpec("Agents for Amazon Bedrock"),
+    "braket" to ServiceSpec("Braket"),
     "budgets" to ServiceSpec("Web Services Budgets"),
     "ce" to ServiceSpec("CE (Cost Explorer)"),
     "chatbot" to ServiceSpec("Chatbot"),
@@ -111,6 +112,7 @@ val services = mapOf(
     "glue" to ServiceSpec("Glue"),
     "grafana" to ServiceSpec("Managed Grafana"),
     "greengrass" to ServiceSpec("IoT Greengrass"),
+    "greengrassv2" to ServiceSpec("IoT Greengrass V2"),
     "groundstation" to ServiceSpec("Ground Station"),
     "guardduty" to ServiceSpec("GuardDuty"),
     "healthlake" to ServiceSpec("HealthLake"),
@@ -123,6 +125,7 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iotfleetwise" to ServiceSpec("IoT FleetWise"),
     "ipam" to ServiceSpec("VPC IPAM (IP Address Manager)", vpcLock = true, patternOverride = "TestAccIPAM", splitPackageRealPackage = "ec2"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
@@ -144,6 +147,7 @@ val services = mapOf(
     "lookoutmetrics" to ServiceSpec("Lookout for Metrics"),
     "m2" to ServiceSpec("Mainframe Modernization"),
     "macie2" to ServiceSpec("Macie"),
+    "marketplacecatalog" to ServiceSpec("Marketplace Catalog"),
     "mediaconnect" to ServiceSpec("Elemental MediaConnect"),
     "mediaconvert" to ServiceSpec("Elemental MediaConvert"),
     "medialive" to ServiceSpec("Elemental MediaLive"),
@@ -183,6 +187,7 @@ val services = mapOf(
     "resourceexplorer2" to ServiceSpec("Resource Explorer"),
     "resourcegroups" to ServiceSpec("Resource Groups"),
     "resourcegroupstaggingapi" to ServiceSpec("Resource Groups Tagging"),
+    "robomaker" to ServiceSpec("RoboMaker"),
     "rolesanywhere" to ServiceSpec("Roles Anywhere"),
     "route53" to ServiceSpec("Route 53", vpcLock = true),
     "route53domains" to ServiceSpec("Route 53 Domains"),
@@ -239,6 +244,7 @@ val services = mapOf(
     "wafv2" to ServiceSpec("WAF"),
     "wavelength" to ServiceSpec("Wavelength", vpcLock = true, patternOverride = "TestAccWavelength", splitPackageRealPackage = "ec2"),
     "wellarchitected" to ServiceSpec("Well-Architected Tool"),
+    "workdocs" to ServiceSpec("WorkDocs"),
     "worklink" to ServiceSpec("WorkLink"),
     "workspaces" to ServiceSpec("WorkSpaces", vpcLock = true),
     "workspacesweb" to ServiceSpec("WorkSpaces Web"),
@@ -3,21 +3,21 @@ module github.com/hashicorp/terraform-provider-aws
 go 1.22.2
 
 require (
-	github.com/ProtonMail/go-crypto v1.1.0-alpha.2
+	github.com/ProtonMail/go-crypto v1.1.3
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.15
-	github.com/aws/aws-sdk-go-v2 v1.27.1
+	github.com/aws/aws-sdk-go-v2 v1.36.4
 	github.com/aws/aws-sdk-go-v2/config v1.27.17
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.17
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.4
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.16.22
-	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.29.7
+	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.36.0
 	github.com/aws/aws-sdk-go-v2/service/account v1.16.9
 	github.com/aws/aws-sdk-go-v2/service/acm v1.26.1
 	github.com/aws/aws-sdk-go-v2/service/acmpca v1.30.2
 	github.com/aws/aws-sdk-go-v2/service/amp v1.25.9
-	github.com/aws/aws-sdk-go-v2/service/amplify v1.21.10
+	github.com/aws/aws-sdk-go-v2/service/amplify v1.31.0
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.23.11
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/appconfig v1.29.7
@@ -27,23 +27,25 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.27.9
 	github.com/aws/aws-sdk-go-v2/service/apprunner v1.28.9
 	github.com/aws/aws-sdk-go-v2/service/appstream v1.34.9
+	github.com/aws/aws-sdk-go-v2/service/appsync v1.40.0
 	github.com/aws/aws-sdk-go-v2/service/athena v1.41.1
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.32.9
-	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.40.10
+	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.47.0
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.20.10
 	github.com/aws/aws-sdk-go-v2/service/batch v1.38.0
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.9
 	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.6
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.12.1
-	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.5
-	github.com/aws/aws-sdk-go-v2/service/chatbot v1.2.2
+	github.com/aws/aws-sdk-go-v2/service/braket v1.31.16
+	github.com/aws/aws-sdk-go-v2/service/budgets v1.31.0
+	github.com/aws/aws-sdk-go-v2/service/chatbot v1.10.0
 	github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines v1.15.10
 	github.com/aws/aws-sdk-go-v2/service/chimesdkvoice v1.15.5
 	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.12.5
 	github.com/aws/aws-sdk-go-v2/service/cloud9 v1.24.9
 	github.com/aws/aws-sdk-go-v2/service/cloudcontrol v1.18.9
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.51.2
-	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.36.5
+	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.42.0
 	github.com/aws/aws-sdk-go-v2/service/cloudfrontkeyvaluestore v1.4.9
 	github.com/aws/aws-sdk-go-v2/service/cloudhsmv2 v1.21.9
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.22.9
@@ -51,13 +53,13 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.38.5
 	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.35.6
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.27.5
-	github.com/aws/aws-sdk-go-v2/service/codebuild v1.37.2
+	github.com/aws/aws-sdk-go-v2/service/codebuild v1.50.0
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.13.6
 	github.com/aws/aws-sdk-go-v2/service/codecommit v1.22.9
 	github.com/aws/aws-sdk-go-v2/service/codedeploy v1.25.9
 	github.com/aws/aws-sdk-go-v2/service/codeguruprofiler v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/codegurureviewer v1.25.9
-	github.com/aws/aws-sdk-go-v2/service/codepipeline v1.27.5
+	github.com/aws/aws-sdk-go-v2/service/codepipeline v1.38.0
 	github.com/aws/aws-sdk-go-v2/service/codestarconnections v1.25.7
 	github.com/aws/aws-sdk-go-v2/service/codestarnotifications v1.22.9
 	github.com/aws/aws-sdk-go-v2/service/cognitoidentity v1.23.12
@@ -70,6 +72,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/costexplorer v1.38.5
 	github.com/aws/aws-sdk-go-v2/service/costoptimizationhub v1.4.9
 	github.com/aws/aws-sdk-go-v2/service/customerprofiles v1.36.9
+	github.com/aws/aws-sdk-go-v2/service/databasemigrationservice v1.46.0
 	github.com/aws/aws-sdk-go-v2/service/datasync v1.38.3
 	github.com/aws/aws-sdk-go-v2/service/datazone v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/dax v1.19.9
@@ -80,7 +83,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/docdbelastic v1.9.8
 	github.com/aws/aws-sdk-go-v2/service/drs v1.26.5
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.32.7
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.162.1
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.198.1
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.28.4
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.23.9
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.12
@@ -89,21 +92,24 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.23.9
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.31.2
 	github.com/aws/aws-sdk-go-v2/service/emr v1.39.10
-	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.21.1
-	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.31.4
+	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.27.0
+	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.36.0
 	github.com/aws/aws-sdk-go-v2/service/evidently v1.19.9
 	github.com/aws/aws-sdk-go-v2/service/finspace v1.24.6
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.28.11
 	github.com/aws/aws-sdk-go-v2/service/fis v1.24.7
 	github.com/aws/aws-sdk-go-v2/service/fms v1.33.6
+	github.com/aws/aws-sdk-go-v2/service/gamelift v1.37.0
 	github.com/aws/aws-sdk-go-v2/service/glacier v1.22.9
 	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.23.6
+	github.com/aws/aws-sdk-go-v2/service/greengrassv2 v1.37.0
 	github.com/aws/aws-sdk-go-v2/service/groundstation v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/healthlake v1.24.5
 	github.com/aws/aws-sdk-go-v2/service/iam v1.32.5
 	github.com/aws/aws-sdk-go-v2/service/identitystore v1.23.10
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.26.5
 	github.com/aws/aws-sdk-go-v2/service/internetmonitor v1.14.5
+	github.com/aws/aws-sdk-go-v2/service/iotfleetwise v1.14.3
 	github.com/aws/aws-sdk-go-v2/service/ivschat v1.12.10
 	github.com/aws/aws-sdk-go-v2/service/kafka v1.33.1
 	github.com/aws/aws-sdk-go-v2/service/kendra v1.50.6
@@ -117,6 +123,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.38.2
 	github.com/aws/aws-sdk-go-v2/service/lookoutmetrics v1.27.9
 	github.com/aws/aws-sdk-go-v2/service/m2 v1.13.5
+	github.com/aws/aws-sdk-go-v2/service/marketplacecatalog v1.25.1
 	github.com/aws/aws-sdk-go-v2/service/mediaconnect v1.28.9
 	github.com/aws/aws-sdk-go-v2/service/mediaconvert v1.53.6
 	github.com/aws/aws-sdk-go-v2/service/medialive v1.52.5
@@ -124,7 +131,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mediapackagev2 v1.11.5
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.9
-	github.com/aws/aws-sdk-go-v2/service/mwaa v1.27.3
+	github.com/aws/aws-sdk-go-v2/service/mwaa v1.35.1
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.8.6
 	github.com/aws/aws-sdk-go-v2/service/oam v1.11.5
 	github.com/aws/aws-sdk-go-v2/service/opensearchserverless v1.11.12
@@ -140,18 +147,19 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ram v1.25.9
 	github.com/aws/aws-sdk-go-v2/service/rbin v1.16.9
 	github.com/aws/aws-sdk-go-v2/service/rds v1.79.4
-	github.com/aws/aws-sdk-go-v2/service/redshift v1.44.5
+	github.com/aws/aws-sdk-go-v2/service/redshift v1.51.0
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.25.9
-	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.18.6
+	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.24.0
 	github.com/aws/aws-sdk-go-v2/service/rekognition v1.40.5
 	github.com/aws/aws-sdk-go-v2/service/resourceexplorer2 v1.10.10
-	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.22.5
+	github.com/aws/aws-sdk-go-v2/service/resourcegroups v1.29.0
 	github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi v1.21.9
+	github.com/aws/aws-sdk-go-v2/service/robomaker v1.31.3
 	github.com/aws/aws-sdk-go-v2/service/rolesanywhere v1.11.5
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.40.9
 	github.com/aws/aws-sdk-go-v2/service/route53domains v1.23.9
 	github.com/aws/aws-sdk-go-v2/service/route53profiles v1.0.6
-	github.com/aws/aws-sdk-go-v2/service/s3 v1.54.4
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.73.0
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.44.12
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.8.9
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.29.2
@@ -172,21 +180,22 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ssoadmin v1.25.10
 	github.com/aws/aws-sdk-go-v2/service/sts v1.28.11
 	github.com/aws/aws-sdk-go-v2/service/swf v1.23.1
-	github.com/aws/aws-sdk-go-v2/service/synthetics v1.24.9
+	github.com/aws/aws-sdk-go-v2/service/synthetics v1.33.0
 	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.0.7
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.10
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.37.5
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.48.2
 	github.com/aws/aws-sdk-go-v2/service/verifiedpermissions v1.14.4
-	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.8.4
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.13.0
 	github.com/aws/aws-sdk-go-v2/service/waf v1.20.9
 	github.com/aws/aws-sdk-go-v2/service/wafregional v1.21.9
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.49.2
 	github.com/aws/aws-sdk-go-v2/service/wellarchitected v1.30.5
+	github.com/aws/aws-sdk-go-v2/service/workdocs v1.26.3
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.5
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.5
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.9
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.22.2
 	github.com/beevik/etree v1.4.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -204,17 +213,17 @@ require (
 	github.com/hashicorp/go-multierror v1.1.1
 	github.com/hashicorp/go-uuid v1.0.3
 	github.com/hashicorp/go-version v1.7.0
-	github.com/hashicorp/hcl/v2 v2.20.1
-	github.com/hashicorp/terraform-plugin-framework v1.8.0
+	github.com/hashicorp/hcl/v2 v2.23.0
+	github.com/hashicorp/terraform-plugin-framework v1.14.1
 	github.com/hashicorp/terraform-plugin-framework-jsontypes v0.1.0
 	github.com/hashicorp/terraform-plugin-framework-timeouts v0.4.1
 	github.com/hashicorp/terraform-plugin-framework-timetypes v0.3.0
 	github.com/hashicorp/terraform-plugin-framework-validators v0.12.0
-	github.com/hashicorp/terraform-plugin-go v0.23.0
+	github.com/hashicorp/terraform-plugin-go v0.26.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
-	github.com/hashicorp/terraform-plugin-mux v0.16.0
-	github.com/hashicorp/terraform-plugin-sdk/v2 v2.34.0
-	github.com/hashicorp/terraform-plugin-testing v1.8.0
+	github.com/hashicorp/terraform-plugin-mux v0.18.0
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.36.1
+	github.com/hashicorp/terraform-plugin-testing v1.11.0
 	github.com/jmespath/go-jmespath v0.4.0
 	github.com/mattbaird/jsonpatch v0.0.0-20230413205102-771768614e91
 	github.com/mitchellh/copystructure v1.2.0
@@ -223,9 +232,9 @@ require (
 	github.com/mitchellh/mapstructure v1.5.0
 	github.com/pquerna/otp v1.4.0
 	github.com/shopspring/decimal v1.4.0
-	golang.org/x/crypto v0.23.0
-	golang.org/x/text v0.15.0
-	golang.org/x/tools v0.18.0
+	golang.org/x/crypto v0.33.0
+	golang.org/x/text v0.22.0
+	golang.org/x/tools v0.21.1-0.20240508182429-e35e4ccd0d2d
 	gopkg.in/dnaeon/go-vcr.v3 v3.2.0
 	gopkg.in/yaml.v2 v2.4.0
 	syreclabs.com/go/faker v1.2.3
@@ -238,16 +247,16 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.8 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.8 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.35 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.35 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.10 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.28 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.1 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.5.0 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.9 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.10 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.8 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.9 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.18.9 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.24.4 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/boombuler/barcode v1.0.1 // indirect
@@ -256,19 +265,20 @@ require (
 	github.com/evanphx/json-patch v0.5.2 // indirect
 	github.com/fatih/color v1.16.0 // indirect
 	github.com/frankban/quicktest v1.14.6 // indirect
-	github.com/go-logr/logr v1.4.1 // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
 	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/go-test/deep v1.1.0 // indirect
 	github.com/golang/protobuf v1.5.4 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-checkpoint v0.5.0 // indirect
-	github.com/hashicorp/go-plugin v1.6.0 // indirect
-	github.com/hashicorp/hc-install v0.6.4 // indirect
+	github.com/hashicorp/go-plugin v1.6.2 // indirect
+	github.com/hashicorp/go-retryablehttp v0.7.7 // indirect
+	github.com/hashicorp/hc-install v0.9.1 // indirect
 	github.com/hashicorp/logutils v1.0.0 // indirect
-	github.com/hashicorp/terraform-exec v0.21.0 // indirect
-	github.com/hashicorp/terraform-json v0.22.1 // indirect
-	github.com/hashicorp/terraform-registry-address v0.2.3 // indirect
+	github.com/hashicorp/terraform-exec v0.22.0 // indirect
+	github.com/hashicorp/terraform-json v0.24.0 // indirect
+	github.com/hashicorp/terraform-registry-address v0.2.4 // indirect
 	github.com/hashicorp/terraform-svchost v0.1.1 // indirect
 	github.com/hashicorp/yamux v0.1.1 // indirect
 	github.com/huandu/xstrings v1.4.0 // indirect
@@ -287,18 +297,19 @@ require (
 	github.com/xeipuuv/gojsonpointer v0.0.0-20190905194746-02993c407bfb // indirect
 	github.com/xeipuuv/gojsonreference v0.0.0-20180127040603-bd5ef7bd5415 // indirect
 	github.com/xeipuuv/gojsonschema v1.2.0 // indirect
-	github.com/zclconf/go-cty v1.14.4 // indirect
+	github.com/zclconf/go-cty v1.16.2 // indirect
 	go.opentelemetry.io/contrib/instrumentation/github.com/aws/aws-sdk-go-v2/otelaws v0.51.0 // indirect
-	go.opentelemetry.io/otel v1.26.0 // indirect
-	go.opentelemetry.io/otel/metric v1.26.0 // indirect
-	go.opentelemetry.io/otel/trace v1.26.0 // indirect
-	golang.org/x/mod v0.16.0 // indirect
-	golang.org/x/net v0.25.0 // indirect
-	golang.org/x/sys v0.20.0 // indirect
+	go.opentelemetry.io/otel v1.31.0 // indirect
+	go.opentelemetry.io/otel/metric v1.31.0 // indirect
+	go.opentelemetry.io/otel/trace v1.31.0 // indirect
+	golang.org/x/mod v0.22.0 // indirect
+	golang.org/x/net v0.34.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
 	google.golang.org/appengine v1.6.8 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240227224415-6ceb2ff114de // indirect
-	google.golang.org/grpc v1.63.2 // indirect
-	google.golang.org/protobuf v1.34.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20241015192408-796eee8c2d53 // indirect
+	google.golang.org/grpc v1.69.4 // indirect
+	google.golang.org/protobuf v1.36.3 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -17,37 +17,45 @@ import (
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
+	route53recoverycluster_sdkv1 "github.com/aws/aws-sdk-go/service/route53recoverycluster"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamsim"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
+	AccountID             string
+	DefaultTagsConfig     *tftags.DefaultConfig
+	DefaultTimeoutsConfig *DefaultTimeoutsConfig
+	IgnoreTagsConfig      *tftags.IgnoreConfig
+	Partition             string
+	Region                string
+	ServicePackages       map[string]ServicePackage
 
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
 	dnsSuffix                 string
+	dualStackEndpoints        map[string]bool   // From provider configuration.
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
+	iamPolicySimulator        *iamsim.Simulator
+	iamPolicySimulatorOnce    sync.Once
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
 	s3UsePathStyle            bool   // From provider configuration.
 	s3USEast1RegionalEndpoint string // From provider configuration.
+	simulateIAMPermissions    bool   // From provider configuration.
 	stsRegion                 string // From provider configuration.
 }
 
@@ -117,6 +125,13 @@ func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sd
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// Route53RecoveryClusterConnForEndpoint returns an AWS SDK For Go v1 Route 53 Recovery Cluster API client for the specified
+// Route 53 Application Recovery Controller cluster endpoint and AWS Region.
+// The data plane API is only reachable through a cluster's regional endpoints so a new "simple" client is always created.
+func (c *AWSClient) Route53RecoveryClusterConnForEndpoint(_ context.Context, endpoint, region string) *route53recoverycluster_sdkv1.Route53RecoveryCluster {
+	return route53recoverycluster_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithEndpoint(endpoint).WithRegion(region))
+}
+
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
@@ -139,6 +154,20 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	return c.s3ExpressClient
 }
 
+// IAMPolicySimulator returns the simulator used to check the caller's IAM permissions at plan time.
+// nil is returned if the simulate_iam_permissions provider configuration value is not set.
+func (c *AWSClient) IAMPolicySimulator(ctx context.Context) *iamsim.Simulator {
+	if !c.simulateIAMPermissions {
+		return nil
+	}
+
+	c.iamPolicySimulatorOnce.Do(func() {
+		c.iamPolicySimulator = iamsim.New(c.IAMClient(ctx), c.STSClient(ctx))
+	})
+
+	return c.iamPolicySimulator
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -271,6 +300,10 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if v, ok := c.dualStackEndpoints[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = c.awsConfigWithDualStackEndpoint(v)
+		m["session"] = c.sessionWithDualStackEndpoint(v)
+	}
 	switch servicePackageName {
 	case names.S3:
 		m["s3_use_path_style"] = c.s3UsePathStyle
@@ -287,6 +320,41 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// awsConfigWithDualStackEndpoint returns a copy of the AWS SDK for Go v2 configuration
+// that overrides the DualStack endpoint setting from all other configuration sources.
+func (c *AWSClient) awsConfigWithDualStackEndpoint(useDualStackEndpoint bool) *aws_sdkv2.Config {
+	state := aws_sdkv2.DualStackEndpointStateDisabled
+	if useDualStackEndpoint {
+		state = aws_sdkv2.DualStackEndpointStateEnabled
+	}
+
+	cfg := c.awsConfig.Copy()
+	cfg.ConfigSources = append([]any{dualStackEndpointSource{state: state}}, cfg.ConfigSources...)
+
+	return &cfg
+}
+
+// sessionWithDualStackEndpoint returns a copy of the AWS SDK for Go v1 session
+// with the DualStack endpoint setting overridden.
+func (c *AWSClient) sessionWithDualStackEndpoint(useDualStackEndpoint bool) *session_sdkv1.Session {
+	state := endpoints_sdkv1.DualStackEndpointStateDisabled
+	if useDualStackEndpoint {
+		state = endpoints_sdkv1.DualStackEndpointStateEnabled
+	}
+
+	return c.session.Copy(&aws_sdkv1.Config{UseDualStackEndpoint: state})
+}
+
+// dualStackEndpointSource is an AWS SDK for Go v2 configuration source
+// that provides a fixed DualStack endpoint setting.
+type dualStackEndpointSource struct {
+	state aws_sdkv2.DualStackEndpointState
+}
+
+func (s dualStackEndpointSource) GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error) {
+	return s.state, true, nil
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
@@ -33,6 +33,8 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          *DefaultTimeoutsConfig
+	DualStackEndpointOverrides     map[string]bool
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -52,6 +54,7 @@ type Config struct {
 	SecretKey                      string
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
+	SimulateIAMPermissions         bool
 	SkipCredsValidation            bool
 	SkipRegionValidation           bool
 	SkipRequestingAccountId        bool
@@ -211,7 +214,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.AccountID = accountID
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeoutsConfig = c.DefaultTimeoutsConfig
 	client.dnsSuffix = dnsSuffix
+	client.dualStackEndpoints = c.DualStackEndpointOverrides
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
@@ -226,6 +231,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.simulateIAMPermissions = c.SimulateIAMPermissions
 	client.stsRegion = c.STSRegion
 
 	return client, diags
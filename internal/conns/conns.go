@@ -19,6 +19,11 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface implemented by service packages that contain ephemeral resources.
+type ServicePackageWithEphemeralResources interface {
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
 type (
 	contextKeyType int
 )
@@ -29,9 +34,10 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	IsDataSource       bool   // Data source?
-	ResourceName       string // Friendly resource name, e.g. "Subnet"
-	ServicePackageName string // Canonical name defined as a constant in names package
+	IsDataSource        bool   // Data source?
+	IsEphemeralResource bool   // Ephemeral resource?
+	ResourceName        string // Friendly resource name, e.g. "Subnet"
+	ServicePackageName  string // Canonical name defined as a constant in names package
 }
 
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
@@ -44,6 +50,16 @@ func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		IsEphemeralResource: true,
+		ResourceName:        resourceName,
+		ServicePackageName:  servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
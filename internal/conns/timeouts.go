@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+)
+
+// Resource operations which support timeouts.
+const (
+	TimeoutCreate = "create"
+	TimeoutRead   = "read"
+	TimeoutUpdate = "update"
+	TimeoutDelete = "delete"
+)
+
+// ResourceTimeouts holds per-operation timeout values.
+// A zero value means that no timeout is set for the operation.
+type ResourceTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+func (t ResourceTimeouts) get(operation string) time.Duration {
+	switch operation {
+	case TimeoutCreate:
+		return t.Create
+	case TimeoutRead:
+		return t.Read
+	case TimeoutUpdate:
+		return t.Update
+	case TimeoutDelete:
+		return t.Delete
+	default:
+		return 0
+	}
+}
+
+// DefaultTimeoutsConfig holds the provider-level default resource timeouts.
+type DefaultTimeoutsConfig struct {
+	// Default timeouts apply to all resources. They only ever extend a resource's built-in default.
+	Default ResourceTimeouts
+	// Overrides are keyed by resource type name and replace the resource's built-in default.
+	Overrides map[string]ResourceTimeouts
+}
+
+// Timeout returns the default timeout for the specified resource type and operation.
+// builtin is the resource's own default for the operation; a zero value indicates that the
+// resource does not support a timeout for the operation and is returned unchanged.
+// A timeout configured in the resource's `timeouts` block still takes precedence over the returned value.
+func (c *DefaultTimeoutsConfig) Timeout(typeName, operation string, builtin time.Duration) time.Duration {
+	if c == nil || builtin == 0 {
+		return builtin
+	}
+
+	if v, ok := c.Overrides[typeName]; ok {
+		if timeout := v.get(operation); timeout > 0 {
+			return timeout
+		}
+	}
+
+	if timeout := c.Default.get(operation); timeout > builtin {
+		return timeout
+	}
+
+	return builtin
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultTimeoutsConfigTimeout(t *testing.T) {
+	t.Parallel()
+
+	config := &DefaultTimeoutsConfig{
+		Default: ResourceTimeouts{
+			Create: 30 * time.Minute,
+			Update: 60 * time.Minute,
+		},
+		Overrides: map[string]ResourceTimeouts{
+			"aws_db_instance": {
+				Create: 10 * time.Minute,
+				Delete: 2 * time.Hour,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config    *DefaultTimeoutsConfig
+		typeName  string
+		operation string
+		builtin   time.Duration
+		expected  time.Duration
+	}{
+		"no config": {
+			typeName:  "aws_vpc",
+			operation: TimeoutCreate,
+			builtin:   5 * time.Minute,
+			expected:  5 * time.Minute,
+		},
+		"default extends": {
+			config:    config,
+			typeName:  "aws_vpc",
+			operation: TimeoutCreate,
+			builtin:   5 * time.Minute,
+			expected:  30 * time.Minute,
+		},
+		"default does not shorten": {
+			config:    config,
+			typeName:  "aws_vpc",
+			operation: TimeoutUpdate,
+			builtin:   90 * time.Minute,
+			expected:  90 * time.Minute,
+		},
+		"default not set for operation": {
+			config:    config,
+			typeName:  "aws_vpc",
+			operation: TimeoutDelete,
+			builtin:   5 * time.Minute,
+			expected:  5 * time.Minute,
+		},
+		"operation not supported": {
+			config:    config,
+			typeName:  "aws_vpc",
+			operation: TimeoutCreate,
+			expected:  0,
+		},
+		"override replaces": {
+			config:    config,
+			typeName:  "aws_db_instance",
+			operation: TimeoutCreate,
+			builtin:   40 * time.Minute,
+			expected:  10 * time.Minute,
+		},
+		"override falls back to default": {
+			config:    config,
+			typeName:  "aws_db_instance",
+			operation: TimeoutUpdate,
+			builtin:   80 * time.Minute,
+			expected:  80 * time.Minute,
+		},
+		"override operation not supported": {
+			config:    config,
+			typeName:  "aws_db_instance",
+			operation: TimeoutDelete,
+			expected:  0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.Timeout(testCase.typeName, testCase.operation, testCase.builtin), testCase.expected; got != want {
+				t.Errorf("Timeout = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// durationValidator validates that a string Attribute's value is a valid, non-negative duration.
+type durationValidator struct{}
+
+func (validator durationValidator) Description(_ context.Context) string {
+	return "value must be a valid non-negative duration, e.g. 30m or 1h30m"
+}
+
+func (validator durationValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator durationValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if d, err := time.ParseDuration(request.ConfigValue.ValueString()); err != nil || d < 0 {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			request.ConfigValue.ValueString(),
+		))
+		return
+	}
+}
+
+// Duration returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which can be parsed by time.ParseDuration.
+//   - Is not a negative duration.
+//
+// This matches the behavior of verify.ValidDuration for SDKv2 schemas.
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Duration() validator.String {
+	return durationValidator{}
+}
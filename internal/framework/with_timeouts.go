@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -37,6 +38,14 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 	w.defaultDeleteTimeout = timeout
 }
 
+// ApplyDefaultTimeouts applies any provider-level default timeouts to the resource's default timeout values.
+func (w *WithTimeouts) ApplyDefaultTimeouts(typeName string, config *conns.DefaultTimeoutsConfig) {
+	w.defaultCreateTimeout = config.Timeout(typeName, conns.TimeoutCreate, w.defaultCreateTimeout)
+	w.defaultReadTimeout = config.Timeout(typeName, conns.TimeoutRead, w.defaultReadTimeout)
+	w.defaultUpdateTimeout = config.Timeout(typeName, conns.TimeoutUpdate, w.defaultUpdateTimeout)
+	w.defaultDeleteTimeout = config.Timeout(typeName, conns.TimeoutDelete, w.defaultDeleteTimeout)
+}
+
 // CreateTimeout returns any configured Create timeout value or the default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
 	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
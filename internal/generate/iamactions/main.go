@@ -0,0 +1,802 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	const (
+		filename = `actions_gen.go`
+	)
+	g := common.NewGenerator()
+
+	packageName := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/%s/%s", packageName, filename)
+
+	data, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	type servicePackage struct {
+		providerPackage string
+		sdkPackages     []string
+	}
+	var servicePackages []servicePackage
+	var sdkPackages []string
+
+	for _, l := range data {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+
+		if _, err := os.Stat(fmt.Sprintf("../service/%s/service_package_gen.go", p)); err != nil {
+			continue
+		}
+
+		s := servicePackage{
+			providerPackage: p,
+		}
+
+		// Prefer the AWS SDK for Go v2 package, if any.
+		if l.ClientSDKV2() {
+			s.sdkPackages = append(s.sdkPackages, "github.com/aws/aws-sdk-go-v2/service/"+l.GoV2Package())
+		}
+		if l.ClientSDKV1() {
+			s.sdkPackages = append(s.sdkPackages, "github.com/aws/aws-sdk-go/service/"+l.GoV1Package())
+		}
+
+		servicePackages = append(servicePackages, s)
+		sdkPackages = append(sdkPackages, s.sdkPackages...)
+	}
+
+	signingNames, err := readSigningNames(sdkPackages)
+
+	if err != nil {
+		g.Fatalf("error reading AWS SDK signing names: %s", err)
+	}
+
+	td := TemplateData{
+		PackageName: packageName,
+	}
+
+	for _, s := range servicePackages {
+		// Service packages such as "meta" don't call any AWS SDK.
+		if len(s.sdkPackages) == 0 {
+			continue
+		}
+
+		var prefix string
+		for _, v := range s.sdkPackages {
+			if prefix = signingNames[v]; prefix != "" {
+				break
+			}
+		}
+
+		if prefix == "" {
+			g.Warnf("no AWS SDK signing name found for service package %s", s.providerPackage)
+			continue
+		}
+
+		if v, ok := iamServicePrefixes[prefix]; ok {
+			prefix = v
+		}
+
+		v := newVisitor(prefix)
+
+		if err := v.processDir(fmt.Sprintf("../service/%s", s.providerPackage)); err != nil {
+			g.Fatalf("error processing service package %s: %s", s.providerPackage, err)
+		}
+
+		td.Resources = append(td.Resources, v.resources()...)
+	}
+
+	sort.SliceStable(td.Resources, func(i, j int) bool {
+		return td.Resources[i].TypeName < td.Resources[j].TypeName
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("iamactions", tmpl, td); err != nil {
+		g.Fatalf("error generating IAM actions: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type OperationDatum struct {
+	Operation string // Go constant name, e.g. "OperationCreate"
+	Actions   []string
+}
+
+type ResourceDatum struct {
+	TypeName   string
+	Operations []OperationDatum
+}
+
+type TemplateData struct {
+	PackageName string
+	Resources   []ResourceDatum
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+// iamServicePrefixes maps AWS SDK signing names to IAM service prefixes where they differ.
+var iamServicePrefixes = map[string]string{
+	"amazonbedrockcontrolplaneservice": "bedrock",
+	"cloudapiservice":                  "cloudformation", // Cloud Control API.
+	"email":                            "ses",
+	"key-value-store":                  "cloudfront-keyvaluestore",
+	"monitoring":                       "cloudwatch",
+}
+
+// iamActionOverrides maps IAM actions derived from API operation names to the IAM actions actually required,
+// where they differ.
+var iamActionOverrides = map[string][]string{
+	"budgets:CreateBudget":                           {"budgets:ModifyBudget"},
+	"budgets:CreateNotification":                     {"budgets:ModifyBudget"},
+	"budgets:CreateSubscriber":                       {"budgets:ModifyBudget"},
+	"budgets:DeleteBudget":                           {"budgets:ModifyBudget"},
+	"budgets:DeleteNotification":                     {"budgets:ModifyBudget"},
+	"budgets:DeleteSubscriber":                       {"budgets:ModifyBudget"},
+	"budgets:DescribeBudget":                         {"budgets:ViewBudget"},
+	"budgets:DescribeBudgets":                        {"budgets:ViewBudget"},
+	"budgets:DescribeNotificationsForBudget":         {"budgets:ViewBudget"},
+	"budgets:DescribeSubscribersForNotification":     {"budgets:ViewBudget"},
+	"budgets:UpdateBudget":                           {"budgets:ModifyBudget"},
+	"budgets:UpdateNotification":                     {"budgets:ModifyBudget"},
+	"budgets:UpdateSubscriber":                       {"budgets:ModifyBudget"},
+	"cassandra:CreateKeyspace":                       {"cassandra:Create"},
+	"cassandra:CreateTable":                          {"cassandra:Create"},
+	"cassandra:DeleteKeyspace":                       {"cassandra:Drop"},
+	"cassandra:DeleteTable":                          {"cassandra:Drop"},
+	"cassandra:GetKeyspace":                          {"cassandra:Select"},
+	"cassandra:GetTable":                             {"cassandra:Select"},
+	"cassandra:ListKeyspaces":                        {"cassandra:Select"},
+	"cassandra:ListTables":                           {"cassandra:Select"},
+	"cassandra:RestoreTable":                         {"cassandra:Restore"},
+	"cassandra:UpdateTable":                          {"cassandra:Alter"},
+	"lambda:Invoke":                                  {"lambda:InvokeFunction"},
+	"s3:CompleteMultipartUpload":                     {"s3:PutObject"},
+	"s3:CopyObject":                                  {"s3:GetObject", "s3:PutObject"},
+	"s3:CreateMultipartUpload":                       {"s3:PutObject"},
+	"s3:DeleteBucketAnalyticsConfiguration":          {"s3:PutAnalyticsConfiguration"},
+	"s3:DeleteBucketCors":                            {"s3:PutBucketCORS"},
+	"s3:DeleteBucketEncryption":                      {"s3:PutEncryptionConfiguration"},
+	"s3:DeleteBucketIntelligentTieringConfiguration": {"s3:PutIntelligentTieringConfiguration"},
+	"s3:DeleteBucketInventoryConfiguration":          {"s3:PutInventoryConfiguration"},
+	"s3:DeleteBucketLifecycle":                       {"s3:PutLifecycleConfiguration"},
+	"s3:DeleteBucketMetricsConfiguration":            {"s3:PutMetricsConfiguration"},
+	"s3:DeleteBucketOwnershipControls":               {"s3:PutBucketOwnershipControls"},
+	"s3:DeleteBucketReplication":                     {"s3:PutReplicationConfiguration"},
+	"s3:DeleteBucketTagging":                         {"s3:PutBucketTagging"},
+	"s3:DeleteObjects":                               {"s3:DeleteObject"},
+	"s3:DeletePublicAccessBlock":                     {"s3:PutBucketPublicAccessBlock"},
+	"s3:GetBucketAccelerateConfiguration":            {"s3:GetAccelerateConfiguration"},
+	"s3:GetBucketAnalyticsConfiguration":             {"s3:GetAnalyticsConfiguration"},
+	"s3:GetBucketCors":                               {"s3:GetBucketCORS"},
+	"s3:GetBucketEncryption":                         {"s3:GetEncryptionConfiguration"},
+	"s3:GetBucketIntelligentTieringConfiguration":    {"s3:GetIntelligentTieringConfiguration"},
+	"s3:GetBucketInventoryConfiguration":             {"s3:GetInventoryConfiguration"},
+	"s3:GetBucketLifecycleConfiguration":             {"s3:GetLifecycleConfiguration"},
+	"s3:GetBucketMetricsConfiguration":               {"s3:GetMetricsConfiguration"},
+	"s3:GetBucketNotificationConfiguration":          {"s3:GetBucketNotification"},
+	"s3:GetBucketReplication":                        {"s3:GetReplicationConfiguration"},
+	"s3:GetObjectLockConfiguration":                  {"s3:GetBucketObjectLockConfiguration"},
+	"s3:GetPublicAccessBlock":                        {"s3:GetBucketPublicAccessBlock"},
+	"s3:HeadBucket":                                  {"s3:ListBucket"},
+	"s3:HeadObject":                                  {"s3:GetObject"},
+	"s3:ListObjectVersions":                          {"s3:ListBucketVersions"},
+	"s3:ListObjects":                                 {"s3:ListBucket"},
+	"s3:ListObjectsV2":                               {"s3:ListBucket"},
+	"s3:PutBucketAccelerateConfiguration":            {"s3:PutAccelerateConfiguration"},
+	"s3:PutBucketAnalyticsConfiguration":             {"s3:PutAnalyticsConfiguration"},
+	"s3:PutBucketCors":                               {"s3:PutBucketCORS"},
+	"s3:PutBucketEncryption":                         {"s3:PutEncryptionConfiguration"},
+	"s3:PutBucketIntelligentTieringConfiguration":    {"s3:PutIntelligentTieringConfiguration"},
+	"s3:PutBucketInventoryConfiguration":             {"s3:PutInventoryConfiguration"},
+	"s3:PutBucketLifecycleConfiguration":             {"s3:PutLifecycleConfiguration"},
+	"s3:PutBucketMetricsConfiguration":               {"s3:PutMetricsConfiguration"},
+	"s3:PutBucketNotificationConfiguration":          {"s3:PutBucketNotification"},
+	"s3:PutBucketReplication":                        {"s3:PutReplicationConfiguration"},
+	"s3:PutObjectLockConfiguration":                  {"s3:PutBucketObjectLockConfiguration"},
+	"s3:PutPublicAccessBlock":                        {"s3:PutBucketPublicAccessBlock"},
+	"s3:UploadPart":                                  {"s3:PutObject"},
+}
+
+// apiOperationName returns the API operation called by the specified AWS SDK client method.
+// AWS SDK for Go v1 methods have "WithContext" and "Pages" variants, and client methods such as
+// v1 waiters and v2's Options don't call an API operation.
+func apiOperationName(method string) (string, bool) {
+	if method == "Options" || strings.HasPrefix(method, "WaitUntil") {
+		return "", false
+	}
+
+	method = strings.TrimSuffix(method, "WithContext")
+	method = strings.TrimSuffix(method, "Pages")
+
+	return method, true
+}
+
+// iamActions returns the IAM actions required to call the specified API operation of the service with the specified IAM prefix.
+func iamActions(prefix, apiOperation string) []string {
+	// API Gateway (REST and HTTP/WebSocket APIs) authorizes requests by HTTP method rather than by API operation.
+	if prefix == "apigateway" {
+		return []string{prefix + ":" + apiGatewayHTTPMethod(apiOperation)}
+	}
+
+	action := prefix + ":" + apiOperation
+
+	if v, ok := iamActionOverrides[action]; ok {
+		return v
+	}
+
+	return []string{action}
+}
+
+// apiGatewayHTTPMethod returns the HTTP method used by the specified API Gateway API operation.
+func apiGatewayHTTPMethod(apiOperation string) string {
+	switch apiOperation {
+	case "ImportApi", "ReimportApi", "TagResource":
+		return "PUT"
+	case "UntagResource":
+		return "DELETE"
+	}
+
+	switch {
+	case strings.HasPrefix(apiOperation, "Get"), strings.HasPrefix(apiOperation, "List"):
+		return "GET"
+	case strings.HasPrefix(apiOperation, "Update"):
+		return "PATCH"
+	case strings.HasPrefix(apiOperation, "Delete"), strings.HasPrefix(apiOperation, "Flush"):
+		return "DELETE"
+	case strings.HasPrefix(apiOperation, "Put"):
+		return "PUT"
+	default: // Create, Generate, Import, Test...
+		return "POST"
+	}
+}
+
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+
+	// AWS SDK for Go v2: `signingName = "logs"` in endpoints.go.
+	sdkV2SigningName = regexache.MustCompile(`\bsigningName = "([^"]+)"`)
+	// AWS SDK for Go v1: `c.SigningName = "ses"` or, where the signing name is the endpoints ID,
+	// `EndpointsID = "logs"` or `ServiceName = "logs"` with `EndpointsID = ServiceName` in service.go.
+	sdkV1SigningName        = regexache.MustCompile(`\bc\.SigningName = "([^"]+)"`)
+	sdkV1EndpointsID        = regexache.MustCompile(`\bEndpointsID = "([^"]+)"`)
+	sdkV1EndpointsIDService = regexache.MustCompile(`\bEndpointsID = ServiceName\b`)
+	sdkV1ServiceName        = regexache.MustCompile(`\bServiceName = "([^"]+)"`)
+
+	// e.g. `NewListQueuesPaginator(conn, input)`.
+	paginatorFunc = regexache.MustCompile(`^New([0-9A-Za-z]+)Paginator$`)
+)
+
+// readSigningNames returns the SigV4 signing names of the specified AWS SDK for Go packages.
+func readSigningNames(importPaths []string) (map[string]string, error) {
+	importPaths = slices.Clone(importPaths)
+	slices.Sort(importPaths)
+	args := append([]string{"list", "-e", "-f", "{{.ImportPath}}\t{{.Dir}}"}, slices.Compact(importPaths)...)
+	output, err := exec.Command("go", args...).Output()
+
+	if err != nil {
+		return nil, fmt.Errorf("listing AWS SDK packages: %w", err)
+	}
+
+	signingNames := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+
+	for scanner.Scan() {
+		importPath, dir, ok := strings.Cut(scanner.Text(), "\t")
+
+		if !ok || dir == "" {
+			continue
+		}
+
+		if strings.HasPrefix(importPath, "github.com/aws/aws-sdk-go-v2/") {
+			b, err := os.ReadFile(path.Join(dir, "endpoints.go"))
+
+			if err != nil {
+				return nil, err
+			}
+
+			if m := sdkV2SigningName.FindSubmatch(b); m != nil {
+				signingNames[importPath] = string(m[1])
+			}
+		} else {
+			b, err := os.ReadFile(path.Join(dir, "service.go"))
+
+			if err != nil {
+				return nil, err
+			}
+
+			if m := sdkV1SigningName.FindSubmatch(b); m != nil {
+				signingNames[importPath] = string(m[1])
+			} else if m := sdkV1EndpointsID.FindSubmatch(b); m != nil {
+				signingNames[importPath] = string(m[1])
+			} else if m := sdkV1ServiceName.FindSubmatch(b); m != nil && sdkV1EndpointsIDService.Match(b) {
+				signingNames[importPath] = string(m[1])
+			}
+		}
+	}
+
+	return signingNames, scanner.Err()
+}
+
+type resource struct {
+	typeNames          []string // A resource type can be registered under more than one name.
+	transparentTagging bool
+	tagsResourceType   string
+	// Entry points for each operation.
+	entries map[string][]*ast.FuncDecl // Operation constant name -> functions.
+	recv    string                     // Receiver type name for Plugin Framework resources and SDK resource handlers.
+}
+
+type visitor struct {
+	prefix string
+
+	funcs     map[string]*ast.FuncDecl            // Function name -> declaration.
+	methods   map[string]map[string]*ast.FuncDecl // Receiver type name -> method name -> declaration.
+	factories []*ast.FuncDecl
+}
+
+func newVisitor(prefix string) *visitor {
+	return &visitor{
+		prefix:  prefix,
+		funcs:   make(map[string]*ast.FuncDecl),
+		methods: make(map[string]map[string]*ast.FuncDecl),
+	}
+}
+
+// processDir scans a single service package directory and indexes the contained functions and methods.
+func (v *visitor) processDir(path string) error {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return fmt.Errorf("parsing (%s): %w", path, err)
+	}
+
+	for _, pkg := range packageMap {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				funcDecl, ok := decl.(*ast.FuncDecl)
+
+				if !ok || funcDecl.Body == nil {
+					continue
+				}
+
+				if funcDecl.Recv == nil {
+					v.funcs[funcDecl.Name.Name] = funcDecl
+
+					if funcDecl.Doc != nil {
+						v.factories = append(v.factories, funcDecl)
+					}
+
+					continue
+				}
+
+				if recv := receiverTypeName(funcDecl); recv != "" {
+					if _, ok := v.methods[recv]; !ok {
+						v.methods[recv] = make(map[string]*ast.FuncDecl)
+					}
+					v.methods[recv][funcDecl.Name.Name] = funcDecl
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// resources returns the IAM actions for the package's annotated resources.
+func (v *visitor) resources() []ResourceDatum {
+	var results []ResourceDatum
+
+	for _, factory := range v.factories {
+		r := v.resource(factory)
+
+		if r == nil || len(r.typeNames) == 0 {
+			continue
+		}
+
+		var operations []OperationDatum
+
+		for _, operation := range []string{"OperationCreate", "OperationUpdate", "OperationDelete"} {
+			entries := r.entries[operation]
+
+			if operation == "OperationUpdate" && r.transparentTagging && len(entries) > 0 {
+				if f, ok := v.funcs["updateTags"]; ok {
+					entries = append(entries, f)
+				}
+			}
+
+			apiOperations := make(map[string]struct{})
+			visited := make(map[*ast.FuncDecl]struct{})
+
+			for _, f := range entries {
+				v.collect(f, r.recv, r.tagsResourceType, apiOperations, visited)
+			}
+
+			var actions []string
+			for apiOperation := range apiOperations {
+				actions = append(actions, iamActions(v.prefix, apiOperation)...)
+			}
+
+			if len(actions) == 0 {
+				continue
+			}
+
+			slices.Sort(actions)
+
+			operations = append(operations, OperationDatum{
+				Operation: operation,
+				Actions:   slices.Compact(actions),
+			})
+		}
+
+		if len(operations) == 0 {
+			continue
+		}
+
+		for _, typeName := range r.typeNames {
+			results = append(results, ResourceDatum{
+				TypeName:   typeName,
+				Operations: operations,
+			})
+		}
+	}
+
+	return results
+}
+
+// resource returns the resource declared by the specified annotated factory function, or nil.
+func (v *visitor) resource(factory *ast.FuncDecl) *resource {
+	var r *resource
+
+	for _, line := range factory.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+
+		if len(m) == 0 {
+			continue
+		}
+
+		switch m[1] {
+		case "SDKResource":
+			args := common.ParseArgs(m[3])
+
+			if len(args.Positional) == 0 {
+				continue
+			}
+
+			if r != nil {
+				r.typeNames = append(r.typeNames, args.Positional[0])
+				continue
+			}
+
+			recv, entries := v.sdkResourceEntries(factory)
+			r = &resource{
+				typeNames: []string{args.Positional[0]},
+				entries:   entries,
+				recv:      recv,
+			}
+		case "FrameworkResource":
+			recv := v.frameworkResourceType(factory)
+
+			if recv == "" {
+				continue
+			}
+
+			methods := v.methods[recv]
+			r = &resource{
+				entries: make(map[string][]*ast.FuncDecl),
+				recv:    recv,
+			}
+
+			if typeName := frameworkResourceTypeName(methods["Metadata"]); typeName != "" {
+				r.typeNames = append(r.typeNames, typeName)
+			}
+
+			for operation, name := range map[string]string{
+				"OperationCreate": "Create",
+				"OperationUpdate": "Update",
+				"OperationDelete": "Delete",
+			} {
+				if f, ok := methods[name]; ok {
+					r.entries[operation] = append(r.entries[operation], f)
+				}
+			}
+		}
+	}
+
+	if r == nil {
+		return nil
+	}
+
+	for _, line := range factory.Doc.List {
+		if m := annotation.FindStringSubmatch(line.Text); len(m) > 0 && m[1] == "Tags" {
+			r.transparentTagging = true
+			r.tagsResourceType = common.ParseArgs(m[3]).Keyword["resourceType"]
+		}
+	}
+
+	return r
+}
+
+// sdkResourceEntries returns the CRUD handler functions set in a Plugin SDK factory function's schema.Resource.
+// Handlers can be package functions or the methods of a handler value declared in the factory function,
+// in which case the handler's type name is also returned.
+func (v *visitor) sdkResourceEntries(factory *ast.FuncDecl) (string, map[string][]*ast.FuncDecl) {
+	var recv string
+	entries := make(map[string][]*ast.FuncDecl)
+	handlers := make(map[string]string) // Local variable name -> type name.
+
+	ast.Inspect(factory.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		ident, ok := assign.Lhs[0].(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		expr := assign.Rhs[0]
+		if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			expr = unary.X
+		}
+
+		if lit, ok := expr.(*ast.CompositeLit); ok {
+			if typ, ok := lit.Type.(*ast.Ident); ok {
+				handlers[ident.Name] = typ.Name
+			}
+		}
+
+		return true
+	})
+
+	ast.Inspect(factory.Body, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+
+		if !ok {
+			return true
+		}
+
+		key, ok := kv.Key.(*ast.Ident)
+
+		if !ok {
+			return true
+		}
+
+		var operation string
+		switch key.Name {
+		case "Create", "CreateContext", "CreateWithoutTimeout":
+			operation = "OperationCreate"
+		case "Update", "UpdateContext", "UpdateWithoutTimeout":
+			operation = "OperationUpdate"
+		case "Delete", "DeleteContext", "DeleteWithoutTimeout":
+			operation = "OperationDelete"
+		default:
+			return true
+		}
+
+		switch value := kv.Value.(type) {
+		case *ast.Ident:
+			if f, ok := v.funcs[value.Name]; ok {
+				entries[operation] = append(entries[operation], f)
+			}
+		case *ast.SelectorExpr:
+			if x, ok := value.X.(*ast.Ident); ok {
+				if typ, ok := handlers[x.Name]; ok {
+					if f, ok := v.methods[typ][value.Sel.Name]; ok {
+						recv = typ
+						entries[operation] = append(entries[operation], f)
+					}
+				}
+			}
+		}
+
+		return true
+	})
+
+	return recv, entries
+}
+
+// frameworkResourceType returns the name of the resource type instantiated by a Plugin Framework factory function.
+func (v *visitor) frameworkResourceType(factory *ast.FuncDecl) string {
+	var recv string
+
+	ast.Inspect(factory.Body, func(n ast.Node) bool {
+		if recv != "" {
+			return false
+		}
+
+		if lit, ok := n.(*ast.CompositeLit); ok {
+			if ident, ok := lit.Type.(*ast.Ident); ok {
+				if _, ok := v.methods[ident.Name]; ok {
+					recv = ident.Name
+				}
+			}
+		}
+
+		return true
+	})
+
+	return recv
+}
+
+// frameworkResourceTypeName returns the type name set by a Plugin Framework resource's Metadata method.
+func frameworkResourceTypeName(metadata *ast.FuncDecl) string {
+	var typeName string
+
+	if metadata == nil {
+		return ""
+	}
+
+	ast.Inspect(metadata.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return true
+		}
+
+		if sel, ok := assign.Lhs[0].(*ast.SelectorExpr); !ok || sel.Sel.Name != "TypeName" {
+			return true
+		}
+
+		if lit, ok := assign.Rhs[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			typeName, _ = strconv.Unquote(lit.Value)
+		}
+
+		return true
+	})
+
+	return typeName
+}
+
+// collect adds the API operations called on the service client by the specified function,
+// and by the package's functions and the receiver's methods that it references, to apiOperations.
+// Where a function switches on a resourceType variable, as multi-resource tagging functions do,
+// only the case matching the specified tagging resource type is followed.
+func (v *visitor) collect(f *ast.FuncDecl, recv, resourceType string, apiOperations map[string]struct{}, visited map[*ast.FuncDecl]struct{}) {
+	if _, ok := visited[f]; ok {
+		return
+	}
+	visited[f] = struct{}{}
+
+	var recvName string
+	if f.Recv != nil && len(f.Recv.List) > 0 && len(f.Recv.List[0].Names) > 0 {
+		recvName = f.Recv.List[0].Names[0].Name
+	}
+
+	var inspect func(n ast.Node) bool
+	inspect = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+
+			if !ok {
+				return true
+			}
+
+			x, ok := sel.X.(*ast.Ident)
+
+			if !ok {
+				return true
+			}
+
+			switch {
+			case x.Name == "conn":
+				if apiOperation, ok := apiOperationName(sel.Sel.Name); ok {
+					apiOperations[apiOperation] = struct{}{}
+				}
+			case x.Name == recvName && recv != "":
+				if m, ok := v.methods[recv][sel.Sel.Name]; ok {
+					v.collect(m, recv, resourceType, apiOperations, visited)
+				}
+			default:
+				if m := paginatorFunc.FindStringSubmatch(sel.Sel.Name); m != nil && len(n.Args) > 0 {
+					if arg, ok := n.Args[0].(*ast.Ident); ok && arg.Name == "conn" {
+						apiOperations[m[1]] = struct{}{}
+					}
+				}
+			}
+		case *ast.SwitchStmt:
+			if tag, ok := n.Tag.(*ast.Ident); !ok || tag.Name != "resourceType" || resourceType == "" {
+				return true
+			}
+
+			for _, stmt := range n.Body.List {
+				clause := stmt.(*ast.CaseClause)
+
+				if !slices.ContainsFunc(clause.List, func(expr ast.Expr) bool {
+					lit, ok := expr.(*ast.BasicLit)
+					return ok && lit.Kind == token.STRING && lit.Value == strconv.Quote(resourceType)
+				}) {
+					continue
+				}
+
+				for _, stmt := range clause.Body {
+					ast.Inspect(stmt, inspect)
+				}
+			}
+
+			return false
+		case *ast.SelectorExpr:
+			// Don't treat selected field, method or package member names as references to the package's functions.
+			ast.Inspect(n.X, inspect)
+
+			return false
+		case *ast.KeyValueExpr:
+			// Nor struct literal field names.
+			ast.Inspect(n.Value, inspect)
+
+			return false
+		case *ast.Ident:
+			// Calls to, or references to, other functions in the package.
+			if g, ok := v.funcs[n.Name]; ok && (n.Obj == nil || n.Obj.Kind == ast.Fun) {
+				v.collect(g, recv, resourceType, apiOperations, visited)
+			}
+		}
+
+		return true
+	}
+
+	ast.Inspect(f.Body, inspect)
+}
+
+func receiverTypeName(funcDecl *ast.FuncDecl) string {
+	if len(funcDecl.Recv.List) == 0 {
+		return ""
+	}
+
+	typ := funcDecl.Recv.List[0].Type
+
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+
+	if index, ok := typ.(*ast.IndexExpr); ok {
+		typ = index.X
+	}
+
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
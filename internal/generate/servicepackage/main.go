@@ -53,6 +53,7 @@ func main() {
 		v := &visitor{
 			g: g,
 
+			ephemeralResources:   make([]ResourceDatum, 0),
 			frameworkDataSources: make([]ResourceDatum, 0),
 			frameworkResources:   make([]ResourceDatum, 0),
 			sdkDataSources:       make(map[string]ResourceDatum),
@@ -71,6 +72,7 @@ func main() {
 			GoV2Package:          l.GoV2Package(),
 			ProviderPackage:      p,
 			ProviderNameUpper:    l.ProviderNameUpper(),
+			EphemeralResources:   v.ephemeralResources,
 			FrameworkDataSources: v.frameworkDataSources,
 			FrameworkResources:   v.frameworkResources,
 			SDKDataSources:       v.sdkDataSources,
@@ -82,6 +84,9 @@ func main() {
 			s.GoV1ClientTypeName = l.GoV1ClientTypeName()
 		}
 
+		sort.SliceStable(s.EphemeralResources, func(i, j int) bool {
+			return s.EphemeralResources[i].FactoryName < s.EphemeralResources[j].FactoryName
+		})
 		sort.SliceStable(s.FrameworkDataSources, func(i, j int) bool {
 			return s.FrameworkDataSources[i].FactoryName < s.FrameworkDataSources[j].FactoryName
 		})
@@ -119,6 +124,7 @@ type ServiceDatum struct {
 	GoV2Package          string // AWS SDK for Go v2 package name
 	ProviderPackage      string
 	ProviderNameUpper    string
+	EphemeralResources   []ResourceDatum
 	FrameworkDataSources []ResourceDatum
 	FrameworkResources   []ResourceDatum
 	SDKDataSources       map[string]ResourceDatum
@@ -141,6 +147,7 @@ type visitor struct {
 	functionName string
 	packageName  string
 
+	ephemeralResources   []ResourceDatum
 	frameworkDataSources []ResourceDatum
 	frameworkResources   []ResourceDatum
 	sdkDataSources       map[string]ResourceDatum
@@ -182,7 +189,8 @@ func (v *visitor) processFile(file *ast.File) {
 }
 
 // processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source,
+// or a Plugin Framework ephemeral resource.
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
@@ -224,6 +232,12 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			}
 
 			switch annotationName := m[1]; annotationName {
+			case "EphemeralResource":
+				if slices.ContainsFunc(v.ephemeralResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
+					v.errs = append(v.errs, fmt.Errorf("duplicate Ephemeral Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				} else {
+					v.ephemeralResources = append(v.ephemeralResources, d)
+				}
 			case "FrameworkDataSource":
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iamsim
+
+// Operation is the kind of change a plan will make to a resource.
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// Actions returns the IAM actions that the specified operation on the specified resource type
+// is likely to require, or nil if the resource type or operation isn't known.
+// The mapping is generated from the AWS API calls made by each resource's create, update and delete handlers
+// (see internal/generate/iamactions) and is best-effort: calls made through other packages' helpers
+// or to other services' APIs, and permissions such as iam:PassRole that aren't API operations, aren't included.
+func Actions(typeName string, operation Operation) []string {
+	if v, ok := actions[typeName]; ok {
+		return v[operation]
+	}
+
+	return nil
+}
+
+// HasActions returns whether any IAM actions are known for the specified resource type.
+func HasActions(typeName string) bool {
+	_, ok := actions[typeName]
+
+	return ok
+}
@@ -0,0 +1,20733 @@
+// Code generated by internal/generate/iamactions/main.go; DO NOT EDIT.
+
+package iamsim
+
+// actions maps resource type name to operation to the IAM actions the operation is likely to require.
+var actions = map[string]map[Operation][]string{
+	"aws_accessanalyzer_analyzer": {
+		OperationCreate: {
+			"access-analyzer:CreateAnalyzer",
+			"access-analyzer:GetAnalyzer",
+		},
+		OperationUpdate: {
+			"access-analyzer:GetAnalyzer",
+			"access-analyzer:TagResource",
+			"access-analyzer:UntagResource",
+			"access-analyzer:UpdateAnalyzer",
+		},
+		OperationDelete: {
+			"access-analyzer:DeleteAnalyzer",
+		},
+	},
+	"aws_accessanalyzer_archive_rule": {
+		OperationCreate: {
+			"access-analyzer:CreateArchiveRule",
+			"access-analyzer:GetArchiveRule",
+		},
+		OperationUpdate: {
+			"access-analyzer:GetArchiveRule",
+			"access-analyzer:UpdateArchiveRule",
+		},
+		OperationDelete: {
+			"access-analyzer:DeleteArchiveRule",
+		},
+	},
+	"aws_account_alternate_contact": {
+		OperationCreate: {
+			"account:GetAlternateContact",
+			"account:PutAlternateContact",
+		},
+		OperationUpdate: {
+			"account:GetAlternateContact",
+			"account:PutAlternateContact",
+		},
+		OperationDelete: {
+			"account:DeleteAlternateContact",
+			"account:GetAlternateContact",
+		},
+	},
+	"aws_account_primary_contact": {
+		OperationCreate: {
+			"account:GetContactInformation",
+			"account:PutContactInformation",
+		},
+		OperationUpdate: {
+			"account:GetContactInformation",
+			"account:PutContactInformation",
+		},
+	},
+	"aws_account_region": {
+		OperationCreate: {
+			"account:DisableRegion",
+			"account:EnableRegion",
+			"account:GetRegionOptStatus",
+		},
+		OperationUpdate: {
+			"account:DisableRegion",
+			"account:EnableRegion",
+			"account:GetRegionOptStatus",
+		},
+	},
+	"aws_acm_certificate": {
+		OperationCreate: {
+			"acm:DescribeCertificate",
+			"acm:ImportCertificate",
+			"acm:RequestCertificate",
+		},
+		OperationUpdate: {
+			"acm:AddTagsToCertificate",
+			"acm:DescribeCertificate",
+			"acm:ImportCertificate",
+			"acm:RemoveTagsFromCertificate",
+			"acm:RenewCertificate",
+			"acm:UpdateCertificateOptions",
+		},
+		OperationDelete: {
+			"acm:DeleteCertificate",
+		},
+	},
+	"aws_acm_certificate_validation": {
+		OperationCreate: {
+			"acm:DescribeCertificate",
+		},
+	},
+	"aws_acmpca_certificate": {
+		OperationCreate: {
+			"acm-pca:GetCertificate",
+			"acm-pca:IssueCertificate",
+		},
+		OperationDelete: {
+			"acm-pca:RevokeCertificate",
+		},
+	},
+	"aws_acmpca_certificate_authority": {
+		OperationCreate: {
+			"acm-pca:CreateCertificateAuthority",
+			"acm-pca:DescribeCertificateAuthority",
+			"acm-pca:GetCertificateAuthorityCertificate",
+			"acm-pca:GetCertificateAuthorityCsr",
+		},
+		OperationUpdate: {
+			"acm-pca:DescribeCertificateAuthority",
+			"acm-pca:GetCertificateAuthorityCertificate",
+			"acm-pca:GetCertificateAuthorityCsr",
+			"acm-pca:TagCertificateAuthority",
+			"acm-pca:UntagCertificateAuthority",
+			"acm-pca:UpdateCertificateAuthority",
+		},
+		OperationDelete: {
+			"acm-pca:DeleteCertificateAuthority",
+			"acm-pca:UpdateCertificateAuthority",
+		},
+	},
+	"aws_acmpca_certificate_authority_certificate": {
+		OperationCreate: {
+			"acm-pca:GetCertificateAuthorityCertificate",
+			"acm-pca:ImportCertificateAuthorityCertificate",
+		},
+	},
+	"aws_acmpca_permission": {
+		OperationCreate: {
+			"acm-pca:CreatePermission",
+			"acm-pca:ListPermissions",
+		},
+		OperationDelete: {
+			"acm-pca:DeletePermission",
+		},
+	},
+	"aws_acmpca_policy": {
+		OperationCreate: {
+			"acm-pca:GetPolicy",
+			"acm-pca:PutPolicy",
+		},
+		OperationUpdate: {
+			"acm-pca:GetPolicy",
+			"acm-pca:PutPolicy",
+		},
+		OperationDelete: {
+			"acm-pca:DeletePolicy",
+		},
+	},
+	"aws_alb": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateLoadBalancer",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetIpAddressType",
+			"elasticloadbalancing:SetSecurityGroups",
+			"elasticloadbalancing:SetSubnets",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetIpAddressType",
+			"elasticloadbalancing:SetSecurityGroups",
+			"elasticloadbalancing:SetSubnets",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancer",
+		},
+	},
+	"aws_alb_listener": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateListener",
+			"elasticloadbalancing:DescribeListeners",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeListeners",
+			"elasticloadbalancing:ModifyListener",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteListener",
+		},
+	},
+	"aws_alb_listener_certificate": {
+		OperationCreate: {
+			"elasticloadbalancing:AddListenerCertificates",
+			"elasticloadbalancing:DescribeListenerCertificates",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:RemoveListenerCertificates",
+		},
+	},
+	"aws_alb_listener_rule": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateRule",
+			"elasticloadbalancing:DescribeRules",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeRules",
+			"elasticloadbalancing:ModifyRule",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetRulePriorities",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteRule",
+		},
+	},
+	"aws_alb_target_group": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateTargetGroup",
+			"elasticloadbalancing:DescribeTargetGroupAttributes",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:ModifyTargetGroupAttributes",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeTargetGroupAttributes",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:ModifyTargetGroup",
+			"elasticloadbalancing:ModifyTargetGroupAttributes",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteTargetGroup",
+		},
+	},
+	"aws_alb_target_group_attachment": {
+		OperationCreate: {
+			"elasticloadbalancing:RegisterTargets",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeregisterTargets",
+		},
+	},
+	"aws_ami": {
+		OperationCreate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+			"ec2:RegisterImage",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeprecation",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+			"ec2:ModifyImageAttribute",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+			"ec2:DeregisterImage",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+		},
+	},
+	"aws_ami_copy": {
+		OperationCreate: {
+			"ec2:CopyImage",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeprecation",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+			"ec2:ModifyImageAttribute",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+			"ec2:DeregisterImage",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+		},
+	},
+	"aws_ami_from_instance": {
+		OperationCreate: {
+			"ec2:CreateImage",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeprecation",
+			"ec2:DisableImageDeregistrationProtection",
+			"ec2:EnableImageDeprecation",
+			"ec2:EnableImageDeregistrationProtection",
+			"ec2:ModifyImageAttribute",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+			"ec2:DeregisterImage",
+			"ec2:DescribeImages",
+			"ec2:DisableImageDeregistrationProtection",
+		},
+	},
+	"aws_ami_launch_permission": {
+		OperationCreate: {
+			"ec2:DescribeImageAttribute",
+			"ec2:ModifyImageAttribute",
+		},
+		OperationDelete: {
+			"ec2:ModifyImageAttribute",
+		},
+	},
+	"aws_amplify_app": {
+		OperationCreate: {
+			"amplify:CreateApp",
+			"amplify:GetApp",
+		},
+		OperationUpdate: {
+			"amplify:GetApp",
+			"amplify:TagResource",
+			"amplify:UntagResource",
+			"amplify:UpdateApp",
+		},
+		OperationDelete: {
+			"amplify:DeleteApp",
+		},
+	},
+	"aws_amplify_backend_environment": {
+		OperationCreate: {
+			"amplify:CreateBackendEnvironment",
+			"amplify:GetBackendEnvironment",
+		},
+		OperationDelete: {
+			"amplify:DeleteBackendEnvironment",
+		},
+	},
+	"aws_amplify_branch": {
+		OperationCreate: {
+			"amplify:CreateBranch",
+			"amplify:GetBranch",
+		},
+		OperationUpdate: {
+			"amplify:GetBranch",
+			"amplify:TagResource",
+			"amplify:UntagResource",
+			"amplify:UpdateBranch",
+		},
+		OperationDelete: {
+			"amplify:DeleteBranch",
+		},
+	},
+	"aws_amplify_domain_association": {
+		OperationCreate: {
+			"amplify:CreateDomainAssociation",
+			"amplify:GetDomainAssociation",
+		},
+		OperationUpdate: {
+			"amplify:GetDomainAssociation",
+			"amplify:UpdateDomainAssociation",
+		},
+		OperationDelete: {
+			"amplify:DeleteDomainAssociation",
+		},
+	},
+	"aws_amplify_webhook": {
+		OperationCreate: {
+			"amplify:CreateWebhook",
+			"amplify:GetWebhook",
+		},
+		OperationUpdate: {
+			"amplify:GetWebhook",
+			"amplify:UpdateWebhook",
+		},
+		OperationDelete: {
+			"amplify:DeleteWebhook",
+		},
+	},
+	"aws_api_gateway_account": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+	},
+	"aws_api_gateway_api_key": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_authorizer": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_base_path_mapping": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_client_certificate": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_deployment": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+		},
+	},
+	"aws_api_gateway_documentation_part": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_documentation_version": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_domain_name": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_gateway_response": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_integration": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_integration_response": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_method": {
+		OperationCreate: {
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_method_response": {
+		OperationCreate: {
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_method_settings": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:PATCH",
+		},
+	},
+	"aws_api_gateway_model": {
+		OperationCreate: {
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_request_validator": {
+		OperationCreate: {
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_resource": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_rest_api": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:POST",
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_rest_api_policy": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:PATCH",
+		},
+	},
+	"aws_api_gateway_stage": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_usage_plan": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+			"apigateway:PATCH",
+		},
+	},
+	"aws_api_gateway_usage_plan_key": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_api_gateway_vpc_link": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+		},
+	},
+	"aws_apigatewayv2_api": {
+		OperationCreate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:POST",
+			"apigateway:PUT",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_api_mapping": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_authorizer": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_deployment": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_domain_name": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_integration": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_integration_response": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_model": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_route": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_route_response": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:GET",
+			"apigateway:PATCH",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_stage": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+		},
+	},
+	"aws_apigatewayv2_vpc_link": {
+		OperationCreate: {
+			"apigateway:GET",
+			"apigateway:POST",
+		},
+		OperationUpdate: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+			"apigateway:PATCH",
+			"apigateway:PUT",
+		},
+		OperationDelete: {
+			"apigateway:DELETE",
+			"apigateway:GET",
+		},
+	},
+	"aws_app_cookie_stickiness_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:CreateAppCookieStickinessPolicy",
+			"elasticloadbalancing:DescribeLoadBalancerPolicies",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+	},
+	"aws_appautoscaling_policy": {
+		OperationCreate: {
+			"application-autoscaling:DescribeScalingPolicies",
+			"application-autoscaling:PutScalingPolicy",
+		},
+		OperationUpdate: {
+			"application-autoscaling:DescribeScalingPolicies",
+			"application-autoscaling:PutScalingPolicy",
+		},
+		OperationDelete: {
+			"application-autoscaling:DeleteScalingPolicy",
+		},
+	},
+	"aws_appautoscaling_scheduled_action": {
+		OperationCreate: {
+			"application-autoscaling:DescribeScheduledActions",
+			"application-autoscaling:PutScheduledAction",
+		},
+		OperationUpdate: {
+			"application-autoscaling:DescribeScheduledActions",
+			"application-autoscaling:PutScheduledAction",
+		},
+		OperationDelete: {
+			"application-autoscaling:DeleteScheduledAction",
+		},
+	},
+	"aws_appautoscaling_target": {
+		OperationCreate: {
+			"application-autoscaling:DescribeScalableTargets",
+			"application-autoscaling:RegisterScalableTarget",
+		},
+		OperationUpdate: {
+			"application-autoscaling:DescribeScalableTargets",
+			"application-autoscaling:RegisterScalableTarget",
+			"application-autoscaling:TagResource",
+			"application-autoscaling:UntagResource",
+		},
+		OperationDelete: {
+			"application-autoscaling:DeregisterScalableTarget",
+			"application-autoscaling:DescribeScalableTargets",
+		},
+	},
+	"aws_appconfig_application": {
+		OperationCreate: {
+			"appconfig:CreateApplication",
+			"appconfig:GetApplication",
+		},
+		OperationUpdate: {
+			"appconfig:GetApplication",
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+			"appconfig:UpdateApplication",
+		},
+		OperationDelete: {
+			"appconfig:DeleteApplication",
+		},
+	},
+	"aws_appconfig_configuration_profile": {
+		OperationCreate: {
+			"appconfig:CreateConfigurationProfile",
+			"appconfig:GetConfigurationProfile",
+		},
+		OperationUpdate: {
+			"appconfig:GetConfigurationProfile",
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+			"appconfig:UpdateConfigurationProfile",
+		},
+		OperationDelete: {
+			"appconfig:DeleteConfigurationProfile",
+		},
+	},
+	"aws_appconfig_deployment": {
+		OperationCreate: {
+			"appconfig:GetDeployment",
+			"appconfig:StartDeployment",
+		},
+		OperationUpdate: {
+			"appconfig:GetDeployment",
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+		},
+	},
+	"aws_appconfig_deployment_strategy": {
+		OperationCreate: {
+			"appconfig:CreateDeploymentStrategy",
+			"appconfig:GetDeploymentStrategy",
+		},
+		OperationUpdate: {
+			"appconfig:GetDeploymentStrategy",
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+			"appconfig:UpdateDeploymentStrategy",
+		},
+		OperationDelete: {
+			"appconfig:DeleteDeploymentStrategy",
+		},
+	},
+	"aws_appconfig_environment": {
+		OperationCreate: {
+			"appconfig:CreateEnvironment",
+		},
+		OperationUpdate: {
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+			"appconfig:UpdateEnvironment",
+		},
+		OperationDelete: {
+			"appconfig:DeleteEnvironment",
+		},
+	},
+	"aws_appconfig_extension": {
+		OperationCreate: {
+			"appconfig:CreateExtension",
+			"appconfig:GetExtension",
+		},
+		OperationUpdate: {
+			"appconfig:GetExtension",
+			"appconfig:TagResource",
+			"appconfig:UntagResource",
+			"appconfig:UpdateExtension",
+		},
+		OperationDelete: {
+			"appconfig:DeleteExtension",
+		},
+	},
+	"aws_appconfig_extension_association": {
+		OperationCreate: {
+			"appconfig:CreateExtensionAssociation",
+			"appconfig:GetExtensionAssociation",
+		},
+		OperationUpdate: {
+			"appconfig:GetExtensionAssociation",
+			"appconfig:UpdateExtensionAssociation",
+		},
+		OperationDelete: {
+			"appconfig:DeleteExtensionAssociation",
+		},
+	},
+	"aws_appconfig_feature_flag": {
+		OperationCreate: {
+			"appconfig:CreateHostedConfigurationVersion",
+			"appconfig:GetHostedConfigurationVersion",
+			"appconfig:ListHostedConfigurationVersions",
+		},
+		OperationUpdate: {
+			"appconfig:CreateHostedConfigurationVersion",
+			"appconfig:GetHostedConfigurationVersion",
+			"appconfig:ListHostedConfigurationVersions",
+		},
+		OperationDelete: {
+			"appconfig:CreateHostedConfigurationVersion",
+			"appconfig:GetHostedConfigurationVersion",
+			"appconfig:ListHostedConfigurationVersions",
+		},
+	},
+	"aws_appconfig_hosted_configuration_version": {
+		OperationCreate: {
+			"appconfig:CreateHostedConfigurationVersion",
+			"appconfig:GetHostedConfigurationVersion",
+		},
+		OperationDelete: {
+			"appconfig:DeleteHostedConfigurationVersion",
+		},
+	},
+	"aws_appflow_connector_profile": {
+		OperationCreate: {
+			"appflow:CreateConnectorProfile",
+			"appflow:DescribeConnectorProfiles",
+		},
+		OperationUpdate: {
+			"appflow:DescribeConnectorProfiles",
+			"appflow:UpdateConnectorProfile",
+		},
+		OperationDelete: {
+			"appflow:DeleteConnectorProfile",
+		},
+	},
+	"aws_appflow_flow": {
+		OperationCreate: {
+			"appflow:CreateFlow",
+			"appflow:DescribeFlow",
+			"appflow:ListFlows",
+		},
+		OperationUpdate: {
+			"appflow:DescribeFlow",
+			"appflow:ListFlows",
+			"appflow:TagResource",
+			"appflow:UntagResource",
+			"appflow:UpdateFlow",
+		},
+		OperationDelete: {
+			"appflow:DeleteFlow",
+			"appflow:ListFlows",
+		},
+	},
+	"aws_appintegrations_data_integration": {
+		OperationCreate: {
+			"app-integrations:CreateDataIntegration",
+			"app-integrations:GetDataIntegration",
+		},
+		OperationUpdate: {
+			"app-integrations:GetDataIntegration",
+			"app-integrations:TagResource",
+			"app-integrations:UntagResource",
+			"app-integrations:UpdateDataIntegration",
+		},
+		OperationDelete: {
+			"app-integrations:DeleteDataIntegration",
+		},
+	},
+	"aws_appintegrations_event_integration": {
+		OperationCreate: {
+			"app-integrations:CreateEventIntegration",
+			"app-integrations:GetEventIntegration",
+		},
+		OperationUpdate: {
+			"app-integrations:GetEventIntegration",
+			"app-integrations:TagResource",
+			"app-integrations:UntagResource",
+			"app-integrations:UpdateEventIntegration",
+		},
+		OperationDelete: {
+			"app-integrations:DeleteEventIntegration",
+		},
+	},
+	"aws_applicationinsights_application": {
+		OperationCreate: {
+			"applicationinsights:CreateApplication",
+			"applicationinsights:DescribeApplication",
+		},
+		OperationUpdate: {
+			"applicationinsights:DescribeApplication",
+			"applicationinsights:TagResource",
+			"applicationinsights:UntagResource",
+			"applicationinsights:UpdateApplication",
+		},
+		OperationDelete: {
+			"applicationinsights:DeleteApplication",
+			"applicationinsights:DescribeApplication",
+		},
+	},
+	"aws_appmesh_gateway_route": {
+		OperationCreate: {
+			"appmesh:CreateGatewayRoute",
+			"appmesh:DescribeGatewayRoute",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeGatewayRoute",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateGatewayRoute",
+		},
+		OperationDelete: {
+			"appmesh:DeleteGatewayRoute",
+		},
+	},
+	"aws_appmesh_mesh": {
+		OperationCreate: {
+			"appmesh:CreateMesh",
+			"appmesh:DescribeMesh",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeMesh",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateMesh",
+		},
+		OperationDelete: {
+			"appmesh:DeleteMesh",
+		},
+	},
+	"aws_appmesh_route": {
+		OperationCreate: {
+			"appmesh:CreateRoute",
+			"appmesh:DescribeRoute",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeRoute",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateRoute",
+		},
+		OperationDelete: {
+			"appmesh:DeleteRoute",
+		},
+	},
+	"aws_appmesh_virtual_gateway": {
+		OperationCreate: {
+			"appmesh:CreateVirtualGateway",
+			"appmesh:DescribeVirtualGateway",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeVirtualGateway",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateVirtualGateway",
+		},
+		OperationDelete: {
+			"appmesh:DeleteVirtualGateway",
+		},
+	},
+	"aws_appmesh_virtual_node": {
+		OperationCreate: {
+			"appmesh:CreateVirtualNode",
+			"appmesh:DescribeVirtualNode",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeVirtualNode",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateVirtualNode",
+		},
+		OperationDelete: {
+			"appmesh:DeleteVirtualNode",
+		},
+	},
+	"aws_appmesh_virtual_router": {
+		OperationCreate: {
+			"appmesh:CreateVirtualRouter",
+			"appmesh:DescribeVirtualRouter",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeVirtualRouter",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateVirtualRouter",
+		},
+		OperationDelete: {
+			"appmesh:DeleteVirtualRouter",
+		},
+	},
+	"aws_appmesh_virtual_service": {
+		OperationCreate: {
+			"appmesh:CreateVirtualService",
+			"appmesh:DescribeVirtualService",
+		},
+		OperationUpdate: {
+			"appmesh:DescribeVirtualService",
+			"appmesh:TagResource",
+			"appmesh:UntagResource",
+			"appmesh:UpdateVirtualService",
+		},
+		OperationDelete: {
+			"appmesh:DeleteVirtualService",
+		},
+	},
+	"aws_apprunner_auto_scaling_configuration_version": {
+		OperationCreate: {
+			"apprunner:CreateAutoScalingConfiguration",
+			"apprunner:DescribeAutoScalingConfiguration",
+		},
+		OperationUpdate: {
+			"apprunner:DescribeAutoScalingConfiguration",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+		},
+		OperationDelete: {
+			"apprunner:DeleteAutoScalingConfiguration",
+			"apprunner:DescribeAutoScalingConfiguration",
+		},
+	},
+	"aws_apprunner_connection": {
+		OperationCreate: {
+			"apprunner:CreateConnection",
+			"apprunner:ListConnections",
+		},
+		OperationUpdate: {
+			"apprunner:ListConnections",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+		},
+		OperationDelete: {
+			"apprunner:DeleteConnection",
+			"apprunner:ListConnections",
+		},
+	},
+	"aws_apprunner_custom_domain_association": {
+		OperationCreate: {
+			"apprunner:AssociateCustomDomain",
+			"apprunner:DescribeCustomDomains",
+		},
+		OperationDelete: {
+			"apprunner:DescribeCustomDomains",
+			"apprunner:DisassociateCustomDomain",
+		},
+	},
+	"aws_apprunner_default_auto_scaling_configuration_version": {
+		OperationCreate: {
+			"apprunner:UpdateDefaultAutoScalingConfiguration",
+		},
+		OperationUpdate: {
+			"apprunner:UpdateDefaultAutoScalingConfiguration",
+		},
+	},
+	"aws_apprunner_deployment": {
+		OperationCreate: {
+			"apprunner:ListOperations",
+			"apprunner:StartDeployment",
+		},
+	},
+	"aws_apprunner_observability_configuration": {
+		OperationCreate: {
+			"apprunner:CreateObservabilityConfiguration",
+			"apprunner:DescribeObservabilityConfiguration",
+		},
+		OperationUpdate: {
+			"apprunner:DescribeObservabilityConfiguration",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+		},
+		OperationDelete: {
+			"apprunner:DeleteObservabilityConfiguration",
+			"apprunner:DescribeObservabilityConfiguration",
+		},
+	},
+	"aws_apprunner_service": {
+		OperationCreate: {
+			"apprunner:CreateService",
+			"apprunner:DescribeCustomDomains",
+			"apprunner:DescribeService",
+			"apprunner:ListOperations",
+		},
+		OperationUpdate: {
+			"apprunner:DescribeCustomDomains",
+			"apprunner:DescribeService",
+			"apprunner:ListOperations",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+			"apprunner:UpdateService",
+		},
+		OperationDelete: {
+			"apprunner:DeleteService",
+			"apprunner:DescribeService",
+		},
+	},
+	"aws_apprunner_vpc_connector": {
+		OperationCreate: {
+			"apprunner:CreateVpcConnector",
+			"apprunner:DescribeVpcConnector",
+		},
+		OperationUpdate: {
+			"apprunner:DescribeVpcConnector",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+		},
+		OperationDelete: {
+			"apprunner:DeleteVpcConnector",
+			"apprunner:DescribeVpcConnector",
+		},
+	},
+	"aws_apprunner_vpc_ingress_connection": {
+		OperationCreate: {
+			"apprunner:CreateVpcIngressConnection",
+			"apprunner:DescribeVpcIngressConnection",
+		},
+		OperationUpdate: {
+			"apprunner:DescribeVpcIngressConnection",
+			"apprunner:TagResource",
+			"apprunner:UntagResource",
+		},
+		OperationDelete: {
+			"apprunner:DeleteVpcIngressConnection",
+			"apprunner:DescribeVpcIngressConnection",
+		},
+	},
+	"aws_apprunner_web_acl_association": {
+		OperationCreate: {
+			"apprunner:AssociateWebACL",
+			"apprunner:GetWebACLForResource",
+		},
+		OperationDelete: {
+			"apprunner:DisassociateWebACL",
+		},
+	},
+	"aws_appstream_directory_config": {
+		OperationCreate: {
+			"appstream:CreateDirectoryConfig",
+			"appstream:DescribeDirectoryConfigs",
+		},
+		OperationUpdate: {
+			"appstream:DescribeDirectoryConfigs",
+			"appstream:UpdateDirectoryConfig",
+		},
+		OperationDelete: {
+			"appstream:DeleteDirectoryConfig",
+		},
+	},
+	"aws_appstream_fleet": {
+		OperationCreate: {
+			"appstream:CreateFleet",
+			"appstream:DescribeFleets",
+			"appstream:StartFleet",
+		},
+		OperationUpdate: {
+			"appstream:DescribeFleets",
+			"appstream:StartFleet",
+			"appstream:StopFleet",
+			"appstream:TagResource",
+			"appstream:UntagResource",
+			"appstream:UpdateFleet",
+		},
+		OperationDelete: {
+			"appstream:DeleteFleet",
+			"appstream:DescribeFleets",
+			"appstream:StopFleet",
+		},
+	},
+	"aws_appstream_fleet_stack_association": {
+		OperationCreate: {
+			"appstream:AssociateFleet",
+			"appstream:ListAssociatedStacks",
+		},
+		OperationDelete: {
+			"appstream:DisassociateFleet",
+		},
+	},
+	"aws_appstream_image_builder": {
+		OperationCreate: {
+			"appstream:CreateImageBuilder",
+			"appstream:DescribeImageBuilders",
+		},
+		OperationUpdate: {
+			"appstream:DescribeImageBuilders",
+			"appstream:TagResource",
+			"appstream:UntagResource",
+		},
+		OperationDelete: {
+			"appstream:DeleteImageBuilder",
+			"appstream:DescribeImageBuilders",
+		},
+	},
+	"aws_appstream_stack": {
+		OperationCreate: {
+			"appstream:CreateStack",
+			"appstream:DescribeStacks",
+		},
+		OperationUpdate: {
+			"appstream:DescribeStacks",
+			"appstream:TagResource",
+			"appstream:UntagResource",
+			"appstream:UpdateStack",
+		},
+		OperationDelete: {
+			"appstream:DeleteStack",
+			"appstream:DescribeStacks",
+		},
+	},
+	"aws_appstream_user": {
+		OperationCreate: {
+			"appstream:CreateUser",
+			"appstream:DescribeUsers",
+			"appstream:DisableUser",
+		},
+		OperationUpdate: {
+			"appstream:DisableUser",
+			"appstream:EnableUser",
+		},
+		OperationDelete: {
+			"appstream:DeleteUser",
+		},
+	},
+	"aws_appstream_user_stack_association": {
+		OperationCreate: {
+			"appstream:BatchAssociateUserStack",
+			"appstream:DescribeUserStackAssociations",
+		},
+		OperationDelete: {
+			"appstream:BatchDisassociateUserStack",
+		},
+	},
+	"aws_appsync_api": {
+		OperationCreate: {
+			"appsync:CreateApi",
+		},
+		OperationUpdate: {
+			"appsync:TagResource",
+			"appsync:UntagResource",
+			"appsync:UpdateApi",
+		},
+		OperationDelete: {
+			"appsync:DeleteApi",
+		},
+	},
+	"aws_appsync_api_cache": {
+		OperationCreate: {
+			"appsync:CreateApiCache",
+			"appsync:GetApiCache",
+		},
+		OperationUpdate: {
+			"appsync:GetApiCache",
+			"appsync:UpdateApiCache",
+		},
+		OperationDelete: {
+			"appsync:DeleteApiCache",
+			"appsync:GetApiCache",
+		},
+	},
+	"aws_appsync_api_key": {
+		OperationCreate: {
+			"appsync:CreateApiKey",
+			"appsync:ListApiKeys",
+		},
+		OperationUpdate: {
+			"appsync:ListApiKeys",
+			"appsync:UpdateApiKey",
+		},
+		OperationDelete: {
+			"appsync:DeleteApiKey",
+		},
+	},
+	"aws_appsync_channel_namespace": {
+		OperationCreate: {
+			"appsync:CreateChannelNamespace",
+		},
+		OperationUpdate: {
+			"appsync:TagResource",
+			"appsync:UntagResource",
+			"appsync:UpdateChannelNamespace",
+		},
+		OperationDelete: {
+			"appsync:DeleteChannelNamespace",
+		},
+	},
+	"aws_appsync_datasource": {
+		OperationCreate: {
+			"appsync:CreateDataSource",
+			"appsync:GetDataSource",
+		},
+		OperationUpdate: {
+			"appsync:GetDataSource",
+			"appsync:UpdateDataSource",
+		},
+		OperationDelete: {
+			"appsync:DeleteDataSource",
+		},
+	},
+	"aws_appsync_domain_name": {
+		OperationCreate: {
+			"appsync:CreateDomainName",
+			"appsync:GetDomainName",
+		},
+		OperationUpdate: {
+			"appsync:GetDomainName",
+			"appsync:UpdateDomainName",
+		},
+		OperationDelete: {
+			"appsync:DeleteDomainName",
+		},
+	},
+	"aws_appsync_domain_name_api_association": {
+		OperationCreate: {
+			"appsync:AssociateApi",
+			"appsync:GetApiAssociation",
+		},
+		OperationUpdate: {
+			"appsync:AssociateApi",
+			"appsync:GetApiAssociation",
+		},
+		OperationDelete: {
+			"appsync:DisassociateApi",
+			"appsync:GetApiAssociation",
+		},
+	},
+	"aws_appsync_function": {
+		OperationCreate: {
+			"appsync:CreateFunction",
+			"appsync:GetFunction",
+		},
+		OperationUpdate: {
+			"appsync:GetFunction",
+			"appsync:UpdateFunction",
+		},
+		OperationDelete: {
+			"appsync:DeleteFunction",
+		},
+	},
+	"aws_appsync_graphql_api": {
+		OperationCreate: {
+			"appsync:CreateGraphqlApi",
+			"appsync:GetGraphqlApi",
+			"appsync:GetIntrospectionSchema",
+			"appsync:GetSchemaCreationStatus",
+			"appsync:StartSchemaCreation",
+		},
+		OperationUpdate: {
+			"appsync:GetGraphqlApi",
+			"appsync:GetIntrospectionSchema",
+			"appsync:GetSchemaCreationStatus",
+			"appsync:StartSchemaCreation",
+			"appsync:TagResource",
+			"appsync:UntagResource",
+			"appsync:UpdateGraphqlApi",
+		},
+		OperationDelete: {
+			"appsync:DeleteGraphqlApi",
+		},
+	},
+	"aws_appsync_resolver": {
+		OperationCreate: {
+			"appsync:CreateResolver",
+			"appsync:GetResolver",
+		},
+		OperationUpdate: {
+			"appsync:GetResolver",
+			"appsync:UpdateResolver",
+		},
+		OperationDelete: {
+			"appsync:DeleteResolver",
+		},
+	},
+	"aws_appsync_type": {
+		OperationCreate: {
+			"appsync:CreateType",
+			"appsync:GetType",
+		},
+		OperationUpdate: {
+			"appsync:GetType",
+			"appsync:UpdateType",
+		},
+		OperationDelete: {
+			"appsync:DeleteType",
+		},
+	},
+	"aws_athena_data_catalog": {
+		OperationCreate: {
+			"athena:CreateDataCatalog",
+			"athena:GetDataCatalog",
+		},
+		OperationUpdate: {
+			"athena:GetDataCatalog",
+			"athena:TagResource",
+			"athena:UntagResource",
+			"athena:UpdateDataCatalog",
+		},
+		OperationDelete: {
+			"athena:DeleteDataCatalog",
+		},
+	},
+	"aws_athena_database": {
+		OperationCreate: {
+			"athena:GetDatabase",
+			"athena:GetQueryExecution",
+			"athena:GetQueryResults",
+			"athena:StartQueryExecution",
+		},
+		OperationDelete: {
+			"athena:GetQueryExecution",
+			"athena:GetQueryResults",
+			"athena:StartQueryExecution",
+		},
+	},
+	"aws_athena_named_query": {
+		OperationCreate: {
+			"athena:CreateNamedQuery",
+			"athena:GetNamedQuery",
+		},
+		OperationDelete: {
+			"athena:DeleteNamedQuery",
+		},
+	},
+	"aws_athena_prepared_statement": {
+		OperationCreate: {
+			"athena:CreatePreparedStatement",
+			"athena:GetPreparedStatement",
+		},
+		OperationUpdate: {
+			"athena:GetPreparedStatement",
+			"athena:UpdatePreparedStatement",
+		},
+		OperationDelete: {
+			"athena:DeletePreparedStatement",
+		},
+	},
+	"aws_athena_workgroup": {
+		OperationCreate: {
+			"athena:CreateWorkGroup",
+			"athena:GetWorkGroup",
+			"athena:UpdateWorkGroup",
+		},
+		OperationUpdate: {
+			"athena:GetWorkGroup",
+			"athena:TagResource",
+			"athena:UntagResource",
+			"athena:UpdateWorkGroup",
+		},
+		OperationDelete: {
+			"athena:DeleteWorkGroup",
+		},
+	},
+	"aws_auditmanager_account_registration": {
+		OperationCreate: {
+			"auditmanager:RegisterAccount",
+		},
+		OperationUpdate: {
+			"auditmanager:RegisterAccount",
+		},
+		OperationDelete: {
+			"auditmanager:DeregisterAccount",
+		},
+	},
+	"aws_auditmanager_assessment": {
+		OperationCreate: {
+			"auditmanager:CreateAssessment",
+		},
+		OperationUpdate: {
+			"auditmanager:TagResource",
+			"auditmanager:UntagResource",
+			"auditmanager:UpdateAssessment",
+		},
+		OperationDelete: {
+			"auditmanager:DeleteAssessment",
+		},
+	},
+	"aws_auditmanager_assessment_delegation": {
+		OperationCreate: {
+			"auditmanager:BatchCreateDelegationByAssessment",
+		},
+		OperationDelete: {
+			"auditmanager:BatchDeleteDelegationByAssessment",
+		},
+	},
+	"aws_auditmanager_assessment_report": {
+		OperationCreate: {
+			"auditmanager:CreateAssessmentReport",
+		},
+		OperationDelete: {
+			"auditmanager:DeleteAssessmentReport",
+		},
+	},
+	"aws_auditmanager_control": {
+		OperationCreate: {
+			"auditmanager:CreateControl",
+		},
+		OperationUpdate: {
+			"auditmanager:TagResource",
+			"auditmanager:UntagResource",
+			"auditmanager:UpdateControl",
+		},
+		OperationDelete: {
+			"auditmanager:DeleteControl",
+		},
+	},
+	"aws_auditmanager_framework": {
+		OperationCreate: {
+			"auditmanager:CreateAssessmentFramework",
+		},
+		OperationUpdate: {
+			"auditmanager:TagResource",
+			"auditmanager:UntagResource",
+			"auditmanager:UpdateAssessmentFramework",
+		},
+		OperationDelete: {
+			"auditmanager:DeleteAssessmentFramework",
+		},
+	},
+	"aws_auditmanager_framework_share": {
+		OperationCreate: {
+			"auditmanager:StartAssessmentFrameworkShare",
+		},
+		OperationDelete: {
+			"auditmanager:DeleteAssessmentFrameworkShare",
+			"auditmanager:UpdateAssessmentFrameworkShare",
+		},
+	},
+	"aws_auditmanager_organization_admin_account_registration": {
+		OperationCreate: {
+			"auditmanager:RegisterOrganizationAdminAccount",
+		},
+		OperationDelete: {
+			"auditmanager:DeregisterOrganizationAdminAccount",
+		},
+	},
+	"aws_autoscaling_attachment": {
+		OperationCreate: {
+			"autoscaling:AttachLoadBalancerTargetGroups",
+			"autoscaling:AttachLoadBalancers",
+			"autoscaling:DescribeAutoScalingGroups",
+		},
+		OperationDelete: {
+			"autoscaling:DetachLoadBalancerTargetGroups",
+			"autoscaling:DetachLoadBalancers",
+		},
+	},
+	"aws_autoscaling_group": {
+		OperationCreate: {
+			"autoscaling:CreateAutoScalingGroup",
+			"autoscaling:DescribeAutoScalingGroups",
+			"autoscaling:DescribeInstanceHealth",
+			"autoscaling:DescribeScalingActivities",
+			"autoscaling:DescribeTargetHealth",
+			"autoscaling:EnableMetricsCollection",
+			"autoscaling:PutLifecycleHook",
+			"autoscaling:PutWarmPool",
+			"autoscaling:SuspendProcesses",
+			"autoscaling:UpdateAutoScalingGroup",
+		},
+		OperationUpdate: {
+			"autoscaling:AttachLoadBalancerTargetGroups",
+			"autoscaling:AttachLoadBalancers",
+			"autoscaling:AttachTrafficSources",
+			"autoscaling:CancelInstanceRefresh",
+			"autoscaling:CreateOrUpdateTags",
+			"autoscaling:DeleteTags",
+			"autoscaling:DeleteWarmPool",
+			"autoscaling:DescribeAutoScalingGroups",
+			"autoscaling:DescribeInstanceHealth",
+			"autoscaling:DescribeInstanceRefreshes",
+			"autoscaling:DescribeLoadBalancerTargetGroups",
+			"autoscaling:DescribeLoadBalancers",
+			"autoscaling:DescribeScalingActivities",
+			"autoscaling:DescribeTargetHealth",
+			"autoscaling:DescribeTrafficSources",
+			"autoscaling:DescribeWarmPool",
+			"autoscaling:DetachLoadBalancerTargetGroups",
+			"autoscaling:DetachLoadBalancers",
+			"autoscaling:DetachTrafficSources",
+			"autoscaling:DisableMetricsCollection",
+			"autoscaling:EnableMetricsCollection",
+			"autoscaling:PutWarmPool",
+			"autoscaling:ResumeProcesses",
+			"autoscaling:StartInstanceRefresh",
+			"autoscaling:SuspendProcesses",
+			"autoscaling:UpdateAutoScalingGroup",
+		},
+		OperationDelete: {
+			"autoscaling:DeleteAutoScalingGroup",
+			"autoscaling:DeleteWarmPool",
+			"autoscaling:DescribeAutoScalingGroups",
+			"autoscaling:DescribeWarmPool",
+			"autoscaling:PutWarmPool",
+			"autoscaling:SetInstanceProtection",
+			"autoscaling:UpdateAutoScalingGroup",
+		},
+	},
+	"aws_autoscaling_group_tag": {
+		OperationCreate: {
+			"autoscaling:CreateOrUpdateTags",
+			"autoscaling:DeleteTags",
+			"autoscaling:DescribeTags",
+		},
+		OperationUpdate: {
+			"autoscaling:CreateOrUpdateTags",
+			"autoscaling:DeleteTags",
+			"autoscaling:DescribeTags",
+		},
+		OperationDelete: {
+			"autoscaling:CreateOrUpdateTags",
+			"autoscaling:DeleteTags",
+		},
+	},
+	"aws_autoscaling_lifecycle_hook": {
+		OperationCreate: {
+			"autoscaling:DescribeLifecycleHooks",
+			"autoscaling:PutLifecycleHook",
+		},
+		OperationUpdate: {
+			"autoscaling:DescribeLifecycleHooks",
+			"autoscaling:PutLifecycleHook",
+		},
+		OperationDelete: {
+			"autoscaling:DeleteLifecycleHook",
+		},
+	},
+	"aws_autoscaling_notification": {
+		OperationCreate: {
+			"autoscaling:DescribeNotificationConfigurations",
+			"autoscaling:PutNotificationConfiguration",
+		},
+		OperationUpdate: {
+			"autoscaling:DeleteNotificationConfiguration",
+			"autoscaling:DescribeNotificationConfigurations",
+			"autoscaling:PutNotificationConfiguration",
+		},
+		OperationDelete: {
+			"autoscaling:DeleteNotificationConfiguration",
+		},
+	},
+	"aws_autoscaling_policy": {
+		OperationCreate: {
+			"autoscaling:DescribePolicies",
+			"autoscaling:PutScalingPolicy",
+		},
+		OperationUpdate: {
+			"autoscaling:DescribePolicies",
+			"autoscaling:PutScalingPolicy",
+		},
+		OperationDelete: {
+			"autoscaling:DeletePolicy",
+		},
+	},
+	"aws_autoscaling_schedule": {
+		OperationCreate: {
+			"autoscaling:DescribeScheduledActions",
+			"autoscaling:PutScheduledUpdateGroupAction",
+		},
+		OperationUpdate: {
+			"autoscaling:DescribeScheduledActions",
+			"autoscaling:PutScheduledUpdateGroupAction",
+		},
+		OperationDelete: {
+			"autoscaling:DeleteScheduledAction",
+		},
+	},
+	"aws_autoscaling_traffic_source_attachment": {
+		OperationCreate: {
+			"autoscaling:AttachTrafficSources",
+			"autoscaling:DescribeTrafficSources",
+		},
+		OperationDelete: {
+			"autoscaling:DescribeTrafficSources",
+			"autoscaling:DetachTrafficSources",
+		},
+	},
+	"aws_autoscalingplans_scaling_plan": {
+		OperationCreate: {
+			"autoscaling-plans:CreateScalingPlan",
+			"autoscaling-plans:DescribeScalingPlans",
+		},
+		OperationUpdate: {
+			"autoscaling-plans:DescribeScalingPlans",
+			"autoscaling-plans:UpdateScalingPlan",
+		},
+		OperationDelete: {
+			"autoscaling-plans:DeleteScalingPlan",
+			"autoscaling-plans:DescribeScalingPlans",
+		},
+	},
+	"aws_backup_framework": {
+		OperationCreate: {
+			"backup:CreateFramework",
+			"backup:DescribeFramework",
+		},
+		OperationUpdate: {
+			"backup:DescribeFramework",
+			"backup:TagResource",
+			"backup:UntagResource",
+			"backup:UpdateFramework",
+		},
+		OperationDelete: {
+			"backup:DeleteFramework",
+			"backup:DescribeFramework",
+		},
+	},
+	"aws_backup_global_settings": {
+		OperationCreate: {
+			"backup:DescribeGlobalSettings",
+			"backup:UpdateGlobalSettings",
+		},
+		OperationUpdate: {
+			"backup:DescribeGlobalSettings",
+			"backup:UpdateGlobalSettings",
+		},
+	},
+	"aws_backup_plan": {
+		OperationCreate: {
+			"backup:CreateBackupPlan",
+			"backup:GetBackupPlan",
+		},
+		OperationUpdate: {
+			"backup:GetBackupPlan",
+			"backup:TagResource",
+			"backup:UntagResource",
+			"backup:UpdateBackupPlan",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupPlan",
+		},
+	},
+	"aws_backup_region_settings": {
+		OperationCreate: {
+			"backup:DescribeRegionSettings",
+			"backup:UpdateRegionSettings",
+		},
+		OperationUpdate: {
+			"backup:DescribeRegionSettings",
+			"backup:UpdateRegionSettings",
+		},
+	},
+	"aws_backup_report_plan": {
+		OperationCreate: {
+			"backup:CreateReportPlan",
+			"backup:DescribeReportPlan",
+		},
+		OperationUpdate: {
+			"backup:DescribeReportPlan",
+			"backup:TagResource",
+			"backup:UntagResource",
+			"backup:UpdateReportPlan",
+		},
+		OperationDelete: {
+			"backup:DeleteReportPlan",
+			"backup:DescribeReportPlan",
+		},
+	},
+	"aws_backup_selection": {
+		OperationCreate: {
+			"backup:CreateBackupSelection",
+			"backup:GetBackupSelection",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupSelection",
+		},
+	},
+	"aws_backup_vault": {
+		OperationCreate: {
+			"backup:CreateBackupVault",
+			"backup:DescribeBackupVault",
+		},
+		OperationUpdate: {
+			"backup:DescribeBackupVault",
+			"backup:TagResource",
+			"backup:UntagResource",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupVault",
+			"backup:DeleteRecoveryPoint",
+			"backup:DescribeRecoveryPoint",
+			"backup:ListRecoveryPointsByBackupVault",
+		},
+	},
+	"aws_backup_vault_lock_configuration": {
+		OperationCreate: {
+			"backup:DescribeBackupVault",
+			"backup:PutBackupVaultLockConfiguration",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupVaultLockConfiguration",
+		},
+	},
+	"aws_backup_vault_notifications": {
+		OperationCreate: {
+			"backup:GetBackupVaultNotifications",
+			"backup:PutBackupVaultNotifications",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupVaultNotifications",
+		},
+	},
+	"aws_backup_vault_policy": {
+		OperationCreate: {
+			"backup:GetBackupVaultAccessPolicy",
+			"backup:PutBackupVaultAccessPolicy",
+		},
+		OperationUpdate: {
+			"backup:GetBackupVaultAccessPolicy",
+			"backup:PutBackupVaultAccessPolicy",
+		},
+		OperationDelete: {
+			"backup:DeleteBackupVaultAccessPolicy",
+		},
+	},
+	"aws_batch_compute_environment": {
+		OperationCreate: {
+			"batch:CreateComputeEnvironment",
+			"batch:DescribeComputeEnvironments",
+			"batch:UpdateComputeEnvironment",
+		},
+		OperationUpdate: {
+			"batch:DescribeComputeEnvironments",
+			"batch:TagResource",
+			"batch:UntagResource",
+			"batch:UpdateComputeEnvironment",
+		},
+		OperationDelete: {
+			"batch:DeleteComputeEnvironment",
+			"batch:DescribeComputeEnvironments",
+			"batch:UpdateComputeEnvironment",
+		},
+	},
+	"aws_batch_job_definition": {
+		OperationCreate: {
+			"batch:DescribeJobDefinitions",
+			"batch:RegisterJobDefinition",
+		},
+		OperationUpdate: {
+			"batch:DeregisterJobDefinition",
+			"batch:DescribeJobDefinitions",
+			"batch:RegisterJobDefinition",
+			"batch:TagResource",
+			"batch:UntagResource",
+		},
+		OperationDelete: {
+			"batch:DeregisterJobDefinition",
+			"batch:DescribeJobDefinitions",
+		},
+	},
+	"aws_batch_job_queue": {
+		OperationCreate: {
+			"batch:CreateJobQueue",
+			"batch:DescribeJobQueues",
+		},
+		OperationUpdate: {
+			"batch:DescribeJobQueues",
+			"batch:TagResource",
+			"batch:UntagResource",
+			"batch:UpdateJobQueue",
+		},
+		OperationDelete: {
+			"batch:DeleteJobQueue",
+			"batch:DescribeJobQueues",
+			"batch:UpdateJobQueue",
+		},
+	},
+	"aws_batch_scheduling_policy": {
+		OperationCreate: {
+			"batch:CreateSchedulingPolicy",
+			"batch:DescribeSchedulingPolicies",
+		},
+		OperationUpdate: {
+			"batch:DescribeSchedulingPolicies",
+			"batch:TagResource",
+			"batch:UntagResource",
+			"batch:UpdateSchedulingPolicy",
+		},
+		OperationDelete: {
+			"batch:DeleteSchedulingPolicy",
+		},
+	},
+	"aws_bcmdataexports_export": {
+		OperationCreate: {
+			"bcm-data-exports:CreateExport",
+			"bcm-data-exports:GetExport",
+		},
+		OperationUpdate: {
+			"bcm-data-exports:GetExport",
+			"bcm-data-exports:TagResource",
+			"bcm-data-exports:UntagResource",
+			"bcm-data-exports:UpdateExport",
+		},
+		OperationDelete: {
+			"bcm-data-exports:DeleteExport",
+		},
+	},
+	"aws_bedrock_custom_model": {
+		OperationCreate: {
+			"bedrock:CreateModelCustomizationJob",
+			"bedrock:GetModelCustomizationJob",
+		},
+		OperationUpdate: {
+			"bedrock:TagResource",
+			"bedrock:UntagResource",
+		},
+		OperationDelete: {
+			"bedrock:DeleteCustomModel",
+			"bedrock:GetModelCustomizationJob",
+			"bedrock:StopModelCustomizationJob",
+		},
+	},
+	"aws_bedrock_model_invocation_logging_configuration": {
+		OperationCreate: {
+			"bedrock:PutModelInvocationLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"bedrock:PutModelInvocationLoggingConfiguration",
+		},
+		OperationDelete: {
+			"bedrock:DeleteModelInvocationLoggingConfiguration",
+		},
+	},
+	"aws_bedrock_provisioned_model_throughput": {
+		OperationCreate: {
+			"bedrock:CreateProvisionedModelThroughput",
+			"bedrock:GetProvisionedModelThroughput",
+		},
+		OperationDelete: {
+			"bedrock:DeleteProvisionedModelThroughput",
+		},
+	},
+	"aws_bedrockagent_agent": {
+		OperationCreate: {
+			"bedrock:CreateAgent",
+			"bedrock:GetAgent",
+			"bedrock:PrepareAgent",
+		},
+		OperationUpdate: {
+			"bedrock:GetAgent",
+			"bedrock:PrepareAgent",
+			"bedrock:TagResource",
+			"bedrock:UntagResource",
+			"bedrock:UpdateAgent",
+		},
+		OperationDelete: {
+			"bedrock:DeleteAgent",
+			"bedrock:GetAgent",
+		},
+	},
+	"aws_bedrockagent_agent_action_group": {
+		OperationCreate: {
+			"bedrock:CreateAgentActionGroup",
+		},
+		OperationUpdate: {
+			"bedrock:GetAgentActionGroup",
+			"bedrock:UpdateAgentActionGroup",
+		},
+		OperationDelete: {
+			"bedrock:DeleteAgentActionGroup",
+		},
+	},
+	"aws_bedrockagent_agent_alias": {
+		OperationCreate: {
+			"bedrock:CreateAgentAlias",
+			"bedrock:GetAgent",
+			"bedrock:GetAgentAlias",
+		},
+		OperationUpdate: {
+			"bedrock:GetAgentAlias",
+			"bedrock:TagResource",
+			"bedrock:UntagResource",
+			"bedrock:UpdateAgentAlias",
+		},
+		OperationDelete: {
+			"bedrock:DeleteAgentAlias",
+		},
+	},
+	"aws_bedrockagent_agent_knowledge_base_association": {
+		OperationCreate: {
+			"bedrock:AssociateAgentKnowledgeBase",
+		},
+		OperationUpdate: {
+			"bedrock:UpdateAgentKnowledgeBase",
+		},
+		OperationDelete: {
+			"bedrock:DisassociateAgentKnowledgeBase",
+		},
+	},
+	"aws_bedrockagent_data_source": {
+		OperationCreate: {
+			"bedrock:CreateDataSource",
+			"bedrock:GetDataSource",
+		},
+		OperationUpdate: {
+			"bedrock:UpdateDataSource",
+		},
+		OperationDelete: {
+			"bedrock:DeleteDataSource",
+			"bedrock:GetDataSource",
+		},
+	},
+	"aws_bedrockagent_knowledge_base": {
+		OperationCreate: {
+			"bedrock:CreateKnowledgeBase",
+			"bedrock:GetKnowledgeBase",
+		},
+		OperationUpdate: {
+			"bedrock:GetKnowledgeBase",
+			"bedrock:TagResource",
+			"bedrock:UntagResource",
+			"bedrock:UpdateKnowledgeBase",
+		},
+		OperationDelete: {
+			"bedrock:DeleteKnowledgeBase",
+			"bedrock:GetKnowledgeBase",
+		},
+	},
+	"aws_budgets_budget": {
+		OperationCreate: {
+			"budgets:ModifyBudget",
+			"budgets:ViewBudget",
+		},
+		OperationUpdate: {
+			"budgets:ModifyBudget",
+			"budgets:TagResource",
+			"budgets:UntagResource",
+			"budgets:ViewBudget",
+		},
+		OperationDelete: {
+			"budgets:ModifyBudget",
+		},
+	},
+	"aws_budgets_budget_action": {
+		OperationCreate: {
+			"budgets:CreateBudgetAction",
+			"budgets:DescribeBudgetAction",
+		},
+		OperationUpdate: {
+			"budgets:DescribeBudgetAction",
+			"budgets:TagResource",
+			"budgets:UntagResource",
+			"budgets:UpdateBudgetAction",
+		},
+		OperationDelete: {
+			"budgets:DeleteBudgetAction",
+		},
+	},
+	"aws_ce_anomaly_monitor": {
+		OperationCreate: {
+			"ce:CreateAnomalyMonitor",
+			"ce:GetAnomalyMonitors",
+		},
+		OperationUpdate: {
+			"ce:GetAnomalyMonitors",
+			"ce:TagResource",
+			"ce:UntagResource",
+			"ce:UpdateAnomalyMonitor",
+		},
+		OperationDelete: {
+			"ce:DeleteAnomalyMonitor",
+		},
+	},
+	"aws_ce_anomaly_subscription": {
+		OperationCreate: {
+			"ce:CreateAnomalySubscription",
+			"ce:GetAnomalySubscriptions",
+		},
+		OperationUpdate: {
+			"ce:GetAnomalySubscriptions",
+			"ce:TagResource",
+			"ce:UntagResource",
+			"ce:UpdateAnomalySubscription",
+		},
+		OperationDelete: {
+			"ce:DeleteAnomalySubscription",
+		},
+	},
+	"aws_ce_cost_allocation_tag": {
+		OperationCreate: {
+			"ce:ListCostAllocationTags",
+			"ce:UpdateCostAllocationTagsStatus",
+		},
+		OperationUpdate: {
+			"ce:ListCostAllocationTags",
+			"ce:UpdateCostAllocationTagsStatus",
+		},
+		OperationDelete: {
+			"ce:UpdateCostAllocationTagsStatus",
+		},
+	},
+	"aws_ce_cost_category": {
+		OperationCreate: {
+			"ce:CreateCostCategoryDefinition",
+			"ce:DescribeCostCategoryDefinition",
+		},
+		OperationUpdate: {
+			"ce:DescribeCostCategoryDefinition",
+			"ce:TagResource",
+			"ce:UntagResource",
+			"ce:UpdateCostCategoryDefinition",
+		},
+		OperationDelete: {
+			"ce:DeleteCostCategoryDefinition",
+		},
+	},
+	"aws_chatbot_custom_action": {
+		OperationCreate: {
+			"chatbot:CreateCustomAction",
+		},
+		OperationUpdate: {
+			"chatbot:UpdateCustomAction",
+		},
+		OperationDelete: {
+			"chatbot:DeleteCustomAction",
+		},
+	},
+	"aws_chime_voice_connector": {
+		OperationCreate: {
+			"chime:CreateVoiceConnector",
+			"chime:GetVoiceConnector",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnector",
+			"chime:TagResource",
+			"chime:UntagResource",
+			"chime:UpdateVoiceConnector",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnector",
+		},
+	},
+	"aws_chime_voice_connector_group": {
+		OperationCreate: {
+			"chime:CreateVoiceConnectorGroup",
+			"chime:GetVoiceConnectorGroup",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnectorGroup",
+			"chime:UpdateVoiceConnectorGroup",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnectorGroup",
+			"chime:GetVoiceConnectorGroup",
+			"chime:UpdateVoiceConnectorGroup",
+		},
+	},
+	"aws_chime_voice_connector_logging": {
+		OperationCreate: {
+			"chime:GetVoiceConnectorLoggingConfiguration",
+			"chime:PutVoiceConnectorLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnectorLoggingConfiguration",
+			"chime:PutVoiceConnectorLoggingConfiguration",
+		},
+		OperationDelete: {
+			"chime:PutVoiceConnectorLoggingConfiguration",
+		},
+	},
+	"aws_chime_voice_connector_origination": {
+		OperationCreate: {
+			"chime:GetVoiceConnectorOrigination",
+			"chime:PutVoiceConnectorOrigination",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnectorOrigination",
+			"chime:PutVoiceConnectorOrigination",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnectorOrigination",
+		},
+	},
+	"aws_chime_voice_connector_streaming": {
+		OperationCreate: {
+			"chime:GetVoiceConnectorStreamingConfiguration",
+			"chime:PutVoiceConnectorStreamingConfiguration",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnectorStreamingConfiguration",
+			"chime:PutVoiceConnectorStreamingConfiguration",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnectorStreamingConfiguration",
+		},
+	},
+	"aws_chime_voice_connector_termination": {
+		OperationCreate: {
+			"chime:GetVoiceConnectorTermination",
+			"chime:PutVoiceConnectorTermination",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceConnectorTermination",
+			"chime:PutVoiceConnectorTermination",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnectorTermination",
+		},
+	},
+	"aws_chime_voice_connector_termination_credentials": {
+		OperationCreate: {
+			"chime:ListVoiceConnectorTerminationCredentials",
+			"chime:PutVoiceConnectorTerminationCredentials",
+		},
+		OperationUpdate: {
+			"chime:ListVoiceConnectorTerminationCredentials",
+			"chime:PutVoiceConnectorTerminationCredentials",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceConnectorTerminationCredentials",
+		},
+	},
+	"aws_chimesdkmediapipelines_media_insights_pipeline_configuration": {
+		OperationCreate: {
+			"chime:CreateMediaInsightsPipelineConfiguration",
+			"chime:GetMediaInsightsPipelineConfiguration",
+		},
+		OperationUpdate: {
+			"chime:GetMediaInsightsPipelineConfiguration",
+			"chime:TagResource",
+			"chime:UntagResource",
+			"chime:UpdateMediaInsightsPipelineConfiguration",
+		},
+		OperationDelete: {
+			"chime:DeleteMediaInsightsPipelineConfiguration",
+		},
+	},
+	"aws_chimesdkvoice_global_settings": {
+		OperationCreate: {
+			"chime:GetGlobalSettings",
+			"chime:UpdateGlobalSettings",
+		},
+		OperationUpdate: {
+			"chime:GetGlobalSettings",
+			"chime:UpdateGlobalSettings",
+		},
+		OperationDelete: {
+			"chime:UpdateGlobalSettings",
+		},
+	},
+	"aws_chimesdkvoice_sip_media_application": {
+		OperationCreate: {
+			"chime:CreateSipMediaApplication",
+			"chime:GetSipMediaApplication",
+		},
+		OperationUpdate: {
+			"chime:GetSipMediaApplication",
+			"chime:TagResource",
+			"chime:UntagResource",
+			"chime:UpdateSipMediaApplication",
+		},
+		OperationDelete: {
+			"chime:DeleteSipMediaApplication",
+		},
+	},
+	"aws_chimesdkvoice_sip_rule": {
+		OperationCreate: {
+			"chime:CreateSipRule",
+			"chime:GetSipRule",
+		},
+		OperationUpdate: {
+			"chime:GetSipRule",
+			"chime:UpdateSipRule",
+		},
+		OperationDelete: {
+			"chime:DeleteSipRule",
+		},
+	},
+	"aws_chimesdkvoice_voice_profile_domain": {
+		OperationCreate: {
+			"chime:CreateVoiceProfileDomain",
+			"chime:GetVoiceProfileDomain",
+		},
+		OperationUpdate: {
+			"chime:GetVoiceProfileDomain",
+			"chime:TagResource",
+			"chime:UntagResource",
+			"chime:UpdateVoiceProfileDomain",
+		},
+		OperationDelete: {
+			"chime:DeleteVoiceProfileDomain",
+		},
+	},
+	"aws_cleanrooms_collaboration": {
+		OperationCreate: {
+			"cleanrooms:CreateCollaboration",
+			"cleanrooms:GetCollaboration",
+			"cleanrooms:ListMembers",
+		},
+		OperationUpdate: {
+			"cleanrooms:GetCollaboration",
+			"cleanrooms:ListMembers",
+			"cleanrooms:TagResource",
+			"cleanrooms:UntagResource",
+			"cleanrooms:UpdateCollaboration",
+		},
+		OperationDelete: {
+			"cleanrooms:DeleteCollaboration",
+		},
+	},
+	"aws_cleanrooms_configured_table": {
+		OperationCreate: {
+			"cleanrooms:CreateConfiguredTable",
+			"cleanrooms:GetConfiguredTable",
+		},
+		OperationUpdate: {
+			"cleanrooms:GetConfiguredTable",
+			"cleanrooms:TagResource",
+			"cleanrooms:UntagResource",
+			"cleanrooms:UpdateConfiguredTable",
+		},
+		OperationDelete: {
+			"cleanrooms:DeleteConfiguredTable",
+		},
+	},
+	"aws_cloud9_environment_ec2": {
+		OperationCreate: {
+			"cloud9:CreateEnvironmentEC2",
+			"cloud9:DescribeEnvironments",
+		},
+		OperationUpdate: {
+			"cloud9:DescribeEnvironments",
+			"cloud9:TagResource",
+			"cloud9:UntagResource",
+			"cloud9:UpdateEnvironment",
+		},
+		OperationDelete: {
+			"cloud9:DeleteEnvironment",
+			"cloud9:DescribeEnvironments",
+		},
+	},
+	"aws_cloud9_environment_membership": {
+		OperationCreate: {
+			"cloud9:CreateEnvironmentMembership",
+			"cloud9:DescribeEnvironmentMemberships",
+		},
+		OperationUpdate: {
+			"cloud9:DescribeEnvironmentMemberships",
+			"cloud9:UpdateEnvironmentMembership",
+		},
+		OperationDelete: {
+			"cloud9:DeleteEnvironmentMembership",
+		},
+	},
+	"aws_cloudcontrolapi_resource": {
+		OperationCreate: {
+			"cloudformation:CreateResource",
+			"cloudformation:GetResource",
+			"cloudformation:GetResourceRequestStatus",
+		},
+		OperationUpdate: {
+			"cloudformation:GetResource",
+			"cloudformation:GetResourceRequestStatus",
+			"cloudformation:UpdateResource",
+		},
+		OperationDelete: {
+			"cloudformation:DeleteResource",
+			"cloudformation:GetResourceRequestStatus",
+		},
+	},
+	"aws_cloudformation_stack": {
+		OperationCreate: {
+			"cloudformation:CreateStack",
+			"cloudformation:DescribeStackEvents",
+			"cloudformation:DescribeStacks",
+			"cloudformation:GetTemplate",
+		},
+		OperationUpdate: {
+			"cloudformation:DescribeStackEvents",
+			"cloudformation:DescribeStacks",
+			"cloudformation:GetTemplate",
+			"cloudformation:UpdateStack",
+		},
+		OperationDelete: {
+			"cloudformation:DeleteStack",
+			"cloudformation:DescribeStackEvents",
+			"cloudformation:DescribeStacks",
+		},
+	},
+	"aws_cloudformation_stack_set": {
+		OperationCreate: {
+			"cloudformation:CreateStackSet",
+			"cloudformation:DescribeStackSet",
+		},
+		OperationUpdate: {
+			"cloudformation:DescribeStackSet",
+			"cloudformation:DescribeStackSetOperation",
+			"cloudformation:ListStackSetOperationResults",
+			"cloudformation:UpdateStackSet",
+		},
+		OperationDelete: {
+			"cloudformation:DeleteStackSet",
+		},
+	},
+	"aws_cloudformation_stack_set_instance": {
+		OperationCreate: {
+			"cloudformation:CreateStackInstances",
+			"cloudformation:DescribeStackInstance",
+			"cloudformation:DescribeStackSetOperation",
+			"cloudformation:ListStackInstances",
+			"cloudformation:ListStackSetOperationResults",
+		},
+		OperationUpdate: {
+			"cloudformation:DescribeStackInstance",
+			"cloudformation:DescribeStackSetOperation",
+			"cloudformation:ListStackInstances",
+			"cloudformation:ListStackSetOperationResults",
+			"cloudformation:UpdateStackInstances",
+		},
+		OperationDelete: {
+			"cloudformation:DeleteStackInstances",
+			"cloudformation:DescribeStackSetOperation",
+			"cloudformation:ListStackSetOperationResults",
+		},
+	},
+	"aws_cloudformation_type": {
+		OperationCreate: {
+			"cloudformation:DescribeType",
+			"cloudformation:DescribeTypeRegistration",
+			"cloudformation:RegisterType",
+		},
+		OperationDelete: {
+			"cloudformation:DeregisterType",
+			"cloudformation:ListTypeVersions",
+		},
+	},
+	"aws_cloudfront_anycast_ip_list": {
+		OperationCreate: {
+			"cloudfront:CreateAnycastIpList",
+			"cloudfront:GetAnycastIpList",
+		},
+		OperationUpdate: {
+			"cloudfront:TagResource",
+			"cloudfront:UntagResource",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteAnycastIpList",
+			"cloudfront:GetAnycastIpList",
+		},
+	},
+	"aws_cloudfront_cache_policy": {
+		OperationCreate: {
+			"cloudfront:CreateCachePolicy",
+			"cloudfront:GetCachePolicy",
+		},
+		OperationUpdate: {
+			"cloudfront:GetCachePolicy",
+			"cloudfront:UpdateCachePolicy",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteCachePolicy",
+		},
+	},
+	"aws_cloudfront_continuous_deployment_policy": {
+		OperationCreate: {
+			"cloudfront:CreateContinuousDeploymentPolicy",
+		},
+		OperationUpdate: {
+			"cloudfront:UpdateContinuousDeploymentPolicy",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteContinuousDeploymentPolicy",
+			"cloudfront:GetContinuousDeploymentPolicy",
+		},
+	},
+	"aws_cloudfront_distribution": {
+		OperationCreate: {
+			"cloudfront:CreateDistributionWithTags",
+			"cloudfront:GetDistribution",
+		},
+		OperationUpdate: {
+			"cloudfront:GetDistribution",
+			"cloudfront:TagResource",
+			"cloudfront:UntagResource",
+			"cloudfront:UpdateDistribution",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteDistribution",
+			"cloudfront:GetContinuousDeploymentPolicy",
+			"cloudfront:GetDistribution",
+			"cloudfront:UpdateContinuousDeploymentPolicy",
+			"cloudfront:UpdateDistribution",
+		},
+	},
+	"aws_cloudfront_field_level_encryption_config": {
+		OperationCreate: {
+			"cloudfront:CreateFieldLevelEncryptionConfig",
+			"cloudfront:GetFieldLevelEncryptionConfig",
+		},
+		OperationUpdate: {
+			"cloudfront:GetFieldLevelEncryptionConfig",
+			"cloudfront:UpdateFieldLevelEncryptionConfig",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteFieldLevelEncryptionConfig",
+		},
+	},
+	"aws_cloudfront_field_level_encryption_profile": {
+		OperationCreate: {
+			"cloudfront:CreateFieldLevelEncryptionProfile",
+			"cloudfront:GetFieldLevelEncryptionProfile",
+		},
+		OperationUpdate: {
+			"cloudfront:GetFieldLevelEncryptionProfile",
+			"cloudfront:UpdateFieldLevelEncryptionProfile",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteFieldLevelEncryptionProfile",
+		},
+	},
+	"aws_cloudfront_function": {
+		OperationCreate: {
+			"cloudfront:CreateFunction",
+			"cloudfront:DescribeFunction",
+			"cloudfront:GetFunction",
+			"cloudfront:PublishFunction",
+		},
+		OperationUpdate: {
+			"cloudfront:DescribeFunction",
+			"cloudfront:GetFunction",
+			"cloudfront:PublishFunction",
+			"cloudfront:UpdateFunction",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteFunction",
+		},
+	},
+	"aws_cloudfront_key_group": {
+		OperationCreate: {
+			"cloudfront:CreateKeyGroup",
+			"cloudfront:GetKeyGroup",
+		},
+		OperationUpdate: {
+			"cloudfront:GetKeyGroup",
+			"cloudfront:UpdateKeyGroup",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteKeyGroup",
+		},
+	},
+	"aws_cloudfront_key_value_store": {
+		OperationCreate: {
+			"cloudfront:CreateKeyValueStore",
+			"cloudfront:DescribeKeyValueStore",
+		},
+		OperationUpdate: {
+			"cloudfront:UpdateKeyValueStore",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteKeyValueStore",
+		},
+	},
+	"aws_cloudfront_monitoring_subscription": {
+		OperationCreate: {
+			"cloudfront:CreateMonitoringSubscription",
+			"cloudfront:GetMonitoringSubscription",
+		},
+		OperationUpdate: {
+			"cloudfront:CreateMonitoringSubscription",
+			"cloudfront:GetMonitoringSubscription",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteMonitoringSubscription",
+		},
+	},
+	"aws_cloudfront_origin_access_control": {
+		OperationCreate: {
+			"cloudfront:CreateOriginAccessControl",
+			"cloudfront:GetOriginAccessControl",
+		},
+		OperationUpdate: {
+			"cloudfront:GetOriginAccessControl",
+			"cloudfront:UpdateOriginAccessControl",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteOriginAccessControl",
+		},
+	},
+	"aws_cloudfront_origin_access_identity": {
+		OperationCreate: {
+			"cloudfront:CreateCloudFrontOriginAccessIdentity",
+			"cloudfront:GetCloudFrontOriginAccessIdentity",
+		},
+		OperationUpdate: {
+			"cloudfront:GetCloudFrontOriginAccessIdentity",
+			"cloudfront:UpdateCloudFrontOriginAccessIdentity",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteCloudFrontOriginAccessIdentity",
+		},
+	},
+	"aws_cloudfront_origin_request_policy": {
+		OperationCreate: {
+			"cloudfront:CreateOriginRequestPolicy",
+			"cloudfront:GetOriginRequestPolicy",
+		},
+		OperationUpdate: {
+			"cloudfront:GetOriginRequestPolicy",
+			"cloudfront:UpdateOriginRequestPolicy",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteOriginRequestPolicy",
+		},
+	},
+	"aws_cloudfront_public_key": {
+		OperationCreate: {
+			"cloudfront:CreatePublicKey",
+			"cloudfront:GetPublicKey",
+		},
+		OperationUpdate: {
+			"cloudfront:GetPublicKey",
+			"cloudfront:UpdatePublicKey",
+		},
+		OperationDelete: {
+			"cloudfront:DeletePublicKey",
+		},
+	},
+	"aws_cloudfront_realtime_log_config": {
+		OperationCreate: {
+			"cloudfront:CreateRealtimeLogConfig",
+			"cloudfront:GetRealtimeLogConfig",
+		},
+		OperationUpdate: {
+			"cloudfront:GetRealtimeLogConfig",
+			"cloudfront:UpdateRealtimeLogConfig",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteRealtimeLogConfig",
+		},
+	},
+	"aws_cloudfront_response_headers_policy": {
+		OperationCreate: {
+			"cloudfront:CreateResponseHeadersPolicy",
+			"cloudfront:GetResponseHeadersPolicy",
+		},
+		OperationUpdate: {
+			"cloudfront:GetResponseHeadersPolicy",
+			"cloudfront:UpdateResponseHeadersPolicy",
+		},
+		OperationDelete: {
+			"cloudfront:DeleteResponseHeadersPolicy",
+		},
+	},
+	"aws_cloudfrontkeyvaluestore_key": {
+		OperationCreate: {
+			"cloudfront-keyvaluestore:DescribeKeyValueStore",
+			"cloudfront-keyvaluestore:PutKey",
+		},
+		OperationUpdate: {
+			"cloudfront-keyvaluestore:DescribeKeyValueStore",
+			"cloudfront-keyvaluestore:PutKey",
+		},
+		OperationDelete: {
+			"cloudfront-keyvaluestore:DeleteKey",
+			"cloudfront-keyvaluestore:DescribeKeyValueStore",
+		},
+	},
+	"aws_cloudhsm_v2_cluster": {
+		OperationCreate: {
+			"cloudhsm:CreateCluster",
+			"cloudhsm:DescribeClusters",
+		},
+		OperationUpdate: {
+			"cloudhsm:DescribeClusters",
+			"cloudhsm:TagResource",
+			"cloudhsm:UntagResource",
+		},
+		OperationDelete: {
+			"cloudhsm:DeleteCluster",
+			"cloudhsm:DescribeClusters",
+		},
+	},
+	"aws_cloudhsm_v2_hsm": {
+		OperationCreate: {
+			"cloudhsm:CreateHsm",
+			"cloudhsm:DescribeClusters",
+		},
+		OperationDelete: {
+			"cloudhsm:DeleteHsm",
+			"cloudhsm:DescribeClusters",
+		},
+	},
+	"aws_cloudsearch_domain": {
+		OperationCreate: {
+			"cloudsearch:CreateDomain",
+			"cloudsearch:DefineIndexField",
+			"cloudsearch:DescribeAvailabilityOptions",
+			"cloudsearch:DescribeDomainEndpointOptions",
+			"cloudsearch:DescribeDomains",
+			"cloudsearch:DescribeIndexFields",
+			"cloudsearch:DescribeScalingParameters",
+			"cloudsearch:IndexDocuments",
+			"cloudsearch:UpdateAvailabilityOptions",
+			"cloudsearch:UpdateDomainEndpointOptions",
+			"cloudsearch:UpdateScalingParameters",
+		},
+		OperationUpdate: {
+			"cloudsearch:DefineIndexField",
+			"cloudsearch:DeleteIndexField",
+			"cloudsearch:DescribeAvailabilityOptions",
+			"cloudsearch:DescribeDomainEndpointOptions",
+			"cloudsearch:DescribeDomains",
+			"cloudsearch:DescribeIndexFields",
+			"cloudsearch:DescribeScalingParameters",
+			"cloudsearch:IndexDocuments",
+			"cloudsearch:UpdateAvailabilityOptions",
+			"cloudsearch:UpdateDomainEndpointOptions",
+			"cloudsearch:UpdateScalingParameters",
+		},
+		OperationDelete: {
+			"cloudsearch:DeleteDomain",
+			"cloudsearch:DescribeDomains",
+		},
+	},
+	"aws_cloudsearch_domain_service_access_policy": {
+		OperationCreate: {
+			"cloudsearch:DescribeServiceAccessPolicies",
+			"cloudsearch:UpdateServiceAccessPolicies",
+		},
+		OperationUpdate: {
+			"cloudsearch:DescribeServiceAccessPolicies",
+			"cloudsearch:UpdateServiceAccessPolicies",
+		},
+		OperationDelete: {
+			"cloudsearch:DescribeServiceAccessPolicies",
+			"cloudsearch:UpdateServiceAccessPolicies",
+		},
+	},
+	"aws_cloudtrail": {
+		OperationCreate: {
+			"cloudtrail:CreateTrail",
+			"cloudtrail:DescribeTrails",
+			"cloudtrail:GetEventSelectors",
+			"cloudtrail:GetInsightSelectors",
+			"cloudtrail:GetTrailStatus",
+			"cloudtrail:PutEventSelectors",
+			"cloudtrail:PutInsightSelectors",
+			"cloudtrail:StartLogging",
+			"cloudtrail:StopLogging",
+		},
+		OperationUpdate: {
+			"cloudtrail:AddTags",
+			"cloudtrail:DescribeTrails",
+			"cloudtrail:GetEventSelectors",
+			"cloudtrail:GetInsightSelectors",
+			"cloudtrail:GetTrailStatus",
+			"cloudtrail:PutEventSelectors",
+			"cloudtrail:PutInsightSelectors",
+			"cloudtrail:RemoveTags",
+			"cloudtrail:StartLogging",
+			"cloudtrail:StopLogging",
+			"cloudtrail:UpdateTrail",
+		},
+		OperationDelete: {
+			"cloudtrail:DeleteTrail",
+		},
+	},
+	"aws_cloudtrail_event_data_store": {
+		OperationCreate: {
+			"cloudtrail:CreateEventDataStore",
+			"cloudtrail:GetEventDataStore",
+		},
+		OperationUpdate: {
+			"cloudtrail:AddTags",
+			"cloudtrail:GetEventDataStore",
+			"cloudtrail:RemoveTags",
+			"cloudtrail:UpdateEventDataStore",
+		},
+		OperationDelete: {
+			"cloudtrail:DeleteEventDataStore",
+			"cloudtrail:GetEventDataStore",
+		},
+	},
+	"aws_cloudwatch_composite_alarm": {
+		OperationCreate: {
+			"cloudwatch:DescribeAlarms",
+			"cloudwatch:PutCompositeAlarm",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationUpdate: {
+			"cloudwatch:DescribeAlarms",
+			"cloudwatch:PutCompositeAlarm",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationDelete: {
+			"cloudwatch:DeleteAlarms",
+		},
+	},
+	"aws_cloudwatch_contributor_insight_rule": {
+		OperationCreate: {
+			"cloudwatch:DescribeInsightRules",
+			"cloudwatch:DisableInsightRules",
+			"cloudwatch:EnableInsightRules",
+			"cloudwatch:ListManagedInsightRules",
+			"cloudwatch:PutInsightRule",
+			"cloudwatch:PutManagedInsightRules",
+		},
+		OperationUpdate: {
+			"cloudwatch:DescribeInsightRules",
+			"cloudwatch:DisableInsightRules",
+			"cloudwatch:EnableInsightRules",
+			"cloudwatch:PutInsightRule",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationDelete: {
+			"cloudwatch:DeleteInsightRules",
+		},
+	},
+	"aws_cloudwatch_dashboard": {
+		OperationCreate: {
+			"cloudwatch:GetDashboard",
+			"cloudwatch:PutDashboard",
+		},
+		OperationUpdate: {
+			"cloudwatch:GetDashboard",
+			"cloudwatch:PutDashboard",
+		},
+		OperationDelete: {
+			"cloudwatch:DeleteDashboards",
+		},
+	},
+	"aws_cloudwatch_event_api_destination": {
+		OperationCreate: {
+			"events:CreateApiDestination",
+			"events:DescribeApiDestination",
+		},
+		OperationUpdate: {
+			"events:DescribeApiDestination",
+			"events:UpdateApiDestination",
+		},
+		OperationDelete: {
+			"events:DeleteApiDestination",
+		},
+	},
+	"aws_cloudwatch_event_archive": {
+		OperationCreate: {
+			"events:CreateArchive",
+			"events:DescribeArchive",
+		},
+		OperationUpdate: {
+			"events:DescribeArchive",
+			"events:UpdateArchive",
+		},
+		OperationDelete: {
+			"events:DeleteArchive",
+		},
+	},
+	"aws_cloudwatch_event_bus": {
+		OperationCreate: {
+			"events:CreateEventBus",
+			"events:DescribeEventBus",
+			"events:TagResource",
+			"events:UntagResource",
+		},
+		OperationUpdate: {
+			"events:DescribeEventBus",
+			"events:TagResource",
+			"events:UntagResource",
+		},
+		OperationDelete: {
+			"events:DeleteEventBus",
+		},
+	},
+	"aws_cloudwatch_event_bus_policy": {
+		OperationCreate: {
+			"events:DescribeEventBus",
+			"events:PutPermission",
+		},
+		OperationUpdate: {
+			"events:DescribeEventBus",
+			"events:PutPermission",
+		},
+		OperationDelete: {
+			"events:RemovePermission",
+		},
+	},
+	"aws_cloudwatch_event_connection": {
+		OperationCreate: {
+			"events:CreateConnection",
+			"events:DescribeConnection",
+		},
+		OperationUpdate: {
+			"events:DescribeConnection",
+			"events:UpdateConnection",
+		},
+		OperationDelete: {
+			"events:DeleteConnection",
+			"events:DescribeConnection",
+		},
+	},
+	"aws_cloudwatch_event_endpoint": {
+		OperationCreate: {
+			"events:CreateEndpoint",
+			"events:DescribeEndpoint",
+		},
+		OperationUpdate: {
+			"events:DescribeEndpoint",
+			"events:UpdateEndpoint",
+		},
+		OperationDelete: {
+			"events:DeleteEndpoint",
+			"events:DescribeEndpoint",
+		},
+	},
+	"aws_cloudwatch_event_permission": {
+		OperationCreate: {
+			"events:DescribeEventBus",
+			"events:PutPermission",
+		},
+		OperationUpdate: {
+			"events:DescribeEventBus",
+			"events:PutPermission",
+		},
+		OperationDelete: {
+			"events:RemovePermission",
+		},
+	},
+	"aws_cloudwatch_event_rule": {
+		OperationCreate: {
+			"events:DescribeRule",
+			"events:PutRule",
+			"events:TagResource",
+			"events:UntagResource",
+		},
+		OperationUpdate: {
+			"events:DescribeRule",
+			"events:PutRule",
+			"events:TagResource",
+			"events:UntagResource",
+		},
+		OperationDelete: {
+			"events:DeleteRule",
+		},
+	},
+	"aws_cloudwatch_event_target": {
+		OperationCreate: {
+			"events:ListTargetsByRule",
+			"events:PutTargets",
+		},
+		OperationUpdate: {
+			"events:ListTargetsByRule",
+			"events:PutTargets",
+		},
+		OperationDelete: {
+			"events:RemoveTargets",
+		},
+	},
+	"aws_cloudwatch_log_data_protection_policy": {
+		OperationCreate: {
+			"logs:GetDataProtectionPolicy",
+			"logs:PutDataProtectionPolicy",
+		},
+		OperationUpdate: {
+			"logs:GetDataProtectionPolicy",
+			"logs:PutDataProtectionPolicy",
+		},
+		OperationDelete: {
+			"logs:DeleteDataProtectionPolicy",
+		},
+	},
+	"aws_cloudwatch_log_destination": {
+		OperationCreate: {
+			"logs:DescribeDestinations",
+			"logs:PutDestination",
+			"logs:TagResource",
+			"logs:UntagResource",
+		},
+		OperationUpdate: {
+			"logs:DescribeDestinations",
+			"logs:PutDestination",
+			"logs:TagResource",
+			"logs:UntagResource",
+		},
+		OperationDelete: {
+			"logs:DeleteDestination",
+		},
+	},
+	"aws_cloudwatch_log_destination_policy": {
+		OperationCreate: {
+			"logs:DescribeDestinations",
+			"logs:PutDestinationPolicy",
+		},
+		OperationUpdate: {
+			"logs:DescribeDestinations",
+			"logs:PutDestinationPolicy",
+		},
+	},
+	"aws_cloudwatch_log_group": {
+		OperationCreate: {
+			"logs:CreateLogGroup",
+			"logs:DescribeLogGroups",
+			"logs:ListTagsLogGroup",
+			"logs:PutRetentionPolicy",
+		},
+		OperationUpdate: {
+			"logs:AssociateKmsKey",
+			"logs:DeleteRetentionPolicy",
+			"logs:DescribeLogGroups",
+			"logs:DisassociateKmsKey",
+			"logs:ListTagsLogGroup",
+			"logs:PutRetentionPolicy",
+			"logs:TagLogGroup",
+			"logs:TagResource",
+			"logs:UntagLogGroup",
+			"logs:UntagResource",
+		},
+		OperationDelete: {
+			"logs:DeleteLogGroup",
+		},
+	},
+	"aws_cloudwatch_log_metric_filter": {
+		OperationCreate: {
+			"logs:DescribeMetricFilters",
+			"logs:PutMetricFilter",
+		},
+		OperationUpdate: {
+			"logs:DescribeMetricFilters",
+			"logs:PutMetricFilter",
+		},
+		OperationDelete: {
+			"logs:DeleteMetricFilter",
+		},
+	},
+	"aws_cloudwatch_log_resource_policy": {
+		OperationCreate: {
+			"logs:DescribeResourcePolicies",
+			"logs:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"logs:DescribeResourcePolicies",
+			"logs:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"logs:DeleteResourcePolicy",
+		},
+	},
+	"aws_cloudwatch_log_stream": {
+		OperationCreate: {
+			"logs:CreateLogStream",
+			"logs:DescribeLogStreams",
+		},
+		OperationDelete: {
+			"logs:DeleteLogStream",
+		},
+	},
+	"aws_cloudwatch_log_subscription_filter": {
+		OperationCreate: {
+			"logs:PutSubscriptionFilter",
+		},
+		OperationUpdate: {
+			"logs:PutSubscriptionFilter",
+		},
+		OperationDelete: {
+			"logs:DeleteSubscriptionFilter",
+		},
+	},
+	"aws_cloudwatch_metric_alarm": {
+		OperationCreate: {
+			"cloudwatch:DescribeAlarms",
+			"cloudwatch:PutMetricAlarm",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationUpdate: {
+			"cloudwatch:DescribeAlarms",
+			"cloudwatch:PutMetricAlarm",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationDelete: {
+			"cloudwatch:DeleteAlarms",
+		},
+	},
+	"aws_cloudwatch_metric_stream": {
+		OperationCreate: {
+			"cloudwatch:GetMetricStream",
+			"cloudwatch:PutMetricStream",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationUpdate: {
+			"cloudwatch:GetMetricStream",
+			"cloudwatch:PutMetricStream",
+			"cloudwatch:TagResource",
+			"cloudwatch:UntagResource",
+		},
+		OperationDelete: {
+			"cloudwatch:DeleteMetricStream",
+			"cloudwatch:GetMetricStream",
+		},
+	},
+	"aws_cloudwatch_query_definition": {
+		OperationCreate: {
+			"logs:DescribeQueryDefinitions",
+			"logs:PutQueryDefinition",
+		},
+		OperationUpdate: {
+			"logs:DescribeQueryDefinitions",
+			"logs:PutQueryDefinition",
+		},
+		OperationDelete: {
+			"logs:DeleteQueryDefinition",
+		},
+	},
+	"aws_codeartifact_domain": {
+		OperationCreate: {
+			"codeartifact:CreateDomain",
+			"codeartifact:DescribeDomain",
+		},
+		OperationUpdate: {
+			"codeartifact:DescribeDomain",
+			"codeartifact:TagResource",
+			"codeartifact:UntagResource",
+		},
+		OperationDelete: {
+			"codeartifact:DeleteDomain",
+		},
+	},
+	"aws_codeartifact_domain_permissions_policy": {
+		OperationCreate: {
+			"codeartifact:GetDomainPermissionsPolicy",
+			"codeartifact:PutDomainPermissionsPolicy",
+		},
+		OperationUpdate: {
+			"codeartifact:GetDomainPermissionsPolicy",
+			"codeartifact:PutDomainPermissionsPolicy",
+		},
+		OperationDelete: {
+			"codeartifact:DeleteDomainPermissionsPolicy",
+		},
+	},
+	"aws_codeartifact_repository": {
+		OperationCreate: {
+			"codeartifact:AssociateExternalConnection",
+			"codeartifact:CreateRepository",
+			"codeartifact:DescribeRepository",
+		},
+		OperationUpdate: {
+			"codeartifact:AssociateExternalConnection",
+			"codeartifact:DescribeRepository",
+			"codeartifact:DisassociateExternalConnection",
+			"codeartifact:TagResource",
+			"codeartifact:UntagResource",
+			"codeartifact:UpdateRepository",
+		},
+		OperationDelete: {
+			"codeartifact:DeleteRepository",
+		},
+	},
+	"aws_codeartifact_repository_permissions_policy": {
+		OperationCreate: {
+			"codeartifact:GetRepositoryPermissionsPolicy",
+			"codeartifact:PutRepositoryPermissionsPolicy",
+		},
+		OperationUpdate: {
+			"codeartifact:GetRepositoryPermissionsPolicy",
+			"codeartifact:PutRepositoryPermissionsPolicy",
+		},
+		OperationDelete: {
+			"codeartifact:DeleteRepositoryPermissionsPolicy",
+		},
+	},
+	"aws_codebuild_fleet": {
+		OperationCreate: {
+			"codebuild:BatchGetFleets",
+			"codebuild:CreateFleet",
+		},
+		OperationUpdate: {
+			"codebuild:BatchGetFleets",
+			"codebuild:UpdateFleet",
+		},
+		OperationDelete: {
+			"codebuild:BatchGetFleets",
+			"codebuild:DeleteFleet",
+		},
+	},
+	"aws_codebuild_project": {
+		OperationCreate: {
+			"codebuild:BatchGetProjects",
+			"codebuild:CreateProject",
+			"codebuild:UpdateProjectVisibility",
+		},
+		OperationUpdate: {
+			"codebuild:BatchGetProjects",
+			"codebuild:UpdateProject",
+			"codebuild:UpdateProjectVisibility",
+		},
+		OperationDelete: {
+			"codebuild:DeleteProject",
+		},
+	},
+	"aws_codebuild_report_group": {
+		OperationCreate: {
+			"codebuild:BatchGetReportGroups",
+			"codebuild:CreateReportGroup",
+		},
+		OperationUpdate: {
+			"codebuild:BatchGetReportGroups",
+			"codebuild:UpdateReportGroup",
+		},
+		OperationDelete: {
+			"codebuild:BatchGetReportGroups",
+			"codebuild:DeleteReportGroup",
+		},
+	},
+	"aws_codebuild_resource_policy": {
+		OperationCreate: {
+			"codebuild:GetResourcePolicy",
+			"codebuild:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"codebuild:GetResourcePolicy",
+			"codebuild:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"codebuild:DeleteResourcePolicy",
+		},
+	},
+	"aws_codebuild_source_credential": {
+		OperationCreate: {
+			"codebuild:ImportSourceCredentials",
+			"codebuild:ListSourceCredentials",
+		},
+		OperationDelete: {
+			"codebuild:DeleteSourceCredentials",
+		},
+	},
+	"aws_codebuild_webhook": {
+		OperationCreate: {
+			"codebuild:BatchGetProjects",
+			"codebuild:CreateWebhook",
+		},
+		OperationUpdate: {
+			"codebuild:BatchGetProjects",
+			"codebuild:UpdateWebhook",
+		},
+		OperationDelete: {
+			"codebuild:DeleteWebhook",
+		},
+	},
+	"aws_codecatalyst_dev_environment": {
+		OperationCreate: {
+			"codecatalyst:CreateDevEnvironment",
+			"codecatalyst:GetDevEnvironment",
+		},
+		OperationUpdate: {
+			"codecatalyst:GetDevEnvironment",
+			"codecatalyst:UpdateDevEnvironment",
+		},
+		OperationDelete: {
+			"codecatalyst:DeleteDevEnvironment",
+		},
+	},
+	"aws_codecatalyst_project": {
+		OperationCreate: {
+			"codecatalyst:CreateProject",
+			"codecatalyst:GetProject",
+		},
+		OperationUpdate: {
+			"codecatalyst:GetDevEnvironment",
+			"codecatalyst:UpdateProject",
+		},
+		OperationDelete: {
+			"codecatalyst:DeleteProject",
+		},
+	},
+	"aws_codecatalyst_source_repository": {
+		OperationCreate: {
+			"codecatalyst:CreateSourceRepository",
+			"codecatalyst:GetSourceRepository",
+		},
+		OperationUpdate: {
+			"codecatalyst:CreateSourceRepository",
+			"codecatalyst:GetSourceRepository",
+		},
+		OperationDelete: {
+			"codecatalyst:DeleteSourceRepository",
+		},
+	},
+	"aws_codecommit_approval_rule_template": {
+		OperationCreate: {
+			"codecommit:CreateApprovalRuleTemplate",
+			"codecommit:GetApprovalRuleTemplate",
+		},
+		OperationUpdate: {
+			"codecommit:GetApprovalRuleTemplate",
+			"codecommit:UpdateApprovalRuleTemplateContent",
+			"codecommit:UpdateApprovalRuleTemplateDescription",
+			"codecommit:UpdateApprovalRuleTemplateName",
+		},
+		OperationDelete: {
+			"codecommit:DeleteApprovalRuleTemplate",
+		},
+	},
+	"aws_codecommit_approval_rule_template_association": {
+		OperationCreate: {
+			"codecommit:AssociateApprovalRuleTemplateWithRepository",
+			"codecommit:ListRepositoriesForApprovalRuleTemplate",
+		},
+		OperationDelete: {
+			"codecommit:DisassociateApprovalRuleTemplateFromRepository",
+		},
+	},
+	"aws_codecommit_repository": {
+		OperationCreate: {
+			"codecommit:CreateRepository",
+			"codecommit:GetRepository",
+			"codecommit:ListBranches",
+			"codecommit:UpdateDefaultBranch",
+		},
+		OperationUpdate: {
+			"codecommit:GetRepository",
+			"codecommit:ListBranches",
+			"codecommit:TagResource",
+			"codecommit:UntagResource",
+			"codecommit:UpdateDefaultBranch",
+			"codecommit:UpdateRepositoryDescription",
+			"codecommit:UpdateRepositoryEncryptionKey",
+			"codecommit:UpdateRepositoryName",
+		},
+		OperationDelete: {
+			"codecommit:DeleteRepository",
+		},
+	},
+	"aws_codecommit_trigger": {
+		OperationCreate: {
+			"codecommit:GetRepositoryTriggers",
+			"codecommit:PutRepositoryTriggers",
+		},
+		OperationDelete: {
+			"codecommit:PutRepositoryTriggers",
+		},
+	},
+	"aws_codedeploy_app": {
+		OperationCreate: {
+			"codedeploy:CreateApplication",
+			"codedeploy:GetApplication",
+		},
+		OperationUpdate: {
+			"codedeploy:GetApplication",
+			"codedeploy:TagResource",
+			"codedeploy:UntagResource",
+			"codedeploy:UpdateApplication",
+		},
+		OperationDelete: {
+			"codedeploy:DeleteApplication",
+		},
+	},
+	"aws_codedeploy_deployment_config": {
+		OperationCreate: {
+			"codedeploy:CreateDeploymentConfig",
+			"codedeploy:GetDeploymentConfig",
+		},
+		OperationDelete: {
+			"codedeploy:DeleteDeploymentConfig",
+		},
+	},
+	"aws_codedeploy_deployment_group": {
+		OperationCreate: {
+			"codedeploy:CreateDeploymentGroup",
+			"codedeploy:GetDeploymentGroup",
+		},
+		OperationUpdate: {
+			"codedeploy:GetDeploymentGroup",
+			"codedeploy:TagResource",
+			"codedeploy:UntagResource",
+			"codedeploy:UpdateDeploymentGroup",
+		},
+		OperationDelete: {
+			"codedeploy:DeleteDeploymentGroup",
+		},
+	},
+	"aws_codeguruprofiler_profiling_group": {
+		OperationCreate: {
+			"codeguru-profiler:CreateProfilingGroup",
+		},
+		OperationUpdate: {
+			"codeguru-profiler:TagResource",
+			"codeguru-profiler:UntagResource",
+			"codeguru-profiler:UpdateProfilingGroup",
+		},
+		OperationDelete: {
+			"codeguru-profiler:DeleteProfilingGroup",
+		},
+	},
+	"aws_codegurureviewer_repository_association": {
+		OperationCreate: {
+			"codeguru-reviewer:AssociateRepository",
+			"codeguru-reviewer:DescribeRepositoryAssociation",
+		},
+		OperationUpdate: {
+			"codeguru-reviewer:DescribeRepositoryAssociation",
+			"codeguru-reviewer:TagResource",
+			"codeguru-reviewer:UntagResource",
+		},
+		OperationDelete: {
+			"codeguru-reviewer:DescribeRepositoryAssociation",
+			"codeguru-reviewer:DisassociateRepository",
+		},
+	},
+	"aws_codepipeline": {
+		OperationCreate: {
+			"codepipeline:CreatePipeline",
+			"codepipeline:GetPipeline",
+		},
+		OperationUpdate: {
+			"codepipeline:GetPipeline",
+			"codepipeline:TagResource",
+			"codepipeline:UntagResource",
+			"codepipeline:UpdatePipeline",
+		},
+		OperationDelete: {
+			"codepipeline:DeletePipeline",
+		},
+	},
+	"aws_codepipeline_custom_action_type": {
+		OperationCreate: {
+			"codepipeline:CreateCustomActionType",
+			"codepipeline:ListActionTypes",
+		},
+		OperationUpdate: {
+			"codepipeline:ListActionTypes",
+			"codepipeline:TagResource",
+			"codepipeline:UntagResource",
+		},
+		OperationDelete: {
+			"codepipeline:DeleteCustomActionType",
+		},
+	},
+	"aws_codepipeline_webhook": {
+		OperationCreate: {
+			"codepipeline:ListWebhooks",
+			"codepipeline:PutWebhook",
+		},
+		OperationUpdate: {
+			"codepipeline:ListWebhooks",
+			"codepipeline:PutWebhook",
+			"codepipeline:TagResource",
+			"codepipeline:UntagResource",
+		},
+		OperationDelete: {
+			"codepipeline:DeleteWebhook",
+		},
+	},
+	"aws_codestarconnections_connection": {
+		OperationCreate: {
+			"codestar-connections:CreateConnection",
+			"codestar-connections:GetConnection",
+		},
+		OperationUpdate: {
+			"codestar-connections:GetConnection",
+			"codestar-connections:TagResource",
+			"codestar-connections:UntagResource",
+		},
+		OperationDelete: {
+			"codestar-connections:DeleteConnection",
+		},
+	},
+	"aws_codestarconnections_host": {
+		OperationCreate: {
+			"codestar-connections:CreateHost",
+			"codestar-connections:GetHost",
+		},
+		OperationUpdate: {
+			"codestar-connections:GetHost",
+			"codestar-connections:UpdateHost",
+		},
+		OperationDelete: {
+			"codestar-connections:DeleteHost",
+		},
+	},
+	"aws_codestarconnections_repository_link": {
+		OperationCreate: {
+			"codestar-connections:CreateRepositoryLink",
+			"codestar-connections:GetRepositoryLink",
+		},
+		OperationUpdate: {
+			"codestar-connections:GetRepositoryLink",
+			"codestar-connections:TagResource",
+			"codestar-connections:UntagResource",
+			"codestar-connections:UpdateRepositoryLink",
+		},
+		OperationDelete: {
+			"codestar-connections:DeleteRepositoryLink",
+		},
+	},
+	"aws_codestarconnections_sync_configuration": {
+		OperationCreate: {
+			"codestar-connections:CreateSyncConfiguration",
+			"codestar-connections:GetSyncConfiguration",
+		},
+		OperationUpdate: {
+			"codestar-connections:GetSyncConfiguration",
+			"codestar-connections:UpdateSyncConfiguration",
+		},
+		OperationDelete: {
+			"codestar-connections:DeleteSyncConfiguration",
+		},
+	},
+	"aws_codestarnotifications_notification_rule": {
+		OperationCreate: {
+			"codestar-notifications:CreateNotificationRule",
+			"codestar-notifications:DescribeNotificationRule",
+		},
+		OperationUpdate: {
+			"codestar-notifications:DeleteTarget",
+			"codestar-notifications:DescribeNotificationRule",
+			"codestar-notifications:TagResource",
+			"codestar-notifications:UntagResource",
+			"codestar-notifications:UpdateNotificationRule",
+		},
+		OperationDelete: {
+			"codestar-notifications:DeleteNotificationRule",
+			"codestar-notifications:DeleteTarget",
+		},
+	},
+	"aws_cognito_identity_pool": {
+		OperationCreate: {
+			"cognito-identity:CreateIdentityPool",
+			"cognito-identity:DescribeIdentityPool",
+		},
+		OperationUpdate: {
+			"cognito-identity:DescribeIdentityPool",
+			"cognito-identity:TagResource",
+			"cognito-identity:UntagResource",
+			"cognito-identity:UpdateIdentityPool",
+		},
+		OperationDelete: {
+			"cognito-identity:DeleteIdentityPool",
+		},
+	},
+	"aws_cognito_identity_pool_provider_principal_tag": {
+		OperationCreate: {
+			"cognito-identity:GetPrincipalTagAttributeMap",
+			"cognito-identity:SetPrincipalTagAttributeMap",
+		},
+		OperationUpdate: {
+			"cognito-identity:GetPrincipalTagAttributeMap",
+			"cognito-identity:SetPrincipalTagAttributeMap",
+		},
+		OperationDelete: {
+			"cognito-identity:SetPrincipalTagAttributeMap",
+		},
+	},
+	"aws_cognito_identity_pool_roles_attachment": {
+		OperationCreate: {
+			"cognito-identity:GetIdentityPoolRoles",
+			"cognito-identity:SetIdentityPoolRoles",
+		},
+		OperationUpdate: {
+			"cognito-identity:GetIdentityPoolRoles",
+			"cognito-identity:SetIdentityPoolRoles",
+		},
+		OperationDelete: {
+			"cognito-identity:SetIdentityPoolRoles",
+		},
+	},
+	"aws_cognito_identity_provider": {
+		OperationCreate: {
+			"cognito-idp:CreateIdentityProvider",
+			"cognito-idp:DescribeIdentityProvider",
+		},
+		OperationUpdate: {
+			"cognito-idp:DescribeIdentityProvider",
+			"cognito-idp:UpdateIdentityProvider",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteIdentityProvider",
+		},
+	},
+	"aws_cognito_managed_user_pool_client": {
+		OperationCreate: {
+			"cognito-idp:DescribeUserPoolClient",
+			"cognito-idp:ListUserPoolClients",
+			"cognito-idp:UpdateUserPoolClient",
+		},
+		OperationUpdate: {
+			"cognito-idp:UpdateUserPoolClient",
+		},
+	},
+	"aws_cognito_resource_server": {
+		OperationCreate: {
+			"cognito-idp:CreateResourceServer",
+			"cognito-idp:DescribeResourceServer",
+		},
+		OperationUpdate: {
+			"cognito-idp:DescribeResourceServer",
+			"cognito-idp:UpdateResourceServer",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteResourceServer",
+		},
+	},
+	"aws_cognito_risk_configuration": {
+		OperationCreate: {
+			"cognito-idp:DescribeRiskConfiguration",
+			"cognito-idp:SetRiskConfiguration",
+		},
+		OperationUpdate: {
+			"cognito-idp:DescribeRiskConfiguration",
+			"cognito-idp:SetRiskConfiguration",
+		},
+		OperationDelete: {
+			"cognito-idp:SetRiskConfiguration",
+		},
+	},
+	"aws_cognito_user": {
+		OperationCreate: {
+			"cognito-idp:AdminCreateUser",
+			"cognito-idp:AdminDisableUser",
+			"cognito-idp:AdminGetUser",
+			"cognito-idp:AdminSetUserPassword",
+		},
+		OperationUpdate: {
+			"cognito-idp:AdminDeleteUserAttributes",
+			"cognito-idp:AdminDisableUser",
+			"cognito-idp:AdminEnableUser",
+			"cognito-idp:AdminGetUser",
+			"cognito-idp:AdminSetUserPassword",
+			"cognito-idp:AdminUpdateUserAttributes",
+		},
+		OperationDelete: {
+			"cognito-idp:AdminDeleteUser",
+		},
+	},
+	"aws_cognito_user_group": {
+		OperationCreate: {
+			"cognito-idp:CreateGroup",
+			"cognito-idp:GetGroup",
+		},
+		OperationUpdate: {
+			"cognito-idp:GetGroup",
+			"cognito-idp:UpdateGroup",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteGroup",
+		},
+	},
+	"aws_cognito_user_in_group": {
+		OperationCreate: {
+			"cognito-idp:AdminAddUserToGroup",
+			"cognito-idp:AdminListGroupsForUser",
+		},
+		OperationDelete: {
+			"cognito-idp:AdminRemoveUserFromGroup",
+		},
+	},
+	"aws_cognito_user_pool": {
+		OperationCreate: {
+			"cognito-idp:CreateUserPool",
+			"cognito-idp:DescribeUserPool",
+			"cognito-idp:GetUserPoolMfaConfig",
+			"cognito-idp:SetUserPoolMfaConfig",
+		},
+		OperationUpdate: {
+			"cognito-idp:AddCustomAttributes",
+			"cognito-idp:DescribeUserPool",
+			"cognito-idp:GetUserPoolMfaConfig",
+			"cognito-idp:SetUserPoolMfaConfig",
+			"cognito-idp:TagResource",
+			"cognito-idp:UntagResource",
+			"cognito-idp:UpdateUserPool",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteUserPool",
+		},
+	},
+	"aws_cognito_user_pool_client": {
+		OperationCreate: {
+			"cognito-idp:CreateUserPoolClient",
+		},
+		OperationUpdate: {
+			"cognito-idp:UpdateUserPoolClient",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteUserPoolClient",
+		},
+	},
+	"aws_cognito_user_pool_domain": {
+		OperationCreate: {
+			"cognito-idp:CreateUserPoolDomain",
+			"cognito-idp:DescribeUserPoolDomain",
+		},
+		OperationUpdate: {
+			"cognito-idp:DescribeUserPoolDomain",
+			"cognito-idp:UpdateUserPoolDomain",
+		},
+		OperationDelete: {
+			"cognito-idp:DeleteUserPoolDomain",
+			"cognito-idp:DescribeUserPoolDomain",
+		},
+	},
+	"aws_cognito_user_pool_ui_customization": {
+		OperationCreate: {
+			"cognito-idp:GetUICustomization",
+			"cognito-idp:SetUICustomization",
+		},
+		OperationUpdate: {
+			"cognito-idp:GetUICustomization",
+			"cognito-idp:SetUICustomization",
+		},
+		OperationDelete: {
+			"cognito-idp:SetUICustomization",
+		},
+	},
+	"aws_comprehend_document_classifier": {
+		OperationCreate: {
+			"comprehend:CreateDocumentClassifier",
+			"comprehend:DescribeDocumentClassifier",
+		},
+		OperationUpdate: {
+			"comprehend:CreateDocumentClassifier",
+			"comprehend:DescribeDocumentClassifier",
+			"comprehend:TagResource",
+			"comprehend:UntagResource",
+		},
+		OperationDelete: {
+			"comprehend:DeleteDocumentClassifier",
+			"comprehend:DescribeDocumentClassifier",
+			"comprehend:ListDocumentClassifiers",
+			"comprehend:StopTrainingDocumentClassifier",
+		},
+	},
+	"aws_comprehend_entity_recognizer": {
+		OperationCreate: {
+			"comprehend:CreateEntityRecognizer",
+			"comprehend:DescribeEntityRecognizer",
+		},
+		OperationUpdate: {
+			"comprehend:CreateEntityRecognizer",
+			"comprehend:DescribeEntityRecognizer",
+			"comprehend:TagResource",
+			"comprehend:UntagResource",
+		},
+		OperationDelete: {
+			"comprehend:DeleteEntityRecognizer",
+			"comprehend:DescribeEntityRecognizer",
+			"comprehend:ListEntityRecognizers",
+			"comprehend:StopTrainingEntityRecognizer",
+		},
+	},
+	"aws_config_aggregate_authorization": {
+		OperationCreate: {
+			"config:DescribeAggregationAuthorizations",
+			"config:PutAggregationAuthorization",
+		},
+		OperationUpdate: {
+			"config:DescribeAggregationAuthorizations",
+			"config:TagResource",
+			"config:UntagResource",
+		},
+		OperationDelete: {
+			"config:DeleteAggregationAuthorization",
+		},
+	},
+	"aws_config_config_rule": {
+		OperationCreate: {
+			"config:DescribeConfigRules",
+			"config:PutConfigRule",
+		},
+		OperationUpdate: {
+			"config:DescribeConfigRules",
+			"config:PutConfigRule",
+			"config:TagResource",
+			"config:UntagResource",
+		},
+		OperationDelete: {
+			"config:DeleteConfigRule",
+			"config:DescribeConfigRules",
+		},
+	},
+	"aws_config_configuration_aggregator": {
+		OperationCreate: {
+			"config:DescribeConfigurationAggregators",
+			"config:PutConfigurationAggregator",
+		},
+		OperationUpdate: {
+			"config:DescribeConfigurationAggregators",
+			"config:PutConfigurationAggregator",
+			"config:TagResource",
+			"config:UntagResource",
+		},
+		OperationDelete: {
+			"config:DeleteConfigurationAggregator",
+		},
+	},
+	"aws_config_configuration_recorder": {
+		OperationCreate: {
+			"config:DescribeConfigurationRecorders",
+			"config:PutConfigurationRecorder",
+		},
+		OperationUpdate: {
+			"config:DescribeConfigurationRecorders",
+			"config:PutConfigurationRecorder",
+		},
+		OperationDelete: {
+			"config:DeleteConfigurationRecorder",
+		},
+	},
+	"aws_config_configuration_recorder_status": {
+		OperationCreate: {
+			"config:DescribeConfigurationRecorderStatus",
+			"config:StartConfigurationRecorder",
+			"config:StopConfigurationRecorder",
+		},
+		OperationUpdate: {
+			"config:DescribeConfigurationRecorderStatus",
+			"config:StartConfigurationRecorder",
+			"config:StopConfigurationRecorder",
+		},
+		OperationDelete: {
+			"config:StopConfigurationRecorder",
+		},
+	},
+	"aws_config_conformance_pack": {
+		OperationCreate: {
+			"config:DescribeConformancePackStatus",
+			"config:DescribeConformancePacks",
+			"config:PutConformancePack",
+		},
+		OperationUpdate: {
+			"config:DescribeConformancePackStatus",
+			"config:DescribeConformancePacks",
+			"config:PutConformancePack",
+		},
+		OperationDelete: {
+			"config:DeleteConformancePack",
+			"config:DescribeConformancePackStatus",
+		},
+	},
+	"aws_config_delivery_channel": {
+		OperationCreate: {
+			"config:DescribeDeliveryChannels",
+			"config:PutDeliveryChannel",
+		},
+		OperationUpdate: {
+			"config:DescribeDeliveryChannels",
+			"config:PutDeliveryChannel",
+		},
+		OperationDelete: {
+			"config:DeleteDeliveryChannel",
+		},
+	},
+	"aws_config_organization_conformance_pack": {
+		OperationCreate: {
+			"config:DescribeOrganizationConformancePackStatuses",
+			"config:DescribeOrganizationConformancePacks",
+			"config:GetOrganizationConformancePackDetailedStatus",
+			"config:PutOrganizationConformancePack",
+		},
+		OperationUpdate: {
+			"config:DescribeOrganizationConformancePackStatuses",
+			"config:DescribeOrganizationConformancePacks",
+			"config:GetOrganizationConformancePackDetailedStatus",
+			"config:PutOrganizationConformancePack",
+		},
+		OperationDelete: {
+			"config:DeleteOrganizationConformancePack",
+			"config:DescribeOrganizationConformancePackStatuses",
+			"config:GetOrganizationConformancePackDetailedStatus",
+		},
+	},
+	"aws_config_organization_custom_policy_rule": {
+		OperationCreate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:GetOrganizationCustomRulePolicy",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationUpdate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:GetOrganizationCustomRulePolicy",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationDelete: {
+			"config:DeleteOrganizationConfigRule",
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+		},
+	},
+	"aws_config_organization_custom_rule": {
+		OperationCreate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationUpdate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationDelete: {
+			"config:DeleteOrganizationConfigRule",
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+		},
+	},
+	"aws_config_organization_managed_rule": {
+		OperationCreate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationUpdate: {
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:DescribeOrganizationConfigRules",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+			"config:PutOrganizationConfigRule",
+		},
+		OperationDelete: {
+			"config:DeleteOrganizationConfigRule",
+			"config:DescribeOrganizationConfigRuleStatuses",
+			"config:GetOrganizationConfigRuleDetailedStatus",
+		},
+	},
+	"aws_config_remediation_configuration": {
+		OperationCreate: {
+			"config:DescribeRemediationConfigurations",
+			"config:PutRemediationConfigurations",
+		},
+		OperationUpdate: {
+			"config:DescribeRemediationConfigurations",
+			"config:PutRemediationConfigurations",
+		},
+		OperationDelete: {
+			"config:DeleteRemediationConfiguration",
+		},
+	},
+	"aws_config_retention_configuration": {
+		OperationCreate: {
+			"config:PutRetentionConfiguration",
+		},
+		OperationUpdate: {
+			"config:PutRetentionConfiguration",
+		},
+		OperationDelete: {
+			"config:DeleteRetentionConfiguration",
+		},
+	},
+	"aws_connect_bot_association": {
+		OperationCreate: {
+			"connect:AssociateBot",
+			"connect:ListBots",
+		},
+		OperationDelete: {
+			"connect:DisassociateBot",
+		},
+	},
+	"aws_connect_contact_flow": {
+		OperationCreate: {
+			"connect:CreateContactFlow",
+			"connect:DescribeContactFlow",
+		},
+		OperationUpdate: {
+			"connect:DescribeContactFlow",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateContactFlowContent",
+			"connect:UpdateContactFlowName",
+		},
+		OperationDelete: {
+			"connect:DeleteContactFlow",
+		},
+	},
+	"aws_connect_contact_flow_module": {
+		OperationCreate: {
+			"connect:CreateContactFlowModule",
+			"connect:DescribeContactFlowModule",
+		},
+		OperationUpdate: {
+			"connect:DescribeContactFlowModule",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateContactFlowModuleContent",
+			"connect:UpdateContactFlowModuleMetadata",
+		},
+		OperationDelete: {
+			"connect:DeleteContactFlowModule",
+		},
+	},
+	"aws_connect_hours_of_operation": {
+		OperationCreate: {
+			"connect:CreateHoursOfOperation",
+			"connect:DescribeHoursOfOperation",
+		},
+		OperationUpdate: {
+			"connect:DescribeHoursOfOperation",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateHoursOfOperation",
+		},
+		OperationDelete: {
+			"connect:DeleteHoursOfOperation",
+		},
+	},
+	"aws_connect_instance": {
+		OperationCreate: {
+			"connect:CreateInstance",
+			"connect:DescribeInstance",
+			"connect:DescribeInstanceAttribute",
+			"connect:UpdateInstanceAttribute",
+		},
+		OperationUpdate: {
+			"connect:UpdateInstanceAttribute",
+		},
+		OperationDelete: {
+			"connect:DeleteInstance",
+			"connect:DescribeInstance",
+		},
+	},
+	"aws_connect_instance_storage_config": {
+		OperationCreate: {
+			"connect:AssociateInstanceStorageConfig",
+			"connect:DescribeInstanceStorageConfig",
+		},
+		OperationUpdate: {
+			"connect:DescribeInstanceStorageConfig",
+			"connect:UpdateInstanceStorageConfig",
+		},
+		OperationDelete: {
+			"connect:DisassociateInstanceStorageConfig",
+		},
+	},
+	"aws_connect_lambda_function_association": {
+		OperationCreate: {
+			"connect:AssociateLambdaFunction",
+			"connect:ListLambdaFunctions",
+		},
+		OperationDelete: {
+			"connect:DisassociateLambdaFunction",
+		},
+	},
+	"aws_connect_phone_number": {
+		OperationCreate: {
+			"connect:ClaimPhoneNumber",
+			"connect:DescribePhoneNumber",
+			"connect:SearchAvailablePhoneNumbers",
+		},
+		OperationUpdate: {
+			"connect:DescribePhoneNumber",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdatePhoneNumber",
+		},
+		OperationDelete: {
+			"connect:DescribePhoneNumber",
+			"connect:ReleasePhoneNumber",
+		},
+	},
+	"aws_connect_queue": {
+		OperationCreate: {
+			"connect:CreateQueue",
+			"connect:DescribeQueue",
+			"connect:ListQueueQuickConnects",
+		},
+		OperationUpdate: {
+			"connect:AssociateQueueQuickConnects",
+			"connect:DescribeQueue",
+			"connect:DisassociateQueueQuickConnects",
+			"connect:ListQueueQuickConnects",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateQueueHoursOfOperation",
+			"connect:UpdateQueueMaxContacts",
+			"connect:UpdateQueueName",
+			"connect:UpdateQueueOutboundCallerConfig",
+			"connect:UpdateQueueStatus",
+		},
+		OperationDelete: {
+			"connect:DeleteQueue",
+		},
+	},
+	"aws_connect_quick_connect": {
+		OperationCreate: {
+			"connect:CreateQuickConnect",
+			"connect:DescribeQuickConnect",
+		},
+		OperationUpdate: {
+			"connect:DescribeQuickConnect",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateQuickConnectConfig",
+			"connect:UpdateQuickConnectName",
+		},
+		OperationDelete: {
+			"connect:DeleteQuickConnect",
+		},
+	},
+	"aws_connect_routing_profile": {
+		OperationCreate: {
+			"connect:AssociateRoutingProfileQueues",
+			"connect:CreateRoutingProfile",
+			"connect:DescribeRoutingProfile",
+			"connect:DisassociateRoutingProfileQueues",
+			"connect:ListRoutingProfileQueues",
+		},
+		OperationUpdate: {
+			"connect:AssociateRoutingProfileQueues",
+			"connect:DescribeRoutingProfile",
+			"connect:DisassociateRoutingProfileQueues",
+			"connect:ListRoutingProfileQueues",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateRoutingProfileConcurrency",
+			"connect:UpdateRoutingProfileDefaultOutboundQueue",
+			"connect:UpdateRoutingProfileName",
+		},
+		OperationDelete: {
+			"connect:DeleteRoutingProfile",
+		},
+	},
+	"aws_connect_security_profile": {
+		OperationCreate: {
+			"connect:CreateSecurityProfile",
+			"connect:DescribeSecurityProfile",
+			"connect:ListSecurityProfilePermissions",
+		},
+		OperationUpdate: {
+			"connect:DescribeSecurityProfile",
+			"connect:ListSecurityProfilePermissions",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateSecurityProfile",
+		},
+		OperationDelete: {
+			"connect:DeleteSecurityProfile",
+		},
+	},
+	"aws_connect_user": {
+		OperationCreate: {
+			"connect:CreateUser",
+			"connect:DescribeUser",
+		},
+		OperationUpdate: {
+			"connect:DescribeUser",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateUserHierarchy",
+			"connect:UpdateUserIdentityInfo",
+			"connect:UpdateUserPhoneConfig",
+			"connect:UpdateUserRoutingProfile",
+			"connect:UpdateUserSecurityProfiles",
+		},
+		OperationDelete: {
+			"connect:DeleteUser",
+		},
+	},
+	"aws_connect_user_hierarchy_group": {
+		OperationCreate: {
+			"connect:CreateUserHierarchyGroup",
+			"connect:DescribeUserHierarchyGroup",
+		},
+		OperationUpdate: {
+			"connect:DescribeUserHierarchyGroup",
+			"connect:TagResource",
+			"connect:UntagResource",
+			"connect:UpdateUserHierarchyGroupName",
+		},
+		OperationDelete: {
+			"connect:DeleteUserHierarchyGroup",
+		},
+	},
+	"aws_connect_user_hierarchy_structure": {
+		OperationCreate: {
+			"connect:DescribeUserHierarchyStructure",
+			"connect:UpdateUserHierarchyStructure",
+		},
+		OperationUpdate: {
+			"connect:DescribeUserHierarchyStructure",
+			"connect:UpdateUserHierarchyStructure",
+		},
+		OperationDelete: {
+			"connect:UpdateUserHierarchyStructure",
+		},
+	},
+	"aws_connect_users": {
+		OperationCreate: {
+			"connect:CreateUser",
+			"connect:ListUserHierarchyGroups",
+			"connect:SearchUsers",
+		},
+		OperationUpdate: {
+			"connect:CreateUser",
+			"connect:DeleteUser",
+			"connect:ListUserHierarchyGroups",
+			"connect:SearchUsers",
+			"connect:UpdateUserHierarchy",
+			"connect:UpdateUserIdentityInfo",
+			"connect:UpdateUserPhoneConfig",
+			"connect:UpdateUserRoutingProfile",
+			"connect:UpdateUserSecurityProfiles",
+		},
+		OperationDelete: {
+			"connect:DeleteUser",
+		},
+	},
+	"aws_connect_vocabulary": {
+		OperationCreate: {
+			"connect:CreateVocabulary",
+			"connect:DescribeVocabulary",
+		},
+		OperationUpdate: {
+			"connect:DescribeVocabulary",
+			"connect:TagResource",
+			"connect:UntagResource",
+		},
+		OperationDelete: {
+			"connect:DeleteVocabulary",
+			"connect:DescribeVocabulary",
+		},
+	},
+	"aws_controltower_control": {
+		OperationCreate: {
+			"controltower:EnableControl",
+			"controltower:GetControlOperation",
+			"controltower:ListEnabledControls",
+		},
+		OperationDelete: {
+			"controltower:DisableControl",
+			"controltower:GetControlOperation",
+		},
+	},
+	"aws_controltower_landing_zone": {
+		OperationCreate: {
+			"controltower:CreateLandingZone",
+			"controltower:GetLandingZone",
+			"controltower:GetLandingZoneOperation",
+		},
+		OperationUpdate: {
+			"controltower:GetLandingZone",
+			"controltower:GetLandingZoneOperation",
+			"controltower:TagResource",
+			"controltower:UntagResource",
+			"controltower:UpdateLandingZone",
+		},
+		OperationDelete: {
+			"controltower:DeleteLandingZone",
+			"controltower:GetLandingZoneOperation",
+		},
+	},
+	"aws_cur_report_definition": {
+		OperationCreate: {
+			"cur:DescribeReportDefinitions",
+			"cur:PutReportDefinition",
+		},
+		OperationUpdate: {
+			"cur:DescribeReportDefinitions",
+			"cur:ModifyReportDefinition",
+		},
+		OperationDelete: {
+			"cur:DeleteReportDefinition",
+		},
+	},
+	"aws_customer_gateway": {
+		OperationCreate: {
+			"ec2:CreateCustomerGateway",
+			"ec2:DescribeCustomerGateways",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeCustomerGateways",
+		},
+		OperationDelete: {
+			"ec2:DeleteCustomerGateway",
+			"ec2:DescribeCustomerGateways",
+		},
+	},
+	"aws_customerprofiles_domain": {
+		OperationCreate: {
+			"profile:CreateDomain",
+			"profile:GetDomain",
+		},
+		OperationUpdate: {
+			"profile:GetDomain",
+			"profile:TagResource",
+			"profile:UntagResource",
+			"profile:UpdateDomain",
+		},
+		OperationDelete: {
+			"profile:DeleteDomain",
+		},
+	},
+	"aws_customerprofiles_profile": {
+		OperationCreate: {
+			"profile:CreateProfile",
+			"profile:SearchProfiles",
+		},
+		OperationUpdate: {
+			"profile:SearchProfiles",
+			"profile:UpdateProfile",
+		},
+		OperationDelete: {
+			"profile:DeleteProfile",
+		},
+	},
+	"aws_dataexchange_data_set": {
+		OperationCreate: {
+			"dataexchange:CreateDataSet",
+			"dataexchange:GetDataSet",
+		},
+		OperationUpdate: {
+			"dataexchange:GetDataSet",
+			"dataexchange:TagResource",
+			"dataexchange:UntagResource",
+			"dataexchange:UpdateDataSet",
+		},
+		OperationDelete: {
+			"dataexchange:DeleteDataSet",
+		},
+	},
+	"aws_dataexchange_revision": {
+		OperationCreate: {
+			"dataexchange:CreateRevision",
+			"dataexchange:GetRevision",
+		},
+		OperationUpdate: {
+			"dataexchange:GetRevision",
+			"dataexchange:TagResource",
+			"dataexchange:UntagResource",
+			"dataexchange:UpdateRevision",
+		},
+		OperationDelete: {
+			"dataexchange:DeleteRevision",
+		},
+	},
+	"aws_datapipeline_pipeline": {
+		OperationCreate: {
+			"datapipeline:CreatePipeline",
+			"datapipeline:DescribePipelines",
+		},
+		OperationUpdate: {
+			"datapipeline:AddTags",
+			"datapipeline:DescribePipelines",
+			"datapipeline:RemoveTags",
+		},
+		OperationDelete: {
+			"datapipeline:DeletePipeline",
+			"datapipeline:DescribePipelines",
+		},
+	},
+	"aws_datapipeline_pipeline_definition": {
+		OperationCreate: {
+			"datapipeline:ActivatePipeline",
+			"datapipeline:GetPipelineDefinition",
+			"datapipeline:PutPipelineDefinition",
+		},
+		OperationUpdate: {
+			"datapipeline:ActivatePipeline",
+			"datapipeline:GetPipelineDefinition",
+			"datapipeline:PutPipelineDefinition",
+		},
+	},
+	"aws_datasync_agent": {
+		OperationCreate: {
+			"datasync:CreateAgent",
+			"datasync:DescribeAgent",
+		},
+		OperationUpdate: {
+			"datasync:DescribeAgent",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateAgent",
+		},
+		OperationDelete: {
+			"datasync:DeleteAgent",
+		},
+	},
+	"aws_datasync_location_azure_blob": {
+		OperationCreate: {
+			"datasync:CreateLocationAzureBlob",
+			"datasync:DescribeLocationAzureBlob",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationAzureBlob",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateLocationAzureBlob",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_efs": {
+		OperationCreate: {
+			"datasync:CreateLocationEfs",
+			"datasync:DescribeLocationEfs",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationEfs",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_fsx_lustre_file_system": {
+		OperationCreate: {
+			"datasync:CreateLocationFsxLustre",
+			"datasync:DescribeLocationFsxLustre",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationFsxLustre",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_fsx_ontap_file_system": {
+		OperationCreate: {
+			"datasync:CreateLocationFsxOntap",
+			"datasync:DescribeLocationFsxOntap",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationFsxOntap",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_fsx_openzfs_file_system": {
+		OperationCreate: {
+			"datasync:CreateLocationFsxOpenZfs",
+			"datasync:DescribeLocationFsxOpenZfs",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationFsxOpenZfs",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_fsx_windows_file_system": {
+		OperationCreate: {
+			"datasync:CreateLocationFsxWindows",
+			"datasync:DescribeLocationFsxWindows",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationFsxWindows",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_hdfs": {
+		OperationCreate: {
+			"datasync:CreateLocationHdfs",
+			"datasync:DescribeLocationHdfs",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationHdfs",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateLocationHdfs",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_nfs": {
+		OperationCreate: {
+			"datasync:CreateLocationNfs",
+			"datasync:DescribeLocationNfs",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationNfs",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateLocationNfs",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_object_storage": {
+		OperationCreate: {
+			"datasync:CreateLocationObjectStorage",
+			"datasync:DescribeLocationObjectStorage",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationObjectStorage",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateLocationObjectStorage",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_s3": {
+		OperationCreate: {
+			"datasync:CreateLocationS3",
+			"datasync:DescribeLocationS3",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationS3",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_location_smb": {
+		OperationCreate: {
+			"datasync:CreateLocationSmb",
+			"datasync:DescribeLocationSmb",
+		},
+		OperationUpdate: {
+			"datasync:DescribeLocationSmb",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateLocationSmb",
+		},
+		OperationDelete: {
+			"datasync:DeleteLocation",
+		},
+	},
+	"aws_datasync_task": {
+		OperationCreate: {
+			"datasync:CreateTask",
+			"datasync:DescribeTask",
+		},
+		OperationUpdate: {
+			"datasync:DescribeTask",
+			"datasync:TagResource",
+			"datasync:UntagResource",
+			"datasync:UpdateTask",
+		},
+		OperationDelete: {
+			"datasync:DeleteTask",
+		},
+	},
+	"aws_datazone_domain": {
+		OperationCreate: {
+			"datazone:CreateDomain",
+			"datazone:GetDomain",
+		},
+		OperationUpdate: {
+			"datazone:TagResource",
+			"datazone:UntagResource",
+			"datazone:UpdateDomain",
+		},
+		OperationDelete: {
+			"datazone:DeleteDomain",
+			"datazone:GetDomain",
+		},
+	},
+	"aws_datazone_environment_blueprint_configuration": {
+		OperationCreate: {
+			"datazone:PutEnvironmentBlueprintConfiguration",
+		},
+		OperationUpdate: {
+			"datazone:PutEnvironmentBlueprintConfiguration",
+		},
+		OperationDelete: {
+			"datazone:DeleteEnvironmentBlueprintConfiguration",
+		},
+	},
+	"aws_dax_cluster": {
+		OperationCreate: {
+			"dax:CreateCluster",
+			"dax:DescribeClusters",
+		},
+		OperationUpdate: {
+			"dax:DecreaseReplicationFactor",
+			"dax:DescribeClusters",
+			"dax:IncreaseReplicationFactor",
+			"dax:TagResource",
+			"dax:UntagResource",
+			"dax:UpdateCluster",
+		},
+		OperationDelete: {
+			"dax:DeleteCluster",
+			"dax:DescribeClusters",
+		},
+	},
+	"aws_dax_parameter_group": {
+		OperationCreate: {
+			"dax:CreateParameterGroup",
+			"dax:DescribeParameterGroups",
+			"dax:DescribeParameters",
+			"dax:UpdateParameterGroup",
+		},
+		OperationUpdate: {
+			"dax:DescribeParameterGroups",
+			"dax:DescribeParameters",
+			"dax:UpdateParameterGroup",
+		},
+		OperationDelete: {
+			"dax:DeleteParameterGroup",
+		},
+	},
+	"aws_dax_subnet_group": {
+		OperationCreate: {
+			"dax:CreateSubnetGroup",
+			"dax:DescribeSubnetGroups",
+		},
+		OperationUpdate: {
+			"dax:DescribeSubnetGroups",
+			"dax:UpdateSubnetGroup",
+		},
+		OperationDelete: {
+			"dax:DeleteSubnetGroup",
+		},
+	},
+	"aws_db_cluster_snapshot": {
+		OperationCreate: {
+			"rds:CreateDBClusterSnapshot",
+			"rds:DescribeDBClusterSnapshots",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterSnapshots",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterSnapshot",
+		},
+	},
+	"aws_db_event_subscription": {
+		OperationCreate: {
+			"rds:CreateEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+		OperationUpdate: {
+			"rds:AddSourceIdentifierToSubscription",
+			"rds:AddTagsToResource",
+			"rds:DescribeEventSubscriptions",
+			"rds:ModifyEventSubscription",
+			"rds:RemoveSourceIdentifierFromSubscription",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+	},
+	"aws_db_instance": {
+		OperationCreate: {
+			"rds:CreateDBInstance",
+			"rds:CreateDBInstanceReadReplica",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:RebootDBInstance",
+			"rds:RestoreDBInstanceFromDBSnapshot",
+			"rds:RestoreDBInstanceFromS3",
+			"rds:RestoreDBInstanceToPointInTime",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DeleteBlueGreenDeployment",
+			"rds:DeleteDBInstance",
+			"rds:DescribeBlueGreenDeployments",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:PromoteReadReplica",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBInstance",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+		},
+	},
+	"aws_db_instance_automated_backups_replication": {
+		OperationCreate: {
+			"rds:DescribeDBInstanceAutomatedBackups",
+			"rds:StartDBInstanceAutomatedBackupsReplication",
+		},
+		OperationDelete: {
+			"rds:DescribeDBInstanceAutomatedBackups",
+			"rds:DescribeDBInstances",
+			"rds:StopDBInstanceAutomatedBackupsReplication",
+		},
+	},
+	"aws_db_instance_role_association": {
+		OperationCreate: {
+			"rds:AddRoleToDBInstance",
+			"rds:DescribeDBInstances",
+		},
+		OperationDelete: {
+			"rds:DescribeDBInstances",
+			"rds:RemoveRoleFromDBInstance",
+		},
+	},
+	"aws_db_option_group": {
+		OperationCreate: {
+			"rds:CreateOptionGroup",
+			"rds:DescribeOptionGroups",
+			"rds:ModifyOptionGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeOptionGroups",
+			"rds:ModifyOptionGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteOptionGroup",
+		},
+	},
+	"aws_db_parameter_group": {
+		OperationCreate: {
+			"rds:CreateDBParameterGroup",
+			"rds:DescribeDBParameterGroups",
+			"rds:DescribeDBParameters",
+			"rds:ModifyDBParameterGroup",
+			"rds:ResetDBParameterGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBParameterGroups",
+			"rds:DescribeDBParameters",
+			"rds:ModifyDBParameterGroup",
+			"rds:RemoveTagsFromResource",
+			"rds:ResetDBParameterGroup",
+		},
+		OperationDelete: {
+			"rds:DeleteDBParameterGroup",
+		},
+	},
+	"aws_db_proxy": {
+		OperationCreate: {
+			"rds:CreateDBProxy",
+			"rds:DescribeDBProxies",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBProxies",
+			"rds:ModifyDBProxy",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBProxy",
+			"rds:DescribeDBProxies",
+		},
+	},
+	"aws_db_proxy_default_target_group": {
+		OperationCreate: {
+			"rds:DescribeDBProxyTargetGroups",
+			"rds:ModifyDBProxyTargetGroup",
+		},
+		OperationUpdate: {
+			"rds:DescribeDBProxyTargetGroups",
+			"rds:ModifyDBProxyTargetGroup",
+		},
+	},
+	"aws_db_proxy_endpoint": {
+		OperationCreate: {
+			"rds:CreateDBProxyEndpoint",
+			"rds:DescribeDBProxyEndpoints",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBProxyEndpoints",
+			"rds:ModifyDBProxyEndpoint",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBProxyEndpoint",
+			"rds:DescribeDBProxyEndpoints",
+		},
+	},
+	"aws_db_proxy_target": {
+		OperationCreate: {
+			"rds:DescribeDBProxyTargets",
+			"rds:RegisterDBProxyTargets",
+		},
+		OperationDelete: {
+			"rds:DeregisterDBProxyTargets",
+		},
+	},
+	"aws_db_snapshot": {
+		OperationCreate: {
+			"rds:CreateDBSnapshot",
+			"rds:DescribeDBSnapshotAttributes",
+			"rds:DescribeDBSnapshots",
+			"rds:ModifyDBSnapshotAttribute",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:ModifyDBSnapshotAttribute",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBSnapshot",
+		},
+	},
+	"aws_db_snapshot_copy": {
+		OperationCreate: {
+			"rds:CopyDBSnapshot",
+			"rds:DescribeDBSnapshots",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBSnapshots",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBSnapshot",
+		},
+	},
+	"aws_db_subnet_group": {
+		OperationCreate: {
+			"rds:CreateDBSubnetGroup",
+			"rds:DescribeDBSubnetGroups",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBSubnetGroups",
+			"rds:ModifyDBSubnetGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBSubnetGroup",
+			"rds:DescribeDBSubnetGroups",
+		},
+	},
+	"aws_default_network_acl": {
+		OperationCreate: {
+			"ec2:CreateNetworkAclEntry",
+			"ec2:CreateTags",
+			"ec2:DeleteNetworkAclEntry",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+		OperationUpdate: {
+			"ec2:CreateNetworkAclEntry",
+			"ec2:CreateTags",
+			"ec2:DeleteNetworkAclEntry",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+	},
+	"aws_default_route_table": {
+		OperationCreate: {
+			"ec2:CreateRoute",
+			"ec2:CreateTags",
+			"ec2:DeleteRoute",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribeRouteTables",
+			"ec2:DisableVgwRoutePropagation",
+			"ec2:EnableVgwRoutePropagation",
+		},
+		OperationUpdate: {
+			"ec2:CreateRoute",
+			"ec2:CreateTags",
+			"ec2:DeleteRoute",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribeRouteTables",
+			"ec2:DisableVgwRoutePropagation",
+			"ec2:EnableVgwRoutePropagation",
+			"ec2:ReplaceRoute",
+		},
+	},
+	"aws_default_security_group": {
+		OperationCreate: {
+			"ec2:AuthorizeSecurityGroupEgress",
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSecurityGroupRules",
+			"ec2:DescribeSecurityGroups",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+		OperationUpdate: {
+			"ec2:AuthorizeSecurityGroupEgress",
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSecurityGroups",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+	},
+	"aws_default_subnet": {
+		OperationCreate: {
+			"ec2:AssociateSubnetCidrBlock",
+			"ec2:CreateDefaultSubnet",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSubnets",
+			"ec2:DisassociateSubnetCidrBlock",
+			"ec2:ModifySubnetAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssociateSubnetCidrBlock",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSubnets",
+			"ec2:DisassociateSubnetCidrBlock",
+			"ec2:ModifySubnetAttribute",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInterface",
+			"ec2:DeleteSubnet",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DetachNetworkInterface",
+		},
+	},
+	"aws_default_vpc": {
+		OperationCreate: {
+			"ec2:AssociateVpcCidrBlock",
+			"ec2:CreateDefaultVpc",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcAttribute",
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateVpcCidrBlock",
+			"ec2:ModifyVpcAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssociateVpcCidrBlock",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcAttribute",
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateVpcCidrBlock",
+			"ec2:ModifyVpcAttribute",
+			"ec2:ModifyVpcTenancy",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpc",
+			"ec2:DescribeVpcs",
+			"ec2:GetIpamPoolAllocations",
+		},
+	},
+	"aws_default_vpc_dhcp_options": {
+		OperationCreate: {
+			"ec2:DescribeDhcpOptions",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeDhcpOptions",
+		},
+	},
+	"aws_detective_graph": {
+		OperationCreate: {
+			"detective:CreateGraph",
+			"detective:ListGraphs",
+		},
+		OperationUpdate: {
+			"detective:ListGraphs",
+			"detective:TagResource",
+			"detective:UntagResource",
+		},
+		OperationDelete: {
+			"detective:DeleteGraph",
+		},
+	},
+	"aws_detective_invitation_accepter": {
+		OperationCreate: {
+			"detective:AcceptInvitation",
+			"detective:ListInvitations",
+		},
+		OperationDelete: {
+			"detective:DisassociateMembership",
+		},
+	},
+	"aws_detective_member": {
+		OperationCreate: {
+			"detective:CreateMembers",
+			"detective:ListMembers",
+		},
+		OperationDelete: {
+			"detective:DeleteMembers",
+		},
+	},
+	"aws_detective_organization_admin_account": {
+		OperationCreate: {
+			"detective:EnableOrganizationAdminAccount",
+			"detective:ListOrganizationAdminAccounts",
+		},
+		OperationDelete: {
+			"detective:DisableOrganizationAdminAccount",
+			"detective:ListOrganizationAdminAccounts",
+		},
+	},
+	"aws_detective_organization_configuration": {
+		OperationCreate: {
+			"detective:DescribeOrganizationConfiguration",
+			"detective:UpdateOrganizationConfiguration",
+		},
+		OperationUpdate: {
+			"detective:DescribeOrganizationConfiguration",
+			"detective:UpdateOrganizationConfiguration",
+		},
+	},
+	"aws_devicefarm_device_pool": {
+		OperationCreate: {
+			"devicefarm:CreateDevicePool",
+			"devicefarm:GetDevicePool",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+		},
+		OperationUpdate: {
+			"devicefarm:GetDevicePool",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+			"devicefarm:UpdateDevicePool",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteDevicePool",
+		},
+	},
+	"aws_devicefarm_instance_profile": {
+		OperationCreate: {
+			"devicefarm:CreateInstanceProfile",
+			"devicefarm:GetInstanceProfile",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+		},
+		OperationUpdate: {
+			"devicefarm:GetInstanceProfile",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+			"devicefarm:UpdateInstanceProfile",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteInstanceProfile",
+		},
+	},
+	"aws_devicefarm_network_profile": {
+		OperationCreate: {
+			"devicefarm:CreateNetworkProfile",
+			"devicefarm:GetNetworkProfile",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+		},
+		OperationUpdate: {
+			"devicefarm:GetNetworkProfile",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+			"devicefarm:UpdateNetworkProfile",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteNetworkProfile",
+		},
+	},
+	"aws_devicefarm_project": {
+		OperationCreate: {
+			"devicefarm:CreateProject",
+			"devicefarm:GetProject",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+		},
+		OperationUpdate: {
+			"devicefarm:GetProject",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+			"devicefarm:UpdateProject",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteProject",
+		},
+	},
+	"aws_devicefarm_test_grid_project": {
+		OperationCreate: {
+			"devicefarm:CreateTestGridProject",
+			"devicefarm:GetTestGridProject",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+		},
+		OperationUpdate: {
+			"devicefarm:GetTestGridProject",
+			"devicefarm:TagResource",
+			"devicefarm:UntagResource",
+			"devicefarm:UpdateTestGridProject",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteTestGridProject",
+		},
+	},
+	"aws_devicefarm_upload": {
+		OperationCreate: {
+			"devicefarm:CreateUpload",
+			"devicefarm:GetUpload",
+		},
+		OperationUpdate: {
+			"devicefarm:GetUpload",
+			"devicefarm:UpdateUpload",
+		},
+		OperationDelete: {
+			"devicefarm:DeleteUpload",
+		},
+	},
+	"aws_devopsguru_event_sources_config": {
+		OperationCreate: {
+			"devops-guru:UpdateEventSourcesConfig",
+		},
+		OperationDelete: {
+			"devops-guru:UpdateEventSourcesConfig",
+		},
+	},
+	"aws_devopsguru_notification_channel": {
+		OperationCreate: {
+			"devops-guru:AddNotificationChannel",
+		},
+		OperationDelete: {
+			"devops-guru:RemoveNotificationChannel",
+		},
+	},
+	"aws_devopsguru_resource_collection": {
+		OperationCreate: {
+			"devops-guru:UpdateResourceCollection",
+		},
+		OperationDelete: {
+			"devops-guru:UpdateResourceCollection",
+		},
+	},
+	"aws_devopsguru_service_integration": {
+		OperationCreate: {
+			"devops-guru:DescribeServiceIntegration",
+			"devops-guru:UpdateServiceIntegration",
+		},
+		OperationUpdate: {
+			"devops-guru:DescribeServiceIntegration",
+			"devops-guru:UpdateServiceIntegration",
+		},
+	},
+	"aws_directory_service_conditional_forwarder": {
+		OperationCreate: {
+			"ds:CreateConditionalForwarder",
+		},
+		OperationUpdate: {
+			"ds:DescribeConditionalForwarders",
+			"ds:UpdateConditionalForwarder",
+		},
+		OperationDelete: {
+			"ds:DeleteConditionalForwarder",
+		},
+	},
+	"aws_directory_service_directory": {
+		OperationCreate: {
+			"ds:CreateAlias",
+			"ds:DeleteDirectory",
+			"ds:DescribeDirectories",
+			"ds:DescribeDomainControllers",
+			"ds:EnableSso",
+			"ds:UpdateNumberOfDomainControllers",
+		},
+		OperationUpdate: {
+			"ds:AddTagsToResource",
+			"ds:DescribeDirectories",
+			"ds:DescribeDomainControllers",
+			"ds:DisableSso",
+			"ds:EnableSso",
+			"ds:RemoveTagsFromResource",
+			"ds:UpdateNumberOfDomainControllers",
+		},
+		OperationDelete: {
+			"ds:DeleteDirectory",
+			"ds:DescribeDirectories",
+		},
+	},
+	"aws_directory_service_log_subscription": {
+		OperationCreate: {
+			"ds:CreateLogSubscription",
+			"ds:ListLogSubscriptions",
+		},
+		OperationDelete: {
+			"ds:DeleteLogSubscription",
+		},
+	},
+	"aws_directory_service_radius_settings": {
+		OperationCreate: {
+			"ds:DescribeDirectories",
+			"ds:EnableRadius",
+		},
+		OperationUpdate: {
+			"ds:DescribeDirectories",
+			"ds:UpdateRadius",
+		},
+		OperationDelete: {
+			"ds:DisableRadius",
+		},
+	},
+	"aws_directory_service_region": {
+		OperationCreate: {
+			"ds:AddRegion",
+			"ds:AddTagsToResource",
+			"ds:DescribeDomainControllers",
+			"ds:DescribeRegions",
+			"ds:ListTagsForResource",
+			"ds:RemoveTagsFromResource",
+			"ds:UpdateNumberOfDomainControllers",
+		},
+		OperationUpdate: {
+			"ds:AddTagsToResource",
+			"ds:DescribeDomainControllers",
+			"ds:DescribeRegions",
+			"ds:ListTagsForResource",
+			"ds:RemoveTagsFromResource",
+			"ds:UpdateNumberOfDomainControllers",
+		},
+		OperationDelete: {
+			"ds:DescribeRegions",
+			"ds:RemoveRegion",
+		},
+	},
+	"aws_directory_service_schema_extension": {
+		OperationCreate: {
+			"ds:GetSnapshotLimits",
+			"ds:ListSchemaExtensions",
+			"ds:StartSchemaExtension",
+		},
+		OperationDelete: {
+			"ds:CancelSchemaExtension",
+			"ds:ListSchemaExtensions",
+		},
+	},
+	"aws_directory_service_shared_directory": {
+		OperationCreate: {
+			"ds:ShareDirectory",
+		},
+		OperationDelete: {
+			"ds:DescribeSharedDirectories",
+			"ds:UnshareDirectory",
+		},
+	},
+	"aws_directory_service_shared_directory_accepter": {
+		OperationCreate: {
+			"ds:AcceptSharedDirectory",
+			"ds:DescribeDirectories",
+		},
+		OperationDelete: {
+			"ds:DeleteDirectory",
+			"ds:DescribeDirectories",
+		},
+	},
+	"aws_directory_service_trust": {
+		OperationCreate: {
+			"ds:CreateTrust",
+			"ds:DescribeTrusts",
+		},
+		OperationUpdate: {
+			"ds:DescribeConditionalForwarders",
+			"ds:DescribeTrusts",
+			"ds:UpdateConditionalForwarder",
+			"ds:UpdateTrust",
+		},
+		OperationDelete: {
+			"ds:DeleteTrust",
+			"ds:DescribeTrusts",
+		},
+	},
+	"aws_dlm_lifecycle_policy": {
+		OperationCreate: {
+			"dlm:CreateLifecyclePolicy",
+			"dlm:GetLifecyclePolicy",
+		},
+		OperationUpdate: {
+			"dlm:GetLifecyclePolicy",
+			"dlm:TagResource",
+			"dlm:UntagResource",
+			"dlm:UpdateLifecyclePolicy",
+		},
+		OperationDelete: {
+			"dlm:DeleteLifecyclePolicy",
+		},
+	},
+	"aws_dms_certificate": {
+		OperationCreate: {
+			"dms:DescribeCertificates",
+			"dms:ImportCertificate",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeCertificates",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteCertificate",
+		},
+	},
+	"aws_dms_data_migration": {
+		OperationCreate: {
+			"dms:CreateDataMigration",
+			"dms:DescribeDataMigrations",
+			"dms:StartDataMigration",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeDataMigrations",
+			"dms:ModifyDataMigration",
+			"dms:RemoveTagsFromResource",
+			"dms:StartDataMigration",
+			"dms:StopDataMigration",
+		},
+		OperationDelete: {
+			"dms:DeleteDataMigration",
+			"dms:DescribeDataMigrations",
+			"dms:StopDataMigration",
+		},
+	},
+	"aws_dms_data_provider": {
+		OperationCreate: {
+			"dms:CreateDataProvider",
+			"dms:DescribeDataProviders",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeDataProviders",
+			"dms:ModifyDataProvider",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteDataProvider",
+		},
+	},
+	"aws_dms_endpoint": {
+		OperationCreate: {
+			"dms:CreateEndpoint",
+			"dms:DescribeEndpoints",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeEndpoints",
+			"dms:DescribeReplicationTasks",
+			"dms:ModifyEndpoint",
+			"dms:RemoveTagsFromResource",
+			"dms:StartReplicationTask",
+			"dms:StopReplicationTask",
+			"dms:TestConnection",
+		},
+		OperationDelete: {
+			"dms:DeleteEndpoint",
+			"dms:DescribeEndpoints",
+		},
+	},
+	"aws_dms_event_subscription": {
+		OperationCreate: {
+			"dms:CreateEventSubscription",
+			"dms:DescribeEventSubscriptions",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeEventSubscriptions",
+			"dms:ModifyEventSubscription",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteEventSubscription",
+			"dms:DescribeEventSubscriptions",
+		},
+	},
+	"aws_dms_replication_config": {
+		OperationCreate: {
+			"dms:CreateReplicationConfig",
+			"dms:DescribeReplicationConfigs",
+			"dms:DescribeReplications",
+			"dms:StartReplication",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeReplicationConfigs",
+			"dms:DescribeReplications",
+			"dms:ModifyReplicationConfig",
+			"dms:RemoveTagsFromResource",
+			"dms:StartReplication",
+			"dms:StopReplication",
+		},
+		OperationDelete: {
+			"dms:DeleteReplicationConfig",
+			"dms:DescribeReplications",
+			"dms:StopReplication",
+		},
+	},
+	"aws_dms_replication_instance": {
+		OperationCreate: {
+			"dms:CreateReplicationInstance",
+			"dms:DescribeReplicationInstances",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeReplicationInstances",
+			"dms:ModifyReplicationInstance",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteReplicationInstance",
+			"dms:DescribeReplicationInstances",
+		},
+	},
+	"aws_dms_replication_subnet_group": {
+		OperationCreate: {
+			"dms:CreateReplicationSubnetGroup",
+			"dms:DescribeReplicationSubnetGroups",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeReplicationSubnetGroups",
+			"dms:ModifyReplicationSubnetGroup",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteReplicationSubnetGroup",
+		},
+	},
+	"aws_dms_replication_task": {
+		OperationCreate: {
+			"dms:CreateReplicationTask",
+			"dms:DescribeReplicationTasks",
+			"dms:StartReplicationTask",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeReplicationTasks",
+			"dms:ModifyReplicationTask",
+			"dms:MoveReplicationTask",
+			"dms:RemoveTagsFromResource",
+			"dms:StartReplicationTask",
+			"dms:StopReplicationTask",
+		},
+		OperationDelete: {
+			"dms:DeleteReplicationTask",
+			"dms:DescribeReplicationTasks",
+			"dms:StopReplicationTask",
+		},
+	},
+	"aws_dms_s3_endpoint": {
+		OperationCreate: {
+			"dms:CreateEndpoint",
+			"dms:DescribeEndpoints",
+			"dms:ModifyEndpoint",
+		},
+		OperationUpdate: {
+			"dms:AddTagsToResource",
+			"dms:DescribeEndpoints",
+			"dms:ModifyEndpoint",
+			"dms:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"dms:DeleteEndpoint",
+			"dms:DescribeEndpoints",
+		},
+	},
+	"aws_docdb_cluster": {
+		OperationCreate: {
+			"rds:CreateDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RestoreDBClusterFromSnapshot",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RemoveFromGlobalCluster",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_docdb_cluster_instance": {
+		OperationCreate: {
+			"rds:CreateDBInstance",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBInstance",
+			"rds:DescribeDBInstances",
+		},
+	},
+	"aws_docdb_cluster_parameter_group": {
+		OperationCreate: {
+			"rds:CreateDBClusterParameterGroup",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterParameterGroup",
+			"rds:DescribeDBClusterParameterGroups",
+		},
+	},
+	"aws_docdb_cluster_snapshot": {
+		OperationCreate: {
+			"rds:CreateDBClusterSnapshot",
+			"rds:DescribeDBClusterSnapshots",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterSnapshot",
+		},
+	},
+	"aws_docdb_event_subscription": {
+		OperationCreate: {
+			"rds:CreateEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+		OperationUpdate: {
+			"rds:AddSourceIdentifierToSubscription",
+			"rds:AddTagsToResource",
+			"rds:DescribeEventSubscriptions",
+			"rds:ModifyEventSubscription",
+			"rds:RemoveSourceIdentifierFromSubscription",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+	},
+	"aws_docdb_global_cluster": {
+		OperationCreate: {
+			"rds:CreateGlobalCluster",
+			"rds:DescribeGlobalClusters",
+		},
+		OperationUpdate: {
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:ModifyGlobalCluster",
+		},
+		OperationDelete: {
+			"rds:DeleteGlobalCluster",
+			"rds:DescribeGlobalClusters",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_docdb_subnet_group": {
+		OperationCreate: {
+			"rds:CreateDBSubnetGroup",
+			"rds:DescribeDBSubnetGroups",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBSubnetGroups",
+			"rds:ModifyDBSubnetGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBSubnetGroup",
+			"rds:DescribeDBSubnetGroups",
+		},
+	},
+	"aws_docdbelastic_cluster": {
+		OperationCreate: {
+			"docdb-elastic:CreateCluster",
+			"docdb-elastic:GetCluster",
+			"docdb-elastic:RestoreClusterFromSnapshot",
+			"docdb-elastic:UpdateCluster",
+		},
+		OperationUpdate: {
+			"docdb-elastic:GetCluster",
+			"docdb-elastic:TagResource",
+			"docdb-elastic:UntagResource",
+			"docdb-elastic:UpdateCluster",
+		},
+		OperationDelete: {
+			"docdb-elastic:DeleteCluster",
+			"docdb-elastic:GetCluster",
+		},
+	},
+	"aws_dx_bgp_peer": {
+		OperationCreate: {
+			"directconnect:CreateBGPPeer",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationDelete: {
+			"directconnect:DeleteBGPPeer",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_connection": {
+		OperationCreate: {
+			"directconnect:CreateConnection",
+			"directconnect:DescribeConnections",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeConnections",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateConnection",
+		},
+		OperationDelete: {
+			"directconnect:DeleteConnection",
+			"directconnect:DescribeConnections",
+		},
+	},
+	"aws_dx_connection_association": {
+		OperationCreate: {
+			"directconnect:AssociateConnectionWithLag",
+		},
+		OperationDelete: {
+			"directconnect:DisassociateConnectionFromLag",
+		},
+	},
+	"aws_dx_connection_confirmation": {
+		OperationCreate: {
+			"directconnect:ConfirmConnection",
+			"directconnect:DescribeConnections",
+		},
+	},
+	"aws_dx_gateway": {
+		OperationCreate: {
+			"directconnect:CreateDirectConnectGateway",
+			"directconnect:DescribeDirectConnectGateways",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeDirectConnectGateways",
+			"directconnect:UpdateDirectConnectGateway",
+		},
+		OperationDelete: {
+			"directconnect:DeleteDirectConnectGateway",
+			"directconnect:DescribeDirectConnectGateways",
+		},
+	},
+	"aws_dx_gateway_association": {
+		OperationCreate: {
+			"directconnect:AcceptDirectConnectGatewayAssociationProposal",
+			"directconnect:CreateDirectConnectGatewayAssociation",
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+			"directconnect:UpdateDirectConnectGatewayAssociation",
+		},
+		OperationDelete: {
+			"directconnect:DeleteDirectConnectGatewayAssociation",
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+		},
+	},
+	"aws_dx_gateway_association_proposal": {
+		OperationCreate: {
+			"directconnect:CreateDirectConnectGatewayAssociationProposal",
+			"directconnect:DescribeDirectConnectGatewayAssociationProposals",
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+		},
+		OperationDelete: {
+			"directconnect:DeleteDirectConnectGatewayAssociationProposal",
+		},
+	},
+	"aws_dx_gateway_association_proposal_accepter": {
+		OperationCreate: {
+			"directconnect:AcceptDirectConnectGatewayAssociationProposal",
+			"directconnect:DescribeDirectConnectGatewayAssociationProposals",
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+			"directconnect:UpdateDirectConnectGatewayAssociation",
+		},
+		OperationDelete: {
+			"directconnect:DeleteDirectConnectGatewayAssociation",
+			"directconnect:DescribeDirectConnectGatewayAssociations",
+		},
+	},
+	"aws_dx_hosted_connection": {
+		OperationCreate: {
+			"directconnect:AllocateHostedConnection",
+			"directconnect:DescribeHostedConnections",
+		},
+		OperationDelete: {
+			"directconnect:DeleteConnection",
+			"directconnect:DescribeHostedConnections",
+		},
+	},
+	"aws_dx_hosted_private_virtual_interface": {
+		OperationCreate: {
+			"directconnect:AllocatePrivateVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_hosted_private_virtual_interface_accepter": {
+		OperationCreate: {
+			"directconnect:ConfirmPrivateVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+	},
+	"aws_dx_hosted_public_virtual_interface": {
+		OperationCreate: {
+			"directconnect:AllocatePublicVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_hosted_public_virtual_interface_accepter": {
+		OperationCreate: {
+			"directconnect:ConfirmPublicVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+	},
+	"aws_dx_hosted_transit_virtual_interface": {
+		OperationCreate: {
+			"directconnect:AllocateTransitVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_hosted_transit_virtual_interface_accepter": {
+		OperationCreate: {
+			"directconnect:ConfirmTransitVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+	},
+	"aws_dx_lag": {
+		OperationCreate: {
+			"directconnect:CreateLag",
+			"directconnect:DeleteConnection",
+			"directconnect:DescribeConnections",
+			"directconnect:DescribeLags",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeLags",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateLag",
+		},
+		OperationDelete: {
+			"directconnect:DeleteConnection",
+			"directconnect:DeleteLag",
+			"directconnect:DescribeConnections",
+			"directconnect:DescribeLags",
+			"directconnect:DisassociateConnectionFromLag",
+		},
+	},
+	"aws_dx_macsec_key_association": {
+		OperationCreate: {
+			"directconnect:AssociateMacSecKey",
+			"directconnect:DescribeConnections",
+		},
+		OperationDelete: {
+			"directconnect:DisassociateMacSecKey",
+		},
+	},
+	"aws_dx_private_virtual_interface": {
+		OperationCreate: {
+			"directconnect:CreatePrivateVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_public_virtual_interface": {
+		OperationCreate: {
+			"directconnect:CreatePublicVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dx_transit_virtual_interface": {
+		OperationCreate: {
+			"directconnect:CreateTransitVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+		OperationUpdate: {
+			"directconnect:DescribeVirtualInterfaces",
+			"directconnect:TagResource",
+			"directconnect:UntagResource",
+			"directconnect:UpdateVirtualInterfaceAttributes",
+		},
+		OperationDelete: {
+			"directconnect:DeleteVirtualInterface",
+			"directconnect:DescribeVirtualInterfaces",
+		},
+	},
+	"aws_dynamodb_contributor_insights": {
+		OperationCreate: {
+			"dynamodb:DescribeContributorInsights",
+			"dynamodb:UpdateContributorInsights",
+		},
+		OperationDelete: {
+			"dynamodb:DescribeContributorInsights",
+			"dynamodb:UpdateContributorInsights",
+		},
+	},
+	"aws_dynamodb_global_table": {
+		OperationCreate: {
+			"dynamodb:CreateGlobalTable",
+			"dynamodb:DescribeGlobalTable",
+		},
+		OperationUpdate: {
+			"dynamodb:DescribeGlobalTable",
+			"dynamodb:UpdateGlobalTable",
+		},
+		OperationDelete: {
+			"dynamodb:DescribeGlobalTable",
+			"dynamodb:UpdateGlobalTable",
+		},
+	},
+	"aws_dynamodb_kinesis_streaming_destination": {
+		OperationCreate: {
+			"dynamodb:DescribeKinesisStreamingDestination",
+			"dynamodb:EnableKinesisStreamingDestination",
+		},
+		OperationDelete: {
+			"dynamodb:DescribeKinesisStreamingDestination",
+			"dynamodb:DisableKinesisStreamingDestination",
+		},
+	},
+	"aws_dynamodb_resource_policy": {
+		OperationCreate: {
+			"dynamodb:GetResourcePolicy",
+			"dynamodb:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"dynamodb:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"dynamodb:DeleteResourcePolicy",
+		},
+	},
+	"aws_dynamodb_table": {
+		OperationCreate: {
+			"dynamodb:CreateTable",
+			"dynamodb:DescribeContinuousBackups",
+			"dynamodb:DescribeImport",
+			"dynamodb:DescribeTable",
+			"dynamodb:DescribeTimeToLive",
+			"dynamodb:ImportTable",
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:RestoreTableToPointInTime",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+			"dynamodb:UpdateContinuousBackups",
+			"dynamodb:UpdateTable",
+			"dynamodb:UpdateTimeToLive",
+		},
+		OperationUpdate: {
+			"dynamodb:DescribeContinuousBackups",
+			"dynamodb:DescribeTable",
+			"dynamodb:DescribeTimeToLive",
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+			"dynamodb:UpdateContinuousBackups",
+			"dynamodb:UpdateTable",
+			"dynamodb:UpdateTimeToLive",
+		},
+		OperationDelete: {
+			"dynamodb:DeleteTable",
+			"dynamodb:DescribeTable",
+			"dynamodb:UpdateTable",
+		},
+	},
+	"aws_dynamodb_table_export": {
+		OperationCreate: {
+			"dynamodb:DescribeExport",
+			"dynamodb:ExportTableToPointInTime",
+		},
+	},
+	"aws_dynamodb_table_item": {
+		OperationCreate: {
+			"dynamodb:GetItem",
+			"dynamodb:PutItem",
+		},
+		OperationUpdate: {
+			"dynamodb:DeleteItem",
+			"dynamodb:GetItem",
+			"dynamodb:UpdateItem",
+		},
+		OperationDelete: {
+			"dynamodb:DeleteItem",
+		},
+	},
+	"aws_dynamodb_table_replica": {
+		OperationCreate: {
+			"dynamodb:DescribeContinuousBackups",
+			"dynamodb:DescribeTable",
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+			"dynamodb:UpdateContinuousBackups",
+			"dynamodb:UpdateTable",
+		},
+		OperationUpdate: {
+			"dynamodb:DescribeContinuousBackups",
+			"dynamodb:DescribeTable",
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+			"dynamodb:UpdateContinuousBackups",
+			"dynamodb:UpdateTable",
+		},
+		OperationDelete: {
+			"dynamodb:DescribeTable",
+			"dynamodb:UpdateTable",
+		},
+	},
+	"aws_dynamodb_tag": {
+		OperationCreate: {
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+		},
+		OperationUpdate: {
+			"dynamodb:ListTagsOfResource",
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+		},
+		OperationDelete: {
+			"dynamodb:TagResource",
+			"dynamodb:UntagResource",
+		},
+	},
+	"aws_ebs_default_kms_key": {
+		OperationCreate: {
+			"ec2:GetEbsDefaultKmsKeyId",
+			"ec2:ModifyEbsDefaultKmsKeyId",
+		},
+		OperationDelete: {
+			"ec2:ResetEbsDefaultKmsKeyId",
+		},
+	},
+	"aws_ebs_encryption_by_default": {
+		OperationCreate: {
+			"ec2:DisableEbsEncryptionByDefault",
+			"ec2:EnableEbsEncryptionByDefault",
+			"ec2:GetEbsEncryptionByDefault",
+		},
+		OperationUpdate: {
+			"ec2:DisableEbsEncryptionByDefault",
+			"ec2:EnableEbsEncryptionByDefault",
+			"ec2:GetEbsEncryptionByDefault",
+		},
+		OperationDelete: {
+			"ec2:DisableEbsEncryptionByDefault",
+			"ec2:EnableEbsEncryptionByDefault",
+		},
+	},
+	"aws_ebs_fast_snapshot_restore": {
+		OperationCreate: {
+			"ec2:DescribeFastSnapshotRestores",
+			"ec2:EnableFastSnapshotRestores",
+		},
+		OperationDelete: {
+			"ec2:DescribeFastSnapshotRestores",
+			"ec2:DisableFastSnapshotRestores",
+		},
+	},
+	"aws_ebs_snapshot": {
+		OperationCreate: {
+			"ec2:CreateSnapshot",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ModifySnapshotTier",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ModifySnapshotTier",
+			"ec2:RestoreSnapshotTier",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+		},
+	},
+	"aws_ebs_snapshot_block_public_access": {
+		OperationCreate: {
+			"ec2:DisableSnapshotBlockPublicAccess",
+			"ec2:EnableSnapshotBlockPublicAccess",
+			"ec2:GetSnapshotBlockPublicAccessState",
+		},
+		OperationUpdate: {
+			"ec2:DisableSnapshotBlockPublicAccess",
+			"ec2:EnableSnapshotBlockPublicAccess",
+			"ec2:GetSnapshotBlockPublicAccessState",
+		},
+	},
+	"aws_ebs_snapshot_copy": {
+		OperationCreate: {
+			"ec2:CopySnapshot",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ModifySnapshotTier",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ModifySnapshotTier",
+			"ec2:RestoreSnapshotTier",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+		},
+	},
+	"aws_ebs_snapshot_import": {
+		OperationCreate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImportSnapshotTasks",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ImportSnapshot",
+			"ec2:ModifySnapshotTier",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSnapshotTierStatus",
+			"ec2:DescribeSnapshots",
+			"ec2:ModifySnapshotTier",
+			"ec2:RestoreSnapshotTier",
+		},
+		OperationDelete: {
+			"ec2:DeleteSnapshot",
+		},
+	},
+	"aws_ebs_volume": {
+		OperationCreate: {
+			"ec2:CreateVolume",
+			"ec2:DescribeVolumes",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVolumes",
+			"ec2:ModifyVolume",
+		},
+		OperationDelete: {
+			"ec2:CreateSnapshot",
+			"ec2:DeleteVolume",
+			"ec2:DescribeVolumes",
+		},
+	},
+	"aws_ec2_availability_zone_group": {
+		OperationCreate: {
+			"ec2:DescribeAvailabilityZones",
+			"ec2:ModifyAvailabilityZoneGroup",
+		},
+		OperationUpdate: {
+			"ec2:DescribeAvailabilityZones",
+			"ec2:ModifyAvailabilityZoneGroup",
+		},
+	},
+	"aws_ec2_capacity_reservation": {
+		OperationCreate: {
+			"ec2:CreateCapacityReservation",
+			"ec2:DescribeCapacityReservations",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeCapacityReservations",
+			"ec2:ModifyCapacityReservation",
+		},
+		OperationDelete: {
+			"ec2:CancelCapacityReservation",
+			"ec2:DescribeCapacityReservations",
+		},
+	},
+	"aws_ec2_carrier_gateway": {
+		OperationCreate: {
+			"ec2:CreateCarrierGateway",
+			"ec2:DescribeCarrierGateways",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeCarrierGateways",
+		},
+		OperationDelete: {
+			"ec2:DeleteCarrierGateway",
+			"ec2:DescribeCarrierGateways",
+		},
+	},
+	"aws_ec2_client_vpn_authorization_rule": {
+		OperationCreate: {
+			"ec2:AuthorizeClientVpnIngress",
+			"ec2:DescribeClientVpnAuthorizationRules",
+		},
+		OperationDelete: {
+			"ec2:DescribeClientVpnAuthorizationRules",
+			"ec2:RevokeClientVpnIngress",
+		},
+	},
+	"aws_ec2_client_vpn_endpoint": {
+		OperationCreate: {
+			"ec2:CreateClientVpnEndpoint",
+			"ec2:DescribeClientVpnEndpoints",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeClientVpnEndpoints",
+			"ec2:ModifyClientVpnEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DeleteClientVpnEndpoint",
+			"ec2:DescribeClientVpnEndpoints",
+		},
+	},
+	"aws_ec2_client_vpn_network_association": {
+		OperationCreate: {
+			"ec2:AssociateClientVpnTargetNetwork",
+			"ec2:DescribeClientVpnTargetNetworks",
+		},
+		OperationDelete: {
+			"ec2:DescribeClientVpnTargetNetworks",
+			"ec2:DisassociateClientVpnTargetNetwork",
+		},
+	},
+	"aws_ec2_client_vpn_route": {
+		OperationCreate: {
+			"ec2:CreateClientVpnRoute",
+			"ec2:DescribeClientVpnRoutes",
+		},
+		OperationDelete: {
+			"ec2:DeleteClientVpnRoute",
+			"ec2:DescribeClientVpnRoutes",
+		},
+	},
+	"aws_ec2_fleet": {
+		OperationCreate: {
+			"ec2:CreateFleet",
+			"ec2:DescribeFleets",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeFleets",
+			"ec2:ModifyFleet",
+		},
+		OperationDelete: {
+			"ec2:DeleteFleets",
+			"ec2:DescribeFleets",
+		},
+	},
+	"aws_ec2_host": {
+		OperationCreate: {
+			"ec2:AllocateHosts",
+			"ec2:DescribeHosts",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeHosts",
+			"ec2:ModifyHosts",
+		},
+		OperationDelete: {
+			"ec2:DescribeHosts",
+			"ec2:ReleaseHosts",
+		},
+	},
+	"aws_ec2_image_block_public_access": {
+		OperationCreate: {
+			"ec2:DisableImageBlockPublicAccess",
+			"ec2:EnableImageBlockPublicAccess",
+			"ec2:GetImageBlockPublicAccessState",
+		},
+		OperationUpdate: {
+			"ec2:DisableImageBlockPublicAccess",
+			"ec2:EnableImageBlockPublicAccess",
+			"ec2:GetImageBlockPublicAccessState",
+		},
+	},
+	"aws_ec2_instance_connect_endpoint": {
+		OperationCreate: {
+			"ec2:CreateInstanceConnectEndpoint",
+			"ec2:DescribeInstanceConnectEndpoints",
+		},
+		OperationDelete: {
+			"ec2:DeleteInstanceConnectEndpoint",
+			"ec2:DescribeInstanceConnectEndpoints",
+		},
+	},
+	"aws_ec2_instance_metadata_defaults": {
+		OperationCreate: {
+			"ec2:ModifyInstanceMetadataDefaults",
+		},
+		OperationUpdate: {
+			"ec2:ModifyInstanceMetadataDefaults",
+		},
+		OperationDelete: {
+			"ec2:ModifyInstanceMetadataDefaults",
+		},
+	},
+	"aws_ec2_instance_state": {
+		OperationCreate: {
+			"ec2:DescribeInstanceStatus",
+			"ec2:DescribeInstances",
+			"ec2:StartInstances",
+			"ec2:StopInstances",
+		},
+		OperationUpdate: {
+			"ec2:DescribeInstanceStatus",
+			"ec2:DescribeInstances",
+			"ec2:StartInstances",
+			"ec2:StopInstances",
+		},
+	},
+	"aws_ec2_local_gateway_route": {
+		OperationCreate: {
+			"ec2:CreateLocalGatewayRoute",
+			"ec2:SearchLocalGatewayRoutes",
+		},
+		OperationDelete: {
+			"ec2:DeleteLocalGatewayRoute",
+		},
+	},
+	"aws_ec2_local_gateway_route_table_vpc_association": {
+		OperationCreate: {
+			"ec2:CreateLocalGatewayRouteTableVpcAssociation",
+			"ec2:DescribeLocalGatewayRouteTableVpcAssociations",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeLocalGatewayRouteTableVpcAssociations",
+		},
+		OperationDelete: {
+			"ec2:DeleteLocalGatewayRouteTableVpcAssociation",
+			"ec2:DescribeLocalGatewayRouteTableVpcAssociations",
+		},
+	},
+	"aws_ec2_managed_prefix_list": {
+		OperationCreate: {
+			"ec2:CreateManagedPrefixList",
+			"ec2:DescribeManagedPrefixLists",
+			"ec2:GetManagedPrefixListEntries",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeManagedPrefixLists",
+			"ec2:GetManagedPrefixListEntries",
+			"ec2:ModifyManagedPrefixList",
+		},
+		OperationDelete: {
+			"ec2:DeleteManagedPrefixList",
+			"ec2:DescribeManagedPrefixLists",
+		},
+	},
+	"aws_ec2_managed_prefix_list_entry": {
+		OperationCreate: {
+			"ec2:DescribeManagedPrefixLists",
+			"ec2:GetManagedPrefixListEntries",
+			"ec2:ModifyManagedPrefixList",
+		},
+		OperationDelete: {
+			"ec2:DescribeManagedPrefixLists",
+			"ec2:ModifyManagedPrefixList",
+		},
+	},
+	"aws_ec2_network_insights_analysis": {
+		OperationCreate: {
+			"ec2:DescribeNetworkInsightsAnalyses",
+			"ec2:StartNetworkInsightsAnalysis",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInsightsAnalyses",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInsightsAnalysis",
+		},
+	},
+	"aws_ec2_network_insights_path": {
+		OperationCreate: {
+			"ec2:CreateNetworkInsightsPath",
+			"ec2:DescribeNetworkInsightsPaths",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInsightsPaths",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInsightsPath",
+		},
+	},
+	"aws_ec2_serial_console_access": {
+		OperationCreate: {
+			"ec2:DisableSerialConsoleAccess",
+			"ec2:EnableSerialConsoleAccess",
+			"ec2:GetSerialConsoleAccessStatus",
+		},
+		OperationUpdate: {
+			"ec2:DisableSerialConsoleAccess",
+			"ec2:EnableSerialConsoleAccess",
+			"ec2:GetSerialConsoleAccessStatus",
+		},
+		OperationDelete: {
+			"ec2:DisableSerialConsoleAccess",
+			"ec2:EnableSerialConsoleAccess",
+		},
+	},
+	"aws_ec2_subnet_cidr_reservation": {
+		OperationCreate: {
+			"ec2:CreateSubnetCidrReservation",
+			"ec2:GetSubnetCidrReservations",
+		},
+		OperationDelete: {
+			"ec2:DeleteSubnetCidrReservation",
+		},
+	},
+	"aws_ec2_tag": {
+		OperationCreate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTags",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTags",
+		},
+		OperationDelete: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+		},
+	},
+	"aws_ec2_traffic_mirror_filter": {
+		OperationCreate: {
+			"ec2:CreateTrafficMirrorFilter",
+			"ec2:DescribeTrafficMirrorFilters",
+			"ec2:ModifyTrafficMirrorFilterNetworkServices",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTrafficMirrorFilters",
+			"ec2:ModifyTrafficMirrorFilterNetworkServices",
+		},
+		OperationDelete: {
+			"ec2:DeleteTrafficMirrorFilter",
+		},
+	},
+	"aws_ec2_traffic_mirror_filter_rule": {
+		OperationCreate: {
+			"ec2:CreateTrafficMirrorFilterRule",
+			"ec2:DescribeTrafficMirrorFilters",
+		},
+		OperationUpdate: {
+			"ec2:DescribeTrafficMirrorFilters",
+			"ec2:ModifyTrafficMirrorFilterRule",
+		},
+		OperationDelete: {
+			"ec2:DeleteTrafficMirrorFilterRule",
+		},
+	},
+	"aws_ec2_traffic_mirror_session": {
+		OperationCreate: {
+			"ec2:CreateTrafficMirrorSession",
+			"ec2:DescribeTrafficMirrorSessions",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTrafficMirrorSessions",
+			"ec2:ModifyTrafficMirrorSession",
+		},
+		OperationDelete: {
+			"ec2:DeleteTrafficMirrorSession",
+		},
+	},
+	"aws_ec2_traffic_mirror_target": {
+		OperationCreate: {
+			"ec2:CreateTrafficMirrorTarget",
+			"ec2:DescribeTrafficMirrorTargets",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTrafficMirrorTargets",
+		},
+		OperationDelete: {
+			"ec2:DeleteTrafficMirrorTarget",
+		},
+	},
+	"aws_ec2_transit_gateway": {
+		OperationCreate: {
+			"ec2:CreateTransitGateway",
+			"ec2:DescribeTransitGateways",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGateways",
+			"ec2:ModifyTransitGateway",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGateway",
+			"ec2:DescribeTransitGateways",
+		},
+	},
+	"aws_ec2_transit_gateway_connect": {
+		OperationCreate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTransitGatewayConnect",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DescribeTransitGatewayConnects",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationUpdate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DescribeTransitGatewayConnects",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayConnect",
+			"ec2:DescribeTransitGatewayConnects",
+		},
+	},
+	"aws_ec2_transit_gateway_connect_peer": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayConnectPeer",
+			"ec2:DescribeTransitGatewayConnectPeers",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayConnectPeers",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayConnectPeer",
+			"ec2:DescribeTransitGatewayConnectPeers",
+		},
+	},
+	"aws_ec2_transit_gateway_multicast_domain": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayMulticastDomain",
+			"ec2:DescribeTransitGatewayMulticastDomains",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayMulticastDomains",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayMulticastDomain",
+			"ec2:DeregisterTransitGatewayMulticastGroupMembers",
+			"ec2:DeregisterTransitGatewayMulticastGroupSources",
+			"ec2:DescribeTransitGatewayMulticastDomains",
+			"ec2:DisassociateTransitGatewayMulticastDomain",
+			"ec2:GetTransitGatewayMulticastDomainAssociations",
+			"ec2:SearchTransitGatewayMulticastGroups",
+		},
+	},
+	"aws_ec2_transit_gateway_multicast_domain_association": {
+		OperationCreate: {
+			"ec2:AssociateTransitGatewayMulticastDomain",
+			"ec2:GetTransitGatewayMulticastDomainAssociations",
+		},
+		OperationDelete: {
+			"ec2:DisassociateTransitGatewayMulticastDomain",
+			"ec2:GetTransitGatewayMulticastDomainAssociations",
+		},
+	},
+	"aws_ec2_transit_gateway_multicast_group_member": {
+		OperationCreate: {
+			"ec2:RegisterTransitGatewayMulticastGroupMembers",
+			"ec2:SearchTransitGatewayMulticastGroups",
+		},
+		OperationDelete: {
+			"ec2:DeregisterTransitGatewayMulticastGroupMembers",
+			"ec2:SearchTransitGatewayMulticastGroups",
+		},
+	},
+	"aws_ec2_transit_gateway_multicast_group_source": {
+		OperationCreate: {
+			"ec2:RegisterTransitGatewayMulticastGroupSources",
+			"ec2:SearchTransitGatewayMulticastGroups",
+		},
+		OperationDelete: {
+			"ec2:DeregisterTransitGatewayMulticastGroupSources",
+			"ec2:SearchTransitGatewayMulticastGroups",
+		},
+	},
+	"aws_ec2_transit_gateway_peering_attachment": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayPeeringAttachment",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayPeeringAttachment",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+		},
+	},
+	"aws_ec2_transit_gateway_peering_attachment_accepter": {
+		OperationCreate: {
+			"ec2:AcceptTransitGatewayPeeringAttachment",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+			"ec2:DescribeTransitGateways",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+			"ec2:DescribeTransitGateways",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayPeeringAttachment",
+			"ec2:DescribeTransitGatewayPeeringAttachments",
+		},
+	},
+	"aws_ec2_transit_gateway_policy_table": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayPolicyTable",
+			"ec2:DescribeTransitGatewayPolicyTables",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayPolicyTables",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayPolicyTable",
+			"ec2:DescribeTransitGatewayPolicyTables",
+		},
+	},
+	"aws_ec2_transit_gateway_policy_table_association": {
+		OperationCreate: {
+			"ec2:AssociateTransitGatewayPolicyTable",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:GetTransitGatewayPolicyTableAssociations",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+		},
+		OperationDelete: {
+			"ec2:DisassociateTransitGatewayPolicyTable",
+			"ec2:GetTransitGatewayPolicyTableAssociations",
+		},
+	},
+	"aws_ec2_transit_gateway_prefix_list_reference": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayPrefixListReference",
+			"ec2:GetTransitGatewayPrefixListReferences",
+		},
+		OperationUpdate: {
+			"ec2:GetTransitGatewayPrefixListReferences",
+			"ec2:ModifyTransitGatewayPrefixListReference",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayPrefixListReference",
+			"ec2:GetTransitGatewayPrefixListReferences",
+		},
+	},
+	"aws_ec2_transit_gateway_route": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayRoute",
+			"ec2:SearchTransitGatewayRoutes",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayRoute",
+			"ec2:SearchTransitGatewayRoutes",
+		},
+	},
+	"aws_ec2_transit_gateway_route_table": {
+		OperationCreate: {
+			"ec2:CreateTransitGatewayRouteTable",
+			"ec2:DescribeTransitGatewayRouteTables",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayRouteTables",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayRouteTable",
+			"ec2:DescribeTransitGatewayRouteTables",
+		},
+	},
+	"aws_ec2_transit_gateway_route_table_association": {
+		OperationCreate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+		},
+		OperationDelete: {
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+		},
+	},
+	"aws_ec2_transit_gateway_route_table_propagation": {
+		OperationCreate: {
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationDelete: {
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+	},
+	"aws_ec2_transit_gateway_vpc_attachment": {
+		OperationCreate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTransitGatewayVpcAttachment",
+			"ec2:DescribeTransitGatewayVpcAttachments",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationUpdate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayVpcAttachments",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+			"ec2:ModifyTransitGatewayVpcAttachment",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayVpcAttachment",
+			"ec2:DescribeTransitGatewayVpcAttachments",
+		},
+	},
+	"aws_ec2_transit_gateway_vpc_attachment_accepter": {
+		OperationCreate: {
+			"ec2:AcceptTransitGatewayVpcAttachment",
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayVpcAttachments",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationUpdate: {
+			"ec2:AssociateTransitGatewayRouteTable",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGateways",
+			"ec2:DisableTransitGatewayRouteTablePropagation",
+			"ec2:DisassociateTransitGatewayRouteTable",
+			"ec2:EnableTransitGatewayRouteTablePropagation",
+			"ec2:GetTransitGatewayRouteTableAssociations",
+			"ec2:GetTransitGatewayRouteTablePropagations",
+		},
+		OperationDelete: {
+			"ec2:DeleteTransitGatewayVpcAttachment",
+			"ec2:DescribeTransitGatewayVpcAttachments",
+		},
+	},
+	"aws_ecr_lifecycle_policy": {
+		OperationCreate: {
+			"ecr:GetLifecyclePolicy",
+			"ecr:PutLifecyclePolicy",
+		},
+		OperationDelete: {
+			"ecr:DeleteLifecyclePolicy",
+		},
+	},
+	"aws_ecr_pull_through_cache_rule": {
+		OperationCreate: {
+			"ecr:CreatePullThroughCacheRule",
+			"ecr:DescribePullThroughCacheRules",
+		},
+		OperationUpdate: {
+			"ecr:DescribePullThroughCacheRules",
+			"ecr:UpdatePullThroughCacheRule",
+		},
+		OperationDelete: {
+			"ecr:DeletePullThroughCacheRule",
+		},
+	},
+	"aws_ecr_registry_policy": {
+		OperationCreate: {
+			"ecr:GetRegistryPolicy",
+			"ecr:PutRegistryPolicy",
+		},
+		OperationUpdate: {
+			"ecr:GetRegistryPolicy",
+			"ecr:PutRegistryPolicy",
+		},
+		OperationDelete: {
+			"ecr:DeleteRegistryPolicy",
+		},
+	},
+	"aws_ecr_registry_scanning_configuration": {
+		OperationCreate: {
+			"ecr:GetRegistryScanningConfiguration",
+			"ecr:PutRegistryScanningConfiguration",
+		},
+		OperationUpdate: {
+			"ecr:GetRegistryScanningConfiguration",
+			"ecr:PutRegistryScanningConfiguration",
+		},
+		OperationDelete: {
+			"ecr:PutRegistryScanningConfiguration",
+		},
+	},
+	"aws_ecr_replication_configuration": {
+		OperationCreate: {
+			"ecr:DescribeRegistry",
+			"ecr:PutReplicationConfiguration",
+		},
+		OperationUpdate: {
+			"ecr:DescribeRegistry",
+			"ecr:PutReplicationConfiguration",
+		},
+		OperationDelete: {
+			"ecr:PutReplicationConfiguration",
+		},
+	},
+	"aws_ecr_repository": {
+		OperationCreate: {
+			"ecr:CreateRepository",
+			"ecr:DescribeRepositories",
+			"ecr:TagResource",
+			"ecr:UntagResource",
+		},
+		OperationUpdate: {
+			"ecr:DescribeRepositories",
+			"ecr:PutImageScanningConfiguration",
+			"ecr:PutImageTagMutability",
+			"ecr:TagResource",
+			"ecr:UntagResource",
+		},
+		OperationDelete: {
+			"ecr:DeleteRepository",
+			"ecr:DescribeRepositories",
+		},
+	},
+	"aws_ecr_repository_policy": {
+		OperationCreate: {
+			"ecr:GetRepositoryPolicy",
+			"ecr:SetRepositoryPolicy",
+		},
+		OperationUpdate: {
+			"ecr:GetRepositoryPolicy",
+			"ecr:SetRepositoryPolicy",
+		},
+		OperationDelete: {
+			"ecr:DeleteRepositoryPolicy",
+		},
+	},
+	"aws_ecrpublic_repository": {
+		OperationCreate: {
+			"ecr-public:CreateRepository",
+			"ecr-public:DescribeRepositories",
+			"ecr-public:GetRepositoryCatalogData",
+		},
+		OperationUpdate: {
+			"ecr-public:DescribeRepositories",
+			"ecr-public:GetRepositoryCatalogData",
+			"ecr-public:PutRepositoryCatalogData",
+			"ecr-public:TagResource",
+			"ecr-public:UntagResource",
+		},
+		OperationDelete: {
+			"ecr-public:DeleteRepository",
+			"ecr-public:DescribeRepositories",
+		},
+	},
+	"aws_ecrpublic_repository_policy": {
+		OperationCreate: {
+			"ecr-public:GetRepositoryPolicy",
+			"ecr-public:SetRepositoryPolicy",
+		},
+		OperationUpdate: {
+			"ecr-public:GetRepositoryPolicy",
+			"ecr-public:SetRepositoryPolicy",
+		},
+		OperationDelete: {
+			"ecr-public:DeleteRepositoryPolicy",
+		},
+	},
+	"aws_ecs_account_setting_default": {
+		OperationCreate: {
+			"ecs:ListAccountSettings",
+			"ecs:PutAccountSettingDefault",
+		},
+		OperationUpdate: {
+			"ecs:PutAccountSettingDefault",
+		},
+		OperationDelete: {
+			"ecs:PutAccountSettingDefault",
+		},
+	},
+	"aws_ecs_capacity_provider": {
+		OperationCreate: {
+			"ecs:CreateCapacityProvider",
+			"ecs:DescribeCapacityProviders",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:DescribeCapacityProviders",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+			"ecs:UpdateCapacityProvider",
+		},
+		OperationDelete: {
+			"ecs:DeleteCapacityProvider",
+			"ecs:DescribeCapacityProviders",
+		},
+	},
+	"aws_ecs_cluster": {
+		OperationCreate: {
+			"ecs:CreateCluster",
+			"ecs:DescribeClusters",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:DescribeClusters",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+			"ecs:UpdateCluster",
+		},
+		OperationDelete: {
+			"ecs:DeleteCluster",
+			"ecs:DescribeClusters",
+		},
+	},
+	"aws_ecs_cluster_capacity_providers": {
+		OperationCreate: {
+			"ecs:DescribeClusters",
+			"ecs:PutClusterCapacityProviders",
+		},
+		OperationUpdate: {
+			"ecs:DescribeClusters",
+			"ecs:PutClusterCapacityProviders",
+		},
+		OperationDelete: {
+			"ecs:DescribeClusters",
+			"ecs:PutClusterCapacityProviders",
+		},
+	},
+	"aws_ecs_service": {
+		OperationCreate: {
+			"ecs:CreateService",
+			"ecs:DescribeServices",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:DescribeServices",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+			"ecs:UpdateService",
+		},
+		OperationDelete: {
+			"ecs:DeleteService",
+			"ecs:DescribeServices",
+			"ecs:UpdateService",
+		},
+	},
+	"aws_ecs_tag": {
+		OperationCreate: {
+			"ecs:ListTagsForResource",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:ListTagsForResource",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationDelete: {
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+	},
+	"aws_ecs_task_definition": {
+		OperationCreate: {
+			"ecs:DescribeTaskDefinition",
+			"ecs:RegisterTaskDefinition",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:DescribeTaskDefinition",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationDelete: {
+			"ecs:DeregisterTaskDefinition",
+		},
+	},
+	"aws_ecs_task_set": {
+		OperationCreate: {
+			"ecs:CreateTaskSet",
+			"ecs:DescribeTaskSets",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+		},
+		OperationUpdate: {
+			"ecs:DescribeTaskSets",
+			"ecs:TagResource",
+			"ecs:UntagResource",
+			"ecs:UpdateTaskSet",
+		},
+		OperationDelete: {
+			"ecs:DeleteTaskSet",
+			"ecs:DescribeTaskSets",
+		},
+	},
+	"aws_efs_access_point": {
+		OperationCreate: {
+			"elasticfilesystem:CreateAccessPoint",
+			"elasticfilesystem:DescribeAccessPoints",
+		},
+		OperationUpdate: {
+			"elasticfilesystem:DescribeAccessPoints",
+			"elasticfilesystem:TagResource",
+			"elasticfilesystem:UntagResource",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DeleteAccessPoint",
+			"elasticfilesystem:DescribeAccessPoints",
+		},
+	},
+	"aws_efs_backup_policy": {
+		OperationCreate: {
+			"elasticfilesystem:DescribeBackupPolicy",
+			"elasticfilesystem:PutBackupPolicy",
+		},
+		OperationUpdate: {
+			"elasticfilesystem:DescribeBackupPolicy",
+			"elasticfilesystem:PutBackupPolicy",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DescribeBackupPolicy",
+			"elasticfilesystem:PutBackupPolicy",
+		},
+	},
+	"aws_efs_file_system": {
+		OperationCreate: {
+			"elasticfilesystem:CreateFileSystem",
+			"elasticfilesystem:DescribeFileSystems",
+			"elasticfilesystem:DescribeLifecycleConfiguration",
+			"elasticfilesystem:PutLifecycleConfiguration",
+			"elasticfilesystem:UpdateFileSystemProtection",
+		},
+		OperationUpdate: {
+			"elasticfilesystem:DescribeFileSystems",
+			"elasticfilesystem:DescribeLifecycleConfiguration",
+			"elasticfilesystem:PutLifecycleConfiguration",
+			"elasticfilesystem:TagResource",
+			"elasticfilesystem:UntagResource",
+			"elasticfilesystem:UpdateFileSystem",
+			"elasticfilesystem:UpdateFileSystemProtection",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DeleteFileSystem",
+			"elasticfilesystem:DescribeFileSystems",
+		},
+	},
+	"aws_efs_file_system_policy": {
+		OperationCreate: {
+			"elasticfilesystem:DescribeFileSystemPolicy",
+			"elasticfilesystem:PutFileSystemPolicy",
+		},
+		OperationUpdate: {
+			"elasticfilesystem:DescribeFileSystemPolicy",
+			"elasticfilesystem:PutFileSystemPolicy",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DeleteFileSystemPolicy",
+		},
+	},
+	"aws_efs_mount_target": {
+		OperationCreate: {
+			"elasticfilesystem:CreateMountTarget",
+			"elasticfilesystem:DescribeMountTargetSecurityGroups",
+			"elasticfilesystem:DescribeMountTargets",
+		},
+		OperationUpdate: {
+			"elasticfilesystem:DescribeMountTargetSecurityGroups",
+			"elasticfilesystem:DescribeMountTargets",
+			"elasticfilesystem:ModifyMountTargetSecurityGroups",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DeleteMountTarget",
+			"elasticfilesystem:DescribeMountTargets",
+		},
+	},
+	"aws_efs_replication_configuration": {
+		OperationCreate: {
+			"elasticfilesystem:CreateReplicationConfiguration",
+			"elasticfilesystem:DescribeReplicationConfigurations",
+		},
+		OperationDelete: {
+			"elasticfilesystem:DeleteReplicationConfiguration",
+			"elasticfilesystem:DescribeReplicationConfigurations",
+		},
+	},
+	"aws_egress_only_internet_gateway": {
+		OperationCreate: {
+			"ec2:CreateEgressOnlyInternetGateway",
+			"ec2:DescribeEgressOnlyInternetGateways",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeEgressOnlyInternetGateways",
+		},
+		OperationDelete: {
+			"ec2:DeleteEgressOnlyInternetGateway",
+		},
+	},
+	"aws_eip": {
+		OperationCreate: {
+			"ec2:AllocateAddress",
+			"ec2:AssociateAddress",
+			"ec2:DescribeAddresses",
+			"ec2:DescribeAddressesAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssociateAddress",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeAddresses",
+			"ec2:DescribeAddressesAttribute",
+			"ec2:DisassociateAddress",
+		},
+		OperationDelete: {
+			"ec2:DisassociateAddress",
+			"ec2:ReleaseAddress",
+		},
+	},
+	"aws_eip_association": {
+		OperationCreate: {
+			"ec2:AssociateAddress",
+			"ec2:DescribeAddresses",
+		},
+		OperationDelete: {
+			"ec2:DisassociateAddress",
+		},
+	},
+	"aws_eip_domain_name": {
+		OperationCreate: {
+			"ec2:DescribeAddressesAttribute",
+			"ec2:ModifyAddressAttribute",
+		},
+		OperationUpdate: {
+			"ec2:DescribeAddressesAttribute",
+			"ec2:ModifyAddressAttribute",
+		},
+		OperationDelete: {
+			"ec2:DescribeAddressesAttribute",
+			"ec2:ResetAddressAttribute",
+		},
+	},
+	"aws_eip_transfer_acceptance": {
+		OperationCreate: {
+			"ec2:AcceptAddressTransfer",
+			"ec2:DescribeAddresses",
+		},
+		OperationDelete: {
+			"ec2:DescribeAddresses",
+			"ec2:ReleaseAddress",
+		},
+	},
+	"aws_eks_access_entry": {
+		OperationCreate: {
+			"eks:CreateAccessEntry",
+			"eks:DescribeAccessEntry",
+		},
+		OperationUpdate: {
+			"eks:DescribeAccessEntry",
+			"eks:TagResource",
+			"eks:UntagResource",
+			"eks:UpdateAccessEntry",
+		},
+		OperationDelete: {
+			"eks:DeleteAccessEntry",
+		},
+	},
+	"aws_eks_access_policy_association": {
+		OperationCreate: {
+			"eks:AssociateAccessPolicy",
+			"eks:ListAssociatedAccessPolicies",
+		},
+		OperationDelete: {
+			"eks:DisassociateAccessPolicy",
+		},
+	},
+	"aws_eks_addon": {
+		OperationCreate: {
+			"eks:CreateAddon",
+			"eks:DescribeAddon",
+		},
+		OperationUpdate: {
+			"eks:DescribeAddon",
+			"eks:DescribeUpdate",
+			"eks:TagResource",
+			"eks:UntagResource",
+			"eks:UpdateAddon",
+		},
+		OperationDelete: {
+			"eks:DeleteAddon",
+			"eks:DescribeAddon",
+		},
+	},
+	"aws_eks_cluster": {
+		OperationCreate: {
+			"eks:CreateCluster",
+			"eks:DescribeCluster",
+		},
+		OperationUpdate: {
+			"eks:AssociateEncryptionConfig",
+			"eks:DescribeCluster",
+			"eks:DescribeUpdate",
+			"eks:TagResource",
+			"eks:UntagResource",
+			"eks:UpdateClusterConfig",
+			"eks:UpdateClusterVersion",
+		},
+		OperationDelete: {
+			"eks:DeleteCluster",
+			"eks:DescribeCluster",
+		},
+	},
+	"aws_eks_fargate_profile": {
+		OperationCreate: {
+			"eks:CreateFargateProfile",
+			"eks:DescribeFargateProfile",
+		},
+		OperationUpdate: {
+			"eks:DescribeFargateProfile",
+			"eks:TagResource",
+			"eks:UntagResource",
+		},
+		OperationDelete: {
+			"eks:DeleteFargateProfile",
+			"eks:DescribeFargateProfile",
+		},
+	},
+	"aws_eks_identity_provider_config": {
+		OperationCreate: {
+			"eks:AssociateIdentityProviderConfig",
+			"eks:DescribeIdentityProviderConfig",
+		},
+		OperationUpdate: {
+			"eks:DescribeIdentityProviderConfig",
+			"eks:TagResource",
+			"eks:UntagResource",
+		},
+		OperationDelete: {
+			"eks:DescribeIdentityProviderConfig",
+			"eks:DisassociateIdentityProviderConfig",
+		},
+	},
+	"aws_eks_node_group": {
+		OperationCreate: {
+			"eks:CreateNodegroup",
+			"eks:DescribeAutoScalingGroups",
+			"eks:DescribeNodegroup",
+		},
+		OperationUpdate: {
+			"eks:DescribeAutoScalingGroups",
+			"eks:DescribeNodegroup",
+			"eks:DescribeUpdate",
+			"eks:TagResource",
+			"eks:UntagResource",
+			"eks:UpdateNodegroupConfig",
+			"eks:UpdateNodegroupVersion",
+		},
+		OperationDelete: {
+			"eks:DeleteNodegroup",
+			"eks:DescribeNodegroup",
+		},
+	},
+	"aws_eks_pod_identity_association": {
+		OperationCreate: {
+			"eks:CreatePodIdentityAssociation",
+		},
+		OperationUpdate: {
+			"eks:TagResource",
+			"eks:UntagResource",
+			"eks:UpdatePodIdentityAssociation",
+		},
+		OperationDelete: {
+			"eks:DeletePodIdentityAssociation",
+		},
+	},
+	"aws_elastic_beanstalk_application": {
+		OperationCreate: {
+			"elasticbeanstalk:CreateApplication",
+			"elasticbeanstalk:DescribeApplications",
+			"elasticbeanstalk:UpdateApplicationResourceLifecycle",
+		},
+		OperationUpdate: {
+			"elasticbeanstalk:DescribeApplications",
+			"elasticbeanstalk:UpdateApplication",
+			"elasticbeanstalk:UpdateApplicationResourceLifecycle",
+			"elasticbeanstalk:UpdateTagsForResource",
+		},
+		OperationDelete: {
+			"elasticbeanstalk:DeleteApplication",
+			"elasticbeanstalk:DescribeApplications",
+		},
+	},
+	"aws_elastic_beanstalk_application_version": {
+		OperationCreate: {
+			"elasticbeanstalk:CreateApplicationVersion",
+			"elasticbeanstalk:DescribeApplicationVersions",
+		},
+		OperationUpdate: {
+			"elasticbeanstalk:DescribeApplicationVersions",
+			"elasticbeanstalk:UpdateApplicationVersion",
+			"elasticbeanstalk:UpdateTagsForResource",
+		},
+		OperationDelete: {
+			"elasticbeanstalk:DeleteApplicationVersion",
+			"elasticbeanstalk:DescribeEnvironments",
+		},
+	},
+	"aws_elastic_beanstalk_configuration_template": {
+		OperationCreate: {
+			"elasticbeanstalk:CreateConfigurationTemplate",
+			"elasticbeanstalk:DescribeConfigurationSettings",
+		},
+		OperationUpdate: {
+			"elasticbeanstalk:DescribeConfigurationSettings",
+			"elasticbeanstalk:UpdateConfigurationTemplate",
+		},
+		OperationDelete: {
+			"elasticbeanstalk:DeleteConfigurationTemplate",
+		},
+	},
+	"aws_elastic_beanstalk_environment": {
+		OperationCreate: {
+			"elasticbeanstalk:CreateEnvironment",
+			"elasticbeanstalk:DescribeConfigurationSettings",
+			"elasticbeanstalk:DescribeEnvironmentResources",
+			"elasticbeanstalk:DescribeEnvironments",
+			"elasticbeanstalk:DescribeEvents",
+		},
+		OperationUpdate: {
+			"elasticbeanstalk:DescribeConfigurationSettings",
+			"elasticbeanstalk:DescribeEnvironmentResources",
+			"elasticbeanstalk:DescribeEnvironments",
+			"elasticbeanstalk:DescribeEvents",
+			"elasticbeanstalk:UpdateEnvironment",
+			"elasticbeanstalk:UpdateTagsForResource",
+		},
+		OperationDelete: {
+			"elasticbeanstalk:DescribeEnvironments",
+			"elasticbeanstalk:TerminateEnvironment",
+		},
+	},
+	"aws_elasticache_cluster": {
+		OperationCreate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:CreateCacheCluster",
+			"elasticache:DescribeCacheClusters",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeCacheClusters",
+			"elasticache:ModifyCacheCluster",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteCacheCluster",
+			"elasticache:DescribeCacheClusters",
+		},
+	},
+	"aws_elasticache_global_replication_group": {
+		OperationCreate: {
+			"elasticache:CreateGlobalReplicationGroup",
+			"elasticache:DecreaseNodeGroupsInGlobalReplicationGroup",
+			"elasticache:DescribeGlobalReplicationGroups",
+			"elasticache:IncreaseNodeGroupsInGlobalReplicationGroup",
+			"elasticache:ModifyGlobalReplicationGroup",
+		},
+		OperationUpdate: {
+			"elasticache:DecreaseNodeGroupsInGlobalReplicationGroup",
+			"elasticache:DescribeGlobalReplicationGroups",
+			"elasticache:IncreaseNodeGroupsInGlobalReplicationGroup",
+			"elasticache:ModifyGlobalReplicationGroup",
+		},
+		OperationDelete: {
+			"elasticache:DeleteGlobalReplicationGroup",
+			"elasticache:DescribeGlobalReplicationGroups",
+		},
+	},
+	"aws_elasticache_parameter_group": {
+		OperationCreate: {
+			"elasticache:CreateCacheParameterGroup",
+			"elasticache:DescribeCacheParameterGroups",
+			"elasticache:DescribeCacheParameters",
+			"elasticache:ModifyCacheParameterGroup",
+			"elasticache:ResetCacheParameterGroup",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeCacheParameterGroups",
+			"elasticache:DescribeCacheParameters",
+			"elasticache:ModifyCacheParameterGroup",
+			"elasticache:RemoveTagsFromResource",
+			"elasticache:ResetCacheParameterGroup",
+		},
+		OperationDelete: {
+			"elasticache:DeleteCacheParameterGroup",
+		},
+	},
+	"aws_elasticache_replication_group": {
+		OperationCreate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:CreateReplicationGroup",
+			"elasticache:DescribeCacheClusters",
+			"elasticache:DescribeGlobalReplicationGroups",
+			"elasticache:DescribeReplicationGroups",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DecreaseReplicaCount",
+			"elasticache:DescribeCacheClusters",
+			"elasticache:DescribeReplicationGroups",
+			"elasticache:IncreaseReplicaCount",
+			"elasticache:ModifyReplicationGroup",
+			"elasticache:ModifyReplicationGroupShardConfiguration",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteCacheParameterGroup",
+			"elasticache:DeleteReplicationGroup",
+			"elasticache:DescribeGlobalReplicationGroups",
+			"elasticache:DescribeReplicationGroups",
+			"elasticache:DisassociateGlobalReplicationGroup",
+		},
+	},
+	"aws_elasticache_serverless_cache": {
+		OperationCreate: {
+			"elasticache:CreateServerlessCache",
+			"elasticache:DescribeServerlessCaches",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeServerlessCaches",
+			"elasticache:ModifyServerlessCache",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteServerlessCache",
+			"elasticache:DescribeServerlessCaches",
+		},
+	},
+	"aws_elasticache_subnet_group": {
+		OperationCreate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:CreateCacheSubnetGroup",
+			"elasticache:DescribeCacheSubnetGroups",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeCacheSubnetGroups",
+			"elasticache:ModifyCacheSubnetGroup",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteCacheSubnetGroup",
+		},
+	},
+	"aws_elasticache_user": {
+		OperationCreate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:CreateUser",
+			"elasticache:DescribeUsers",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeUsers",
+			"elasticache:ModifyUser",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteUser",
+			"elasticache:DescribeUsers",
+		},
+	},
+	"aws_elasticache_user_group": {
+		OperationCreate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:CreateUserGroup",
+			"elasticache:DescribeUserGroups",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"elasticache:AddTagsToResource",
+			"elasticache:DescribeUserGroups",
+			"elasticache:ModifyUserGroup",
+			"elasticache:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"elasticache:DeleteUserGroup",
+			"elasticache:DescribeUserGroups",
+		},
+	},
+	"aws_elasticache_user_group_association": {
+		OperationCreate: {
+			"elasticache:DescribeUserGroups",
+			"elasticache:ModifyUserGroup",
+		},
+		OperationDelete: {
+			"elasticache:DescribeUserGroups",
+			"elasticache:ModifyUserGroup",
+		},
+	},
+	"aws_elasticsearch_domain": {
+		OperationCreate: {
+			"es:CreateElasticsearchDomain",
+			"es:DescribeElasticsearchDomain",
+			"es:DescribeElasticsearchDomainConfig",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+		OperationUpdate: {
+			"es:AddTags",
+			"es:DescribeElasticsearchDomain",
+			"es:DescribeElasticsearchDomainConfig",
+			"es:GetUpgradeStatus",
+			"es:RemoveTags",
+			"es:UpdateElasticsearchDomainConfig",
+			"es:UpgradeElasticsearchDomain",
+		},
+		OperationDelete: {
+			"es:DeleteElasticsearchDomain",
+			"es:DescribeElasticsearchDomain",
+			"es:DescribeElasticsearchDomainConfig",
+		},
+	},
+	"aws_elasticsearch_domain_policy": {
+		OperationCreate: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+		OperationUpdate: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+		OperationDelete: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+	},
+	"aws_elasticsearch_domain_saml_options": {
+		OperationCreate: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+		OperationUpdate: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+		OperationDelete: {
+			"es:DescribeElasticsearchDomain",
+			"es:UpdateElasticsearchDomainConfig",
+		},
+	},
+	"aws_elasticsearch_vpc_endpoint": {
+		OperationCreate: {
+			"es:CreateVpcEndpoint",
+			"es:DescribeVpcEndpoints",
+		},
+		OperationUpdate: {
+			"es:DescribeVpcEndpoints",
+			"es:UpdateVpcEndpoint",
+		},
+		OperationDelete: {
+			"es:DeleteVpcEndpoint",
+			"es:DescribeVpcEndpoints",
+		},
+	},
+	"aws_elastictranscoder_pipeline": {
+		OperationCreate: {
+			"elastictranscoder:CreatePipeline",
+			"elastictranscoder:ReadPipeline",
+		},
+		OperationUpdate: {
+			"elastictranscoder:ReadPipeline",
+			"elastictranscoder:UpdatePipeline",
+		},
+		OperationDelete: {
+			"elastictranscoder:DeletePipeline",
+		},
+	},
+	"aws_elastictranscoder_preset": {
+		OperationCreate: {
+			"elastictranscoder:CreatePreset",
+			"elastictranscoder:ReadPreset",
+		},
+		OperationDelete: {
+			"elastictranscoder:DeletePreset",
+		},
+	},
+	"aws_elb": {
+		OperationCreate: {
+			"elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+			"elasticloadbalancing:AttachLoadBalancerToSubnets",
+			"elasticloadbalancing:ConfigureHealthCheck",
+			"elasticloadbalancing:CreateLoadBalancer",
+			"elasticloadbalancing:CreateLoadBalancerListeners",
+			"elasticloadbalancing:DeleteLoadBalancerListeners",
+			"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:DetachLoadBalancerFromSubnets",
+			"elasticloadbalancing:DisableAvailabilityZonesForLoadBalancer",
+			"elasticloadbalancing:EnableAvailabilityZonesForLoadBalancer",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:ApplySecurityGroupsToLoadBalancer",
+			"elasticloadbalancing:AttachLoadBalancerToSubnets",
+			"elasticloadbalancing:ConfigureHealthCheck",
+			"elasticloadbalancing:CreateLoadBalancerListeners",
+			"elasticloadbalancing:DeleteLoadBalancerListeners",
+			"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:DetachLoadBalancerFromSubnets",
+			"elasticloadbalancing:DisableAvailabilityZonesForLoadBalancer",
+			"elasticloadbalancing:EnableAvailabilityZonesForLoadBalancer",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancer",
+		},
+	},
+	"aws_elb_attachment": {
+		OperationCreate: {
+			"elasticloadbalancing:RegisterInstancesWithLoadBalancer",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeregisterInstancesFromLoadBalancer",
+		},
+	},
+	"aws_emr_block_public_access_configuration": {
+		OperationCreate: {
+			"elasticmapreduce:GetBlockPublicAccessConfiguration",
+			"elasticmapreduce:PutBlockPublicAccessConfiguration",
+		},
+		OperationDelete: {
+			"elasticmapreduce:PutBlockPublicAccessConfiguration",
+		},
+	},
+	"aws_emr_cluster": {
+		OperationCreate: {
+			"elasticmapreduce:DescribeCluster",
+			"elasticmapreduce:GetAutoTerminationPolicy",
+			"elasticmapreduce:ListBootstrapActions",
+			"elasticmapreduce:ListInstanceFleets",
+			"elasticmapreduce:ListInstanceGroups",
+			"elasticmapreduce:ListSteps",
+			"elasticmapreduce:RunJobFlow",
+			"elasticmapreduce:SetTerminationProtection",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:AddTags",
+			"elasticmapreduce:DescribeCluster",
+			"elasticmapreduce:GetAutoTerminationPolicy",
+			"elasticmapreduce:ListBootstrapActions",
+			"elasticmapreduce:ListInstanceFleets",
+			"elasticmapreduce:ListInstanceGroups",
+			"elasticmapreduce:ListSteps",
+			"elasticmapreduce:ModifyCluster",
+			"elasticmapreduce:ModifyInstanceGroups",
+			"elasticmapreduce:PutAutoScalingPolicy",
+			"elasticmapreduce:PutAutoTerminationPolicy",
+			"elasticmapreduce:RemoveAutoScalingPolicy",
+			"elasticmapreduce:RemoveAutoTerminationPolicy",
+			"elasticmapreduce:RemoveTags",
+			"elasticmapreduce:SetTerminationProtection",
+			"elasticmapreduce:SetUnhealthyNodeReplacement",
+			"elasticmapreduce:SetVisibleToAllUsers",
+		},
+		OperationDelete: {
+			"elasticmapreduce:DescribeCluster",
+			"elasticmapreduce:TerminateJobFlows",
+		},
+	},
+	"aws_emr_instance_fleet": {
+		OperationCreate: {
+			"elasticmapreduce:AddInstanceFleet",
+			"elasticmapreduce:ListInstanceFleets",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:ListInstanceFleets",
+			"elasticmapreduce:ModifyInstanceFleet",
+		},
+		OperationDelete: {
+			"elasticmapreduce:ModifyInstanceFleet",
+		},
+	},
+	"aws_emr_instance_group": {
+		OperationCreate: {
+			"elasticmapreduce:AddInstanceGroups",
+			"elasticmapreduce:ListInstanceGroups",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:ListInstanceGroups",
+			"elasticmapreduce:ModifyInstanceGroups",
+			"elasticmapreduce:PutAutoScalingPolicy",
+		},
+		OperationDelete: {
+			"elasticmapreduce:ModifyInstanceGroups",
+		},
+	},
+	"aws_emr_managed_scaling_policy": {
+		OperationCreate: {
+			"elasticmapreduce:PutManagedScalingPolicy",
+		},
+		OperationDelete: {
+			"elasticmapreduce:RemoveManagedScalingPolicy",
+		},
+	},
+	"aws_emr_security_configuration": {
+		OperationCreate: {
+			"elasticmapreduce:CreateSecurityConfiguration",
+			"elasticmapreduce:DescribeSecurityConfiguration",
+		},
+		OperationDelete: {
+			"elasticmapreduce:DeleteSecurityConfiguration",
+		},
+	},
+	"aws_emr_studio": {
+		OperationCreate: {
+			"elasticmapreduce:CreateStudio",
+			"elasticmapreduce:DescribeStudio",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:AddTags",
+			"elasticmapreduce:DescribeStudio",
+			"elasticmapreduce:RemoveTags",
+			"elasticmapreduce:UpdateStudio",
+		},
+		OperationDelete: {
+			"elasticmapreduce:DeleteStudio",
+		},
+	},
+	"aws_emr_studio_session_mapping": {
+		OperationCreate: {
+			"elasticmapreduce:CreateStudioSessionMapping",
+			"elasticmapreduce:GetStudioSessionMapping",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:GetStudioSessionMapping",
+			"elasticmapreduce:UpdateStudioSessionMapping",
+		},
+		OperationDelete: {
+			"elasticmapreduce:DeleteStudioSessionMapping",
+		},
+	},
+	"aws_emr_studio_session_mappings": {
+		OperationCreate: {
+			"elasticmapreduce:CreateStudioSessionMapping",
+			"elasticmapreduce:ListStudioSessionMappings",
+		},
+		OperationUpdate: {
+			"elasticmapreduce:CreateStudioSessionMapping",
+			"elasticmapreduce:DeleteStudioSessionMapping",
+			"elasticmapreduce:ListStudioSessionMappings",
+			"elasticmapreduce:UpdateStudioSessionMapping",
+		},
+		OperationDelete: {
+			"elasticmapreduce:DeleteStudioSessionMapping",
+		},
+	},
+	"aws_emrcontainers_job_template": {
+		OperationCreate: {
+			"emr-containers:CreateJobTemplate",
+			"emr-containers:DescribeJobTemplate",
+		},
+		OperationDelete: {
+			"emr-containers:DeleteJobTemplate",
+		},
+	},
+	"aws_emrcontainers_virtual_cluster": {
+		OperationCreate: {
+			"emr-containers:CreateVirtualCluster",
+			"emr-containers:DescribeVirtualCluster",
+		},
+		OperationUpdate: {
+			"emr-containers:DescribeVirtualCluster",
+			"emr-containers:TagResource",
+			"emr-containers:UntagResource",
+		},
+		OperationDelete: {
+			"emr-containers:DeleteVirtualCluster",
+			"emr-containers:DescribeVirtualCluster",
+		},
+	},
+	"aws_emrserverless_application": {
+		OperationCreate: {
+			"emr-serverless:CreateApplication",
+			"emr-serverless:GetApplication",
+		},
+		OperationUpdate: {
+			"emr-serverless:GetApplication",
+			"emr-serverless:TagResource",
+			"emr-serverless:UntagResource",
+			"emr-serverless:UpdateApplication",
+		},
+		OperationDelete: {
+			"emr-serverless:DeleteApplication",
+			"emr-serverless:GetApplication",
+		},
+	},
+	"aws_evidently_feature": {
+		OperationCreate: {
+			"evidently:CreateFeature",
+			"evidently:GetFeature",
+		},
+		OperationUpdate: {
+			"evidently:GetFeature",
+			"evidently:TagResource",
+			"evidently:UntagResource",
+			"evidently:UpdateFeature",
+		},
+		OperationDelete: {
+			"evidently:DeleteFeature",
+			"evidently:GetFeature",
+		},
+	},
+	"aws_evidently_launch": {
+		OperationCreate: {
+			"evidently:CreateLaunch",
+			"evidently:GetLaunch",
+		},
+		OperationUpdate: {
+			"evidently:GetLaunch",
+			"evidently:TagResource",
+			"evidently:UntagResource",
+			"evidently:UpdateLaunch",
+		},
+		OperationDelete: {
+			"evidently:DeleteLaunch",
+			"evidently:GetLaunch",
+		},
+	},
+	"aws_evidently_project": {
+		OperationCreate: {
+			"evidently:CreateProject",
+			"evidently:GetProject",
+		},
+		OperationUpdate: {
+			"evidently:GetProject",
+			"evidently:TagResource",
+			"evidently:UntagResource",
+			"evidently:UpdateProject",
+			"evidently:UpdateProjectDataDelivery",
+		},
+		OperationDelete: {
+			"evidently:DeleteProject",
+			"evidently:GetProject",
+		},
+	},
+	"aws_evidently_segment": {
+		OperationCreate: {
+			"evidently:CreateSegment",
+			"evidently:GetSegment",
+		},
+		OperationUpdate: {
+			"evidently:GetSegment",
+			"evidently:TagResource",
+			"evidently:UntagResource",
+		},
+		OperationDelete: {
+			"evidently:DeleteSegment",
+		},
+	},
+	"aws_finspace_kx_cluster": {
+		OperationCreate: {
+			"finspace:CreateKxCluster",
+			"finspace:GetKxCluster",
+			"finspace:GetKxEnvironment",
+		},
+		OperationUpdate: {
+			"finspace:GetKxCluster",
+			"finspace:GetKxEnvironment",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxClusterCodeConfiguration",
+			"finspace:UpdateKxClusterDatabases",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxCluster",
+			"finspace:GetKxCluster",
+		},
+	},
+	"aws_finspace_kx_database": {
+		OperationCreate: {
+			"finspace:CreateKxDatabase",
+			"finspace:GetKxDatabase",
+		},
+		OperationUpdate: {
+			"finspace:GetKxDatabase",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxDatabase",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxDatabase",
+		},
+	},
+	"aws_finspace_kx_dataview": {
+		OperationCreate: {
+			"finspace:CreateKxDataview",
+			"finspace:GetKxDataview",
+		},
+		OperationUpdate: {
+			"finspace:GetKxDataview",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxDataview",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxDataview",
+			"finspace:GetKxDataview",
+		},
+	},
+	"aws_finspace_kx_environment": {
+		OperationCreate: {
+			"finspace:CreateKxEnvironment",
+			"finspace:GetKxEnvironment",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+		},
+		OperationUpdate: {
+			"finspace:GetKxEnvironment",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxEnvironment",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxEnvironment",
+			"finspace:GetKxEnvironment",
+		},
+	},
+	"aws_finspace_kx_scaling_group": {
+		OperationCreate: {
+			"finspace:CreateKxScalingGroup",
+			"finspace:GetKxScalingGroup",
+		},
+		OperationUpdate: {
+			"finspace:GetKxScalingGroup",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxScalingGroup",
+			"finspace:GetKxScalingGroup",
+		},
+	},
+	"aws_finspace_kx_user": {
+		OperationCreate: {
+			"finspace:GetKxUser",
+		},
+		OperationUpdate: {
+			"finspace:GetKxUser",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxUser",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxUser",
+		},
+	},
+	"aws_finspace_kx_volume": {
+		OperationCreate: {
+			"finspace:CreateKxVolume",
+			"finspace:GetKxVolume",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+		},
+		OperationUpdate: {
+			"finspace:GetKxVolume",
+			"finspace:TagResource",
+			"finspace:UntagResource",
+			"finspace:UpdateKxVolume",
+		},
+		OperationDelete: {
+			"finspace:DeleteKxVolume",
+			"finspace:GetKxVolume",
+		},
+	},
+	"aws_fis_experiment_template": {
+		OperationCreate: {
+			"fis:CreateExperimentTemplate",
+			"fis:GetExperimentTemplate",
+		},
+		OperationUpdate: {
+			"fis:GetExperimentTemplate",
+			"fis:TagResource",
+			"fis:UntagResource",
+			"fis:UpdateExperimentTemplate",
+		},
+		OperationDelete: {
+			"fis:DeleteExperimentTemplate",
+		},
+	},
+	"aws_flow_log": {
+		OperationCreate: {
+			"ec2:CreateFlowLogs",
+			"ec2:DescribeFlowLogs",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeFlowLogs",
+		},
+		OperationDelete: {
+			"ec2:DeleteFlowLogs",
+		},
+	},
+	"aws_fms_admin_account": {
+		OperationCreate: {
+			"fms:AssociateAdminAccount",
+			"fms:GetAdminAccount",
+		},
+		OperationDelete: {
+			"fms:DisassociateAdminAccount",
+			"fms:GetAdminAccount",
+		},
+	},
+	"aws_fms_policy": {
+		OperationCreate: {
+			"fms:GetPolicy",
+			"fms:PutPolicy",
+		},
+		OperationUpdate: {
+			"fms:GetPolicy",
+			"fms:PutPolicy",
+			"fms:TagResource",
+			"fms:UntagResource",
+		},
+		OperationDelete: {
+			"fms:DeletePolicy",
+		},
+	},
+	"aws_fsx_backup": {
+		OperationCreate: {
+			"fsx:CreateBackup",
+			"fsx:DescribeBackups",
+		},
+		OperationUpdate: {
+			"fsx:DescribeBackups",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+		},
+		OperationDelete: {
+			"fsx:DeleteBackup",
+			"fsx:DescribeBackups",
+		},
+	},
+	"aws_fsx_data_repository_association": {
+		OperationCreate: {
+			"fsx:CreateDataRepositoryAssociation",
+			"fsx:DescribeDataRepositoryAssociations",
+		},
+		OperationUpdate: {
+			"fsx:DescribeDataRepositoryAssociations",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateDataRepositoryAssociation",
+		},
+		OperationDelete: {
+			"fsx:DeleteDataRepositoryAssociation",
+			"fsx:DescribeDataRepositoryAssociations",
+		},
+	},
+	"aws_fsx_file_cache": {
+		OperationCreate: {
+			"fsx:CreateFileCache",
+			"fsx:DescribeDataRepositoryAssociations",
+			"fsx:DescribeFileCaches",
+		},
+		OperationUpdate: {
+			"fsx:DescribeDataRepositoryAssociations",
+			"fsx:DescribeFileCaches",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateFileCache",
+		},
+		OperationDelete: {
+			"fsx:DeleteFileCache",
+			"fsx:DescribeFileCaches",
+		},
+	},
+	"aws_fsx_lustre_file_system": {
+		OperationCreate: {
+			"fsx:CreateFileSystem",
+			"fsx:CreateFileSystemFromBackup",
+			"fsx:DescribeFileSystems",
+		},
+		OperationUpdate: {
+			"fsx:DescribeFileSystems",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateFileSystem",
+		},
+		OperationDelete: {
+			"fsx:DeleteFileSystem",
+			"fsx:DescribeFileSystems",
+		},
+	},
+	"aws_fsx_ontap_file_system": {
+		OperationCreate: {
+			"fsx:CreateFileSystem",
+			"fsx:DescribeFileSystems",
+		},
+		OperationUpdate: {
+			"fsx:DescribeFileSystems",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateFileSystem",
+		},
+		OperationDelete: {
+			"fsx:DeleteFileSystem",
+			"fsx:DescribeFileSystems",
+		},
+	},
+	"aws_fsx_ontap_storage_virtual_machine": {
+		OperationCreate: {
+			"fsx:CreateStorageVirtualMachine",
+			"fsx:DescribeStorageVirtualMachines",
+		},
+		OperationUpdate: {
+			"fsx:DescribeStorageVirtualMachines",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateStorageVirtualMachine",
+		},
+		OperationDelete: {
+			"fsx:DeleteStorageVirtualMachine",
+			"fsx:DescribeStorageVirtualMachines",
+		},
+	},
+	"aws_fsx_ontap_volume": {
+		OperationCreate: {
+			"fsx:CreateVolume",
+			"fsx:DescribeVolumes",
+		},
+		OperationUpdate: {
+			"fsx:DescribeVolumes",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateVolume",
+		},
+		OperationDelete: {
+			"fsx:DeleteVolume",
+			"fsx:DescribeVolumes",
+		},
+	},
+	"aws_fsx_openzfs_file_system": {
+		OperationCreate: {
+			"fsx:CreateFileSystem",
+			"fsx:CreateFileSystemFromBackup",
+			"fsx:DescribeFileSystems",
+			"fsx:DescribeVolumes",
+		},
+		OperationUpdate: {
+			"fsx:DescribeFileSystems",
+			"fsx:DescribeVolumes",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateFileSystem",
+			"fsx:UpdateVolume",
+		},
+		OperationDelete: {
+			"fsx:DeleteFileSystem",
+			"fsx:DescribeFileSystems",
+		},
+	},
+	"aws_fsx_openzfs_snapshot": {
+		OperationCreate: {
+			"fsx:CreateSnapshot",
+			"fsx:DescribeSnapshots",
+		},
+		OperationUpdate: {
+			"fsx:DescribeSnapshots",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateSnapshot",
+		},
+		OperationDelete: {
+			"fsx:DeleteSnapshot",
+			"fsx:DescribeSnapshots",
+		},
+	},
+	"aws_fsx_openzfs_volume": {
+		OperationCreate: {
+			"fsx:CreateVolume",
+			"fsx:DescribeVolumes",
+		},
+		OperationUpdate: {
+			"fsx:DescribeVolumes",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateVolume",
+		},
+		OperationDelete: {
+			"fsx:DeleteVolume",
+			"fsx:DescribeVolumes",
+		},
+	},
+	"aws_fsx_windows_file_system": {
+		OperationCreate: {
+			"fsx:CreateFileSystem",
+			"fsx:CreateFileSystemFromBackup",
+			"fsx:DescribeFileSystems",
+		},
+		OperationUpdate: {
+			"fsx:AssociateFileSystemAliases",
+			"fsx:DescribeFileSystems",
+			"fsx:DisassociateFileSystemAliases",
+			"fsx:TagResource",
+			"fsx:UntagResource",
+			"fsx:UpdateFileSystem",
+		},
+		OperationDelete: {
+			"fsx:DeleteFileSystem",
+			"fsx:DescribeFileSystems",
+		},
+	},
+	"aws_gamelift_alias": {
+		OperationCreate: {
+			"gamelift:CreateAlias",
+			"gamelift:DescribeAlias",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeAlias",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateAlias",
+		},
+		OperationDelete: {
+			"gamelift:DeleteAlias",
+		},
+	},
+	"aws_gamelift_build": {
+		OperationCreate: {
+			"gamelift:CreateBuild",
+			"gamelift:DescribeBuild",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeBuild",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateBuild",
+		},
+		OperationDelete: {
+			"gamelift:DeleteBuild",
+		},
+	},
+	"aws_gamelift_container_fleet": {
+		OperationCreate: {
+			"gamelift:CreateContainerFleet",
+			"gamelift:DescribeContainerFleet",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeContainerFleet",
+			"gamelift:DescribeFleetDeployment",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateContainerFleet",
+		},
+		OperationDelete: {
+			"gamelift:DeleteContainerFleet",
+			"gamelift:DescribeContainerFleet",
+		},
+	},
+	"aws_gamelift_container_group_definition": {
+		OperationCreate: {
+			"gamelift:CreateContainerGroupDefinition",
+			"gamelift:DescribeContainerGroupDefinition",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeContainerGroupDefinition",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateContainerGroupDefinition",
+		},
+		OperationDelete: {
+			"gamelift:DeleteContainerGroupDefinition",
+		},
+	},
+	"aws_gamelift_fleet": {
+		OperationCreate: {
+			"gamelift:CreateFleet",
+			"gamelift:DescribeFleetAttributes",
+			"gamelift:DescribeFleetPortSettings",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeFleetAttributes",
+			"gamelift:DescribeFleetPortSettings",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateFleetAttributes",
+			"gamelift:UpdateFleetPortSettings",
+			"gamelift:UpdateRuntimeConfiguration",
+		},
+		OperationDelete: {
+			"gamelift:DeleteFleet",
+			"gamelift:DescribeFleetAttributes",
+			"gamelift:DescribeFleetEvents",
+		},
+	},
+	"aws_gamelift_game_server_group": {
+		OperationCreate: {
+			"gamelift:CreateGameServerGroup",
+			"gamelift:DescribeGameServerGroup",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeGameServerGroup",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateGameServerGroup",
+		},
+		OperationDelete: {
+			"gamelift:DeleteGameServerGroup",
+			"gamelift:DescribeGameServerGroup",
+		},
+	},
+	"aws_gamelift_game_session_queue": {
+		OperationCreate: {
+			"gamelift:CreateGameSessionQueue",
+			"gamelift:DescribeGameSessionQueues",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeGameSessionQueues",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateGameSessionQueue",
+		},
+		OperationDelete: {
+			"gamelift:DeleteGameSessionQueue",
+			"gamelift:DescribeGameSessionQueues",
+		},
+	},
+	"aws_gamelift_script": {
+		OperationCreate: {
+			"gamelift:CreateScript",
+			"gamelift:DescribeScript",
+		},
+		OperationUpdate: {
+			"gamelift:DescribeScript",
+			"gamelift:TagResource",
+			"gamelift:UntagResource",
+			"gamelift:UpdateScript",
+		},
+		OperationDelete: {
+			"gamelift:DeleteScript",
+		},
+	},
+	"aws_glacier_vault": {
+		OperationCreate: {
+			"glacier:AddTagsToVault",
+			"glacier:CreateVault",
+			"glacier:DescribeVault",
+			"glacier:GetVaultAccessPolicy",
+			"glacier:GetVaultNotifications",
+			"glacier:RemoveTagsFromVault",
+			"glacier:SetVaultAccessPolicy",
+			"glacier:SetVaultNotifications",
+		},
+		OperationUpdate: {
+			"glacier:AddTagsToVault",
+			"glacier:DeleteVaultAccessPolicy",
+			"glacier:DeleteVaultNotifications",
+			"glacier:DescribeVault",
+			"glacier:GetVaultAccessPolicy",
+			"glacier:GetVaultNotifications",
+			"glacier:RemoveTagsFromVault",
+			"glacier:SetVaultAccessPolicy",
+			"glacier:SetVaultNotifications",
+		},
+		OperationDelete: {
+			"glacier:DeleteVault",
+		},
+	},
+	"aws_glacier_vault_lock": {
+		OperationCreate: {
+			"glacier:CompleteVaultLock",
+			"glacier:GetVaultLock",
+			"glacier:InitiateVaultLock",
+		},
+		OperationDelete: {
+			"glacier:AbortVaultLock",
+		},
+	},
+	"aws_globalaccelerator_accelerator": {
+		OperationCreate: {
+			"globalaccelerator:CreateAccelerator",
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeAcceleratorAttributes",
+			"globalaccelerator:UpdateAcceleratorAttributes",
+		},
+		OperationUpdate: {
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeAcceleratorAttributes",
+			"globalaccelerator:TagResource",
+			"globalaccelerator:UntagResource",
+			"globalaccelerator:UpdateAccelerator",
+			"globalaccelerator:UpdateAcceleratorAttributes",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteAccelerator",
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:UpdateAccelerator",
+		},
+	},
+	"aws_globalaccelerator_cross_account_attachment": {
+		OperationCreate: {
+			"globalaccelerator:CreateCrossAccountAttachment",
+		},
+		OperationUpdate: {
+			"globalaccelerator:TagResource",
+			"globalaccelerator:UntagResource",
+			"globalaccelerator:UpdateCrossAccountAttachment",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteCrossAccountAttachment",
+		},
+	},
+	"aws_globalaccelerator_custom_routing_accelerator": {
+		OperationCreate: {
+			"globalaccelerator:CreateCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingAcceleratorAttributes",
+			"globalaccelerator:UpdateAcceleratorAttributes",
+		},
+		OperationUpdate: {
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingAcceleratorAttributes",
+			"globalaccelerator:TagResource",
+			"globalaccelerator:UntagResource",
+			"globalaccelerator:UpdateCustomRoutingAccelerator",
+			"globalaccelerator:UpdateCustomRoutingAcceleratorAttributes",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:UpdateCustomRoutingAccelerator",
+		},
+	},
+	"aws_globalaccelerator_custom_routing_endpoint_group": {
+		OperationCreate: {
+			"globalaccelerator:AddCustomRoutingEndpoints",
+			"globalaccelerator:CreateCustomRoutingEndpointGroup",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingEndpointGroup",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteCustomRoutingEndpointGroup",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+		},
+	},
+	"aws_globalaccelerator_custom_routing_endpoint_traffic": {
+		OperationCreate: {
+			"globalaccelerator:AllowCustomRoutingTraffic",
+			"globalaccelerator:DescribeCustomRoutingEndpointGroup",
+			"globalaccelerator:ListCustomRoutingPortMappings",
+		},
+		OperationUpdate: {
+			"globalaccelerator:AllowCustomRoutingTraffic",
+			"globalaccelerator:DenyCustomRoutingTraffic",
+			"globalaccelerator:DescribeCustomRoutingEndpointGroup",
+			"globalaccelerator:ListCustomRoutingPortMappings",
+		},
+		OperationDelete: {
+			"globalaccelerator:DenyCustomRoutingTraffic",
+		},
+	},
+	"aws_globalaccelerator_custom_routing_listener": {
+		OperationCreate: {
+			"globalaccelerator:CreateCustomRoutingListener",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingListener",
+		},
+		OperationUpdate: {
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+			"globalaccelerator:DescribeCustomRoutingListener",
+			"globalaccelerator:UpdateCustomRoutingListener",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteCustomRoutingListener",
+			"globalaccelerator:DescribeCustomRoutingAccelerator",
+		},
+	},
+	"aws_globalaccelerator_endpoint_group": {
+		OperationCreate: {
+			"globalaccelerator:CreateEndpointGroup",
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeEndpointGroup",
+		},
+		OperationUpdate: {
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeEndpointGroup",
+			"globalaccelerator:UpdateEndpointGroup",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteEndpointGroup",
+			"globalaccelerator:DescribeAccelerator",
+		},
+	},
+	"aws_globalaccelerator_listener": {
+		OperationCreate: {
+			"globalaccelerator:CreateListener",
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeListener",
+		},
+		OperationUpdate: {
+			"globalaccelerator:DescribeAccelerator",
+			"globalaccelerator:DescribeListener",
+			"globalaccelerator:UpdateListener",
+		},
+		OperationDelete: {
+			"globalaccelerator:DeleteListener",
+			"globalaccelerator:DescribeAccelerator",
+		},
+	},
+	"aws_glue_catalog_database": {
+		OperationCreate: {
+			"glue:CreateDatabase",
+			"glue:GetDatabase",
+		},
+		OperationUpdate: {
+			"glue:GetDatabase",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateDatabase",
+		},
+		OperationDelete: {
+			"glue:DeleteDatabase",
+		},
+	},
+	"aws_glue_catalog_table": {
+		OperationCreate: {
+			"glue:CreateTable",
+			"glue:GetPartitionIndexes",
+			"glue:GetTable",
+		},
+		OperationUpdate: {
+			"glue:GetPartitionIndexes",
+			"glue:GetTable",
+			"glue:UpdateTable",
+		},
+		OperationDelete: {
+			"glue:DeleteTable",
+		},
+	},
+	"aws_glue_classifier": {
+		OperationCreate: {
+			"glue:CreateClassifier",
+			"glue:GetClassifier",
+		},
+		OperationUpdate: {
+			"glue:UpdateClassifier",
+		},
+		OperationDelete: {
+			"glue:DeleteClassifier",
+		},
+	},
+	"aws_glue_connection": {
+		OperationCreate: {
+			"glue:CreateConnection",
+			"glue:GetConnection",
+		},
+		OperationUpdate: {
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateConnection",
+		},
+		OperationDelete: {
+			"glue:DeleteConnection",
+		},
+	},
+	"aws_glue_crawler": {
+		OperationCreate: {
+			"glue:GetCrawler",
+		},
+		OperationUpdate: {
+			"glue:GetCrawler",
+			"glue:TagResource",
+			"glue:UntagResource",
+		},
+	},
+	"aws_glue_data_catalog_encryption_settings": {
+		OperationCreate: {
+			"glue:GetDataCatalogEncryptionSettings",
+			"glue:PutDataCatalogEncryptionSettings",
+		},
+		OperationUpdate: {
+			"glue:GetDataCatalogEncryptionSettings",
+			"glue:PutDataCatalogEncryptionSettings",
+		},
+		OperationDelete: {
+			"glue:PutDataCatalogEncryptionSettings",
+		},
+	},
+	"aws_glue_data_quality_ruleset": {
+		OperationCreate: {
+			"glue:CreateDataQualityRuleset",
+			"glue:GetDataQualityRuleset",
+		},
+		OperationUpdate: {
+			"glue:GetDataQualityRuleset",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateDataQualityRuleset",
+		},
+		OperationDelete: {
+			"glue:DeleteDataQualityRuleset",
+		},
+	},
+	"aws_glue_dev_endpoint": {
+		OperationCreate: {
+			"glue:CreateDevEndpoint",
+			"glue:GetDevEndpoint",
+		},
+		OperationUpdate: {
+			"glue:GetDevEndpoint",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateDevEndpoint",
+		},
+		OperationDelete: {
+			"glue:DeleteDevEndpoint",
+			"glue:GetDevEndpoint",
+		},
+	},
+	"aws_glue_job": {
+		OperationCreate: {
+			"glue:CreateJob",
+			"glue:GetJob",
+		},
+		OperationUpdate: {
+			"glue:GetJob",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateJob",
+		},
+		OperationDelete: {
+			"glue:DeleteJob",
+		},
+	},
+	"aws_glue_ml_transform": {
+		OperationCreate: {
+			"glue:CreateMLTransform",
+			"glue:GetMLTransform",
+		},
+		OperationUpdate: {
+			"glue:GetMLTransform",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateMLTransform",
+		},
+		OperationDelete: {
+			"glue:DeleteMLTransform",
+			"glue:GetMLTransform",
+		},
+	},
+	"aws_glue_partition": {
+		OperationCreate: {
+			"glue:CreatePartition",
+			"glue:GetPartition",
+		},
+		OperationUpdate: {
+			"glue:GetPartition",
+			"glue:UpdatePartition",
+		},
+		OperationDelete: {
+			"glue:DeletePartition",
+		},
+	},
+	"aws_glue_partition_index": {
+		OperationCreate: {
+			"glue:CreatePartitionIndex",
+			"glue:GetPartitionIndexes",
+		},
+		OperationDelete: {
+			"glue:DeletePartitionIndex",
+			"glue:GetPartitionIndexes",
+		},
+	},
+	"aws_glue_registry": {
+		OperationCreate: {
+			"glue:CreateRegistry",
+			"glue:GetRegistry",
+		},
+		OperationUpdate: {
+			"glue:GetRegistry",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateRegistry",
+		},
+		OperationDelete: {
+			"glue:DeleteRegistry",
+			"glue:GetRegistry",
+		},
+	},
+	"aws_glue_resource_policy": {
+		OperationDelete: {
+			"glue:DeleteResourcePolicy",
+		},
+	},
+	"aws_glue_schema": {
+		OperationCreate: {
+			"glue:CreateSchema",
+			"glue:GetSchema",
+			"glue:GetSchemaVersion",
+		},
+		OperationUpdate: {
+			"glue:GetSchema",
+			"glue:GetSchemaVersion",
+			"glue:RegisterSchemaVersion",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateSchema",
+		},
+		OperationDelete: {
+			"glue:DeleteSchema",
+			"glue:GetSchema",
+		},
+	},
+	"aws_glue_security_configuration": {
+		OperationCreate: {
+			"glue:CreateSecurityConfiguration",
+			"glue:GetSecurityConfiguration",
+		},
+		OperationDelete: {
+			"glue:DeleteSecurityConfiguration",
+		},
+	},
+	"aws_glue_trigger": {
+		OperationCreate: {
+			"glue:CreateTrigger",
+			"glue:GetTrigger",
+			"glue:StartTrigger",
+		},
+		OperationUpdate: {
+			"glue:GetTrigger",
+			"glue:StartTrigger",
+			"glue:StopTrigger",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateTrigger",
+		},
+		OperationDelete: {
+			"glue:DeleteTrigger",
+			"glue:GetTrigger",
+		},
+	},
+	"aws_glue_user_defined_function": {
+		OperationCreate: {
+			"glue:CreateUserDefinedFunction",
+			"glue:GetUserDefinedFunction",
+		},
+		OperationUpdate: {
+			"glue:GetUserDefinedFunction",
+			"glue:UpdateUserDefinedFunction",
+		},
+		OperationDelete: {
+			"glue:DeleteUserDefinedFunction",
+		},
+	},
+	"aws_glue_workflow": {
+		OperationCreate: {
+			"glue:CreateWorkflow",
+			"glue:GetWorkflow",
+		},
+		OperationUpdate: {
+			"glue:GetWorkflow",
+			"glue:TagResource",
+			"glue:UntagResource",
+			"glue:UpdateWorkflow",
+		},
+		OperationDelete: {
+			"glue:DeleteWorkflow",
+		},
+	},
+	"aws_grafana_license_association": {
+		OperationCreate: {
+			"grafana:AssociateLicense",
+			"grafana:DescribeWorkspace",
+		},
+		OperationDelete: {
+			"grafana:DescribeWorkspace",
+			"grafana:DisassociateLicense",
+		},
+	},
+	"aws_grafana_role_association": {
+		OperationCreate: {
+			"grafana:ListPermissions",
+			"grafana:UpdatePermissions",
+		},
+		OperationUpdate: {
+			"grafana:ListPermissions",
+			"grafana:UpdatePermissions",
+		},
+		OperationDelete: {
+			"grafana:UpdatePermissions",
+		},
+	},
+	"aws_grafana_workspace": {
+		OperationCreate: {
+			"grafana:CreateWorkspace",
+			"grafana:DescribeWorkspace",
+			"grafana:DescribeWorkspaceConfiguration",
+		},
+		OperationUpdate: {
+			"grafana:DescribeWorkspace",
+			"grafana:DescribeWorkspaceConfiguration",
+			"grafana:TagResource",
+			"grafana:UntagResource",
+			"grafana:UpdateWorkspace",
+			"grafana:UpdateWorkspaceConfiguration",
+		},
+		OperationDelete: {
+			"grafana:DeleteWorkspace",
+			"grafana:DescribeWorkspace",
+		},
+	},
+	"aws_grafana_workspace_api_key": {
+		OperationCreate: {
+			"grafana:CreateWorkspaceApiKey",
+		},
+		OperationDelete: {
+			"grafana:DeleteWorkspaceApiKey",
+		},
+	},
+	"aws_grafana_workspace_saml_configuration": {
+		OperationCreate: {
+			"grafana:DescribeWorkspace",
+			"grafana:DescribeWorkspaceAuthentication",
+			"grafana:UpdateWorkspaceAuthentication",
+		},
+		OperationUpdate: {
+			"grafana:DescribeWorkspace",
+			"grafana:DescribeWorkspaceAuthentication",
+			"grafana:UpdateWorkspaceAuthentication",
+		},
+	},
+	"aws_greengrassv2_component_version": {
+		OperationCreate: {
+			"greengrass:CreateComponentVersion",
+			"greengrass:DescribeComponent",
+			"greengrass:GetComponent",
+		},
+		OperationUpdate: {
+			"greengrass:DescribeComponent",
+			"greengrass:GetComponent",
+			"greengrass:TagResource",
+			"greengrass:UntagResource",
+		},
+		OperationDelete: {
+			"greengrass:DeleteComponent",
+		},
+	},
+	"aws_greengrassv2_deployment": {
+		OperationCreate: {
+			"greengrass:CreateDeployment",
+			"greengrass:GetDeployment",
+		},
+		OperationUpdate: {
+			"greengrass:GetDeployment",
+			"greengrass:TagResource",
+			"greengrass:UntagResource",
+		},
+		OperationDelete: {
+			"greengrass:CancelDeployment",
+			"greengrass:DeleteDeployment",
+		},
+	},
+	"aws_groundstation_config": {
+		OperationCreate: {
+			"groundstation:CreateConfig",
+			"groundstation:GetConfig",
+		},
+		OperationUpdate: {
+			"groundstation:GetConfig",
+			"groundstation:TagResource",
+			"groundstation:UntagResource",
+			"groundstation:UpdateConfig",
+		},
+		OperationDelete: {
+			"groundstation:DeleteConfig",
+		},
+	},
+	"aws_groundstation_dataflow_endpoint_group": {
+		OperationCreate: {
+			"groundstation:CreateDataflowEndpointGroup",
+			"groundstation:GetDataflowEndpointGroup",
+		},
+		OperationUpdate: {
+			"groundstation:GetDataflowEndpointGroup",
+			"groundstation:TagResource",
+			"groundstation:UntagResource",
+		},
+		OperationDelete: {
+			"groundstation:DeleteDataflowEndpointGroup",
+		},
+	},
+	"aws_groundstation_ephemeris": {
+		OperationCreate: {
+			"groundstation:CreateEphemeris",
+			"groundstation:DescribeEphemeris",
+		},
+		OperationUpdate: {
+			"groundstation:DescribeEphemeris",
+			"groundstation:TagResource",
+			"groundstation:UntagResource",
+			"groundstation:UpdateEphemeris",
+		},
+		OperationDelete: {
+			"groundstation:DeleteEphemeris",
+		},
+	},
+	"aws_groundstation_mission_profile": {
+		OperationCreate: {
+			"groundstation:CreateMissionProfile",
+			"groundstation:GetMissionProfile",
+		},
+		OperationUpdate: {
+			"groundstation:GetMissionProfile",
+			"groundstation:TagResource",
+			"groundstation:UntagResource",
+			"groundstation:UpdateMissionProfile",
+		},
+		OperationDelete: {
+			"groundstation:DeleteMissionProfile",
+		},
+	},
+	"aws_guardduty_detector": {
+		OperationCreate: {
+			"guardduty:CreateDetector",
+			"guardduty:GetDetector",
+		},
+		OperationUpdate: {
+			"guardduty:GetDetector",
+			"guardduty:TagResource",
+			"guardduty:UntagResource",
+			"guardduty:UpdateDetector",
+		},
+		OperationDelete: {
+			"guardduty:DeleteDetector",
+		},
+	},
+	"aws_guardduty_detector_feature": {
+		OperationCreate: {
+			"guardduty:GetDetector",
+			"guardduty:UpdateDetector",
+		},
+		OperationUpdate: {
+			"guardduty:GetDetector",
+			"guardduty:UpdateDetector",
+		},
+	},
+	"aws_guardduty_filter": {
+		OperationCreate: {
+			"guardduty:CreateFilter",
+			"guardduty:GetFilter",
+		},
+		OperationUpdate: {
+			"guardduty:GetFilter",
+			"guardduty:TagResource",
+			"guardduty:UntagResource",
+			"guardduty:UpdateFilter",
+		},
+		OperationDelete: {
+			"guardduty:DeleteFilter",
+		},
+	},
+	"aws_guardduty_invite_accepter": {
+		OperationCreate: {
+			"guardduty:AcceptInvitation",
+			"guardduty:GetMasterAccount",
+			"guardduty:ListInvitations",
+		},
+		OperationDelete: {
+			"guardduty:DisassociateFromMasterAccount",
+		},
+	},
+	"aws_guardduty_ipset": {
+		OperationCreate: {
+			"guardduty:CreateIPSet",
+			"guardduty:GetIPSet",
+		},
+		OperationUpdate: {
+			"guardduty:GetIPSet",
+			"guardduty:TagResource",
+			"guardduty:UntagResource",
+			"guardduty:UpdateIPSet",
+		},
+		OperationDelete: {
+			"guardduty:DeleteIPSet",
+			"guardduty:GetIPSet",
+		},
+	},
+	"aws_guardduty_member": {
+		OperationCreate: {
+			"guardduty:CreateMembers",
+			"guardduty:GetMembers",
+			"guardduty:InviteMembers",
+		},
+		OperationUpdate: {
+			"guardduty:DisassociateMembers",
+			"guardduty:GetMembers",
+			"guardduty:InviteMembers",
+		},
+		OperationDelete: {
+			"guardduty:DeleteMembers",
+		},
+	},
+	"aws_guardduty_organization_admin_account": {
+		OperationCreate: {
+			"guardduty:EnableOrganizationAdminAccount",
+			"guardduty:ListOrganizationAdminAccounts",
+		},
+		OperationDelete: {
+			"guardduty:DisableOrganizationAdminAccount",
+			"guardduty:ListOrganizationAdminAccounts",
+		},
+	},
+	"aws_guardduty_organization_configuration": {
+		OperationCreate: {
+			"guardduty:DescribeOrganizationConfiguration",
+			"guardduty:UpdateOrganizationConfiguration",
+		},
+		OperationUpdate: {
+			"guardduty:DescribeOrganizationConfiguration",
+			"guardduty:UpdateOrganizationConfiguration",
+		},
+	},
+	"aws_guardduty_organization_configuration_feature": {
+		OperationCreate: {
+			"guardduty:DescribeOrganizationConfiguration",
+			"guardduty:UpdateOrganizationConfiguration",
+		},
+		OperationUpdate: {
+			"guardduty:DescribeOrganizationConfiguration",
+			"guardduty:UpdateOrganizationConfiguration",
+		},
+	},
+	"aws_guardduty_publishing_destination": {
+		OperationCreate: {
+			"guardduty:CreatePublishingDestination",
+			"guardduty:DescribePublishingDestination",
+		},
+		OperationUpdate: {
+			"guardduty:DescribePublishingDestination",
+			"guardduty:UpdatePublishingDestination",
+		},
+		OperationDelete: {
+			"guardduty:DeletePublishingDestination",
+		},
+	},
+	"aws_guardduty_threatintelset": {
+		OperationCreate: {
+			"guardduty:CreateThreatIntelSet",
+			"guardduty:GetThreatIntelSet",
+		},
+		OperationUpdate: {
+			"guardduty:GetThreatIntelSet",
+			"guardduty:TagResource",
+			"guardduty:UntagResource",
+			"guardduty:UpdateThreatIntelSet",
+		},
+		OperationDelete: {
+			"guardduty:DeleteThreatIntelSet",
+			"guardduty:GetThreatIntelSet",
+		},
+	},
+	"aws_iam_access_key": {
+		OperationCreate: {
+			"iam:CreateAccessKey",
+			"iam:UpdateAccessKey",
+		},
+		OperationUpdate: {
+			"iam:ListAccessKeys",
+			"iam:UpdateAccessKey",
+		},
+		OperationDelete: {
+			"iam:DeleteAccessKey",
+		},
+	},
+	"aws_iam_account_alias": {
+		OperationCreate: {
+			"iam:CreateAccountAlias",
+		},
+		OperationDelete: {
+			"iam:DeleteAccountAlias",
+		},
+	},
+	"aws_iam_account_password_policy": {
+		OperationCreate: {
+			"iam:GetAccountPasswordPolicy",
+			"iam:UpdateAccountPasswordPolicy",
+		},
+		OperationUpdate: {
+			"iam:GetAccountPasswordPolicy",
+			"iam:UpdateAccountPasswordPolicy",
+		},
+		OperationDelete: {
+			"iam:DeleteAccountPasswordPolicy",
+		},
+	},
+	"aws_iam_group": {
+		OperationCreate: {
+			"iam:CreateGroup",
+			"iam:GetGroup",
+		},
+		OperationUpdate: {
+			"iam:GetGroup",
+			"iam:UpdateGroup",
+		},
+		OperationDelete: {
+			"iam:DeleteGroup",
+		},
+	},
+	"aws_iam_group_membership": {
+		OperationCreate: {
+			"iam:AddUserToGroup",
+			"iam:GetGroup",
+		},
+		OperationUpdate: {
+			"iam:AddUserToGroup",
+			"iam:GetGroup",
+			"iam:RemoveUserFromGroup",
+		},
+		OperationDelete: {
+			"iam:RemoveUserFromGroup",
+		},
+	},
+	"aws_iam_group_policy": {
+		OperationCreate: {
+			"iam:GetGroupPolicy",
+			"iam:PutGroupPolicy",
+		},
+		OperationUpdate: {
+			"iam:GetGroupPolicy",
+			"iam:PutGroupPolicy",
+		},
+		OperationDelete: {
+			"iam:DeleteGroupPolicy",
+		},
+	},
+	"aws_iam_group_policy_attachment": {
+		OperationCreate: {
+			"iam:AttachGroupPolicy",
+			"iam:ListAttachedGroupPolicies",
+		},
+		OperationDelete: {
+			"iam:DetachGroupPolicy",
+		},
+	},
+	"aws_iam_instance_profile": {
+		OperationCreate: {
+			"iam:AddRoleToInstanceProfile",
+			"iam:CreateInstanceProfile",
+			"iam:GetInstanceProfile",
+			"iam:GetRole",
+			"iam:RemoveRoleFromInstanceProfile",
+			"iam:TagInstanceProfile",
+			"iam:UntagInstanceProfile",
+		},
+		OperationUpdate: {
+			"iam:AddRoleToInstanceProfile",
+			"iam:GetInstanceProfile",
+			"iam:GetRole",
+			"iam:RemoveRoleFromInstanceProfile",
+			"iam:TagInstanceProfile",
+			"iam:UntagInstanceProfile",
+		},
+		OperationDelete: {
+			"iam:DeleteInstanceProfile",
+			"iam:RemoveRoleFromInstanceProfile",
+		},
+	},
+	"aws_iam_openid_connect_provider": {
+		OperationCreate: {
+			"iam:CreateOpenIDConnectProvider",
+			"iam:GetOpenIDConnectProvider",
+			"iam:TagOpenIDConnectProvider",
+			"iam:UntagOpenIDConnectProvider",
+		},
+		OperationUpdate: {
+			"iam:AddClientIDToOpenIDConnectProvider",
+			"iam:GetOpenIDConnectProvider",
+			"iam:RemoveClientIDFromOpenIDConnectProvider",
+			"iam:TagOpenIDConnectProvider",
+			"iam:UntagOpenIDConnectProvider",
+			"iam:UpdateOpenIDConnectProviderThumbprint",
+		},
+		OperationDelete: {
+			"iam:DeleteOpenIDConnectProvider",
+		},
+	},
+	"aws_iam_policy": {
+		OperationCreate: {
+			"iam:CreatePolicy",
+			"iam:GetPolicy",
+			"iam:GetPolicyVersion",
+			"iam:TagPolicy",
+			"iam:UntagPolicy",
+		},
+		OperationUpdate: {
+			"iam:CreatePolicyVersion",
+			"iam:DeletePolicyVersion",
+			"iam:GetPolicy",
+			"iam:GetPolicyVersion",
+			"iam:ListPolicyVersions",
+			"iam:TagPolicy",
+			"iam:UntagPolicy",
+		},
+		OperationDelete: {
+			"iam:DeletePolicy",
+			"iam:DeletePolicyVersion",
+			"iam:ListPolicyVersions",
+		},
+	},
+	"aws_iam_policy_attachment": {
+		OperationCreate: {
+			"iam:AttachGroupPolicy",
+			"iam:AttachRolePolicy",
+			"iam:AttachUserPolicy",
+			"iam:ListEntitiesForPolicy",
+		},
+		OperationUpdate: {
+			"iam:AttachGroupPolicy",
+			"iam:AttachRolePolicy",
+			"iam:AttachUserPolicy",
+			"iam:DetachGroupPolicy",
+			"iam:DetachRolePolicy",
+			"iam:DetachUserPolicy",
+			"iam:ListEntitiesForPolicy",
+		},
+		OperationDelete: {
+			"iam:DetachGroupPolicy",
+			"iam:DetachRolePolicy",
+			"iam:DetachUserPolicy",
+		},
+	},
+	"aws_iam_role": {
+		OperationCreate: {
+			"iam:AttachRolePolicy",
+			"iam:CreateRole",
+			"iam:GetRole",
+			"iam:GetRolePolicy",
+			"iam:ListAttachedRolePolicies",
+			"iam:ListRolePolicies",
+			"iam:PutRolePolicy",
+			"iam:TagRole",
+			"iam:UntagRole",
+		},
+		OperationUpdate: {
+			"iam:AttachRolePolicy",
+			"iam:DeleteRolePermissionsBoundary",
+			"iam:DeleteRolePolicy",
+			"iam:DetachRolePolicy",
+			"iam:GetRole",
+			"iam:GetRolePolicy",
+			"iam:ListAttachedRolePolicies",
+			"iam:ListRolePolicies",
+			"iam:PutRolePermissionsBoundary",
+			"iam:PutRolePolicy",
+			"iam:TagRole",
+			"iam:UntagRole",
+			"iam:UpdateAssumeRolePolicy",
+			"iam:UpdateRole",
+			"iam:UpdateRoleDescription",
+		},
+		OperationDelete: {
+			"iam:DeleteRole",
+			"iam:DeleteRolePolicy",
+			"iam:DetachRolePolicy",
+			"iam:ListAttachedRolePolicies",
+			"iam:ListInstanceProfilesForRole",
+			"iam:ListRolePolicies",
+			"iam:RemoveRoleFromInstanceProfile",
+		},
+	},
+	"aws_iam_role_policy": {
+		OperationCreate: {
+			"iam:GetRolePolicy",
+			"iam:PutRolePolicy",
+		},
+		OperationUpdate: {
+			"iam:GetRolePolicy",
+			"iam:PutRolePolicy",
+		},
+		OperationDelete: {
+			"iam:DeleteRolePolicy",
+		},
+	},
+	"aws_iam_role_policy_attachment": {
+		OperationCreate: {
+			"iam:AttachRolePolicy",
+			"iam:ListAttachedRolePolicies",
+		},
+		OperationDelete: {
+			"iam:DetachRolePolicy",
+		},
+	},
+	"aws_iam_saml_provider": {
+		OperationCreate: {
+			"iam:CreateSAMLProvider",
+			"iam:GetSAMLProvider",
+			"iam:TagSAMLProvider",
+			"iam:UntagSAMLProvider",
+		},
+		OperationUpdate: {
+			"iam:GetSAMLProvider",
+			"iam:TagSAMLProvider",
+			"iam:UntagSAMLProvider",
+			"iam:UpdateSAMLProvider",
+		},
+		OperationDelete: {
+			"iam:DeleteSAMLProvider",
+		},
+	},
+	"aws_iam_security_token_service_preferences": {
+		OperationCreate: {
+			"iam:GetAccountSummary",
+			"iam:SetSecurityTokenServicePreferences",
+		},
+		OperationUpdate: {
+			"iam:GetAccountSummary",
+			"iam:SetSecurityTokenServicePreferences",
+		},
+	},
+	"aws_iam_server_certificate": {
+		OperationCreate: {
+			"iam:GetServerCertificate",
+			"iam:TagServerCertificate",
+			"iam:UntagServerCertificate",
+			"iam:UploadServerCertificate",
+		},
+		OperationUpdate: {
+			"iam:GetServerCertificate",
+			"iam:TagServerCertificate",
+			"iam:UntagServerCertificate",
+		},
+		OperationDelete: {
+			"iam:DeleteServerCertificate",
+		},
+	},
+	"aws_iam_service_linked_role": {
+		OperationCreate: {
+			"iam:CreateServiceLinkedRole",
+			"iam:GetRole",
+			"iam:TagRole",
+			"iam:UntagRole",
+		},
+		OperationUpdate: {
+			"iam:GetRole",
+			"iam:TagRole",
+			"iam:UntagRole",
+			"iam:UpdateRole",
+		},
+		OperationDelete: {
+			"iam:DeleteServiceLinkedRole",
+			"iam:GetServiceLinkedRoleDeletionStatus",
+		},
+	},
+	"aws_iam_service_specific_credential": {
+		OperationCreate: {
+			"iam:CreateServiceSpecificCredential",
+			"iam:ListServiceSpecificCredentials",
+			"iam:UpdateServiceSpecificCredential",
+		},
+		OperationUpdate: {
+			"iam:ListServiceSpecificCredentials",
+			"iam:UpdateServiceSpecificCredential",
+		},
+		OperationDelete: {
+			"iam:DeleteServiceSpecificCredential",
+		},
+	},
+	"aws_iam_signing_certificate": {
+		OperationCreate: {
+			"iam:ListSigningCertificates",
+			"iam:UpdateSigningCertificate",
+			"iam:UploadSigningCertificate",
+		},
+		OperationUpdate: {
+			"iam:ListSigningCertificates",
+			"iam:UpdateSigningCertificate",
+		},
+		OperationDelete: {
+			"iam:DeleteSigningCertificate",
+		},
+	},
+	"aws_iam_user": {
+		OperationCreate: {
+			"iam:CreateUser",
+			"iam:GetUser",
+			"iam:TagUser",
+			"iam:UntagUser",
+		},
+		OperationUpdate: {
+			"iam:DeleteUserPermissionsBoundary",
+			"iam:GetUser",
+			"iam:PutUserPermissionsBoundary",
+			"iam:TagUser",
+			"iam:UntagUser",
+			"iam:UpdateUser",
+		},
+		OperationDelete: {
+			"iam:DeactivateMFADevice",
+			"iam:DeleteAccessKey",
+			"iam:DeleteLoginProfile",
+			"iam:DeleteSSHPublicKey",
+			"iam:DeleteServiceSpecificCredential",
+			"iam:DeleteSigningCertificate",
+			"iam:DeleteUser",
+			"iam:DeleteUserPolicy",
+			"iam:DeleteVirtualMFADevice",
+			"iam:DetachUserPolicy",
+			"iam:ListAccessKeys",
+			"iam:ListAttachedUserPolicies",
+			"iam:ListGroupsForUser",
+			"iam:ListMFADevices",
+			"iam:ListSSHPublicKeys",
+			"iam:ListServiceSpecificCredentials",
+			"iam:ListSigningCertificates",
+			"iam:ListUserPolicies",
+			"iam:ListVirtualMFADevices",
+			"iam:RemoveUserFromGroup",
+		},
+	},
+	"aws_iam_user_group_membership": {
+		OperationCreate: {
+			"iam:AddUserToGroup",
+			"iam:ListGroupsForUser",
+		},
+		OperationUpdate: {
+			"iam:AddUserToGroup",
+			"iam:ListGroupsForUser",
+			"iam:RemoveUserFromGroup",
+		},
+		OperationDelete: {
+			"iam:RemoveUserFromGroup",
+		},
+	},
+	"aws_iam_user_login_profile": {
+		OperationCreate: {
+			"iam:CreateLoginProfile",
+			"iam:GetLoginProfile",
+		},
+		OperationDelete: {
+			"iam:DeleteLoginProfile",
+		},
+	},
+	"aws_iam_user_policy": {
+		OperationCreate: {
+			"iam:GetUserPolicy",
+			"iam:PutUserPolicy",
+		},
+		OperationUpdate: {
+			"iam:GetUserPolicy",
+			"iam:PutUserPolicy",
+		},
+		OperationDelete: {
+			"iam:DeleteUserPolicy",
+		},
+	},
+	"aws_iam_user_policy_attachment": {
+		OperationCreate: {
+			"iam:AttachUserPolicy",
+			"iam:ListAttachedUserPolicies",
+		},
+		OperationDelete: {
+			"iam:DetachUserPolicy",
+		},
+	},
+	"aws_iam_user_ssh_key": {
+		OperationCreate: {
+			"iam:GetSSHPublicKey",
+			"iam:UpdateSSHPublicKey",
+			"iam:UploadSSHPublicKey",
+		},
+		OperationUpdate: {
+			"iam:GetSSHPublicKey",
+			"iam:UpdateSSHPublicKey",
+		},
+		OperationDelete: {
+			"iam:DeleteSSHPublicKey",
+		},
+	},
+	"aws_iam_virtual_mfa_device": {
+		OperationCreate: {
+			"iam:CreateVirtualMFADevice",
+			"iam:ListMFADeviceTags",
+			"iam:ListVirtualMFADevices",
+			"iam:TagMFADevice",
+			"iam:UntagMFADevice",
+		},
+		OperationUpdate: {
+			"iam:ListMFADeviceTags",
+			"iam:ListVirtualMFADevices",
+			"iam:TagMFADevice",
+			"iam:UntagMFADevice",
+		},
+		OperationDelete: {
+			"iam:DeactivateMFADevice",
+			"iam:DeleteVirtualMFADevice",
+		},
+	},
+	"aws_identitystore_group": {
+		OperationCreate: {
+			"identitystore:CreateGroup",
+			"identitystore:DescribeGroup",
+		},
+		OperationUpdate: {
+			"identitystore:DescribeGroup",
+			"identitystore:UpdateGroup",
+		},
+		OperationDelete: {
+			"identitystore:DeleteGroup",
+		},
+	},
+	"aws_identitystore_group_membership": {
+		OperationCreate: {
+			"identitystore:CreateGroupMembership",
+			"identitystore:DescribeGroupMembership",
+		},
+		OperationDelete: {
+			"identitystore:DeleteGroupMembership",
+		},
+	},
+	"aws_identitystore_group_memberships": {
+		OperationCreate: {
+			"identitystore:CreateGroupMembership",
+			"identitystore:DeleteGroupMembership",
+			"identitystore:GetUserId",
+			"identitystore:ListGroupMemberships",
+		},
+		OperationUpdate: {
+			"identitystore:CreateGroupMembership",
+			"identitystore:DeleteGroupMembership",
+			"identitystore:GetUserId",
+			"identitystore:ListGroupMemberships",
+		},
+		OperationDelete: {
+			"identitystore:DeleteGroupMembership",
+		},
+	},
+	"aws_identitystore_user": {
+		OperationCreate: {
+			"identitystore:CreateUser",
+			"identitystore:DescribeUser",
+		},
+		OperationUpdate: {
+			"identitystore:DescribeUser",
+			"identitystore:UpdateUser",
+		},
+		OperationDelete: {
+			"identitystore:DeleteUser",
+		},
+	},
+	"aws_imagebuilder_component": {
+		OperationCreate: {
+			"imagebuilder:CreateComponent",
+			"imagebuilder:GetComponent",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetComponent",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteComponent",
+		},
+	},
+	"aws_imagebuilder_container_recipe": {
+		OperationCreate: {
+			"imagebuilder:CreateContainerRecipe",
+			"imagebuilder:GetContainerRecipe",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetContainerRecipe",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteContainerRecipe",
+		},
+	},
+	"aws_imagebuilder_distribution_configuration": {
+		OperationCreate: {
+			"imagebuilder:CreateDistributionConfiguration",
+			"imagebuilder:GetDistributionConfiguration",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetDistributionConfiguration",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+			"imagebuilder:UpdateDistributionConfiguration",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteDistributionConfiguration",
+		},
+	},
+	"aws_imagebuilder_image": {
+		OperationCreate: {
+			"imagebuilder:CreateImage",
+			"imagebuilder:GetImage",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetImage",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteImage",
+		},
+	},
+	"aws_imagebuilder_image_pipeline": {
+		OperationCreate: {
+			"imagebuilder:CreateImagePipeline",
+			"imagebuilder:GetImagePipeline",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetImagePipeline",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+			"imagebuilder:UpdateImagePipeline",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteImagePipeline",
+		},
+	},
+	"aws_imagebuilder_image_recipe": {
+		OperationCreate: {
+			"imagebuilder:CreateImageRecipe",
+			"imagebuilder:GetImageRecipe",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetImageRecipe",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteImageRecipe",
+		},
+	},
+	"aws_imagebuilder_infrastructure_configuration": {
+		OperationCreate: {
+			"imagebuilder:CreateInfrastructureConfiguration",
+			"imagebuilder:GetInfrastructureConfiguration",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetInfrastructureConfiguration",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+			"imagebuilder:UpdateInfrastructureConfiguration",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteInfrastructureConfiguration",
+		},
+	},
+	"aws_imagebuilder_workflow": {
+		OperationCreate: {
+			"imagebuilder:CreateWorkflow",
+			"imagebuilder:GetWorkflow",
+		},
+		OperationUpdate: {
+			"imagebuilder:GetWorkflow",
+			"imagebuilder:TagResource",
+			"imagebuilder:UntagResource",
+		},
+		OperationDelete: {
+			"imagebuilder:DeleteWorkflow",
+		},
+	},
+	"aws_inspector2_delegated_admin_account": {
+		OperationCreate: {
+			"inspector2:EnableDelegatedAdminAccount",
+			"inspector2:ListDelegatedAdminAccounts",
+		},
+		OperationDelete: {
+			"inspector2:DisableDelegatedAdminAccount",
+			"inspector2:ListDelegatedAdminAccounts",
+		},
+	},
+	"aws_inspector2_enabler": {
+		OperationCreate: {
+			"inspector2:BatchGetAccountStatus",
+			"inspector2:Disable",
+			"inspector2:Enable",
+		},
+		OperationUpdate: {
+			"inspector2:BatchGetAccountStatus",
+			"inspector2:Disable",
+			"inspector2:Enable",
+		},
+		OperationDelete: {
+			"inspector2:BatchGetAccountStatus",
+			"inspector2:Disable",
+		},
+	},
+	"aws_inspector2_member_association": {
+		OperationCreate: {
+			"inspector2:AssociateMember",
+			"inspector2:GetMember",
+		},
+		OperationDelete: {
+			"inspector2:DisassociateMember",
+			"inspector2:GetMember",
+		},
+	},
+	"aws_inspector2_organization_configuration": {
+		OperationCreate: {
+			"inspector2:DescribeOrganizationConfiguration",
+			"inspector2:UpdateOrganizationConfiguration",
+		},
+		OperationUpdate: {
+			"inspector2:DescribeOrganizationConfiguration",
+			"inspector2:UpdateOrganizationConfiguration",
+		},
+		OperationDelete: {
+			"inspector2:DescribeOrganizationConfiguration",
+			"inspector2:UpdateOrganizationConfiguration",
+		},
+	},
+	"aws_inspector_assessment_target": {
+		OperationCreate: {
+			"inspector:CreateAssessmentTarget",
+			"inspector:DescribeAssessmentTargets",
+		},
+		OperationUpdate: {
+			"inspector:DescribeAssessmentTargets",
+			"inspector:UpdateAssessmentTarget",
+		},
+		OperationDelete: {
+			"inspector:DeleteAssessmentTarget",
+		},
+	},
+	"aws_inspector_assessment_template": {
+		OperationCreate: {
+			"inspector:CreateAssessmentTemplate",
+			"inspector:DescribeAssessmentTemplates",
+			"inspector:ListEventSubscriptions",
+			"inspector:SetTagsForResource",
+			"inspector:SubscribeToEvent",
+		},
+		OperationUpdate: {
+			"inspector:DescribeAssessmentTemplates",
+			"inspector:ListEventSubscriptions",
+			"inspector:SetTagsForResource",
+			"inspector:SubscribeToEvent",
+			"inspector:UnsubscribeFromEvent",
+		},
+		OperationDelete: {
+			"inspector:DeleteAssessmentTemplate",
+		},
+	},
+	"aws_inspector_resource_group": {
+		OperationCreate: {
+			"inspector:CreateResourceGroup",
+			"inspector:DescribeResourceGroups",
+		},
+	},
+	"aws_instance": {
+		OperationCreate: {
+			"ec2:AssignIpv6Addresses",
+			"ec2:AssignPrivateIpAddresses",
+			"ec2:AssociateIamInstanceProfile",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIamInstanceProfileAssociations",
+			"ec2:DescribeImages",
+			"ec2:DescribeInstanceAttribute",
+			"ec2:DescribeInstanceCreditSpecifications",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeInstances",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:DescribeLaunchTemplates",
+			"ec2:DescribeSpotInstanceRequests",
+			"ec2:DescribeTags",
+			"ec2:DescribeVolumes",
+			"ec2:DescribeVolumesModifications",
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateIamInstanceProfile",
+			"ec2:GetPasswordData",
+			"ec2:ModifyInstanceAttribute",
+			"ec2:ModifyInstanceCapacityReservationAttributes",
+			"ec2:ModifyInstanceCreditSpecification",
+			"ec2:ModifyInstanceMaintenanceOptions",
+			"ec2:ModifyInstanceMetadataOptions",
+			"ec2:ModifyNetworkInterfaceAttribute",
+			"ec2:ModifyPrivateDnsNameOptions",
+			"ec2:ModifyVolume",
+			"ec2:MonitorInstances",
+			"ec2:ReplaceIamInstanceProfileAssociation",
+			"ec2:RunInstances",
+			"ec2:StartInstances",
+			"ec2:StopInstances",
+			"ec2:UnassignIpv6Addresses",
+			"ec2:UnassignPrivateIpAddresses",
+			"ec2:UnmonitorInstances",
+		},
+		OperationUpdate: {
+			"ec2:AssignIpv6Addresses",
+			"ec2:AssignPrivateIpAddresses",
+			"ec2:AssociateIamInstanceProfile",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIamInstanceProfileAssociations",
+			"ec2:DescribeInstanceAttribute",
+			"ec2:DescribeInstanceCreditSpecifications",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeInstances",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:DescribeLaunchTemplates",
+			"ec2:DescribeSpotInstanceRequests",
+			"ec2:DescribeTags",
+			"ec2:DescribeVolumes",
+			"ec2:DescribeVolumesModifications",
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateIamInstanceProfile",
+			"ec2:GetPasswordData",
+			"ec2:ModifyInstanceAttribute",
+			"ec2:ModifyInstanceCapacityReservationAttributes",
+			"ec2:ModifyInstanceCreditSpecification",
+			"ec2:ModifyInstanceMaintenanceOptions",
+			"ec2:ModifyInstanceMetadataOptions",
+			"ec2:ModifyNetworkInterfaceAttribute",
+			"ec2:ModifyPrivateDnsNameOptions",
+			"ec2:ModifyVolume",
+			"ec2:MonitorInstances",
+			"ec2:ReplaceIamInstanceProfileAssociation",
+			"ec2:StartInstances",
+			"ec2:StopInstances",
+			"ec2:UnassignIpv6Addresses",
+			"ec2:UnassignPrivateIpAddresses",
+			"ec2:UnmonitorInstances",
+		},
+		OperationDelete: {
+			"ec2:CancelSpotInstanceRequests",
+			"ec2:DescribeInstances",
+			"ec2:ModifyInstanceAttribute",
+			"ec2:TerminateInstances",
+		},
+	},
+	"aws_internet_gateway": {
+		OperationCreate: {
+			"ec2:AttachInternetGateway",
+			"ec2:CreateInternetGateway",
+			"ec2:DescribeInternetGateways",
+		},
+		OperationUpdate: {
+			"ec2:AttachInternetGateway",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeInternetGateways",
+			"ec2:DetachInternetGateway",
+		},
+		OperationDelete: {
+			"ec2:DeleteInternetGateway",
+			"ec2:DescribeInternetGateways",
+			"ec2:DetachInternetGateway",
+		},
+	},
+	"aws_internet_gateway_attachment": {
+		OperationCreate: {
+			"ec2:AttachInternetGateway",
+			"ec2:DescribeInternetGateways",
+		},
+		OperationDelete: {
+			"ec2:DescribeInternetGateways",
+			"ec2:DetachInternetGateway",
+		},
+	},
+	"aws_internetmonitor_monitor": {
+		OperationCreate: {
+			"internetmonitor:CreateMonitor",
+			"internetmonitor:GetMonitor",
+			"internetmonitor:UpdateMonitor",
+		},
+		OperationUpdate: {
+			"internetmonitor:GetMonitor",
+			"internetmonitor:TagResource",
+			"internetmonitor:UntagResource",
+			"internetmonitor:UpdateMonitor",
+		},
+		OperationDelete: {
+			"internetmonitor:DeleteMonitor",
+			"internetmonitor:GetMonitor",
+			"internetmonitor:UpdateMonitor",
+		},
+	},
+	"aws_iot_authorizer": {
+		OperationCreate: {
+			"iot:CreateAuthorizer",
+			"iot:DescribeAuthorizer",
+		},
+		OperationUpdate: {
+			"iot:DescribeAuthorizer",
+			"iot:UpdateAuthorizer",
+		},
+		OperationDelete: {
+			"iot:DeleteAuthorizer",
+			"iot:UpdateAuthorizer",
+		},
+	},
+	"aws_iot_billing_group": {
+		OperationCreate: {
+			"iot:CreateBillingGroup",
+			"iot:DescribeBillingGroup",
+		},
+		OperationUpdate: {
+			"iot:DescribeBillingGroup",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateBillingGroup",
+		},
+		OperationDelete: {
+			"iot:DeleteBillingGroup",
+		},
+	},
+	"aws_iot_billing_group_membership": {
+		OperationCreate: {
+			"iot:AddThingToBillingGroup",
+			"iot:DescribeThing",
+		},
+		OperationDelete: {
+			"iot:RemoveThingFromBillingGroup",
+		},
+	},
+	"aws_iot_ca_certificate": {
+		OperationCreate: {
+			"iot:DescribeCACertificate",
+			"iot:RegisterCACertificate",
+		},
+		OperationUpdate: {
+			"iot:DescribeCACertificate",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateCACertificate",
+		},
+		OperationDelete: {
+			"iot:DeleteCACertificate",
+			"iot:UpdateCACertificate",
+		},
+	},
+	"aws_iot_certificate": {
+		OperationCreate: {
+			"iot:CreateCertificateFromCsr",
+			"iot:CreateKeysAndCertificate",
+			"iot:DescribeCertificate",
+			"iot:RegisterCertificate",
+			"iot:RegisterCertificateWithoutCA",
+		},
+		OperationUpdate: {
+			"iot:DescribeCertificate",
+			"iot:UpdateCertificate",
+		},
+		OperationDelete: {
+			"iot:DeleteCertificate",
+			"iot:UpdateCertificate",
+		},
+	},
+	"aws_iot_domain_configuration": {
+		OperationCreate: {
+			"iot:CreateDomainConfiguration",
+			"iot:DescribeDomainConfiguration",
+		},
+		OperationUpdate: {
+			"iot:DescribeDomainConfiguration",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateDomainConfiguration",
+		},
+		OperationDelete: {
+			"iot:DeleteDomainConfiguration",
+			"iot:UpdateDomainConfiguration",
+		},
+	},
+	"aws_iot_event_configurations": {
+		OperationCreate: {
+			"iot:DescribeEventConfigurations",
+			"iot:UpdateEventConfigurations",
+		},
+		OperationUpdate: {
+			"iot:DescribeEventConfigurations",
+			"iot:UpdateEventConfigurations",
+		},
+	},
+	"aws_iot_fleet_metric": {
+		OperationCreate: {
+			"iot:CreateFleetMetric",
+			"iot:DescribeFleetMetric",
+		},
+		OperationUpdate: {
+			"iot:DescribeFleetMetric",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateFleetMetric",
+		},
+		OperationDelete: {
+			"iot:DeleteFleetMetric",
+		},
+	},
+	"aws_iot_indexing_configuration": {
+		OperationCreate: {
+			"iot:GetIndexingConfiguration",
+			"iot:UpdateIndexingConfiguration",
+		},
+		OperationUpdate: {
+			"iot:GetIndexingConfiguration",
+			"iot:UpdateIndexingConfiguration",
+		},
+	},
+	"aws_iot_logging_options": {
+		OperationCreate: {
+			"iot:GetV2LoggingOptions",
+			"iot:SetV2LoggingOptions",
+		},
+		OperationUpdate: {
+			"iot:GetV2LoggingOptions",
+			"iot:SetV2LoggingOptions",
+		},
+	},
+	"aws_iot_policy": {
+		OperationCreate: {
+			"iot:CreatePolicy",
+			"iot:GetPolicy",
+		},
+		OperationUpdate: {
+			"iot:CreatePolicyVersion",
+			"iot:DeletePolicyVersion",
+			"iot:GetPolicy",
+			"iot:ListPolicyVersions",
+			"iot:TagResource",
+			"iot:UntagResource",
+		},
+		OperationDelete: {
+			"iot:DeletePolicy",
+			"iot:DeletePolicyVersion",
+			"iot:ListPolicyVersions",
+		},
+	},
+	"aws_iot_policy_attachment": {
+		OperationCreate: {
+			"iot:AttachPolicy",
+			"iot:ListAttachedPolicies",
+		},
+		OperationDelete: {
+			"iot:DetachPolicy",
+		},
+	},
+	"aws_iot_provisioning_template": {
+		OperationCreate: {
+			"iot:CreateProvisioningTemplate",
+			"iot:DescribeProvisioningTemplate",
+			"iot:DescribeProvisioningTemplateVersion",
+			"iot:ListProvisioningTemplateVersions",
+		},
+		OperationUpdate: {
+			"iot:CreateProvisioningTemplateVersion",
+			"iot:DeleteProvisioningTemplateVersion",
+			"iot:DescribeProvisioningTemplate",
+			"iot:DescribeProvisioningTemplateVersion",
+			"iot:ListProvisioningTemplateVersions",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateProvisioningTemplate",
+		},
+		OperationDelete: {
+			"iot:DeleteProvisioningTemplate",
+		},
+	},
+	"aws_iot_role_alias": {
+		OperationCreate: {
+			"iot:CreateRoleAlias",
+			"iot:DescribeRoleAlias",
+		},
+		OperationUpdate: {
+			"iot:DescribeRoleAlias",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateRoleAlias",
+		},
+		OperationDelete: {
+			"iot:DeleteRoleAlias",
+		},
+	},
+	"aws_iot_thing": {
+		OperationCreate: {
+			"iot:CreateThing",
+			"iot:DescribeThing",
+		},
+		OperationUpdate: {
+			"iot:DescribeThing",
+			"iot:UpdateThing",
+		},
+		OperationDelete: {
+			"iot:DeleteThing",
+		},
+	},
+	"aws_iot_thing_group": {
+		OperationCreate: {
+			"iot:CreateThingGroup",
+			"iot:DescribeThingGroup",
+		},
+		OperationUpdate: {
+			"iot:DescribeThingGroup",
+			"iot:TagResource",
+			"iot:UntagResource",
+			"iot:UpdateThingGroup",
+		},
+		OperationDelete: {
+			"iot:DeleteThingGroup",
+		},
+	},
+	"aws_iot_thing_group_membership": {
+		OperationCreate: {
+			"iot:AddThingToThingGroup",
+			"iot:ListThingGroupsForThing",
+		},
+		OperationDelete: {
+			"iot:RemoveThingFromThingGroup",
+		},
+	},
+	"aws_iot_thing_principal_attachment": {
+		OperationCreate: {
+			"iot:AttachThingPrincipal",
+			"iot:ListThingPrincipals",
+		},
+		OperationDelete: {
+			"iot:DetachThingPrincipal",
+		},
+	},
+	"aws_iot_thing_type": {
+		OperationCreate: {
+			"iot:CreateThingType",
+			"iot:DeprecateThingType",
+			"iot:DescribeThingType",
+		},
+		OperationUpdate: {
+			"iot:DeprecateThingType",
+			"iot:DescribeThingType",
+			"iot:TagResource",
+			"iot:UntagResource",
+		},
+		OperationDelete: {
+			"iot:DeleteThingType",
+			"iot:DeprecateThingType",
+		},
+	},
+	"aws_iot_topic_rule": {
+		OperationCreate: {
+			"iot:CreateTopicRule",
+			"iot:GetTopicRule",
+			"iot:ListTopicRules",
+		},
+		OperationUpdate: {
+			"iot:GetTopicRule",
+			"iot:ListTopicRules",
+			"iot:ReplaceTopicRule",
+			"iot:TagResource",
+			"iot:UntagResource",
+		},
+		OperationDelete: {
+			"iot:DeleteTopicRule",
+		},
+	},
+	"aws_iot_topic_rule_destination": {
+		OperationCreate: {
+			"iot:CreateTopicRuleDestination",
+			"iot:GetTopicRuleDestination",
+			"iot:ListTopicRuleDestinations",
+			"iot:UpdateTopicRuleDestination",
+		},
+		OperationUpdate: {
+			"iot:GetTopicRuleDestination",
+			"iot:ListTopicRuleDestinations",
+			"iot:UpdateTopicRuleDestination",
+		},
+		OperationDelete: {
+			"iot:DeleteTopicRuleDestination",
+			"iot:GetTopicRuleDestination",
+			"iot:ListTopicRuleDestinations",
+		},
+	},
+	"aws_iotfleetwise_campaign": {
+		OperationCreate: {
+			"iotfleetwise:CreateCampaign",
+			"iotfleetwise:GetCampaign",
+			"iotfleetwise:UpdateCampaign",
+		},
+		OperationUpdate: {
+			"iotfleetwise:GetCampaign",
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateCampaign",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteCampaign",
+		},
+	},
+	"aws_iotfleetwise_decoder_manifest": {
+		OperationCreate: {
+			"iotfleetwise:CreateDecoderManifest",
+			"iotfleetwise:GetDecoderManifest",
+			"iotfleetwise:UpdateDecoderManifest",
+		},
+		OperationUpdate: {
+			"iotfleetwise:GetDecoderManifest",
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateDecoderManifest",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteDecoderManifest",
+		},
+	},
+	"aws_iotfleetwise_fleet": {
+		OperationCreate: {
+			"iotfleetwise:CreateFleet",
+		},
+		OperationUpdate: {
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateFleet",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteFleet",
+		},
+	},
+	"aws_iotfleetwise_model_manifest": {
+		OperationCreate: {
+			"iotfleetwise:CreateModelManifest",
+			"iotfleetwise:GetModelManifest",
+			"iotfleetwise:UpdateModelManifest",
+		},
+		OperationUpdate: {
+			"iotfleetwise:GetModelManifest",
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateModelManifest",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteModelManifest",
+		},
+	},
+	"aws_iotfleetwise_signal_catalog": {
+		OperationCreate: {
+			"iotfleetwise:CreateSignalCatalog",
+		},
+		OperationUpdate: {
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateSignalCatalog",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteSignalCatalog",
+		},
+	},
+	"aws_iotfleetwise_vehicle": {
+		OperationCreate: {
+			"iotfleetwise:AssociateVehicleFleet",
+			"iotfleetwise:CreateVehicle",
+		},
+		OperationUpdate: {
+			"iotfleetwise:AssociateVehicleFleet",
+			"iotfleetwise:DisassociateVehicleFleet",
+			"iotfleetwise:TagResource",
+			"iotfleetwise:UntagResource",
+			"iotfleetwise:UpdateVehicle",
+		},
+		OperationDelete: {
+			"iotfleetwise:DeleteVehicle",
+		},
+	},
+	"aws_ivs_channel": {
+		OperationCreate: {
+			"ivs:CreateChannel",
+			"ivs:GetChannel",
+		},
+		OperationUpdate: {
+			"ivs:GetChannel",
+			"ivs:TagResource",
+			"ivs:UntagResource",
+			"ivs:UpdateChannel",
+		},
+		OperationDelete: {
+			"ivs:DeleteChannel",
+			"ivs:GetChannel",
+		},
+	},
+	"aws_ivs_playback_key_pair": {
+		OperationCreate: {
+			"ivs:GetPlaybackKeyPair",
+			"ivs:ImportPlaybackKeyPair",
+		},
+		OperationDelete: {
+			"ivs:DeletePlaybackKeyPair",
+			"ivs:GetPlaybackKeyPair",
+		},
+	},
+	"aws_ivs_recording_configuration": {
+		OperationCreate: {
+			"ivs:CreateRecordingConfiguration",
+			"ivs:GetRecordingConfiguration",
+		},
+		OperationUpdate: {
+			"ivs:GetRecordingConfiguration",
+			"ivs:TagResource",
+			"ivs:UntagResource",
+		},
+		OperationDelete: {
+			"ivs:DeleteRecordingConfiguration",
+			"ivs:GetRecordingConfiguration",
+		},
+	},
+	"aws_ivschat_logging_configuration": {
+		OperationCreate: {
+			"ivschat:CreateLoggingConfiguration",
+			"ivschat:GetLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"ivschat:GetLoggingConfiguration",
+			"ivschat:TagResource",
+			"ivschat:UntagResource",
+			"ivschat:UpdateLoggingConfiguration",
+		},
+		OperationDelete: {
+			"ivschat:DeleteLoggingConfiguration",
+			"ivschat:GetLoggingConfiguration",
+		},
+	},
+	"aws_ivschat_room": {
+		OperationCreate: {
+			"ivschat:CreateRoom",
+			"ivschat:GetRoom",
+		},
+		OperationUpdate: {
+			"ivschat:GetRoom",
+			"ivschat:TagResource",
+			"ivschat:UntagResource",
+			"ivschat:UpdateRoom",
+		},
+		OperationDelete: {
+			"ivschat:DeleteRoom",
+			"ivschat:GetRoom",
+		},
+	},
+	"aws_kendra_data_source": {
+		OperationCreate: {
+			"kendra:CreateDataSource",
+			"kendra:DescribeDataSource",
+		},
+		OperationUpdate: {
+			"kendra:DescribeDataSource",
+			"kendra:TagResource",
+			"kendra:UntagResource",
+			"kendra:UpdateDataSource",
+		},
+		OperationDelete: {
+			"kendra:DeleteDataSource",
+			"kendra:DescribeDataSource",
+		},
+	},
+	"aws_kendra_experience": {
+		OperationCreate: {
+			"kendra:CreateExperience",
+			"kendra:DescribeExperience",
+		},
+		OperationUpdate: {
+			"kendra:DescribeExperience",
+			"kendra:UpdateExperience",
+		},
+		OperationDelete: {
+			"kendra:DeleteExperience",
+			"kendra:DescribeExperience",
+		},
+	},
+	"aws_kendra_faq": {
+		OperationCreate: {
+			"kendra:CreateFaq",
+			"kendra:DescribeFaq",
+		},
+		OperationUpdate: {
+			"kendra:DescribeFaq",
+			"kendra:TagResource",
+			"kendra:UntagResource",
+		},
+		OperationDelete: {
+			"kendra:DeleteFaq",
+			"kendra:DescribeFaq",
+		},
+	},
+	"aws_kendra_index": {
+		OperationCreate: {
+			"kendra:CreateIndex",
+			"kendra:DescribeIndex",
+			"kendra:UpdateIndex",
+		},
+		OperationUpdate: {
+			"kendra:DescribeIndex",
+			"kendra:TagResource",
+			"kendra:UntagResource",
+			"kendra:UpdateIndex",
+		},
+		OperationDelete: {
+			"kendra:DeleteIndex",
+			"kendra:DescribeIndex",
+		},
+	},
+	"aws_kendra_query_suggestions_block_list": {
+		OperationCreate: {
+			"kendra:CreateQuerySuggestionsBlockList",
+			"kendra:DescribeQuerySuggestionsBlockList",
+		},
+		OperationUpdate: {
+			"kendra:DescribeQuerySuggestionsBlockList",
+			"kendra:TagResource",
+			"kendra:UntagResource",
+			"kendra:UpdateQuerySuggestionsBlockList",
+		},
+		OperationDelete: {
+			"kendra:DeleteQuerySuggestionsBlockList",
+			"kendra:DescribeQuerySuggestionsBlockList",
+		},
+	},
+	"aws_kendra_thesaurus": {
+		OperationCreate: {
+			"kendra:CreateThesaurus",
+			"kendra:DescribeThesaurus",
+		},
+		OperationUpdate: {
+			"kendra:DescribeThesaurus",
+			"kendra:TagResource",
+			"kendra:UntagResource",
+			"kendra:UpdateThesaurus",
+		},
+		OperationDelete: {
+			"kendra:DeleteThesaurus",
+			"kendra:DescribeThesaurus",
+		},
+	},
+	"aws_key_pair": {
+		OperationCreate: {
+			"ec2:DescribeKeyPairs",
+			"ec2:ImportKeyPair",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeKeyPairs",
+		},
+		OperationDelete: {
+			"ec2:DeleteKeyPair",
+		},
+	},
+	"aws_keyspaces_keyspace": {
+		OperationCreate: {
+			"cassandra:Create",
+			"cassandra:Select",
+		},
+		OperationUpdate: {
+			"cassandra:Select",
+			"cassandra:TagResource",
+			"cassandra:UntagResource",
+		},
+		OperationDelete: {
+			"cassandra:Drop",
+			"cassandra:Select",
+		},
+	},
+	"aws_keyspaces_table": {
+		OperationCreate: {
+			"cassandra:Create",
+			"cassandra:Select",
+		},
+		OperationUpdate: {
+			"cassandra:Alter",
+			"cassandra:Select",
+			"cassandra:TagResource",
+			"cassandra:UntagResource",
+		},
+		OperationDelete: {
+			"cassandra:Drop",
+			"cassandra:Select",
+		},
+	},
+	"aws_kinesis_analytics_application": {
+		OperationCreate: {
+			"kinesisanalytics:AddApplicationReferenceDataSource",
+			"kinesisanalytics:CreateApplication",
+			"kinesisanalytics:DescribeApplication",
+			"kinesisanalytics:StartApplication",
+		},
+		OperationUpdate: {
+			"kinesisanalytics:AddApplicationCloudWatchLoggingOption",
+			"kinesisanalytics:AddApplicationInput",
+			"kinesisanalytics:AddApplicationInputProcessingConfiguration",
+			"kinesisanalytics:AddApplicationOutput",
+			"kinesisanalytics:AddApplicationReferenceDataSource",
+			"kinesisanalytics:DeleteApplicationCloudWatchLoggingOption",
+			"kinesisanalytics:DeleteApplicationInputProcessingConfiguration",
+			"kinesisanalytics:DeleteApplicationOutput",
+			"kinesisanalytics:DeleteApplicationReferenceDataSource",
+			"kinesisanalytics:DescribeApplication",
+			"kinesisanalytics:StartApplication",
+			"kinesisanalytics:StopApplication",
+			"kinesisanalytics:TagResource",
+			"kinesisanalytics:UntagResource",
+			"kinesisanalytics:UpdateApplication",
+		},
+		OperationDelete: {
+			"kinesisanalytics:DeleteApplication",
+			"kinesisanalytics:DescribeApplication",
+		},
+	},
+	"aws_kinesis_firehose_delivery_stream": {
+		OperationCreate: {
+			"firehose:CreateDeliveryStream",
+			"firehose:DescribeDeliveryStream",
+			"firehose:StartDeliveryStreamEncryption",
+		},
+		OperationUpdate: {
+			"firehose:DescribeDeliveryStream",
+			"firehose:StartDeliveryStreamEncryption",
+			"firehose:StopDeliveryStreamEncryption",
+			"firehose:TagDeliveryStream",
+			"firehose:UntagDeliveryStream",
+			"firehose:UpdateDestination",
+		},
+		OperationDelete: {
+			"firehose:DeleteDeliveryStream",
+			"firehose:DescribeDeliveryStream",
+		},
+	},
+	"aws_kinesis_resource_policy": {
+		OperationCreate: {
+			"kinesis:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"kinesis:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"kinesis:DeleteResourcePolicy",
+		},
+	},
+	"aws_kinesis_stream": {
+		OperationCreate: {
+			"kinesis:AddTagsToStream",
+			"kinesis:CreateStream",
+			"kinesis:DescribeStreamSummary",
+			"kinesis:EnableEnhancedMonitoring",
+			"kinesis:IncreaseStreamRetentionPeriod",
+			"kinesis:RemoveTagsFromStream",
+			"kinesis:StartStreamEncryption",
+		},
+		OperationUpdate: {
+			"kinesis:AddTagsToStream",
+			"kinesis:DecreaseStreamRetentionPeriod",
+			"kinesis:DescribeStreamSummary",
+			"kinesis:DisableEnhancedMonitoring",
+			"kinesis:EnableEnhancedMonitoring",
+			"kinesis:IncreaseStreamRetentionPeriod",
+			"kinesis:RemoveTagsFromStream",
+			"kinesis:StartStreamEncryption",
+			"kinesis:StopStreamEncryption",
+			"kinesis:UpdateShardCount",
+			"kinesis:UpdateStreamMode",
+		},
+		OperationDelete: {
+			"kinesis:DeleteStream",
+			"kinesis:DescribeStreamSummary",
+		},
+	},
+	"aws_kinesis_stream_consumer": {
+		OperationCreate: {
+			"kinesis:DescribeStreamConsumer",
+			"kinesis:RegisterStreamConsumer",
+		},
+		OperationDelete: {
+			"kinesis:DeregisterStreamConsumer",
+			"kinesis:DescribeStreamConsumer",
+		},
+	},
+	"aws_kinesis_video_stream": {
+		OperationCreate: {
+			"kinesisvideo:CreateStream",
+			"kinesisvideo:DescribeStream",
+		},
+		OperationUpdate: {
+			"kinesisvideo:DescribeStream",
+			"kinesisvideo:TagStream",
+			"kinesisvideo:UntagStream",
+			"kinesisvideo:UpdateStream",
+		},
+		OperationDelete: {
+			"kinesisvideo:DeleteStream",
+			"kinesisvideo:DescribeStream",
+		},
+	},
+	"aws_kinesisanalyticsv2_application": {
+		OperationCreate: {
+			"kinesisanalytics:CreateApplication",
+			"kinesisanalytics:DescribeApplication",
+			"kinesisanalytics:StartApplication",
+		},
+		OperationUpdate: {
+			"kinesisanalytics:AddApplicationCloudWatchLoggingOption",
+			"kinesisanalytics:AddApplicationInput",
+			"kinesisanalytics:AddApplicationInputProcessingConfiguration",
+			"kinesisanalytics:AddApplicationOutput",
+			"kinesisanalytics:AddApplicationReferenceDataSource",
+			"kinesisanalytics:AddApplicationVpcConfiguration",
+			"kinesisanalytics:DeleteApplicationCloudWatchLoggingOption",
+			"kinesisanalytics:DeleteApplicationInputProcessingConfiguration",
+			"kinesisanalytics:DeleteApplicationOutput",
+			"kinesisanalytics:DeleteApplicationReferenceDataSource",
+			"kinesisanalytics:DeleteApplicationVpcConfiguration",
+			"kinesisanalytics:DescribeApplication",
+			"kinesisanalytics:StartApplication",
+			"kinesisanalytics:StopApplication",
+			"kinesisanalytics:TagResource",
+			"kinesisanalytics:UntagResource",
+			"kinesisanalytics:UpdateApplication",
+		},
+		OperationDelete: {
+			"kinesisanalytics:DeleteApplication",
+			"kinesisanalytics:DescribeApplication",
+		},
+	},
+	"aws_kinesisanalyticsv2_application_snapshot": {
+		OperationCreate: {
+			"kinesisanalytics:CreateApplicationSnapshot",
+			"kinesisanalytics:DescribeApplicationSnapshot",
+		},
+		OperationDelete: {
+			"kinesisanalytics:DeleteApplicationSnapshot",
+			"kinesisanalytics:DescribeApplicationSnapshot",
+		},
+	},
+	"aws_kms_alias": {
+		OperationCreate: {
+			"kms:CreateAlias",
+			"kms:ListAliases",
+		},
+		OperationUpdate: {
+			"kms:ListAliases",
+			"kms:UpdateAlias",
+		},
+		OperationDelete: {
+			"kms:DeleteAlias",
+		},
+	},
+	"aws_kms_ciphertext": {
+		OperationCreate: {
+			"kms:Encrypt",
+		},
+	},
+	"aws_kms_custom_key_store": {
+		OperationCreate: {
+			"kms:CreateCustomKeyStore",
+			"kms:DescribeCustomKeyStores",
+		},
+		OperationUpdate: {
+			"kms:DescribeCustomKeyStores",
+			"kms:UpdateCustomKeyStore",
+		},
+		OperationDelete: {
+			"kms:DeleteCustomKeyStore",
+		},
+	},
+	"aws_kms_external_key": {
+		OperationCreate: {
+			"kms:CreateKey",
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:GetParametersForImport",
+			"kms:ImportKeyMaterial",
+			"kms:ListResourceTags",
+		},
+		OperationUpdate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:GetParametersForImport",
+			"kms:ImportKeyMaterial",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+			"kms:TagResource",
+			"kms:UntagResource",
+			"kms:UpdateKeyDescription",
+		},
+		OperationDelete: {
+			"kms:DescribeKey",
+			"kms:ScheduleKeyDeletion",
+		},
+	},
+	"aws_kms_grant": {
+		OperationCreate: {
+			"kms:CreateGrant",
+			"kms:ListGrants",
+		},
+		OperationDelete: {
+			"kms:ListGrants",
+			"kms:RetireGrant",
+			"kms:RevokeGrant",
+		},
+	},
+	"aws_kms_key": {
+		OperationCreate: {
+			"kms:CreateKey",
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:DisableKeyRotation",
+			"kms:EnableKey",
+			"kms:EnableKeyRotation",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+		},
+		OperationUpdate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:DisableKeyRotation",
+			"kms:EnableKey",
+			"kms:EnableKeyRotation",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+			"kms:TagResource",
+			"kms:UntagResource",
+			"kms:UpdateKeyDescription",
+		},
+		OperationDelete: {
+			"kms:DescribeKey",
+			"kms:ScheduleKeyDeletion",
+		},
+	},
+	"aws_kms_key_policy": {
+		OperationCreate: {
+			"kms:DescribeKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+		},
+		OperationUpdate: {
+			"kms:DescribeKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+		},
+		OperationDelete: {
+			"kms:GetKeyPolicy",
+			"kms:PutKeyPolicy",
+		},
+	},
+	"aws_kms_replica_external_key": {
+		OperationCreate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:GetParametersForImport",
+			"kms:ImportKeyMaterial",
+			"kms:ListResourceTags",
+			"kms:ReplicateKey",
+		},
+		OperationUpdate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:GetParametersForImport",
+			"kms:ImportKeyMaterial",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+			"kms:TagResource",
+			"kms:UntagResource",
+			"kms:UpdateKeyDescription",
+		},
+		OperationDelete: {
+			"kms:DescribeKey",
+			"kms:ScheduleKeyDeletion",
+		},
+	},
+	"aws_kms_replica_key": {
+		OperationCreate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+			"kms:ReplicateKey",
+		},
+		OperationUpdate: {
+			"kms:DescribeKey",
+			"kms:DisableKey",
+			"kms:EnableKey",
+			"kms:GetKeyPolicy",
+			"kms:GetKeyRotationStatus",
+			"kms:ListResourceTags",
+			"kms:PutKeyPolicy",
+			"kms:TagResource",
+			"kms:UntagResource",
+			"kms:UpdateKeyDescription",
+		},
+		OperationDelete: {
+			"kms:DescribeKey",
+			"kms:ScheduleKeyDeletion",
+		},
+	},
+	"aws_lakeformation_data_cells_filter": {
+		OperationCreate: {
+			"lakeformation:CreateDataCellsFilter",
+			"lakeformation:GetDataCellsFilter",
+		},
+		OperationUpdate: {
+			"lakeformation:GetDataCellsFilter",
+			"lakeformation:UpdateDataCellsFilter",
+		},
+		OperationDelete: {
+			"lakeformation:DeleteDataCellsFilter",
+		},
+	},
+	"aws_lakeformation_data_lake_settings": {
+		OperationCreate: {
+			"lakeformation:GetDataLakeSettings",
+			"lakeformation:PutDataLakeSettings",
+		},
+		OperationUpdate: {
+			"lakeformation:GetDataLakeSettings",
+			"lakeformation:PutDataLakeSettings",
+		},
+		OperationDelete: {
+			"lakeformation:PutDataLakeSettings",
+		},
+	},
+	"aws_lakeformation_lf_tag": {
+		OperationCreate: {
+			"lakeformation:CreateLFTag",
+			"lakeformation:GetLFTag",
+			"lakeformation:UpdateLFTag",
+		},
+		OperationUpdate: {
+			"lakeformation:GetLFTag",
+			"lakeformation:UpdateLFTag",
+		},
+		OperationDelete: {
+			"lakeformation:DeleteLFTag",
+		},
+	},
+	"aws_lakeformation_permissions": {
+		OperationCreate: {
+			"lakeformation:GrantPermissions",
+			"lakeformation:ListPermissions",
+		},
+		OperationDelete: {
+			"lakeformation:RevokePermissions",
+		},
+	},
+	"aws_lakeformation_resource": {
+		OperationCreate: {
+			"lakeformation:DescribeResource",
+			"lakeformation:RegisterResource",
+		},
+		OperationDelete: {
+			"lakeformation:DeregisterResource",
+		},
+	},
+	"aws_lakeformation_resource_lf_tag": {
+		OperationCreate: {
+			"lakeformation:AddLFTagsToResource",
+			"lakeformation:GetResourceLFTags",
+		},
+		OperationDelete: {
+			"lakeformation:RemoveLFTagsFromResource",
+		},
+	},
+	"aws_lakeformation_resource_lf_tags": {
+		OperationCreate: {
+			"lakeformation:AddLFTagsToResource",
+			"lakeformation:GetResourceLFTags",
+		},
+		OperationDelete: {
+			"lakeformation:RemoveLFTagsFromResource",
+		},
+	},
+	"aws_lambda_alias": {
+		OperationCreate: {
+			"lambda:CreateAlias",
+			"lambda:GetAlias",
+		},
+		OperationUpdate: {
+			"lambda:GetAlias",
+			"lambda:UpdateAlias",
+		},
+		OperationDelete: {
+			"lambda:DeleteAlias",
+		},
+	},
+	"aws_lambda_code_signing_config": {
+		OperationCreate: {
+			"lambda:CreateCodeSigningConfig",
+			"lambda:GetCodeSigningConfig",
+		},
+		OperationUpdate: {
+			"lambda:GetCodeSigningConfig",
+			"lambda:UpdateCodeSigningConfig",
+		},
+		OperationDelete: {
+			"lambda:DeleteCodeSigningConfig",
+		},
+	},
+	"aws_lambda_event_source_mapping": {
+		OperationCreate: {
+			"lambda:CreateEventSourceMapping",
+			"lambda:GetEventSourceMapping",
+		},
+		OperationUpdate: {
+			"lambda:GetEventSourceMapping",
+			"lambda:UpdateEventSourceMapping",
+		},
+		OperationDelete: {
+			"lambda:DeleteEventSourceMapping",
+			"lambda:GetEventSourceMapping",
+		},
+	},
+	"aws_lambda_function": {
+		OperationCreate: {
+			"lambda:CreateFunction",
+			"lambda:GetFunction",
+			"lambda:GetFunctionCodeSigningConfig",
+			"lambda:ListVersionsByFunction",
+			"lambda:PutFunctionConcurrency",
+		},
+		OperationUpdate: {
+			"lambda:DeleteFunctionCodeSigningConfig",
+			"lambda:DeleteFunctionConcurrency",
+			"lambda:GetFunction",
+			"lambda:GetFunctionCodeSigningConfig",
+			"lambda:ListVersionsByFunction",
+			"lambda:PublishVersion",
+			"lambda:PutFunctionCodeSigningConfig",
+			"lambda:PutFunctionConcurrency",
+			"lambda:TagResource",
+			"lambda:UntagResource",
+			"lambda:UpdateFunctionCode",
+			"lambda:UpdateFunctionConfiguration",
+		},
+		OperationDelete: {
+			"lambda:DeleteFunction",
+			"lambda:GetFunction",
+			"lambda:UpdateFunctionConfiguration",
+		},
+	},
+	"aws_lambda_function_event_invoke_config": {
+		OperationCreate: {
+			"lambda:GetFunctionEventInvokeConfig",
+			"lambda:PutFunctionEventInvokeConfig",
+		},
+		OperationUpdate: {
+			"lambda:GetFunctionEventInvokeConfig",
+			"lambda:PutFunctionEventInvokeConfig",
+		},
+		OperationDelete: {
+			"lambda:DeleteFunctionEventInvokeConfig",
+		},
+	},
+	"aws_lambda_function_url": {
+		OperationCreate: {
+			"lambda:AddPermission",
+			"lambda:CreateFunctionUrlConfig",
+			"lambda:GetFunctionUrlConfig",
+		},
+		OperationUpdate: {
+			"lambda:GetFunctionUrlConfig",
+			"lambda:UpdateFunctionUrlConfig",
+		},
+		OperationDelete: {
+			"lambda:DeleteFunctionUrlConfig",
+		},
+	},
+	"aws_lambda_invocation": {
+		OperationCreate: {
+			"lambda:InvokeFunction",
+		},
+		OperationUpdate: {
+			"lambda:InvokeFunction",
+		},
+		OperationDelete: {
+			"lambda:InvokeFunction",
+		},
+	},
+	"aws_lambda_layer_version": {
+		OperationCreate: {
+			"lambda:GetLayerVersion",
+			"lambda:PublishLayerVersion",
+		},
+		OperationDelete: {
+			"lambda:DeleteLayerVersion",
+		},
+	},
+	"aws_lambda_layer_version_permission": {
+		OperationCreate: {
+			"lambda:AddLayerVersionPermission",
+			"lambda:GetLayerVersionPolicy",
+		},
+		OperationDelete: {
+			"lambda:RemoveLayerVersionPermission",
+		},
+	},
+	"aws_lambda_permission": {
+		OperationCreate: {
+			"lambda:AddPermission",
+			"lambda:GetPolicy",
+		},
+		OperationDelete: {
+			"lambda:GetPolicy",
+			"lambda:RemovePermission",
+		},
+	},
+	"aws_lambda_provisioned_concurrency_config": {
+		OperationCreate: {
+			"lambda:GetProvisionedConcurrencyConfig",
+			"lambda:PutProvisionedConcurrencyConfig",
+		},
+		OperationUpdate: {
+			"lambda:GetProvisionedConcurrencyConfig",
+			"lambda:PutProvisionedConcurrencyConfig",
+		},
+		OperationDelete: {
+			"lambda:DeleteProvisionedConcurrencyConfig",
+		},
+	},
+	"aws_lambda_runtime_management_config": {
+		OperationCreate: {
+			"lambda:PutRuntimeManagementConfig",
+		},
+		OperationUpdate: {
+			"lambda:PutRuntimeManagementConfig",
+		},
+		OperationDelete: {
+			"lambda:PutRuntimeManagementConfig",
+		},
+	},
+	"aws_launch_configuration": {
+		OperationCreate: {
+			"autoscaling:DescribeLaunchConfigurations",
+		},
+		OperationDelete: {
+			"autoscaling:DeleteLaunchConfiguration",
+		},
+	},
+	"aws_launch_template": {
+		OperationCreate: {
+			"ec2:CreateLaunchTemplate",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:DescribeLaunchTemplates",
+		},
+		OperationUpdate: {
+			"ec2:CreateLaunchTemplateVersion",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:DescribeLaunchTemplates",
+			"ec2:ModifyLaunchTemplate",
+		},
+		OperationDelete: {
+			"ec2:DeleteLaunchTemplate",
+		},
+	},
+	"aws_lb": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateLoadBalancer",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetIpAddressType",
+			"elasticloadbalancing:SetSecurityGroups",
+			"elasticloadbalancing:SetSubnets",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetIpAddressType",
+			"elasticloadbalancing:SetSecurityGroups",
+			"elasticloadbalancing:SetSubnets",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancer",
+		},
+	},
+	"aws_lb_cookie_stickiness_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:CreateLBCookieStickinessPolicy",
+			"elasticloadbalancing:DescribeLoadBalancerPolicies",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+	},
+	"aws_lb_listener": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateListener",
+			"elasticloadbalancing:DescribeListeners",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeListeners",
+			"elasticloadbalancing:ModifyListener",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteListener",
+		},
+	},
+	"aws_lb_listener_certificate": {
+		OperationCreate: {
+			"elasticloadbalancing:AddListenerCertificates",
+			"elasticloadbalancing:DescribeListenerCertificates",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:RemoveListenerCertificates",
+		},
+	},
+	"aws_lb_listener_rule": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateRule",
+			"elasticloadbalancing:DescribeRules",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeRules",
+			"elasticloadbalancing:ModifyRule",
+			"elasticloadbalancing:RemoveTags",
+			"elasticloadbalancing:SetRulePriorities",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteRule",
+		},
+	},
+	"aws_lb_ssl_negotiation_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:CreateLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancerPolicies",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+	},
+	"aws_lb_target_group": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateTargetGroup",
+			"elasticloadbalancing:DescribeTargetGroupAttributes",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:ModifyTargetGroupAttributes",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeTargetGroupAttributes",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:ModifyTargetGroup",
+			"elasticloadbalancing:ModifyTargetGroupAttributes",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteTargetGroup",
+		},
+	},
+	"aws_lb_target_group_attachment": {
+		OperationCreate: {
+			"elasticloadbalancing:RegisterTargets",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeregisterTargets",
+		},
+	},
+	"aws_lb_trust_store": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:CreateTrustStore",
+			"elasticloadbalancing:DescribeTrustStores",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:DescribeTrustStores",
+			"elasticloadbalancing:ModifyTrustStore",
+			"elasticloadbalancing:RemoveTags",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteTrustStore",
+			"elasticloadbalancing:DescribeTrustStoreAssociations",
+		},
+	},
+	"aws_lb_trust_store_revocation": {
+		OperationCreate: {
+			"elasticloadbalancing:AddTrustStoreRevocations",
+			"elasticloadbalancing:DescribeTrustStoreRevocations",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:RemoveTrustStoreRevocations",
+		},
+	},
+	"aws_lex_bot": {
+		OperationCreate: {
+			"lex:GetBot",
+			"lex:GetBotVersions",
+			"lex:PutBot",
+		},
+		OperationUpdate: {
+			"lex:GetBot",
+			"lex:GetBotVersions",
+			"lex:PutBot",
+		},
+		OperationDelete: {
+			"lex:DeleteBot",
+			"lex:GetBot",
+		},
+	},
+	"aws_lex_bot_alias": {
+		OperationCreate: {
+			"lex:GetBotAlias",
+			"lex:PutBotAlias",
+		},
+		OperationUpdate: {
+			"lex:GetBotAlias",
+			"lex:PutBotAlias",
+		},
+		OperationDelete: {
+			"lex:DeleteBotAlias",
+			"lex:GetBotAlias",
+		},
+	},
+	"aws_lex_intent": {
+		OperationCreate: {
+			"lex:GetIntent",
+			"lex:GetIntentVersions",
+			"lex:PutIntent",
+		},
+		OperationUpdate: {
+			"lex:GetIntent",
+			"lex:GetIntentVersions",
+			"lex:PutIntent",
+		},
+		OperationDelete: {
+			"lex:DeleteIntent",
+			"lex:GetIntentVersions",
+		},
+	},
+	"aws_lex_slot_type": {
+		OperationCreate: {
+			"lex:GetSlotType",
+			"lex:GetSlotTypeVersions",
+			"lex:PutSlotType",
+		},
+		OperationUpdate: {
+			"lex:GetSlotType",
+			"lex:GetSlotTypeVersions",
+			"lex:PutSlotType",
+		},
+		OperationDelete: {
+			"lex:DeleteSlotType",
+			"lex:GetSlotType",
+		},
+	},
+	"aws_lexv2models_bot": {
+		OperationCreate: {
+			"lex:CreateBot",
+			"lex:DescribeBot",
+		},
+		OperationUpdate: {
+			"lex:DescribeBot",
+			"lex:TagResource",
+			"lex:UntagResource",
+			"lex:UpdateBot",
+		},
+		OperationDelete: {
+			"lex:DeleteBot",
+			"lex:DescribeBot",
+		},
+	},
+	"aws_lexv2models_bot_locale": {
+		OperationCreate: {
+			"lex:CreateBotLocale",
+			"lex:DescribeBotLocale",
+		},
+		OperationUpdate: {
+			"lex:DescribeBotLocale",
+			"lex:UpdateBotLocale",
+		},
+		OperationDelete: {
+			"lex:DeleteBotLocale",
+			"lex:DescribeBotLocale",
+		},
+	},
+	"aws_lexv2models_bot_version": {
+		OperationCreate: {
+			"lex:CreateBotVersion",
+			"lex:DescribeBotVersion",
+		},
+		OperationDelete: {
+			"lex:DeleteBotVersion",
+			"lex:DescribeBotVersion",
+		},
+	},
+	"aws_lexv2models_intent": {
+		OperationCreate: {
+			"lex:CreateIntent",
+			"lex:DescribeIntent",
+		},
+		OperationUpdate: {
+			"lex:DescribeIntent",
+			"lex:UpdateIntent",
+		},
+		OperationDelete: {
+			"lex:DeleteIntent",
+			"lex:DescribeIntent",
+		},
+	},
+	"aws_lexv2models_slot": {
+		OperationCreate: {
+			"lex:CreateSlot",
+		},
+		OperationUpdate: {
+			"lex:UpdateSlot",
+		},
+		OperationDelete: {
+			"lex:DeleteSlot",
+		},
+	},
+	"aws_lexv2models_slot_type": {
+		OperationCreate: {
+			"lex:CreateSlotType",
+		},
+		OperationUpdate: {
+			"lex:UpdateSlotType",
+		},
+		OperationDelete: {
+			"lex:DeleteSlotType",
+		},
+	},
+	"aws_licensemanager_association": {
+		OperationCreate: {
+			"license-manager:ListLicenseSpecificationsForResource",
+			"license-manager:UpdateLicenseSpecificationsForResource",
+		},
+		OperationDelete: {
+			"license-manager:UpdateLicenseSpecificationsForResource",
+		},
+	},
+	"aws_licensemanager_grant": {
+		OperationCreate: {
+			"license-manager:CreateGrant",
+			"license-manager:GetGrant",
+		},
+		OperationUpdate: {
+			"license-manager:CreateGrantVersion",
+			"license-manager:GetGrant",
+		},
+		OperationDelete: {
+			"license-manager:DeleteGrant",
+			"license-manager:GetGrant",
+		},
+	},
+	"aws_licensemanager_grant_accepter": {
+		OperationCreate: {
+			"license-manager:AcceptGrant",
+			"license-manager:ListReceivedGrants",
+		},
+		OperationDelete: {
+			"license-manager:RejectGrant",
+		},
+	},
+	"aws_licensemanager_license_configuration": {
+		OperationCreate: {
+			"license-manager:CreateLicenseConfiguration",
+			"license-manager:GetLicenseConfiguration",
+		},
+		OperationUpdate: {
+			"license-manager:GetLicenseConfiguration",
+			"license-manager:TagResource",
+			"license-manager:UntagResource",
+			"license-manager:UpdateLicenseConfiguration",
+		},
+		OperationDelete: {
+			"license-manager:DeleteLicenseConfiguration",
+		},
+	},
+	"aws_lightsail_bucket": {
+		OperationCreate: {
+			"lightsail:CreateBucket",
+			"lightsail:GetBuckets",
+			"lightsail:GetOperation",
+		},
+		OperationUpdate: {
+			"lightsail:GetBuckets",
+			"lightsail:GetOperation",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+			"lightsail:UpdateBucketBundle",
+		},
+		OperationDelete: {
+			"lightsail:DeleteBucket",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_bucket_access_key": {
+		OperationCreate: {
+			"lightsail:CreateBucketAccessKey",
+			"lightsail:GetBucketAccessKeys",
+			"lightsail:GetOperation",
+		},
+		OperationDelete: {
+			"lightsail:DeleteBucketAccessKey",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_bucket_resource_access": {
+		OperationCreate: {
+			"lightsail:GetBuckets",
+			"lightsail:GetOperation",
+			"lightsail:SetResourceAccessForBucket",
+		},
+		OperationDelete: {
+			"lightsail:GetOperation",
+			"lightsail:SetResourceAccessForBucket",
+		},
+	},
+	"aws_lightsail_certificate": {
+		OperationCreate: {
+			"lightsail:CreateCertificate",
+			"lightsail:GetCertificates",
+			"lightsail:GetOperation",
+		},
+		OperationUpdate: {
+			"lightsail:GetCertificates",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+		},
+		OperationDelete: {
+			"lightsail:DeleteCertificate",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_certificate_validation": {
+		OperationCreate: {
+			"lightsail:ChangeResourceRecordSets",
+			"lightsail:GetCertificates",
+			"lightsail:GetChange",
+		},
+		OperationDelete: {
+			"lightsail:ChangeResourceRecordSets",
+			"lightsail:GetCertificates",
+			"lightsail:GetChange",
+		},
+	},
+	"aws_lightsail_container_service": {
+		OperationCreate: {
+			"lightsail:CreateContainerService",
+			"lightsail:GetContainerServices",
+			"lightsail:UpdateContainerService",
+		},
+		OperationUpdate: {
+			"lightsail:GetContainerServices",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+			"lightsail:UpdateContainerService",
+		},
+		OperationDelete: {
+			"lightsail:DeleteContainerService",
+			"lightsail:GetContainerServices",
+		},
+	},
+	"aws_lightsail_container_service_deployment_version": {
+		OperationCreate: {
+			"lightsail:CreateContainerServiceDeployment",
+			"lightsail:GetContainerServiceDeployments",
+		},
+	},
+	"aws_lightsail_database": {
+		OperationCreate: {
+			"lightsail:CreateRelationalDatabase",
+			"lightsail:GetOperation",
+			"lightsail:GetRelationalDatabase",
+			"lightsail:UpdateRelationalDatabase",
+		},
+		OperationUpdate: {
+			"lightsail:GetOperation",
+			"lightsail:GetRelationalDatabase",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+			"lightsail:UpdateRelationalDatabase",
+		},
+		OperationDelete: {
+			"lightsail:DeleteRelationalDatabase",
+			"lightsail:GetOperation",
+			"lightsail:GetRelationalDatabase",
+		},
+	},
+	"aws_lightsail_disk": {
+		OperationCreate: {
+			"lightsail:CreateDisk",
+			"lightsail:GetDisk",
+			"lightsail:GetOperation",
+		},
+		OperationUpdate: {
+			"lightsail:GetDisk",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+		},
+		OperationDelete: {
+			"lightsail:DeleteDisk",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_disk_attachment": {
+		OperationCreate: {
+			"lightsail:AttachDisk",
+			"lightsail:GetDisk",
+			"lightsail:GetOperation",
+		},
+		OperationDelete: {
+			"lightsail:DetachDisk",
+			"lightsail:GetInstanceState",
+			"lightsail:GetOperation",
+			"lightsail:StartInstance",
+			"lightsail:StopInstance",
+		},
+	},
+	"aws_lightsail_distribution": {
+		OperationCreate: {
+			"lightsail:CreateDistribution",
+			"lightsail:GetDistributions",
+			"lightsail:GetOperation",
+			"lightsail:UpdateDistribution",
+		},
+		OperationUpdate: {
+			"lightsail:GetDistributions",
+			"lightsail:GetOperation",
+			"lightsail:SetIpAddressType",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+			"lightsail:UpdateDistribution",
+			"lightsail:UpdateDistributionBundle",
+		},
+		OperationDelete: {
+			"lightsail:DeleteDistribution",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_domain": {
+		OperationCreate: {
+			"lightsail:CreateDomain",
+			"lightsail:GetDomain",
+		},
+		OperationDelete: {
+			"lightsail:DeleteDomain",
+		},
+	},
+	"aws_lightsail_domain_entry": {
+		OperationCreate: {
+			"lightsail:CreateDomainEntry",
+			"lightsail:GetDomain",
+			"lightsail:GetOperation",
+		},
+		OperationDelete: {
+			"lightsail:DeleteDomainEntry",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_instance": {
+		OperationCreate: {
+			"lightsail:CreateInstances",
+			"lightsail:EnableAddOn",
+			"lightsail:GetInstance",
+			"lightsail:GetOperation",
+		},
+		OperationUpdate: {
+			"lightsail:DisableAddOn",
+			"lightsail:EnableAddOn",
+			"lightsail:GetInstance",
+			"lightsail:GetOperation",
+			"lightsail:SetIpAddressType",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+		},
+		OperationDelete: {
+			"lightsail:DeleteInstance",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_instance_public_ports": {
+		OperationCreate: {
+			"lightsail:GetInstancePortStates",
+			"lightsail:PutInstancePublicPorts",
+		},
+		OperationDelete: {
+			"lightsail:CloseInstancePublicPorts",
+		},
+	},
+	"aws_lightsail_key_pair": {
+		OperationCreate: {
+			"lightsail:CreateKeyPair",
+			"lightsail:GetKeyPair",
+			"lightsail:GetOperation",
+			"lightsail:ImportKeyPair",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+		},
+		OperationUpdate: {
+			"lightsail:GetKeyPair",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+		},
+		OperationDelete: {
+			"lightsail:DeleteKeyPair",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_lb": {
+		OperationCreate: {
+			"lightsail:CreateLoadBalancer",
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+		},
+		OperationUpdate: {
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+			"lightsail:TagResource",
+			"lightsail:UntagResource",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+		OperationDelete: {
+			"lightsail:DeleteLoadBalancer",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_lb_attachment": {
+		OperationCreate: {
+			"lightsail:AttachInstancesToLoadBalancer",
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+		},
+		OperationDelete: {
+			"lightsail:DetachInstancesFromLoadBalancer",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_lb_certificate": {
+		OperationCreate: {
+			"lightsail:CreateLoadBalancerTlsCertificate",
+			"lightsail:GetLoadBalancerTlsCertificates",
+			"lightsail:GetOperation",
+		},
+		OperationDelete: {
+			"lightsail:DeleteLoadBalancerTlsCertificate",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_lb_certificate_attachment": {
+		OperationCreate: {
+			"lightsail:AttachLoadBalancerTlsCertificate",
+			"lightsail:GetLoadBalancerTlsCertificates",
+			"lightsail:GetOperation",
+		},
+	},
+	"aws_lightsail_lb_https_redirection_policy": {
+		OperationCreate: {
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+		OperationUpdate: {
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+		OperationDelete: {
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+	},
+	"aws_lightsail_lb_stickiness_policy": {
+		OperationCreate: {
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+		OperationUpdate: {
+			"lightsail:GetLoadBalancer",
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+		OperationDelete: {
+			"lightsail:GetOperation",
+			"lightsail:UpdateLoadBalancerAttribute",
+		},
+	},
+	"aws_lightsail_static_ip": {
+		OperationCreate: {
+			"lightsail:AllocateStaticIp",
+			"lightsail:GetStaticIp",
+		},
+		OperationDelete: {
+			"lightsail:ReleaseStaticIp",
+		},
+	},
+	"aws_lightsail_static_ip_attachment": {
+		OperationCreate: {
+			"lightsail:AttachStaticIp",
+			"lightsail:GetStaticIp",
+		},
+		OperationDelete: {
+			"lightsail:DetachStaticIp",
+		},
+	},
+	"aws_load_balancer_backend_server_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+	},
+	"aws_load_balancer_listener_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+	},
+	"aws_load_balancer_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:CreateLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancerPolicies",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:CreateLoadBalancerPolicy",
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancerPolicies",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+			"elasticloadbalancing:SetLoadBalancerPoliciesOfListener",
+		},
+	},
+	"aws_location_geofence_collection": {
+		OperationCreate: {
+			"geo:CreateGeofenceCollection",
+			"geo:DescribeGeofenceCollection",
+		},
+		OperationUpdate: {
+			"geo:DescribeGeofenceCollection",
+			"geo:TagResource",
+			"geo:UntagResource",
+			"geo:UpdateGeofenceCollection",
+		},
+		OperationDelete: {
+			"geo:DeleteGeofenceCollection",
+		},
+	},
+	"aws_location_map": {
+		OperationCreate: {
+			"geo:CreateMap",
+			"geo:DescribeMap",
+		},
+		OperationUpdate: {
+			"geo:DescribeMap",
+			"geo:TagResource",
+			"geo:UntagResource",
+			"geo:UpdateMap",
+		},
+		OperationDelete: {
+			"geo:DeleteMap",
+		},
+	},
+	"aws_location_place_index": {
+		OperationCreate: {
+			"geo:CreatePlaceIndex",
+			"geo:DescribePlaceIndex",
+		},
+		OperationUpdate: {
+			"geo:DescribePlaceIndex",
+			"geo:TagResource",
+			"geo:UntagResource",
+			"geo:UpdatePlaceIndex",
+		},
+		OperationDelete: {
+			"geo:DeletePlaceIndex",
+		},
+	},
+	"aws_location_route_calculator": {
+		OperationCreate: {
+			"geo:CreateRouteCalculator",
+			"geo:DescribeRouteCalculator",
+		},
+		OperationUpdate: {
+			"geo:DescribeRouteCalculator",
+			"geo:TagResource",
+			"geo:UntagResource",
+			"geo:UpdateRouteCalculator",
+		},
+		OperationDelete: {
+			"geo:DeleteRouteCalculator",
+		},
+	},
+	"aws_location_tracker": {
+		OperationCreate: {
+			"geo:CreateTracker",
+			"geo:DescribeTracker",
+		},
+		OperationUpdate: {
+			"geo:DescribeTracker",
+			"geo:TagResource",
+			"geo:UntagResource",
+			"geo:UpdateTracker",
+		},
+		OperationDelete: {
+			"geo:DeleteTracker",
+		},
+	},
+	"aws_location_tracker_association": {
+		OperationCreate: {
+			"geo:AssociateTrackerConsumer",
+			"geo:ListTrackerConsumers",
+		},
+		OperationDelete: {
+			"geo:DisassociateTrackerConsumer",
+		},
+	},
+	"aws_m2_application": {
+		OperationCreate: {
+			"m2:CreateApplication",
+			"m2:GetApplication",
+		},
+		OperationUpdate: {
+			"m2:GetApplicationVersion",
+			"m2:TagResource",
+			"m2:UntagResource",
+			"m2:UpdateApplication",
+		},
+		OperationDelete: {
+			"m2:DeleteApplication",
+			"m2:GetApplication",
+		},
+	},
+	"aws_m2_deployment": {
+		OperationCreate: {
+			"m2:CreateDeployment",
+			"m2:GetApplication",
+			"m2:GetDeployment",
+			"m2:StartApplication",
+		},
+		OperationUpdate: {
+			"m2:CreateDeployment",
+			"m2:GetApplication",
+			"m2:GetDeployment",
+			"m2:StartApplication",
+			"m2:StopApplication",
+		},
+		OperationDelete: {
+			"m2:DeleteApplicationFromEnvironment",
+			"m2:GetApplication",
+			"m2:StopApplication",
+		},
+	},
+	"aws_m2_environment": {
+		OperationCreate: {
+			"m2:CreateEnvironment",
+			"m2:GetEnvironment",
+		},
+		OperationUpdate: {
+			"m2:GetEnvironment",
+			"m2:TagResource",
+			"m2:UntagResource",
+			"m2:UpdateEnvironment",
+		},
+		OperationDelete: {
+			"m2:DeleteEnvironment",
+			"m2:GetEnvironment",
+		},
+	},
+	"aws_macie2_account": {
+		OperationCreate: {
+			"macie2:EnableMacie",
+			"macie2:GetMacieSession",
+		},
+		OperationUpdate: {
+			"macie2:GetMacieSession",
+			"macie2:UpdateMacieSession",
+		},
+		OperationDelete: {
+			"macie2:DisableMacie",
+		},
+	},
+	"aws_macie2_classification_export_configuration": {
+		OperationCreate: {
+			"macie2:GetClassificationExportConfiguration",
+			"macie2:PutClassificationExportConfiguration",
+		},
+		OperationUpdate: {
+			"macie2:GetClassificationExportConfiguration",
+			"macie2:PutClassificationExportConfiguration",
+		},
+		OperationDelete: {
+			"macie2:PutClassificationExportConfiguration",
+		},
+	},
+	"aws_macie2_classification_job": {
+		OperationCreate: {
+			"macie2:CreateClassificationJob",
+			"macie2:DescribeClassificationJob",
+		},
+		OperationUpdate: {
+			"macie2:DescribeClassificationJob",
+			"macie2:UpdateClassificationJob",
+		},
+		OperationDelete: {
+			"macie2:UpdateClassificationJob",
+		},
+	},
+	"aws_macie2_custom_data_identifier": {
+		OperationCreate: {
+			"macie2:CreateCustomDataIdentifier",
+			"macie2:GetCustomDataIdentifier",
+		},
+		OperationDelete: {
+			"macie2:DeleteCustomDataIdentifier",
+		},
+	},
+	"aws_macie2_findings_filter": {
+		OperationCreate: {
+			"macie2:CreateFindingsFilter",
+			"macie2:GetFindingsFilter",
+		},
+		OperationUpdate: {
+			"macie2:GetFindingsFilter",
+			"macie2:UpdateFindingsFilter",
+		},
+		OperationDelete: {
+			"macie2:DeleteFindingsFilter",
+		},
+	},
+	"aws_macie2_invitation_accepter": {
+		OperationCreate: {
+			"macie2:AcceptInvitation",
+			"macie2:GetAdministratorAccount",
+			"macie2:ListInvitations",
+		},
+		OperationDelete: {
+			"macie2:DisassociateFromAdministratorAccount",
+		},
+	},
+	"aws_macie2_member": {
+		OperationCreate: {
+			"macie2:CreateInvitations",
+			"macie2:CreateMember",
+			"macie2:GetMember",
+			"macie2:ListMembers",
+		},
+		OperationUpdate: {
+			"macie2:CreateInvitations",
+			"macie2:DisassociateMember",
+			"macie2:GetMember",
+			"macie2:ListMembers",
+			"macie2:UpdateMemberSession",
+		},
+		OperationDelete: {
+			"macie2:DeleteMember",
+		},
+	},
+	"aws_macie2_organization_admin_account": {
+		OperationCreate: {
+			"macie2:EnableOrganizationAdminAccount",
+			"macie2:ListOrganizationAdminAccounts",
+		},
+		OperationDelete: {
+			"macie2:DisableOrganizationAdminAccount",
+		},
+	},
+	"aws_main_route_table_association": {
+		OperationCreate: {
+			"ec2:DescribeRouteTables",
+			"ec2:ReplaceRouteTableAssociation",
+		},
+		OperationUpdate: {
+			"ec2:DescribeRouteTables",
+			"ec2:ReplaceRouteTableAssociation",
+		},
+		OperationDelete: {
+			"ec2:DescribeRouteTables",
+			"ec2:ReplaceRouteTableAssociation",
+		},
+	},
+	"aws_marketplacecatalog_change_set": {
+		OperationCreate: {
+			"aws-marketplace:DescribeChangeSet",
+			"aws-marketplace:StartChangeSet",
+		},
+		OperationUpdate: {
+			"aws-marketplace:TagResource",
+			"aws-marketplace:UntagResource",
+		},
+		OperationDelete: {
+			"aws-marketplace:CancelChangeSet",
+			"aws-marketplace:DescribeChangeSet",
+		},
+	},
+	"aws_media_convert_queue": {
+		OperationCreate: {
+			"mediaconvert:CreateQueue",
+			"mediaconvert:GetQueue",
+		},
+		OperationUpdate: {
+			"mediaconvert:GetQueue",
+			"mediaconvert:TagResource",
+			"mediaconvert:UntagResource",
+			"mediaconvert:UpdateQueue",
+		},
+		OperationDelete: {
+			"mediaconvert:DeleteQueue",
+		},
+	},
+	"aws_media_package_channel": {
+		OperationCreate: {
+			"mediapackage:CreateChannel",
+			"mediapackage:DescribeChannel",
+		},
+		OperationUpdate: {
+			"mediapackage:DescribeChannel",
+			"mediapackage:TagResource",
+			"mediapackage:UntagResource",
+			"mediapackage:UpdateChannel",
+		},
+		OperationDelete: {
+			"mediapackage:DeleteChannel",
+			"mediapackage:DescribeChannel",
+		},
+	},
+	"aws_media_store_container": {
+		OperationCreate: {
+			"mediastore:CreateContainer",
+			"mediastore:DescribeContainer",
+		},
+		OperationUpdate: {
+			"mediastore:DescribeContainer",
+			"mediastore:TagResource",
+			"mediastore:UntagResource",
+		},
+		OperationDelete: {
+			"mediastore:DeleteContainer",
+			"mediastore:DescribeContainer",
+		},
+	},
+	"aws_media_store_container_policy": {
+		OperationCreate: {
+			"mediastore:GetContainerPolicy",
+			"mediastore:PutContainerPolicy",
+		},
+		OperationUpdate: {
+			"mediastore:GetContainerPolicy",
+			"mediastore:PutContainerPolicy",
+		},
+		OperationDelete: {
+			"mediastore:DeleteContainerPolicy",
+		},
+	},
+	"aws_medialive_channel": {
+		OperationCreate: {
+			"medialive:CreateChannel",
+			"medialive:DescribeChannel",
+			"medialive:StartChannel",
+		},
+		OperationUpdate: {
+			"medialive:CreateTags",
+			"medialive:DeleteTags",
+			"medialive:DescribeChannel",
+			"medialive:StartChannel",
+			"medialive:StopChannel",
+			"medialive:UpdateChannel",
+		},
+		OperationDelete: {
+			"medialive:DeleteChannel",
+			"medialive:DescribeChannel",
+			"medialive:StopChannel",
+		},
+	},
+	"aws_medialive_input": {
+		OperationCreate: {
+			"medialive:CreateInput",
+			"medialive:DescribeInput",
+		},
+		OperationUpdate: {
+			"medialive:CreateTags",
+			"medialive:DeleteTags",
+			"medialive:DescribeInput",
+			"medialive:UpdateInput",
+		},
+		OperationDelete: {
+			"medialive:DeleteInput",
+			"medialive:DescribeInput",
+		},
+	},
+	"aws_medialive_input_security_group": {
+		OperationCreate: {
+			"medialive:CreateInputSecurityGroup",
+			"medialive:DescribeInputSecurityGroup",
+		},
+		OperationUpdate: {
+			"medialive:CreateTags",
+			"medialive:DeleteTags",
+			"medialive:DescribeInputSecurityGroup",
+			"medialive:UpdateInputSecurityGroup",
+		},
+		OperationDelete: {
+			"medialive:DeleteInputSecurityGroup",
+			"medialive:DescribeInputSecurityGroup",
+		},
+	},
+	"aws_medialive_multiplex": {
+		OperationCreate: {
+			"medialive:CreateMultiplex",
+			"medialive:DescribeMultiplex",
+			"medialive:StartMultiplex",
+		},
+		OperationUpdate: {
+			"medialive:CreateTags",
+			"medialive:DeleteTags",
+			"medialive:DescribeMultiplex",
+			"medialive:StartMultiplex",
+			"medialive:StopMultiplex",
+			"medialive:UpdateMultiplex",
+		},
+		OperationDelete: {
+			"medialive:DeleteMultiplex",
+			"medialive:DescribeMultiplex",
+			"medialive:StopMultiplex",
+		},
+	},
+	"aws_medialive_multiplex_program": {
+		OperationCreate: {
+			"medialive:CreateMultiplexProgram",
+		},
+		OperationUpdate: {
+			"medialive:DescribeMultiplexProgram",
+			"medialive:UpdateMultiplexProgram",
+		},
+		OperationDelete: {
+			"medialive:DeleteMultiplexProgram",
+		},
+	},
+	"aws_memorydb_acl": {
+		OperationCreate: {
+			"memorydb:CreateACL",
+			"memorydb:DescribeACLs",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeACLs",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+			"memorydb:UpdateACL",
+		},
+		OperationDelete: {
+			"memorydb:DeleteACL",
+			"memorydb:DescribeACLs",
+		},
+	},
+	"aws_memorydb_cluster": {
+		OperationCreate: {
+			"memorydb:CreateCluster",
+			"memorydb:DescribeClusters",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeClusters",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+			"memorydb:UpdateCluster",
+		},
+		OperationDelete: {
+			"memorydb:DeleteCluster",
+			"memorydb:DescribeClusters",
+		},
+	},
+	"aws_memorydb_parameter_group": {
+		OperationCreate: {
+			"memorydb:CreateParameterGroup",
+			"memorydb:DescribeParameterGroups",
+			"memorydb:DescribeParameters",
+			"memorydb:ResetParameterGroup",
+			"memorydb:UpdateParameterGroup",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeParameterGroups",
+			"memorydb:DescribeParameters",
+			"memorydb:ResetParameterGroup",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+			"memorydb:UpdateParameterGroup",
+		},
+		OperationDelete: {
+			"memorydb:DeleteParameterGroup",
+		},
+	},
+	"aws_memorydb_snapshot": {
+		OperationCreate: {
+			"memorydb:CreateSnapshot",
+			"memorydb:DescribeSnapshots",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeSnapshots",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+		},
+		OperationDelete: {
+			"memorydb:DeleteSnapshot",
+			"memorydb:DescribeSnapshots",
+		},
+	},
+	"aws_memorydb_subnet_group": {
+		OperationCreate: {
+			"memorydb:CreateSubnetGroup",
+			"memorydb:DescribeSubnetGroups",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeSubnetGroups",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+			"memorydb:UpdateSubnetGroup",
+		},
+		OperationDelete: {
+			"memorydb:DeleteSubnetGroup",
+		},
+	},
+	"aws_memorydb_user": {
+		OperationCreate: {
+			"memorydb:CreateUser",
+			"memorydb:DescribeUsers",
+		},
+		OperationUpdate: {
+			"memorydb:DescribeUsers",
+			"memorydb:TagResource",
+			"memorydb:UntagResource",
+			"memorydb:UpdateUser",
+		},
+		OperationDelete: {
+			"memorydb:DeleteUser",
+			"memorydb:DescribeUsers",
+		},
+	},
+	"aws_mq_broker": {
+		OperationCreate: {
+			"mq:CreateBroker",
+			"mq:DescribeBroker",
+			"mq:DescribeUser",
+		},
+		OperationUpdate: {
+			"mq:CreateTags",
+			"mq:CreateUser",
+			"mq:DeleteTags",
+			"mq:DeleteUser",
+			"mq:DescribeBroker",
+			"mq:RebootBroker",
+			"mq:UpdateBroker",
+			"mq:UpdateUser",
+		},
+		OperationDelete: {
+			"mq:DeleteBroker",
+			"mq:DescribeBroker",
+		},
+	},
+	"aws_mq_configuration": {
+		OperationCreate: {
+			"mq:CreateConfiguration",
+			"mq:DescribeConfiguration",
+			"mq:DescribeConfigurationRevision",
+			"mq:UpdateConfiguration",
+		},
+		OperationUpdate: {
+			"mq:CreateTags",
+			"mq:DeleteTags",
+			"mq:DescribeConfiguration",
+			"mq:DescribeConfigurationRevision",
+			"mq:UpdateConfiguration",
+		},
+	},
+	"aws_msk_cluster": {
+		OperationCreate: {
+			"kafka:CreateCluster",
+			"kafka:DescribeCluster",
+			"kafka:DescribeClusterOperation",
+			"kafka:DescribeClusterV2",
+			"kafka:GetBootstrapBrokers",
+			"kafka:UpdateConnectivity",
+		},
+		OperationUpdate: {
+			"kafka:DescribeCluster",
+			"kafka:DescribeClusterOperation",
+			"kafka:GetBootstrapBrokers",
+			"kafka:TagResource",
+			"kafka:UntagResource",
+			"kafka:UpdateBrokerCount",
+			"kafka:UpdateBrokerStorage",
+			"kafka:UpdateBrokerType",
+			"kafka:UpdateClusterConfiguration",
+			"kafka:UpdateClusterKafkaVersion",
+			"kafka:UpdateConnectivity",
+			"kafka:UpdateMonitoring",
+			"kafka:UpdateSecurity",
+			"kafka:UpdateStorage",
+		},
+		OperationDelete: {
+			"kafka:DeleteCluster",
+			"kafka:DescribeClusterV2",
+		},
+	},
+	"aws_msk_cluster_policy": {
+		OperationCreate: {
+			"kafka:GetClusterPolicy",
+			"kafka:PutClusterPolicy",
+		},
+		OperationUpdate: {
+			"kafka:GetClusterPolicy",
+			"kafka:PutClusterPolicy",
+		},
+		OperationDelete: {
+			"kafka:DeleteClusterPolicy",
+		},
+	},
+	"aws_msk_configuration": {
+		OperationCreate: {
+			"kafka:CreateConfiguration",
+			"kafka:DescribeConfiguration",
+			"kafka:DescribeConfigurationRevision",
+		},
+		OperationUpdate: {
+			"kafka:DescribeConfiguration",
+			"kafka:DescribeConfigurationRevision",
+			"kafka:UpdateConfiguration",
+		},
+		OperationDelete: {
+			"kafka:DeleteConfiguration",
+			"kafka:DescribeConfiguration",
+		},
+	},
+	"aws_msk_replicator": {
+		OperationCreate: {
+			"kafka:CreateReplicator",
+			"kafka:DescribeReplicator",
+		},
+		OperationUpdate: {
+			"kafka:DescribeReplicator",
+			"kafka:TagResource",
+			"kafka:UntagResource",
+			"kafka:UpdateReplicationInfo",
+		},
+		OperationDelete: {
+			"kafka:DeleteReplicator",
+			"kafka:DescribeReplicator",
+		},
+	},
+	"aws_msk_scram_secret_association": {
+		OperationCreate: {
+			"kafka:BatchAssociateScramSecret",
+			"kafka:ListScramSecrets",
+		},
+		OperationUpdate: {
+			"kafka:BatchAssociateScramSecret",
+			"kafka:BatchDisassociateScramSecret",
+			"kafka:ListScramSecrets",
+		},
+		OperationDelete: {
+			"kafka:BatchDisassociateScramSecret",
+		},
+	},
+	"aws_msk_serverless_cluster": {
+		OperationCreate: {
+			"kafka:CreateClusterV2",
+			"kafka:DescribeClusterV2",
+		},
+		OperationUpdate: {
+			"kafka:DescribeClusterV2",
+			"kafka:TagResource",
+			"kafka:UntagResource",
+		},
+		OperationDelete: {
+			"kafka:DeleteCluster",
+			"kafka:DescribeClusterV2",
+		},
+	},
+	"aws_msk_vpc_connection": {
+		OperationCreate: {
+			"kafka:CreateVpcConnection",
+			"kafka:DescribeVpcConnection",
+		},
+		OperationUpdate: {
+			"kafka:DescribeVpcConnection",
+			"kafka:TagResource",
+			"kafka:UntagResource",
+		},
+		OperationDelete: {
+			"kafka:DeleteVpcConnection",
+			"kafka:DescribeVpcConnection",
+		},
+	},
+	"aws_mskconnect_connector": {
+		OperationCreate: {
+			"kafkaconnect:CreateConnector",
+			"kafkaconnect:DescribeConnector",
+		},
+		OperationUpdate: {
+			"kafkaconnect:DescribeConnector",
+			"kafkaconnect:UpdateConnector",
+		},
+		OperationDelete: {
+			"kafkaconnect:DeleteConnector",
+			"kafkaconnect:DescribeConnector",
+		},
+	},
+	"aws_mskconnect_custom_plugin": {
+		OperationCreate: {
+			"kafkaconnect:CreateCustomPlugin",
+			"kafkaconnect:DescribeCustomPlugin",
+		},
+		OperationDelete: {
+			"kafkaconnect:DeleteCustomPlugin",
+			"kafkaconnect:DescribeCustomPlugin",
+		},
+	},
+	"aws_mskconnect_worker_configuration": {
+		OperationCreate: {
+			"kafkaconnect:CreateWorkerConfiguration",
+			"kafkaconnect:DescribeWorkerConfiguration",
+		},
+	},
+	"aws_mwaa_environment": {
+		OperationCreate: {
+			"airflow:CreateEnvironment",
+			"airflow:GetEnvironment",
+		},
+		OperationUpdate: {
+			"airflow:GetEnvironment",
+			"airflow:TagResource",
+			"airflow:UntagResource",
+			"airflow:UpdateEnvironment",
+		},
+		OperationDelete: {
+			"airflow:DeleteEnvironment",
+			"airflow:GetEnvironment",
+		},
+	},
+	"aws_nat_gateway": {
+		OperationCreate: {
+			"ec2:CreateNatGateway",
+			"ec2:DescribeNatGateways",
+		},
+		OperationUpdate: {
+			"ec2:AssignPrivateNatGatewayAddress",
+			"ec2:AssociateNatGatewayAddress",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNatGateways",
+			"ec2:DisassociateNatGatewayAddress",
+			"ec2:UnassignPrivateNatGatewayAddress",
+		},
+		OperationDelete: {
+			"ec2:DeleteNatGateway",
+			"ec2:DescribeNatGateways",
+		},
+	},
+	"aws_neptune_cluster": {
+		OperationCreate: {
+			"rds:AddRoleToDBCluster",
+			"rds:CreateDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RestoreDBClusterFromSnapshot",
+		},
+		OperationUpdate: {
+			"rds:AddRoleToDBCluster",
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RemoveFromGlobalCluster",
+			"rds:RemoveRoleFromDBCluster",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_neptune_cluster_endpoint": {
+		OperationCreate: {
+			"rds:CreateDBClusterEndpoint",
+			"rds:DescribeDBClusterEndpoints",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterEndpoints",
+			"rds:ModifyDBClusterEndpoint",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterEndpoint",
+			"rds:DescribeDBClusterEndpoints",
+		},
+	},
+	"aws_neptune_cluster_instance": {
+		OperationCreate: {
+			"rds:CreateDBInstance",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBInstance",
+			"rds:DescribeDBInstances",
+		},
+	},
+	"aws_neptune_cluster_parameter_group": {
+		OperationCreate: {
+			"rds:CreateDBClusterParameterGroup",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterParameterGroup",
+		},
+	},
+	"aws_neptune_cluster_snapshot": {
+		OperationCreate: {
+			"rds:CreateDBClusterSnapshot",
+			"rds:DescribeDBClusterSnapshots",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterSnapshot",
+		},
+	},
+	"aws_neptune_event_subscription": {
+		OperationCreate: {
+			"rds:CreateEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+		OperationUpdate: {
+			"rds:AddSourceIdentifierToSubscription",
+			"rds:AddTagsToResource",
+			"rds:DescribeEventSubscriptions",
+			"rds:ModifyEventSubscription",
+			"rds:RemoveSourceIdentifierFromSubscription",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteEventSubscription",
+			"rds:DescribeEventSubscriptions",
+		},
+	},
+	"aws_neptune_global_cluster": {
+		OperationCreate: {
+			"rds:CreateGlobalCluster",
+			"rds:DescribeGlobalClusters",
+		},
+		OperationUpdate: {
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:ModifyGlobalCluster",
+		},
+		OperationDelete: {
+			"rds:DeleteGlobalCluster",
+			"rds:DescribeGlobalClusters",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_neptune_parameter_group": {
+		OperationCreate: {
+			"rds:CreateDBParameterGroup",
+			"rds:DescribeDBParameterGroups",
+			"rds:DescribeDBParameters",
+			"rds:ModifyDBParameterGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBParameterGroups",
+			"rds:DescribeDBParameters",
+			"rds:ModifyDBParameterGroup",
+			"rds:RemoveTagsFromResource",
+			"rds:ResetDBParameterGroup",
+		},
+		OperationDelete: {
+			"rds:DeleteDBParameterGroup",
+		},
+	},
+	"aws_neptune_subnet_group": {
+		OperationCreate: {
+			"rds:CreateDBSubnetGroup",
+			"rds:DescribeDBSubnetGroups",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBSubnetGroups",
+			"rds:ModifyDBSubnetGroup",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBSubnetGroup",
+		},
+	},
+	"aws_network_acl": {
+		OperationCreate: {
+			"ec2:CreateNetworkAcl",
+			"ec2:CreateNetworkAclEntry",
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+		OperationUpdate: {
+			"ec2:CreateNetworkAclEntry",
+			"ec2:CreateTags",
+			"ec2:DeleteNetworkAclEntry",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkAcl",
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+	},
+	"aws_network_acl_association": {
+		OperationCreate: {
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+		OperationDelete: {
+			"ec2:DescribeNetworkAcls",
+			"ec2:ReplaceNetworkAclAssociation",
+		},
+	},
+	"aws_network_acl_rule": {
+		OperationCreate: {
+			"ec2:CreateNetworkAclEntry",
+			"ec2:DescribeNetworkAcls",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkAclEntry",
+		},
+	},
+	"aws_network_interface": {
+		OperationCreate: {
+			"ec2:AssignPrivateIpAddresses",
+			"ec2:AttachNetworkInterface",
+			"ec2:CreateNetworkInterface",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:ModifyNetworkInterfaceAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssignIpv6Addresses",
+			"ec2:AssignPrivateIpAddresses",
+			"ec2:AttachNetworkInterface",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DetachNetworkInterface",
+			"ec2:ModifyNetworkInterfaceAttribute",
+			"ec2:UnassignIpv6Addresses",
+			"ec2:UnassignPrivateIpAddresses",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInterface",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DetachNetworkInterface",
+		},
+	},
+	"aws_network_interface_attachment": {
+		OperationCreate: {
+			"ec2:AttachNetworkInterface",
+			"ec2:DescribeNetworkInterfaces",
+		},
+		OperationDelete: {
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DetachNetworkInterface",
+		},
+	},
+	"aws_network_interface_sg_attachment": {
+		OperationCreate: {
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:ModifyNetworkInterfaceAttribute",
+		},
+		OperationDelete: {
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:ModifyNetworkInterfaceAttribute",
+		},
+	},
+	"aws_networkfirewall_firewall": {
+		OperationCreate: {
+			"network-firewall:CreateFirewall",
+			"network-firewall:DescribeFirewall",
+		},
+		OperationUpdate: {
+			"network-firewall:AssociateFirewallPolicy",
+			"network-firewall:AssociateSubnets",
+			"network-firewall:DescribeFirewall",
+			"network-firewall:DisassociateSubnets",
+			"network-firewall:TagResource",
+			"network-firewall:UntagResource",
+			"network-firewall:UpdateFirewallDeleteProtection",
+			"network-firewall:UpdateFirewallDescription",
+			"network-firewall:UpdateFirewallEncryptionConfiguration",
+			"network-firewall:UpdateFirewallPolicyChangeProtection",
+			"network-firewall:UpdateSubnetChangeProtection",
+		},
+		OperationDelete: {
+			"network-firewall:DeleteFirewall",
+			"network-firewall:DescribeFirewall",
+		},
+	},
+	"aws_networkfirewall_firewall_policy": {
+		OperationCreate: {
+			"network-firewall:CreateFirewallPolicy",
+			"network-firewall:DescribeFirewallPolicy",
+		},
+		OperationUpdate: {
+			"network-firewall:DescribeFirewallPolicy",
+			"network-firewall:TagResource",
+			"network-firewall:UntagResource",
+			"network-firewall:UpdateFirewallPolicy",
+		},
+		OperationDelete: {
+			"network-firewall:DeleteFirewallPolicy",
+			"network-firewall:DescribeFirewallPolicy",
+		},
+	},
+	"aws_networkfirewall_logging_configuration": {
+		OperationCreate: {
+			"network-firewall:DescribeLoggingConfiguration",
+			"network-firewall:UpdateLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"network-firewall:DescribeLoggingConfiguration",
+			"network-firewall:UpdateLoggingConfiguration",
+		},
+		OperationDelete: {
+			"network-firewall:DescribeLoggingConfiguration",
+			"network-firewall:UpdateLoggingConfiguration",
+		},
+	},
+	"aws_networkfirewall_resource_policy": {
+		OperationCreate: {
+			"network-firewall:DescribeResourcePolicy",
+			"network-firewall:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"network-firewall:DescribeResourcePolicy",
+			"network-firewall:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"network-firewall:DeleteResourcePolicy",
+		},
+	},
+	"aws_networkfirewall_rule_group": {
+		OperationCreate: {
+			"network-firewall:CreateRuleGroup",
+			"network-firewall:DescribeRuleGroup",
+		},
+		OperationUpdate: {
+			"network-firewall:DescribeRuleGroup",
+			"network-firewall:TagResource",
+			"network-firewall:UntagResource",
+			"network-firewall:UpdateRuleGroup",
+		},
+		OperationDelete: {
+			"network-firewall:DeleteRuleGroup",
+			"network-firewall:DescribeRuleGroup",
+		},
+	},
+	"aws_networkmanager_attachment_accepter": {
+		OperationCreate: {
+			"networkmanager:AcceptAttachment",
+			"networkmanager:GetConnectAttachment",
+			"networkmanager:GetSiteToSiteVpnAttachment",
+			"networkmanager:GetTransitGatewayRouteTableAttachment",
+			"networkmanager:GetVpcAttachment",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteAttachment",
+			"networkmanager:GetVpcAttachment",
+		},
+	},
+	"aws_networkmanager_connect_attachment": {
+		OperationCreate: {
+			"networkmanager:CreateConnectAttachment",
+			"networkmanager:GetConnectAttachment",
+		},
+		OperationUpdate: {
+			"networkmanager:GetConnectAttachment",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteAttachment",
+			"networkmanager:GetConnectAttachment",
+		},
+	},
+	"aws_networkmanager_connect_peer": {
+		OperationCreate: {
+			"networkmanager:CreateConnectPeer",
+			"networkmanager:GetConnectPeer",
+		},
+		OperationUpdate: {
+			"networkmanager:GetConnectPeer",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteConnectPeer",
+			"networkmanager:GetConnectPeer",
+		},
+	},
+	"aws_networkmanager_connection": {
+		OperationCreate: {
+			"networkmanager:CreateConnection",
+			"networkmanager:GetConnections",
+		},
+		OperationUpdate: {
+			"networkmanager:GetConnections",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateConnection",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteConnection",
+			"networkmanager:GetConnections",
+		},
+	},
+	"aws_networkmanager_core_network": {
+		OperationCreate: {
+			"networkmanager:CreateCoreNetwork",
+			"networkmanager:GetCoreNetwork",
+		},
+		OperationUpdate: {
+			"networkmanager:ExecuteCoreNetworkChangeSet",
+			"networkmanager:GetCoreNetwork",
+			"networkmanager:GetCoreNetworkPolicy",
+			"networkmanager:PutCoreNetworkPolicy",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateCoreNetwork",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteCoreNetwork",
+			"networkmanager:GetCoreNetwork",
+		},
+	},
+	"aws_networkmanager_core_network_policy_attachment": {
+		OperationCreate: {
+			"networkmanager:ExecuteCoreNetworkChangeSet",
+			"networkmanager:GetCoreNetwork",
+			"networkmanager:GetCoreNetworkPolicy",
+			"networkmanager:PutCoreNetworkPolicy",
+		},
+		OperationUpdate: {
+			"networkmanager:ExecuteCoreNetworkChangeSet",
+			"networkmanager:GetCoreNetwork",
+			"networkmanager:GetCoreNetworkPolicy",
+			"networkmanager:PutCoreNetworkPolicy",
+		},
+	},
+	"aws_networkmanager_customer_gateway_association": {
+		OperationCreate: {
+			"networkmanager:AssociateCustomerGateway",
+			"networkmanager:GetCustomerGatewayAssociations",
+		},
+		OperationDelete: {
+			"networkmanager:DisassociateCustomerGateway",
+			"networkmanager:GetCustomerGatewayAssociations",
+		},
+	},
+	"aws_networkmanager_device": {
+		OperationCreate: {
+			"networkmanager:CreateDevice",
+			"networkmanager:GetDevices",
+		},
+		OperationUpdate: {
+			"networkmanager:GetDevices",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateDevice",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteDevice",
+			"networkmanager:GetDevices",
+		},
+	},
+	"aws_networkmanager_global_network": {
+		OperationCreate: {
+			"networkmanager:CreateGlobalNetwork",
+			"networkmanager:DescribeGlobalNetworks",
+		},
+		OperationUpdate: {
+			"networkmanager:DescribeGlobalNetworks",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateGlobalNetwork",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteGlobalNetwork",
+			"networkmanager:DeregisterTransitGateway",
+			"networkmanager:DescribeGlobalNetworks",
+			"networkmanager:DisassociateCustomerGateway",
+			"networkmanager:DisassociateTransitGatewayConnectPeer",
+			"networkmanager:GetCustomerGatewayAssociations",
+			"networkmanager:GetTransitGatewayConnectPeerAssociations",
+			"networkmanager:GetTransitGatewayRegistrations",
+		},
+	},
+	"aws_networkmanager_link": {
+		OperationCreate: {
+			"networkmanager:CreateLink",
+			"networkmanager:GetLinks",
+		},
+		OperationUpdate: {
+			"networkmanager:GetLinks",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateLink",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteLink",
+			"networkmanager:GetLinks",
+		},
+	},
+	"aws_networkmanager_link_association": {
+		OperationCreate: {
+			"networkmanager:AssociateLink",
+			"networkmanager:GetLinkAssociations",
+		},
+		OperationDelete: {
+			"networkmanager:DisassociateLink",
+			"networkmanager:GetLinkAssociations",
+		},
+	},
+	"aws_networkmanager_site": {
+		OperationCreate: {
+			"networkmanager:CreateSite",
+			"networkmanager:GetSites",
+		},
+		OperationUpdate: {
+			"networkmanager:GetSites",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateSite",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteSite",
+			"networkmanager:GetSites",
+		},
+	},
+	"aws_networkmanager_site_to_site_vpn_attachment": {
+		OperationCreate: {
+			"networkmanager:CreateSiteToSiteVpnAttachment",
+			"networkmanager:GetSiteToSiteVpnAttachment",
+		},
+		OperationUpdate: {
+			"networkmanager:GetSiteToSiteVpnAttachment",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteAttachment",
+			"networkmanager:GetSiteToSiteVpnAttachment",
+		},
+	},
+	"aws_networkmanager_transit_gateway_connect_peer_association": {
+		OperationCreate: {
+			"networkmanager:AssociateTransitGatewayConnectPeer",
+			"networkmanager:GetTransitGatewayConnectPeerAssociations",
+		},
+		OperationDelete: {
+			"networkmanager:DisassociateTransitGatewayConnectPeer",
+			"networkmanager:GetTransitGatewayConnectPeerAssociations",
+		},
+	},
+	"aws_networkmanager_transit_gateway_peering": {
+		OperationCreate: {
+			"networkmanager:CreateTransitGatewayPeering",
+			"networkmanager:GetTransitGatewayPeering",
+		},
+		OperationUpdate: {
+			"networkmanager:GetTransitGatewayPeering",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+		},
+		OperationDelete: {
+			"networkmanager:DeletePeering",
+			"networkmanager:GetTransitGatewayPeering",
+		},
+	},
+	"aws_networkmanager_transit_gateway_registration": {
+		OperationCreate: {
+			"networkmanager:GetTransitGatewayRegistrations",
+			"networkmanager:RegisterTransitGateway",
+		},
+		OperationDelete: {
+			"networkmanager:DeregisterTransitGateway",
+			"networkmanager:GetTransitGatewayRegistrations",
+		},
+	},
+	"aws_networkmanager_transit_gateway_route_table_attachment": {
+		OperationCreate: {
+			"networkmanager:CreateTransitGatewayRouteTableAttachment",
+			"networkmanager:GetTransitGatewayRouteTableAttachment",
+		},
+		OperationUpdate: {
+			"networkmanager:GetTransitGatewayRouteTableAttachment",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteAttachment",
+			"networkmanager:GetTransitGatewayRouteTableAttachment",
+		},
+	},
+	"aws_networkmanager_vpc_attachment": {
+		OperationCreate: {
+			"networkmanager:CreateVpcAttachment",
+			"networkmanager:GetVpcAttachment",
+		},
+		OperationUpdate: {
+			"networkmanager:GetVpcAttachment",
+			"networkmanager:TagResource",
+			"networkmanager:UntagResource",
+			"networkmanager:UpdateVpcAttachment",
+		},
+		OperationDelete: {
+			"networkmanager:DeleteAttachment",
+			"networkmanager:GetVpcAttachment",
+			"networkmanager:RejectAttachment",
+		},
+	},
+	"aws_oam_link": {
+		OperationCreate: {
+			"oam:CreateLink",
+			"oam:GetLink",
+		},
+		OperationUpdate: {
+			"oam:GetLink",
+			"oam:TagResource",
+			"oam:UntagResource",
+			"oam:UpdateLink",
+		},
+		OperationDelete: {
+			"oam:DeleteLink",
+		},
+	},
+	"aws_oam_sink": {
+		OperationCreate: {
+			"oam:CreateSink",
+			"oam:GetSink",
+		},
+		OperationUpdate: {
+			"oam:GetSink",
+			"oam:TagResource",
+			"oam:UntagResource",
+		},
+		OperationDelete: {
+			"oam:DeleteSink",
+		},
+	},
+	"aws_oam_sink_policy": {
+		OperationCreate: {
+			"oam:GetSinkPolicy",
+			"oam:PutSinkPolicy",
+		},
+		OperationUpdate: {
+			"oam:GetSinkPolicy",
+			"oam:PutSinkPolicy",
+		},
+	},
+	"aws_opensearch_domain": {
+		OperationCreate: {
+			"es:CreateDomain",
+			"es:DescribeDomain",
+			"es:DescribeDomainConfig",
+			"es:UpdateDomainConfig",
+		},
+		OperationUpdate: {
+			"es:AddTags",
+			"es:DescribeDomain",
+			"es:DescribeDomainConfig",
+			"es:GetUpgradeStatus",
+			"es:RemoveTags",
+			"es:UpdateDomainConfig",
+			"es:UpgradeDomain",
+		},
+		OperationDelete: {
+			"es:DeleteDomain",
+			"es:DescribeDomain",
+			"es:DescribeDomainConfig",
+		},
+	},
+	"aws_opensearch_domain_policy": {
+		OperationCreate: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+		OperationUpdate: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+		OperationDelete: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+	},
+	"aws_opensearch_domain_saml_options": {
+		OperationCreate: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+		OperationUpdate: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+		OperationDelete: {
+			"es:DescribeDomain",
+			"es:UpdateDomainConfig",
+		},
+	},
+	"aws_opensearch_inbound_connection_accepter": {
+		OperationCreate: {
+			"es:AcceptInboundConnection",
+			"es:DescribeInboundConnections",
+		},
+		OperationDelete: {
+			"es:DeleteInboundConnection",
+			"es:DescribeInboundConnections",
+			"es:RejectInboundConnection",
+		},
+	},
+	"aws_opensearch_outbound_connection": {
+		OperationCreate: {
+			"es:AcceptInboundConnection",
+			"es:CreateOutboundConnection",
+			"es:DescribeInboundConnections",
+			"es:DescribeOutboundConnections",
+		},
+		OperationDelete: {
+			"es:DeleteOutboundConnection",
+			"es:DescribeOutboundConnections",
+		},
+	},
+	"aws_opensearch_package": {
+		OperationCreate: {
+			"es:CreatePackage",
+			"es:DescribePackages",
+		},
+		OperationUpdate: {
+			"es:DescribePackages",
+			"es:UpdatePackage",
+		},
+		OperationDelete: {
+			"es:DeletePackage",
+		},
+	},
+	"aws_opensearch_package_association": {
+		OperationCreate: {
+			"es:AssociatePackage",
+			"es:ListPackagesForDomain",
+		},
+		OperationDelete: {
+			"es:DissociatePackage",
+			"es:ListPackagesForDomain",
+		},
+	},
+	"aws_opensearch_vpc_endpoint": {
+		OperationCreate: {
+			"es:CreateVpcEndpoint",
+			"es:DescribeVpcEndpoints",
+		},
+		OperationUpdate: {
+			"es:DescribeVpcEndpoints",
+			"es:UpdateVpcEndpoint",
+		},
+		OperationDelete: {
+			"es:DeleteVpcEndpoint",
+			"es:DescribeVpcEndpoints",
+		},
+	},
+	"aws_opensearchserverless_access_policy": {
+		OperationCreate: {
+			"aoss:CreateAccessPolicy",
+		},
+		OperationUpdate: {
+			"aoss:UpdateAccessPolicy",
+		},
+		OperationDelete: {
+			"aoss:DeleteAccessPolicy",
+		},
+	},
+	"aws_opensearchserverless_collection": {
+		OperationCreate: {
+			"aoss:BatchGetCollection",
+			"aoss:CreateCollection",
+		},
+		OperationUpdate: {
+			"aoss:TagResource",
+			"aoss:UntagResource",
+			"aoss:UpdateCollection",
+		},
+		OperationDelete: {
+			"aoss:BatchGetCollection",
+			"aoss:DeleteCollection",
+		},
+	},
+	"aws_opensearchserverless_lifecycle_policy": {
+		OperationCreate: {
+			"aoss:CreateLifecyclePolicy",
+		},
+		OperationUpdate: {
+			"aoss:UpdateLifecyclePolicy",
+		},
+		OperationDelete: {
+			"aoss:DeleteLifecyclePolicy",
+		},
+	},
+	"aws_opensearchserverless_security_config": {
+		OperationCreate: {
+			"aoss:CreateSecurityConfig",
+		},
+		OperationUpdate: {
+			"aoss:UpdateSecurityConfig",
+		},
+		OperationDelete: {
+			"aoss:DeleteSecurityConfig",
+		},
+	},
+	"aws_opensearchserverless_security_policy": {
+		OperationCreate: {
+			"aoss:CreateSecurityPolicy",
+		},
+		OperationUpdate: {
+			"aoss:UpdateSecurityPolicy",
+		},
+		OperationDelete: {
+			"aoss:DeleteSecurityPolicy",
+		},
+	},
+	"aws_opensearchserverless_vpc_endpoint": {
+		OperationCreate: {
+			"aoss:BatchGetVpcEndpoint",
+			"aoss:CreateVpcEndpoint",
+		},
+		OperationUpdate: {
+			"aoss:BatchGetVpcEndpoint",
+			"aoss:UpdateVpcEndpoint",
+		},
+		OperationDelete: {
+			"aoss:BatchGetVpcEndpoint",
+			"aoss:DeleteVpcEndpoint",
+		},
+	},
+	"aws_opsworks_application": {
+		OperationCreate: {
+			"opsworks:CreateApp",
+			"opsworks:DescribeApps",
+		},
+		OperationUpdate: {
+			"opsworks:DescribeApps",
+			"opsworks:UpdateApp",
+		},
+		OperationDelete: {
+			"opsworks:DeleteApp",
+		},
+	},
+	"aws_opsworks_instance": {
+		OperationCreate: {
+			"opsworks:CreateInstance",
+			"opsworks:DescribeInstances",
+			"opsworks:StartInstance",
+		},
+		OperationUpdate: {
+			"opsworks:DescribeInstances",
+			"opsworks:StartInstance",
+			"opsworks:StopInstance",
+			"opsworks:UpdateInstance",
+		},
+		OperationDelete: {
+			"opsworks:DeleteInstance",
+			"opsworks:DescribeInstances",
+			"opsworks:StopInstance",
+		},
+	},
+	"aws_opsworks_permission": {
+		OperationCreate: {
+			"opsworks:DescribePermissions",
+			"opsworks:SetPermission",
+		},
+		OperationUpdate: {
+			"opsworks:DescribePermissions",
+			"opsworks:SetPermission",
+		},
+	},
+	"aws_opsworks_rds_db_instance": {
+		OperationCreate: {
+			"opsworks:DescribeRdsDbInstances",
+		},
+		OperationUpdate: {
+			"opsworks:DescribeRdsDbInstances",
+		},
+	},
+	"aws_opsworks_stack": {
+		OperationCreate: {
+			"opsworks:CreateStack",
+			"opsworks:DescribeStacks",
+			"opsworks:ListTags",
+			"opsworks:TagResource",
+			"opsworks:UntagResource",
+		},
+		OperationUpdate: {
+			"opsworks:DescribeStacks",
+			"opsworks:ListTags",
+			"opsworks:TagResource",
+			"opsworks:UntagResource",
+			"opsworks:UpdateStack",
+		},
+		OperationDelete: {
+			"opsworks:DeleteStack",
+		},
+	},
+	"aws_opsworks_user_profile": {
+		OperationCreate: {
+			"opsworks:CreateUserProfile",
+			"opsworks:DescribeUserProfiles",
+			"opsworks:UpdateUserProfile",
+		},
+		OperationUpdate: {
+			"opsworks:DescribeUserProfiles",
+			"opsworks:UpdateUserProfile",
+		},
+		OperationDelete: {
+			"opsworks:DeleteUserProfile",
+		},
+	},
+	"aws_organizations_account": {
+		OperationCreate: {
+			"organizations:CreateAccount",
+			"organizations:CreateGovCloudAccount",
+			"organizations:DescribeAccount",
+			"organizations:DescribeCreateAccountStatus",
+			"organizations:ListParents",
+			"organizations:MoveAccount",
+		},
+		OperationUpdate: {
+			"organizations:DescribeAccount",
+			"organizations:ListParents",
+			"organizations:MoveAccount",
+			"organizations:TagResource",
+			"organizations:UntagResource",
+		},
+		OperationDelete: {
+			"organizations:CloseAccount",
+			"organizations:DescribeAccount",
+			"organizations:RemoveAccountFromOrganization",
+		},
+	},
+	"aws_organizations_delegated_administrator": {
+		OperationCreate: {
+			"organizations:ListDelegatedAdministrators",
+			"organizations:RegisterDelegatedAdministrator",
+		},
+		OperationDelete: {
+			"organizations:DeregisterDelegatedAdministrator",
+		},
+	},
+	"aws_organizations_organization": {
+		OperationCreate: {
+			"organizations:CreateOrganization",
+			"organizations:DescribeOrganization",
+			"organizations:EnableAWSServiceAccess",
+			"organizations:EnablePolicyType",
+			"organizations:ListAWSServiceAccessForOrganization",
+			"organizations:ListAccounts",
+			"organizations:ListRoots",
+		},
+		OperationUpdate: {
+			"organizations:DescribeOrganization",
+			"organizations:DisableAWSServiceAccess",
+			"organizations:DisablePolicyType",
+			"organizations:EnableAWSServiceAccess",
+			"organizations:EnableAllFeatures",
+			"organizations:EnablePolicyType",
+			"organizations:ListAWSServiceAccessForOrganization",
+			"organizations:ListAccounts",
+			"organizations:ListRoots",
+		},
+		OperationDelete: {
+			"organizations:DeleteOrganization",
+		},
+	},
+	"aws_organizations_organizational_unit": {
+		OperationCreate: {
+			"organizations:CreateOrganizationalUnit",
+			"organizations:DescribeOrganizationalUnit",
+			"organizations:ListAccountsForParent",
+			"organizations:ListParents",
+		},
+		OperationUpdate: {
+			"organizations:TagResource",
+			"organizations:UntagResource",
+			"organizations:UpdateOrganizationalUnit",
+		},
+		OperationDelete: {
+			"organizations:DeleteOrganizationalUnit",
+		},
+	},
+	"aws_organizations_policy": {
+		OperationCreate: {
+			"organizations:CreatePolicy",
+			"organizations:DescribePolicy",
+		},
+		OperationUpdate: {
+			"organizations:DescribePolicy",
+			"organizations:TagResource",
+			"organizations:UntagResource",
+			"organizations:UpdatePolicy",
+		},
+		OperationDelete: {
+			"organizations:DeletePolicy",
+		},
+	},
+	"aws_organizations_policy_attachment": {
+		OperationCreate: {
+			"organizations:AttachPolicy",
+			"organizations:ListTargetsForPolicy",
+		},
+		OperationUpdate: {
+			"organizations:ListTargetsForPolicy",
+		},
+		OperationDelete: {
+			"organizations:DetachPolicy",
+		},
+	},
+	"aws_organizations_resource_policy": {
+		OperationCreate: {
+			"organizations:DescribeResourcePolicy",
+			"organizations:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"organizations:DescribeResourcePolicy",
+			"organizations:PutResourcePolicy",
+			"organizations:TagResource",
+			"organizations:UntagResource",
+		},
+		OperationDelete: {
+			"organizations:DeleteResourcePolicy",
+		},
+	},
+	"aws_osis_pipeline": {
+		OperationCreate: {
+			"osis:CreatePipeline",
+			"osis:GetPipeline",
+		},
+		OperationUpdate: {
+			"osis:GetPipeline",
+			"osis:TagResource",
+			"osis:UntagResource",
+			"osis:UpdatePipeline",
+		},
+		OperationDelete: {
+			"osis:DeletePipeline",
+			"osis:GetPipeline",
+		},
+	},
+	"aws_paymentcryptography_key": {
+		OperationCreate: {
+			"payment-cryptography:CreateKey",
+			"payment-cryptography:GetKey",
+		},
+		OperationUpdate: {
+			"payment-cryptography:GetKey",
+			"payment-cryptography:StartKeyUsage",
+			"payment-cryptography:StopKeyUsage",
+			"payment-cryptography:TagResource",
+			"payment-cryptography:UntagResource",
+		},
+		OperationDelete: {
+			"payment-cryptography:DeleteKey",
+			"payment-cryptography:GetKey",
+		},
+	},
+	"aws_paymentcryptography_key_alias": {
+		OperationCreate: {
+			"payment-cryptography:CreateAlias",
+		},
+		OperationUpdate: {
+			"payment-cryptography:UpdateAlias",
+		},
+		OperationDelete: {
+			"payment-cryptography:DeleteAlias",
+		},
+	},
+	"aws_pinpoint_adm_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetAdmChannel",
+			"mobiletargeting:UpdateAdmChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetAdmChannel",
+			"mobiletargeting:UpdateAdmChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteAdmChannel",
+		},
+	},
+	"aws_pinpoint_apns_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetApnsChannel",
+			"mobiletargeting:UpdateApnsChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetApnsChannel",
+			"mobiletargeting:UpdateApnsChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteApnsChannel",
+		},
+	},
+	"aws_pinpoint_apns_sandbox_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetApnsSandboxChannel",
+			"mobiletargeting:UpdateApnsSandboxChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetApnsSandboxChannel",
+			"mobiletargeting:UpdateApnsSandboxChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteApnsSandboxChannel",
+		},
+	},
+	"aws_pinpoint_apns_voip_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetApnsVoipChannel",
+			"mobiletargeting:UpdateApnsVoipChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetApnsVoipChannel",
+			"mobiletargeting:UpdateApnsVoipChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteApnsVoipChannel",
+		},
+	},
+	"aws_pinpoint_apns_voip_sandbox_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetApnsVoipSandboxChannel",
+			"mobiletargeting:UpdateApnsVoipSandboxChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetApnsVoipSandboxChannel",
+			"mobiletargeting:UpdateApnsVoipSandboxChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteApnsVoipSandboxChannel",
+		},
+	},
+	"aws_pinpoint_app": {
+		OperationCreate: {
+			"mobiletargeting:CreateApp",
+			"mobiletargeting:GetApp",
+			"mobiletargeting:GetApplicationSettings",
+			"mobiletargeting:UpdateApplicationSettings",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetApp",
+			"mobiletargeting:GetApplicationSettings",
+			"mobiletargeting:TagResource",
+			"mobiletargeting:UntagResource",
+			"mobiletargeting:UpdateApplicationSettings",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteApp",
+		},
+	},
+	"aws_pinpoint_baidu_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetBaiduChannel",
+			"mobiletargeting:UpdateBaiduChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetBaiduChannel",
+			"mobiletargeting:UpdateBaiduChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteBaiduChannel",
+		},
+	},
+	"aws_pinpoint_email_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetEmailChannel",
+			"mobiletargeting:UpdateEmailChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetEmailChannel",
+			"mobiletargeting:UpdateEmailChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteEmailChannel",
+		},
+	},
+	"aws_pinpoint_event_stream": {
+		OperationCreate: {
+			"mobiletargeting:GetEventStream",
+			"mobiletargeting:PutEventStream",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetEventStream",
+			"mobiletargeting:PutEventStream",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteEventStream",
+		},
+	},
+	"aws_pinpoint_gcm_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetGcmChannel",
+			"mobiletargeting:UpdateGcmChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetGcmChannel",
+			"mobiletargeting:UpdateGcmChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteGcmChannel",
+		},
+	},
+	"aws_pinpoint_sms_channel": {
+		OperationCreate: {
+			"mobiletargeting:GetSmsChannel",
+			"mobiletargeting:UpdateSmsChannel",
+		},
+		OperationUpdate: {
+			"mobiletargeting:GetSmsChannel",
+			"mobiletargeting:UpdateSmsChannel",
+		},
+		OperationDelete: {
+			"mobiletargeting:DeleteSmsChannel",
+		},
+	},
+	"aws_pipes_pipe": {
+		OperationCreate: {
+			"pipes:CreatePipe",
+			"pipes:DescribePipe",
+		},
+		OperationUpdate: {
+			"pipes:DescribePipe",
+			"pipes:TagResource",
+			"pipes:UntagResource",
+			"pipes:UpdatePipe",
+		},
+		OperationDelete: {
+			"pipes:DeletePipe",
+			"pipes:DescribePipe",
+		},
+	},
+	"aws_placement_group": {
+		OperationCreate: {
+			"ec2:CreatePlacementGroup",
+			"ec2:DescribePlacementGroups",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribePlacementGroups",
+		},
+		OperationDelete: {
+			"ec2:DeletePlacementGroup",
+			"ec2:DescribePlacementGroups",
+		},
+	},
+	"aws_prometheus_alert_manager_definition": {
+		OperationCreate: {
+			"aps:CreateAlertManagerDefinition",
+			"aps:DescribeAlertManagerDefinition",
+		},
+		OperationUpdate: {
+			"aps:DescribeAlertManagerDefinition",
+			"aps:PutAlertManagerDefinition",
+		},
+		OperationDelete: {
+			"aps:DeleteAlertManagerDefinition",
+			"aps:DescribeAlertManagerDefinition",
+		},
+	},
+	"aws_prometheus_rule_group_namespace": {
+		OperationCreate: {
+			"aps:CreateRuleGroupsNamespace",
+			"aps:DescribeRuleGroupsNamespace",
+		},
+		OperationUpdate: {
+			"aps:DescribeRuleGroupsNamespace",
+			"aps:PutRuleGroupsNamespace",
+		},
+		OperationDelete: {
+			"aps:DeleteRuleGroupsNamespace",
+			"aps:DescribeRuleGroupsNamespace",
+		},
+	},
+	"aws_prometheus_scraper": {
+		OperationCreate: {
+			"aps:CreateScraper",
+			"aps:DescribeScraper",
+		},
+		OperationUpdate: {
+			"aps:TagResource",
+			"aps:UntagResource",
+		},
+		OperationDelete: {
+			"aps:DeleteScraper",
+			"aps:DescribeScraper",
+		},
+	},
+	"aws_prometheus_workspace": {
+		OperationCreate: {
+			"aps:CreateLoggingConfiguration",
+			"aps:CreateWorkspace",
+			"aps:DescribeLoggingConfiguration",
+			"aps:DescribeWorkspace",
+		},
+		OperationUpdate: {
+			"aps:CreateLoggingConfiguration",
+			"aps:DeleteLoggingConfiguration",
+			"aps:DescribeLoggingConfiguration",
+			"aps:DescribeWorkspace",
+			"aps:TagResource",
+			"aps:UntagResource",
+			"aps:UpdateLoggingConfiguration",
+			"aps:UpdateWorkspaceAlias",
+		},
+		OperationDelete: {
+			"aps:DeleteWorkspace",
+			"aps:DescribeWorkspace",
+		},
+	},
+	"aws_proxy_protocol_policy": {
+		OperationCreate: {
+			"elasticloadbalancing:CreateLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+		OperationUpdate: {
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+		OperationDelete: {
+			"elasticloadbalancing:DeleteLoadBalancerPolicy",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:SetLoadBalancerPoliciesForBackendServer",
+		},
+	},
+	"aws_qldb_ledger": {
+		OperationCreate: {
+			"qldb:CreateLedger",
+			"qldb:DescribeLedger",
+		},
+		OperationUpdate: {
+			"qldb:DescribeLedger",
+			"qldb:TagResource",
+			"qldb:UntagResource",
+			"qldb:UpdateLedger",
+			"qldb:UpdateLedgerPermissionsMode",
+		},
+		OperationDelete: {
+			"qldb:DeleteLedger",
+			"qldb:DescribeLedger",
+		},
+	},
+	"aws_qldb_stream": {
+		OperationCreate: {
+			"qldb:DescribeJournalKinesisStream",
+			"qldb:StreamJournalToKinesis",
+		},
+		OperationUpdate: {
+			"qldb:DescribeJournalKinesisStream",
+			"qldb:TagResource",
+			"qldb:UntagResource",
+		},
+		OperationDelete: {
+			"qldb:CancelJournalKinesisStream",
+			"qldb:DescribeJournalKinesisStream",
+		},
+	},
+	"aws_quicksight_account_subscription": {
+		OperationCreate: {
+			"quicksight:CreateAccountSubscription",
+			"quicksight:DescribeAccountSubscription",
+		},
+		OperationDelete: {
+			"quicksight:DeleteAccountSubscription",
+			"quicksight:DescribeAccountSubscription",
+		},
+	},
+	"aws_quicksight_analysis": {
+		OperationCreate: {
+			"quicksight:CreateAnalysis",
+			"quicksight:DescribeAnalysis",
+			"quicksight:DescribeAnalysisDefinition",
+			"quicksight:DescribeAnalysisPermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeAnalysis",
+			"quicksight:DescribeAnalysisDefinition",
+			"quicksight:DescribeAnalysisPermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateAnalysis",
+			"quicksight:UpdateAnalysisPermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteAnalysis",
+		},
+	},
+	"aws_quicksight_dashboard": {
+		OperationCreate: {
+			"quicksight:CreateDashboard",
+			"quicksight:DescribeDashboard",
+			"quicksight:DescribeDashboardDefinition",
+			"quicksight:DescribeDashboardPermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeDashboard",
+			"quicksight:DescribeDashboardDefinition",
+			"quicksight:DescribeDashboardPermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateDashboard",
+			"quicksight:UpdateDashboardPermissions",
+			"quicksight:UpdateDashboardPublishedVersion",
+		},
+		OperationDelete: {
+			"quicksight:DeleteDashboard",
+		},
+	},
+	"aws_quicksight_data_set": {
+		OperationCreate: {
+			"quicksight:CreateDataSet",
+			"quicksight:DescribeDataSet",
+			"quicksight:DescribeDataSetPermissions",
+			"quicksight:DescribeDataSetRefreshProperties",
+			"quicksight:PutDataSetRefreshProperties",
+		},
+		OperationUpdate: {
+			"quicksight:DeleteDataSetRefreshProperties",
+			"quicksight:DescribeDataSet",
+			"quicksight:DescribeDataSetPermissions",
+			"quicksight:DescribeDataSetRefreshProperties",
+			"quicksight:PutDataSetRefreshProperties",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateDataSet",
+			"quicksight:UpdateDataSetPermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteDataSet",
+		},
+	},
+	"aws_quicksight_data_source": {
+		OperationCreate: {
+			"quicksight:CreateDataSource",
+			"quicksight:DescribeDataSource",
+			"quicksight:DescribeDataSourcePermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeDataSource",
+			"quicksight:DescribeDataSourcePermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateDataSource",
+			"quicksight:UpdateDataSourcePermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteDataSource",
+		},
+	},
+	"aws_quicksight_folder": {
+		OperationCreate: {
+			"quicksight:CreateFolder",
+			"quicksight:DescribeFolder",
+			"quicksight:DescribeFolderPermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeFolder",
+			"quicksight:DescribeFolderPermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateFolder",
+			"quicksight:UpdateFolderPermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteFolder",
+		},
+	},
+	"aws_quicksight_folder_membership": {
+		OperationCreate: {
+			"quicksight:CreateFolderMembership",
+		},
+		OperationDelete: {
+			"quicksight:DeleteFolderMembership",
+		},
+	},
+	"aws_quicksight_group": {
+		OperationCreate: {
+			"quicksight:CreateGroup",
+			"quicksight:DescribeGroup",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeGroup",
+			"quicksight:UpdateGroup",
+		},
+		OperationDelete: {
+			"quicksight:DeleteGroup",
+		},
+	},
+	"aws_quicksight_group_membership": {
+		OperationCreate: {
+			"quicksight:CreateGroupMembership",
+			"quicksight:ListGroupMemberships",
+		},
+		OperationDelete: {
+			"quicksight:DeleteGroupMembership",
+		},
+	},
+	"aws_quicksight_iam_policy_assignment": {
+		OperationCreate: {
+			"quicksight:CreateIAMPolicyAssignment",
+			"quicksight:DescribeIAMPolicyAssignment",
+		},
+		OperationUpdate: {
+			"quicksight:UpdateIAMPolicyAssignment",
+		},
+		OperationDelete: {
+			"quicksight:DeleteIAMPolicyAssignment",
+			"quicksight:DescribeIAMPolicyAssignment",
+		},
+	},
+	"aws_quicksight_ingestion": {
+		OperationCreate: {
+			"quicksight:CreateIngestion",
+		},
+		OperationDelete: {
+			"quicksight:CancelIngestion",
+		},
+	},
+	"aws_quicksight_namespace": {
+		OperationCreate: {
+			"quicksight:CreateNamespace",
+			"quicksight:DescribeNamespace",
+		},
+		OperationUpdate: {
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+		},
+		OperationDelete: {
+			"quicksight:DeleteNamespace",
+			"quicksight:DescribeNamespace",
+		},
+	},
+	"aws_quicksight_refresh_schedule": {
+		OperationCreate: {
+			"quicksight:CreateRefreshSchedule",
+			"quicksight:DescribeRefreshSchedule",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeRefreshSchedule",
+			"quicksight:UpdateRefreshSchedule",
+		},
+		OperationDelete: {
+			"quicksight:DeleteRefreshSchedule",
+		},
+	},
+	"aws_quicksight_template": {
+		OperationCreate: {
+			"quicksight:CreateTemplate",
+			"quicksight:DescribeTemplate",
+			"quicksight:DescribeTemplateDefinition",
+			"quicksight:DescribeTemplatePermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeTemplate",
+			"quicksight:DescribeTemplateDefinition",
+			"quicksight:DescribeTemplatePermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateTemplate",
+			"quicksight:UpdateTemplatePermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteTemplate",
+		},
+	},
+	"aws_quicksight_template_alias": {
+		OperationCreate: {
+			"quicksight:CreateTemplateAlias",
+		},
+		OperationUpdate: {
+			"quicksight:UpdateTemplateAlias",
+		},
+		OperationDelete: {
+			"quicksight:DeleteTemplateAlias",
+		},
+	},
+	"aws_quicksight_theme": {
+		OperationCreate: {
+			"quicksight:CreateTheme",
+			"quicksight:DescribeTheme",
+			"quicksight:DescribeThemePermissions",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeTheme",
+			"quicksight:DescribeThemePermissions",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateTheme",
+			"quicksight:UpdateThemePermissions",
+		},
+		OperationDelete: {
+			"quicksight:DeleteTheme",
+		},
+	},
+	"aws_quicksight_user": {
+		OperationCreate: {
+			"quicksight:DescribeUser",
+			"quicksight:RegisterUser",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeUser",
+			"quicksight:UpdateUser",
+		},
+		OperationDelete: {
+			"quicksight:DeleteUser",
+		},
+	},
+	"aws_quicksight_vpc_connection": {
+		OperationCreate: {
+			"quicksight:CreateVPCConnection",
+			"quicksight:DescribeVPCConnection",
+		},
+		OperationUpdate: {
+			"quicksight:DescribeVPCConnection",
+			"quicksight:TagResource",
+			"quicksight:UntagResource",
+			"quicksight:UpdateVPCConnection",
+		},
+		OperationDelete: {
+			"quicksight:DeleteVPCConnection",
+			"quicksight:DescribeVPCConnection",
+		},
+	},
+	"aws_ram_principal_association": {
+		OperationCreate: {
+			"ram:AssociateResourceShare",
+			"ram:GetResourceShareAssociations",
+		},
+		OperationDelete: {
+			"ram:DisassociateResourceShare",
+			"ram:GetResourceShareAssociations",
+		},
+	},
+	"aws_ram_resource_association": {
+		OperationCreate: {
+			"ram:AssociateResourceShare",
+			"ram:GetResourceShareAssociations",
+		},
+		OperationDelete: {
+			"ram:DisassociateResourceShare",
+			"ram:GetResourceShareAssociations",
+		},
+	},
+	"aws_ram_resource_share": {
+		OperationCreate: {
+			"ram:CreateResourceShare",
+			"ram:GetResourceShares",
+			"ram:ListResourceSharePermissions",
+		},
+		OperationUpdate: {
+			"ram:GetResourceShares",
+			"ram:ListResourceSharePermissions",
+			"ram:TagResource",
+			"ram:UntagResource",
+			"ram:UpdateResourceShare",
+		},
+		OperationDelete: {
+			"ram:DeleteResourceShare",
+			"ram:GetResourceShares",
+		},
+	},
+	"aws_ram_resource_share_accepter": {
+		OperationCreate: {
+			"ram:AcceptResourceShareInvitation",
+			"ram:GetResourceShareInvitations",
+			"ram:GetResourceShares",
+			"ram:ListResources",
+		},
+		OperationDelete: {
+			"ram:DisassociateResourceShare",
+			"ram:GetResourceShares",
+		},
+	},
+	"aws_ram_sharing_with_organization": {
+		OperationCreate: {
+			"ram:EnableSharingWithAwsOrganization",
+		},
+	},
+	"aws_rbin_rule": {
+		OperationCreate: {
+			"rbin:CreateRule",
+			"rbin:GetRule",
+		},
+		OperationUpdate: {
+			"rbin:GetRule",
+			"rbin:TagResource",
+			"rbin:UntagResource",
+			"rbin:UpdateRule",
+		},
+		OperationDelete: {
+			"rbin:DeleteRule",
+			"rbin:GetRule",
+		},
+	},
+	"aws_rds_cluster": {
+		OperationCreate: {
+			"rds:AddRoleToDBCluster",
+			"rds:CreateDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RestoreDBClusterFromS3",
+			"rds:RestoreDBClusterFromSnapshot",
+			"rds:RestoreDBClusterToPointInTime",
+		},
+		OperationUpdate: {
+			"rds:AddRoleToDBCluster",
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyDBCluster",
+			"rds:RemoveFromGlobalCluster",
+			"rds:RemoveRoleFromDBCluster",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBCluster",
+			"rds:DescribeDBClusters",
+			"rds:ModifyDBCluster",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_rds_cluster_activity_stream": {
+		OperationCreate: {
+			"rds:DescribeDBClusters",
+			"rds:StartActivityStream",
+		},
+		OperationDelete: {
+			"rds:DescribeDBClusters",
+			"rds:StopActivityStream",
+		},
+	},
+	"aws_rds_cluster_endpoint": {
+		OperationCreate: {
+			"rds:CreateDBClusterEndpoint",
+			"rds:DescribeDBClusterEndpoints",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterEndpoints",
+			"rds:ModifyDBClusterEndpoint",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterEndpoint",
+			"rds:DescribeDBClusterEndpoints",
+		},
+	},
+	"aws_rds_cluster_instance": {
+		OperationCreate: {
+			"rds:CreateDBInstance",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:RebootDBInstance",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusters",
+			"rds:DescribeDBInstances",
+			"rds:ModifyDBInstance",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteDBInstance",
+			"rds:DescribeDBInstances",
+		},
+	},
+	"aws_rds_cluster_parameter_group": {
+		OperationCreate: {
+			"rds:CreateDBClusterParameterGroup",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+			"rds:ResetDBClusterParameterGroup",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBClusterParameterGroups",
+			"rds:DescribeDBClusterParameters",
+			"rds:ModifyDBClusterParameterGroup",
+			"rds:RemoveTagsFromResource",
+			"rds:ResetDBClusterParameterGroup",
+		},
+		OperationDelete: {
+			"rds:DeleteDBClusterParameterGroup",
+		},
+	},
+	"aws_rds_cluster_role_association": {
+		OperationCreate: {
+			"rds:AddRoleToDBCluster",
+			"rds:DescribeDBClusters",
+		},
+		OperationDelete: {
+			"rds:DescribeDBClusters",
+			"rds:RemoveRoleFromDBCluster",
+		},
+	},
+	"aws_rds_custom_db_engine_version": {
+		OperationCreate: {
+			"rds:CreateCustomDBEngineVersion",
+			"rds:DescribeDBEngineVersions",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeDBEngineVersions",
+			"rds:ModifyCustomDBEngineVersion",
+			"rds:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"rds:DeleteCustomDBEngineVersion",
+			"rds:DescribeDBEngineVersions",
+		},
+	},
+	"aws_rds_export_task": {
+		OperationCreate: {
+			"rds:DescribeExportTasks",
+			"rds:StartExportTask",
+		},
+		OperationDelete: {
+			"rds:CancelExportTask",
+			"rds:DescribeExportTasks",
+		},
+	},
+	"aws_rds_global_cluster": {
+		OperationCreate: {
+			"rds:CreateGlobalCluster",
+			"rds:DescribeGlobalClusters",
+		},
+		OperationUpdate: {
+			"rds:DescribeDBClusters",
+			"rds:DescribeGlobalClusters",
+			"rds:ModifyGlobalCluster",
+		},
+		OperationDelete: {
+			"rds:DeleteGlobalCluster",
+			"rds:DescribeGlobalClusters",
+			"rds:RemoveFromGlobalCluster",
+		},
+	},
+	"aws_rds_reserved_instance": {
+		OperationCreate: {
+			"rds:DescribeReservedDBInstances",
+			"rds:PurchaseReservedDBInstancesOffering",
+		},
+		OperationUpdate: {
+			"rds:AddTagsToResource",
+			"rds:DescribeReservedDBInstances",
+			"rds:RemoveTagsFromResource",
+		},
+	},
+	"aws_redshift_authentication_profile": {
+		OperationCreate: {
+			"redshift:CreateAuthenticationProfile",
+			"redshift:DescribeAuthenticationProfiles",
+		},
+		OperationUpdate: {
+			"redshift:DescribeAuthenticationProfiles",
+			"redshift:ModifyAuthenticationProfile",
+		},
+		OperationDelete: {
+			"redshift:DeleteAuthenticationProfile",
+		},
+	},
+	"aws_redshift_cluster": {
+		OperationCreate: {
+			"redshift:CreateCluster",
+			"redshift:DescribeClusters",
+			"redshift:DescribeLoggingStatus",
+			"redshift:EnableLogging",
+			"redshift:EnableSnapshotCopy",
+			"redshift:RestoreFromClusterSnapshot",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeClusters",
+			"redshift:DescribeLoggingStatus",
+			"redshift:DisableLogging",
+			"redshift:DisableSnapshotCopy",
+			"redshift:EnableLogging",
+			"redshift:EnableSnapshotCopy",
+			"redshift:ModifyAquaConfiguration",
+			"redshift:ModifyCluster",
+			"redshift:ModifyClusterIamRoles",
+			"redshift:RebootCluster",
+		},
+		OperationDelete: {
+			"redshift:DeleteCluster",
+			"redshift:DescribeClusters",
+		},
+	},
+	"aws_redshift_cluster_iam_roles": {
+		OperationCreate: {
+			"redshift:DescribeClusters",
+			"redshift:ModifyClusterIamRoles",
+		},
+		OperationUpdate: {
+			"redshift:DescribeClusters",
+			"redshift:ModifyClusterIamRoles",
+		},
+		OperationDelete: {
+			"redshift:DescribeClusters",
+			"redshift:ModifyClusterIamRoles",
+		},
+	},
+	"aws_redshift_cluster_snapshot": {
+		OperationCreate: {
+			"redshift:CreateClusterSnapshot",
+			"redshift:DescribeClusterSnapshots",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeClusterSnapshots",
+			"redshift:ModifyClusterSnapshot",
+		},
+		OperationDelete: {
+			"redshift:DeleteClusterSnapshot",
+			"redshift:DescribeClusterSnapshots",
+		},
+	},
+	"aws_redshift_data_share_authorization": {
+		OperationCreate: {
+			"redshift:AuthorizeDataShare",
+		},
+		OperationDelete: {
+			"redshift:DeauthorizeDataShare",
+		},
+	},
+	"aws_redshift_data_share_consumer_association": {
+		OperationCreate: {
+			"redshift:AssociateDataShareConsumer",
+		},
+		OperationDelete: {
+			"redshift:DisassociateDataShareConsumer",
+		},
+	},
+	"aws_redshift_endpoint_access": {
+		OperationCreate: {
+			"redshift:CreateEndpointAccess",
+			"redshift:DescribeEndpointAccess",
+		},
+		OperationUpdate: {
+			"redshift:DescribeEndpointAccess",
+			"redshift:ModifyEndpointAccess",
+		},
+		OperationDelete: {
+			"redshift:DeleteEndpointAccess",
+			"redshift:DescribeEndpointAccess",
+		},
+	},
+	"aws_redshift_endpoint_authorization": {
+		OperationCreate: {
+			"redshift:AuthorizeEndpointAccess",
+			"redshift:DescribeEndpointAuthorization",
+		},
+		OperationUpdate: {
+			"redshift:AuthorizeEndpointAccess",
+			"redshift:DescribeEndpointAuthorization",
+			"redshift:RevokeEndpointAccess",
+		},
+		OperationDelete: {
+			"redshift:RevokeEndpointAccess",
+		},
+	},
+	"aws_redshift_event_subscription": {
+		OperationCreate: {
+			"redshift:CreateEventSubscription",
+			"redshift:DescribeEventSubscriptions",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeEventSubscriptions",
+			"redshift:ModifyEventSubscription",
+		},
+		OperationDelete: {
+			"redshift:DeleteEventSubscription",
+		},
+	},
+	"aws_redshift_hsm_client_certificate": {
+		OperationCreate: {
+			"redshift:CreateHsmClientCertificate",
+			"redshift:DescribeHsmClientCertificates",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeHsmClientCertificates",
+		},
+		OperationDelete: {
+			"redshift:DeleteHsmClientCertificate",
+		},
+	},
+	"aws_redshift_hsm_configuration": {
+		OperationCreate: {
+			"redshift:CreateHsmConfiguration",
+			"redshift:DescribeHsmConfigurations",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeHsmConfigurations",
+		},
+		OperationDelete: {
+			"redshift:DeleteHsmConfiguration",
+		},
+	},
+	"aws_redshift_integration": {
+		OperationCreate: {
+			"redshift:CreateIntegration",
+			"redshift:DescribeIntegrations",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeIntegrations",
+			"redshift:ModifyIntegration",
+		},
+		OperationDelete: {
+			"redshift:DeleteIntegration",
+			"redshift:DescribeIntegrations",
+		},
+	},
+	"aws_redshift_logging": {
+		OperationCreate: {
+			"redshift:EnableLogging",
+		},
+		OperationUpdate: {
+			"redshift:EnableLogging",
+		},
+		OperationDelete: {
+			"redshift:DisableLogging",
+		},
+	},
+	"aws_redshift_parameter_group": {
+		OperationCreate: {
+			"redshift:CreateClusterParameterGroup",
+			"redshift:DescribeClusterParameterGroups",
+			"redshift:DescribeClusterParameters",
+			"redshift:ModifyClusterParameterGroup",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeClusterParameterGroups",
+			"redshift:DescribeClusterParameters",
+			"redshift:ModifyClusterParameterGroup",
+		},
+		OperationDelete: {
+			"redshift:DeleteClusterParameterGroup",
+		},
+	},
+	"aws_redshift_partner": {
+		OperationCreate: {
+			"redshift:AddPartner",
+			"redshift:DescribePartners",
+		},
+		OperationDelete: {
+			"redshift:DeletePartner",
+		},
+	},
+	"aws_redshift_resource_policy": {
+		OperationCreate: {
+			"redshift:GetResourcePolicy",
+			"redshift:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"redshift:GetResourcePolicy",
+			"redshift:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"redshift:DeleteResourcePolicy",
+		},
+	},
+	"aws_redshift_scheduled_action": {
+		OperationCreate: {
+			"redshift:CreateScheduledAction",
+			"redshift:DescribeScheduledActions",
+		},
+		OperationUpdate: {
+			"redshift:ModifyScheduledAction",
+		},
+		OperationDelete: {
+			"redshift:DeleteScheduledAction",
+		},
+	},
+	"aws_redshift_snapshot_copy": {
+		OperationCreate: {
+			"redshift:EnableSnapshotCopy",
+		},
+		OperationUpdate: {
+			"redshift:ModifySnapshotCopyRetentionPeriod",
+		},
+		OperationDelete: {
+			"redshift:DisableSnapshotCopy",
+		},
+	},
+	"aws_redshift_snapshot_copy_grant": {
+		OperationCreate: {
+			"redshift:CreateSnapshotCopyGrant",
+			"redshift:DescribeSnapshotCopyGrants",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeSnapshotCopyGrants",
+		},
+		OperationDelete: {
+			"redshift:DeleteSnapshotCopyGrant",
+			"redshift:DescribeSnapshotCopyGrants",
+		},
+	},
+	"aws_redshift_snapshot_schedule": {
+		OperationCreate: {
+			"redshift:CreateSnapshotSchedule",
+			"redshift:DescribeSnapshotSchedules",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeSnapshotSchedules",
+			"redshift:ModifySnapshotSchedule",
+		},
+		OperationDelete: {
+			"redshift:DeleteSnapshotSchedule",
+			"redshift:DescribeSnapshotSchedules",
+			"redshift:ModifyClusterSnapshotSchedule",
+		},
+	},
+	"aws_redshift_snapshot_schedule_association": {
+		OperationCreate: {
+			"redshift:DescribeSnapshotSchedules",
+			"redshift:ModifyClusterSnapshotSchedule",
+		},
+		OperationDelete: {
+			"redshift:DescribeSnapshotSchedules",
+			"redshift:ModifyClusterSnapshotSchedule",
+		},
+	},
+	"aws_redshift_subnet_group": {
+		OperationCreate: {
+			"redshift:CreateClusterSubnetGroup",
+			"redshift:DescribeClusterSubnetGroups",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeClusterSubnetGroups",
+			"redshift:ModifyClusterSubnetGroup",
+		},
+		OperationDelete: {
+			"redshift:DeleteClusterSubnetGroup",
+		},
+	},
+	"aws_redshift_usage_limit": {
+		OperationCreate: {
+			"redshift:CreateUsageLimit",
+			"redshift:DescribeUsageLimits",
+		},
+		OperationUpdate: {
+			"redshift:CreateTags",
+			"redshift:DeleteTags",
+			"redshift:DescribeUsageLimits",
+			"redshift:ModifyUsageLimit",
+		},
+		OperationDelete: {
+			"redshift:DeleteUsageLimit",
+		},
+	},
+	"aws_redshiftdata_statement": {
+		OperationCreate: {
+			"redshift-data:DescribeStatement",
+			"redshift-data:ExecuteStatement",
+		},
+	},
+	"aws_redshiftserverless_custom_domain_association": {
+		OperationCreate: {
+			"redshift-serverless:CreateCustomDomainAssociation",
+		},
+		OperationUpdate: {
+			"redshift-serverless:UpdateCustomDomainAssociation",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteCustomDomainAssociation",
+		},
+	},
+	"aws_redshiftserverless_endpoint_access": {
+		OperationCreate: {
+			"redshift-serverless:CreateEndpointAccess",
+			"redshift-serverless:GetEndpointAccess",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetEndpointAccess",
+			"redshift-serverless:UpdateEndpointAccess",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteEndpointAccess",
+			"redshift-serverless:GetEndpointAccess",
+		},
+	},
+	"aws_redshiftserverless_namespace": {
+		OperationCreate: {
+			"redshift-serverless:CreateNamespace",
+			"redshift-serverless:GetNamespace",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetNamespace",
+			"redshift-serverless:TagResource",
+			"redshift-serverless:UntagResource",
+			"redshift-serverless:UpdateNamespace",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteNamespace",
+			"redshift-serverless:GetNamespace",
+		},
+	},
+	"aws_redshiftserverless_resource_policy": {
+		OperationCreate: {
+			"redshift-serverless:GetResourcePolicy",
+			"redshift-serverless:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetResourcePolicy",
+			"redshift-serverless:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteResourcePolicy",
+		},
+	},
+	"aws_redshiftserverless_snapshot": {
+		OperationCreate: {
+			"redshift-serverless:CreateSnapshot",
+			"redshift-serverless:GetSnapshot",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetSnapshot",
+			"redshift-serverless:UpdateSnapshot",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteSnapshot",
+			"redshift-serverless:GetSnapshot",
+		},
+	},
+	"aws_redshiftserverless_usage_limit": {
+		OperationCreate: {
+			"redshift-serverless:CreateUsageLimit",
+			"redshift-serverless:GetUsageLimit",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetUsageLimit",
+			"redshift-serverless:UpdateUsageLimit",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteUsageLimit",
+		},
+	},
+	"aws_redshiftserverless_workgroup": {
+		OperationCreate: {
+			"redshift-serverless:CreateWorkgroup",
+			"redshift-serverless:GetWorkgroup",
+		},
+		OperationUpdate: {
+			"redshift-serverless:GetWorkgroup",
+			"redshift-serverless:TagResource",
+			"redshift-serverless:UntagResource",
+			"redshift-serverless:UpdateWorkgroup",
+		},
+		OperationDelete: {
+			"redshift-serverless:DeleteWorkgroup",
+			"redshift-serverless:GetWorkgroup",
+		},
+	},
+	"aws_rekognition_collection": {
+		OperationCreate: {
+			"rekognition:CreateCollection",
+			"rekognition:DescribeCollection",
+		},
+		OperationUpdate: {
+			"rekognition:TagResource",
+			"rekognition:UntagResource",
+		},
+		OperationDelete: {
+			"rekognition:DeleteCollection",
+		},
+	},
+	"aws_rekognition_project": {
+		OperationCreate: {
+			"rekognition:CreateProject",
+			"rekognition:DescribeProjects",
+		},
+		OperationDelete: {
+			"rekognition:DeleteProject",
+			"rekognition:DescribeProjects",
+		},
+	},
+	"aws_resourceexplorer2_index": {
+		OperationCreate: {
+			"resource-explorer-2:CreateIndex",
+			"resource-explorer-2:GetIndex",
+			"resource-explorer-2:UpdateIndexType",
+		},
+		OperationUpdate: {
+			"resource-explorer-2:GetIndex",
+			"resource-explorer-2:TagResource",
+			"resource-explorer-2:UntagResource",
+			"resource-explorer-2:UpdateIndexType",
+		},
+		OperationDelete: {
+			"resource-explorer-2:DeleteIndex",
+			"resource-explorer-2:GetIndex",
+		},
+	},
+	"aws_resourceexplorer2_view": {
+		OperationCreate: {
+			"resource-explorer-2:AssociateDefaultView",
+			"resource-explorer-2:CreateView",
+		},
+		OperationUpdate: {
+			"resource-explorer-2:AssociateDefaultView",
+			"resource-explorer-2:DisassociateDefaultView",
+			"resource-explorer-2:TagResource",
+			"resource-explorer-2:UntagResource",
+			"resource-explorer-2:UpdateView",
+		},
+		OperationDelete: {
+			"resource-explorer-2:DeleteView",
+		},
+	},
+	"aws_resourcegroups_group": {
+		OperationCreate: {
+			"resource-groups:CreateGroup",
+			"resource-groups:GetGroup",
+			"resource-groups:GetGroupConfiguration",
+			"resource-groups:GetGroupQuery",
+		},
+		OperationUpdate: {
+			"resource-groups:GetGroup",
+			"resource-groups:GetGroupConfiguration",
+			"resource-groups:GetGroupQuery",
+			"resource-groups:PutGroupConfiguration",
+			"resource-groups:Tag",
+			"resource-groups:Untag",
+			"resource-groups:UpdateGroup",
+			"resource-groups:UpdateGroupQuery",
+		},
+		OperationDelete: {
+			"resource-groups:DeleteGroup",
+		},
+	},
+	"aws_resourcegroups_resource": {
+		OperationCreate: {
+			"resource-groups:GroupResources",
+			"resource-groups:ListGroupResources",
+		},
+		OperationDelete: {
+			"resource-groups:ListGroupResources",
+			"resource-groups:UngroupResources",
+		},
+	},
+	"aws_resourcegroups_tag_sync_task": {
+		OperationCreate: {
+			"resource-groups:GetTagSyncTask",
+			"resource-groups:StartTagSyncTask",
+		},
+		OperationDelete: {
+			"resource-groups:CancelTagSyncTask",
+		},
+	},
+	"aws_rolesanywhere_crl": {
+		OperationCreate: {
+			"rolesanywhere:GetCrl",
+			"rolesanywhere:ImportCrl",
+		},
+		OperationUpdate: {
+			"rolesanywhere:DisableCrl",
+			"rolesanywhere:EnableCrl",
+			"rolesanywhere:GetCrl",
+			"rolesanywhere:TagResource",
+			"rolesanywhere:UntagResource",
+			"rolesanywhere:UpdateCrl",
+		},
+		OperationDelete: {
+			"rolesanywhere:DeleteCrl",
+		},
+	},
+	"aws_rolesanywhere_profile": {
+		OperationCreate: {
+			"rolesanywhere:CreateProfile",
+			"rolesanywhere:GetProfile",
+		},
+		OperationUpdate: {
+			"rolesanywhere:DisableProfile",
+			"rolesanywhere:EnableProfile",
+			"rolesanywhere:GetProfile",
+			"rolesanywhere:TagResource",
+			"rolesanywhere:UntagResource",
+			"rolesanywhere:UpdateProfile",
+		},
+		OperationDelete: {
+			"rolesanywhere:DeleteProfile",
+		},
+	},
+	"aws_rolesanywhere_trust_anchor": {
+		OperationCreate: {
+			"rolesanywhere:CreateTrustAnchor",
+			"rolesanywhere:GetTrustAnchor",
+		},
+		OperationUpdate: {
+			"rolesanywhere:DisableTrustAnchor",
+			"rolesanywhere:EnableTrustAnchor",
+			"rolesanywhere:GetTrustAnchor",
+			"rolesanywhere:PutNotificationSettings",
+			"rolesanywhere:ResetNotificationSettings",
+			"rolesanywhere:TagResource",
+			"rolesanywhere:UntagResource",
+			"rolesanywhere:UpdateTrustAnchor",
+		},
+		OperationDelete: {
+			"rolesanywhere:DeleteTrustAnchor",
+		},
+	},
+	"aws_route": {
+		OperationCreate: {
+			"ec2:CreateRoute",
+			"ec2:DescribeRouteTables",
+		},
+		OperationUpdate: {
+			"ec2:DescribeRouteTables",
+			"ec2:ReplaceRoute",
+		},
+		OperationDelete: {
+			"ec2:DeleteRoute",
+			"ec2:DescribeRouteTables",
+		},
+	},
+	"aws_route53_cidr_collection": {
+		OperationCreate: {
+			"route53:CreateCidrCollection",
+		},
+		OperationDelete: {
+			"route53:DeleteCidrCollection",
+		},
+	},
+	"aws_route53_cidr_location": {
+		OperationCreate: {
+			"route53:ChangeCidrCollection",
+			"route53:ListCidrCollections",
+		},
+		OperationUpdate: {
+			"route53:ChangeCidrCollection",
+			"route53:ListCidrCollections",
+		},
+		OperationDelete: {
+			"route53:ChangeCidrCollection",
+			"route53:ListCidrCollections",
+		},
+	},
+	"aws_route53_delegation_set": {
+		OperationCreate: {
+			"route53:CreateReusableDelegationSet",
+			"route53:GetReusableDelegationSet",
+		},
+		OperationDelete: {
+			"route53:DeleteReusableDelegationSet",
+		},
+	},
+	"aws_route53_health_check": {
+		OperationCreate: {
+			"route53:ChangeTagsForResource",
+			"route53:CreateHealthCheck",
+			"route53:GetHealthCheck",
+		},
+		OperationUpdate: {
+			"route53:ChangeTagsForResource",
+			"route53:GetHealthCheck",
+			"route53:UpdateHealthCheck",
+		},
+		OperationDelete: {
+			"route53:DeleteHealthCheck",
+		},
+	},
+	"aws_route53_hosted_zone_dnssec": {
+		OperationCreate: {
+			"route53:DisableHostedZoneDNSSEC",
+			"route53:EnableHostedZoneDNSSEC",
+			"route53:GetChange",
+			"route53:GetDNSSEC",
+		},
+		OperationUpdate: {
+			"route53:DisableHostedZoneDNSSEC",
+			"route53:EnableHostedZoneDNSSEC",
+			"route53:GetChange",
+			"route53:GetDNSSEC",
+		},
+		OperationDelete: {
+			"route53:DisableHostedZoneDNSSEC",
+			"route53:GetChange",
+		},
+	},
+	"aws_route53_key_signing_key": {
+		OperationCreate: {
+			"route53:CreateKeySigningKey",
+			"route53:GetChange",
+			"route53:GetDNSSEC",
+		},
+		OperationUpdate: {
+			"route53:ActivateKeySigningKey",
+			"route53:DeactivateKeySigningKey",
+			"route53:GetChange",
+			"route53:GetDNSSEC",
+		},
+		OperationDelete: {
+			"route53:DeactivateKeySigningKey",
+			"route53:DeleteKeySigningKey",
+			"route53:GetChange",
+		},
+	},
+	"aws_route53_query_log": {
+		OperationCreate: {
+			"route53:CreateQueryLoggingConfig",
+			"route53:GetQueryLoggingConfig",
+		},
+		OperationDelete: {
+			"route53:DeleteQueryLoggingConfig",
+		},
+	},
+	"aws_route53_record": {
+		OperationCreate: {
+			"route53:ChangeResourceRecordSets",
+			"route53:GetChange",
+			"route53:GetHostedZone",
+			"route53:ListResourceRecordSets",
+		},
+		OperationUpdate: {
+			"route53:ChangeResourceRecordSets",
+			"route53:GetChange",
+			"route53:GetHostedZone",
+			"route53:ListResourceRecordSets",
+		},
+		OperationDelete: {
+			"route53:ChangeResourceRecordSets",
+			"route53:GetChange",
+			"route53:GetHostedZone",
+			"route53:ListResourceRecordSets",
+		},
+	},
+	"aws_route53_resolver_config": {
+		OperationCreate: {
+			"route53resolver:ListResolverConfigs",
+			"route53resolver:UpdateResolverConfig",
+		},
+		OperationUpdate: {
+			"route53resolver:ListResolverConfigs",
+			"route53resolver:UpdateResolverConfig",
+		},
+	},
+	"aws_route53_resolver_dnssec_config": {
+		OperationCreate: {
+			"route53resolver:ListResolverDnssecConfigs",
+			"route53resolver:UpdateResolverDnssecConfig",
+		},
+		OperationDelete: {
+			"route53resolver:ListResolverDnssecConfigs",
+			"route53resolver:UpdateResolverDnssecConfig",
+		},
+	},
+	"aws_route53_resolver_endpoint": {
+		OperationCreate: {
+			"route53resolver:CreateResolverEndpoint",
+			"route53resolver:GetResolverEndpoint",
+			"route53resolver:ListResolverEndpointIpAddresses",
+		},
+		OperationUpdate: {
+			"route53resolver:AssociateResolverEndpointIpAddress",
+			"route53resolver:DisassociateResolverEndpointIpAddress",
+			"route53resolver:GetResolverEndpoint",
+			"route53resolver:ListResolverEndpointIpAddresses",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+			"route53resolver:UpdateResolverEndpoint",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteResolverEndpoint",
+			"route53resolver:GetResolverEndpoint",
+		},
+	},
+	"aws_route53_resolver_firewall_config": {
+		OperationCreate: {
+			"route53resolver:ListFirewallConfigs",
+			"route53resolver:UpdateFirewallConfig",
+		},
+		OperationUpdate: {
+			"route53resolver:ListFirewallConfigs",
+			"route53resolver:UpdateFirewallConfig",
+		},
+		OperationDelete: {
+			"route53resolver:UpdateFirewallConfig",
+		},
+	},
+	"aws_route53_resolver_firewall_domain_list": {
+		OperationCreate: {
+			"route53resolver:CreateFirewallDomainList",
+			"route53resolver:GetFirewallDomainList",
+			"route53resolver:ListFirewallDomains",
+			"route53resolver:UpdateFirewallDomains",
+		},
+		OperationUpdate: {
+			"route53resolver:GetFirewallDomainList",
+			"route53resolver:ListFirewallDomains",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+			"route53resolver:UpdateFirewallDomains",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteFirewallDomainList",
+			"route53resolver:GetFirewallDomainList",
+		},
+	},
+	"aws_route53_resolver_firewall_rule": {
+		OperationCreate: {
+			"route53resolver:CreateFirewallRule",
+			"route53resolver:ListFirewallRules",
+		},
+		OperationUpdate: {
+			"route53resolver:ListFirewallRules",
+			"route53resolver:UpdateFirewallRule",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteFirewallRule",
+		},
+	},
+	"aws_route53_resolver_firewall_rule_group": {
+		OperationCreate: {
+			"route53resolver:CreateFirewallRuleGroup",
+			"route53resolver:GetFirewallRuleGroup",
+		},
+		OperationUpdate: {
+			"route53resolver:GetFirewallRuleGroup",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteFirewallRuleGroup",
+		},
+	},
+	"aws_route53_resolver_firewall_rule_group_association": {
+		OperationCreate: {
+			"route53resolver:AssociateFirewallRuleGroup",
+			"route53resolver:GetFirewallRuleGroupAssociation",
+		},
+		OperationUpdate: {
+			"route53resolver:GetFirewallRuleGroupAssociation",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+			"route53resolver:UpdateFirewallRuleGroupAssociation",
+		},
+		OperationDelete: {
+			"route53resolver:DisassociateFirewallRuleGroup",
+			"route53resolver:GetFirewallRuleGroupAssociation",
+		},
+	},
+	"aws_route53_resolver_query_log_config": {
+		OperationCreate: {
+			"route53resolver:CreateResolverQueryLogConfig",
+			"route53resolver:GetResolverQueryLogConfig",
+		},
+		OperationUpdate: {
+			"route53resolver:GetResolverQueryLogConfig",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteResolverQueryLogConfig",
+			"route53resolver:GetResolverQueryLogConfig",
+		},
+	},
+	"aws_route53_resolver_query_log_config_association": {
+		OperationCreate: {
+			"route53resolver:AssociateResolverQueryLogConfig",
+			"route53resolver:GetResolverQueryLogConfigAssociation",
+		},
+		OperationDelete: {
+			"route53resolver:DisassociateResolverQueryLogConfig",
+			"route53resolver:GetResolverQueryLogConfigAssociation",
+		},
+	},
+	"aws_route53_resolver_rule": {
+		OperationCreate: {
+			"route53resolver:CreateResolverRule",
+			"route53resolver:GetResolverRule",
+		},
+		OperationUpdate: {
+			"route53resolver:GetResolverRule",
+			"route53resolver:TagResource",
+			"route53resolver:UntagResource",
+			"route53resolver:UpdateResolverRule",
+		},
+		OperationDelete: {
+			"route53resolver:DeleteResolverRule",
+			"route53resolver:GetResolverRule",
+		},
+	},
+	"aws_route53_resolver_rule_association": {
+		OperationCreate: {
+			"route53resolver:AssociateResolverRule",
+			"route53resolver:GetResolverRuleAssociation",
+		},
+		OperationDelete: {
+			"route53resolver:DisassociateResolverRule",
+			"route53resolver:GetResolverRuleAssociation",
+		},
+	},
+	"aws_route53_traffic_policy": {
+		OperationCreate: {
+			"route53:CreateTrafficPolicy",
+			"route53:GetTrafficPolicy",
+			"route53:ListTrafficPolicies",
+		},
+		OperationUpdate: {
+			"route53:GetTrafficPolicy",
+			"route53:ListTrafficPolicies",
+			"route53:UpdateTrafficPolicyComment",
+		},
+		OperationDelete: {
+			"route53:DeleteTrafficPolicy",
+			"route53:ListTrafficPolicyVersions",
+		},
+	},
+	"aws_route53_traffic_policy_instance": {
+		OperationCreate: {
+			"route53:CreateTrafficPolicyInstance",
+			"route53:GetTrafficPolicyInstance",
+		},
+		OperationUpdate: {
+			"route53:GetTrafficPolicyInstance",
+			"route53:UpdateTrafficPolicyInstance",
+		},
+		OperationDelete: {
+			"route53:DeleteTrafficPolicyInstance",
+			"route53:GetTrafficPolicyInstance",
+		},
+	},
+	"aws_route53_vpc_association_authorization": {
+		OperationCreate: {
+			"route53:CreateVPCAssociationAuthorization",
+			"route53:ListVPCAssociationAuthorizations",
+		},
+		OperationDelete: {
+			"route53:DeleteVPCAssociationAuthorization",
+		},
+	},
+	"aws_route53_zone": {
+		OperationCreate: {
+			"route53:AssociateVPCWithHostedZone",
+			"route53:ChangeTagsForResource",
+			"route53:CreateHostedZone",
+			"route53:GetChange",
+			"route53:GetHostedZone",
+			"route53:ListResourceRecordSets",
+		},
+		OperationUpdate: {
+			"route53:AssociateVPCWithHostedZone",
+			"route53:ChangeTagsForResource",
+			"route53:DisassociateVPCFromHostedZone",
+			"route53:GetChange",
+			"route53:GetHostedZone",
+			"route53:ListResourceRecordSets",
+			"route53:UpdateHostedZoneComment",
+		},
+		OperationDelete: {
+			"route53:ChangeResourceRecordSets",
+			"route53:DeleteHostedZone",
+			"route53:DisableHostedZoneDNSSEC",
+			"route53:GetChange",
+			"route53:GetDNSSEC",
+			"route53:ListResourceRecordSets",
+		},
+	},
+	"aws_route53_zone_association": {
+		OperationCreate: {
+			"route53:AssociateVPCWithHostedZone",
+			"route53:GetChange",
+			"route53:ListHostedZonesByVPC",
+		},
+		OperationDelete: {
+			"route53:DisassociateVPCFromHostedZone",
+			"route53:GetChange",
+		},
+	},
+	"aws_route53domains_delegation_signer_record": {
+		OperationCreate: {
+			"route53domains:AssociateDelegationSignerToDomain",
+			"route53domains:GetDomainDetail",
+			"route53domains:GetOperationDetail",
+		},
+		OperationDelete: {
+			"route53domains:DisassociateDelegationSignerFromDomain",
+			"route53domains:GetOperationDetail",
+		},
+	},
+	"aws_route53domains_registered_domain": {
+		OperationCreate: {
+			"route53domains:DeleteTagsForDomain",
+			"route53domains:DisableDomainAutoRenew",
+			"route53domains:DisableDomainTransferLock",
+			"route53domains:EnableDomainAutoRenew",
+			"route53domains:EnableDomainTransferLock",
+			"route53domains:GetDomainDetail",
+			"route53domains:GetOperationDetail",
+			"route53domains:ListTagsForDomain",
+			"route53domains:UpdateDomainContact",
+			"route53domains:UpdateDomainContactPrivacy",
+			"route53domains:UpdateDomainNameservers",
+			"route53domains:UpdateTagsForDomain",
+		},
+		OperationUpdate: {
+			"route53domains:DeleteTagsForDomain",
+			"route53domains:DisableDomainAutoRenew",
+			"route53domains:DisableDomainTransferLock",
+			"route53domains:EnableDomainAutoRenew",
+			"route53domains:EnableDomainTransferLock",
+			"route53domains:GetDomainDetail",
+			"route53domains:GetOperationDetail",
+			"route53domains:UpdateDomainContact",
+			"route53domains:UpdateDomainContactPrivacy",
+			"route53domains:UpdateDomainNameservers",
+			"route53domains:UpdateTagsForDomain",
+		},
+	},
+	"aws_route53recoverycontrolconfig_cluster": {
+		OperationCreate: {
+			"route53-recovery-control-config:CreateCluster",
+			"route53-recovery-control-config:DescribeCluster",
+		},
+		OperationDelete: {
+			"route53-recovery-control-config:DeleteCluster",
+			"route53-recovery-control-config:DescribeCluster",
+		},
+	},
+	"aws_route53recoverycontrolconfig_control_panel": {
+		OperationCreate: {
+			"route53-recovery-control-config:CreateControlPanel",
+			"route53-recovery-control-config:DescribeControlPanel",
+		},
+		OperationUpdate: {
+			"route53-recovery-control-config:DescribeControlPanel",
+			"route53-recovery-control-config:UpdateControlPanel",
+		},
+		OperationDelete: {
+			"route53-recovery-control-config:DeleteControlPanel",
+			"route53-recovery-control-config:DescribeControlPanel",
+		},
+	},
+	"aws_route53recoverycontrolconfig_routing_control": {
+		OperationCreate: {
+			"route53-recovery-control-config:CreateRoutingControl",
+			"route53-recovery-control-config:DescribeRoutingControl",
+		},
+		OperationUpdate: {
+			"route53-recovery-control-config:DescribeRoutingControl",
+			"route53-recovery-control-config:UpdateRoutingControl",
+		},
+		OperationDelete: {
+			"route53-recovery-control-config:DeleteRoutingControl",
+			"route53-recovery-control-config:DescribeRoutingControl",
+		},
+	},
+	"aws_route53recoverycontrolconfig_routing_control_state": {
+		OperationCreate: {
+			"route53-recovery-control-config:DescribeCluster",
+			"route53-recovery-control-config:DescribeControlPanel",
+			"route53-recovery-control-config:DescribeRoutingControl",
+			"route53-recovery-control-config:GetRoutingControlState",
+			"route53-recovery-control-config:UpdateRoutingControlState",
+		},
+		OperationUpdate: {
+			"route53-recovery-control-config:DescribeCluster",
+			"route53-recovery-control-config:DescribeControlPanel",
+			"route53-recovery-control-config:DescribeRoutingControl",
+			"route53-recovery-control-config:GetRoutingControlState",
+			"route53-recovery-control-config:UpdateRoutingControlState",
+		},
+	},
+	"aws_route53recoverycontrolconfig_safety_rule": {
+		OperationCreate: {
+			"route53-recovery-control-config:CreateSafetyRule",
+			"route53-recovery-control-config:DescribeSafetyRule",
+		},
+		OperationUpdate: {
+			"route53-recovery-control-config:DescribeControlPanel",
+			"route53-recovery-control-config:UpdateSafetyRule",
+		},
+		OperationDelete: {
+			"route53-recovery-control-config:DeleteSafetyRule",
+			"route53-recovery-control-config:DescribeSafetyRule",
+		},
+	},
+	"aws_route53recoveryreadiness_cell": {
+		OperationCreate: {
+			"route53-recovery-readiness:CreateCell",
+			"route53-recovery-readiness:GetCell",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+		},
+		OperationUpdate: {
+			"route53-recovery-readiness:GetCell",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+			"route53-recovery-readiness:UpdateCell",
+		},
+		OperationDelete: {
+			"route53-recovery-readiness:DeleteCell",
+			"route53-recovery-readiness:GetCell",
+		},
+	},
+	"aws_route53recoveryreadiness_readiness_check": {
+		OperationCreate: {
+			"route53-recovery-readiness:CreateReadinessCheck",
+			"route53-recovery-readiness:GetReadinessCheck",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+		},
+		OperationUpdate: {
+			"route53-recovery-readiness:GetReadinessCheck",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+			"route53-recovery-readiness:UpdateReadinessCheck",
+		},
+		OperationDelete: {
+			"route53-recovery-readiness:DeleteReadinessCheck",
+			"route53-recovery-readiness:GetReadinessCheck",
+		},
+	},
+	"aws_route53recoveryreadiness_recovery_group": {
+		OperationCreate: {
+			"route53-recovery-readiness:CreateRecoveryGroup",
+			"route53-recovery-readiness:GetRecoveryGroup",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+		},
+		OperationUpdate: {
+			"route53-recovery-readiness:GetRecoveryGroup",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+			"route53-recovery-readiness:UpdateRecoveryGroup",
+		},
+		OperationDelete: {
+			"route53-recovery-readiness:DeleteRecoveryGroup",
+			"route53-recovery-readiness:GetRecoveryGroup",
+		},
+	},
+	"aws_route53recoveryreadiness_resource_set": {
+		OperationCreate: {
+			"route53-recovery-readiness:CreateResourceSet",
+			"route53-recovery-readiness:GetResourceSet",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+		},
+		OperationUpdate: {
+			"route53-recovery-readiness:GetResourceSet",
+			"route53-recovery-readiness:TagResource",
+			"route53-recovery-readiness:UntagResource",
+			"route53-recovery-readiness:UpdateResourceSet",
+		},
+		OperationDelete: {
+			"route53-recovery-readiness:DeleteResourceSet",
+			"route53-recovery-readiness:GetResourceSet",
+		},
+	},
+	"aws_route_table": {
+		OperationCreate: {
+			"ec2:CreateRoute",
+			"ec2:CreateRouteTable",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribeRouteTables",
+			"ec2:EnableVgwRoutePropagation",
+		},
+		OperationUpdate: {
+			"ec2:CreateRoute",
+			"ec2:CreateTags",
+			"ec2:DeleteRoute",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribeRouteTables",
+			"ec2:DisableVgwRoutePropagation",
+			"ec2:EnableVgwRoutePropagation",
+			"ec2:ReplaceRoute",
+		},
+		OperationDelete: {
+			"ec2:DeleteRouteTable",
+			"ec2:DescribeRouteTables",
+			"ec2:DisassociateRouteTable",
+		},
+	},
+	"aws_route_table_association": {
+		OperationCreate: {
+			"ec2:AssociateRouteTable",
+			"ec2:DescribeRouteTables",
+		},
+		OperationUpdate: {
+			"ec2:AssociateRouteTable",
+			"ec2:DescribeRouteTables",
+			"ec2:ReplaceRouteTableAssociation",
+		},
+		OperationDelete: {
+			"ec2:DescribeRouteTables",
+			"ec2:DisassociateRouteTable",
+		},
+	},
+	"aws_rum_app_monitor": {
+		OperationCreate: {
+			"rum:CreateAppMonitor",
+			"rum:GetAppMonitor",
+		},
+		OperationUpdate: {
+			"rum:GetAppMonitor",
+			"rum:TagResource",
+			"rum:UntagResource",
+			"rum:UpdateAppMonitor",
+		},
+		OperationDelete: {
+			"rum:DeleteAppMonitor",
+		},
+	},
+	"aws_rum_metrics_destination": {
+		OperationCreate: {
+			"rum:ListRumMetricsDestinations",
+			"rum:PutRumMetricsDestination",
+		},
+		OperationUpdate: {
+			"rum:ListRumMetricsDestinations",
+			"rum:PutRumMetricsDestination",
+		},
+		OperationDelete: {
+			"rum:DeleteRumMetricsDestination",
+		},
+	},
+	"aws_s3_access_point": {
+		OperationCreate: {
+			"s3:CreateAccessPoint",
+			"s3:GetAccessPoint",
+			"s3:GetAccessPointPolicy",
+			"s3:GetAccessPointPolicyStatus",
+			"s3:PutAccessPointPolicy",
+		},
+		OperationUpdate: {
+			"s3:DeleteAccessPointPolicy",
+			"s3:GetAccessPoint",
+			"s3:GetAccessPointPolicy",
+			"s3:GetAccessPointPolicyStatus",
+			"s3:PutAccessPointPolicy",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessPoint",
+		},
+	},
+	"aws_s3_account_public_access_block": {
+		OperationCreate: {
+			"s3:GetBucketPublicAccessBlock",
+			"s3:PutBucketPublicAccessBlock",
+		},
+		OperationUpdate: {
+			"s3:GetBucketPublicAccessBlock",
+			"s3:PutBucketPublicAccessBlock",
+		},
+		OperationDelete: {
+			"s3:PutBucketPublicAccessBlock",
+		},
+	},
+	"aws_s3_bucket": {
+		OperationCreate: {
+			"s3:CreateBucket",
+			"s3:DeleteBucketPolicy",
+			"s3:DeleteBucketWebsite",
+			"s3:GetAccelerateConfiguration",
+			"s3:GetBucketAcl",
+			"s3:GetBucketCORS",
+			"s3:GetBucketLogging",
+			"s3:GetBucketObjectLockConfiguration",
+			"s3:GetBucketPolicy",
+			"s3:GetBucketRequestPayment",
+			"s3:GetBucketTagging",
+			"s3:GetBucketVersioning",
+			"s3:GetBucketWebsite",
+			"s3:GetEncryptionConfiguration",
+			"s3:GetLifecycleConfiguration",
+			"s3:GetReplicationConfiguration",
+			"s3:ListBucket",
+			"s3:PutAccelerateConfiguration",
+			"s3:PutBucketAcl",
+			"s3:PutBucketCORS",
+			"s3:PutBucketLogging",
+			"s3:PutBucketObjectLockConfiguration",
+			"s3:PutBucketPolicy",
+			"s3:PutBucketRequestPayment",
+			"s3:PutBucketTagging",
+			"s3:PutBucketVersioning",
+			"s3:PutBucketWebsite",
+			"s3:PutEncryptionConfiguration",
+			"s3:PutLifecycleConfiguration",
+			"s3:PutReplicationConfiguration",
+		},
+		OperationUpdate: {
+			"s3:DeleteBucketPolicy",
+			"s3:DeleteBucketWebsite",
+			"s3:GetAccelerateConfiguration",
+			"s3:GetBucketAcl",
+			"s3:GetBucketCORS",
+			"s3:GetBucketLogging",
+			"s3:GetBucketObjectLockConfiguration",
+			"s3:GetBucketPolicy",
+			"s3:GetBucketRequestPayment",
+			"s3:GetBucketVersioning",
+			"s3:GetBucketWebsite",
+			"s3:GetEncryptionConfiguration",
+			"s3:GetLifecycleConfiguration",
+			"s3:GetReplicationConfiguration",
+			"s3:ListBucket",
+			"s3:PutAccelerateConfiguration",
+			"s3:PutBucketAcl",
+			"s3:PutBucketCORS",
+			"s3:PutBucketLogging",
+			"s3:PutBucketObjectLockConfiguration",
+			"s3:PutBucketPolicy",
+			"s3:PutBucketRequestPayment",
+			"s3:PutBucketVersioning",
+			"s3:PutBucketWebsite",
+			"s3:PutEncryptionConfiguration",
+			"s3:PutLifecycleConfiguration",
+			"s3:PutReplicationConfiguration",
+		},
+		OperationDelete: {
+			"s3:DeleteBucket",
+			"s3:DeleteObject",
+			"s3:ListBucket",
+			"s3:ListBucketVersions",
+			"s3:PutObjectLegalHold",
+		},
+	},
+	"aws_s3_bucket_accelerate_configuration": {
+		OperationCreate: {
+			"s3:GetAccelerateConfiguration",
+			"s3:PutAccelerateConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetAccelerateConfiguration",
+			"s3:PutAccelerateConfiguration",
+		},
+		OperationDelete: {
+			"s3:PutAccelerateConfiguration",
+		},
+	},
+	"aws_s3_bucket_acl": {
+		OperationCreate: {
+			"s3:GetBucketAcl",
+			"s3:PutBucketAcl",
+		},
+		OperationUpdate: {
+			"s3:GetBucketAcl",
+			"s3:PutBucketAcl",
+		},
+	},
+	"aws_s3_bucket_analytics_configuration": {
+		OperationCreate: {
+			"s3:GetAnalyticsConfiguration",
+			"s3:PutAnalyticsConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetAnalyticsConfiguration",
+			"s3:PutAnalyticsConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetAnalyticsConfiguration",
+			"s3:PutAnalyticsConfiguration",
+		},
+	},
+	"aws_s3_bucket_cors_configuration": {
+		OperationCreate: {
+			"s3:GetBucketCORS",
+			"s3:PutBucketCORS",
+		},
+		OperationUpdate: {
+			"s3:GetBucketCORS",
+			"s3:PutBucketCORS",
+		},
+		OperationDelete: {
+			"s3:GetBucketCORS",
+			"s3:PutBucketCORS",
+		},
+	},
+	"aws_s3_bucket_intelligent_tiering_configuration": {
+		OperationCreate: {
+			"s3:GetIntelligentTieringConfiguration",
+			"s3:PutIntelligentTieringConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetIntelligentTieringConfiguration",
+			"s3:PutIntelligentTieringConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetIntelligentTieringConfiguration",
+			"s3:PutIntelligentTieringConfiguration",
+		},
+	},
+	"aws_s3_bucket_inventory": {
+		OperationCreate: {
+			"s3:GetInventoryConfiguration",
+			"s3:PutInventoryConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetInventoryConfiguration",
+			"s3:PutInventoryConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetInventoryConfiguration",
+			"s3:PutInventoryConfiguration",
+		},
+	},
+	"aws_s3_bucket_lifecycle_configuration": {
+		OperationCreate: {
+			"s3:GetLifecycleConfiguration",
+			"s3:PutLifecycleConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetLifecycleConfiguration",
+			"s3:PutLifecycleConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetLifecycleConfiguration",
+			"s3:PutLifecycleConfiguration",
+		},
+	},
+	"aws_s3_bucket_logging": {
+		OperationCreate: {
+			"s3:GetBucketLogging",
+			"s3:PutBucketLogging",
+		},
+		OperationUpdate: {
+			"s3:GetBucketLogging",
+			"s3:PutBucketLogging",
+		},
+		OperationDelete: {
+			"s3:PutBucketLogging",
+		},
+	},
+	"aws_s3_bucket_metric": {
+		OperationCreate: {
+			"s3:GetMetricsConfiguration",
+			"s3:PutMetricsConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetMetricsConfiguration",
+			"s3:PutMetricsConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetMetricsConfiguration",
+			"s3:PutMetricsConfiguration",
+		},
+	},
+	"aws_s3_bucket_notification": {
+		OperationCreate: {
+			"s3:GetBucketNotification",
+			"s3:PutBucketNotification",
+		},
+		OperationUpdate: {
+			"s3:GetBucketNotification",
+			"s3:PutBucketNotification",
+		},
+		OperationDelete: {
+			"s3:PutBucketNotification",
+		},
+	},
+	"aws_s3_bucket_object": {
+		OperationCreate: {
+			"s3:GetObject",
+		},
+		OperationUpdate: {
+			"s3:GetObject",
+			"s3:PutObjectAcl",
+			"s3:PutObjectLegalHold",
+			"s3:PutObjectRetention",
+		},
+		OperationDelete: {
+			"s3:DeleteObject",
+			"s3:GetObject",
+			"s3:ListBucketVersions",
+			"s3:PutObjectLegalHold",
+		},
+	},
+	"aws_s3_bucket_object_lock_configuration": {
+		OperationCreate: {
+			"s3:GetBucketObjectLockConfiguration",
+			"s3:PutBucketObjectLockConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetBucketObjectLockConfiguration",
+			"s3:PutBucketObjectLockConfiguration",
+		},
+		OperationDelete: {
+			"s3:PutBucketObjectLockConfiguration",
+		},
+	},
+	"aws_s3_bucket_ownership_controls": {
+		OperationCreate: {
+			"s3:GetBucketOwnershipControls",
+			"s3:PutBucketOwnershipControls",
+		},
+		OperationUpdate: {
+			"s3:GetBucketOwnershipControls",
+			"s3:PutBucketOwnershipControls",
+		},
+		OperationDelete: {
+			"s3:GetBucketOwnershipControls",
+			"s3:PutBucketOwnershipControls",
+		},
+	},
+	"aws_s3_bucket_policy": {
+		OperationCreate: {
+			"s3:GetBucketPolicy",
+			"s3:PutBucketPolicy",
+		},
+		OperationUpdate: {
+			"s3:GetBucketPolicy",
+			"s3:PutBucketPolicy",
+		},
+		OperationDelete: {
+			"s3:DeleteBucketPolicy",
+			"s3:GetBucketPolicy",
+		},
+	},
+	"aws_s3_bucket_public_access_block": {
+		OperationCreate: {
+			"s3:GetBucketPublicAccessBlock",
+			"s3:PutBucketPublicAccessBlock",
+		},
+		OperationUpdate: {
+			"s3:PutBucketPublicAccessBlock",
+		},
+		OperationDelete: {
+			"s3:GetBucketPublicAccessBlock",
+			"s3:PutBucketPublicAccessBlock",
+		},
+	},
+	"aws_s3_bucket_replication_configuration": {
+		OperationCreate: {
+			"s3:GetReplicationConfiguration",
+			"s3:PutReplicationConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetReplicationConfiguration",
+			"s3:PutReplicationConfiguration",
+		},
+		OperationDelete: {
+			"s3:GetReplicationConfiguration",
+			"s3:PutReplicationConfiguration",
+		},
+	},
+	"aws_s3_bucket_request_payment_configuration": {
+		OperationCreate: {
+			"s3:GetBucketRequestPayment",
+			"s3:PutBucketRequestPayment",
+		},
+		OperationUpdate: {
+			"s3:GetBucketRequestPayment",
+			"s3:PutBucketRequestPayment",
+		},
+		OperationDelete: {
+			"s3:PutBucketRequestPayment",
+		},
+	},
+	"aws_s3_bucket_server_side_encryption_configuration": {
+		OperationCreate: {
+			"s3:GetEncryptionConfiguration",
+			"s3:PutEncryptionConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetEncryptionConfiguration",
+			"s3:PutEncryptionConfiguration",
+		},
+		OperationDelete: {
+			"s3:PutEncryptionConfiguration",
+		},
+	},
+	"aws_s3_bucket_versioning": {
+		OperationCreate: {
+			"s3:GetBucketVersioning",
+			"s3:PutBucketVersioning",
+		},
+		OperationUpdate: {
+			"s3:GetBucketVersioning",
+			"s3:PutBucketVersioning",
+		},
+		OperationDelete: {
+			"s3:PutBucketVersioning",
+		},
+	},
+	"aws_s3_bucket_website_configuration": {
+		OperationCreate: {
+			"s3:GetBucketLocation",
+			"s3:GetBucketWebsite",
+			"s3:PutBucketWebsite",
+		},
+		OperationUpdate: {
+			"s3:GetBucketLocation",
+			"s3:GetBucketWebsite",
+			"s3:PutBucketWebsite",
+		},
+		OperationDelete: {
+			"s3:DeleteBucketWebsite",
+			"s3:GetBucketWebsite",
+		},
+	},
+	"aws_s3_directory_bucket": {
+		OperationCreate: {
+			"s3:CreateBucket",
+		},
+		OperationDelete: {
+			"s3:DeleteBucket",
+			"s3:DeleteObject",
+			"s3:ListBucket",
+		},
+	},
+	"aws_s3_object": {
+		OperationCreate: {
+			"s3:GetObject",
+		},
+		OperationUpdate: {
+			"s3:GetObject",
+			"s3:PutObjectAcl",
+			"s3:PutObjectLegalHold",
+			"s3:PutObjectRetention",
+		},
+		OperationDelete: {
+			"s3:DeleteObject",
+			"s3:GetObject",
+			"s3:ListBucketVersions",
+			"s3:PutObjectLegalHold",
+		},
+	},
+	"aws_s3_object_copy": {
+		OperationCreate: {
+			"s3:GetObject",
+			"s3:PutObject",
+		},
+		OperationUpdate: {
+			"s3:GetObject",
+			"s3:PutObject",
+		},
+		OperationDelete: {
+			"s3:DeleteObject",
+			"s3:GetObject",
+			"s3:ListBucketVersions",
+			"s3:PutObjectLegalHold",
+		},
+	},
+	"aws_s3control_access_grant": {
+		OperationCreate: {
+			"s3:CreateAccessGrant",
+		},
+		OperationUpdate: {
+			"s3:TagResource",
+			"s3:UntagResource",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessGrant",
+		},
+	},
+	"aws_s3control_access_grants_instance": {
+		OperationCreate: {
+			"s3:CreateAccessGrantsInstance",
+		},
+		OperationUpdate: {
+			"s3:AssociateAccessGrantsIdentityCenter",
+			"s3:DissociateAccessGrantsIdentityCenter",
+			"s3:TagResource",
+			"s3:UntagResource",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessGrantsInstance",
+			"s3:DissociateAccessGrantsIdentityCenter",
+		},
+	},
+	"aws_s3control_access_grants_instance_resource_policy": {
+		OperationCreate: {
+			"s3:PutAccessGrantsInstanceResourcePolicy",
+		},
+		OperationUpdate: {
+			"s3:PutAccessGrantsInstanceResourcePolicy",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessGrantsInstanceResourcePolicy",
+		},
+	},
+	"aws_s3control_access_grants_location": {
+		OperationCreate: {
+			"s3:CreateAccessGrantsLocation",
+			"s3:CreateRole",
+			"s3:PutRolePolicy",
+		},
+		OperationUpdate: {
+			"s3:TagResource",
+			"s3:UntagResource",
+			"s3:UpdateAccessGrantsLocation",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessGrantsLocation",
+		},
+	},
+	"aws_s3control_access_point_policy": {
+		OperationCreate: {
+			"s3:GetAccessPointPolicy",
+			"s3:GetAccessPointPolicyStatus",
+			"s3:PutAccessPointPolicy",
+		},
+		OperationUpdate: {
+			"s3:GetAccessPointPolicy",
+			"s3:GetAccessPointPolicyStatus",
+			"s3:PutAccessPointPolicy",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessPointPolicy",
+		},
+	},
+	"aws_s3control_bucket": {
+		OperationCreate: {
+			"s3:CreateBucket",
+			"s3:GetBucket",
+			"s3:GetBucketTagging",
+			"s3:PutBucketTagging",
+		},
+		OperationUpdate: {
+			"s3:GetBucket",
+			"s3:GetBucketTagging",
+			"s3:PutBucketTagging",
+			"s3:TagResource",
+			"s3:UntagResource",
+		},
+		OperationDelete: {
+			"s3:DeleteBucket",
+		},
+	},
+	"aws_s3control_bucket_lifecycle_configuration": {
+		OperationCreate: {
+			"s3:GetLifecycleConfiguration",
+			"s3:PutLifecycleConfiguration",
+		},
+		OperationUpdate: {
+			"s3:GetLifecycleConfiguration",
+			"s3:PutLifecycleConfiguration",
+		},
+		OperationDelete: {
+			"s3:DeleteBucketLifecycleConfiguration",
+		},
+	},
+	"aws_s3control_bucket_policy": {
+		OperationCreate: {
+			"s3:GetBucketPolicy",
+			"s3:PutBucketPolicy",
+		},
+		OperationUpdate: {
+			"s3:GetBucketPolicy",
+			"s3:PutBucketPolicy",
+		},
+		OperationDelete: {
+			"s3:DeleteBucketPolicy",
+		},
+	},
+	"aws_s3control_multi_region_access_point": {
+		OperationCreate: {
+			"s3:CreateMultiRegionAccessPoint",
+			"s3:DescribeMultiRegionAccessPointOperation",
+			"s3:GetMultiRegionAccessPoint",
+		},
+		OperationDelete: {
+			"s3:DeleteMultiRegionAccessPoint",
+			"s3:DescribeMultiRegionAccessPointOperation",
+		},
+	},
+	"aws_s3control_multi_region_access_point_policy": {
+		OperationCreate: {
+			"s3:DescribeMultiRegionAccessPointOperation",
+			"s3:GetMultiRegionAccessPointPolicy",
+			"s3:PutMultiRegionAccessPointPolicy",
+		},
+		OperationUpdate: {
+			"s3:DescribeMultiRegionAccessPointOperation",
+			"s3:GetMultiRegionAccessPointPolicy",
+			"s3:PutMultiRegionAccessPointPolicy",
+		},
+	},
+	"aws_s3control_object_lambda_access_point": {
+		OperationCreate: {
+			"s3:CreateAccessPointForObjectLambda",
+			"s3:GetAccessPointConfigurationForObjectLambda",
+			"s3:GetAccessPointForObjectLambda",
+		},
+		OperationUpdate: {
+			"s3:GetAccessPointConfigurationForObjectLambda",
+			"s3:GetAccessPointForObjectLambda",
+			"s3:PutAccessPointConfigurationForObjectLambda",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessPointForObjectLambda",
+		},
+	},
+	"aws_s3control_object_lambda_access_point_policy": {
+		OperationCreate: {
+			"s3:GetAccessPointPolicyForObjectLambda",
+			"s3:GetAccessPointPolicyStatusForObjectLambda",
+			"s3:PutAccessPointPolicyForObjectLambda",
+		},
+		OperationUpdate: {
+			"s3:GetAccessPointPolicyForObjectLambda",
+			"s3:GetAccessPointPolicyStatusForObjectLambda",
+			"s3:PutAccessPointPolicyForObjectLambda",
+		},
+		OperationDelete: {
+			"s3:DeleteAccessPointPolicyForObjectLambda",
+		},
+	},
+	"aws_s3control_storage_lens_configuration": {
+		OperationCreate: {
+			"s3:GetStorageLensConfiguration",
+			"s3:GetStorageLensConfigurationTagging",
+			"s3:PutStorageLensConfiguration",
+		},
+		OperationUpdate: {
+			"s3:DeleteStorageLensConfigurationTagging",
+			"s3:GetStorageLensConfiguration",
+			"s3:GetStorageLensConfigurationTagging",
+			"s3:PutStorageLensConfiguration",
+			"s3:PutStorageLensConfigurationTagging",
+			"s3:TagResource",
+			"s3:UntagResource",
+		},
+		OperationDelete: {
+			"s3:DeleteStorageLensConfiguration",
+		},
+	},
+	"aws_s3outposts_endpoint": {
+		OperationCreate: {
+			"s3-outposts:CreateEndpoint",
+			"s3-outposts:ListEndpoints",
+		},
+		OperationDelete: {
+			"s3-outposts:DeleteEndpoint",
+		},
+	},
+	"aws_sagemaker_app": {
+		OperationCreate: {
+			"sagemaker:CreateApp",
+			"sagemaker:DescribeApp",
+			"sagemaker:ListApps",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeApp",
+			"sagemaker:ListApps",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteApp",
+			"sagemaker:DescribeApp",
+			"sagemaker:ListApps",
+		},
+	},
+	"aws_sagemaker_app_image_config": {
+		OperationCreate: {
+			"sagemaker:CreateAppImageConfig",
+			"sagemaker:DescribeAppImageConfig",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeAppImageConfig",
+			"sagemaker:UpdateAppImageConfig",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteAppImageConfig",
+		},
+	},
+	"aws_sagemaker_code_repository": {
+		OperationCreate: {
+			"sagemaker:CreateCodeRepository",
+			"sagemaker:DescribeCodeRepository",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeCodeRepository",
+			"sagemaker:UpdateCodeRepository",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteCodeRepository",
+		},
+	},
+	"aws_sagemaker_data_quality_job_definition": {
+		OperationCreate: {
+			"sagemaker:CreateDataQualityJobDefinition",
+			"sagemaker:DescribeDataQualityJobDefinition",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeDataQualityJobDefinition",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteDataQualityJobDefinition",
+		},
+	},
+	"aws_sagemaker_device": {
+		OperationCreate: {
+			"sagemaker:DescribeDevice",
+			"sagemaker:RegisterDevices",
+		},
+		OperationUpdate: {
+			"sagemaker:DescribeDevice",
+			"sagemaker:UpdateDevices",
+		},
+		OperationDelete: {
+			"sagemaker:DeregisterDevices",
+		},
+	},
+	"aws_sagemaker_device_fleet": {
+		OperationCreate: {
+			"sagemaker:CreateDeviceFleet",
+			"sagemaker:DescribeDeviceFleet",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeDeviceFleet",
+			"sagemaker:UpdateDeviceFleet",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteDeviceFleet",
+		},
+	},
+	"aws_sagemaker_domain": {
+		OperationCreate: {
+			"sagemaker:CreateDomain",
+			"sagemaker:DescribeDomain",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeDomain",
+			"sagemaker:UpdateDomain",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteDomain",
+			"sagemaker:DescribeDomain",
+		},
+	},
+	"aws_sagemaker_endpoint": {
+		OperationCreate: {
+			"sagemaker:CreateEndpoint",
+			"sagemaker:DescribeEndpoint",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeEndpoint",
+			"sagemaker:UpdateEndpoint",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteEndpoint",
+		},
+	},
+	"aws_sagemaker_endpoint_configuration": {
+		OperationCreate: {
+			"sagemaker:CreateEndpointConfig",
+			"sagemaker:DescribeEndpointConfig",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeEndpointConfig",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteEndpointConfig",
+		},
+	},
+	"aws_sagemaker_feature_group": {
+		OperationCreate: {
+			"sagemaker:CreateFeatureGroup",
+			"sagemaker:DescribeFeatureGroup",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeFeatureGroup",
+			"sagemaker:UpdateFeatureGroup",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteFeatureGroup",
+			"sagemaker:DescribeFeatureGroup",
+		},
+	},
+	"aws_sagemaker_flow_definition": {
+		OperationCreate: {
+			"sagemaker:CreateFlowDefinition",
+			"sagemaker:DescribeFlowDefinition",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeFlowDefinition",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteFlowDefinition",
+			"sagemaker:DescribeFlowDefinition",
+		},
+	},
+	"aws_sagemaker_human_task_ui": {
+		OperationCreate: {
+			"sagemaker:CreateHumanTaskUi",
+			"sagemaker:DescribeHumanTaskUi",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeHumanTaskUi",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteHumanTaskUi",
+		},
+	},
+	"aws_sagemaker_image": {
+		OperationCreate: {
+			"sagemaker:CreateImage",
+			"sagemaker:DescribeImage",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeImage",
+			"sagemaker:UpdateImage",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteImage",
+			"sagemaker:DescribeImage",
+		},
+	},
+	"aws_sagemaker_image_version": {
+		OperationCreate: {
+			"sagemaker:CreateImageVersion",
+			"sagemaker:DescribeImageVersion",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteImageVersion",
+			"sagemaker:DescribeImageVersion",
+		},
+	},
+	"aws_sagemaker_model": {
+		OperationCreate: {
+			"sagemaker:CreateModel",
+			"sagemaker:DescribeModel",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeModel",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteModel",
+		},
+	},
+	"aws_sagemaker_model_package_group": {
+		OperationCreate: {
+			"sagemaker:CreateModelPackageGroup",
+			"sagemaker:DescribeModelPackageGroup",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeModelPackageGroup",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteModelPackageGroup",
+			"sagemaker:DescribeModelPackageGroup",
+		},
+	},
+	"aws_sagemaker_model_package_group_policy": {
+		OperationCreate: {
+			"sagemaker:GetModelPackageGroupPolicy",
+			"sagemaker:PutModelPackageGroupPolicy",
+		},
+		OperationUpdate: {
+			"sagemaker:GetModelPackageGroupPolicy",
+			"sagemaker:PutModelPackageGroupPolicy",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteModelPackageGroupPolicy",
+		},
+	},
+	"aws_sagemaker_monitoring_schedule": {
+		OperationCreate: {
+			"sagemaker:CreateMonitoringSchedule",
+			"sagemaker:DescribeMonitoringSchedule",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeMonitoringSchedule",
+			"sagemaker:UpdateMonitoringSchedule",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteMonitoringSchedule",
+			"sagemaker:DescribeMonitoringSchedule",
+		},
+	},
+	"aws_sagemaker_notebook_instance": {
+		OperationCreate: {
+			"sagemaker:CreateNotebookInstance",
+			"sagemaker:DescribeNotebookInstance",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeNotebookInstance",
+			"sagemaker:StartNotebookInstance",
+			"sagemaker:StopNotebookInstance",
+			"sagemaker:UpdateNotebookInstance",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteNotebookInstance",
+			"sagemaker:DescribeNotebookInstance",
+			"sagemaker:StopNotebookInstance",
+		},
+	},
+	"aws_sagemaker_notebook_instance_lifecycle_configuration": {
+		OperationCreate: {
+			"sagemaker:CreateNotebookInstanceLifecycleConfig",
+			"sagemaker:DescribeNotebookInstanceLifecycleConfig",
+		},
+		OperationUpdate: {
+			"sagemaker:DescribeNotebookInstanceLifecycleConfig",
+			"sagemaker:UpdateNotebookInstanceLifecycleConfig",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteNotebookInstanceLifecycleConfig",
+		},
+	},
+	"aws_sagemaker_pipeline": {
+		OperationCreate: {
+			"sagemaker:CreatePipeline",
+			"sagemaker:DescribePipeline",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribePipeline",
+			"sagemaker:UpdatePipeline",
+		},
+		OperationDelete: {
+			"sagemaker:DeletePipeline",
+		},
+	},
+	"aws_sagemaker_project": {
+		OperationCreate: {
+			"sagemaker:CreateProject",
+			"sagemaker:DescribeProject",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeProject",
+			"sagemaker:UpdateProject",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteProject",
+			"sagemaker:DescribeProject",
+		},
+	},
+	"aws_sagemaker_servicecatalog_portfolio_status": {
+		OperationCreate: {
+			"sagemaker:DisableSagemakerServicecatalogPortfolio",
+			"sagemaker:EnableSagemakerServicecatalogPortfolio",
+			"sagemaker:GetSagemakerServicecatalogPortfolioStatus",
+		},
+		OperationUpdate: {
+			"sagemaker:DisableSagemakerServicecatalogPortfolio",
+			"sagemaker:EnableSagemakerServicecatalogPortfolio",
+			"sagemaker:GetSagemakerServicecatalogPortfolioStatus",
+		},
+	},
+	"aws_sagemaker_space": {
+		OperationCreate: {
+			"sagemaker:CreateSpace",
+			"sagemaker:DescribeSpace",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeSpace",
+			"sagemaker:UpdateSpace",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteSpace",
+			"sagemaker:DescribeSpace",
+		},
+	},
+	"aws_sagemaker_studio_lifecycle_config": {
+		OperationCreate: {
+			"sagemaker:CreateStudioLifecycleConfig",
+			"sagemaker:DescribeStudioLifecycleConfig",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeStudioLifecycleConfig",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteStudioLifecycleConfig",
+		},
+	},
+	"aws_sagemaker_user_profile": {
+		OperationCreate: {
+			"sagemaker:CreateUserProfile",
+			"sagemaker:DescribeUserProfile",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeUserProfile",
+			"sagemaker:UpdateUserProfile",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteUserProfile",
+			"sagemaker:DescribeUserProfile",
+		},
+	},
+	"aws_sagemaker_workforce": {
+		OperationCreate: {
+			"sagemaker:CreateWorkforce",
+			"sagemaker:DescribeWorkforce",
+		},
+		OperationUpdate: {
+			"sagemaker:DescribeWorkforce",
+			"sagemaker:UpdateWorkforce",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteWorkforce",
+			"sagemaker:DescribeWorkforce",
+		},
+	},
+	"aws_sagemaker_workteam": {
+		OperationCreate: {
+			"sagemaker:CreateWorkteam",
+			"sagemaker:DescribeWorkteam",
+		},
+		OperationUpdate: {
+			"sagemaker:AddTags",
+			"sagemaker:DeleteTags",
+			"sagemaker:DescribeWorkteam",
+			"sagemaker:UpdateWorkteam",
+		},
+		OperationDelete: {
+			"sagemaker:DeleteWorkteam",
+		},
+	},
+	"aws_scheduler_schedule": {
+		OperationCreate: {
+			"scheduler:CreateSchedule",
+			"scheduler:GetSchedule",
+		},
+		OperationUpdate: {
+			"scheduler:GetSchedule",
+			"scheduler:UpdateSchedule",
+		},
+		OperationDelete: {
+			"scheduler:DeleteSchedule",
+		},
+	},
+	"aws_scheduler_schedule_group": {
+		OperationCreate: {
+			"scheduler:CreateScheduleGroup",
+			"scheduler:GetScheduleGroup",
+		},
+		OperationUpdate: {
+			"scheduler:GetScheduleGroup",
+			"scheduler:TagResource",
+			"scheduler:UntagResource",
+		},
+		OperationDelete: {
+			"scheduler:DeleteScheduleGroup",
+			"scheduler:GetScheduleGroup",
+		},
+	},
+	"aws_schemas_discoverer": {
+		OperationCreate: {
+			"schemas:CreateDiscoverer",
+			"schemas:DescribeDiscoverer",
+		},
+		OperationUpdate: {
+			"schemas:DescribeDiscoverer",
+			"schemas:TagResource",
+			"schemas:UntagResource",
+			"schemas:UpdateDiscoverer",
+		},
+		OperationDelete: {
+			"schemas:DeleteDiscoverer",
+		},
+	},
+	"aws_schemas_registry": {
+		OperationCreate: {
+			"schemas:CreateRegistry",
+			"schemas:DescribeRegistry",
+		},
+		OperationUpdate: {
+			"schemas:DescribeRegistry",
+			"schemas:TagResource",
+			"schemas:UntagResource",
+			"schemas:UpdateRegistry",
+		},
+		OperationDelete: {
+			"schemas:DeleteRegistry",
+		},
+	},
+	"aws_schemas_registry_policy": {
+		OperationCreate: {
+			"schemas:GetResourcePolicy",
+			"schemas:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"schemas:GetResourcePolicy",
+			"schemas:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"schemas:DeleteResourcePolicy",
+		},
+	},
+	"aws_schemas_schema": {
+		OperationCreate: {
+			"schemas:CreateSchema",
+			"schemas:DescribeSchema",
+		},
+		OperationUpdate: {
+			"schemas:DescribeSchema",
+			"schemas:TagResource",
+			"schemas:UntagResource",
+			"schemas:UpdateSchema",
+		},
+		OperationDelete: {
+			"schemas:DeleteSchema",
+		},
+	},
+	"aws_secretsmanager_secret": {
+		OperationCreate: {
+			"secretsmanager:CreateSecret",
+			"secretsmanager:DescribeSecret",
+			"secretsmanager:GetResourcePolicy",
+			"secretsmanager:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"secretsmanager:DeleteResourcePolicy",
+			"secretsmanager:DescribeSecret",
+			"secretsmanager:GetResourcePolicy",
+			"secretsmanager:PutResourcePolicy",
+			"secretsmanager:RemoveRegionsFromReplication",
+			"secretsmanager:ReplicateSecretToRegions",
+			"secretsmanager:TagResource",
+			"secretsmanager:UntagResource",
+			"secretsmanager:UpdateSecret",
+		},
+		OperationDelete: {
+			"secretsmanager:DeleteSecret",
+			"secretsmanager:DescribeSecret",
+			"secretsmanager:RemoveRegionsFromReplication",
+		},
+	},
+	"aws_secretsmanager_secret_policy": {
+		OperationCreate: {
+			"secretsmanager:GetResourcePolicy",
+			"secretsmanager:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"secretsmanager:GetResourcePolicy",
+			"secretsmanager:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"secretsmanager:DeleteResourcePolicy",
+			"secretsmanager:GetResourcePolicy",
+		},
+	},
+	"aws_secretsmanager_secret_rotation": {
+		OperationCreate: {
+			"secretsmanager:DescribeSecret",
+			"secretsmanager:RotateSecret",
+		},
+		OperationUpdate: {
+			"secretsmanager:DescribeSecret",
+			"secretsmanager:RotateSecret",
+		},
+		OperationDelete: {
+			"secretsmanager:CancelRotateSecret",
+		},
+	},
+	"aws_secretsmanager_secret_version": {
+		OperationCreate: {
+			"secretsmanager:GetSecretValue",
+			"secretsmanager:PutSecretValue",
+		},
+		OperationUpdate: {
+			"secretsmanager:GetSecretValue",
+			"secretsmanager:ListSecretVersionIds",
+			"secretsmanager:UpdateSecretVersionStage",
+		},
+		OperationDelete: {
+			"secretsmanager:GetSecretValue",
+			"secretsmanager:UpdateSecretVersionStage",
+		},
+	},
+	"aws_security_group": {
+		OperationCreate: {
+			"ec2:AuthorizeSecurityGroupEgress",
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:CreateSecurityGroup",
+			"ec2:DescribeSecurityGroups",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+		OperationUpdate: {
+			"ec2:AuthorizeSecurityGroupEgress",
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSecurityGroups",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInterface",
+			"ec2:DeleteSecurityGroup",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribeSecurityGroupRules",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DetachNetworkInterface",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+	},
+	"aws_security_group_rule": {
+		OperationCreate: {
+			"ec2:AuthorizeSecurityGroupEgress",
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:DescribeSecurityGroups",
+		},
+		OperationUpdate: {
+			"ec2:DescribeSecurityGroupRules",
+			"ec2:DescribeSecurityGroups",
+			"ec2:UpdateSecurityGroupRuleDescriptionsEgress",
+			"ec2:UpdateSecurityGroupRuleDescriptionsIngress",
+		},
+		OperationDelete: {
+			"ec2:DescribeSecurityGroups",
+			"ec2:RevokeSecurityGroupEgress",
+			"ec2:RevokeSecurityGroupIngress",
+		},
+	},
+	"aws_securityhub_account": {
+		OperationCreate: {
+			"securityhub:DescribeHub",
+			"securityhub:EnableSecurityHub",
+			"securityhub:UpdateSecurityHubConfiguration",
+		},
+		OperationUpdate: {
+			"securityhub:DescribeHub",
+			"securityhub:UpdateSecurityHubConfiguration",
+		},
+		OperationDelete: {
+			"securityhub:DisableSecurityHub",
+		},
+	},
+	"aws_securityhub_action_target": {
+		OperationCreate: {
+			"securityhub:CreateActionTarget",
+			"securityhub:DescribeActionTargets",
+		},
+		OperationUpdate: {
+			"securityhub:UpdateActionTarget",
+		},
+		OperationDelete: {
+			"securityhub:DeleteActionTarget",
+		},
+	},
+	"aws_securityhub_automation_rule": {
+		OperationCreate: {
+			"securityhub:BatchGetAutomationRules",
+			"securityhub:CreateAutomationRule",
+		},
+		OperationUpdate: {
+			"securityhub:BatchUpdateAutomationRules",
+			"securityhub:TagResource",
+			"securityhub:UntagResource",
+		},
+		OperationDelete: {
+			"securityhub:BatchDeleteAutomationRules",
+		},
+	},
+	"aws_securityhub_configuration_policy": {
+		OperationCreate: {
+			"securityhub:CreateConfigurationPolicy",
+			"securityhub:GetConfigurationPolicy",
+		},
+		OperationUpdate: {
+			"securityhub:GetConfigurationPolicy",
+			"securityhub:UpdateConfigurationPolicy",
+		},
+		OperationDelete: {
+			"securityhub:DeleteConfigurationPolicy",
+		},
+	},
+	"aws_securityhub_configuration_policy_association": {
+		OperationCreate: {
+			"securityhub:GetConfigurationPolicyAssociation",
+			"securityhub:StartConfigurationPolicyAssociation",
+		},
+		OperationUpdate: {
+			"securityhub:GetConfigurationPolicyAssociation",
+			"securityhub:StartConfigurationPolicyAssociation",
+		},
+		OperationDelete: {
+			"securityhub:StartConfigurationPolicyDisassociation",
+		},
+	},
+	"aws_securityhub_finding_aggregator": {
+		OperationCreate: {
+			"securityhub:CreateFindingAggregator",
+			"securityhub:GetFindingAggregator",
+		},
+		OperationUpdate: {
+			"securityhub:GetFindingAggregator",
+			"securityhub:UpdateFindingAggregator",
+		},
+		OperationDelete: {
+			"securityhub:DeleteFindingAggregator",
+		},
+	},
+	"aws_securityhub_insight": {
+		OperationCreate: {
+			"securityhub:CreateInsight",
+			"securityhub:GetInsights",
+		},
+		OperationUpdate: {
+			"securityhub:GetInsights",
+			"securityhub:UpdateInsight",
+		},
+		OperationDelete: {
+			"securityhub:DeleteInsight",
+		},
+	},
+	"aws_securityhub_invite_accepter": {
+		OperationCreate: {
+			"securityhub:AcceptInvitation",
+			"securityhub:GetMasterAccount",
+			"securityhub:ListInvitations",
+		},
+		OperationDelete: {
+			"securityhub:DisassociateFromMasterAccount",
+		},
+	},
+	"aws_securityhub_member": {
+		OperationCreate: {
+			"securityhub:CreateMembers",
+			"securityhub:GetMembers",
+			"securityhub:InviteMembers",
+		},
+		OperationDelete: {
+			"securityhub:DeleteMembers",
+			"securityhub:DisassociateMembers",
+		},
+	},
+	"aws_securityhub_organization_admin_account": {
+		OperationCreate: {
+			"securityhub:EnableOrganizationAdminAccount",
+			"securityhub:ListOrganizationAdminAccounts",
+		},
+		OperationDelete: {
+			"securityhub:DisableOrganizationAdminAccount",
+			"securityhub:ListOrganizationAdminAccounts",
+		},
+	},
+	"aws_securityhub_organization_configuration": {
+		OperationCreate: {
+			"securityhub:DescribeOrganizationConfiguration",
+			"securityhub:UpdateOrganizationConfiguration",
+		},
+		OperationUpdate: {
+			"securityhub:DescribeOrganizationConfiguration",
+			"securityhub:UpdateOrganizationConfiguration",
+		},
+		OperationDelete: {
+			"securityhub:DescribeOrganizationConfiguration",
+			"securityhub:UpdateOrganizationConfiguration",
+		},
+	},
+	"aws_securityhub_product_subscription": {
+		OperationCreate: {
+			"securityhub:EnableImportFindingsForProduct",
+			"securityhub:ListEnabledProductsForImport",
+		},
+		OperationDelete: {
+			"securityhub:DisableImportFindingsForProduct",
+		},
+	},
+	"aws_securityhub_standards_control": {
+		OperationCreate: {
+			"securityhub:DescribeStandardsControls",
+			"securityhub:UpdateStandardsControl",
+		},
+		OperationUpdate: {
+			"securityhub:DescribeStandardsControls",
+			"securityhub:UpdateStandardsControl",
+		},
+	},
+	"aws_securityhub_standards_subscription": {
+		OperationCreate: {
+			"securityhub:BatchEnableStandards",
+			"securityhub:GetEnabledStandards",
+		},
+		OperationDelete: {
+			"securityhub:BatchDisableStandards",
+			"securityhub:GetEnabledStandards",
+		},
+	},
+	"aws_securitylake_aws_log_source": {
+		OperationCreate: {
+			"securitylake:CreateAwsLogSource",
+			"securitylake:ListLogSources",
+		},
+		OperationDelete: {
+			"securitylake:DeleteAwsLogSource",
+			"securitylake:ListLogSources",
+		},
+	},
+	"aws_securitylake_custom_log_source": {
+		OperationCreate: {
+			"securitylake:CreateCustomLogSource",
+		},
+		OperationDelete: {
+			"securitylake:DeleteCustomLogSource",
+		},
+	},
+	"aws_securitylake_data_lake": {
+		OperationCreate: {
+			"securitylake:CreateDataLake",
+			"securitylake:ListDataLakes",
+		},
+		OperationUpdate: {
+			"securitylake:ListDataLakes",
+			"securitylake:TagResource",
+			"securitylake:UntagResource",
+			"securitylake:UpdateDataLake",
+		},
+		OperationDelete: {
+			"securitylake:DeleteDataLake",
+			"securitylake:ListDataLakes",
+		},
+	},
+	"aws_securitylake_subscriber": {
+		OperationCreate: {
+			"securitylake:CreateSubscriber",
+			"securitylake:GetSubscriber",
+		},
+		OperationUpdate: {
+			"securitylake:GetSubscriber",
+			"securitylake:TagResource",
+			"securitylake:UntagResource",
+			"securitylake:UpdateSubscriber",
+		},
+		OperationDelete: {
+			"securitylake:DeleteSubscriber",
+			"securitylake:GetSubscriber",
+		},
+	},
+	"aws_securitylake_subscriber_notification": {
+		OperationCreate: {
+			"securitylake:CreateSubscriberNotification",
+		},
+		OperationUpdate: {
+			"securitylake:UpdateSubscriberNotification",
+		},
+		OperationDelete: {
+			"securitylake:DeleteSubscriberNotification",
+		},
+	},
+	"aws_serverlessapplicationrepository_cloudformation_stack": {
+		OperationCreate: {
+			"serverlessrepo:GetApplication",
+		},
+		OperationUpdate: {
+			"serverlessrepo:GetApplication",
+		},
+	},
+	"aws_service_discovery_http_namespace": {
+		OperationCreate: {
+			"servicediscovery:CreateHttpNamespace",
+			"servicediscovery:GetNamespace",
+			"servicediscovery:GetOperation",
+		},
+		OperationUpdate: {
+			"servicediscovery:GetNamespace",
+			"servicediscovery:TagResource",
+			"servicediscovery:UntagResource",
+		},
+		OperationDelete: {
+			"servicediscovery:DeleteNamespace",
+			"servicediscovery:GetOperation",
+		},
+	},
+	"aws_service_discovery_instance": {
+		OperationCreate: {
+			"servicediscovery:GetInstance",
+			"servicediscovery:GetOperation",
+			"servicediscovery:RegisterInstance",
+		},
+		OperationUpdate: {
+			"servicediscovery:GetInstance",
+			"servicediscovery:GetOperation",
+			"servicediscovery:RegisterInstance",
+		},
+		OperationDelete: {
+			"servicediscovery:DeregisterInstance",
+			"servicediscovery:GetOperation",
+		},
+	},
+	"aws_service_discovery_instances": {
+		OperationCreate: {
+			"servicediscovery:GetOperation",
+			"servicediscovery:ListInstances",
+			"servicediscovery:RegisterInstance",
+		},
+		OperationUpdate: {
+			"servicediscovery:DeregisterInstance",
+			"servicediscovery:GetOperation",
+			"servicediscovery:ListInstances",
+			"servicediscovery:RegisterInstance",
+		},
+		OperationDelete: {
+			"servicediscovery:DeregisterInstance",
+			"servicediscovery:GetOperation",
+		},
+	},
+	"aws_service_discovery_private_dns_namespace": {
+		OperationCreate: {
+			"servicediscovery:CreatePrivateDnsNamespace",
+			"servicediscovery:GetNamespace",
+			"servicediscovery:GetOperation",
+		},
+		OperationUpdate: {
+			"servicediscovery:GetNamespace",
+			"servicediscovery:GetOperation",
+			"servicediscovery:TagResource",
+			"servicediscovery:UntagResource",
+			"servicediscovery:UpdatePrivateDnsNamespace",
+		},
+		OperationDelete: {
+			"servicediscovery:DeleteNamespace",
+			"servicediscovery:GetOperation",
+		},
+	},
+	"aws_service_discovery_public_dns_namespace": {
+		OperationCreate: {
+			"servicediscovery:CreatePublicDnsNamespace",
+			"servicediscovery:GetNamespace",
+			"servicediscovery:GetOperation",
+		},
+		OperationUpdate: {
+			"servicediscovery:GetNamespace",
+			"servicediscovery:GetOperation",
+			"servicediscovery:TagResource",
+			"servicediscovery:UntagResource",
+			"servicediscovery:UpdatePublicDnsNamespace",
+		},
+		OperationDelete: {
+			"servicediscovery:DeleteNamespace",
+			"servicediscovery:GetOperation",
+		},
+	},
+	"aws_service_discovery_service": {
+		OperationCreate: {
+			"servicediscovery:CreateService",
+			"servicediscovery:GetService",
+		},
+		OperationUpdate: {
+			"servicediscovery:GetOperation",
+			"servicediscovery:GetService",
+			"servicediscovery:TagResource",
+			"servicediscovery:UntagResource",
+			"servicediscovery:UpdateService",
+		},
+		OperationDelete: {
+			"servicediscovery:DeleteService",
+			"servicediscovery:DeregisterInstance",
+			"servicediscovery:GetOperation",
+			"servicediscovery:ListInstances",
+		},
+	},
+	"aws_servicecatalog_budget_resource_association": {
+		OperationCreate: {
+			"servicecatalog:AssociateBudgetWithResource",
+			"servicecatalog:ListBudgetsForResource",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociateBudgetFromResource",
+			"servicecatalog:ListBudgetsForResource",
+		},
+	},
+	"aws_servicecatalog_constraint": {
+		OperationCreate: {
+			"servicecatalog:CreateConstraint",
+			"servicecatalog:DescribeConstraint",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeConstraint",
+			"servicecatalog:UpdateConstraint",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteConstraint",
+			"servicecatalog:DescribeConstraint",
+		},
+	},
+	"aws_servicecatalog_organizations_access": {
+		OperationCreate: {
+			"servicecatalog:DisableAWSOrganizationsAccess",
+			"servicecatalog:EnableAWSOrganizationsAccess",
+			"servicecatalog:GetAWSOrganizationsAccessStatus",
+		},
+		OperationDelete: {
+			"servicecatalog:DisableAWSOrganizationsAccess",
+			"servicecatalog:EnableAWSOrganizationsAccess",
+		},
+	},
+	"aws_servicecatalog_portfolio": {
+		OperationCreate: {
+			"servicecatalog:CreatePortfolio",
+			"servicecatalog:DescribePortfolio",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribePortfolio",
+			"servicecatalog:UpdatePortfolio",
+		},
+		OperationDelete: {
+			"servicecatalog:DeletePortfolio",
+		},
+	},
+	"aws_servicecatalog_portfolio_share": {
+		OperationCreate: {
+			"servicecatalog:CreatePortfolioShare",
+			"servicecatalog:DescribePortfolioShareStatus",
+			"servicecatalog:DescribePortfolioShares",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribePortfolioShares",
+			"servicecatalog:UpdatePortfolioShare",
+		},
+		OperationDelete: {
+			"servicecatalog:DeletePortfolioShare",
+			"servicecatalog:DescribePortfolioShareStatus",
+			"servicecatalog:DescribePortfolioShares",
+		},
+	},
+	"aws_servicecatalog_principal_portfolio_association": {
+		OperationCreate: {
+			"servicecatalog:AssociatePrincipalWithPortfolio",
+			"servicecatalog:ListPrincipalsForPortfolio",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociatePrincipalFromPortfolio",
+			"servicecatalog:ListPrincipalsForPortfolio",
+		},
+	},
+	"aws_servicecatalog_product": {
+		OperationCreate: {
+			"servicecatalog:CreateProduct",
+			"servicecatalog:DescribeProductAsAdmin",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeProductAsAdmin",
+			"servicecatalog:UpdateProduct",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteProduct",
+			"servicecatalog:DescribeProductAsAdmin",
+		},
+	},
+	"aws_servicecatalog_product_portfolio_association": {
+		OperationCreate: {
+			"servicecatalog:AssociateProductWithPortfolio",
+			"servicecatalog:ListPortfoliosForProduct",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociateProductFromPortfolio",
+			"servicecatalog:ListPortfoliosForProduct",
+		},
+	},
+	"aws_servicecatalog_provisioned_product": {
+		OperationCreate: {
+			"servicecatalog:DescribeProvisionedProduct",
+			"servicecatalog:DescribeRecord",
+			"servicecatalog:ProvisionProduct",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeProvisionedProduct",
+			"servicecatalog:DescribeRecord",
+			"servicecatalog:UpdateProvisionedProduct",
+		},
+		OperationDelete: {
+			"servicecatalog:DescribeProvisionedProduct",
+			"servicecatalog:TerminateProvisionedProduct",
+		},
+	},
+	"aws_servicecatalog_provisioning_artifact": {
+		OperationCreate: {
+			"servicecatalog:CreateProvisioningArtifact",
+			"servicecatalog:DescribeProvisioningArtifact",
+			"servicecatalog:UpdateProvisioningArtifact",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeProvisioningArtifact",
+			"servicecatalog:UpdateProvisioningArtifact",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteProvisioningArtifact",
+			"servicecatalog:DescribeProvisioningArtifact",
+		},
+	},
+	"aws_servicecatalog_service_action": {
+		OperationCreate: {
+			"servicecatalog:CreateServiceAction",
+			"servicecatalog:DescribeServiceAction",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeServiceAction",
+			"servicecatalog:UpdateServiceAction",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteServiceAction",
+			"servicecatalog:DescribeServiceAction",
+		},
+	},
+	"aws_servicecatalog_tag_option": {
+		OperationCreate: {
+			"servicecatalog:CreateTagOption",
+			"servicecatalog:DescribeTagOption",
+			"servicecatalog:UpdateTagOption",
+		},
+		OperationUpdate: {
+			"servicecatalog:DescribeTagOption",
+			"servicecatalog:UpdateTagOption",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteTagOption",
+			"servicecatalog:DescribeTagOption",
+		},
+	},
+	"aws_servicecatalog_tag_option_resource_association": {
+		OperationCreate: {
+			"servicecatalog:AssociateTagOptionWithResource",
+			"servicecatalog:ListResourcesForTagOption",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociateTagOptionFromResource",
+			"servicecatalog:ListResourcesForTagOption",
+		},
+	},
+	"aws_servicecatalogappregistry_application": {
+		OperationCreate: {
+			"servicecatalog:CreateApplication",
+		},
+		OperationUpdate: {
+			"servicecatalog:UpdateApplication",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteApplication",
+		},
+	},
+	"aws_servicecatalogappregistry_attribute_group": {
+		OperationCreate: {
+			"servicecatalog:CreateAttributeGroup",
+		},
+		OperationUpdate: {
+			"servicecatalog:UpdateAttributeGroup",
+		},
+		OperationDelete: {
+			"servicecatalog:DeleteAttributeGroup",
+		},
+	},
+	"aws_servicecatalogappregistry_attribute_group_association": {
+		OperationCreate: {
+			"servicecatalog:AssociateAttributeGroup",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociateAttributeGroup",
+		},
+	},
+	"aws_servicecatalogappregistry_resource_association": {
+		OperationCreate: {
+			"servicecatalog:AssociateResource",
+			"servicecatalog:GetAssociatedResource",
+		},
+		OperationDelete: {
+			"servicecatalog:DisassociateResource",
+		},
+	},
+	"aws_servicequotas_service_quota": {
+		OperationCreate: {
+			"servicequotas:GetAWSDefaultServiceQuota",
+			"servicequotas:GetRequestedServiceQuotaChange",
+			"servicequotas:GetServiceQuota",
+			"servicequotas:RequestServiceQuotaIncrease",
+		},
+		OperationUpdate: {
+			"servicequotas:GetAWSDefaultServiceQuota",
+			"servicequotas:GetRequestedServiceQuotaChange",
+			"servicequotas:GetServiceQuota",
+			"servicequotas:RequestServiceQuotaIncrease",
+		},
+	},
+	"aws_servicequotas_template": {
+		OperationCreate: {
+			"servicequotas:PutServiceQuotaIncreaseRequestIntoTemplate",
+		},
+		OperationUpdate: {
+			"servicequotas:PutServiceQuotaIncreaseRequestIntoTemplate",
+		},
+		OperationDelete: {
+			"servicequotas:DeleteServiceQuotaIncreaseRequestFromTemplate",
+		},
+	},
+	"aws_servicequotas_template_association": {
+		OperationCreate: {
+			"servicequotas:AssociateServiceQuotaTemplate",
+			"servicequotas:GetAssociationForServiceQuotaTemplate",
+		},
+		OperationDelete: {
+			"servicequotas:DisassociateServiceQuotaTemplate",
+		},
+	},
+	"aws_ses_active_receipt_rule_set": {
+		OperationCreate: {
+			"ses:DescribeActiveReceiptRuleSet",
+			"ses:SetActiveReceiptRuleSet",
+		},
+		OperationUpdate: {
+			"ses:DescribeActiveReceiptRuleSet",
+			"ses:SetActiveReceiptRuleSet",
+		},
+		OperationDelete: {
+			"ses:SetActiveReceiptRuleSet",
+		},
+	},
+	"aws_ses_configuration_set": {
+		OperationCreate: {
+			"ses:CreateConfigurationSet",
+			"ses:CreateConfigurationSetTrackingOptions",
+			"ses:DescribeConfigurationSet",
+			"ses:PutConfigurationSetDeliveryOptions",
+			"ses:UpdateConfigurationSetReputationMetricsEnabled",
+			"ses:UpdateConfigurationSetSendingEnabled",
+		},
+		OperationUpdate: {
+			"ses:DescribeConfigurationSet",
+			"ses:PutConfigurationSetDeliveryOptions",
+			"ses:UpdateConfigurationSetReputationMetricsEnabled",
+			"ses:UpdateConfigurationSetSendingEnabled",
+			"ses:UpdateConfigurationSetTrackingOptions",
+		},
+		OperationDelete: {
+			"ses:DeleteConfigurationSet",
+		},
+	},
+	"aws_ses_domain_dkim": {
+		OperationCreate: {
+			"ses:GetIdentityDkimAttributes",
+			"ses:VerifyDomainDkim",
+		},
+	},
+	"aws_ses_domain_identity": {
+		OperationCreate: {
+			"ses:GetIdentityVerificationAttributes",
+			"ses:VerifyDomainIdentity",
+		},
+		OperationDelete: {
+			"ses:DeleteIdentity",
+		},
+	},
+	"aws_ses_domain_identity_verification": {
+		OperationCreate: {
+			"ses:GetIdentityVerificationAttributes",
+		},
+	},
+	"aws_ses_domain_mail_from": {
+		OperationCreate: {
+			"ses:GetIdentityMailFromDomainAttributes",
+			"ses:SetIdentityMailFromDomain",
+		},
+		OperationUpdate: {
+			"ses:GetIdentityMailFromDomainAttributes",
+			"ses:SetIdentityMailFromDomain",
+		},
+		OperationDelete: {
+			"ses:SetIdentityMailFromDomain",
+		},
+	},
+	"aws_ses_email_identity": {
+		OperationCreate: {
+			"ses:GetIdentityVerificationAttributes",
+			"ses:VerifyEmailIdentity",
+		},
+		OperationDelete: {
+			"ses:DeleteIdentity",
+		},
+	},
+	"aws_ses_event_destination": {
+		OperationCreate: {
+			"ses:CreateConfigurationSetEventDestination",
+			"ses:DescribeConfigurationSet",
+		},
+		OperationDelete: {
+			"ses:DeleteConfigurationSetEventDestination",
+		},
+	},
+	"aws_ses_identity_notification_topic": {
+		OperationCreate: {
+			"ses:GetIdentityNotificationAttributes",
+			"ses:SetIdentityHeadersInNotificationsEnabled",
+			"ses:SetIdentityNotificationTopic",
+		},
+		OperationUpdate: {
+			"ses:GetIdentityNotificationAttributes",
+			"ses:SetIdentityHeadersInNotificationsEnabled",
+			"ses:SetIdentityNotificationTopic",
+		},
+		OperationDelete: {
+			"ses:GetIdentityNotificationAttributes",
+			"ses:SetIdentityNotificationTopic",
+		},
+	},
+	"aws_ses_identity_policy": {
+		OperationCreate: {
+			"ses:GetIdentityPolicies",
+			"ses:PutIdentityPolicy",
+		},
+		OperationUpdate: {
+			"ses:GetIdentityPolicies",
+			"ses:PutIdentityPolicy",
+		},
+		OperationDelete: {
+			"ses:DeleteIdentityPolicy",
+		},
+	},
+	"aws_ses_receipt_filter": {
+		OperationCreate: {
+			"ses:CreateReceiptFilter",
+			"ses:ListReceiptFilters",
+		},
+		OperationDelete: {
+			"ses:DeleteReceiptFilter",
+		},
+	},
+	"aws_ses_receipt_rule": {
+		OperationCreate: {
+			"ses:CreateReceiptRule",
+			"ses:DescribeReceiptRule",
+		},
+		OperationUpdate: {
+			"ses:DescribeReceiptRule",
+			"ses:SetReceiptRulePosition",
+			"ses:UpdateReceiptRule",
+		},
+		OperationDelete: {
+			"ses:DeleteReceiptRule",
+		},
+	},
+	"aws_ses_receipt_rule_set": {
+		OperationCreate: {
+			"ses:CreateReceiptRuleSet",
+			"ses:DescribeReceiptRuleSet",
+		},
+		OperationDelete: {
+			"ses:DeleteReceiptRuleSet",
+		},
+	},
+	"aws_ses_template": {
+		OperationCreate: {
+			"ses:CreateTemplate",
+			"ses:GetTemplate",
+		},
+		OperationUpdate: {
+			"ses:GetTemplate",
+			"ses:UpdateTemplate",
+		},
+		OperationDelete: {
+			"ses:DeleteTemplate",
+		},
+	},
+	"aws_sesv2_account_vdm_attributes": {
+		OperationCreate: {
+			"ses:GetAccount",
+			"ses:PutAccountVdmAttributes",
+		},
+		OperationUpdate: {
+			"ses:GetAccount",
+			"ses:PutAccountVdmAttributes",
+		},
+		OperationDelete: {
+			"ses:PutAccountVdmAttributes",
+		},
+	},
+	"aws_sesv2_configuration_set": {
+		OperationCreate: {
+			"ses:CreateConfigurationSet",
+			"ses:GetConfigurationSet",
+		},
+		OperationUpdate: {
+			"ses:GetConfigurationSet",
+			"ses:PutConfigurationSetDeliveryOptions",
+			"ses:PutConfigurationSetReputationOptions",
+			"ses:PutConfigurationSetSendingOptions",
+			"ses:PutConfigurationSetSuppressionOptions",
+			"ses:PutConfigurationSetTrackingOptions",
+			"ses:PutConfigurationSetVdmOptions",
+			"ses:TagResource",
+			"ses:UntagResource",
+		},
+		OperationDelete: {
+			"ses:DeleteConfigurationSet",
+		},
+	},
+	"aws_sesv2_configuration_set_event_destination": {
+		OperationCreate: {
+			"ses:CreateConfigurationSetEventDestination",
+			"ses:GetConfigurationSetEventDestinations",
+		},
+		OperationUpdate: {
+			"ses:GetConfigurationSetEventDestinations",
+			"ses:UpdateConfigurationSetEventDestination",
+		},
+		OperationDelete: {
+			"ses:DeleteConfigurationSetEventDestination",
+		},
+	},
+	"aws_sesv2_contact_list": {
+		OperationCreate: {
+			"ses:CreateContactList",
+			"ses:GetContactList",
+		},
+		OperationUpdate: {
+			"ses:GetContactList",
+			"ses:TagResource",
+			"ses:UntagResource",
+			"ses:UpdateContactList",
+		},
+		OperationDelete: {
+			"ses:DeleteContactList",
+		},
+	},
+	"aws_sesv2_dedicated_ip_assignment": {
+		OperationCreate: {
+			"ses:GetDedicatedIp",
+			"ses:PutDedicatedIpInPool",
+		},
+		OperationDelete: {
+			"ses:PutDedicatedIpInPool",
+		},
+	},
+	"aws_sesv2_dedicated_ip_pool": {
+		OperationCreate: {
+			"ses:CreateDedicatedIpPool",
+			"ses:GetDedicatedIpPool",
+		},
+		OperationUpdate: {
+			"ses:GetDedicatedIpPool",
+			"ses:TagResource",
+			"ses:UntagResource",
+		},
+		OperationDelete: {
+			"ses:DeleteDedicatedIpPool",
+		},
+	},
+	"aws_sesv2_email_identity": {
+		OperationCreate: {
+			"ses:CreateEmailIdentity",
+			"ses:GetEmailIdentity",
+		},
+		OperationUpdate: {
+			"ses:GetEmailIdentity",
+			"ses:PutEmailIdentityConfigurationSetAttributes",
+			"ses:PutEmailIdentityDkimSigningAttributes",
+			"ses:TagResource",
+			"ses:UntagResource",
+		},
+		OperationDelete: {
+			"ses:DeleteEmailIdentity",
+		},
+	},
+	"aws_sesv2_email_identity_feedback_attributes": {
+		OperationCreate: {
+			"ses:GetEmailIdentity",
+			"ses:PutEmailIdentityFeedbackAttributes",
+		},
+		OperationUpdate: {
+			"ses:GetEmailIdentity",
+			"ses:PutEmailIdentityFeedbackAttributes",
+		},
+		OperationDelete: {
+			"ses:PutEmailIdentityFeedbackAttributes",
+		},
+	},
+	"aws_sesv2_email_identity_mail_from_attributes": {
+		OperationCreate: {
+			"ses:GetEmailIdentity",
+			"ses:PutEmailIdentityMailFromAttributes",
+		},
+		OperationUpdate: {
+			"ses:GetEmailIdentity",
+			"ses:PutEmailIdentityMailFromAttributes",
+		},
+		OperationDelete: {
+			"ses:PutEmailIdentityMailFromAttributes",
+		},
+	},
+	"aws_sesv2_email_identity_policy": {
+		OperationCreate: {
+			"ses:CreateEmailIdentityPolicy",
+			"ses:GetEmailIdentityPolicies",
+		},
+		OperationUpdate: {
+			"ses:GetEmailIdentityPolicies",
+			"ses:UpdateEmailIdentityPolicy",
+		},
+		OperationDelete: {
+			"ses:DeleteEmailIdentityPolicy",
+		},
+	},
+	"aws_sfn_activity": {
+		OperationCreate: {
+			"states:CreateActivity",
+			"states:DescribeActivity",
+		},
+		OperationUpdate: {
+			"states:DescribeActivity",
+			"states:TagResource",
+			"states:UntagResource",
+		},
+		OperationDelete: {
+			"states:DeleteActivity",
+		},
+	},
+	"aws_sfn_alias": {
+		OperationCreate: {
+			"states:CreateStateMachineAlias",
+			"states:DescribeStateMachineAlias",
+		},
+		OperationUpdate: {
+			"states:DescribeAlarms",
+			"states:DescribeStateMachineAlias",
+			"states:UpdateStateMachineAlias",
+		},
+		OperationDelete: {
+			"states:DeleteStateMachineAlias",
+		},
+	},
+	"aws_sfn_state_machine": {
+		OperationCreate: {
+			"states:CreateStateMachine",
+			"states:DescribeStateMachine",
+			"states:ListStateMachineVersions",
+		},
+		OperationUpdate: {
+			"states:DescribeStateMachine",
+			"states:ListStateMachineVersions",
+			"states:TagResource",
+			"states:UntagResource",
+			"states:UpdateStateMachine",
+		},
+		OperationDelete: {
+			"states:DeleteStateMachine",
+			"states:DescribeStateMachine",
+		},
+	},
+	"aws_shield_application_layer_automatic_response": {
+		OperationCreate: {
+			"shield:DescribeProtection",
+			"shield:EnableApplicationLayerAutomaticResponse",
+		},
+		OperationUpdate: {
+			"shield:DescribeProtection",
+			"shield:UpdateApplicationLayerAutomaticResponse",
+		},
+		OperationDelete: {
+			"shield:DescribeProtection",
+			"shield:DisableApplicationLayerAutomaticResponse",
+		},
+	},
+	"aws_shield_drt_access_log_bucket_association": {
+		OperationCreate: {
+			"shield:AssociateDRTLogBucket",
+			"shield:DescribeDRTAccess",
+		},
+		OperationDelete: {
+			"shield:DescribeDRTAccess",
+			"shield:DisassociateDRTLogBucket",
+		},
+	},
+	"aws_shield_drt_access_role_arn_association": {
+		OperationCreate: {
+			"shield:AssociateDRTRole",
+			"shield:DescribeDRTAccess",
+		},
+		OperationUpdate: {
+			"shield:AssociateDRTRole",
+			"shield:DescribeDRTAccess",
+		},
+		OperationDelete: {
+			"shield:DescribeDRTAccess",
+			"shield:DisassociateDRTRole",
+		},
+	},
+	"aws_shield_proactive_engagement": {
+		OperationCreate: {
+			"shield:AssociateProactiveEngagementDetails",
+			"shield:DisableProactiveEngagement",
+			"shield:EnableProactiveEngagement",
+			"shield:UpdateEmergencyContactSettings",
+		},
+		OperationUpdate: {
+			"shield:DisableProactiveEngagement",
+			"shield:EnableProactiveEngagement",
+			"shield:UpdateEmergencyContactSettings",
+		},
+		OperationDelete: {
+			"shield:DisableProactiveEngagement",
+			"shield:UpdateEmergencyContactSettings",
+		},
+	},
+	"aws_shield_protection": {
+		OperationCreate: {
+			"shield:CreateProtection",
+			"shield:DescribeProtection",
+		},
+		OperationUpdate: {
+			"shield:DescribeProtection",
+			"shield:TagResource",
+			"shield:UntagResource",
+		},
+		OperationDelete: {
+			"shield:DeleteProtection",
+		},
+	},
+	"aws_shield_protection_group": {
+		OperationCreate: {
+			"shield:CreateProtectionGroup",
+			"shield:DescribeProtectionGroup",
+		},
+		OperationUpdate: {
+			"shield:DescribeProtectionGroup",
+			"shield:TagResource",
+			"shield:UntagResource",
+			"shield:UpdateProtectionGroup",
+		},
+		OperationDelete: {
+			"shield:DeleteProtectionGroup",
+		},
+	},
+	"aws_shield_protection_health_check_association": {
+		OperationCreate: {
+			"shield:AssociateHealthCheck",
+			"shield:DescribeProtection",
+		},
+		OperationDelete: {
+			"shield:DisassociateHealthCheck",
+		},
+	},
+	"aws_signer_signing_job": {
+		OperationCreate: {
+			"signer:DescribeSigningJob",
+			"signer:StartSigningJob",
+		},
+	},
+	"aws_signer_signing_profile": {
+		OperationCreate: {
+			"signer:GetSigningProfile",
+			"signer:PutSigningProfile",
+		},
+		OperationUpdate: {
+			"signer:GetSigningProfile",
+			"signer:TagResource",
+			"signer:UntagResource",
+		},
+		OperationDelete: {
+			"signer:CancelSigningProfile",
+		},
+	},
+	"aws_signer_signing_profile_permission": {
+		OperationCreate: {
+			"signer:AddProfilePermission",
+			"signer:ListProfilePermissions",
+		},
+		OperationDelete: {
+			"signer:ListProfilePermissions",
+			"signer:RemoveProfilePermission",
+		},
+	},
+	"aws_simpledb_domain": {
+		OperationCreate: {
+			"sdb:CreateDomain",
+		},
+		OperationDelete: {
+			"sdb:DeleteDomain",
+		},
+	},
+	"aws_snapshot_create_volume_permission": {
+		OperationCreate: {
+			"ec2:DescribeSnapshotAttribute",
+			"ec2:ModifySnapshotAttribute",
+		},
+		OperationDelete: {
+			"ec2:DescribeSnapshotAttribute",
+			"ec2:ModifySnapshotAttribute",
+		},
+	},
+	"aws_sns_platform_application": {
+		OperationCreate: {
+			"sns:CreatePlatformApplication",
+			"sns:GetPlatformApplicationAttributes",
+		},
+		OperationUpdate: {
+			"sns:GetPlatformApplicationAttributes",
+			"sns:SetPlatformApplicationAttributes",
+		},
+		OperationDelete: {
+			"sns:DeletePlatformApplication",
+		},
+	},
+	"aws_sns_sms_preferences": {
+		OperationCreate: {
+			"sns:SetSMSAttributes",
+		},
+		OperationUpdate: {
+			"sns:SetSMSAttributes",
+		},
+		OperationDelete: {
+			"sns:SetSMSAttributes",
+		},
+	},
+	"aws_sns_topic": {
+		OperationCreate: {
+			"sns:CreateTopic",
+			"sns:GetTopicAttributes",
+			"sns:SetTopicAttributes",
+			"sns:TagResource",
+			"sns:UntagResource",
+		},
+		OperationUpdate: {
+			"sns:GetTopicAttributes",
+			"sns:SetTopicAttributes",
+			"sns:TagResource",
+			"sns:UntagResource",
+		},
+		OperationDelete: {
+			"sns:DeleteTopic",
+		},
+	},
+	"aws_sns_topic_data_protection_policy": {
+		OperationCreate: {
+			"sns:GetDataProtectionPolicy",
+			"sns:PutDataProtectionPolicy",
+		},
+		OperationUpdate: {
+			"sns:GetDataProtectionPolicy",
+			"sns:PutDataProtectionPolicy",
+		},
+		OperationDelete: {
+			"sns:PutDataProtectionPolicy",
+		},
+	},
+	"aws_sns_topic_policy": {
+		OperationCreate: {
+			"sns:GetTopicAttributes",
+			"sns:SetTopicAttributes",
+		},
+		OperationUpdate: {
+			"sns:GetTopicAttributes",
+			"sns:SetTopicAttributes",
+		},
+		OperationDelete: {
+			"sns:SetTopicAttributes",
+		},
+	},
+	"aws_sns_topic_subscription": {
+		OperationCreate: {
+			"sns:GetSubscriptionAttributes",
+			"sns:Subscribe",
+		},
+		OperationUpdate: {
+			"sns:GetSubscriptionAttributes",
+			"sns:SetSubscriptionAttributes",
+		},
+		OperationDelete: {
+			"sns:GetSubscriptionAttributes",
+			"sns:Unsubscribe",
+		},
+	},
+	"aws_spot_datafeed_subscription": {
+		OperationCreate: {
+			"ec2:CreateSpotDatafeedSubscription",
+			"ec2:DescribeSpotDatafeedSubscription",
+		},
+		OperationDelete: {
+			"ec2:DeleteSpotDatafeedSubscription",
+		},
+	},
+	"aws_spot_fleet_request": {
+		OperationCreate: {
+			"ec2:DescribeImages",
+			"ec2:DescribeSpotFleetRequestHistory",
+			"ec2:DescribeSpotFleetRequests",
+			"ec2:RequestSpotFleet",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeImages",
+			"ec2:DescribeSpotFleetRequests",
+			"ec2:ModifySpotFleetRequest",
+		},
+		OperationDelete: {
+			"ec2:CancelSpotFleetRequests",
+			"ec2:DescribeSpotFleetInstances",
+		},
+	},
+	"aws_spot_instance_request": {
+		OperationCreate: {
+			"ec2:DescribeImages",
+			"ec2:DescribeInstanceTypes",
+			"ec2:DescribeInstances",
+			"ec2:DescribeLaunchTemplateVersions",
+			"ec2:DescribeSpotInstanceRequests",
+			"ec2:DescribeVolumes",
+			"ec2:DescribeVpcs",
+			"ec2:GetPasswordData",
+			"ec2:RequestSpotInstances",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeInstances",
+			"ec2:DescribeSpotInstanceRequests",
+			"ec2:DescribeVolumes",
+			"ec2:DescribeVpcs",
+			"ec2:GetPasswordData",
+		},
+		OperationDelete: {
+			"ec2:CancelSpotInstanceRequests",
+			"ec2:DescribeInstances",
+			"ec2:TerminateInstances",
+		},
+	},
+	"aws_sqs_queue": {
+		OperationCreate: {
+			"sqs:CreateQueue",
+			"sqs:GetQueueAttributes",
+			"sqs:TagQueue",
+			"sqs:UntagQueue",
+		},
+		OperationUpdate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+			"sqs:TagQueue",
+			"sqs:UntagQueue",
+		},
+		OperationDelete: {
+			"sqs:DeleteQueue",
+			"sqs:GetQueueAttributes",
+		},
+	},
+	"aws_sqs_queue_policy": {
+		OperationCreate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationUpdate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationDelete: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+	},
+	"aws_sqs_queue_redrive_allow_policy": {
+		OperationCreate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationUpdate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationDelete: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+	},
+	"aws_sqs_queue_redrive_policy": {
+		OperationCreate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationUpdate: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+		OperationDelete: {
+			"sqs:GetQueueAttributes",
+			"sqs:SetQueueAttributes",
+		},
+	},
+	"aws_ssm_activation": {
+		OperationCreate: {
+			"ssm:CreateActivation",
+			"ssm:DescribeActivations",
+		},
+		OperationDelete: {
+			"ssm:DeleteActivation",
+		},
+	},
+	"aws_ssm_association": {
+		OperationCreate: {
+			"ssm:CreateAssociation",
+			"ssm:DescribeAssociation",
+		},
+		OperationUpdate: {
+			"ssm:DescribeAssociation",
+			"ssm:UpdateAssociation",
+		},
+		OperationDelete: {
+			"ssm:DeleteAssociation",
+		},
+	},
+	"aws_ssm_default_patch_baseline": {
+		OperationCreate: {
+			"ssm:GetDefaultPatchBaseline",
+			"ssm:GetPatchBaseline",
+			"ssm:RegisterDefaultPatchBaseline",
+		},
+		OperationDelete: {
+			"ssm:DescribePatchBaselines",
+			"ssm:RegisterDefaultPatchBaseline",
+		},
+	},
+	"aws_ssm_document": {
+		OperationCreate: {
+			"ssm:CreateDocument",
+			"ssm:DescribeDocument",
+			"ssm:DescribeDocumentPermission",
+			"ssm:GetDocument",
+			"ssm:ModifyDocumentPermission",
+		},
+		OperationUpdate: {
+			"ssm:AddTagsToResource",
+			"ssm:DescribeDocument",
+			"ssm:DescribeDocumentPermission",
+			"ssm:GetDocument",
+			"ssm:ModifyDocumentPermission",
+			"ssm:RemoveTagsFromResource",
+			"ssm:UpdateDocument",
+			"ssm:UpdateDocumentDefaultVersion",
+		},
+		OperationDelete: {
+			"ssm:DeleteDocument",
+			"ssm:DescribeDocument",
+			"ssm:ModifyDocumentPermission",
+		},
+	},
+	"aws_ssm_maintenance_window": {
+		OperationCreate: {
+			"ssm:CreateMaintenanceWindow",
+			"ssm:GetMaintenanceWindow",
+			"ssm:UpdateMaintenanceWindow",
+		},
+		OperationUpdate: {
+			"ssm:AddTagsToResource",
+			"ssm:GetMaintenanceWindow",
+			"ssm:RemoveTagsFromResource",
+			"ssm:UpdateMaintenanceWindow",
+		},
+		OperationDelete: {
+			"ssm:DeleteMaintenanceWindow",
+		},
+	},
+	"aws_ssm_maintenance_window_target": {
+		OperationCreate: {
+			"ssm:DescribeMaintenanceWindowTargets",
+			"ssm:RegisterTargetWithMaintenanceWindow",
+		},
+		OperationUpdate: {
+			"ssm:UpdateMaintenanceWindowTarget",
+		},
+		OperationDelete: {
+			"ssm:DeregisterTargetFromMaintenanceWindow",
+		},
+	},
+	"aws_ssm_maintenance_window_task": {
+		OperationCreate: {
+			"ssm:GetMaintenanceWindowTask",
+			"ssm:RegisterTaskWithMaintenanceWindow",
+		},
+		OperationUpdate: {
+			"ssm:GetMaintenanceWindowTask",
+			"ssm:UpdateMaintenanceWindowTask",
+		},
+		OperationDelete: {
+			"ssm:DeregisterTaskFromMaintenanceWindow",
+		},
+	},
+	"aws_ssm_parameter": {
+		OperationCreate: {
+			"ssm:AddTagsToResource",
+			"ssm:DescribeParameters",
+			"ssm:GetParameter",
+			"ssm:PutParameter",
+			"ssm:RemoveTagsFromResource",
+		},
+		OperationUpdate: {
+			"ssm:AddTagsToResource",
+			"ssm:DescribeParameters",
+			"ssm:GetParameter",
+			"ssm:PutParameter",
+			"ssm:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"ssm:DeleteParameter",
+		},
+	},
+	"aws_ssm_patch_baseline": {
+		OperationCreate: {
+			"ssm:CreatePatchBaseline",
+			"ssm:GetPatchBaseline",
+		},
+		OperationUpdate: {
+			"ssm:AddTagsToResource",
+			"ssm:GetPatchBaseline",
+			"ssm:RemoveTagsFromResource",
+			"ssm:UpdatePatchBaseline",
+		},
+		OperationDelete: {
+			"ssm:DeletePatchBaseline",
+			"ssm:DescribePatchBaselines",
+			"ssm:RegisterDefaultPatchBaseline",
+		},
+	},
+	"aws_ssm_patch_group": {
+		OperationCreate: {
+			"ssm:DescribePatchGroups",
+			"ssm:RegisterPatchBaselineForPatchGroup",
+		},
+		OperationDelete: {
+			"ssm:DeregisterPatchBaselineForPatchGroup",
+		},
+	},
+	"aws_ssm_resource_data_sync": {
+		OperationCreate: {
+			"ssm:CreateResourceDataSync",
+			"ssm:ListResourceDataSync",
+		},
+		OperationDelete: {
+			"ssm:DeleteResourceDataSync",
+		},
+	},
+	"aws_ssm_service_setting": {
+		OperationCreate: {
+			"ssm:GetServiceSetting",
+			"ssm:UpdateServiceSetting",
+		},
+		OperationUpdate: {
+			"ssm:GetServiceSetting",
+			"ssm:UpdateServiceSetting",
+		},
+		OperationDelete: {
+			"ssm:GetServiceSetting",
+			"ssm:ResetServiceSetting",
+		},
+	},
+	"aws_ssmcontacts_contact": {
+		OperationCreate: {
+			"ssm-contacts:GetContact",
+		},
+		OperationUpdate: {
+			"ssm-contacts:GetContact",
+			"ssm-contacts:TagResource",
+			"ssm-contacts:UntagResource",
+			"ssm-contacts:UpdateContact",
+		},
+		OperationDelete: {
+			"ssm-contacts:DeleteContact",
+		},
+	},
+	"aws_ssmcontacts_contact_channel": {
+		OperationCreate: {
+			"ssm-contacts:CreateContactChannel",
+			"ssm-contacts:GetContactChannel",
+		},
+		OperationUpdate: {
+			"ssm-contacts:GetContactChannel",
+			"ssm-contacts:UpdateContactChannel",
+		},
+		OperationDelete: {
+			"ssm-contacts:DeleteContactChannel",
+		},
+	},
+	"aws_ssmcontacts_plan": {
+		OperationCreate: {
+			"ssm-contacts:GetContact",
+			"ssm-contacts:UpdateContact",
+		},
+		OperationUpdate: {
+			"ssm-contacts:GetContact",
+			"ssm-contacts:UpdateContact",
+		},
+		OperationDelete: {
+			"ssm-contacts:UpdateContact",
+		},
+	},
+	"aws_ssmcontacts_rotation": {
+		OperationCreate: {
+			"ssm-contacts:CreateRotation",
+		},
+		OperationUpdate: {
+			"ssm-contacts:TagResource",
+			"ssm-contacts:UntagResource",
+			"ssm-contacts:UpdateRotation",
+		},
+		OperationDelete: {
+			"ssm-contacts:DeleteRotation",
+		},
+	},
+	"aws_ssmincidents_replication_set": {
+		OperationUpdate: {
+			"ssm-incidents:TagResource",
+			"ssm-incidents:UntagResource",
+		},
+	},
+	"aws_ssmincidents_response_plan": {
+		OperationUpdate: {
+			"ssm-incidents:TagResource",
+			"ssm-incidents:UntagResource",
+		},
+	},
+	"aws_ssoadmin_account_assignment": {
+		OperationCreate: {
+			"sso:CreateAccountAssignment",
+			"sso:DescribeAccountAssignmentCreationStatus",
+			"sso:ListAccountAssignments",
+		},
+		OperationDelete: {
+			"sso:DeleteAccountAssignment",
+			"sso:DescribeAccountAssignmentDeletionStatus",
+		},
+	},
+	"aws_ssoadmin_application": {
+		OperationCreate: {
+			"sso:CreateApplication",
+			"sso:DescribeApplication",
+		},
+		OperationUpdate: {
+			"sso:TagResource",
+			"sso:UntagResource",
+			"sso:UpdateApplication",
+		},
+		OperationDelete: {
+			"sso:DeleteApplication",
+		},
+	},
+	"aws_ssoadmin_application_access_scope": {
+		OperationCreate: {
+			"sso:PutApplicationAccessScope",
+		},
+		OperationDelete: {
+			"sso:DeleteApplicationAccessScope",
+		},
+	},
+	"aws_ssoadmin_application_assignment": {
+		OperationCreate: {
+			"sso:CreateApplicationAssignment",
+		},
+		OperationDelete: {
+			"sso:DeleteApplicationAssignment",
+		},
+	},
+	"aws_ssoadmin_application_assignment_configuration": {
+		OperationCreate: {
+			"sso:PutApplicationAssignmentConfiguration",
+		},
+		OperationUpdate: {
+			"sso:PutApplicationAssignmentConfiguration",
+		},
+		OperationDelete: {
+			"sso:PutApplicationAssignmentConfiguration",
+		},
+	},
+	"aws_ssoadmin_application_authentication_method": {
+		OperationCreate: {
+			"sso:PutApplicationAuthenticationMethod",
+		},
+		OperationUpdate: {
+			"sso:PutApplicationAuthenticationMethod",
+		},
+		OperationDelete: {
+			"sso:DeleteApplicationAuthenticationMethod",
+		},
+	},
+	"aws_ssoadmin_customer_managed_policy_attachment": {
+		OperationCreate: {
+			"sso:AttachCustomerManagedPolicyReferenceToPermissionSet",
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:ListCustomerManagedPolicyReferencesInPermissionSet",
+			"sso:ProvisionPermissionSet",
+		},
+		OperationDelete: {
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:DetachCustomerManagedPolicyReferenceFromPermissionSet",
+			"sso:ProvisionPermissionSet",
+		},
+	},
+	"aws_ssoadmin_instance_access_control_attributes": {
+		OperationCreate: {
+			"sso:CreateInstanceAccessControlAttributeConfiguration",
+			"sso:DescribeInstanceAccessControlAttributeConfiguration",
+		},
+		OperationUpdate: {
+			"sso:DescribeInstanceAccessControlAttributeConfiguration",
+			"sso:UpdateInstanceAccessControlAttributeConfiguration",
+		},
+		OperationDelete: {
+			"sso:DeleteInstanceAccessControlAttributeConfiguration",
+		},
+	},
+	"aws_ssoadmin_managed_policy_attachment": {
+		OperationCreate: {
+			"sso:AttachManagedPolicyToPermissionSet",
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:ListManagedPoliciesInPermissionSet",
+			"sso:ProvisionPermissionSet",
+		},
+		OperationDelete: {
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:DetachManagedPolicyFromPermissionSet",
+			"sso:ProvisionPermissionSet",
+		},
+	},
+	"aws_ssoadmin_permission_set": {
+		OperationCreate: {
+			"sso:CreatePermissionSet",
+			"sso:DescribePermissionSet",
+			"sso:ListTagsForResource",
+		},
+		OperationUpdate: {
+			"sso:DescribePermissionSet",
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:ListAccountsForProvisionedPermissionSet",
+			"sso:ListTagsForResource",
+			"sso:ProvisionPermissionSet",
+			"sso:TagResource",
+			"sso:UntagResource",
+			"sso:UpdatePermissionSet",
+		},
+		OperationDelete: {
+			"sso:DeletePermissionSet",
+		},
+	},
+	"aws_ssoadmin_permission_set_inline_policy": {
+		OperationCreate: {
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:GetInlinePolicyForPermissionSet",
+			"sso:ProvisionPermissionSet",
+			"sso:PutInlinePolicyToPermissionSet",
+		},
+		OperationUpdate: {
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:GetInlinePolicyForPermissionSet",
+			"sso:ProvisionPermissionSet",
+			"sso:PutInlinePolicyToPermissionSet",
+		},
+		OperationDelete: {
+			"sso:DeleteInlinePolicyFromPermissionSet",
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:ProvisionPermissionSet",
+		},
+	},
+	"aws_ssoadmin_permissions_boundary_attachment": {
+		OperationCreate: {
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:GetPermissionsBoundaryForPermissionSet",
+			"sso:ProvisionPermissionSet",
+			"sso:PutPermissionsBoundaryToPermissionSet",
+		},
+		OperationDelete: {
+			"sso:DeletePermissionsBoundaryFromPermissionSet",
+			"sso:DescribePermissionSetProvisioningStatus",
+			"sso:ProvisionPermissionSet",
+		},
+	},
+	"aws_ssoadmin_trusted_token_issuer": {
+		OperationCreate: {
+			"sso:CreateTrustedTokenIssuer",
+		},
+		OperationUpdate: {
+			"sso:TagResource",
+			"sso:UntagResource",
+			"sso:UpdateTrustedTokenIssuer",
+		},
+		OperationDelete: {
+			"sso:DeleteTrustedTokenIssuer",
+		},
+	},
+	"aws_storagegateway_cache": {
+		OperationCreate: {
+			"storagegateway:AddCache",
+			"storagegateway:DescribeCache",
+			"storagegateway:ListLocalDisks",
+		},
+	},
+	"aws_storagegateway_cached_iscsi_volume": {
+		OperationCreate: {
+			"storagegateway:CreateCachediSCSIVolume",
+			"storagegateway:DescribeCachediSCSIVolumes",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DescribeCachediSCSIVolumes",
+			"storagegateway:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteVolume",
+		},
+	},
+	"aws_storagegateway_file_system_association": {
+		OperationCreate: {
+			"storagegateway:AssociateFileSystem",
+			"storagegateway:DescribeFileSystemAssociations",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DescribeFileSystemAssociations",
+			"storagegateway:RemoveTagsFromResource",
+			"storagegateway:UpdateFileSystemAssociation",
+		},
+		OperationDelete: {
+			"storagegateway:DescribeFileSystemAssociations",
+			"storagegateway:DisassociateFileSystem",
+		},
+	},
+	"aws_storagegateway_gateway": {
+		OperationCreate: {
+			"storagegateway:ActivateGateway",
+			"storagegateway:DescribeBandwidthRateLimit",
+			"storagegateway:DescribeGatewayInformation",
+			"storagegateway:DescribeMaintenanceStartTime",
+			"storagegateway:DescribeSMBSettings",
+			"storagegateway:JoinDomain",
+			"storagegateway:SetSMBGuestPassword",
+			"storagegateway:UpdateBandwidthRateLimit",
+			"storagegateway:UpdateGatewayInformation",
+			"storagegateway:UpdateMaintenanceStartTime",
+			"storagegateway:UpdateSMBFileShareVisibility",
+			"storagegateway:UpdateSMBSecurityStrategy",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DeleteBandwidthRateLimit",
+			"storagegateway:DescribeBandwidthRateLimit",
+			"storagegateway:DescribeGatewayInformation",
+			"storagegateway:DescribeMaintenanceStartTime",
+			"storagegateway:DescribeSMBSettings",
+			"storagegateway:JoinDomain",
+			"storagegateway:RemoveTagsFromResource",
+			"storagegateway:SetSMBGuestPassword",
+			"storagegateway:UpdateBandwidthRateLimit",
+			"storagegateway:UpdateGatewayInformation",
+			"storagegateway:UpdateMaintenanceStartTime",
+			"storagegateway:UpdateSMBFileShareVisibility",
+			"storagegateway:UpdateSMBSecurityStrategy",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteGateway",
+		},
+	},
+	"aws_storagegateway_nfs_file_share": {
+		OperationCreate: {
+			"storagegateway:CreateNFSFileShare",
+			"storagegateway:DescribeNFSFileShares",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DescribeNFSFileShares",
+			"storagegateway:RemoveTagsFromResource",
+			"storagegateway:UpdateNFSFileShare",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteFileShare",
+			"storagegateway:DescribeNFSFileShares",
+		},
+	},
+	"aws_storagegateway_smb_file_share": {
+		OperationCreate: {
+			"storagegateway:CreateSMBFileShare",
+			"storagegateway:DescribeSMBFileShares",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DescribeSMBFileShares",
+			"storagegateway:RemoveTagsFromResource",
+			"storagegateway:UpdateSMBFileShare",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteFileShare",
+			"storagegateway:DescribeSMBFileShares",
+		},
+	},
+	"aws_storagegateway_stored_iscsi_volume": {
+		OperationCreate: {
+			"storagegateway:CreateStorediSCSIVolume",
+			"storagegateway:DescribeStorediSCSIVolumes",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:DescribeStorediSCSIVolumes",
+			"storagegateway:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteVolume",
+		},
+	},
+	"aws_storagegateway_tape_pool": {
+		OperationCreate: {
+			"storagegateway:CreateTapePool",
+			"storagegateway:ListTapePools",
+		},
+		OperationUpdate: {
+			"storagegateway:AddTagsToResource",
+			"storagegateway:ListTapePools",
+			"storagegateway:RemoveTagsFromResource",
+		},
+		OperationDelete: {
+			"storagegateway:DeleteTapePool",
+		},
+	},
+	"aws_storagegateway_upload_buffer": {
+		OperationCreate: {
+			"storagegateway:AddUploadBuffer",
+			"storagegateway:DescribeUploadBuffer",
+			"storagegateway:ListLocalDisks",
+		},
+	},
+	"aws_storagegateway_working_storage": {
+		OperationCreate: {
+			"storagegateway:AddWorkingStorage",
+			"storagegateway:DescribeWorkingStorage",
+		},
+	},
+	"aws_subnet": {
+		OperationCreate: {
+			"ec2:AssociateSubnetCidrBlock",
+			"ec2:CreateSubnet",
+			"ec2:DescribeSubnets",
+			"ec2:DisassociateSubnetCidrBlock",
+			"ec2:ModifySubnetAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssociateSubnetCidrBlock",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeSubnets",
+			"ec2:DisassociateSubnetCidrBlock",
+			"ec2:ModifySubnetAttribute",
+		},
+		OperationDelete: {
+			"ec2:DeleteNetworkInterface",
+			"ec2:DeleteSubnet",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DetachNetworkInterface",
+		},
+	},
+	"aws_swf_domain": {
+		OperationCreate: {
+			"swf:DescribeDomain",
+			"swf:RegisterDomain",
+		},
+		OperationUpdate: {
+			"swf:DescribeDomain",
+			"swf:TagResource",
+			"swf:UntagResource",
+		},
+		OperationDelete: {
+			"swf:DeprecateDomain",
+		},
+	},
+	"aws_synthetics_canary": {
+		OperationCreate: {
+			"synthetics:CreateCanary",
+			"synthetics:DeleteCanary",
+			"synthetics:DescribeRuntimeVersions",
+			"synthetics:GetCanary",
+			"synthetics:StartCanary",
+		},
+		OperationUpdate: {
+			"synthetics:DescribeRuntimeVersions",
+			"synthetics:GetCanary",
+			"synthetics:StartCanary",
+			"synthetics:StartCanaryDryRun",
+			"synthetics:StopCanary",
+			"synthetics:TagResource",
+			"synthetics:UntagResource",
+			"synthetics:UpdateCanary",
+		},
+		OperationDelete: {
+			"synthetics:DeleteCanary",
+			"synthetics:GetCanary",
+			"synthetics:StopCanary",
+		},
+	},
+	"aws_synthetics_group": {
+		OperationCreate: {
+			"synthetics:CreateGroup",
+			"synthetics:GetGroup",
+		},
+		OperationUpdate: {
+			"synthetics:GetGroup",
+			"synthetics:TagResource",
+			"synthetics:UntagResource",
+		},
+		OperationDelete: {
+			"synthetics:DeleteGroup",
+		},
+	},
+	"aws_synthetics_group_association": {
+		OperationCreate: {
+			"synthetics:AssociateResource",
+			"synthetics:ListAssociatedGroups",
+		},
+		OperationDelete: {
+			"synthetics:DisassociateResource",
+		},
+	},
+	"aws_timestreamwrite_batch_load_task": {
+		OperationCreate: {
+			"timestream:CreateBatchLoadTask",
+			"timestream:DescribeBatchLoadTask",
+		},
+	},
+	"aws_timestreamwrite_database": {
+		OperationCreate: {
+			"timestream:CreateDatabase",
+			"timestream:DescribeDatabase",
+		},
+		OperationUpdate: {
+			"timestream:DescribeDatabase",
+			"timestream:TagResource",
+			"timestream:UntagResource",
+			"timestream:UpdateDatabase",
+		},
+		OperationDelete: {
+			"timestream:DeleteDatabase",
+		},
+	},
+	"aws_timestreamwrite_table": {
+		OperationCreate: {
+			"timestream:CreateTable",
+			"timestream:DescribeTable",
+		},
+		OperationUpdate: {
+			"timestream:DescribeTable",
+			"timestream:TagResource",
+			"timestream:UntagResource",
+			"timestream:UpdateTable",
+		},
+		OperationDelete: {
+			"timestream:DeleteTable",
+		},
+	},
+	"aws_transcribe_language_model": {
+		OperationCreate: {
+			"transcribe:CreateLanguageModel",
+			"transcribe:DescribeLanguageModel",
+		},
+		OperationUpdate: {
+			"transcribe:DescribeLanguageModel",
+			"transcribe:TagResource",
+			"transcribe:UntagResource",
+		},
+		OperationDelete: {
+			"transcribe:DeleteLanguageModel",
+		},
+	},
+	"aws_transcribe_medical_vocabulary": {
+		OperationCreate: {
+			"transcribe:CreateMedicalVocabulary",
+			"transcribe:GetMedicalVocabulary",
+		},
+		OperationUpdate: {
+			"transcribe:GetMedicalVocabulary",
+			"transcribe:TagResource",
+			"transcribe:UntagResource",
+			"transcribe:UpdateMedicalVocabulary",
+		},
+		OperationDelete: {
+			"transcribe:DeleteMedicalVocabulary",
+			"transcribe:GetMedicalVocabulary",
+		},
+	},
+	"aws_transcribe_vocabulary": {
+		OperationCreate: {
+			"transcribe:CreateVocabulary",
+			"transcribe:GetVocabulary",
+		},
+		OperationUpdate: {
+			"transcribe:GetVocabulary",
+			"transcribe:TagResource",
+			"transcribe:UntagResource",
+			"transcribe:UpdateVocabulary",
+		},
+		OperationDelete: {
+			"transcribe:DeleteVocabulary",
+			"transcribe:GetVocabulary",
+		},
+	},
+	"aws_transcribe_vocabulary_filter": {
+		OperationCreate: {
+			"transcribe:CreateVocabularyFilter",
+			"transcribe:GetVocabularyFilter",
+		},
+		OperationUpdate: {
+			"transcribe:GetVocabularyFilter",
+			"transcribe:TagResource",
+			"transcribe:UntagResource",
+			"transcribe:UpdateVocabularyFilter",
+		},
+		OperationDelete: {
+			"transcribe:DeleteVocabularyFilter",
+		},
+	},
+	"aws_transfer_access": {
+		OperationCreate: {
+			"transfer:CreateAccess",
+			"transfer:DescribeAccess",
+		},
+		OperationUpdate: {
+			"transfer:DescribeAccess",
+			"transfer:UpdateAccess",
+		},
+		OperationDelete: {
+			"transfer:DeleteAccess",
+		},
+	},
+	"aws_transfer_agreement": {
+		OperationCreate: {
+			"transfer:CreateAgreement",
+			"transfer:DescribeAgreement",
+		},
+		OperationUpdate: {
+			"transfer:DescribeAgreement",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateAgreement",
+		},
+		OperationDelete: {
+			"transfer:DeleteAgreement",
+		},
+	},
+	"aws_transfer_certificate": {
+		OperationCreate: {
+			"transfer:DescribeCertificate",
+			"transfer:ImportCertificate",
+		},
+		OperationUpdate: {
+			"transfer:DescribeCertificate",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateCertificate",
+		},
+		OperationDelete: {
+			"transfer:DeleteCertificate",
+		},
+	},
+	"aws_transfer_connector": {
+		OperationCreate: {
+			"transfer:CreateConnector",
+			"transfer:DescribeConnector",
+		},
+		OperationUpdate: {
+			"transfer:DescribeConnector",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateConnector",
+		},
+		OperationDelete: {
+			"transfer:DeleteConnector",
+		},
+	},
+	"aws_transfer_profile": {
+		OperationCreate: {
+			"transfer:CreateProfile",
+			"transfer:DescribeProfile",
+		},
+		OperationUpdate: {
+			"transfer:DescribeProfile",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateProfile",
+		},
+		OperationDelete: {
+			"transfer:DeleteProfile",
+		},
+	},
+	"aws_transfer_server": {
+		OperationCreate: {
+			"transfer:CreateServer",
+			"transfer:DescribeServer",
+			"transfer:StartServer",
+			"transfer:StopServer",
+			"transfer:UpdateServer",
+		},
+		OperationUpdate: {
+			"transfer:DescribeServer",
+			"transfer:ModifyVpcEndpoint",
+			"transfer:StartServer",
+			"transfer:StopServer",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateServer",
+		},
+		OperationDelete: {
+			"transfer:DeleteServer",
+			"transfer:DeleteUser",
+			"transfer:DescribeServer",
+			"transfer:DescribeUser",
+			"transfer:ListUsers",
+		},
+	},
+	"aws_transfer_ssh_key": {
+		OperationCreate: {
+			"transfer:ImportSshPublicKey",
+		},
+		OperationDelete: {
+			"transfer:DeleteSshPublicKey",
+		},
+	},
+	"aws_transfer_tag": {
+		OperationCreate: {
+			"transfer:ListTagsForResource",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+		},
+		OperationUpdate: {
+			"transfer:ListTagsForResource",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+		},
+		OperationDelete: {
+			"transfer:TagResource",
+			"transfer:UntagResource",
+		},
+	},
+	"aws_transfer_user": {
+		OperationCreate: {
+			"transfer:CreateUser",
+			"transfer:DescribeUser",
+		},
+		OperationUpdate: {
+			"transfer:DescribeUser",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+			"transfer:UpdateUser",
+		},
+		OperationDelete: {
+			"transfer:DeleteUser",
+			"transfer:DescribeUser",
+		},
+	},
+	"aws_transfer_workflow": {
+		OperationCreate: {
+			"transfer:CreateWorkflow",
+			"transfer:DescribeWorkflow",
+		},
+		OperationUpdate: {
+			"transfer:DescribeWorkflow",
+			"transfer:TagResource",
+			"transfer:UntagResource",
+		},
+		OperationDelete: {
+			"transfer:DeleteWorkflow",
+		},
+	},
+	"aws_verifiedaccess_endpoint": {
+		OperationCreate: {
+			"ec2:CreateVerifiedAccessEndpoint",
+			"ec2:DescribeVerifiedAccessEndpoints",
+			"ec2:GetVerifiedAccessEndpointPolicy",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVerifiedAccessEndpoints",
+			"ec2:GetVerifiedAccessEndpointPolicy",
+			"ec2:ModifyVerifiedAccessEndpoint",
+			"ec2:ModifyVerifiedAccessEndpointPolicy",
+		},
+		OperationDelete: {
+			"ec2:DeleteVerifiedAccessEndpoint",
+			"ec2:DescribeVerifiedAccessEndpoints",
+		},
+	},
+	"aws_verifiedaccess_group": {
+		OperationCreate: {
+			"ec2:CreateVerifiedAccessGroup",
+			"ec2:DescribeVerifiedAccessGroups",
+			"ec2:GetVerifiedAccessGroupPolicy",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVerifiedAccessGroups",
+			"ec2:GetVerifiedAccessGroupPolicy",
+			"ec2:ModifyVerifiedAccessGroup",
+			"ec2:ModifyVerifiedAccessGroupPolicy",
+		},
+		OperationDelete: {
+			"ec2:DeleteVerifiedAccessGroup",
+		},
+	},
+	"aws_verifiedaccess_instance": {
+		OperationCreate: {
+			"ec2:CreateVerifiedAccessInstance",
+			"ec2:DescribeVerifiedAccessInstances",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVerifiedAccessInstances",
+			"ec2:ModifyVerifiedAccessInstance",
+		},
+		OperationDelete: {
+			"ec2:DeleteVerifiedAccessInstance",
+		},
+	},
+	"aws_verifiedaccess_instance_logging_configuration": {
+		OperationCreate: {
+			"ec2:DescribeVerifiedAccessInstanceLoggingConfigurations",
+			"ec2:ModifyVerifiedAccessInstanceLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"ec2:DescribeVerifiedAccessInstanceLoggingConfigurations",
+			"ec2:ModifyVerifiedAccessInstanceLoggingConfiguration",
+		},
+		OperationDelete: {
+			"ec2:ModifyVerifiedAccessInstanceLoggingConfiguration",
+		},
+	},
+	"aws_verifiedaccess_instance_trust_provider_attachment": {
+		OperationCreate: {
+			"ec2:AttachVerifiedAccessTrustProvider",
+			"ec2:DescribeVerifiedAccessInstances",
+		},
+		OperationDelete: {
+			"ec2:DetachVerifiedAccessTrustProvider",
+		},
+	},
+	"aws_verifiedaccess_trust_provider": {
+		OperationCreate: {
+			"ec2:CreateVerifiedAccessTrustProvider",
+			"ec2:DescribeVerifiedAccessTrustProviders",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVerifiedAccessTrustProviders",
+			"ec2:ModifyVerifiedAccessTrustProvider",
+		},
+		OperationDelete: {
+			"ec2:DeleteVerifiedAccessTrustProvider",
+		},
+	},
+	"aws_verifiedpermissions_policy": {
+		OperationCreate: {
+			"verifiedpermissions:CreatePolicy",
+		},
+		OperationUpdate: {
+			"verifiedpermissions:UpdatePolicy",
+		},
+		OperationDelete: {
+			"verifiedpermissions:DeletePolicy",
+		},
+	},
+	"aws_verifiedpermissions_policy_store": {
+		OperationCreate: {
+			"verifiedpermissions:CreatePolicyStore",
+		},
+		OperationUpdate: {
+			"verifiedpermissions:UpdatePolicyStore",
+		},
+		OperationDelete: {
+			"verifiedpermissions:DeletePolicyStore",
+		},
+	},
+	"aws_verifiedpermissions_policy_template": {
+		OperationCreate: {
+			"verifiedpermissions:CreatePolicyTemplate",
+		},
+		OperationUpdate: {
+			"verifiedpermissions:UpdatePolicyTemplate",
+		},
+		OperationDelete: {
+			"verifiedpermissions:DeletePolicyTemplate",
+		},
+	},
+	"aws_verifiedpermissions_policy_template_linked_policies": {
+		OperationCreate: {
+			"verifiedpermissions:CreatePolicy",
+		},
+		OperationUpdate: {
+			"verifiedpermissions:CreatePolicy",
+			"verifiedpermissions:DeletePolicy",
+			"verifiedpermissions:ListPolicies",
+		},
+		OperationDelete: {
+			"verifiedpermissions:DeletePolicy",
+			"verifiedpermissions:ListPolicies",
+		},
+	},
+	"aws_verifiedpermissions_policy_template_linked_policy": {
+		OperationCreate: {
+			"verifiedpermissions:CreatePolicy",
+			"verifiedpermissions:GetPolicy",
+		},
+		OperationDelete: {
+			"verifiedpermissions:DeletePolicy",
+		},
+	},
+	"aws_verifiedpermissions_schema": {
+		OperationCreate: {
+			"verifiedpermissions:PutSchema",
+		},
+		OperationUpdate: {
+			"verifiedpermissions:GetSchema",
+			"verifiedpermissions:PutSchema",
+		},
+		OperationDelete: {
+			"verifiedpermissions:PutSchema",
+		},
+	},
+	"aws_volume_attachment": {
+		OperationCreate: {
+			"ec2:AttachVolume",
+			"ec2:DescribeInstances",
+			"ec2:DescribeVolumes",
+		},
+		OperationDelete: {
+			"ec2:DescribeInstances",
+			"ec2:DescribeVolumes",
+			"ec2:DetachVolume",
+			"ec2:StopInstances",
+		},
+	},
+	"aws_vpc": {
+		OperationCreate: {
+			"ec2:CreateVpc",
+			"ec2:DescribeNetworkAcls",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcAttribute",
+			"ec2:DescribeVpcs",
+			"ec2:ModifyVpcAttribute",
+		},
+		OperationUpdate: {
+			"ec2:AssociateVpcCidrBlock",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkAcls",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcAttribute",
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateVpcCidrBlock",
+			"ec2:ModifyVpcAttribute",
+			"ec2:ModifyVpcTenancy",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpc",
+			"ec2:DescribeVpcs",
+			"ec2:GetIpamPoolAllocations",
+		},
+	},
+	"aws_vpc_dhcp_options": {
+		OperationCreate: {
+			"ec2:CreateDhcpOptions",
+			"ec2:DescribeDhcpOptions",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeDhcpOptions",
+		},
+		OperationDelete: {
+			"ec2:AssociateDhcpOptions",
+			"ec2:DeleteDhcpOptions",
+			"ec2:DescribeVpcs",
+		},
+	},
+	"aws_vpc_dhcp_options_association": {
+		OperationCreate: {
+			"ec2:AssociateDhcpOptions",
+			"ec2:DescribeVpcs",
+		},
+		OperationUpdate: {
+			"ec2:AssociateDhcpOptions",
+			"ec2:DescribeVpcs",
+		},
+		OperationDelete: {
+			"ec2:AssociateDhcpOptions",
+		},
+	},
+	"aws_vpc_endpoint": {
+		OperationCreate: {
+			"ec2:AcceptVpcEndpointConnections",
+			"ec2:CreateTags",
+			"ec2:CreateVpcEndpoint",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribePrefixLists",
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpoints",
+		},
+		OperationUpdate: {
+			"ec2:AcceptVpcEndpointConnections",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:DescribePrefixLists",
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpcEndpoints",
+			"ec2:DescribeVpcEndpoints",
+		},
+	},
+	"aws_vpc_endpoint_connection_accepter": {
+		OperationCreate: {
+			"ec2:AcceptVpcEndpointConnections",
+			"ec2:DescribeVpcEndpointConnections",
+		},
+		OperationDelete: {
+			"ec2:RejectVpcEndpointConnections",
+		},
+	},
+	"aws_vpc_endpoint_connection_notification": {
+		OperationCreate: {
+			"ec2:CreateVpcEndpointConnectionNotification",
+			"ec2:DescribeVpcEndpointConnectionNotifications",
+		},
+		OperationUpdate: {
+			"ec2:DescribeVpcEndpointConnectionNotifications",
+			"ec2:ModifyVpcEndpointConnectionNotification",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpcEndpointConnectionNotifications",
+		},
+	},
+	"aws_vpc_endpoint_policy": {
+		OperationCreate: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationUpdate: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+	},
+	"aws_vpc_endpoint_private_dns": {
+		OperationCreate: {
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationUpdate: {
+			"ec2:ModifyVpcEndpoint",
+		},
+	},
+	"aws_vpc_endpoint_route_table_association": {
+		OperationCreate: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+	},
+	"aws_vpc_endpoint_security_group_association": {
+		OperationCreate: {
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+	},
+	"aws_vpc_endpoint_service": {
+		OperationCreate: {
+			"ec2:CreateVpcEndpointServiceConfiguration",
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpointServicePermissions",
+			"ec2:ModifyVpcEndpointServicePermissions",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpointServicePermissions",
+			"ec2:ModifyVpcEndpointServiceConfiguration",
+			"ec2:ModifyVpcEndpointServicePermissions",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+		},
+	},
+	"aws_vpc_endpoint_service_allowed_principal": {
+		OperationCreate: {
+			"ec2:DescribeVpcEndpointServicePermissions",
+			"ec2:ModifyVpcEndpointServicePermissions",
+		},
+		OperationDelete: {
+			"ec2:ModifyVpcEndpointServicePermissions",
+		},
+	},
+	"aws_vpc_endpoint_service_private_dns_verification": {
+		OperationCreate: {
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:StartVpcEndpointServicePrivateDnsVerification",
+		},
+	},
+	"aws_vpc_endpoint_subnet_association": {
+		OperationCreate: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpcEndpoints",
+			"ec2:ModifyVpcEndpoint",
+		},
+	},
+	"aws_vpc_ipam": {
+		OperationCreate: {
+			"ec2:CreateIpam",
+			"ec2:DescribeIpams",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIpams",
+			"ec2:ModifyIpam",
+		},
+		OperationDelete: {
+			"ec2:DeleteIpam",
+			"ec2:DescribeIpams",
+		},
+	},
+	"aws_vpc_ipam_byoasn": {
+		OperationCreate: {
+			"ec2:DescribeIpamByoasn",
+			"ec2:ProvisionIpamByoasn",
+		},
+		OperationDelete: {
+			"ec2:DeprovisionIpamByoasn",
+			"ec2:DescribeIpamByoasn",
+		},
+	},
+	"aws_vpc_ipam_byoasn_association": {
+		OperationCreate: {
+			"ec2:AssociateIpamByoasn",
+			"ec2:DescribeByoipCidrs",
+		},
+		OperationDelete: {
+			"ec2:DescribeByoipCidrs",
+			"ec2:DisassociateIpamByoasn",
+		},
+	},
+	"aws_vpc_ipam_organization_admin_account": {
+		OperationCreate: {
+			"ec2:EnableIpamOrganizationAdminAccount",
+		},
+		OperationDelete: {
+			"ec2:DisableIpamOrganizationAdminAccount",
+		},
+	},
+	"aws_vpc_ipam_pool": {
+		OperationCreate: {
+			"ec2:CreateIpamPool",
+			"ec2:DescribeIpamPools",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIpamPools",
+			"ec2:ModifyIpamPool",
+		},
+		OperationDelete: {
+			"ec2:DeleteIpamPool",
+			"ec2:DescribeIpamPools",
+		},
+	},
+	"aws_vpc_ipam_pool_cidr": {
+		OperationCreate: {
+			"ec2:GetIpamPoolCidrs",
+			"ec2:ProvisionIpamPoolCidr",
+		},
+		OperationDelete: {
+			"ec2:DeprovisionIpamPoolCidr",
+			"ec2:GetIpamPoolCidrs",
+		},
+	},
+	"aws_vpc_ipam_pool_cidr_allocation": {
+		OperationCreate: {
+			"ec2:AllocateIpamPoolCidr",
+			"ec2:GetIpamPoolAllocations",
+		},
+		OperationDelete: {
+			"ec2:ReleaseIpamPoolAllocation",
+		},
+	},
+	"aws_vpc_ipam_preview_next_cidr": {
+		OperationCreate: {
+			"ec2:AllocateIpamPoolCidr",
+		},
+	},
+	"aws_vpc_ipam_resource_discovery": {
+		OperationCreate: {
+			"ec2:CreateIpamResourceDiscovery",
+			"ec2:DescribeIpamResourceDiscoveries",
+			"ec2:ModifyIpamResourceDiscovery",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIpamResourceDiscoveries",
+			"ec2:ModifyIpamResourceDiscovery",
+		},
+		OperationDelete: {
+			"ec2:DeleteIpamResourceDiscovery",
+			"ec2:DescribeIpamResourceDiscoveries",
+		},
+	},
+	"aws_vpc_ipam_resource_discovery_association": {
+		OperationCreate: {
+			"ec2:AssociateIpamResourceDiscovery",
+			"ec2:DescribeIpamResourceDiscoveryAssociations",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIpamResourceDiscoveryAssociations",
+		},
+		OperationDelete: {
+			"ec2:DescribeIpamResourceDiscoveryAssociations",
+			"ec2:DisassociateIpamResourceDiscovery",
+		},
+	},
+	"aws_vpc_ipam_scope": {
+		OperationCreate: {
+			"ec2:CreateIpamScope",
+			"ec2:DescribeIpamScopes",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeIpamScopes",
+			"ec2:ModifyIpamScope",
+		},
+		OperationDelete: {
+			"ec2:DeleteIpamScope",
+			"ec2:DescribeIpamScopes",
+		},
+	},
+	"aws_vpc_ipv4_cidr_block_association": {
+		OperationCreate: {
+			"ec2:AssociateVpcCidrBlock",
+			"ec2:DescribeVpcs",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateVpcCidrBlock",
+		},
+	},
+	"aws_vpc_ipv6_cidr_block_association": {
+		OperationCreate: {
+			"ec2:AssociateVpcCidrBlock",
+			"ec2:DescribeVpcs",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpcs",
+			"ec2:DisassociateVpcCidrBlock",
+		},
+	},
+	"aws_vpc_network_performance_metric_subscription": {
+		OperationCreate: {
+			"ec2:DescribeAwsNetworkPerformanceMetricSubscriptions",
+			"ec2:EnableAwsNetworkPerformanceMetricSubscription",
+		},
+		OperationDelete: {
+			"ec2:DisableAwsNetworkPerformanceMetricSubscription",
+		},
+	},
+	"aws_vpc_peering_connection": {
+		OperationCreate: {
+			"ec2:AcceptVpcPeeringConnection",
+			"ec2:CreateVpcPeeringConnection",
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+		OperationUpdate: {
+			"ec2:AcceptVpcPeeringConnection",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpcPeeringConnection",
+			"ec2:DescribeVpcPeeringConnections",
+		},
+	},
+	"aws_vpc_peering_connection_accepter": {
+		OperationCreate: {
+			"ec2:AcceptVpcPeeringConnection",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+		OperationUpdate: {
+			"ec2:AcceptVpcPeeringConnection",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+	},
+	"aws_vpc_peering_connection_options": {
+		OperationCreate: {
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+		OperationUpdate: {
+			"ec2:DescribeVpcPeeringConnections",
+			"ec2:ModifyVpcPeeringConnectionOptions",
+		},
+	},
+	"aws_vpclattice_access_log_subscription": {
+		OperationCreate: {
+			"vpc-lattice:CreateAccessLogSubscription",
+			"vpc-lattice:GetAccessLogSubscription",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetAccessLogSubscription",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteAccessLogSubscription",
+		},
+	},
+	"aws_vpclattice_auth_policy": {
+		OperationCreate: {
+			"vpc-lattice:GetAuthPolicy",
+			"vpc-lattice:PutAuthPolicy",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetAuthPolicy",
+			"vpc-lattice:PutAuthPolicy",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteAuthPolicy",
+		},
+	},
+	"aws_vpclattice_listener": {
+		OperationCreate: {
+			"vpc-lattice:CreateListener",
+			"vpc-lattice:GetListener",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetListener",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateListener",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteListener",
+		},
+	},
+	"aws_vpclattice_listener_rule": {
+		OperationCreate: {
+			"vpc-lattice:CreateRule",
+			"vpc-lattice:GetRule",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetRule",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateRule",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteRule",
+		},
+	},
+	"aws_vpclattice_resource_policy": {
+		OperationCreate: {
+			"vpc-lattice:GetResourcePolicy",
+			"vpc-lattice:PutResourcePolicy",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetResourcePolicy",
+			"vpc-lattice:PutResourcePolicy",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteResourcePolicy",
+		},
+	},
+	"aws_vpclattice_service": {
+		OperationCreate: {
+			"vpc-lattice:CreateService",
+			"vpc-lattice:GetService",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetService",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateService",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteService",
+			"vpc-lattice:GetService",
+		},
+	},
+	"aws_vpclattice_service_network": {
+		OperationCreate: {
+			"vpc-lattice:CreateServiceNetwork",
+			"vpc-lattice:GetServiceNetwork",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetServiceNetwork",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateServiceNetwork",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteServiceNetwork",
+		},
+	},
+	"aws_vpclattice_service_network_resource_association": {
+		OperationCreate: {
+			"vpc-lattice:CreateServiceNetworkResourceAssociation",
+			"vpc-lattice:GetServiceNetworkResourceAssociation",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetServiceNetworkResourceAssociation",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteServiceNetworkResourceAssociation",
+			"vpc-lattice:GetServiceNetworkResourceAssociation",
+		},
+	},
+	"aws_vpclattice_service_network_service_association": {
+		OperationCreate: {
+			"vpc-lattice:CreateServiceNetworkServiceAssociation",
+			"vpc-lattice:GetServiceNetworkServiceAssociation",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetServiceNetworkServiceAssociation",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteServiceNetworkServiceAssociation",
+			"vpc-lattice:GetServiceNetworkServiceAssociation",
+		},
+	},
+	"aws_vpclattice_service_network_vpc_association": {
+		OperationCreate: {
+			"vpc-lattice:CreateServiceNetworkVpcAssociation",
+			"vpc-lattice:GetServiceNetworkVpcAssociation",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetServiceNetworkVpcAssociation",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateServiceNetworkVpcAssociation",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteServiceNetworkVpcAssociation",
+			"vpc-lattice:GetServiceNetworkVpcAssociation",
+		},
+	},
+	"aws_vpclattice_target_group": {
+		OperationCreate: {
+			"vpc-lattice:CreateTargetGroup",
+			"vpc-lattice:GetTargetGroup",
+		},
+		OperationUpdate: {
+			"vpc-lattice:GetTargetGroup",
+			"vpc-lattice:TagResource",
+			"vpc-lattice:UntagResource",
+			"vpc-lattice:UpdateTargetGroup",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeleteTargetGroup",
+			"vpc-lattice:GetTargetGroup",
+		},
+	},
+	"aws_vpclattice_target_group_attachment": {
+		OperationCreate: {
+			"vpc-lattice:ListTargets",
+			"vpc-lattice:RegisterTargets",
+		},
+		OperationDelete: {
+			"vpc-lattice:DeregisterTargets",
+			"vpc-lattice:ListTargets",
+		},
+	},
+	"aws_vpn_connection": {
+		OperationCreate: {
+			"ec2:CreateVpnConnection",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DescribeVpnConnections",
+			"ec2:GetVpnTunnelReplacementStatus",
+		},
+		OperationUpdate: {
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeTransitGatewayAttachments",
+			"ec2:DescribeVpnConnections",
+			"ec2:GetVpnTunnelReplacementStatus",
+			"ec2:ModifyVpnConnection",
+			"ec2:ModifyVpnConnectionOptions",
+			"ec2:ModifyVpnTunnelOptions",
+			"ec2:ReplaceVpnTunnel",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpnConnection",
+			"ec2:DescribeVpnConnections",
+		},
+	},
+	"aws_vpn_connection_route": {
+		OperationCreate: {
+			"ec2:CreateVpnConnectionRoute",
+			"ec2:DescribeVpnConnections",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpnConnectionRoute",
+			"ec2:DescribeVpnConnections",
+		},
+	},
+	"aws_vpn_gateway": {
+		OperationCreate: {
+			"ec2:AttachVpnGateway",
+			"ec2:CreateVpnGateway",
+			"ec2:DescribeVpnGateways",
+		},
+		OperationUpdate: {
+			"ec2:AttachVpnGateway",
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+			"ec2:DescribeVpnGateways",
+			"ec2:DetachVpnGateway",
+		},
+		OperationDelete: {
+			"ec2:DeleteVpnGateway",
+			"ec2:DescribeVpnGateways",
+			"ec2:DetachVpnGateway",
+		},
+	},
+	"aws_vpn_gateway_attachment": {
+		OperationCreate: {
+			"ec2:AttachVpnGateway",
+			"ec2:DescribeVpnGateways",
+		},
+		OperationDelete: {
+			"ec2:DescribeVpnGateways",
+			"ec2:DetachVpnGateway",
+		},
+	},
+	"aws_vpn_gateway_route_propagation": {
+		OperationCreate: {
+			"ec2:DescribeRouteTables",
+			"ec2:EnableVgwRoutePropagation",
+		},
+		OperationDelete: {
+			"ec2:DisableVgwRoutePropagation",
+		},
+	},
+	"aws_waf_byte_match_set": {
+		OperationCreate: {
+			"waf:CreateByteMatchSet",
+			"waf:GetByteMatchSet",
+			"waf:UpdateByteMatchSet",
+		},
+		OperationUpdate: {
+			"waf:GetByteMatchSet",
+			"waf:UpdateByteMatchSet",
+		},
+		OperationDelete: {
+			"waf:DeleteByteMatchSet",
+			"waf:UpdateByteMatchSet",
+		},
+	},
+	"aws_waf_geo_match_set": {
+		OperationCreate: {
+			"waf:CreateGeoMatchSet",
+			"waf:GetGeoMatchSet",
+			"waf:UpdateGeoMatchSet",
+		},
+		OperationUpdate: {
+			"waf:GetGeoMatchSet",
+			"waf:UpdateGeoMatchSet",
+		},
+		OperationDelete: {
+			"waf:DeleteGeoMatchSet",
+			"waf:UpdateGeoMatchSet",
+		},
+	},
+	"aws_waf_ipset": {
+		OperationCreate: {
+			"waf:CreateIPSet",
+			"waf:GetIPSet",
+			"waf:UpdateIPSet",
+		},
+		OperationUpdate: {
+			"waf:GetIPSet",
+			"waf:UpdateIPSet",
+		},
+		OperationDelete: {
+			"waf:DeleteIPSet",
+			"waf:UpdateIPSet",
+		},
+	},
+	"aws_waf_rate_based_rule": {
+		OperationCreate: {
+			"waf:CreateRateBasedRule",
+			"waf:GetRateBasedRule",
+			"waf:UpdateRateBasedRule",
+		},
+		OperationUpdate: {
+			"waf:GetRateBasedRule",
+			"waf:TagResource",
+			"waf:UntagResource",
+			"waf:UpdateRateBasedRule",
+		},
+		OperationDelete: {
+			"waf:DeleteRateBasedRule",
+			"waf:UpdateRateBasedRule",
+		},
+	},
+	"aws_waf_regex_match_set": {
+		OperationCreate: {
+			"waf:CreateRegexMatchSet",
+			"waf:GetRegexMatchSet",
+			"waf:UpdateRegexMatchSet",
+		},
+		OperationUpdate: {
+			"waf:GetRegexMatchSet",
+			"waf:UpdateRegexMatchSet",
+		},
+		OperationDelete: {
+			"waf:DeleteRegexMatchSet",
+			"waf:UpdateRegexMatchSet",
+		},
+	},
+	"aws_waf_regex_pattern_set": {
+		OperationCreate: {
+			"waf:CreateRegexPatternSet",
+			"waf:GetRegexPatternSet",
+			"waf:UpdateRegexPatternSet",
+		},
+		OperationUpdate: {
+			"waf:GetRegexPatternSet",
+			"waf:UpdateRegexPatternSet",
+		},
+		OperationDelete: {
+			"waf:DeleteRegexPatternSet",
+			"waf:UpdateRegexPatternSet",
+		},
+	},
+	"aws_waf_rule": {
+		OperationCreate: {
+			"waf:CreateRule",
+			"waf:GetRule",
+			"waf:UpdateRule",
+		},
+		OperationUpdate: {
+			"waf:GetRule",
+			"waf:TagResource",
+			"waf:UntagResource",
+			"waf:UpdateRule",
+		},
+		OperationDelete: {
+			"waf:DeleteRule",
+			"waf:UpdateRule",
+		},
+	},
+	"aws_waf_rule_group": {
+		OperationCreate: {
+			"waf:CreateRuleGroup",
+			"waf:GetRuleGroup",
+			"waf:ListActivatedRulesInRuleGroup",
+			"waf:UpdateRuleGroup",
+		},
+		OperationUpdate: {
+			"waf:GetRuleGroup",
+			"waf:ListActivatedRulesInRuleGroup",
+			"waf:TagResource",
+			"waf:UntagResource",
+			"waf:UpdateRuleGroup",
+		},
+		OperationDelete: {
+			"waf:DeleteRuleGroup",
+			"waf:UpdateRuleGroup",
+		},
+	},
+	"aws_waf_size_constraint_set": {
+		OperationCreate: {
+			"waf:CreateSizeConstraintSet",
+			"waf:GetSizeConstraintSet",
+			"waf:UpdateSizeConstraintSet",
+		},
+		OperationUpdate: {
+			"waf:GetSizeConstraintSet",
+			"waf:UpdateSizeConstraintSet",
+		},
+		OperationDelete: {
+			"waf:DeleteSizeConstraintSet",
+			"waf:UpdateSizeConstraintSet",
+		},
+	},
+	"aws_waf_sql_injection_match_set": {
+		OperationCreate: {
+			"waf:CreateSqlInjectionMatchSet",
+			"waf:GetSqlInjectionMatchSet",
+			"waf:UpdateSqlInjectionMatchSet",
+		},
+		OperationUpdate: {
+			"waf:GetSqlInjectionMatchSet",
+			"waf:UpdateSqlInjectionMatchSet",
+		},
+		OperationDelete: {
+			"waf:DeleteSqlInjectionMatchSet",
+			"waf:UpdateSqlInjectionMatchSet",
+		},
+	},
+	"aws_waf_web_acl": {
+		OperationCreate: {
+			"waf:CreateWebACL",
+			"waf:GetLoggingConfiguration",
+			"waf:GetWebACL",
+			"waf:PutLoggingConfiguration",
+			"waf:UpdateWebACL",
+		},
+		OperationUpdate: {
+			"waf:DeleteLoggingConfiguration",
+			"waf:GetLoggingConfiguration",
+			"waf:GetWebACL",
+			"waf:PutLoggingConfiguration",
+			"waf:TagResource",
+			"waf:UntagResource",
+			"waf:UpdateWebACL",
+		},
+		OperationDelete: {
+			"waf:DeleteWebACL",
+			"waf:UpdateWebACL",
+		},
+	},
+	"aws_waf_xss_match_set": {
+		OperationCreate: {
+			"waf:CreateXssMatchSet",
+			"waf:GetXssMatchSet",
+			"waf:UpdateXssMatchSet",
+		},
+		OperationUpdate: {
+			"waf:GetXssMatchSet",
+			"waf:UpdateXssMatchSet",
+		},
+		OperationDelete: {
+			"waf:DeleteXssMatchSet",
+			"waf:UpdateXssMatchSet",
+		},
+	},
+	"aws_wafregional_byte_match_set": {
+		OperationCreate: {
+			"waf-regional:CreateByteMatchSet",
+			"waf-regional:GetByteMatchSet",
+			"waf-regional:UpdateByteMatchSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetByteMatchSet",
+			"waf-regional:UpdateByteMatchSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteByteMatchSet",
+			"waf-regional:UpdateByteMatchSet",
+		},
+	},
+	"aws_wafregional_geo_match_set": {
+		OperationCreate: {
+			"waf-regional:CreateGeoMatchSet",
+			"waf-regional:GetGeoMatchSet",
+			"waf-regional:UpdateGeoMatchSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetGeoMatchSet",
+			"waf-regional:UpdateGeoMatchSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteGeoMatchSet",
+			"waf-regional:UpdateGeoMatchSet",
+		},
+	},
+	"aws_wafregional_ipset": {
+		OperationCreate: {
+			"waf-regional:CreateIPSet",
+			"waf-regional:GetIPSet",
+			"waf-regional:UpdateIPSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetIPSet",
+			"waf-regional:UpdateIPSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteIPSet",
+			"waf-regional:UpdateIPSet",
+		},
+	},
+	"aws_wafregional_rate_based_rule": {
+		OperationCreate: {
+			"waf-regional:CreateRateBasedRule",
+			"waf-regional:GetRateBasedRule",
+			"waf-regional:UpdateRateBasedRule",
+		},
+		OperationUpdate: {
+			"waf-regional:GetRateBasedRule",
+			"waf-regional:TagResource",
+			"waf-regional:UntagResource",
+			"waf-regional:UpdateRateBasedRule",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteRateBasedRule",
+			"waf-regional:UpdateRateBasedRule",
+		},
+	},
+	"aws_wafregional_regex_match_set": {
+		OperationCreate: {
+			"waf-regional:CreateRegexMatchSet",
+			"waf-regional:GetRegexMatchSet",
+			"waf-regional:UpdateRegexMatchSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetRegexMatchSet",
+			"waf-regional:UpdateRegexMatchSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteRegexMatchSet",
+			"waf-regional:UpdateRegexMatchSet",
+		},
+	},
+	"aws_wafregional_regex_pattern_set": {
+		OperationCreate: {
+			"waf-regional:CreateRegexPatternSet",
+			"waf-regional:GetRegexPatternSet",
+			"waf-regional:UpdateRegexPatternSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetRegexPatternSet",
+			"waf-regional:UpdateRegexPatternSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteRegexPatternSet",
+			"waf-regional:UpdateRegexPatternSet",
+		},
+	},
+	"aws_wafregional_rule": {
+		OperationCreate: {
+			"waf-regional:CreateRule",
+			"waf-regional:GetRule",
+			"waf-regional:UpdateRule",
+		},
+		OperationUpdate: {
+			"waf-regional:GetRule",
+			"waf-regional:TagResource",
+			"waf-regional:UntagResource",
+			"waf-regional:UpdateRule",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteRule",
+			"waf-regional:UpdateRule",
+		},
+	},
+	"aws_wafregional_rule_group": {
+		OperationCreate: {
+			"waf-regional:CreateRuleGroup",
+			"waf-regional:GetRuleGroup",
+			"waf-regional:ListActivatedRulesInRuleGroup",
+			"waf-regional:UpdateRuleGroup",
+		},
+		OperationUpdate: {
+			"waf-regional:GetRuleGroup",
+			"waf-regional:ListActivatedRulesInRuleGroup",
+			"waf-regional:TagResource",
+			"waf-regional:UntagResource",
+			"waf-regional:UpdateRuleGroup",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteRuleGroup",
+			"waf-regional:UpdateRuleGroup",
+		},
+	},
+	"aws_wafregional_size_constraint_set": {
+		OperationCreate: {
+			"waf-regional:CreateSizeConstraintSet",
+			"waf-regional:GetSizeConstraintSet",
+			"waf-regional:UpdateSizeConstraintSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetSizeConstraintSet",
+			"waf-regional:UpdateSizeConstraintSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteSizeConstraintSet",
+			"waf-regional:UpdateSizeConstraintSet",
+		},
+	},
+	"aws_wafregional_sql_injection_match_set": {
+		OperationCreate: {
+			"waf-regional:CreateSqlInjectionMatchSet",
+			"waf-regional:GetSqlInjectionMatchSet",
+			"waf-regional:UpdateSqlInjectionMatchSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetSqlInjectionMatchSet",
+			"waf-regional:UpdateSqlInjectionMatchSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteSqlInjectionMatchSet",
+			"waf-regional:UpdateSqlInjectionMatchSet",
+		},
+	},
+	"aws_wafregional_web_acl": {
+		OperationCreate: {
+			"waf-regional:CreateWebACL",
+			"waf-regional:GetLoggingConfiguration",
+			"waf-regional:GetWebACL",
+			"waf-regional:PutLoggingConfiguration",
+			"waf-regional:UpdateWebACL",
+		},
+		OperationUpdate: {
+			"waf-regional:DeleteLoggingConfiguration",
+			"waf-regional:GetLoggingConfiguration",
+			"waf-regional:GetWebACL",
+			"waf-regional:PutLoggingConfiguration",
+			"waf-regional:TagResource",
+			"waf-regional:UntagResource",
+			"waf-regional:UpdateWebACL",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteWebACL",
+			"waf-regional:UpdateWebACL",
+		},
+	},
+	"aws_wafregional_web_acl_association": {
+		OperationCreate: {
+			"waf-regional:AssociateWebACL",
+		},
+		OperationDelete: {
+			"waf-regional:DisassociateWebACL",
+		},
+	},
+	"aws_wafregional_xss_match_set": {
+		OperationCreate: {
+			"waf-regional:CreateXssMatchSet",
+			"waf-regional:GetXssMatchSet",
+			"waf-regional:UpdateXssMatchSet",
+		},
+		OperationUpdate: {
+			"waf-regional:GetXssMatchSet",
+			"waf-regional:UpdateXssMatchSet",
+		},
+		OperationDelete: {
+			"waf-regional:DeleteXssMatchSet",
+			"waf-regional:UpdateXssMatchSet",
+		},
+	},
+	"aws_wafv2_ip_set": {
+		OperationCreate: {
+			"wafv2:CreateIPSet",
+			"wafv2:GetIPSet",
+		},
+		OperationUpdate: {
+			"wafv2:GetIPSet",
+			"wafv2:TagResource",
+			"wafv2:UntagResource",
+			"wafv2:UpdateIPSet",
+		},
+		OperationDelete: {
+			"wafv2:DeleteIPSet",
+		},
+	},
+	"aws_wafv2_regex_pattern_set": {
+		OperationCreate: {
+			"wafv2:CreateRegexPatternSet",
+			"wafv2:GetRegexPatternSet",
+		},
+		OperationUpdate: {
+			"wafv2:GetRegexPatternSet",
+			"wafv2:TagResource",
+			"wafv2:UntagResource",
+			"wafv2:UpdateRegexPatternSet",
+		},
+		OperationDelete: {
+			"wafv2:DeleteRegexPatternSet",
+		},
+	},
+	"aws_wafv2_rule_group": {
+		OperationCreate: {
+			"wafv2:CreateRuleGroup",
+			"wafv2:GetRuleGroup",
+		},
+		OperationUpdate: {
+			"wafv2:GetRuleGroup",
+			"wafv2:TagResource",
+			"wafv2:UntagResource",
+			"wafv2:UpdateRuleGroup",
+		},
+		OperationDelete: {
+			"wafv2:DeleteRuleGroup",
+		},
+	},
+	"aws_wafv2_web_acl": {
+		OperationCreate: {
+			"wafv2:CreateWebACL",
+			"wafv2:GetWebACL",
+		},
+		OperationUpdate: {
+			"wafv2:GetWebACL",
+			"wafv2:TagResource",
+			"wafv2:UntagResource",
+			"wafv2:UpdateWebACL",
+		},
+		OperationDelete: {
+			"wafv2:DeleteWebACL",
+			"wafv2:GetWebACL",
+		},
+	},
+	"aws_wafv2_web_acl_association": {
+		OperationCreate: {
+			"wafv2:AssociateWebACL",
+			"wafv2:GetWebACLForResource",
+		},
+		OperationDelete: {
+			"wafv2:DisassociateWebACL",
+		},
+	},
+	"aws_wafv2_web_acl_logging_configuration": {
+		OperationCreate: {
+			"wafv2:GetLoggingConfiguration",
+			"wafv2:PutLoggingConfiguration",
+		},
+		OperationUpdate: {
+			"wafv2:GetLoggingConfiguration",
+			"wafv2:PutLoggingConfiguration",
+		},
+		OperationDelete: {
+			"wafv2:DeleteLoggingConfiguration",
+		},
+	},
+	"aws_worklink_fleet": {
+		OperationCreate: {
+			"worklink:CreateFleet",
+			"worklink:DescribeAuditStreamConfiguration",
+			"worklink:DescribeCompanyNetworkConfiguration",
+			"worklink:DescribeDevicePolicyConfiguration",
+			"worklink:DescribeFleetMetadata",
+			"worklink:DescribeIdentityProviderConfiguration",
+			"worklink:UpdateAuditStreamConfiguration",
+			"worklink:UpdateCompanyNetworkConfiguration",
+			"worklink:UpdateDevicePolicyConfiguration",
+			"worklink:UpdateIdentityProviderConfiguration",
+		},
+		OperationUpdate: {
+			"worklink:DescribeAuditStreamConfiguration",
+			"worklink:DescribeCompanyNetworkConfiguration",
+			"worklink:DescribeDevicePolicyConfiguration",
+			"worklink:DescribeFleetMetadata",
+			"worklink:DescribeIdentityProviderConfiguration",
+			"worklink:UpdateAuditStreamConfiguration",
+			"worklink:UpdateCompanyNetworkConfiguration",
+			"worklink:UpdateDevicePolicyConfiguration",
+			"worklink:UpdateFleetMetadata",
+			"worklink:UpdateIdentityProviderConfiguration",
+		},
+		OperationDelete: {
+			"worklink:DeleteFleet",
+			"worklink:DescribeFleetMetadata",
+		},
+	},
+	"aws_worklink_website_certificate_authority_association": {
+		OperationCreate: {
+			"worklink:AssociateWebsiteCertificateAuthority",
+			"worklink:DescribeWebsiteCertificateAuthority",
+		},
+		OperationDelete: {
+			"worklink:DescribeWebsiteCertificateAuthority",
+			"worklink:DisassociateWebsiteCertificateAuthority",
+		},
+	},
+	"aws_workspaces_connection_alias": {
+		OperationCreate: {
+			"workspaces:CreateConnectionAlias",
+			"workspaces:DescribeConnectionAliases",
+		},
+		OperationUpdate: {
+			"workspaces:CreateTags",
+			"workspaces:DeleteTags",
+		},
+		OperationDelete: {
+			"workspaces:DeleteConnectionAlias",
+			"workspaces:DescribeConnectionAliases",
+		},
+	},
+	"aws_workspaces_directory": {
+		OperationCreate: {
+			"workspaces:AssociateIpGroups",
+			"workspaces:DescribeWorkspaceDirectories",
+			"workspaces:ModifySelfservicePermissions",
+			"workspaces:ModifyWorkspaceAccessProperties",
+			"workspaces:ModifyWorkspaceCreationProperties",
+			"workspaces:RegisterWorkspaceDirectory",
+		},
+		OperationUpdate: {
+			"workspaces:AssociateIpGroups",
+			"workspaces:CreateTags",
+			"workspaces:DeleteTags",
+			"workspaces:DescribeWorkspaceDirectories",
+			"workspaces:DisassociateIpGroups",
+			"workspaces:ModifySelfservicePermissions",
+			"workspaces:ModifyWorkspaceAccessProperties",
+			"workspaces:ModifyWorkspaceCreationProperties",
+		},
+		OperationDelete: {
+			"workspaces:DeregisterWorkspaceDirectory",
+			"workspaces:DescribeWorkspaceDirectories",
+		},
+	},
+	"aws_workspaces_ip_group": {
+		OperationCreate: {
+			"workspaces:CreateIpGroup",
+			"workspaces:DescribeIpGroups",
+		},
+		OperationUpdate: {
+			"workspaces:CreateTags",
+			"workspaces:DeleteTags",
+			"workspaces:DescribeIpGroups",
+			"workspaces:UpdateRulesOfIpGroup",
+		},
+		OperationDelete: {
+			"workspaces:DeleteIpGroup",
+			"workspaces:DescribeWorkspaceDirectories",
+			"workspaces:DisassociateIpGroups",
+		},
+	},
+	"aws_workspaces_workspace": {
+		OperationCreate: {
+			"workspaces:CreateWorkspaces",
+			"workspaces:DescribeWorkspaces",
+		},
+		OperationUpdate: {
+			"workspaces:CreateTags",
+			"workspaces:DeleteTags",
+			"workspaces:DescribeWorkspaces",
+			"workspaces:ModifyWorkspaceProperties",
+		},
+		OperationDelete: {
+			"workspaces:DescribeWorkspaces",
+			"workspaces:TerminateWorkspaces",
+		},
+	},
+	"aws_workspacesweb_ip_access_settings": {
+		OperationCreate: {
+			"workspaces-web:CreateIpAccessSettings",
+			"workspaces-web:GetIpAccessSettings",
+		},
+		OperationUpdate: {
+			"workspaces-web:GetIpAccessSettings",
+			"workspaces-web:TagResource",
+			"workspaces-web:UntagResource",
+			"workspaces-web:UpdateIpAccessSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DeleteIpAccessSettings",
+		},
+	},
+	"aws_workspacesweb_ip_access_settings_association": {
+		OperationCreate: {
+			"workspaces-web:AssociateIpAccessSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DisassociateIpAccessSettings",
+		},
+	},
+	"aws_workspacesweb_network_settings": {
+		OperationCreate: {
+			"workspaces-web:CreateNetworkSettings",
+			"workspaces-web:GetNetworkSettings",
+		},
+		OperationUpdate: {
+			"workspaces-web:GetNetworkSettings",
+			"workspaces-web:TagResource",
+			"workspaces-web:UntagResource",
+			"workspaces-web:UpdateNetworkSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DeleteNetworkSettings",
+		},
+	},
+	"aws_workspacesweb_network_settings_association": {
+		OperationCreate: {
+			"workspaces-web:AssociateNetworkSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DisassociateNetworkSettings",
+		},
+	},
+	"aws_workspacesweb_portal": {
+		OperationCreate: {
+			"workspaces-web:CreatePortal",
+			"workspaces-web:GetPortal",
+		},
+		OperationUpdate: {
+			"workspaces-web:GetPortal",
+			"workspaces-web:TagResource",
+			"workspaces-web:UntagResource",
+			"workspaces-web:UpdatePortal",
+		},
+		OperationDelete: {
+			"workspaces-web:DeletePortal",
+		},
+	},
+	"aws_workspacesweb_trust_store": {
+		OperationCreate: {
+			"workspaces-web:CreateTrustStore",
+			"workspaces-web:GetTrustStore",
+			"workspaces-web:GetTrustStoreCertificate",
+			"workspaces-web:ListTrustStoreCertificates",
+		},
+		OperationUpdate: {
+			"workspaces-web:GetTrustStore",
+			"workspaces-web:GetTrustStoreCertificate",
+			"workspaces-web:ListTrustStoreCertificates",
+			"workspaces-web:TagResource",
+			"workspaces-web:UntagResource",
+			"workspaces-web:UpdateTrustStore",
+		},
+		OperationDelete: {
+			"workspaces-web:DeleteTrustStore",
+		},
+	},
+	"aws_workspacesweb_trust_store_association": {
+		OperationCreate: {
+			"workspaces-web:AssociateTrustStore",
+		},
+		OperationDelete: {
+			"workspaces-web:DisassociateTrustStore",
+		},
+	},
+	"aws_workspacesweb_user_settings": {
+		OperationCreate: {
+			"workspaces-web:CreateUserSettings",
+			"workspaces-web:GetUserSettings",
+		},
+		OperationUpdate: {
+			"workspaces-web:GetUserSettings",
+			"workspaces-web:TagResource",
+			"workspaces-web:UntagResource",
+			"workspaces-web:UpdateUserSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DeleteUserSettings",
+		},
+	},
+	"aws_workspacesweb_user_settings_association": {
+		OperationCreate: {
+			"workspaces-web:AssociateUserSettings",
+		},
+		OperationDelete: {
+			"workspaces-web:DisassociateUserSettings",
+		},
+	},
+	"aws_xray_encryption_config": {
+		OperationCreate: {
+			"xray:GetEncryptionConfig",
+			"xray:PutEncryptionConfig",
+		},
+		OperationUpdate: {
+			"xray:GetEncryptionConfig",
+			"xray:PutEncryptionConfig",
+		},
+	},
+	"aws_xray_group": {
+		OperationCreate: {
+			"xray:CreateGroup",
+			"xray:GetGroup",
+		},
+		OperationUpdate: {
+			"xray:GetGroup",
+			"xray:TagResource",
+			"xray:UntagResource",
+			"xray:UpdateGroup",
+		},
+		OperationDelete: {
+			"xray:DeleteGroup",
+		},
+	},
+	"aws_xray_sampling_rule": {
+		OperationCreate: {
+			"xray:CreateSamplingRule",
+			"xray:GetSamplingRules",
+		},
+		OperationUpdate: {
+			"xray:GetSamplingRules",
+			"xray:TagResource",
+			"xray:UntagResource",
+			"xray:UpdateSamplingRule",
+		},
+		OperationDelete: {
+			"xray:DeleteSamplingRule",
+		},
+	},
+}
@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iamsim
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestActions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName  string
+		operation Operation
+		expected  bool
+	}{
+		"known create": {
+			typeName:  "aws_s3_bucket",
+			operation: OperationCreate,
+			expected:  true,
+		},
+		"known delete": {
+			typeName:  "aws_iam_role",
+			operation: OperationDelete,
+			expected:  true,
+		},
+		"unknown type": {
+			typeName:  "aws_not_a_resource",
+			operation: OperationCreate,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := len(Actions(testCase.typeName, testCase.operation)) > 0, testCase.expected; got != want {
+				t.Errorf("Actions(%q, %q) found = %t, want %t", testCase.typeName, testCase.operation, got, want)
+			}
+		})
+	}
+}
+
+// knownIAMServicePrefixes are the IAM service prefixes (service namespaces) of services used by the provider.
+// See https://docs.aws.amazon.com/service-authorization/latest/reference/reference_policies_actions-resources-contextkeys.html.
+var knownIAMServicePrefixes = []string{
+	"access-analyzer",
+	"account",
+	"acm",
+	"acm-pca",
+	"airflow",
+	"amplify",
+	"aoss",
+	"apigateway",
+	"app-integrations",
+	"appconfig",
+	"appflow",
+	"application-autoscaling",
+	"applicationinsights",
+	"appmesh",
+	"apprunner",
+	"appstream",
+	"appsync",
+	"aps",
+	"athena",
+	"auditmanager",
+	"autoscaling",
+	"autoscaling-plans",
+	"aws-marketplace",
+	"backup",
+	"batch",
+	"bcm-data-exports",
+	"bedrock",
+	"budgets",
+	"cassandra",
+	"ce",
+	"chatbot",
+	"chime",
+	"cleanrooms",
+	"cloud9",
+	"cloudformation",
+	"cloudfront",
+	"cloudfront-keyvaluestore",
+	"cloudhsm",
+	"cloudsearch",
+	"cloudtrail",
+	"cloudwatch",
+	"codeartifact",
+	"codebuild",
+	"codecatalyst",
+	"codecommit",
+	"codedeploy",
+	"codeguru-profiler",
+	"codeguru-reviewer",
+	"codepipeline",
+	"codestar-connections",
+	"codestar-notifications",
+	"cognito-identity",
+	"cognito-idp",
+	"comprehend",
+	"config",
+	"connect",
+	"controltower",
+	"cur",
+	"dataexchange",
+	"datapipeline",
+	"datasync",
+	"datazone",
+	"dax",
+	"detective",
+	"devicefarm",
+	"devops-guru",
+	"directconnect",
+	"dlm",
+	"dms",
+	"docdb-elastic",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecr-public",
+	"ecs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elasticfilesystem",
+	"elasticloadbalancing",
+	"elasticmapreduce",
+	"elastictranscoder",
+	"emr-containers",
+	"emr-serverless",
+	"es",
+	"events",
+	"evidently",
+	"finspace",
+	"firehose",
+	"fis",
+	"fms",
+	"fsx",
+	"gamelift",
+	"geo",
+	"glacier",
+	"globalaccelerator",
+	"glue",
+	"grafana",
+	"greengrass",
+	"groundstation",
+	"guardduty",
+	"iam",
+	"identitystore",
+	"imagebuilder",
+	"inspector",
+	"inspector2",
+	"internetmonitor",
+	"iot",
+	"iotfleetwise",
+	"ivs",
+	"ivschat",
+	"kafka",
+	"kafkaconnect",
+	"kendra",
+	"kinesis",
+	"kinesisanalytics",
+	"kinesisvideo",
+	"kms",
+	"lakeformation",
+	"lambda",
+	"lex",
+	"license-manager",
+	"lightsail",
+	"logs",
+	"m2",
+	"macie2",
+	"mediaconvert",
+	"medialive",
+	"mediapackage",
+	"mediapackagev2",
+	"mediastore",
+	"memorydb",
+	"mobiletargeting",
+	"mq",
+	"network-firewall",
+	"networkmanager",
+	"oam",
+	"opsworks",
+	"organizations",
+	"osis",
+	"payment-cryptography",
+	"pipes",
+	"profile",
+	"qldb",
+	"quicksight",
+	"ram",
+	"rbin",
+	"rds",
+	"redshift",
+	"redshift-data",
+	"redshift-serverless",
+	"rekognition",
+	"resource-explorer-2",
+	"resource-groups",
+	"rolesanywhere",
+	"route53",
+	"route53-recovery-control-config",
+	"route53-recovery-readiness",
+	"route53domains",
+	"route53resolver",
+	"rum",
+	"s3",
+	"s3-outposts",
+	"sagemaker",
+	"scheduler",
+	"schemas",
+	"sdb",
+	"secretsmanager",
+	"securityhub",
+	"securitylake",
+	"serverlessrepo",
+	"servicecatalog",
+	"servicediscovery",
+	"servicequotas",
+	"ses",
+	"shield",
+	"signer",
+	"sns",
+	"sqs",
+	"ssm",
+	"ssm-contacts",
+	"ssm-incidents",
+	"sso",
+	"states",
+	"storagegateway",
+	"swf",
+	"synthetics",
+	"timestream",
+	"transcribe",
+	"transfer",
+	"verifiedpermissions",
+	"vpc-lattice",
+	"waf",
+	"waf-regional",
+	"wafv2",
+	"worklink",
+	"workspaces",
+	"workspaces-web",
+	"xray",
+}
+
+func TestActionsIAMServicePrefixes(t *testing.T) {
+	t.Parallel()
+
+	for typeName, operations := range actions {
+		for operation, actions := range operations {
+			for _, action := range actions {
+				prefix, name, ok := strings.Cut(action, ":")
+
+				if !ok || name == "" {
+					t.Errorf("%s %s: malformed IAM action %q", typeName, operation, action)
+					continue
+				}
+
+				if !slices.Contains(knownIAMServicePrefixes, prefix) {
+					t.Errorf("%s %s: IAM action %q has unknown service prefix %q", typeName, operation, action, prefix)
+				}
+
+				// API Gateway IAM actions are HTTP methods.
+				if prefix == "apigateway" && !slices.Contains([]string{"DELETE", "GET", "PATCH", "POST", "PUT"}, name) {
+					t.Errorf("%s %s: IAM action %q is not an API Gateway HTTP method", typeName, operation, action)
+				}
+			}
+		}
+	}
+}
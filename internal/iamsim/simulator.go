@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iamsim
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// Simulator evaluates the caller's identity-based policies against the IAM actions a plan will require.
+// Decisions are cached for the lifetime of the Simulator.
+type Simulator struct {
+	iamClient *iam.Client
+	stsClient *sts.Client
+
+	principalOnce sync.Once
+	principalARN  string
+	principalErr  error
+
+	lock      sync.Mutex
+	decisions map[string]bool     // Action name -> allowed.
+	reported  map[string]struct{} // Details of "not simulated" warnings already returned.
+}
+
+// New returns a new Simulator that uses the specified API clients.
+func New(iamClient *iam.Client, stsClient *sts.Client) *Simulator {
+	return &Simulator{
+		iamClient: iamClient,
+		stsClient: stsClient,
+		decisions: make(map[string]bool),
+		reported:  make(map[string]struct{}),
+	}
+}
+
+// Warning is the result of an IAM policy simulation that should be reported to the practitioner.
+type Warning struct {
+	Summary string
+	Detail  string
+}
+
+const (
+	summaryLikelyIssue  = "Likely IAM permissions issue"
+	summaryNotSimulated = "IAM permissions not simulated"
+)
+
+// Simulate returns warnings for the specified operation on the specified resource type.
+// A warning is returned if the caller is likely to be denied any of the required IAM actions.
+// A warning is also returned, once per Simulator, if the operation's IAM actions aren't known
+// or if the simulation can't be run, so that skipped checks are never silent.
+func (s *Simulator) Simulate(ctx context.Context, typeName string, operation Operation) []Warning {
+	if len(Actions(typeName, operation)) == 0 {
+		return s.notSimulated(fmt.Sprintf("The IAM actions required by the %s operation on %s are not known. "+
+			"The caller's IAM permissions were not checked for this resource type.", operation, typeName))
+	}
+
+	denied, err := s.DeniedActions(ctx, typeName, operation)
+
+	if err != nil {
+		return s.notSimulated(fmt.Sprintf("IAM policy simulation failed: %s", err))
+	}
+
+	if len(denied) > 0 {
+		return []Warning{{
+			Summary: summaryLikelyIssue,
+			Detail:  DeniedActionsSummary(typeName, operation, denied),
+		}}
+	}
+
+	return nil
+}
+
+// notSimulated returns a "not simulated" warning with the specified detail the first time it's called for that detail.
+func (s *Simulator) notSimulated(detail string) []Warning {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.reported[detail]; ok {
+		return nil
+	}
+	s.reported[detail] = struct{}{}
+
+	return []Warning{{
+		Summary: summaryNotSimulated,
+		Detail:  detail,
+	}}
+}
+
+// DeniedActions returns the IAM actions required by the specified operation on the specified resource type
+// that the caller's policies would not allow.
+// Resource-based policies, SCPs and condition keys are not taken into account.
+func (s *Simulator) DeniedActions(ctx context.Context, typeName string, operation Operation) ([]string, error) {
+	actions := Actions(typeName, operation)
+
+	if len(actions) == 0 {
+		return nil, nil
+	}
+
+	principalARN, err := s.principal(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var unknown []string
+	for _, action := range actions {
+		if _, ok := s.decisions[action]; !ok {
+			unknown = append(unknown, action)
+		}
+	}
+
+	if len(unknown) > 0 {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     unknown,
+			PolicySourceArn: aws.String(principalARN),
+		}
+
+		pages := iam.NewSimulatePrincipalPolicyPaginator(s.iamClient, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("simulating IAM principal policy (%s): %w", principalARN, err)
+			}
+
+			for _, v := range page.EvaluationResults {
+				s.decisions[aws.ToString(v.EvalActionName)] = v.EvalDecision == awstypes.PolicyEvaluationDecisionTypeAllowed
+			}
+		}
+	}
+
+	var denied []string
+	for _, action := range actions {
+		if allowed, ok := s.decisions[action]; ok && !allowed {
+			denied = append(denied, action)
+		}
+	}
+
+	return denied, nil
+}
+
+func (s *Simulator) principal(ctx context.Context) (string, error) {
+	s.principalOnce.Do(func() {
+		output, err := s.stsClient.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+
+		if err != nil {
+			s.principalErr = fmt.Errorf("reading STS caller identity: %w", err)
+			return
+		}
+
+		callerARN := aws.ToString(output.Arn)
+		principalARN, roleName, err := policySource(callerARN)
+
+		if err != nil {
+			s.principalErr = err
+			return
+		}
+
+		if roleName != "" {
+			// The session ARN doesn't include the role's path, so look up the role's ARN.
+			output, err := s.iamClient.GetRole(ctx, &iam.GetRoleInput{
+				RoleName: aws.String(roleName),
+			})
+
+			if err != nil {
+				s.principalErr = fmt.Errorf("reading IAM Role (%s) for assumed-role session %s (the iam:GetRole permission is required): %w", roleName, callerARN, err)
+				return
+			}
+
+			principalARN = aws.ToString(output.Role.Arn)
+		}
+
+		s.principalARN = principalARN
+	})
+
+	return s.principalARN, s.principalErr
+}
+
+// policySource returns the IAM principal whose policies apply to the specified caller ARN.
+// IAM users and roles are returned as ARNs. An STS assumed-role session is returned as the name of its IAM role;
+// the session ARN doesn't include the role's path so the role's ARN must be looked up.
+func policySource(callerARN string) (string, string, error) {
+	v, err := arn.Parse(callerARN)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	switch v.Service {
+	case "iam":
+		if strings.HasPrefix(v.Resource, "user/") || strings.HasPrefix(v.Resource, "role/") {
+			return callerARN, "", nil
+		}
+	case "sts":
+		if parts := strings.Split(v.Resource, "/"); len(parts) == 3 && parts[0] == "assumed-role" {
+			return "", parts[1], nil
+		}
+	}
+
+	return "", "", fmt.Errorf("IAM policy simulation is not supported for principal %s", callerARN)
+}
+
+// DeniedActionsSummary returns a human-readable description of the specified denied actions.
+func DeniedActionsSummary(typeName string, operation Operation, denied []string) string {
+	denied = slices.Clone(denied)
+	slices.Sort(denied)
+
+	return fmt.Sprintf("IAM policy simulation indicates that the %s operation on %s may fail with AccessDenied. "+
+		"The following actions are not allowed by the caller's identity-based policies: %s. "+
+		"Resource-based policies, service control policies and condition keys were not evaluated.",
+		operation, typeName, strings.Join(denied, ", "))
+}
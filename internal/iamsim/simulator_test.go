@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iamsim
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPolicySource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		callerARN        string
+		expectedARN      string
+		expectedRoleName string
+		expectError      bool
+	}{
+		"IAM user": {
+			callerARN:   "arn:aws:iam::123456789012:user/test",
+			expectedARN: "arn:aws:iam::123456789012:user/test",
+		},
+		"IAM role with path": {
+			callerARN:   "arn:aws:iam::123456789012:role/path/test",
+			expectedARN: "arn:aws:iam::123456789012:role/path/test",
+		},
+		"assumed role": {
+			callerARN:        "arn:aws:sts::123456789012:assumed-role/test/session",
+			expectedRoleName: "test",
+		},
+		"assumed role SSO": {
+			callerARN:        "arn:aws:sts::123456789012:assumed-role/AWSReservedSSO_Admin_0123456789abcdef/user@example.com",
+			expectedRoleName: "AWSReservedSSO_Admin_0123456789abcdef",
+		},
+		"root": {
+			callerARN:   "arn:aws:iam::123456789012:root",
+			expectError: true,
+		},
+		"federated user": {
+			callerARN:   "arn:aws:sts::123456789012:federated-user/test",
+			expectError: true,
+		},
+		"invalid ARN": {
+			callerARN:   "test",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotARN, gotRoleName, err := policySource(testCase.callerARN)
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.expectError {
+				t.Fatalf("expected error")
+			}
+
+			if gotARN != testCase.expectedARN {
+				t.Errorf("policySource(%q) ARN = %q, want %q", testCase.callerARN, gotARN, testCase.expectedARN)
+			}
+
+			if gotRoleName != testCase.expectedRoleName {
+				t.Errorf("policySource(%q) role name = %q, want %q", testCase.callerARN, gotRoleName, testCase.expectedRoleName)
+			}
+		})
+	}
+}
+
+func TestSimulateUnknownType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	simulator := New(nil, nil)
+
+	got := simulator.Simulate(ctx, "aws_not_a_resource", OperationCreate)
+
+	if len(got) != 1 || got[0].Summary != summaryNotSimulated {
+		t.Fatalf("Simulate() = %v, want one %q warning", got, summaryNotSimulated)
+	}
+
+	if got := simulator.Simulate(ctx, "aws_not_a_resource", OperationCreate); len(got) != 0 {
+		t.Errorf("Simulate() = %v, want no repeated warning", got)
+	}
+
+	if got := simulator.Simulate(ctx, "aws_not_a_resource", OperationUpdate); len(got) != 1 {
+		t.Errorf("Simulate() = %v, want one warning for a different operation", got)
+	}
+}
@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/iamsim"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
@@ -34,5 +37,86 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	return func() tfprotov5.ProviderServer {
+		return iamSimulationProviderServer{ProviderServer: muxServer.ProviderServer(), meta: primary.Meta}
+	}, primary, nil
+}
+
+// iamSimulationProviderServer simulates, at plan time, the IAM permissions required by planned changes to
+// Plugin SDK and Plugin Framework resources and returns the results as plan warnings.
+type iamSimulationProviderServer struct {
+	tfprotov5.ProviderServer
+	meta func() any
+}
+
+func (s iamSimulationProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) {
+		return response, err
+	}
+
+	for _, v := range s.simulateIAMPermissions(ctx, request, response) {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  v.Summary,
+			Detail:   v.Detail,
+		})
+	}
+
+	return response, err
+}
+
+// simulateIAMPermissions returns the IAM policy simulation results for a planned change.
+// A planned replacement requires the IAM actions of both the delete and the create operations.
+func (s iamSimulationProviderServer) simulateIAMPermissions(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest, response *tfprotov5.PlanResourceChangeResponse) []iamsim.Warning {
+	c, ok := s.meta().(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	simulator := c.IAMPolicySimulator(ctx)
+
+	if simulator == nil {
+		return nil
+	}
+
+	var operations []iamsim.Operation
+	switch {
+	case isNullDynamicValue(request.PriorState):
+		operations = []iamsim.Operation{iamsim.OperationCreate}
+	case isNullDynamicValue(response.PlannedState):
+		operations = []iamsim.Operation{iamsim.OperationDelete}
+	case len(response.RequiresReplace) > 0:
+		operations = []iamsim.Operation{iamsim.OperationDelete, iamsim.OperationCreate}
+	case !bytes.Equal(request.PriorState.MsgPack, response.PlannedState.MsgPack):
+		operations = []iamsim.Operation{iamsim.OperationUpdate}
+	}
+
+	var warnings []iamsim.Warning
+	for _, operation := range operations {
+		warnings = append(warnings, simulator.Simulate(ctx, request.TypeName, operation)...)
+	}
+
+	return warnings
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, v := range diags {
+		if v != nil && v.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	null, err := v.IsNull()
+
+	return err == nil && null
 }
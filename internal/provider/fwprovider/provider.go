@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -26,6 +28,7 @@ import (
 
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
+var _ provider.ProviderWithEphemeralResources = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -128,6 +131,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "List of paths to shared credentials files. If not set, defaults to [~/.aws/credentials].",
 			},
+			"simulate_iam_permissions": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Simulate the IAM permissions required by planned resource changes and report actions that are likely to be denied.",
+			},
 			"skip_credentials_validation": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Skip the credentials validation via STS API. Used for AWS API implementations that do not have STS available/implemented.",
@@ -160,6 +167,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Resolve an endpoint with DualStack capability",
 			},
+			"use_dualstack_endpoint_overrides": schema.MapAttribute{
+				ElementType: types.BoolType,
+				Optional:    true,
+				Description: "Per-service overrides of use_dualstack_endpoint, keyed by the service's endpoints block attribute name",
+			},
 			"use_fips_endpoint": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
@@ -267,7 +279,8 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
-			"endpoints": endpointsBlock(),
+			"default_timeouts": defaultTimeoutsBlock(),
+			"endpoints":        endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -299,6 +312,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	// Provider's parsed configuration (its instance state) is available through the primary provider's Meta() method.
 	v := p.Primary.Meta()
 	response.DataSourceData = v
+	response.EphemeralResourceData = v
 	response.ResourceData = v
 }
 
@@ -443,7 +457,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, typeName, inner, interceptors)
 			})
 		}
 	}
@@ -457,6 +471,57 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 	return resources
 }
 
+// EphemeralResources returns a slice of functions to instantiate each EphemeralResource
+// implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource implementing
+// the Metadata method. All ephemeral resources must have unique names.
+func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var errs []error
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	for _, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+
+		v, ok := sp.(conns.ServicePackageWithEphemeralResources)
+		if !ok {
+			continue
+		}
+
+		for _, v := range v.EphemeralResources(ctx) {
+			v := v
+			inner, err := v.Factory(ctx)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("creating ephemeral resource: %w", err))
+				continue
+			}
+
+			// bootstrapContext is run on all wrapped methods.
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				ctx = conns.NewEphemeralResourceContext(ctx, servicePackageName, v.Name)
+				if meta != nil {
+					ctx = meta.RegisterLogger(ctx)
+				}
+
+				return ctx
+			}
+
+			ephemeralResources = append(ephemeralResources, func() ephemeral.EphemeralResource {
+				return newWrappedEphemeralResource(bootstrapContext, inner)
+			})
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering ephemeral resources", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return ephemeralResources
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
@@ -486,3 +551,47 @@ func endpointsBlock() schema.SetNestedBlock {
 		},
 	}
 }
+
+func defaultTimeoutsBlock() schema.ListNestedBlock {
+	timeoutAttribute := func(operation string) schema.Attribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Default %s timeout. Valid time units are ns, us (or µs), ms, s, h, or m.", operation),
+			Validators: []validator.String{
+				fwvalidators.Duration(),
+			},
+		}
+	}
+
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		Description: "Configuration block with settings to default resource timeouts across all resources.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"create": timeoutAttribute("Create"),
+				"delete": timeoutAttribute("Delete"),
+				"read":   timeoutAttribute("Read"),
+				"update": timeoutAttribute("Update"),
+			},
+			Blocks: map[string]schema.Block{
+				"override": schema.ListNestedBlock{
+					Description: "Per-resource type default timeouts. These replace the resource's own default timeouts.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"create": timeoutAttribute("Create"),
+							"delete": timeoutAttribute("Delete"),
+							"read":   timeoutAttribute("Read"),
+							"resource_type": schema.StringAttribute{
+								Required:    true,
+								Description: "Resource type name, e.g. aws_db_instance.",
+							},
+							"update": timeoutAttribute("Update"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -74,6 +74,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_timeouts": defaultTimeoutsSchema(),
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -197,6 +198,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "List of paths to shared credentials files. If not set, defaults to [~/.aws/credentials].",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"simulate_iam_permissions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Simulate the IAM permissions required by planned resource changes " +
+					"and report actions that are likely to be denied.",
+			},
 			"skip_credentials_validation": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -244,6 +251,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "Resolve an endpoint with DualStack capability",
 			},
+			"use_dualstack_endpoint_overrides": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Per-service overrides of use_dualstack_endpoint, keyed by the service's endpoints block attribute name",
+			},
 			"use_fips_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -258,8 +271,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 		ResourcesMap:   make(map[string]*schema.Resource),
 	}
 
+	// Each resource's own default timeouts, captured before any provider-level defaults are applied.
+	resourceTimeouts := make(map[string]*schema.ResourceTimeout)
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		meta, diags := configure(ctx, provider, d)
+
+		if !diags.HasError() {
+			applyDefaultTimeouts(provider, resourceTimeouts, meta.DefaultTimeoutsConfig)
+		}
+
+		return meta, diags
 	}
 
 	var errs []error
@@ -441,6 +463,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				}
 			}
 
+			if v := r.Timeouts; v != nil {
+				resourceTimeouts[typeName] = copyResourceTimeout(v)
+			}
+
 			provider.ResourcesMap[typeName] = r
 		}
 	}
@@ -487,6 +513,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		SimulateIAMPermissions:         d.Get("simulate_iam_permissions").(bool),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
@@ -536,6 +563,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("default_timeouts"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		defaultTimeouts, dx := expandDefaultTimeouts(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.DefaultTimeoutsConfig = defaultTimeouts
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -544,6 +580,15 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	if v, ok := d.GetOk("use_dualstack_endpoint_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		overrides, dx := expandDualStackEndpointOverrides(ctx, v.(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.DualStackEndpointOverrides = overrides
+	}
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -603,6 +648,50 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	return meta, diags
 }
 
+func defaultTimeoutsSchema() *schema.Schema {
+	timeoutSchema := func(operation string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  fmt.Sprintf("Default %s timeout. Valid time units are ns, us (or µs), ms, s, h, or m.", operation),
+			ValidateFunc: verify.ValidDuration,
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to default resource timeouts across all resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"create": timeoutSchema("Create"),
+				"delete": timeoutSchema("Delete"),
+				"override": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Per-resource type default timeouts. These replace the resource's own default timeouts.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"create": timeoutSchema("Create"),
+							"delete": timeoutSchema("Delete"),
+							"read":   timeoutSchema("Read"),
+							"resource_type": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Resource type name, e.g. aws_db_instance.",
+							},
+							"update": timeoutSchema("Update"),
+						},
+					},
+				},
+				"read":   timeoutSchema("Read"),
+				"update": timeoutSchema("Update"),
+			},
+		},
+	}
+}
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -852,6 +941,134 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDefaultTimeouts(_ context.Context, tfMap map[string]interface{}) (*conns.DefaultTimeoutsConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if tfMap == nil {
+		return nil, diags
+	}
+
+	defaultTimeoutsPath := cty.GetAttrPath("default_timeouts").IndexInt(0)
+	defaultTimeouts := &conns.DefaultTimeoutsConfig{
+		Overrides: make(map[string]conns.ResourceTimeouts),
+	}
+
+	v, dx := expandResourceTimeouts(tfMap, defaultTimeoutsPath)
+	diags = append(diags, dx...)
+	defaultTimeouts.Default = v
+
+	if v, ok := tfMap["override"].([]interface{}); ok {
+		for i, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			overridePath := defaultTimeoutsPath.GetAttr("override").IndexInt(i)
+			resourceType := tfMap["resource_type"].(string)
+
+			if _, ok := defaultTimeouts.Overrides[resourceType]; ok {
+				diags = append(diags, errs.NewInvalidValueAttributeErrorf(overridePath.GetAttr("resource_type"), "duplicate resource type %q", resourceType))
+				continue
+			}
+
+			v, dx := expandResourceTimeouts(tfMap, overridePath)
+			diags = append(diags, dx...)
+			defaultTimeouts.Overrides[resourceType] = v
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return defaultTimeouts, diags
+}
+
+func expandResourceTimeouts(tfMap map[string]interface{}, path cty.Path) (conns.ResourceTimeouts, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var timeouts conns.ResourceTimeouts
+
+	for _, v := range []struct {
+		operation string
+		timeout   *time.Duration
+	}{
+		{conns.TimeoutCreate, &timeouts.Create},
+		{conns.TimeoutRead, &timeouts.Read},
+		{conns.TimeoutUpdate, &timeouts.Update},
+		{conns.TimeoutDelete, &timeouts.Delete},
+	} {
+		s, ok := tfMap[v.operation].(string)
+
+		if !ok || s == "" {
+			continue
+		}
+
+		timeout, err := time.ParseDuration(s)
+
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.GetAttr(v.operation), "parsing duration %q: %s", s, err))
+			continue
+		}
+
+		*v.timeout = timeout
+	}
+
+	return timeouts, diags
+}
+
+// applyDefaultTimeouts applies any provider-level default timeouts to the resources' default timeouts.
+// Resources' own default timeouts are always restored first so that this function is idempotent.
+func applyDefaultTimeouts(provider *schema.Provider, resourceTimeouts map[string]*schema.ResourceTimeout, config *conns.DefaultTimeoutsConfig) {
+	for typeName, original := range resourceTimeouts {
+		r, ok := provider.ResourcesMap[typeName]
+
+		if !ok {
+			continue
+		}
+
+		timeouts := copyResourceTimeout(original)
+
+		for _, v := range []struct {
+			operation string
+			timeout   **time.Duration
+		}{
+			{conns.TimeoutCreate, &timeouts.Create},
+			{conns.TimeoutRead, &timeouts.Read},
+			{conns.TimeoutUpdate, &timeouts.Update},
+			{conns.TimeoutDelete, &timeouts.Delete},
+		} {
+			// Only operations for which the resource supports timeouts.
+			if *v.timeout == nil {
+				continue
+			}
+
+			*v.timeout = aws.Duration(config.Timeout(typeName, v.operation, **v.timeout))
+		}
+
+		r.Timeouts = timeouts
+	}
+}
+
+func copyResourceTimeout(v *schema.ResourceTimeout) *schema.ResourceTimeout {
+	copyDuration := func(v *time.Duration) *time.Duration {
+		if v == nil {
+			return nil
+		}
+
+		return aws.Duration(*v)
+	}
+
+	return &schema.ResourceTimeout{
+		Create:  copyDuration(v.Create),
+		Read:    copyDuration(v.Read),
+		Update:  copyDuration(v.Update),
+		Delete:  copyDuration(v.Delete),
+		Default: copyDuration(v.Default),
+	}
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1087,30 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandDualStackEndpointOverrides(_ context.Context, tfMap map[string]interface{}) (map[string]bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	overridesPath := cty.GetAttrPath("use_dualstack_endpoint_overrides")
+	overrides := make(map[string]bool)
+
+	for k, v := range tfMap {
+		pkg, err := names.ProviderPackageForAlias(k)
+
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(overridesPath.IndexString(k), "unsupported service %q", k))
+			continue
+		}
+
+		overrides[pkg] = v.(bool)
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return overrides, diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
@@ -8,10 +8,13 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -123,6 +126,134 @@ func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDualStackEndpointOverrides(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		overrides     map[string]interface{}
+		expected      map[string]bool
+		expectedDiags diag.Diagnostics
+	}{
+		"service package": {
+			overrides: map[string]interface{}{
+				"ec2": true,
+				"sts": false,
+			},
+			expected: map[string]bool{
+				names.EC2: true,
+				names.STS: false,
+			},
+		},
+		"alias": {
+			overrides: map[string]interface{}{
+				"transcribeservice": true,
+			},
+			expected: map[string]bool{
+				names.Transcribe: true,
+			},
+		},
+		"unsupported service": {
+			overrides: map[string]interface{}{
+				"notaservice": true,
+			},
+			expectedDiags: diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(
+				cty.GetAttrPath("use_dualstack_endpoint_overrides").IndexString("notaservice"),
+				"unsupported service %q",
+				"notaservice",
+			)},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandDualStackEndpointOverrides(ctx, testcase.overrides)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandDefaultTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap         map[string]interface{}
+		expected      *conns.DefaultTimeoutsConfig
+		expectedDiags diag.Diagnostics
+	}{
+		"defaults and overrides": {
+			tfMap: map[string]interface{}{
+				"create": "30m",
+				"update": "1h",
+				"override": []interface{}{
+					map[string]interface{}{
+						"resource_type": "aws_db_instance",
+						"delete":        "2h",
+					},
+				},
+			},
+			expected: &conns.DefaultTimeoutsConfig{
+				Default: conns.ResourceTimeouts{
+					Create: 30 * time.Minute,
+					Update: time.Hour,
+				},
+				Overrides: map[string]conns.ResourceTimeouts{
+					"aws_db_instance": {
+						Delete: 2 * time.Hour,
+					},
+				},
+			},
+		},
+		"duplicate override": {
+			tfMap: map[string]interface{}{
+				"override": []interface{}{
+					map[string]interface{}{
+						"resource_type": "aws_db_instance",
+						"create":        "1h",
+					},
+					map[string]interface{}{
+						"resource_type": "aws_db_instance",
+						"delete":        "1h",
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{errs.NewInvalidValueAttributeErrorf(
+				cty.GetAttrPath("default_timeouts").IndexInt(0).GetAttr("override").IndexInt(1).GetAttr("resource_type"),
+				"duplicate resource type %q",
+				"aws_db_instance",
+			)},
+		},
+	}
+
+	for name, testcase := range testcases {
+		testcase := testcase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results, diags := expandDefaultTimeouts(ctx, testcase.tfMap)
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(results, testcase.expected); diff != "" {
+				t.Errorf("unexpected results difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -20,6 +20,7 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 		"Analyzer": {
 			acctest.CtBasic:      testAccAnalyzer_basic,
 			"configuration":      testAccAnalyzer_configuration,
+			"analysisRule":       testAccAnalyzer_configurationAnalysisRule,
 			acctest.CtDisappears: testAccAnalyzer_disappears,
 			"tags":               testAccAccessAnalyzerAnalyzer_tagsSerial,
 			"Type_Organization":  testAccAnalyzer_Type_Organization,
@@ -28,6 +29,11 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			acctest.CtBasic:      testAccAnalyzerArchiveRule_basic,
 			acctest.CtDisappears: testAccAnalyzerArchiveRule_disappears,
 			"update_filters":     testAccAnalyzerArchiveRule_updateFilters,
+			"filter_validation":  testAccAnalyzerArchiveRule_filterValidation,
+		},
+		"FindingsDataSource": {
+			acctest.CtBasic: testAccFindingsDataSource_basic,
+			"filter":        testAccFindingsDataSource_filter,
 		},
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -77,10 +78,44 @@ func resourceAnalyzer() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"unused_access_age": {
-										Type:     schema.TypeInt,
+									"analysis_rule": {
+										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"exclusion": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"account_ids": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type:         schema.TypeString,
+																	ValidateFunc: verify.ValidAccountID,
+																},
+															},
+															"resource_tags": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeMap,
+																	Elem: &schema.Schema{Type: schema.TypeString},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"unused_access_age": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
 									},
 								},
 							},
@@ -170,8 +205,23 @@ func resourceAnalyzerRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 func resourceAnalyzerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	if d.HasChange(names.AttrConfiguration) {
+		input := &accessanalyzer.UpdateAnalyzerInput{
+			AnalyzerName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk(names.AttrConfiguration); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.Configuration = expandAnalyzerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		_, err := conn.UpdateAnalyzer(ctx, input)
 
-	// Tags only.
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM Access Analyzer Analyzer (%s): %s", d.Id(), err)
+		}
+	}
 
 	return append(diags, resourceAnalyzerRead(ctx, d, meta)...)
 }
@@ -239,6 +289,10 @@ func expandAnalyzerConfiguration(tfMap map[string]interface{}) types.AnalyzerCon
 func expandUnusedAccess(tfMap map[string]interface{}) types.UnusedAccessConfiguration {
 	apiObject := types.UnusedAccessConfiguration{}
 
+	if v, ok := tfMap["analysis_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AnalysisRule = expandAnalysisRule(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap["unused_access_age"].(int); ok && v != 0 {
 		apiObject.UnusedAccessAge = aws.Int32(int32(v))
 	}
@@ -246,6 +300,45 @@ func expandUnusedAccess(tfMap map[string]interface{}) types.UnusedAccessConfigur
 	return apiObject
 }
 
+func expandAnalysisRule(tfMap map[string]interface{}) *types.AnalysisRule {
+	apiObject := &types.AnalysisRule{}
+
+	if v, ok := tfMap["exclusion"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Exclusions = expandAnalysisRuleCriterias(v)
+	}
+
+	return apiObject
+}
+
+func expandAnalysisRuleCriterias(tfList []interface{}) []types.AnalysisRuleCriteria {
+	var apiObjects []types.AnalysisRuleCriteria
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AnalysisRuleCriteria{}
+
+		if v, ok := tfMap["account_ids"].([]interface{}); ok && len(v) > 0 {
+			apiObject.AccountIds = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["resource_tags"].([]interface{}); ok && len(v) > 0 {
+			for _, v := range v {
+				if v, ok := v.(map[string]interface{}); ok {
+					apiObject.ResourceTags = append(apiObject.ResourceTags, flex.ExpandStringValueMap(v))
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
 func flattenConfiguration(apiObject types.AnalyzerConfiguration) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -268,9 +361,47 @@ func flattenUnusedAccessConfiguration(apiObject *types.UnusedAccessConfiguration
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.AnalysisRule; v != nil {
+		tfMap["analysis_rule"] = []interface{}{flattenAnalysisRule(v)}
+	}
+
 	if v := apiObject.UnusedAccessAge; v != nil {
 		tfMap["unused_access_age"] = aws.ToInt32(v)
 	}
 
 	return tfMap
 }
+
+func flattenAnalysisRule(apiObject *types.AnalysisRule) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Exclusions; len(v) > 0 {
+		tfMap["exclusion"] = flattenAnalysisRuleCriterias(v)
+	}
+
+	return tfMap
+}
+
+func flattenAnalysisRuleCriterias(apiObjects []types.AnalysisRuleCriteria) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"account_ids": apiObject.AccountIds,
+		}
+
+		var resourceTags []interface{}
+		for _, v := range apiObject.ResourceTags {
+			resourceTags = append(resourceTags, flex.FlattenStringValueMap(v))
+		}
+		tfMap["resource_tags"] = resourceTags
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
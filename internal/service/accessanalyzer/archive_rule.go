@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
@@ -17,6 +18,7 @@ import (
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -50,19 +52,22 @@ func resourceArchiveRule() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"criteria": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validArchiveRuleFilterCriteria,
 						},
 						"contains": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 20,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"eq": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 20,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"exists": {
@@ -75,6 +80,7 @@ func resourceArchiveRule() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
+							MaxItems: 20,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
@@ -86,7 +92,47 @@ func resourceArchiveRule() *schema.Resource {
 				Required: true,
 			},
 		},
+
+		CustomizeDiff: customizeDiffArchiveRuleFilter,
+	}
+}
+
+// archiveRuleFilterCriteria are the filter keys supported by archive rules for external and unused access findings.
+// Condition keys are matched separately as "condition.<key>".
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/access-analyzer-reference-filter-keys.html.
+var archiveRuleFilterCriteria = []string{
+	"action",
+	"error",
+	"findingType",
+	"isPublic",
+	"principal.AWS",
+	"principal.CanonicalUser",
+	"principal.Federated",
+	"principal.Service",
+	"resource",
+	"resourceOwnerAccount",
+	"resourceType",
+	"sharedVia",
+}
+
+var validArchiveRuleFilterCriteria = validation.Any(
+	validation.StringInSlice(archiveRuleFilterCriteria, false),
+	validation.StringMatch(regexache.MustCompile(`^condition\..+$`), "must be a condition key in the form condition.<key>"),
+)
+
+func customizeDiffArchiveRuleFilter(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, v := range d.Get(names.AttrFilter).(*schema.Set).List() {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if len(tfMap["contains"].([]interface{})) == 0 && len(tfMap["eq"].([]interface{})) == 0 && len(tfMap["neq"].([]interface{})) == 0 && tfMap["exists"].(string) == "" {
+			return fmt.Errorf("filter %q: one of contains, eq, exists or neq must be specified", tfMap["criteria"].(string))
+		}
 	}
+
+	return nil
 }
 
 func resourceArchiveRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -150,6 +151,51 @@ func testAccAnalyzerArchiveRule_disappears(t *testing.T) {
 	})
 }
 
+func testAccAnalyzerArchiveRule_filterValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.AccessAnalyzerEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckArchiveRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveRuleConfig_updateFilters(rName, `
+filter {
+  criteria = "notAFilterKey"
+  eq       = ["value"]
+}
+`),
+				ExpectError: regexache.MustCompile(`must be a condition key`),
+			},
+			{
+				Config: testAccArchiveRuleConfig_updateFilters(rName, `
+filter {
+  criteria = "resourceType"
+}
+`),
+				ExpectError: regexache.MustCompile(`one of contains, eq, exists or neq must be specified`),
+			},
+			{
+				Config: testAccArchiveRuleConfig_updateFilters(rName, `
+filter {
+  criteria = "condition.aws:SourceVpc"
+  exists   = "true"
+}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_accessanalyzer_archive_rule.test", "filter.0.criteria", "condition.aws:SourceVpc"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckArchiveRuleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AccessAnalyzerClient(ctx)
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_findings", name="Findings")
+func dataSourceFindings() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criteria": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"contains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"eq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"exists": {
+							Type:         nullable.TypeNullableBool,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableBool,
+						},
+						"neq": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"condition": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_public": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"principal": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrResourceType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ResourceType](),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.FindingStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceFindingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := &accessanalyzer.ListFindingsInput{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter:      make(map[string]types.Criterion),
+	}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		for k, v := range expandFilter(v.(*schema.Set)) {
+			input.Filter[k] = v
+		}
+	}
+
+	// The resource_type and status arguments are shorthands for equality filters.
+	if v, ok := d.GetOk(names.AttrResourceType); ok {
+		input.Filter["resourceType"] = types.Criterion{Eq: []string{v.(string)}}
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Filter["status"] = types.Criterion{Eq: []string{v.(string)}}
+	}
+
+	findings, err := findFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Findings (%s): %s", analyzerARN, err)
+	}
+
+	var ids []string
+	for _, v := range findings {
+		ids = append(ids, aws.ToString(v.Id))
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenFindingSummaries(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	d.Set(names.AttrIDs, ids)
+
+	return diags
+}
+
+func findFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ListFindingsInput) ([]types.FindingSummary, error) {
+	var output []types.FindingSummary
+
+	pages := accessanalyzer.NewListFindingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenFindingSummaries(apiObjects []types.FindingSummary) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAction:         apiObject.Action,
+			"condition":              apiObject.Condition,
+			"error":                  aws.ToString(apiObject.Error),
+			names.AttrID:             aws.ToString(apiObject.Id),
+			"is_public":              aws.ToBool(apiObject.IsPublic),
+			"principal":              apiObject.Principal,
+			"resource":               aws.ToString(apiObject.Resource),
+			"resource_owner_account": aws.ToString(apiObject.ResourceOwnerAccount),
+			names.AttrResourceType:   string(apiObject.ResourceType),
+			names.AttrStatus:         string(apiObject.Status),
+		}
+
+		if v := apiObject.AnalyzedAt; v != nil {
+			tfMap["analyzed_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.CreatedAt; v != nil {
+			tfMap[names.AttrCreatedAt] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.UpdatedAt; v != nil {
+			tfMap["updated_at"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -38,9 +38,13 @@ func TestAccAmplify_serial(t *testing.T) {
 			acctest.CtBasic:        testAccBranch_basic,
 			acctest.CtDisappears:   testAccBranch_disappears,
 			"tags":                 testAccBranch_tags,
+			"Backend":              testAccBranch_Backend,
 			"BasicAuthCredentials": testAccBranch_BasicAuthCredentials,
+			"BuildSpec":            testAccBranch_BuildSpec,
+			"ComputeRole":          testAccBranch_ComputeRole,
 			"EnvironmentVariables": testAccBranch_EnvironmentVariables,
 			"OptionalArguments":    testAccBranch_OptionalArguments,
+			"SkewProtection":       testAccBranch_SkewProtection,
 		},
 		"DomainAssociation": {
 			acctest.CtBasic:      testAccDomainAssociation_basic,
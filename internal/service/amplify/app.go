@@ -293,6 +293,26 @@ func resourceApp() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"waf_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"waf_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"web_acl_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -435,6 +455,13 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta interface
 		d.Set("production_branch", nil)
 	}
 	d.Set("repository", app.Repository)
+	if app.WafConfiguration != nil {
+		if err := d.Set("waf_configuration", []interface{}{flattenWAFConfiguration(app.WafConfiguration)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting waf_configuration: %s", err)
+		}
+	} else {
+		d.Set("waf_configuration", nil)
+	}
 
 	setTagsOut(ctx, app.Tags)
 
@@ -802,3 +829,23 @@ func flattenProductionBranch(apiObject *types.ProductionBranch) map[string]inter
 
 	return tfMap
 }
+
+func flattenWAFConfiguration(apiObject *types.WafConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"waf_status": string(apiObject.WafStatus),
+	}
+
+	if v := apiObject.StatusReason; v != nil {
+		tfMap[names.AttrStatusReason] = aws.ToString(v)
+	}
+
+	if v := apiObject.WebAclArn; v != nil {
+		tfMap["web_acl_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
@@ -58,10 +58,26 @@ func resourceBranch() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"backend": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"backend_environment_arn"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stack_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
 			"backend_environment_arn": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: verify.ValidARN,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"backend"},
 			},
 			"basic_auth_credentials": {
 				Type:         schema.TypeString,
@@ -83,6 +99,17 @@ func resourceBranch() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z/_.-]{1,255}$`), "should be not be more than 255 letters, numbers, and the symbols /_.-"),
 			},
+			"build_spec": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 25000),
+			},
+			"compute_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"custom_domains": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -124,6 +151,10 @@ func resourceBranch() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"enable_skew_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"environment_variables": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -188,6 +219,10 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Tags:            getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("backend"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Backend = expandBackend(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("backend_environment_arn"); ok {
 		input.BackendEnvironmentArn = aws.String(v.(string))
 	}
@@ -196,6 +231,14 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.BasicAuthCredentials = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("build_spec"); ok {
+		input.BuildSpec = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("compute_role_arn"); ok {
+		input.ComputeRoleArn = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -220,6 +263,10 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.EnablePullRequestPreview = aws.Bool(v.(bool))
 	}
 
+	if v, ok := d.GetOk("enable_skew_protection"); ok {
+		input.EnableSkewProtection = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("environment_variables"); ok && len(v.(map[string]interface{})) > 0 {
 		input.EnvironmentVariables = flex.ExpandStringValueMap(v.(map[string]interface{}))
 	}
@@ -275,9 +322,18 @@ func resourceBranchRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("app_id", appID)
 	d.Set(names.AttrARN, branch.BranchArn)
 	d.Set("associated_resources", branch.AssociatedResources)
+	if branch.Backend != nil && aws.ToString(branch.Backend.StackArn) != "" {
+		if err := d.Set("backend", []interface{}{flattenBackend(branch.Backend)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting backend: %s", err)
+		}
+	} else {
+		d.Set("backend", nil)
+	}
 	d.Set("backend_environment_arn", branch.BackendEnvironmentArn)
 	d.Set("basic_auth_credentials", branch.BasicAuthCredentials)
 	d.Set("branch_name", branch.BranchName)
+	d.Set("build_spec", branch.BuildSpec)
+	d.Set("compute_role_arn", branch.ComputeRoleArn)
 	d.Set("custom_domains", branch.CustomDomains)
 	d.Set(names.AttrDescription, branch.Description)
 	d.Set("destination_branch", branch.DestinationBranch)
@@ -287,6 +343,7 @@ func resourceBranchRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("enable_notification", branch.EnableNotification)
 	d.Set("enable_performance_mode", branch.EnablePerformanceMode)
 	d.Set("enable_pull_request_preview", branch.EnablePullRequestPreview)
+	d.Set("enable_skew_protection", branch.EnableSkewProtection)
 	d.Set("environment_variables", branch.EnvironmentVariables)
 	d.Set("framework", branch.Framework)
 	d.Set("pull_request_environment_name", branch.PullRequestEnvironmentName)
@@ -314,6 +371,14 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			BranchName: aws.String(branchName),
 		}
 
+		if d.HasChange("backend") {
+			if v, ok := d.GetOk("backend"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.Backend = expandBackend(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.Backend = &types.Backend{}
+			}
+		}
+
 		if d.HasChange("backend_environment_arn") {
 			input.BackendEnvironmentArn = aws.String(d.Get("backend_environment_arn").(string))
 		}
@@ -322,6 +387,14 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
 		}
 
+		if d.HasChange("build_spec") {
+			input.BuildSpec = aws.String(d.Get("build_spec").(string))
+		}
+
+		if d.HasChange("compute_role_arn") {
+			input.ComputeRoleArn = aws.String(d.Get("compute_role_arn").(string))
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
@@ -350,6 +423,10 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.EnablePullRequestPreview = aws.Bool(d.Get("enable_pull_request_preview").(bool))
 		}
 
+		if d.HasChange("enable_skew_protection") {
+			input.EnableSkewProtection = aws.Bool(d.Get("enable_skew_protection").(bool))
+		}
+
 		if d.HasChange("environment_variables") {
 			if v := d.Get("environment_variables").(map[string]interface{}); len(v) > 0 {
 				input.EnvironmentVariables = flex.ExpandStringValueMap(v)
@@ -436,6 +513,34 @@ func findBranchByTwoPartKey(ctx context.Context, conn *amplify.Client, appID, br
 	return output.Branch, nil
 }
 
+func expandBackend(tfMap map[string]interface{}) *types.Backend {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.Backend{}
+
+	if v, ok := tfMap["stack_arn"].(string); ok && v != "" {
+		apiObject.StackArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenBackend(apiObject *types.Backend) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.StackArn; v != nil {
+		tfMap["stack_arn"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
 const branchResourceIDSeparator = "/"
 
 func branchCreateResourceID(appID, branchName string) string {
@@ -291,6 +291,141 @@ func testAccBranch_OptionalArguments(t *testing.T) {
 	})
 }
 
+func testAccBranch_BuildSpec(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_buildSpec(rName, "make"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "build_spec", "version: 0.1\nfrontend:\n  phases:\n    build:\n      commands:\n        - make\n"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBranchConfig_buildSpec(rName, "npm run build"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "build_spec", "version: 0.1\nfrontend:\n  phases:\n    build:\n      commands:\n        - npm run build\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranch_ComputeRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_computeRoleARN(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", "aws_iam_role.test1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBranchConfig_computeRoleARN(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_role_arn", "aws_iam_role.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranch_SkewProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_skewProtection(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "enable_skew_protection", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBranchConfig_skewProtection(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "enable_skew_protection", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccBranch_Backend(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBranchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_backend(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, resourceName, &branch),
+					resource.TestCheckResourceAttr(resourceName, "backend.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "backend.0.stack_arn", "aws_cloudformation_stack.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "backend_environment_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBranchExists(ctx context.Context, resourceName string, v *types.Branch) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -506,3 +641,87 @@ resource "aws_amplify_branch" "test" {
 }
 `, rName, environmentName)
 }
+
+func testAccBranchConfig_buildSpec(rName, command string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  build_spec = <<-EOT
+    version: 0.1
+    frontend:
+      phases:
+        build:
+          commands:
+            - %[2]s
+  EOT
+}
+`, rName, command)
+}
+
+func testAccBranchConfig_computeRoleARN(rName, roleResourceName string) string {
+	return acctest.ConfigCompose(testAccAppIAMServiceRoleBaseConfig(rName), fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+
+  platform = "WEB_COMPUTE"
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  compute_role_arn = aws_iam_role.%[2]s.arn
+}
+`, rName, roleResourceName))
+}
+
+func testAccBranchConfig_skewProtection(rName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  enable_skew_protection = %[2]t
+}
+`, rName, enabled)
+}
+
+func testAccBranchConfig_backend(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name     = %[1]q
+  platform = "WEB_COMPUTE"
+}
+
+resource "aws_cloudformation_stack" "test" {
+  name = "amplify-${aws_amplify_app.test.id}-%[1]s"
+
+  template_body = jsonencode({
+    Resources = {
+      Handle = {
+        Type = "AWS::CloudFormation::WaitConditionHandle"
+      }
+    }
+  })
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  backend {
+    stack_arn = aws_cloudformation_stack.test.id
+  }
+}
+`, rName)
+}
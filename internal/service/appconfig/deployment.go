@@ -5,6 +5,7 @@ package appconfig
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
@@ -17,9 +18,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -40,6 +43,10 @@ func ResourceDeployment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrApplicationID: {
 				Type:         schema.TypeString,
@@ -97,6 +104,10 @@ func ResourceDeployment() *schema.Resource {
 					verify.ValidARN,
 					validation.StringLenBetween(1, 256)),
 			},
+			"rollback_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -143,6 +154,12 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/%d", appID, envID, output.DeploymentNumber))
 
+	if _, err := waitDeploymentCompleted(ctx, conn, appID, envID, output.DeploymentNumber, d.Timeout(schema.TimeoutCreate)); err != nil {
+		// Returning an error here taints the resource. Refresh state so that any rollback reason is recorded.
+		diags = sdkdiag.AppendErrorf(diags, "waiting for AppConfig Deployment (%s) complete: %s", d.Id(), err)
+		return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -156,15 +173,9 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	input := &appconfig.GetDeploymentInput{
-		ApplicationId:    aws.String(appID),
-		DeploymentNumber: aws.Int32(deploymentNum),
-		EnvironmentId:    aws.String(envID),
-	}
+	output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
 
-	output, err := conn.GetDeployment(ctx, input)
-
-	if !d.IsNewResource() && errs.IsA[*awstypes.ResourceNotFoundException](err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Appconfig Deployment (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return diags
@@ -174,10 +185,6 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): %s", d.Id(), err)
 	}
 
-	if output == nil {
-		return sdkdiag.AppendErrorf(diags, "reading AppConfig Deployment (%s): empty response", d.Id())
-	}
-
 	arn := arn.ARN{
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Partition: meta.(*conns.AWSClient).Partition,
@@ -196,6 +203,7 @@ func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("environment_id", output.EnvironmentId)
 	d.Set(names.AttrKMSKeyARN, output.KmsKeyArn)
 	d.Set("kms_key_identifier", output.KmsKeyIdentifier)
+	d.Set("rollback_reason", deploymentRollbackReason(output))
 	d.Set(names.AttrState, output.State)
 
 	return diags
@@ -229,3 +237,92 @@ func DeploymentParseID(id string) (string, string, int32, error) {
 
 	return parts[0], parts[1], int32(num), nil
 }
+
+func findDeploymentByThreePartKey(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) (*appconfig.GetDeploymentOutput, error) {
+	input := &appconfig.GetDeploymentInput{
+		ApplicationId:    aws.String(appID),
+		DeploymentNumber: aws.Int32(deploymentNum),
+		EnvironmentId:    aws.String(envID),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDeployment(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByThreePartKey(ctx, conn, appID, envID, deploymentNum)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitDeploymentCompleted(ctx context.Context, conn *appconfig.Client, appID, envID string, deploymentNum int32, timeout time.Duration) (*appconfig.GetDeploymentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DeploymentStateBaking, awstypes.DeploymentStateDeploying, awstypes.DeploymentStateRollingBack, awstypes.DeploymentStateValidating),
+		Target:     enum.Slice(awstypes.DeploymentStateComplete),
+		Refresh:    statusDeployment(ctx, conn, appID, envID, deploymentNum),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*appconfig.GetDeploymentOutput); ok {
+		if reason := deploymentRollbackReason(output); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// deploymentRollbackReason returns a description of the most recent rollback of the specified deployment, if any.
+func deploymentRollbackReason(output *appconfig.GetDeploymentOutput) string {
+	switch output.State {
+	case awstypes.DeploymentStateRollingBack, awstypes.DeploymentStateRolledBack:
+	default:
+		return ""
+	}
+
+	// The event log is returned in reverse chronological order.
+	for _, v := range output.EventLog {
+		if v.EventType != awstypes.DeploymentEventTypeRollbackStarted {
+			continue
+		}
+
+		if description := aws.ToString(v.Description); description != "" {
+			return fmt.Sprintf("%s (triggered by %s)", description, v.TriggeredBy)
+		}
+
+		return fmt.Sprintf("rollback triggered by %s", v.TriggeredBy)
+	}
+
+	return ""
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -48,7 +49,8 @@ func TestAccAppConfigDeployment_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "deployment_strategy_id", depStrategyResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
 					resource.TestCheckResourceAttrPair(resourceName, "environment_id", envResourceName, "environment_id"),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttr(resourceName, "rollback_reason", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.DeploymentStateComplete)),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
 				),
 			},
@@ -121,11 +123,6 @@ func TestAccAppConfigDeployment_predefinedStrategy(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// Since AppConfig Deployments can vary in completion times
-				// depending on the predefined deployment strategy,
-				// a waiter is not implemented for the resource;
-				// thus, we cannot guarantee the "state" value during import.
-				ImportStateVerifyIgnore: []string{names.AttrState},
 			},
 		},
 	})
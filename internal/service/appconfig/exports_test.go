@@ -6,4 +6,7 @@ package appconfig
 // Exports for use in tests only.
 var (
 	ResourceEnvironmentFW = newResourceEnvironment
+
+	FeatureFlagParseResourceID = featureFlagParseResourceID
+	FindLatestFeatureFlags     = findLatestFeatureFlags
 )
@@ -6,6 +6,7 @@ package appconfig
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -37,6 +39,8 @@ func ResourceExtensionAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceExtensionAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -58,8 +62,17 @@ func ResourceExtensionAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"extension_version": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"sensitive_parameters": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
@@ -75,8 +88,12 @@ func resourceExtensionAssociationCreate(ctx context.Context, d *schema.ResourceD
 		ResourceIdentifier:  aws.String(d.Get(names.AttrResourceARN).(string)),
 	}
 
-	if v, ok := d.GetOk(names.AttrParameters); ok {
-		in.Parameters = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	if v, ok := d.GetOk("extension_version"); ok {
+		in.ExtensionVersionNumber = aws.Int32(int32(v.(int)))
+	}
+
+	if v := expandExtensionAssociationParameters(d); len(v) > 0 {
+		in.Parameters = v
 	}
 
 	out, err := conn.CreateExtensionAssociation(ctx, &in)
@@ -113,7 +130,9 @@ func resourceExtensionAssociationRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set(names.AttrARN, out.Arn)
 	d.Set("extension_arn", out.ExtensionArn)
-	d.Set(names.AttrParameters, out.Parameters)
+	parameters, sensitiveParameters := flattenExtensionAssociationParameters(d, out.Parameters)
+	d.Set(names.AttrParameters, parameters)
+	d.Set("sensitive_parameters", sensitiveParameters)
 	d.Set(names.AttrResourceARN, out.ResourceArn)
 	d.Set("extension_version", out.ExtensionVersionNumber)
 
@@ -130,8 +149,8 @@ func resourceExtensionAssociationUpdate(ctx context.Context, d *schema.ResourceD
 		ExtensionAssociationId: aws.String(d.Id()),
 	}
 
-	if d.HasChange(names.AttrParameters) {
-		in.Parameters = flex.ExpandStringValueMap(d.Get(names.AttrParameters).(map[string]interface{}))
+	if d.HasChanges(names.AttrParameters, "sensitive_parameters") {
+		in.Parameters = expandExtensionAssociationParameters(d)
 		requestUpdate = true
 	}
 
@@ -170,3 +189,50 @@ func resourceExtensionAssociationDelete(ctx context.Context, d *schema.ResourceD
 
 	return diags
 }
+
+func resourceExtensionAssociationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The two maps are merged into a single parameter map, so a key may appear in only one of them.
+	parameters, sensitiveParameters := d.GetRawConfig().GetAttr(names.AttrParameters), d.GetRawConfig().GetAttr("sensitive_parameters")
+
+	if !parameters.IsKnown() || parameters.IsNull() || !sensitiveParameters.IsKnown() || sensitiveParameters.IsNull() {
+		return nil
+	}
+
+	for k := range sensitiveParameters.AsValueMap() {
+		if _, ok := parameters.AsValueMap()[k]; ok {
+			return fmt.Errorf("parameter %q must not be specified in both parameters and sensitive_parameters", k)
+		}
+	}
+
+	return nil
+}
+
+// expandExtensionAssociationParameters merges the configured parameters and sensitive parameters.
+func expandExtensionAssociationParameters(d *schema.ResourceData) map[string]string {
+	parameters := flex.ExpandStringValueMap(d.Get(names.AttrParameters).(map[string]interface{}))
+
+	for k, v := range flex.ExpandStringValueMap(d.Get("sensitive_parameters").(map[string]interface{})) {
+		parameters[k] = v
+	}
+
+	return parameters
+}
+
+// flattenExtensionAssociationParameters splits the association's parameters into those configured
+// as sensitive and all others.
+// The API does not record which parameters are sensitive, so on import (no prior state) all values are
+// read into parameters.
+func flattenExtensionAssociationParameters(d *schema.ResourceData, apiObject map[string]string) (map[string]string, map[string]string) {
+	sensitiveKeys := d.Get("sensitive_parameters").(map[string]interface{})
+	parameters, sensitiveParameters := make(map[string]string), make(map[string]string)
+
+	for k, v := range apiObject {
+		if _, ok := sensitiveKeys[k]; ok {
+			sensitiveParameters[k] = v
+		} else {
+			parameters[k] = v
+		}
+	}
+
+	return parameters, sensitiveParameters
+}
@@ -108,6 +108,91 @@ func TestAccAppConfigExtensionAssociation_Parameters(t *testing.T) {
 	})
 }
 
+func TestAccAppConfigExtensionAssociation_sensitiveParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_extension_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_sensitiveParameters(rName, "SensitiveValue1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtensionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.parameter1", "ParameterValue1"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_parameters.parameter2", "SensitiveValue1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrParameters, "sensitive_parameters"},
+			},
+			{
+				Config: testAccExtensionAssociationConfig_sensitiveParameters(rName, "SensitiveValue2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtensionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_parameters.parameter2", "SensitiveValue2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigExtensionAssociation_sensitiveParametersConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccExtensionAssociationConfig_sensitiveParametersConflict(rName),
+				ExpectError: regexache.MustCompile(`parameter "parameter1" must not be specified in both parameters and sensitive_parameters`),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigExtensionAssociation_extensionVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_extension_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckExtensionAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtensionAssociationConfig_extensionVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExtensionAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "extension_version", "aws_appconfig_extension.test", names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAppConfigExtensionAssociation_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -319,3 +404,97 @@ resource "aws_appconfig_extension_association" "test" {
 }
 `, rName, pName, pDescription, pRequired, pValue))
 }
+
+func testAccExtensionAssociationConfig_sensitiveParameters(rName, sensitiveValue string) string {
+	return acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name = %[1]q
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+  parameter {
+    name        = "parameter1"
+    description = "description1"
+    required    = true
+  }
+  parameter {
+    name        = "parameter2"
+    description = "description2"
+    required    = true
+  }
+}
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn = aws_appconfig_extension.test.arn
+  resource_arn  = aws_appconfig_application.test.arn
+  parameters = {
+    parameter1 = "ParameterValue1"
+  }
+  sensitive_parameters = {
+    parameter2 = %[2]q
+  }
+}
+`, rName, sensitiveValue))
+}
+
+func testAccExtensionAssociationConfig_sensitiveParametersConflict(rName string) string {
+	return acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name = %[1]q
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+  parameter {
+    name        = "parameter1"
+    description = "description1"
+    required    = true
+  }
+}
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn = aws_appconfig_extension.test.arn
+  resource_arn  = aws_appconfig_application.test.arn
+  parameters = {
+    parameter1 = "ParameterValue1"
+  }
+  sensitive_parameters = {
+    parameter1 = "SensitiveValue1"
+  }
+}
+`, rName))
+}
+
+func testAccExtensionAssociationConfig_extensionVersion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccExtensionAssociationConfigBase(rName),
+		fmt.Sprintf(`
+resource "aws_appconfig_extension" "test" {
+  name = %[1]q
+  action_point {
+    point = "ON_DEPLOYMENT_COMPLETE"
+    action {
+      name     = "test"
+      role_arn = aws_iam_role.test.arn
+      uri      = aws_sns_topic.test.arn
+    }
+  }
+}
+resource "aws_appconfig_extension_association" "test" {
+  extension_arn     = aws_appconfig_extension.test.arn
+  extension_version = aws_appconfig_extension.test.version
+  resource_arn      = aws_appconfig_application.test.arn
+}
+`, rName))
+}
@@ -0,0 +1,641 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appconfig"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appconfig/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appconfig_feature_flag", name="Feature Flag")
+func ResourceFeatureFlag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeatureFlagPut,
+		ReadWithoutTimeout:   resourceFeatureFlagRead,
+		UpdateWithoutTimeout: resourceFeatureFlagPut,
+		DeleteWithoutTimeout: resourceFeatureFlagDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrApplicationID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			"attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enum": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"maximum": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"minimum": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_-]{0,63}$`), ""),
+						},
+						"pattern": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(featureFlagAttributeType_Values(), false),
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"configuration_profile_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9a-z]{4,7}`), ""),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[a-z][0-9A-Za-z_-]{0,63}$`), ""),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"variant": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_values": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						names.AttrRule: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	featureFlagsContentType = "application/json"
+	featureFlagsVariantsKey = "_variants"
+)
+
+const (
+	featureFlagAttributeTypeBoolean     = "boolean"
+	featureFlagAttributeTypeNumber      = "number"
+	featureFlagAttributeTypeNumberArray = "number[]"
+	featureFlagAttributeTypeString      = "string"
+	featureFlagAttributeTypeStringArray = "string[]"
+)
+
+func featureFlagAttributeType_Values() []string {
+	return []string{
+		featureFlagAttributeTypeBoolean,
+		featureFlagAttributeTypeNumber,
+		featureFlagAttributeTypeNumberArray,
+		featureFlagAttributeTypeString,
+		featureFlagAttributeTypeStringArray,
+	}
+}
+
+// featureFlagsContent is the AWS.AppConfig.FeatureFlags hosted configuration format.
+// See https://docs.aws.amazon.com/appconfig/latest/userguide/appconfig-type-reference-feature-flags.html.
+type featureFlagsContent struct {
+	Flags   map[string]map[string]interface{} `json:"flags"`
+	Values  map[string]map[string]interface{} `json:"values"`
+	Version string                            `json:"version"`
+}
+
+func resourceFeatureFlagPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID, profileID, key := d.Get(names.AttrApplicationID).(string), d.Get("configuration_profile_id").(string), d.Get(names.AttrKey).(string)
+	id := featureFlagCreateResourceID(appID, profileID, key)
+
+	flag, value, err := expandFeatureFlag(d)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting AppConfig Feature Flag (%s): %s", id, err)
+	}
+
+	versionNumber, err := updateFeatureFlags(ctx, conn, appID, profileID, func(content *featureFlagsContent) {
+		content.Flags[key] = flag
+		content.Values[key] = value
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting AppConfig Feature Flag (%s): %s", id, err)
+	}
+
+	log.Printf("[DEBUG] Created AppConfig Hosted Configuration Version (%d) for Feature Flag (%s)", versionNumber, id)
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return append(diags, resourceFeatureFlagRead(ctx, d, meta)...)
+}
+
+func resourceFeatureFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID, profileID, key, err := featureFlagParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	content, versionNumber, err := findLatestFeatureFlags(ctx, conn, appID, profileID)
+
+	if err == nil {
+		if _, ok := content.Flags[key]; !ok {
+			err = &retry.NotFoundError{
+				Message: fmt.Sprintf("feature flag (%s) not found in Hosted Configuration Version (%d)", key, versionNumber),
+			}
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppConfig Feature Flag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	flag, value := content.Flags[key], content.Values[key]
+
+	d.Set(names.AttrApplicationID, appID)
+	if err := d.Set("attribute", flattenFeatureFlagAttributes(flag, value)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attribute: %s", err)
+	}
+	d.Set("configuration_profile_id", profileID)
+	d.Set(names.AttrDescription, flag[names.AttrDescription])
+	d.Set(names.AttrEnabled, value[names.AttrEnabled])
+	d.Set(names.AttrKey, key)
+	d.Set(names.AttrName, flag[names.AttrName])
+	if err := d.Set("variant", flattenFeatureFlagVariants(flag, value)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting variant: %s", err)
+	}
+	d.Set("version_number", versionNumber)
+
+	return diags
+}
+
+func resourceFeatureFlagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppConfigClient(ctx)
+
+	appID, profileID, key, err := featureFlagParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting AppConfig Feature Flag: %s", d.Id())
+	_, err = updateFeatureFlags(ctx, conn, appID, profileID, func(content *featureFlagsContent) {
+		delete(content.Flags, key)
+		delete(content.Values, key)
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppConfig Feature Flag (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// updateFeatureFlags applies f to the latest feature flags of the configuration profile
+// and stores the result as a new hosted configuration version, returning its version number.
+// All flags of a configuration profile share the same hosted configuration, so updates are serialized.
+func updateFeatureFlags(ctx context.Context, conn *appconfig.Client, appID, profileID string, f func(*featureFlagsContent)) (int32, error) {
+	mutexKey := fmt.Sprintf("appconfig-feature-flags-%s-%s", appID, profileID)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	content, _, err := findLatestFeatureFlags(ctx, conn, appID, profileID)
+
+	switch {
+	case tfresource.NotFound(err):
+		content = &featureFlagsContent{
+			Flags:   map[string]map[string]interface{}{},
+			Values:  map[string]map[string]interface{}{},
+			Version: "1",
+		}
+	case err != nil:
+		return 0, err
+	}
+
+	f(content)
+
+	body, err := json.Marshal(content)
+
+	if err != nil {
+		return 0, err
+	}
+
+	output, err := conn.CreateHostedConfigurationVersion(ctx, &appconfig.CreateHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(profileID),
+		Content:                body,
+		ContentType:            aws.String(featureFlagsContentType),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("creating AppConfig Hosted Configuration Version: %w", err)
+	}
+
+	return output.VersionNumber, nil
+}
+
+func findLatestFeatureFlags(ctx context.Context, conn *appconfig.Client, appID, profileID string) (*featureFlagsContent, int32, error) {
+	input := &appconfig.ListHostedConfigurationVersionsInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(profileID),
+	}
+	var versionNumber int32
+
+	pages := appconfig.NewListHostedConfigurationVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, 0, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, v := range page.Items {
+			versionNumber = max(versionNumber, v.VersionNumber)
+		}
+	}
+
+	if versionNumber == 0 {
+		return nil, 0, tfresource.NewEmptyResultError(input)
+	}
+
+	output, err := conn.GetHostedConfigurationVersion(ctx, &appconfig.GetHostedConfigurationVersionInput{
+		ApplicationId:          aws.String(appID),
+		ConfigurationProfileId: aws.String(profileID),
+		VersionNumber:          aws.Int32(versionNumber),
+	})
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	content := &featureFlagsContent{}
+
+	if err := json.Unmarshal(output.Content, content); err != nil {
+		return nil, 0, fmt.Errorf("parsing AppConfig Hosted Configuration Version (%d) feature flags: %w", versionNumber, err)
+	}
+
+	if content.Flags == nil {
+		content.Flags = map[string]map[string]interface{}{}
+	}
+	if content.Values == nil {
+		content.Values = map[string]map[string]interface{}{}
+	}
+
+	return content, versionNumber, nil
+}
+
+const featureFlagResourceIDSeparator = "/"
+
+func featureFlagCreateResourceID(appID, profileID, key string) string {
+	parts := []string{appID, profileID, key}
+	id := strings.Join(parts, featureFlagResourceIDSeparator)
+
+	return id
+}
+
+func featureFlagParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, featureFlagResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ApplicationID%[2]sConfigurationProfileID%[2]sKey", id, featureFlagResourceIDSeparator)
+}
+
+// expandFeatureFlag returns the flag definition and flag value for the resource's configuration.
+func expandFeatureFlag(d *schema.ResourceData) (map[string]interface{}, map[string]interface{}, error) {
+	flag := map[string]interface{}{
+		names.AttrName: d.Get(names.AttrName).(string),
+	}
+	value := map[string]interface{}{}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		flag[names.AttrDescription] = v.(string)
+	}
+
+	attributeTypes := map[string]string{}
+	attributes := map[string]interface{}{}
+	variants := d.Get("variant").([]interface{})
+
+	for _, tfMapRaw := range d.Get("attribute").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, typ := tfMap[names.AttrName].(string), tfMap[names.AttrType].(string)
+		attributeTypes[name] = typ
+		constraints := map[string]interface{}{
+			names.AttrType: typ,
+		}
+
+		if v, ok := tfMap["enum"].([]interface{}); ok && len(v) > 0 {
+			enum := make([]interface{}, 0, len(v))
+			for _, v := range v {
+				v, err := featureFlagAttributeValue(strings.TrimSuffix(typ, "[]"), v.(string))
+				if err != nil {
+					return nil, nil, fmt.Errorf("attribute (%s) enum: %w", name, err)
+				}
+				enum = append(enum, v)
+			}
+			constraints["enum"] = enum
+		}
+
+		if v, ok := tfMap["maximum"].(float64); ok && v != 0 {
+			constraints["maximum"] = v
+		}
+
+		if v, ok := tfMap["minimum"].(float64); ok && v != 0 {
+			constraints["minimum"] = v
+		}
+
+		if v, ok := tfMap["pattern"].(string); ok && v != "" {
+			constraints["pattern"] = v
+		}
+
+		if v, ok := tfMap["required"].(bool); ok && v {
+			constraints["required"] = v
+		}
+
+		attributes[name] = map[string]interface{}{
+			"constraints": constraints,
+		}
+
+		if v, ok := tfMap[names.AttrValue].(string); ok && v != "" {
+			if len(variants) > 0 {
+				return nil, nil, fmt.Errorf("attribute (%s) value cannot be set when variants are configured, set it in each variant instead", name)
+			}
+
+			v, err := featureFlagAttributeValue(typ, v)
+			if err != nil {
+				return nil, nil, fmt.Errorf("attribute (%s) value: %w", name, err)
+			}
+			value[name] = v
+		}
+	}
+
+	if len(attributes) > 0 {
+		flag["attributes"] = attributes
+	}
+
+	if len(variants) == 0 {
+		value[names.AttrEnabled] = d.Get(names.AttrEnabled).(bool)
+
+		return flag, value, nil
+	}
+
+	if d.Get(names.AttrEnabled).(bool) {
+		return nil, nil, errors.New("enabled cannot be set when variants are configured, set it in each variant instead")
+	}
+
+	apiObjects := make([]interface{}, 0, len(variants))
+
+	for _, tfMapRaw := range variants {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		apiObject := map[string]interface{}{
+			names.AttrEnabled: tfMap[names.AttrEnabled].(bool),
+			names.AttrName:    name,
+		}
+
+		if v, ok := tfMap[names.AttrRule].(string); ok && v != "" {
+			apiObject[names.AttrRule] = v
+		}
+
+		for k, v := range tfMap["attribute_values"].(map[string]interface{}) {
+			typ, ok := attributeTypes[k]
+			if !ok {
+				return nil, nil, fmt.Errorf("variant (%s) sets a value for undefined attribute (%s)", name, k)
+			}
+
+			v, err := featureFlagAttributeValue(typ, v.(string))
+			if err != nil {
+				return nil, nil, fmt.Errorf("variant (%s) attribute (%s) value: %w", name, k, err)
+			}
+			apiObject[k] = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	value[featureFlagsVariantsKey] = apiObjects
+
+	return flag, value, nil
+}
+
+// featureFlagAttributeValue converts an attribute value from its string representation to the attribute's type.
+// Array values are JSON-encoded.
+func featureFlagAttributeValue(typ, s string) (interface{}, error) {
+	switch typ {
+	case featureFlagAttributeTypeBoolean:
+		return strconv.ParseBool(s)
+	case featureFlagAttributeTypeNumber:
+		return strconv.ParseFloat(s, 64)
+	case featureFlagAttributeTypeNumberArray:
+		var v []float64
+		err := json.Unmarshal([]byte(s), &v)
+		return v, err
+	case featureFlagAttributeTypeStringArray:
+		var v []string
+		err := json.Unmarshal([]byte(s), &v)
+		return v, err
+	default:
+		return s, nil
+	}
+}
+
+// featureFlagAttributeString returns the string representation of an attribute value.
+func featureFlagAttributeString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+func flattenFeatureFlagAttributes(flag, value map[string]interface{}) []interface{} {
+	attributes, _ := flag["attributes"].(map[string]interface{})
+
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	tfList := make([]interface{}, 0, len(keys))
+
+	for _, k := range keys {
+		attribute, _ := attributes[k].(map[string]interface{})
+		constraints, _ := attribute["constraints"].(map[string]interface{})
+		tfMap := map[string]interface{}{
+			names.AttrName: k,
+			names.AttrType: constraints[names.AttrType],
+		}
+
+		if v, ok := constraints["enum"].([]interface{}); ok {
+			enum := make([]interface{}, 0, len(v))
+			for _, v := range v {
+				enum = append(enum, featureFlagAttributeString(v))
+			}
+			tfMap["enum"] = enum
+		}
+
+		for _, c := range []string{"maximum", "minimum", "pattern", "required"} {
+			if v, ok := constraints[c]; ok {
+				tfMap[c] = v
+			}
+		}
+
+		if v, ok := value[k]; ok {
+			tfMap[names.AttrValue] = featureFlagAttributeString(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenFeatureFlagVariants(flag, value map[string]interface{}) []interface{} {
+	apiObjects, _ := value[featureFlagsVariantsKey].([]interface{})
+	attributes, _ := flag["attributes"].(map[string]interface{})
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		apiObject, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attributeValues := map[string]interface{}{}
+		for k := range attributes {
+			if v, ok := apiObject[k]; ok {
+				attributeValues[k] = featureFlagAttributeString(v)
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"attribute_values": attributeValues,
+			names.AttrEnabled:  apiObject[names.AttrEnabled],
+			names.AttrName:     apiObject[names.AttrName],
+			names.AttrRule:     apiObject[names.AttrRule],
+		})
+	}
+
+	return tfList
+}
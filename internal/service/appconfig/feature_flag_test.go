@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appconfig_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappconfig "github.com/hashicorp/terraform-provider-aws/internal/service/appconfig"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppConfigFeatureFlag_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_appconfig_application.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration_profile_id", "aws_appconfig_configuration_profile.test", "configuration_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKey, "checkout"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFeatureFlagConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappconfig.ResourceFeatureFlag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_attributes(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_attributes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrName:  "color",
+						names.AttrType:  "string",
+						"enum.#":        acctest.Ct2,
+						"required":      acctest.CtTrue,
+						names.AttrValue: "blue",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrName:  "limit",
+						names.AttrType:  "number",
+						"maximum":       "100",
+						"minimum":       acctest.Ct1,
+						names.AttrValue: "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						names.AttrName:  "regions",
+						names.AttrType:  "string[]",
+						names.AttrValue: `["eu-west-1","us-west-2"]`,
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Checkout flow"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppConfigFeatureFlag_variants(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appconfig_feature_flag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_variants(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "variant.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.name", "beta"),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.rule", `(ends_with $email "example.com")`),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.attribute_values.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "variant.0.attribute_values.color", "green"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.enabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "variant.1.rule", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Flags in the same configuration profile share a hosted configuration and must not overwrite each other.
+func TestAccAppConfigFeatureFlag_multiple(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFeatureFlagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureFlagConfig_multiple(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, "aws_appconfig_feature_flag.test.0"),
+					testAccCheckFeatureFlagExists(ctx, "aws_appconfig_feature_flag.test.1"),
+					testAccCheckFeatureFlagExists(ctx, "aws_appconfig_feature_flag.test.2"),
+				),
+			},
+			{
+				Config: testAccFeatureFlagConfig_multiple(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFeatureFlagExists(ctx, "aws_appconfig_feature_flag.test.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFeatureFlagDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appconfig_feature_flag" {
+				continue
+			}
+
+			appID, profileID, key, err := tfappconfig.FeatureFlagParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			content, _, err := tfappconfig.FindLatestFeatureFlags(ctx, conn, appID, profileID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if _, ok := content.Flags[key]; ok {
+				return fmt.Errorf("AppConfig Feature Flag %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFeatureFlagExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		appID, profileID, key, err := tfappconfig.FeatureFlagParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppConfigClient(ctx)
+
+		content, _, err := tfappconfig.FindLatestFeatureFlags(ctx, conn, appID, profileID)
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := content.Flags[key]; !ok {
+			return fmt.Errorf("AppConfig Feature Flag (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFeatureFlagConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appconfig_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_appconfig_configuration_profile" "test" {
+  application_id = aws_appconfig_application.test.id
+  name           = %[1]q
+  location_uri   = "hosted"
+  type           = "AWS.AppConfig.FeatureFlags"
+}
+`, rName)
+}
+
+func testAccFeatureFlagConfig_basic(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccFeatureFlagConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "checkout"
+  name                     = %[1]q
+  enabled                  = %[2]t
+}
+`, rName, enabled))
+}
+
+func testAccFeatureFlagConfig_attributes(rName string) string {
+	return acctest.ConfigCompose(testAccFeatureFlagConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "checkout"
+  name                     = %[1]q
+  description              = "Checkout flow"
+  enabled                  = true
+
+  attribute {
+    name     = "color"
+    type     = "string"
+    enum     = ["blue", "green"]
+    required = true
+    value    = "blue"
+  }
+
+  attribute {
+    name    = "limit"
+    type    = "number"
+    minimum = 1
+    maximum = 100
+    value   = "10"
+  }
+
+  attribute {
+    name  = "regions"
+    type  = "string[]"
+    value = jsonencode(["eu-west-1", "us-west-2"])
+  }
+}
+`, rName))
+}
+
+func testAccFeatureFlagConfig_variants(rName string) string {
+	return acctest.ConfigCompose(testAccFeatureFlagConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "checkout"
+  name                     = %[1]q
+
+  attribute {
+    name = "color"
+    type = "string"
+  }
+
+  variant {
+    name    = "beta"
+    enabled = true
+    rule    = "(ends_with $email \"example.com\")"
+
+    attribute_values = {
+      color = "green"
+    }
+  }
+
+  variant {
+    name = "default"
+  }
+}
+`, rName))
+}
+
+func testAccFeatureFlagConfig_multiple(rName string, count int) string {
+	return acctest.ConfigCompose(testAccFeatureFlagConfig_base(rName), fmt.Sprintf(`
+resource "aws_appconfig_feature_flag" "test" {
+  count = %[2]d
+
+  application_id           = aws_appconfig_application.test.id
+  configuration_profile_id = aws_appconfig_configuration_profile.test.configuration_profile_id
+  key                      = "flag${count.index}"
+  name                     = "%[1]s-${count.index}"
+}
+`, rName, count))
+}
@@ -107,7 +107,7 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 
 	createTimeout := r.CreateTimeout(ctx, data.Timeouts)
 
-	op, err := waitDeploymentSucceeded(ctx, conn, serviceARN, operationID, createTimeout)
+	op, err := waitOperationSucceeded(ctx, conn, serviceARN, operationID, createTimeout)
 
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("waiting for App Runner Deployment (%s/%s)", serviceARN, operationID), err.Error())
@@ -215,9 +215,11 @@ func statusOperation(ctx context.Context, conn *apprunner.Client, serviceARN, op
 	}
 }
 
-func waitDeploymentSucceeded(ctx context.Context, conn *apprunner.Client, serviceARN, operationID string, timeout time.Duration) (*awstypes.OperationSummary, error) {
+// waitOperationSucceeded waits for a service operation to complete.
+// A failed deployment is rolled back by App Runner, so wait for the rollback to finish and report it as an error.
+func waitOperationSucceeded(ctx context.Context, conn *apprunner.Client, serviceARN, operationID string, timeout time.Duration) (*awstypes.OperationSummary, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:        enum.Slice(awstypes.OperationStatusPending, awstypes.OperationStatusInProgress),
+		Pending:        enum.Slice(awstypes.OperationStatusPending, awstypes.OperationStatusInProgress, awstypes.OperationStatusRollbackInProgress),
 		Target:         enum.Slice(awstypes.OperationStatusSucceeded),
 		Refresh:        statusOperation(ctx, conn, serviceARN, operationID),
 		Timeout:        timeout,
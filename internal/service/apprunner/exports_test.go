@@ -12,6 +12,7 @@ var (
 	ResourceService                         = resourceService
 	ResourceVPCConnector                    = resourceVPCConnector
 	ResourceVPCIngressConnection            = resourceVPCIngressConnection
+	ResourceWebACLAssociation               = resourceWebACLAssociation
 
 	FindAutoScalingConfigurationByARN          = findAutoScalingConfigurationByARN
 	FindConnectionByName                       = findConnectionByName
@@ -21,5 +22,6 @@ var (
 	FindServiceByARN                           = findServiceByARN
 	FindVPCConnectorByARN                      = findVPCConnectorByARN
 	FindVPCIngressConnectionByARN              = findVPCIngressConnectionByARN
+	FindWebACLByServiceARN                     = findWebACLByServiceARN
 	PutDefaultAutoScalingConfiguration         = putDefaultAutoScalingConfiguration
 )
@@ -67,6 +67,10 @@ func resourceService() *schema.Resource {
 					},
 				},
 			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"health_check_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -518,6 +522,17 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	deployment, err := findLatestDeploymentOperationByServiceARN(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("deployment_id", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading App Runner Service (%s) deployments: %s", d.Id(), err)
+	default:
+		d.Set("deployment_id", deployment.Id)
+	}
+
 	d.Set(names.AttrARN, service.ServiceArn)
 	if service.AutoScalingConfigurationSummary != nil {
 		d.Set("auto_scaling_configuration_arn", service.AutoScalingConfigurationSummary.AutoScalingConfigurationArn)
@@ -584,7 +599,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.SourceConfiguration = expandServiceSourceConfiguration(d.Get("source_configuration").([]interface{}))
 		}
 
-		_, err := conn.UpdateService(ctx, input)
+		output, err := conn.UpdateService(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating App Runner Service (%s): %s", d.Id(), err)
@@ -593,6 +608,13 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if _, err := waitServiceUpdated(ctx, conn, d.Id()); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for App Runner Service (%s) update: %s", d.Id(), err)
 		}
+
+		// The service returns to RUNNING after a failed deployment is rolled back, so check the operation's outcome.
+		if operationID := aws.ToString(output.OperationId); operationID != "" {
+			if _, err := waitOperationSucceeded(ctx, conn, d.Id(), operationID, serviceOperationTimeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for App Runner Service (%s) deployment (%s): %s", d.Id(), operationID, err)
+			}
+		}
 	}
 
 	return append(diags, resourceServiceRead(ctx, d, meta)...)
@@ -655,6 +677,38 @@ func findServiceByARN(ctx context.Context, conn *apprunner.Client, arn string) (
 	return output.Service, nil
 }
 
+func findLatestDeploymentOperationByServiceARN(ctx context.Context, conn *apprunner.Client, arn string) (*types.OperationSummary, error) {
+	input := &apprunner.ListOperationsInput{
+		ServiceArn: aws.String(arn),
+	}
+
+	// Operations are returned in reverse chronological order.
+	pages := apprunner.NewListOperationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.OperationSummaryList {
+			switch v.Type {
+			case types.OperationTypeCreateService, types.OperationTypeStartDeployment, types.OperationTypeUpdateService:
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 func statusService(ctx context.Context, conn *apprunner.Client, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findServiceByARN(ctx, conn, arn)
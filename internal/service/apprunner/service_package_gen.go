@@ -95,6 +95,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceWebACLAssociation,
+			TypeName: "aws_apprunner_web_acl_association",
+			Name:     "Web ACL Association",
+		},
 	}
 }
 
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apprunner
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// App Runner services are associated with WAFv2 web ACLs using the WAFv2 API.
+
+// @SDKResource("aws_apprunner_web_acl_association", name="Web ACL Association")
+func resourceWebACLAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWebACLAssociationCreate,
+		ReadWithoutTimeout:   resourceWebACLAssociationRead,
+		DeleteWithoutTimeout: resourceWebACLAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"web_acl_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceWebACLAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	serviceARN := d.Get("service_arn").(string)
+	input := &wafv2.AssociateWebACLInput{
+		ResourceArn: aws.String(serviceARN),
+		WebACLArn:   aws.String(d.Get("web_acl_arn").(string)),
+	}
+
+	// The service can't be associated while an operation is in progress.
+	_, err := tfresource.RetryWhenIsA[*wafv2types.WAFUnavailableEntityException](ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+		return conn.AssociateWebACL(ctx, input)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating App Runner Web ACL Association (%s): %s", serviceARN, err)
+	}
+
+	d.SetId(serviceARN)
+
+	return append(diags, resourceWebACLAssociationRead(ctx, d, meta)...)
+}
+
+func resourceWebACLAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	webACL, err := findWebACLByServiceARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] App Runner Web ACL Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading App Runner Web ACL Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("service_arn", d.Id())
+	d.Set("web_acl_arn", webACL.ARN)
+
+	return diags
+}
+
+func resourceWebACLAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+
+	log.Printf("[INFO] Deleting App Runner Web ACL Association: %s", d.Id())
+	_, err := conn.DisassociateWebACL(ctx, &wafv2.DisassociateWebACLInput{
+		ResourceArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*wafv2types.WAFNonexistentItemException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting App Runner Web ACL Association (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWebACLByServiceARN(ctx context.Context, conn *wafv2.Client, arn string) (*wafv2types.WebACL, error) {
+	input := &wafv2.GetWebACLForResourceInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetWebACLForResource(ctx, input)
+
+	if errs.IsA[*wafv2types.WAFNonexistentItemException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.WebACL == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.WebACL, nil
+}
@@ -15,9 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -32,6 +34,27 @@ func ResourceDirectoryConfig() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			"certificate_based_auth_properties": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_authority_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrStatus: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.CertificateBasedAuthStatus](),
+						},
+					},
+				},
+			},
 			names.AttrCreatedTime: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -84,6 +107,10 @@ func resourceDirectoryConfigCreate(ctx context.Context, d *schema.ResourceData,
 		ServiceAccountCredentials:            expandServiceAccountCredentials(d.Get("service_account_credentials").([]interface{})),
 	}
 
+	if v, ok := d.GetOk("certificate_based_auth_properties"); ok {
+		input.CertificateBasedAuthProperties = expandCertificateBasedAuthProperties(v.([]interface{}))
+	}
+
 	output, err := conn.CreateDirectoryConfig(ctx, input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating AppStream Directory Config (%s): %s", directoryName, err)
@@ -125,6 +152,9 @@ func resourceDirectoryConfigRead(ctx context.Context, d *schema.ResourceData, me
 
 	directoryConfig := resp.DirectoryConfigs[0]
 
+	if err = d.Set("certificate_based_auth_properties", flattenCertificateBasedAuthProperties(directoryConfig.CertificateBasedAuthProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting `%s` for AppStream Directory Config (%s): %s", "certificate_based_auth_properties", d.Id(), err)
+	}
 	d.Set(names.AttrCreatedTime, aws.ToTime(directoryConfig.CreatedTime).Format(time.RFC3339))
 	d.Set("directory_name", directoryConfig.DirectoryName)
 	d.Set("organizational_unit_distinguished_names", flex.FlattenStringValueSet(directoryConfig.OrganizationalUnitDistinguishedNames))
@@ -144,6 +174,10 @@ func resourceDirectoryConfigUpdate(ctx context.Context, d *schema.ResourceData,
 		DirectoryName: aws.String(d.Id()),
 	}
 
+	if d.HasChange("certificate_based_auth_properties") {
+		input.CertificateBasedAuthProperties = expandCertificateBasedAuthProperties(d.Get("certificate_based_auth_properties").([]interface{}))
+	}
+
 	if d.HasChange("organizational_unit_distinguished_names") {
 		input.OrganizationalUnitDistinguishedNames = flex.ExpandStringValueSet(d.Get("organizational_unit_distinguished_names").(*schema.Set))
 	}
@@ -207,3 +241,36 @@ func flattenServiceAccountCredentials(apiObject *awstypes.ServiceAccountCredenti
 
 	return []interface{}{tfList}
 }
+
+func expandCertificateBasedAuthProperties(tfList []interface{}) *awstypes.CertificateBasedAuthProperties {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.CertificateBasedAuthProperties{}
+
+	if v, ok := tfMap["certificate_authority_arn"].(string); ok && v != "" {
+		apiObject.CertificateAuthorityArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrStatus].(string); ok && v != "" {
+		apiObject.Status = awstypes.CertificateBasedAuthStatus(v)
+	}
+
+	return apiObject
+}
+
+func flattenCertificateBasedAuthProperties(apiObject *awstypes.CertificateBasedAuthProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"certificate_authority_arn": aws.ToString(apiObject.CertificateAuthorityArn),
+		names.AttrStatus:            string(apiObject.Status),
+	}
+
+	return []interface{}{tfMap}
+}
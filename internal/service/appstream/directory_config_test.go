@@ -154,6 +154,50 @@ func TestAccAppStreamDirectoryConfig_OrganizationalUnitDistinguishedNames(t *tes
 	})
 }
 
+func TestAccAppStreamDirectoryConfig_certificateBasedAuthProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.DirectoryConfig
+	resourceName := "aws_appstream_directory_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	rUserName := fmt.Sprintf("%s\\%s", domain, sdkacctest.RandString(10))
+	rPassword := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	orgUnitDN := orgUnitFromDomain("Test", domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryConfigDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfigConfig_certificateBasedAuthProperties(rName, domain, rUserName, rPassword, orgUnitDN, "ENABLED_NO_DIRECTORY_LOGIN_FALLBACK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryConfigExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_based_auth_properties.0.certificate_authority_arn", "aws_acmpca_certificate_authority.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.0.status", "ENABLED_NO_DIRECTORY_LOGIN_FALLBACK"),
+				),
+			},
+			{
+				Config: testAccDirectoryConfigConfig_certificateBasedAuthProperties(rName, domain, rUserName, rPassword, orgUnitDN, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryConfigExists(ctx, resourceName, &v2),
+					testAccCheckDirectoryConfigNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "certificate_based_auth_properties.0.status", "ENABLED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_account_credentials.0.account_password"},
+			},
+		},
+	})
+}
+
 func testAccCheckDirectoryConfigExists(ctx context.Context, resourceName string, appStreamDirectoryConfig *awstypes.DirectoryConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -288,3 +332,76 @@ resource "aws_directory_service_directory" "test" {
 }
 `, domain, userName, password, orgUnitDN1, orgUnitDN2))
 }
+
+func testAccDirectoryConfigConfig_certificateBasedAuthProperties(rName, domain, userName, password, orgUnitDN, status string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_appstream_directory_config" "test" {
+  directory_name                          = %[1]q
+  organizational_unit_distinguished_names = [%[4]q]
+
+  service_account_credentials {
+    account_name     = %[2]q
+    account_password = %[3]q
+  }
+
+  certificate_based_auth_properties {
+    certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+    status                    = %[5]q
+  }
+
+  depends_on = [
+    aws_directory_service_directory.test,
+    aws_acmpca_certificate_authority_certificate.test,
+  ]
+}
+
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = %[3]q
+  edition  = "Standard"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = %[1]q
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+
+  template_arn = "arn:${data.aws_partition.current.partition}:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate.test.certificate
+  certificate_chain         = aws_acmpca_certificate.test.certificate_chain
+}
+`, domain, userName, password, orgUnitDN, status))
+}
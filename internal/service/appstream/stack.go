@@ -80,6 +80,10 @@ func ResourceStack() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+						names.AttrS3BucketName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						"settings_group": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -558,8 +562,9 @@ func flattenApplicationSetting(apiObject *awstypes.ApplicationSettingsResponse)
 	}
 
 	return map[string]interface{}{
-		names.AttrEnabled: aws.ToBool(apiObject.Enabled),
-		"settings_group":  aws.ToString(apiObject.SettingsGroup),
+		names.AttrEnabled:      aws.ToBool(apiObject.Enabled),
+		names.AttrS3BucketName: aws.ToString(apiObject.S3BucketName),
+		"settings_group":       aws.ToString(apiObject.SettingsGroup),
 	}
 }
 
@@ -112,6 +112,7 @@ func TestAccAppStreamStack_complete(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "access_endpoints.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "application_settings.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "application_settings.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "application_settings.0.s3_bucket_name"),
 					resource.TestCheckResourceAttr(resourceName, "application_settings.0.settings_group", "SettingsGroup"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, ""),
 					resource.TestCheckResourceAttr(resourceName, "feedback_url", ""),
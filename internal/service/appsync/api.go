@@ -0,0 +1,440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="API")
+// @Tags(identifierAttribute="arn")
+func newAPIResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &apiResource{}
+
+	return r, nil
+}
+
+type apiResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*apiResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appsync_api"
+}
+
+func (r *apiResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	authModeBlock := func(minItems int) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[authModeModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(minItems),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"auth_type": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dns": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			"owner_contact": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"waf_web_acl_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"xray_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"auth_provider": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[authProviderModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"auth_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AuthenticationType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"cognito_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[cognitoConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"app_id_client_regex": schema.StringAttribute{
+													Optional: true,
+												},
+												"aws_region": schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrUserPoolID: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"lambda_authorizer_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaAuthorizerConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"authorizer_result_ttl_in_seconds": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												"authorizer_uri": schema.StringAttribute{
+													Required: true,
+												},
+												"identity_validation_expression": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"openid_connect_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"auth_ttl": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrClientID: schema.StringAttribute{
+													Optional: true,
+												},
+												"iat_ttl": schema.Int64Attribute{
+													Optional: true,
+													Computed: true,
+												},
+												names.AttrIssuer: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"connection_auth_mode":        authModeBlock(1),
+						"default_publish_auth_mode":   authModeBlock(1),
+						"default_subscribe_auth_mode": authModeBlock(1),
+						"log_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[eventLogConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cloudwatch_logs_role_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"log_level": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.EventLogLevel](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *apiResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	input := &appsync.CreateApiInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsInV2(ctx)
+
+	output, err := conn.CreateApi(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating AppSync API (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.Api, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *apiResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	output, err := findAPIByID(ctx, conn, data.ApiID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppSync API (%s)", data.ApiID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOutV2(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *apiResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	if !new.EventConfig.Equal(old.EventConfig) ||
+		!new.Name.Equal(old.Name) ||
+		!new.OwnerContact.Equal(old.OwnerContact) {
+		input := &appsync.UpdateApiInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateApi(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppSync API (%s)", new.ApiID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Api, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *apiResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data apiResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppSyncClient(ctx)
+
+	tflog.Debug(ctx, "deleting AppSync API", map[string]interface{}{
+		names.AttrID: data.ApiID.ValueString(),
+	})
+
+	_, err := conn.DeleteApi(ctx, &appsync.DeleteApiInput{
+		ApiId: fwflex.StringFromFramework(ctx, data.ApiID),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppSync API (%s)", data.ApiID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *apiResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAPIByID(ctx context.Context, conn *appsync.Client, id string) (*awstypes.Api, error) {
+	input := &appsync.GetApiInput{
+		ApiId: aws.String(id),
+	}
+
+	output, err := conn.GetApi(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Api == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Api, nil
+}
+
+type apiResourceModel struct {
+	ApiARN       types.String                                      `tfsdk:"arn"`
+	ApiID        types.String                                      `tfsdk:"id"`
+	Dns          fwtypes.MapValueOf[types.String]                  `tfsdk:"dns"`
+	EventConfig  fwtypes.ListNestedObjectValueOf[eventConfigModel] `tfsdk:"event_config"`
+	Name         types.String                                      `tfsdk:"name"`
+	OwnerContact types.String                                      `tfsdk:"owner_contact"`
+	Tags         types.Map                                         `tfsdk:"tags"`
+	TagsAll      types.Map                                         `tfsdk:"tags_all"`
+	WafWebAclArn types.String                                      `tfsdk:"waf_web_acl_arn"`
+	XrayEnabled  types.Bool                                        `tfsdk:"xray_enabled"`
+}
+
+type eventConfigModel struct {
+	AuthProviders             fwtypes.ListNestedObjectValueOf[authProviderModel]   `tfsdk:"auth_provider"`
+	ConnectionAuthModes       fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"connection_auth_mode"`
+	DefaultPublishAuthModes   fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"default_publish_auth_mode"`
+	DefaultSubscribeAuthModes fwtypes.ListNestedObjectValueOf[authModeModel]       `tfsdk:"default_subscribe_auth_mode"`
+	LogConfig                 fwtypes.ListNestedObjectValueOf[eventLogConfigModel] `tfsdk:"log_config"`
+}
+
+type authProviderModel struct {
+	AuthType               fwtypes.StringEnum[awstypes.AuthenticationType]              `tfsdk:"auth_type"`
+	CognitoConfig          fwtypes.ListNestedObjectValueOf[cognitoConfigModel]          `tfsdk:"cognito_config"`
+	LambdaAuthorizerConfig fwtypes.ListNestedObjectValueOf[lambdaAuthorizerConfigModel] `tfsdk:"lambda_authorizer_config"`
+	OpenIDConnectConfig    fwtypes.ListNestedObjectValueOf[openIDConnectConfigModel]    `tfsdk:"openid_connect_config"`
+}
+
+type cognitoConfigModel struct {
+	AppIdClientRegex types.String `tfsdk:"app_id_client_regex"`
+	AwsRegion        types.String `tfsdk:"aws_region"`
+	UserPoolId       types.String `tfsdk:"user_pool_id"`
+}
+
+type lambdaAuthorizerConfigModel struct {
+	AuthorizerResultTtlInSeconds types.Int64  `tfsdk:"authorizer_result_ttl_in_seconds"`
+	AuthorizerUri                types.String `tfsdk:"authorizer_uri"`
+	IdentityValidationExpression types.String `tfsdk:"identity_validation_expression"`
+}
+
+type openIDConnectConfigModel struct {
+	AuthTTL  types.Int64  `tfsdk:"auth_ttl"`
+	ClientId types.String `tfsdk:"client_id"`
+	IatTTL   types.Int64  `tfsdk:"iat_ttl"`
+	Issuer   types.String `tfsdk:"issuer"`
+}
+
+type authModeModel struct {
+	AuthType fwtypes.StringEnum[awstypes.AuthenticationType] `tfsdk:"auth_type"`
+}
+
+type eventLogConfigModel struct {
+	CloudWatchLogsRoleArn fwtypes.ARN                                `tfsdk:"cloudwatch_logs_role_arn"`
+	LogLevel              fwtypes.StringEnum[awstypes.EventLogLevel] `tfsdk:"log_level"`
+}
@@ -0,0 +1,394 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAPI_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Api
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "appsync", regexache.MustCompile(`apis/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "dns.%"),
+					resource.TestCheckResourceAttr(resourceName, "event_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.0.auth_type", "API_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.connection_auth_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.default_publish_auth_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.default_subscribe_auth_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.log_config.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckNoResourceAttr(resourceName, "owner_contact"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAPI_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Api
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappsync.ResourceAPI, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccAPI_eventConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Api
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccAPIConfig_eventConfig(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.1.auth_type", "AMAZON_COGNITO_USER_POOLS"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_config.0.auth_provider.1.cognito_config.0.user_pool_id", "aws_cognito_user_pool.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.auth_provider.2.auth_type", "AWS_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.connection_auth_mode.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.default_publish_auth_mode.0.auth_type", "AWS_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.default_subscribe_auth_mode.0.auth_type", "AMAZON_COGNITO_USER_POOLS"),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.log_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "event_config.0.log_config.0.cloudwatch_logs_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "event_config.0.log_config.0.log_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "owner_contact", "test@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAPI_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Api
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_api.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAPIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAPIConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccAPIConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAPIExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAPIDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appsync_api" {
+				continue
+			}
+
+			_, err := tfappsync.FindAPIByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppSync API %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAPIExists(ctx context.Context, n string, v *awstypes.Api) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		output, err := tfappsync.FindAPIByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAPIConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_api" "test" {
+  name = %[1]q
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "API_KEY"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAPIConfig_eventConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "appsync.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSAppSyncPushToCloudWatchLogs"
+}
+
+resource "aws_appsync_api" "test" {
+  name          = %[1]q
+  owner_contact = "test@example.com"
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    auth_provider {
+      auth_type = "AMAZON_COGNITO_USER_POOLS"
+
+      cognito_config {
+        aws_region   = data.aws_region.current.name
+        user_pool_id = aws_cognito_user_pool.test.id
+      }
+    }
+
+    auth_provider {
+      auth_type = "AWS_IAM"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    connection_auth_mode {
+      auth_type = "AWS_IAM"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "AWS_IAM"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "AMAZON_COGNITO_USER_POOLS"
+    }
+
+    log_config {
+      cloudwatch_logs_role_arn = aws_iam_role.test.arn
+      log_level                = "ERROR"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName)
+}
+
+func testAccAPIConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_api" "test" {
+  name = %[1]q
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "API_KEY"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAPIConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_api" "test" {
+  name = %[1]q
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "API_KEY"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
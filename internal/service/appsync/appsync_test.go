@@ -14,6 +14,12 @@ func TestAccAppSync_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"API": {
+			acctest.CtBasic:      testAccAPI_basic,
+			acctest.CtDisappears: testAccAPI_disappears,
+			"eventConfig":        testAccAPI_eventConfig,
+			"tags":               testAccAPI_tags,
+		},
 		"APIKey": {
 			acctest.CtBasic: testAccAPIKey_basic,
 			"description":   testAccAPIKey_description,
@@ -70,6 +76,8 @@ func TestAccAppSync_serial(t *testing.T) {
 			"introspectionConfig":                                 testAccGraphQLAPI_introspectionConfig,
 			"queryDepthLimit":                                     testAccGraphQLAPI_queryDepthLimit,
 			"resolverCountLimit":                                  testAccGraphQLAPI_resolverCountLimit,
+			"enhancedMetricsConfig":                               testAccGraphQLAPI_enhancedMetricsConfig,
+			"schemaDriftDetection":                                testAccGraphQLAPI_schemaDriftDetection,
 		},
 		"Function": {
 			acctest.CtBasic:           testAccFunction_basic,
@@ -105,6 +113,11 @@ func TestAccAppSync_serial(t *testing.T) {
 			acctest.CtDisappears: testAccDomainName_disappears,
 			"description":        testAccDomainName_description,
 		},
+		"ChannelNamespace": {
+			acctest.CtBasic:      testAccChannelNamespace_basic,
+			acctest.CtDisappears: testAccChannelNamespace_disappears,
+			"authModes":          testAccChannelNamespace_authModes,
+		},
 		"DomainNameAssociation": {
 			acctest.CtBasic:      testAccDomainNameAPIAssociation_basic,
 			acctest.CtDisappears: testAccDomainNameAPIAssociation_disappears,
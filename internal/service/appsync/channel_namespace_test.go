@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappsync "github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccChannelNamespace_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ChannelNamespace
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_channel_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelNamespaceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelNamespaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "api_id", "aws_appsync_api.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "appsync", regexache.MustCompile(`apis/.+/channelNamespace/.+`)),
+					resource.TestCheckNoResourceAttr(resourceName, "code_handlers"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, "test"),
+					resource.TestCheckResourceAttr(resourceName, "publish_auth_mode.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "subscribe_auth_mode.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccChannelNamespace_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ChannelNamespace
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_channel_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelNamespaceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelNamespaceExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfappsync.ResourceChannelNamespace, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccChannelNamespace_authModes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ChannelNamespace
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appsync_channel_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppSyncEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelNamespaceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelNamespaceConfig_authModes(rName, "API_KEY", "AWS_IAM"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelNamespaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_auth_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "publish_auth_mode.0.auth_type", "API_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "subscribe_auth_mode.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "subscribe_auth_mode.0.auth_type", "AWS_IAM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelNamespaceConfig_authModes(rName, "AWS_IAM", "API_KEY"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChannelNamespaceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_auth_mode.0.auth_type", "AWS_IAM"),
+					resource.TestCheckResourceAttr(resourceName, "subscribe_auth_mode.0.auth_type", "API_KEY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelNamespaceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_appsync_channel_namespace" {
+				continue
+			}
+
+			_, err := tfappsync.FindChannelNamespaceByTwoPartKey(ctx, conn, rs.Primary.Attributes["api_id"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("AppSync Channel Namespace %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelNamespaceExists(ctx context.Context, n string, v *awstypes.ChannelNamespace) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppSyncClient(ctx)
+
+		output, err := tfappsync.FindChannelNamespaceByTwoPartKey(ctx, conn, rs.Primary.Attributes["api_id"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccChannelNamespaceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_appsync_api" "test" {
+  name = %[1]q
+
+  event_config {
+    auth_provider {
+      auth_type = "API_KEY"
+    }
+
+    auth_provider {
+      auth_type = "AWS_IAM"
+    }
+
+    connection_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_publish_auth_mode {
+      auth_type = "API_KEY"
+    }
+
+    default_subscribe_auth_mode {
+      auth_type = "API_KEY"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccChannelNamespaceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelNamespaceConfig_base(rName), `
+resource "aws_appsync_channel_namespace" "test" {
+  api_id = aws_appsync_api.test.id
+  name   = "test"
+}
+`)
+}
+
+func testAccChannelNamespaceConfig_authModes(rName, publishAuthType, subscribeAuthType string) string {
+	return acctest.ConfigCompose(testAccChannelNamespaceConfig_base(rName), fmt.Sprintf(`
+resource "aws_appsync_channel_namespace" "test" {
+  api_id = aws_appsync_api.test.id
+  name   = "test"
+
+  publish_auth_mode {
+    auth_type = %[1]q
+  }
+
+  subscribe_auth_mode {
+    auth_type = %[2]q
+  }
+}
+`, publishAuthType, subscribeAuthType))
+}
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appsync
+
+// Exports for use in tests only.
+var (
+	ResourceAPI              = newAPIResource
+	ResourceChannelNamespace = newChannelNamespaceResource
+
+	FindAPIByID                      = findAPIByID
+	FindChannelNamespaceByTwoPartKey = findChannelNamespaceByTwoPartKey
+	PutSchema                        = putSchema
+)
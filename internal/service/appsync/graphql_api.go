@@ -5,6 +5,8 @@ package appsync
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"log"
@@ -136,12 +138,45 @@ func ResourceGraphQLAPI() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(appsync.AuthenticationType_Values(), false),
 			},
+			"detect_schema_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"enhanced_metrics_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_source_level_metrics_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.DataSourceLevelMetricsBehavior_Values(), false),
+						},
+						"operation_level_metrics_config": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.OperationLevelMetricsConfig_Values(), false),
+						},
+						"resolver_level_metrics_behavior": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(appsync.ResolverLevelMetricsBehavior_Values(), false),
+						},
+					},
+				},
+			},
 			"introspection_config": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      appsync.GraphQLApiIntrospectionConfigEnabled,
 				ValidateFunc: validation.StringInSlice(appsync.GraphQLApiIntrospectionConfig_Values(), false),
 			},
+			"introspection_schema_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"lambda_authorizer_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -307,6 +342,10 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 		input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
 	}
 
+	if v, ok := d.GetOk("enhanced_metrics_config"); ok {
+		input.EnhancedMetricsConfig = expandGraphQLAPIEnhancedMetricsConfig(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("lambda_authorizer_config"); ok {
 		input.LambdaAuthorizerConfig = expandGraphQLAPILambdaAuthorizerConfig(v.([]interface{}))
 	}
@@ -355,6 +394,12 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 		if err := putSchema(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+
+		if d.Get("detect_schema_drift").(bool) {
+			if err := setIntrospectionSchemaSHA256(ctx, conn, d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	return append(diags, resourceGraphQLAPIRead(ctx, d, meta)...)
@@ -381,6 +426,9 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 	}
 	d.Set(names.AttrARN, api.Arn)
 	d.Set("authentication_type", api.AuthenticationType)
+	if err := d.Set("enhanced_metrics_config", flattenGraphQLAPIEnhancedMetricsConfig(api.EnhancedMetricsConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting enhanced_metrics_config: %s", err)
+	}
 	if err := d.Set("lambda_authorizer_config", flattenGraphQLAPILambdaAuthorizerConfig(api.LambdaAuthorizerConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting lambda_authorizer_config: %s", err)
 	}
@@ -405,6 +453,25 @@ func resourceGraphQLAPIRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	setTagsOut(ctx, api.Tags)
 
+	// The schema isn't returned by GetGraphqlApi and the introspected schema is formatted differently from the configured one.
+	// Instead, compare a hash of the introspected schema with the one recorded when the schema was last applied.
+	if d.Get("detect_schema_drift").(bool) && d.Get(names.AttrSchema).(string) != "" {
+		liveSchema, err := findIntrospectionSchemaByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AppSync GraphQL API (%s) introspection schema: %s", d.Id(), err)
+		}
+
+		switch hash, recordedHash := schemaSHA256(liveSchema), d.Get("introspection_schema_sha256").(string); {
+		case recordedHash == "":
+			d.Set("introspection_schema_sha256", hash)
+		case hash != recordedHash:
+			// The schema has been changed outside of Terraform.
+			// Surface the live schema so that it's diffed against the configured schema.
+			d.Set(names.AttrSchema, string(liveSchema))
+		}
+	}
+
 	return diags
 }
 
@@ -423,6 +490,10 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.AdditionalAuthenticationProviders = expandGraphQLAPIAdditionalAuthProviders(v.([]interface{}), meta.(*conns.AWSClient).Region)
 		}
 
+		if v, ok := d.GetOk("enhanced_metrics_config"); ok {
+			input.EnhancedMetricsConfig = expandGraphQLAPIEnhancedMetricsConfig(v.([]interface{}))
+		}
+
 		if v, ok := d.GetOk("lambda_authorizer_config"); ok {
 			input.LambdaAuthorizerConfig = expandGraphQLAPILambdaAuthorizerConfig(v.([]interface{}))
 		}
@@ -468,6 +539,16 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 			}
 		}
+
+		if d.HasChanges("detect_schema_drift", names.AttrSchema) {
+			if d.Get("detect_schema_drift").(bool) && d.Get(names.AttrSchema).(string) != "" {
+				if err := setIntrospectionSchemaSHA256(ctx, conn, d); err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+			} else {
+				d.Set("introspection_schema_sha256", "")
+			}
+		}
 	}
 
 	return append(diags, resourceGraphQLAPIRead(ctx, d, meta)...)
@@ -512,6 +593,25 @@ func putSchema(ctx context.Context, conn *appsync.AppSync, apiID, definition str
 	return nil
 }
 
+// setIntrospectionSchemaSHA256 records a hash of the API's introspected schema for later drift detection.
+func setIntrospectionSchemaSHA256(ctx context.Context, conn *appsync.AppSync, d *schema.ResourceData) error {
+	liveSchema, err := findIntrospectionSchemaByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("reading AppSync GraphQL API (%s) introspection schema: %w", d.Id(), err)
+	}
+
+	d.Set("introspection_schema_sha256", schemaSHA256(liveSchema))
+
+	return nil
+}
+
+func schemaSHA256(definition []byte) string {
+	hash := sha256.Sum256(definition)
+
+	return hex.EncodeToString(hash[:])
+}
+
 func FindGraphQLAPIByID(ctx context.Context, conn *appsync.AppSync, id string) (*appsync.GraphqlApi, error) {
 	input := &appsync.GetGraphqlApiInput{
 		ApiId: aws.String(id),
@@ -537,6 +637,33 @@ func FindGraphQLAPIByID(ctx context.Context, conn *appsync.AppSync, id string) (
 	return output.GraphqlApi, nil
 }
 
+func findIntrospectionSchemaByID(ctx context.Context, conn *appsync.AppSync, id string) ([]byte, error) {
+	input := &appsync.GetIntrospectionSchemaInput{
+		ApiId:             aws.String(id),
+		Format:            aws.String(appsync.OutputTypeSdl),
+		IncludeDirectives: aws.Bool(true),
+	}
+
+	output, err := conn.GetIntrospectionSchemaWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, appsync.ErrCodeNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Schema) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Schema, nil
+}
+
 func findSchemaCreationStatusByID(ctx context.Context, conn *appsync.AppSync, id string) (*appsync.GetSchemaCreationStatusOutput, error) {
 	input := &appsync.GetSchemaCreationStatusInput{
 		ApiId: aws.String(id),
@@ -611,6 +738,22 @@ func expandGraphQLAPILogConfig(l []interface{}) *appsync.LogConfig {
 	return logConfig
 }
 
+func expandGraphQLAPIEnhancedMetricsConfig(l []interface{}) *appsync.EnhancedMetricsConfig {
+	if len(l) < 1 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	enhancedMetricsConfig := &appsync.EnhancedMetricsConfig{
+		DataSourceLevelMetricsBehavior: aws.String(m["data_source_level_metrics_behavior"].(string)),
+		OperationLevelMetricsConfig:    aws.String(m["operation_level_metrics_config"].(string)),
+		ResolverLevelMetricsBehavior:   aws.String(m["resolver_level_metrics_behavior"].(string)),
+	}
+
+	return enhancedMetricsConfig
+}
+
 func expandGraphQLAPIOpenIDConnectConfig(l []interface{}) *appsync.OpenIDConnectConfig {
 	if len(l) < 1 || l[0] == nil {
 		return nil
@@ -751,6 +894,20 @@ func flattenGraphQLAPILogConfig(logConfig *appsync.LogConfig) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenGraphQLAPIEnhancedMetricsConfig(enhancedMetricsConfig *appsync.EnhancedMetricsConfig) []interface{} {
+	if enhancedMetricsConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"data_source_level_metrics_behavior": aws.StringValue(enhancedMetricsConfig.DataSourceLevelMetricsBehavior),
+		"operation_level_metrics_config":     aws.StringValue(enhancedMetricsConfig.OperationLevelMetricsConfig),
+		"resolver_level_metrics_behavior":    aws.StringValue(enhancedMetricsConfig.ResolverLevelMetricsBehavior),
+	}
+
+	return []interface{}{m}
+}
+
 func flattenGraphQLAPIOpenIDConnectConfig(openIDConnectConfig *appsync.OpenIDConnectConfig) []interface{} {
 	if openIDConnectConfig == nil {
 		return []interface{}{}
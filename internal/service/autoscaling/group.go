@@ -20,6 +20,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -262,7 +263,8 @@ func resourceGroup() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Elem: &schema.Schema{
-											Type: schema.TypeInt,
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(1, 100),
 										},
 									},
 									"instance_warmup": {
@@ -315,6 +317,11 @@ func resourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -1653,9 +1660,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}), true)
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if v, ok := tfMap["wait_for_completion"].(bool); ok && v {
+				if _, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -2326,6 +2341,7 @@ func statusGroupCapacity(ctx context.Context, conn *autoscaling.Client, elbconn
 
 		nASG := 0
 		nELB := 0
+		var failures []string
 
 		for _, v := range g.Instances {
 			instanceID := aws.ToString(v.InstanceId)
@@ -2333,7 +2349,8 @@ func statusGroupCapacity(ctx context.Context, conn *autoscaling.Client, elbconn
 				continue
 			}
 
-			if aws.ToString(v.HealthStatus) != InstanceHealthStatusHealthy {
+			if healthStatus := aws.ToString(v.HealthStatus); healthStatus != InstanceHealthStatusHealthy {
+				failures = append(failures, fmt.Sprintf("%s: %s (%s)", instanceID, healthStatus, v.LifecycleState))
 				continue
 			}
 
@@ -2372,13 +2389,19 @@ func statusGroupCapacity(ctx context.Context, conn *autoscaling.Client, elbconn
 			}
 		}
 
+		progress := tfresource.CapacityProgress{
+			Current:  nASG,
+			Desired:  int(aws.ToInt32(g.DesiredCapacity)),
+			Failures: failures,
+		}
+
 		err = cb(nASG, nELB)
 
 		if err != nil {
-			return struct{}{}, err.Error(), nil //nolint:nilerr // err is passed via the result State
+			return progress, err.Error(), nil //nolint:nilerr // err is passed via the result State
 		}
 
-		return struct{}{}, "ok", nil
+		return progress, "ok", nil
 	}
 }
 
@@ -2419,6 +2442,37 @@ func statusInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name,
 	}
 }
 
+// statusInstanceRefreshWithProgress is like statusInstanceRefresh but also logs the refresh's progress,
+// so that long-running refreshes don't appear to hang.
+func statusInstanceRefreshWithProgress(ctx context.Context, conn *autoscaling.Client, name, id string) retry.StateRefreshFunc {
+	refresh := statusInstanceRefresh(ctx, conn, name, id)
+
+	return func() (interface{}, string, error) {
+		outputRaw, status, err := refresh()
+
+		if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
+			fields := map[string]any{
+				"auto_scaling_group_name": name,
+				"instance_refresh_id":     id,
+				names.AttrStatus:          status,
+			}
+			if v := output.PercentageComplete; v != nil {
+				fields["percentage_complete"] = aws.ToInt32(v)
+			}
+			if v := output.InstancesToUpdate; v != nil {
+				fields["instances_to_update"] = aws.ToInt32(v)
+			}
+			if v := output.StatusReason; v != nil {
+				fields["status_reason"] = aws.ToString(v)
+			}
+
+			tflog.Info(ctx, "Auto Scaling Group instance refresh in progress", fields)
+		}
+
+		return outputRaw, status, err
+	}
+}
+
 func statusLoadBalancerInStateCount(ctx context.Context, conn *autoscaling.Client, name string, states ...string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findLoadBalancerStates(ctx, conn, name)
@@ -2534,16 +2588,15 @@ func statusWarmPoolInstanceCount(ctx context.Context, conn *autoscaling.Client,
 
 func waitGroupCapacitySatisfied(ctx context.Context, conn *autoscaling.Client, elbconn *elb.ELB, elbv2conn *elbv2.ELBV2, name string, cb func(int, int) error, startTime time.Time, ignoreFailedScalingActivities bool, timeout time.Duration) error {
 	stateConf := &retry.StateChangeConf{
-		Target:  []string{"ok"},
-		Refresh: statusGroupCapacity(ctx, conn, elbconn, elbv2conn, name, cb, startTime, ignoreFailedScalingActivities),
+		Target: []string{"ok"},
+		Refresh: tfresource.WithCapacityProgress(ctx, "aws_autoscaling_group", name, statusGroupCapacity(ctx, conn, elbconn, elbv2conn, name, cb, startTime, ignoreFailedScalingActivities), func(result any) (tfresource.CapacityProgress, bool) {
+			v, ok := result.(tfresource.CapacityProgress)
+			return v, ok
+		}),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(struct{ err error }); ok {
-		tfresource.SetLastError(err, output.err)
-	}
+	_, err := stateConf.WaitForStateContext(ctx)
 
 	return err
 }
@@ -2695,6 +2748,41 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration) (*awstypes.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InstanceRefreshStatusBaking,
+			awstypes.InstanceRefreshStatusInProgress,
+			awstypes.InstanceRefreshStatusPending,
+		),
+		Target: enum.Slice(
+			awstypes.InstanceRefreshStatusSuccessful,
+		),
+		Refresh:    statusInstanceRefreshWithProgress(ctx, conn, name, id),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
+		switch output.Status {
+		case awstypes.InstanceRefreshStatusRollbackInProgress, awstypes.InstanceRefreshStatusRollbackFailed, awstypes.InstanceRefreshStatusRollbackSuccessful:
+			if v := output.RollbackDetails; v != nil && v.RollbackReason != nil {
+				tfresource.SetLastError(err, fmt.Errorf("rolled back: %s", aws.ToString(v.RollbackReason)))
+			} else {
+				tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+			}
+		default:
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.WarmPoolStatusPendingDelete),
@@ -3983,10 +4071,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name s
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.ToString(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefresh(ctx, input)
 		},
@@ -4003,10 +4091,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.ToString(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bcmdataexports
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bcmdataexports/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Table")
+func newDataSourceTable(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceTable{}, nil
+}
+
+const (
+	DSNameTable = "Table Data Source"
+)
+
+type dataSourceTable struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceTable) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_bcmdataexports_table"
+}
+
+func (d *dataSourceTable) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"columns": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[columnData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[columnData](ctx),
+				Computed:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"table_name": schema.StringAttribute{
+				Required: true,
+			},
+			"table_properties": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"table_property_descriptions": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[tablePropertyDescriptionData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[tablePropertyDescriptionData](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceTable) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().BCMDataExportsClient(ctx)
+
+	var data dataSourceTableData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableName := data.TableName.ValueString()
+
+	in := &bcmdataexports.GetTableInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.GetTable(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionReading, DSNameTable, tableName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	table, err := findTableByName(ctx, conn, tableName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.BCMDataExports, create.ErrActionReading, DSNameTable, tableName, err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	resp.Diagnostics.Append(flex.Flatten(ctx, table.TableProperties, &data.TablePropertyDescriptions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(tableName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findTableByName(ctx context.Context, conn *bcmdataexports.Client, name string) (*awstypes.Table, error) {
+	in := &bcmdataexports.ListTablesInput{}
+
+	output, err := findTables(ctx, conn, in, func(v *awstypes.Table) bool {
+		return aws.ToString(v.TableName) == name
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findTables(ctx context.Context, conn *bcmdataexports.Client, in *bcmdataexports.ListTablesInput, filter tfslices.Predicate[*awstypes.Table]) ([]awstypes.Table, error) {
+	var output []awstypes.Table
+
+	pages := bcmdataexports.NewListTablesPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Tables {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type dataSourceTableData struct {
+	Description types.String `tfsdk:"description"`
+	ID          types.String `tfsdk:"id"`
+	// The API returns a table's columns as its schema.
+	Schema                    fwtypes.ListNestedObjectValueOf[columnData]                   `tfsdk:"columns"`
+	TableName                 types.String                                                  `tfsdk:"table_name"`
+	TableProperties           fwtypes.MapValueOf[types.String]                              `tfsdk:"table_properties"`
+	TablePropertyDescriptions fwtypes.ListNestedObjectValueOf[tablePropertyDescriptionData] `tfsdk:"table_property_descriptions"`
+}
+
+type columnData struct {
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+}
+
+type tablePropertyDescriptionData struct {
+	DefaultValue types.String                      `tfsdk:"default_value"`
+	Description  types.String                      `tfsdk:"description"`
+	Name         types.String                      `tfsdk:"name"`
+	ValidValues  fwtypes.ListValueOf[types.String] `tfsdk:"valid_values"`
+}
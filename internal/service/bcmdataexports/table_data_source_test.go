@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bcmdataexports_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBCMDataExportsTableDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bcmdataexports_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDataSourceConfig_basic("FOCUS_1_0_AWS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "table_name", "FOCUS_1_0_AWS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrDescription),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "columns.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "columns.*", map[string]string{
+						names.AttrName: "billed_cost",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBCMDataExportsTableDataSource_tableProperties(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_bcmdataexports_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionNot(t, names.USGovCloudPartitionID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BCMDataExportsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableDataSourceConfig_tableProperties("COST_AND_USAGE_REPORT", "INCLUDE_RESOURCES", "TRUE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "table_properties.INCLUDE_RESOURCES", "TRUE"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "columns.*", map[string]string{
+						names.AttrName: "line_item_resource_id",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "table_property_descriptions.*", map[string]string{
+						names.AttrName: "TIME_GRANULARITY",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccTableDataSourceConfig_basic(tableName string) string {
+	return fmt.Sprintf(`
+data "aws_bcmdataexports_table" "test" {
+  table_name = %[1]q
+}
+`, tableName)
+}
+
+func testAccTableDataSourceConfig_tableProperties(tableName, propertyName, propertyValue string) string {
+	return fmt.Sprintf(`
+data "aws_bcmdataexports_table" "test" {
+  table_name = %[1]q
+
+  table_properties = {
+    %[2]q = %[3]q
+  }
+}
+`, tableName, propertyName, propertyValue)
+}
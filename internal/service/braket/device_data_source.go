@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/braket"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/braket/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_braket_device", name="Device")
+func dataSourceDevice() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeviceRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"capabilities": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"execution_windows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"execution_day": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_end_hour": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_start_hour": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrProviderName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"queue_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"queue": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_priority": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"queue_size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeviceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	output, err := findDeviceByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Device (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.ToString(output.DeviceArn))
+	d.Set(names.AttrARN, output.DeviceArn)
+	d.Set("capabilities", output.DeviceCapabilities)
+	executionWindows, err := flattenDeviceExecutionWindows(aws.ToString(output.DeviceCapabilities))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Device (%s) execution windows: %s", arn, err)
+	}
+	if err := d.Set("execution_windows", executionWindows); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting execution_windows: %s", err)
+	}
+	d.Set(names.AttrName, output.DeviceName)
+	d.Set(names.AttrProviderName, output.ProviderName)
+	if err := d.Set("queue_info", flattenDeviceQueueInfos(output.DeviceQueueInfo)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting queue_info: %s", err)
+	}
+	d.Set(names.AttrStatus, output.DeviceStatus)
+	d.Set(names.AttrType, output.DeviceType)
+
+	return diags
+}
+
+func findDeviceByARN(ctx context.Context, conn *braket.Client, arn string) (*braket.GetDeviceOutput, error) {
+	input := &braket.GetDeviceInput{
+		DeviceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetDevice(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// deviceCapabilities is the subset of the device capabilities JSON document
+// that describes when the device accepts quantum tasks.
+type deviceCapabilities struct {
+	Service struct {
+		ExecutionWindows []struct {
+			ExecutionDay    string `json:"executionDay"`
+			WindowEndHour   string `json:"windowEndHour"`
+			WindowStartHour string `json:"windowStartHour"`
+		} `json:"executionWindows"`
+	} `json:"service"`
+}
+
+func flattenDeviceExecutionWindows(capabilities string) ([]interface{}, error) {
+	if capabilities == "" {
+		return nil, nil
+	}
+
+	var v deviceCapabilities
+	if err := json.Unmarshal([]byte(capabilities), &v); err != nil {
+		return nil, err
+	}
+
+	tfList := make([]interface{}, 0, len(v.Service.ExecutionWindows))
+	for _, apiObject := range v.Service.ExecutionWindows {
+		tfList = append(tfList, map[string]interface{}{
+			"execution_day":     apiObject.ExecutionDay,
+			"window_end_hour":   apiObject.WindowEndHour,
+			"window_start_hour": apiObject.WindowStartHour,
+		})
+	}
+
+	return tfList, nil
+}
+
+func flattenDeviceQueueInfos(apiObjects []awstypes.DeviceQueueInfo) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"queue":          string(apiObject.Queue),
+			"queue_priority": string(apiObject.QueuePriority),
+			"queue_size":     aws.ToString(apiObject.QueueSize),
+		})
+	}
+
+	return tfList
+}
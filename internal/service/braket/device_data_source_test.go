@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBraketDeviceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_braket_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BraketEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BraketServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeviceDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrARN, "arn:aws:braket:::device/quantum-simulator/amazon/sv1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "capabilities"),
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName, "execution_windows.#", 1),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "SV1"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrProviderName, "Amazon Braket"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrType, "SIMULATOR"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDeviceDataSourceConfig_basic = `
+data "aws_braket_device" "test" {
+  arn = "arn:${data.aws_partition.current.partition}:braket:::device/quantum-simulator/amazon/sv1"
+}
+
+data "aws_partition" "current" {}
+`
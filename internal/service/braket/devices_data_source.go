@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package braket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/braket"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/braket/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_braket_devices", name="Devices")
+func dataSourceDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDevicesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrProviderName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DeviceStatus](),
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DeviceType](),
+			},
+		},
+	}
+}
+
+func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BraketClient(ctx)
+
+	input := &braket.SearchDevicesInput{
+		Filters: []awstypes.SearchDevicesFilter{},
+	}
+
+	for attr, filter := range map[string]string{
+		names.AttrName:         "deviceName",
+		names.AttrProviderName: "providerName",
+		names.AttrStatus:       "deviceStatus",
+		names.AttrType:         "deviceType",
+	} {
+		if v, ok := d.GetOk(attr); ok {
+			input.Filters = append(input.Filters, awstypes.SearchDevicesFilter{
+				Name:   aws.String(filter),
+				Values: []string{v.(string)},
+			})
+		}
+	}
+
+	devices, err := findDevices(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Braket Devices: %s", err)
+	}
+
+	var arns, deviceNames []string
+	for _, v := range devices {
+		arns = append(arns, aws.ToString(v.DeviceArn))
+		deviceNames = append(deviceNames, aws.ToString(v.DeviceName))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, deviceNames)
+
+	return diags
+}
+
+func findDevices(ctx context.Context, conn *braket.Client, input *braket.SearchDevicesInput) ([]awstypes.DeviceSummary, error) {
+	var output []awstypes.DeviceSummary
+
+	pages := braket.NewSearchDevicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Devices...)
+	}
+
+	return output, nil
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +34,10 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const (
+	filterExpressionRootElementSchemaLevel = 3
+)
+
 // @SDKResource("aws_budgets_budget")
 // @Tags(identifierAttribute="arn")
 func ResourceBudget() *schema.Resource {
@@ -100,9 +105,10 @@ func ResourceBudget() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.BudgetType](),
 			},
 			"cost_filter": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"filter_expression"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrName: {
@@ -184,6 +190,13 @@ func ResourceBudget() *schema.Resource {
 					},
 				},
 			},
+			"filter_expression": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"cost_filter"},
+				Elem:          filterExpressionElem(filterExpressionRootElementSchemaLevel),
+			},
 			"limit_amount": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -298,6 +311,90 @@ func ResourceBudget() *schema.Resource {
 	}
 }
 
+func filterExpressionElem(level int) *schema.Resource {
+	// This is the non-recursive part of the schema.
+	valuesSchema := func(key *schema.Schema) *schema.Resource {
+		return &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrKey: key,
+				"match_options": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[awstypes.MatchOption](),
+					},
+				},
+				names.AttrValues: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringLenBetween(0, 1024),
+					},
+				},
+			},
+		}
+	}
+
+	expressionSchema := map[string]*schema.Schema{
+		"cost_category": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: valuesSchema(&schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			}),
+		},
+		"dimension": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: valuesSchema(&schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Dimension](),
+			}),
+		},
+		names.AttrTags: {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem: valuesSchema(&schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			}),
+		},
+	}
+
+	if level > 1 {
+		// Add in the recursive part of the schema.
+		// Sets are used so that the order of sub-expressions does not cause a diff.
+		expressionSchema["and"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     filterExpressionElem(level - 1),
+		}
+		expressionSchema["not"] = &schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem:     filterExpressionElem(level - 1),
+		}
+		expressionSchema["or"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     filterExpressionElem(level - 1),
+		}
+	}
+
+	return &schema.Resource{
+		Schema: expressionSchema,
+	}
+}
+
 func resourceBudgetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -378,9 +475,12 @@ func resourceBudgetRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrARN, arn.String())
 	d.Set("budget_type", budget.BudgetType)
 
-	if err := d.Set("cost_filter", convertCostFiltersToMap(budget.CostFilters)); err != nil {
+	if err := d.Set("cost_filter", convertCostFiltersToMap(budget.CostFilters, d.Get("cost_filter").(*schema.Set).List())); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cost_filter: %s", err)
 	}
+	if err := d.Set("filter_expression", flattenFilterExpressions(budget.FilterExpression)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting filter_expression: %s", err)
+	}
 	if err := d.Set("cost_types", flattenCostTypes(budget.CostTypes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting cost_types: %s", err)
 	}
@@ -767,12 +867,34 @@ func flattenCostTypes(costTypes *awstypes.CostTypes) []map[string]interface{} {
 	return []map[string]interface{}{m}
 }
 
-func convertCostFiltersToMap(costFilters map[string][]string) []map[string]interface{} {
+// convertCostFiltersToMap flattens the API's cost filters.
+// The API does not preserve the order of a filter's values, so where the values match those
+// previously configured (ignoring order) the configured order is kept, otherwise they are sorted.
+func convertCostFiltersToMap(costFilters map[string][]string, old []interface{}) []map[string]interface{} {
+	oldValues := make(map[string][]string)
+	for _, v := range old {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		oldValues[tfMap[names.AttrName].(string)] = flex.ExpandStringValueList(tfMap[names.AttrValues].([]interface{}))
+	}
+
 	convertedCostFilters := make([]map[string]interface{}, 0)
 	for k, v := range costFilters {
 		convertedCostFilter := make(map[string]interface{})
-		filterValues := make([]string, 0)
-		filterValues = append(filterValues, v...)
+		filterValues := slices.Clone(v)
+		slices.Sort(filterValues)
+
+		if o, ok := oldValues[k]; ok {
+			sorted := slices.Clone(o)
+			slices.Sort(sorted)
+
+			if slices.Equal(sorted, filterValues) {
+				filterValues = o
+			}
+		}
 
 		convertedCostFilter[names.AttrValues] = filterValues
 		convertedCostFilter[names.AttrName] = k
@@ -782,6 +904,65 @@ func convertCostFiltersToMap(costFilters map[string][]string) []map[string]inter
 	return convertedCostFilters
 }
 
+func flattenFilterExpressions(apiObject *awstypes.Expression) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{flattenFilterExpression(apiObject)}
+}
+
+func flattenFilterExpression(apiObject *awstypes.Expression) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if len(apiObject.And) > 0 {
+		tfMap["and"] = flattenFilterExpressionList(apiObject.And)
+	}
+	if v := apiObject.CostCategories; v != nil {
+		tfMap["cost_category"] = []interface{}{flattenExpressionValues(aws.ToString(v.Key), v.MatchOptions, v.Values)}
+	}
+	if v := apiObject.Dimensions; v != nil {
+		tfMap["dimension"] = []interface{}{flattenExpressionValues(string(v.Key), v.MatchOptions, v.Values)}
+	}
+	if apiObject.Not != nil {
+		tfMap["not"] = []interface{}{flattenFilterExpression(apiObject.Not)}
+	}
+	if len(apiObject.Or) > 0 {
+		tfMap["or"] = flattenFilterExpressionList(apiObject.Or)
+	}
+	if v := apiObject.Tags; v != nil {
+		tfMap[names.AttrTags] = []interface{}{flattenExpressionValues(aws.ToString(v.Key), v.MatchOptions, v.Values)}
+	}
+
+	return tfMap
+}
+
+func flattenFilterExpressionList(apiObjects []awstypes.Expression) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenFilterExpression(&apiObject))
+	}
+
+	return tfList
+}
+
+func flattenExpressionValues(key string, matchOptions []awstypes.MatchOption, values []string) map[string]interface{} {
+	return map[string]interface{}{
+		names.AttrKey:    key,
+		"match_options":  flex.FlattenStringyValueList(matchOptions),
+		names.AttrValues: values,
+	}
+}
+
 func convertPlannedBudgetLimitsToSet(plannedBudgetLimits map[string]awstypes.Spend) []interface{} {
 	if plannedBudgetLimits == nil {
 		return nil
@@ -847,8 +1028,14 @@ func expandBudgetUnmarshal(d *schema.ResourceData) (*awstypes.Budget, error) {
 			End:   budgetTimePeriodEnd,
 			Start: budgetTimePeriodStart,
 		},
-		TimeUnit:    awstypes.TimeUnit(budgetTimeUnit),
-		CostFilters: budgetCostFilters,
+		TimeUnit: awstypes.TimeUnit(budgetTimeUnit),
+	}
+
+	if v, ok := d.GetOk("filter_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		// CostFilters and FilterExpression are mutually exclusive.
+		budget.FilterExpression = expandFilterExpression(v.([]interface{})[0].(map[string]interface{}))
+	} else {
+		budget.CostFilters = budgetCostFilters
 	}
 
 	if v, ok := d.GetOk("auto_adjust_data"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -883,6 +1070,104 @@ func expandBudgetUnmarshal(d *schema.ResourceData) (*awstypes.Budget, error) {
 	return budget, nil
 }
 
+func expandFilterExpression(tfMap map[string]interface{}) *awstypes.Expression {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.Expression{}
+
+	if v, ok := tfMap["and"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.And = expandFilterExpressionList(v.List())
+	}
+
+	if v, ok := tfMap["cost_category"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CostCategories = &awstypes.CostCategoryValues{
+			MatchOptions: expandMatchOptions(tfMap),
+			Values:       expandExpressionValues(tfMap),
+		}
+		if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+			apiObject.CostCategories.Key = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Dimensions = &awstypes.ExpressionDimensionValues{
+			Key:          awstypes.Dimension(tfMap[names.AttrKey].(string)),
+			MatchOptions: expandMatchOptions(tfMap),
+			Values:       expandExpressionValues(tfMap),
+		}
+	}
+
+	if v, ok := tfMap["not"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Not = expandFilterExpression(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["or"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Or = expandFilterExpressionList(v.List())
+	}
+
+	if v, ok := tfMap[names.AttrTags].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Tags = &awstypes.TagValues{
+			MatchOptions: expandMatchOptions(tfMap),
+			Values:       expandExpressionValues(tfMap),
+		}
+		if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+			apiObject.Tags.Key = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandFilterExpressionList(tfList []interface{}) []awstypes.Expression {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.Expression
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := expandFilterExpression(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMatchOptions(tfMap map[string]interface{}) []awstypes.MatchOption {
+	if v, ok := tfMap["match_options"].(*schema.Set); ok && v.Len() > 0 {
+		return flex.ExpandStringyValueSet[awstypes.MatchOption](v)
+	}
+
+	return nil
+}
+
+// expandExpressionValues returns the values sorted, so that the request does not depend on set iteration order.
+func expandExpressionValues(tfMap map[string]interface{}) []string {
+	if v, ok := tfMap[names.AttrValues].(*schema.Set); ok && v.Len() > 0 {
+		values := flex.ExpandStringValueSet(v)
+		slices.Sort(values)
+
+		return values
+	}
+
+	return nil
+}
+
 func expandAutoAdjustData(tfMap map[string]interface{}) *awstypes.AutoAdjustData {
 	if tfMap == nil {
 		return nil
@@ -10,7 +10,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/budgets/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -49,6 +53,128 @@ func TestTimePeriodSecondsToString(t *testing.T) {
 	}
 }
 
+func TestConvertCostFiltersToMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old  []interface{}
+		want []string
+	}{
+		"no prior values": {
+			want: []string{"Amazon EC2", "Amazon Redshift", "Amazon S3"},
+		},
+		"prior values reordered": {
+			old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:   "Service",
+					names.AttrValues: []interface{}{"Amazon S3", "Amazon EC2", "Amazon Redshift"},
+				},
+			},
+			want: []string{"Amazon S3", "Amazon EC2", "Amazon Redshift"},
+		},
+		"prior values changed": {
+			old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:   "Service",
+					names.AttrValues: []interface{}{"Amazon S3", "Amazon EC2"},
+				},
+			},
+			want: []string{"Amazon EC2", "Amazon Redshift", "Amazon S3"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfbudgets.ConvertCostFiltersToMap(map[string][]string{
+				"Service": {"Amazon Redshift", "Amazon S3", "Amazon EC2"},
+			}, testCase.old)
+
+			if len(got) != 1 {
+				t.Fatalf("got %d cost filters, expected 1", len(got))
+			}
+
+			if diff := cmp.Diff(got[0][names.AttrValues], testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandFilterExpression(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, tfbudgets.ResourceBudget().Schema, map[string]interface{}{
+		"filter_expression": []interface{}{
+			map[string]interface{}{
+				"and": []interface{}{
+					map[string]interface{}{
+						"dimension": []interface{}{
+							map[string]interface{}{
+								names.AttrKey:    "SERVICE",
+								names.AttrValues: []interface{}{"Amazon S3", "Amazon EC2"},
+							},
+						},
+					},
+					map[string]interface{}{
+						"not": []interface{}{
+							map[string]interface{}{
+								names.AttrTags: []interface{}{
+									map[string]interface{}{
+										names.AttrKey:    "Environment",
+										"match_options":  []interface{}{"EQUALS"},
+										names.AttrValues: []interface{}{"test"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	got := tfbudgets.ExpandFilterExpression(d.Get("filter_expression").([]interface{})[0].(map[string]interface{}))
+	want := &awstypes.Expression{
+		And: []awstypes.Expression{
+			{
+				Dimensions: &awstypes.ExpressionDimensionValues{
+					Key:    awstypes.DimensionService,
+					Values: []string{"Amazon EC2", "Amazon S3"},
+				},
+			},
+			{
+				Not: &awstypes.Expression{
+					Tags: &awstypes.TagValues{
+						Key:          aws.String("Environment"),
+						MatchOptions: []awstypes.MatchOption{awstypes.MatchOptionEquals},
+						Values:       []string{"test"},
+					},
+				},
+			},
+		},
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreUnexported(awstypes.Expression{}, awstypes.ExpressionDimensionValues{}, awstypes.TagValues{}),
+		// The order of sub-expressions is not significant.
+		cmpopts.SortSlices(func(a, b awstypes.Expression) bool { return a.Dimensions != nil && b.Dimensions == nil }),
+	}
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Flattening the expanded expression must produce the same set hashes as the configuration.
+	if err := d.Set("filter_expression", []interface{}{tfbudgets.FlattenFilterExpression(got)}); err != nil {
+		t.Fatalf("setting filter_expression: %s", err)
+	}
+	if diff := cmp.Diff(want, tfbudgets.ExpandFilterExpression(d.Get("filter_expression").([]interface{})[0].(map[string]interface{})), opts...); diff != "" {
+		t.Errorf("unexpected diff after round trip (+wanted, -got): %s", diff)
+	}
+}
+
 func TestAccBudgetsBudget_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var budget awstypes.Budget
@@ -276,6 +402,59 @@ func TestAccBudgetsBudget_autoAdjustDataHistorical(t *testing.T) {
 	})
 }
 
+func TestAccBudgetsBudget_filterExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var budget awstypes.Budget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_budgets_budget.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BudgetsEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BudgetsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBudgetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBudgetConfig_filterExpression(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBudgetExists(ctx, resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.and.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_expression.0.and.*.dimension.*", map[string]string{
+						names.AttrKey: "SERVICE",
+						"values.#":    acctest.Ct2,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_expression.0.and.*.not.0.tags.*", map[string]string{
+						names.AttrKey: "Environment",
+						"values.#":    acctest.Ct1,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBudgetConfig_filterExpressionUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBudgetExists(ctx, resourceName, &budget),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression.0.or.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_expression.0.or.*.dimension.*", map[string]string{
+						names.AttrKey: "REGION",
+						"values.#":    acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "filter_expression.0.or.*.tags.*", map[string]string{
+						names.AttrKey: "Project",
+						"values.#":    acctest.Ct2,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBudgetsBudget_costTypes(t *testing.T) {
 	ctx := acctest.Context(t)
 	var budget awstypes.Budget
@@ -714,6 +893,65 @@ resource "aws_budgets_budget" "test" {
 `, rName)
 }
 
+func testAccBudgetConfig_filterExpression(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100.0"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  filter_expression {
+    and {
+      dimension {
+        key    = "SERVICE"
+        values = ["Amazon Simple Storage Service", "Amazon Elastic Compute Cloud - Compute"]
+      }
+    }
+
+    and {
+      not {
+        tags {
+          key           = "Environment"
+          match_options = ["EQUALS"]
+          values        = ["test"]
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBudgetConfig_filterExpressionUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_budgets_budget" "test" {
+  name         = %[1]q
+  budget_type  = "COST"
+  limit_amount = "100.0"
+  limit_unit   = "USD"
+  time_unit    = "MONTHLY"
+
+  filter_expression {
+    or {
+      tags {
+        key    = "Project"
+        values = ["beta", "alpha"]
+      }
+    }
+
+    or {
+      dimension {
+        key    = "REGION"
+        values = [%[2]q]
+      }
+    }
+  }
+}
+`, rName, acctest.Region())
+}
+
 func testAccBudgetConfig_costTypes(rName, startDate, endDate string) string {
 	return fmt.Sprintf(`
 resource "aws_budgets_budget" "test" {
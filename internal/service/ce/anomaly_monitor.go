@@ -6,6 +6,7 @@ package ce
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -78,10 +80,35 @@ func resourceAnomalyMonitor() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAnomalyMonitorCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceAnomalyMonitorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+
+	monitorType := config.GetAttr("monitor_type")
+	if !monitorType.IsKnown() || monitorType.IsNull() {
+		return nil
+	}
+
+	switch awstypes.MonitorType(monitorType.AsString()) {
+	case awstypes.MonitorTypeDimensional:
+		if config.GetAttr("monitor_dimension").IsNull() {
+			return fmt.Errorf("monitor_dimension is required when monitor_type is %s", awstypes.MonitorTypeDimensional)
+		}
+	case awstypes.MonitorTypeCustom:
+		if config.GetAttr("monitor_specification").IsNull() {
+			return fmt.Errorf("monitor_specification is required when monitor_type is %s", awstypes.MonitorTypeCustom)
+		}
+	}
+
+	return nil
+}
+
 func resourceAnomalyMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
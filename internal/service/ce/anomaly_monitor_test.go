@@ -157,6 +157,30 @@ func TestAccCEAnomalyMonitor_tags(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalyMonitor_monitorTypeInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalyMonitorDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalyMonitorConfig_monitorTypeOnly(rName, "DIMENSIONAL"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`monitor_dimension is required when monitor_type is DIMENSIONAL`),
+			},
+			{
+				Config:      testAccAnomalyMonitorConfig_monitorTypeOnly(rName, "CUSTOM"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`monitor_specification is required when monitor_type is CUSTOM`),
+			},
+		},
+	})
+}
+
 // An AWS account can only have one anomaly monitor of type DIMENSIONAL. As
 // such, if additional tests are added, they should be combined with the
 // following test in a serial test
@@ -333,3 +357,12 @@ resource "aws_ce_anomaly_monitor" "test" {
 }
 `, rName)
 }
+
+func testAccAnomalyMonitorConfig_monitorTypeOnly(rName, monitorType string) string {
+	return fmt.Sprintf(`
+resource "aws_ce_anomaly_monitor" "test" {
+  name         = %[1]q
+  monitor_type = %[2]q
+}
+`, rName, monitorType)
+}
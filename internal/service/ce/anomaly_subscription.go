@@ -5,13 +5,18 @@ package ce
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strconv"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/costexplorer/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -104,10 +109,98 @@ func resourceAnomalySubscription() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAnomalySubscriptionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceAnomalySubscriptionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.GetRawConfig().GetAttr("threshold_expression"); !v.IsWhollyKnown() || v.IsNull() {
+		return nil
+	}
+
+	if v, ok := d.GetOk("threshold_expression"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := validateThresholdExpression(v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("threshold_expression: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// validateThresholdExpression checks the restrictions that the API places on an anomaly subscription's threshold expression
+// beyond those of a general Cost Explorer expression: either a single anomaly total impact dimension,
+// or an "and" or "or" of them.
+func validateThresholdExpression(tfMap map[string]interface{}) error {
+	for _, k := range []string{"cost_category", "not", names.AttrTags} {
+		if v, ok := tfMap[k].([]interface{}); ok && len(v) > 0 {
+			return fmt.Errorf("%s is not supported", k)
+		}
+	}
+
+	var n int
+	for _, k := range []string{"and", "or"} {
+		v, ok := tfMap[k].(*schema.Set)
+		if !ok || v.Len() == 0 {
+			continue
+		}
+
+		n++
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if err := validateThresholdExpression(tfMap); err != nil {
+				return fmt.Errorf("%s: %w", k, err)
+			}
+
+			if v, ok := tfMap["dimension"].([]interface{}); !ok || len(v) == 0 {
+				return fmt.Errorf("%s: each expression must contain a dimension", k)
+			}
+		}
+	}
+
+	if v, ok := tfMap["dimension"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		if err := validateThresholdExpressionDimension(v[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("dimension: %w", err)
+		}
+	}
+
+	if n != 1 {
+		return errors.New("exactly one of and, dimension or or must be specified")
+	}
+
+	return nil
+}
+
+func validateThresholdExpressionDimension(tfMap map[string]interface{}) error {
+	if key, validKeys := awstypes.Dimension(tfMap[names.AttrKey].(string)), []awstypes.Dimension{awstypes.DimensionAnomalyTotalImpactAbsolute, awstypes.DimensionAnomalyTotalImpactPercentage}; !slices.Contains(validKeys, key) {
+		return fmt.Errorf("key must be one of %s, got %q", validKeys, key)
+	}
+
+	for _, v := range tfMap["match_options"].(*schema.Set).List() {
+		if v := awstypes.MatchOption(v.(string)); v != awstypes.MatchOptionGreaterThanOrEqual {
+			return fmt.Errorf("match_options must be %s, got %q", awstypes.MatchOptionGreaterThanOrEqual, v)
+		}
+	}
+
+	values := tfMap[names.AttrValues].(*schema.Set).List()
+	if len(values) != 1 {
+		return fmt.Errorf("values must contain exactly one threshold, got %d", len(values))
+	}
+
+	if v, err := strconv.ParseFloat(values[0].(string), 64); err != nil || v < 0 {
+		return fmt.Errorf("values must contain a non-negative number, got %q", values[0])
+	}
+
+	return nil
+}
+
 func resourceAnomalySubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
@@ -226,6 +226,78 @@ func TestAccCEAnomalySubscription_Subscriber(t *testing.T) {
 	})
 }
 
+func TestAccCEAnomalySubscription_ThresholdExpression(t *testing.T) {
+	ctx := acctest.Context(t)
+	var subscription awstypes.AnomalySubscription
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "100.0", "GREATER_THAN_OR_EQUAL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(ctx, resourceName, &subscription),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "threshold_expression.0.and.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*", map[string]string{
+						"dimension.0.key":      "ANOMALY_TOTAL_IMPACT_ABSOLUTE",
+						"dimension.0.values.#": acctest.Ct1,
+						"dimension.0.values.0": "100.0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "threshold_expression.0.and.*", map[string]string{
+						"dimension.0.key":      "ANOMALY_TOTAL_IMPACT_PERCENTAGE",
+						"dimension.0.values.#": acctest.Ct1,
+						"dimension.0.values.0": "50.0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_ThresholdExpressionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAnomalySubscriptionDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.CEServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "one hundred", "GREATER_THAN_OR_EQUAL"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`values must contain a non-negative number`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, "100.0", "EQUALS"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`match_options must be GREATER_THAN_OR_EQUAL`),
+			},
+			{
+				Config:      testAccAnomalySubscriptionConfig_thresholdExpressionTags(rName, address),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`tags is not supported`),
+			},
+		},
+	})
+}
+
 func TestAccCEAnomalySubscription_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var subscription awstypes.AnomalySubscription
@@ -580,6 +652,67 @@ resource "aws_ce_anomaly_subscription" "test" {
 `, rName))
 }
 
+func testAccAnomalySubscriptionConfig_thresholdExpressionAnd(rName, address, absoluteValue, matchOption string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_ABSOLUTE"
+        values        = [%[3]q]
+        match_options = [%[4]q]
+      }
+    }
+
+    and {
+      dimension {
+        key           = "ANOMALY_TOTAL_IMPACT_PERCENTAGE"
+        values        = ["50.0"]
+        match_options = ["GREATER_THAN_OR_EQUAL"]
+      }
+    }
+  }
+}
+`, rName, address, absoluteValue, matchOption))
+}
+
+func testAccAnomalySubscriptionConfig_thresholdExpressionTags(rName, address string) string {
+	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name      = %[1]q
+  frequency = "DAILY"
+
+  monitor_arn_list = [
+    aws_ce_anomaly_monitor.test.arn,
+  ]
+
+  subscriber {
+    type    = "EMAIL"
+    address = %[2]q
+  }
+
+  threshold_expression {
+    tags {
+      key    = "CostCenter"
+      values = ["10000"]
+    }
+  }
+}
+`, rName, address))
+}
+
 func testAccAnomalySubscriptionConfig_tags1(rName, address, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccAnomalySubscriptionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ce_anomaly_subscription" "test" {
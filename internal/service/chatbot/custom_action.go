@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Custom Action")
+func newCustomActionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &customActionResource{}, nil
+}
+
+type customActionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *customActionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_chatbot_custom_action"
+}
+
+func (r *customActionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"action_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]{1,64}$`), "must be 1-64 alphanumeric, hyphen or underscore characters"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_-]{1,30}$`), "must be 1-30 alphanumeric, hyphen or underscore characters"),
+				},
+			},
+			"custom_action_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"attachment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customActionAttachmentModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"button_text": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 50),
+							},
+						},
+						"notification_type": schema.StringAttribute{
+							Optional: true,
+						},
+						"variables": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"criteria": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[customActionAttachmentCriteriaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(5),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operator": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.CustomActionAttachmentCriteriaOperator](),
+										Required:   true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+									"variable_name": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customActionDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"command_text": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 5000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *customActionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	input := &chatbot.CreateCustomActionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateCustomAction(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Chatbot Custom Action (%s)", data.ActionName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CustomActionARN = fwflex.StringToFramework(ctx, output.CustomActionArn)
+	data.ID = data.CustomActionARN
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *customActionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	output, err := findCustomActionByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Custom Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *customActionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new customActionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	// UpdateCustomAction replaces the whole custom action, so every argument is sent.
+	input := &chatbot.UpdateCustomActionInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.UpdateCustomAction(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Chatbot Custom Action (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *customActionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data customActionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ChatbotClient(ctx)
+
+	_, err := conn.DeleteCustomAction(ctx, &chatbot.DeleteCustomActionInput{
+		CustomActionArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Chatbot Custom Action (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findCustomActionByARN(ctx context.Context, conn *chatbot.Client, arn string) (*awstypes.CustomAction, error) {
+	input := &chatbot.GetCustomActionInput{
+		CustomActionArn: aws.String(arn),
+	}
+
+	output, err := conn.GetCustomAction(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.CustomAction == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.CustomAction, nil
+}
+
+type customActionResourceModel struct {
+	ActionName      types.String                                                 `tfsdk:"action_name"`
+	AliasName       types.String                                                 `tfsdk:"alias_name"`
+	Attachments     fwtypes.ListNestedObjectValueOf[customActionAttachmentModel] `tfsdk:"attachment"`
+	CustomActionARN types.String                                                 `tfsdk:"custom_action_arn"`
+	Definition      fwtypes.ListNestedObjectValueOf[customActionDefinitionModel] `tfsdk:"definition"`
+	ID              types.String                                                 `tfsdk:"id"`
+}
+
+type customActionAttachmentModel struct {
+	ButtonText       types.String                                                         `tfsdk:"button_text"`
+	Criteria         fwtypes.ListNestedObjectValueOf[customActionAttachmentCriteriaModel] `tfsdk:"criteria"`
+	NotificationType types.String                                                         `tfsdk:"notification_type"`
+	Variables        fwtypes.MapValueOf[types.String]                                     `tfsdk:"variables"`
+}
+
+type customActionAttachmentCriteriaModel struct {
+	Operator     fwtypes.StringEnum[awstypes.CustomActionAttachmentCriteriaOperator] `tfsdk:"operator"`
+	Value        types.String                                                        `tfsdk:"value"`
+	VariableName types.String                                                        `tfsdk:"variable_name"`
+}
+
+type customActionDefinitionModel struct {
+	CommandText types.String `tfsdk:"command_text"`
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotCustomAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CustomAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", acctest.Ct0),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_action_arn"),
+					resource.TestCheckResourceAttr(resourceName, "definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "sts get-caller-identity"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CustomAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceCustomAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotCustomAction_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.CustomAction
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_custom_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomActionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+				),
+			},
+			{
+				Config: testAccCustomActionConfig_attachment(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomActionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", "whoami"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.button_text", "Who am I?"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.notification_type", "CloudWatch"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.0.operator", string(awstypes.CustomActionAttachmentCriteriaOperatorEquals)),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.0.variable_name", "Region"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.criteria.0.value", "us-west-2"),
+					resource.TestCheckResourceAttr(resourceName, "attachment.0.variables.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "definition.0.command_text", "sts get-caller-identity --region $region"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_custom_action" {
+				continue
+			}
+
+			_, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Custom Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomActionExists(ctx context.Context, n string, v *awstypes.CustomAction) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindCustomActionByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCustomActionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+
+  definition {
+    command_text = "sts get-caller-identity"
+  }
+}
+`, rName)
+}
+
+func testAccCustomActionConfig_attachment(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_chatbot_custom_action" "test" {
+  action_name = %[1]q
+  alias_name  = "whoami"
+
+  definition {
+    command_text = "sts get-caller-identity --region $region"
+  }
+
+  attachment {
+    button_text       = "Who am I?"
+    notification_type = "CloudWatch"
+
+    criteria {
+      operator      = "EQUALS"
+      variable_name = "Region"
+      value         = "us-west-2"
+    }
+
+    variables = {
+      region = "event.Region"
+    }
+  }
+}
+`, rName)
+}
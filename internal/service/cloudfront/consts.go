@@ -15,6 +15,11 @@ func (streamType) Values() []streamType {
 	}
 }
 
+const (
+	anycastIPListStatusDeployed  = "Deployed"
+	anycastIPListStatusDeploying = "Deploying"
+)
+
 const (
 	distributionStatusDeployed   = "Deployed"
 	distributionStatusInProgress = "InProgress"
@@ -20,6 +20,13 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAnycastIPListResource,
+			Name:    "Anycast IP List",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory: newContinuousDeploymentPolicyResource,
 			Name:    "Continuous Deployment Policy",
@@ -51,6 +58,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudfront_function",
 			Name:     "Function",
 		},
+		{
+			Factory:  dataSourceFunctionTest,
+			TypeName: "aws_cloudfront_function_test",
+			Name:     "Function Test",
+		},
 		{
 			Factory:  dataSourceLogDeliveryCanonicalUserID,
 			TypeName: "aws_cloudfront_log_delivery_canonical_user_id",
@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudsearch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudsearch_domain", name="Domain")
+func dataSourceDomain() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDomainRead,
+
+		Schema: map[string]*schema.Schema{
+			"analysis_scheme": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithmic_stemming": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"japanese_tokenization_dictionary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"language": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stemming_dictionary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stopwords": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"synonyms": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enforce_https": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"tls_security_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"index_field": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_scheme": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDefaultValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"facet": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"highlight": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"return": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"search": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"sort": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"source_fields": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"multi_az": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scaling_parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"desired_partition_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_replication_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"search_service_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudSearchClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	domain, err := findDomainByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(domain.DomainName))
+	d.Set(names.AttrARN, domain.ARN)
+	if domain.DocService != nil {
+		d.Set("document_service_endpoint", domain.DocService.Endpoint)
+	} else {
+		d.Set("document_service_endpoint", nil)
+	}
+	d.Set("domain_id", domain.DomainId)
+	d.Set(names.AttrName, domain.DomainName)
+	if domain.SearchService != nil {
+		d.Set("search_service_endpoint", domain.SearchService.Endpoint)
+	} else {
+		d.Set("search_service_endpoint", nil)
+	}
+
+	availabilityOptionStatus, err := findAvailabilityOptionsStatusByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) availability options: %s", d.Id(), err)
+	}
+
+	d.Set("multi_az", availabilityOptionStatus.Options)
+
+	endpointOptions, err := findDomainEndpointOptionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) endpoint options: %s", d.Id(), err)
+	}
+
+	if err := d.Set("endpoint_options", []interface{}{flattenDomainEndpointOptions(endpointOptions)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_options: %s", err)
+	}
+
+	scalingParameters, err := findScalingParametersByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) scaling parameters: %s", d.Id(), err)
+	}
+
+	if err := d.Set("scaling_parameters", []interface{}{flattenScalingParameters(scalingParameters)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scaling_parameters: %s", err)
+	}
+
+	indexResults, err := conn.DescribeIndexFields(ctx, &cloudsearch.DescribeIndexFieldsInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) index fields: %s", d.Id(), err)
+	}
+
+	if tfList, err := flattenIndexFieldStatuses(indexResults.IndexFields); err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s): %s", d.Id(), err)
+	} else if err := d.Set("index_field", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting index_field: %s", err)
+	}
+
+	analysisSchemeResults, err := conn.DescribeAnalysisSchemes(ctx, &cloudsearch.DescribeAnalysisSchemesInput{
+		DomainName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudSearch Domain (%s) analysis schemes: %s", d.Id(), err)
+	}
+
+	if err := d.Set("analysis_scheme", flattenAnalysisSchemeStatuses(analysisSchemeResults.AnalysisSchemes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting analysis_scheme: %s", err)
+	}
+
+	return diags
+}
+
+func flattenAnalysisSchemeStatuses(apiObjects []types.AnalysisSchemeStatus) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject.Options == nil || apiObject.Status == nil {
+			continue
+		}
+
+		// Don't read in any analysis schemes that are pending deletion.
+		if aws.ToBool(apiObject.Status.PendingDeletion) {
+			continue
+		}
+
+		tfList = append(tfList, flattenAnalysisScheme(apiObject.Options))
+	}
+
+	return tfList
+}
+
+func flattenAnalysisScheme(apiObject *types.AnalysisScheme) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"language":     apiObject.AnalysisSchemeLanguage,
+		names.AttrName: aws.ToString(apiObject.AnalysisSchemeName),
+	}
+
+	if v := apiObject.AnalysisOptions; v != nil {
+		tfMap["algorithmic_stemming"] = v.AlgorithmicStemming
+		tfMap["japanese_tokenization_dictionary"] = aws.ToString(v.JapaneseTokenizationDictionary)
+		tfMap["stemming_dictionary"] = aws.ToString(v.StemmingDictionary)
+		tfMap["stopwords"] = aws.ToString(v.Stopwords)
+		tfMap["synonyms"] = aws.ToString(v.Synonyms)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudsearch_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudSearchDomainDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudsearch_domain.test"
+	resourceName := "aws_cloudsearch_domain.test"
+	rName := testAccDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudSearchEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudSearchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "analysis_scheme.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "document_service_endpoint", resourceName, "document_service_endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "domain_id", resourceName, "domain_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint_options.#", resourceName, "endpoint_options.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "index_field.#", resourceName, "index_field.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "index_field.*", map[string]string{
+						names.AttrName:  "int_test",
+						names.AttrType:  "int",
+						"default_value": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "index_field.*", map[string]string{
+						names.AttrName: "literal_test",
+						names.AttrType: "literal",
+						"facet":        acctest.CtTrue,
+						"search":       acctest.CtTrue,
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "multi_az", resourceName, "multi_az"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "scaling_parameters.#", resourceName, "scaling_parameters.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "search_service_endpoint", resourceName, "search_service_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDomainDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_indexFields(rName), `
+data "aws_cloudsearch_domain" "test" {
+  name = aws_cloudsearch_domain.test.name
+}
+`)
+}
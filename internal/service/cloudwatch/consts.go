@@ -30,3 +30,61 @@ func missingData_Values() []string {
 		missingDataNotBreaching,
 	}
 }
+
+const (
+	dashboardLogWidgetViewBar        = "bar"
+	dashboardLogWidgetViewPie        = "pie"
+	dashboardLogWidgetViewTable      = "table"
+	dashboardLogWidgetViewTimeSeries = "timeSeries"
+)
+
+func dashboardLogWidgetView_Values() []string {
+	return []string{
+		dashboardLogWidgetViewBar,
+		dashboardLogWidgetViewPie,
+		dashboardLogWidgetViewTable,
+		dashboardLogWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardMetricWidgetViewBar         = "bar"
+	dashboardMetricWidgetViewGauge       = "gauge"
+	dashboardMetricWidgetViewPie         = "pie"
+	dashboardMetricWidgetViewSingleValue = "singleValue"
+	dashboardMetricWidgetViewTimeSeries  = "timeSeries"
+)
+
+func dashboardMetricWidgetView_Values() []string {
+	return []string{
+		dashboardMetricWidgetViewBar,
+		dashboardMetricWidgetViewGauge,
+		dashboardMetricWidgetViewPie,
+		dashboardMetricWidgetViewSingleValue,
+		dashboardMetricWidgetViewTimeSeries,
+	}
+}
+
+const (
+	dashboardTextWidgetBackgroundSolid       = "solid"
+	dashboardTextWidgetBackgroundTransparent = "transparent"
+)
+
+func dashboardTextWidgetBackground_Values() []string {
+	return []string{
+		dashboardTextWidgetBackgroundSolid,
+		dashboardTextWidgetBackgroundTransparent,
+	}
+}
+
+const (
+	insightRuleStateDisabled = "DISABLED"
+	insightRuleStateEnabled  = "ENABLED"
+)
+
+func insightRuleState_Values() []string {
+	return []string{
+		insightRuleStateDisabled,
+		insightRuleStateEnabled,
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_cloudwatch_contributor_insight_rule", name="Contributor Insight Rule")
+// @Tags(identifierAttribute="arn")
+func resourceContributorInsightRule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContributorInsightRuleCreate,
+		ReadWithoutTimeout:   resourceContributorInsightRuleRead,
+		UpdateWithoutTimeout: resourceContributorInsightRuleUpdate,
+		DeleteWithoutTimeout: resourceContributorInsightRuleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"managed_rule": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrResourceARN: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{"rule_definition", "rule_name"},
+				RequiredWith:  []string{"template_name"},
+			},
+			"rule_definition": {
+				Type:                  schema.TypeString,
+				Optional:              true,
+				Computed:              true,
+				ExactlyOneOf:          []string{"rule_definition", "template_name"},
+				RequiredWith:          []string{"rule_name"},
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"rule_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"rule_definition"},
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"rule_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      insightRuleStateEnabled,
+				ValidateFunc: validation.StringInSlice(insightRuleState_Values(), false),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"template_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"rule_definition", "template_name"},
+				RequiredWith: []string{names.AttrResourceARN},
+			},
+		},
+	}
+}
+
+func resourceContributorInsightRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	if v, ok := d.GetOk("template_name"); ok {
+		templateName, resourceARN := v.(string), d.Get(names.AttrResourceARN).(string)
+		input := &cloudwatch.PutManagedInsightRulesInput{
+			ManagedRules: []types.ManagedRule{{
+				ResourceARN:  aws.String(resourceARN),
+				Tags:         getTagsIn(ctx),
+				TemplateName: aws.String(templateName),
+			}},
+		}
+
+		output, err := conn.PutManagedInsightRules(ctx, input)
+
+		if err == nil {
+			err = partialFailuresError(output.Failures)
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudWatch Contributor Insight Rule (%s) from template (%s): %s", resourceARN, templateName, err)
+		}
+
+		// The name of a managed rule is chosen by CloudWatch.
+		rule, err := findManagedInsightRuleByTwoPartKey(ctx, conn, resourceARN, templateName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Insight Rule (%s) from template (%s): %s", resourceARN, templateName, err)
+		}
+
+		d.SetId(aws.ToString(rule.RuleState.RuleName))
+
+		// Managed rules are created enabled.
+		if state := d.Get("rule_state").(string); state != aws.ToString(rule.RuleState.State) {
+			if err := updateInsightRuleState(ctx, conn, d.Id(), state); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	} else {
+		name := d.Get("rule_name").(string)
+		input := &cloudwatch.PutInsightRuleInput{
+			RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+			RuleName:       aws.String(name),
+			RuleState:      aws.String(d.Get("rule_state").(string)),
+			Tags:           getTagsIn(ctx),
+		}
+
+		_, err := conn.PutInsightRule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating CloudWatch Contributor Insight Rule (%s): %s", name, err)
+		}
+
+		d.SetId(name)
+	}
+
+	return append(diags, resourceContributorInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorInsightRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	rule, err := findInsightRuleByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Contributor Insight Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	// Normalize the definition so that formatting differences do not show as drift.
+	definition, err := structure.NormalizeJsonString(aws.ToString(rule.Definition))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "cloudwatch",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("insight-rule/%s", d.Id()),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set("managed_rule", rule.ManagedRule)
+	d.Set("rule_definition", definition)
+	d.Set("rule_name", rule.Name)
+	d.Set("rule_state", rule.State)
+
+	return diags
+}
+
+func resourceContributorInsightRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	// The definition of a managed rule is owned by its template.
+	if d.HasChange("rule_definition") && d.Get("template_name").(string) == "" {
+		input := &cloudwatch.PutInsightRuleInput{
+			RuleDefinition: aws.String(d.Get("rule_definition").(string)),
+			RuleName:       aws.String(d.Id()),
+			RuleState:      aws.String(d.Get("rule_state").(string)),
+		}
+
+		_, err := conn.PutInsightRule(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+		}
+	} else if d.HasChange("rule_state") {
+		if err := updateInsightRuleState(ctx, conn, d.Id(), d.Get("rule_state").(string)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceContributorInsightRuleRead(ctx, d, meta)...)
+}
+
+func resourceContributorInsightRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
+
+	log.Printf("[INFO] Deleting CloudWatch Contributor Insight Rule: %s", d.Id())
+	output, err := conn.DeleteInsightRules(ctx, &cloudwatch.DeleteInsightRulesInput{
+		RuleNames: []string{d.Id()},
+	})
+
+	if err == nil {
+		err = partialFailuresError(output.Failures)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CloudWatch Contributor Insight Rule (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func updateInsightRuleState(ctx context.Context, conn *cloudwatch.Client, name, state string) error {
+	var failures []types.PartialFailure
+
+	switch state {
+	case insightRuleStateEnabled:
+		output, err := conn.EnableInsightRules(ctx, &cloudwatch.EnableInsightRulesInput{
+			RuleNames: []string{name},
+		})
+
+		if err != nil {
+			return fmt.Errorf("enabling CloudWatch Contributor Insight Rule (%s): %w", name, err)
+		}
+
+		failures = output.Failures
+	case insightRuleStateDisabled:
+		output, err := conn.DisableInsightRules(ctx, &cloudwatch.DisableInsightRulesInput{
+			RuleNames: []string{name},
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling CloudWatch Contributor Insight Rule (%s): %w", name, err)
+		}
+
+		failures = output.Failures
+	}
+
+	if err := partialFailuresError(failures); err != nil {
+		return fmt.Errorf("setting CloudWatch Contributor Insight Rule (%s) state to %s: %w", name, state, err)
+	}
+
+	return nil
+}
+
+func findInsightRuleByName(ctx context.Context, conn *cloudwatch.Client, name string) (*types.InsightRule, error) {
+	input := &cloudwatch.DescribeInsightRulesInput{}
+
+	return findInsightRule(ctx, conn, input, func(v *types.InsightRule) bool {
+		return aws.ToString(v.Name) == name
+	})
+}
+
+func findInsightRule(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeInsightRulesInput, filter tfslices.Predicate[*types.InsightRule]) (*types.InsightRule, error) {
+	output, err := findInsightRules(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findInsightRules(ctx context.Context, conn *cloudwatch.Client, input *cloudwatch.DescribeInsightRulesInput, filter tfslices.Predicate[*types.InsightRule]) ([]types.InsightRule, error) {
+	var output []types.InsightRule
+
+	pages := cloudwatch.NewDescribeInsightRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.InsightRules {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findManagedInsightRuleByTwoPartKey(ctx context.Context, conn *cloudwatch.Client, resourceARN, templateName string) (*types.ManagedRuleDescription, error) {
+	input := &cloudwatch.ListManagedInsightRulesInput{
+		ResourceARN: aws.String(resourceARN),
+	}
+	var output []types.ManagedRuleDescription
+
+	pages := cloudwatch.NewListManagedInsightRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ManagedRules {
+			// Templates that have not been used to create a rule are also listed.
+			if aws.ToString(v.TemplateName) == templateName && v.RuleState != nil {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func partialFailuresError(apiObjects []types.PartialFailure) error {
+	return errors.Join(tfslices.ApplyToAll(apiObjects, func(v types.PartialFailure) error {
+		return fmt.Errorf("%s: %s: %s", aws.ToString(v.FailureResource), aws.ToString(v.FailureCode), aws.ToString(v.FailureDescription))
+	})...)
+}
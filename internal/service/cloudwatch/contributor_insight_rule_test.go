@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudwatch "github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchContributorInsightRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "$.ip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "cloudwatch", fmt.Sprintf("insight-rule/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_definition"),
+					resource.TestCheckResourceAttr(resourceName, "rule_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Reformatting the definition does not produce any changes.
+			{
+				Config:   testAccContributorInsightRuleConfig_heredoc(rName, "$.ip"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "$.ip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcloudwatch.ResourceContributorInsightRule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_basic(rName, "$.ip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "rule_definition", regexache.MustCompile(`"\$\.ip"`)),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_state(rName, "$.ip", "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_state(rName, "$.requestId", "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "rule_definition", regexache.MustCompile(`"\$\.requestId"`)),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchContributorInsightRule_template(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cloudwatch_contributor_insight_rule.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContributorInsightRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContributorInsightRuleConfig_template(rName, "ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "managed_rule", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, "aws_vpc_endpoint_service.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_definition"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_name"),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "template_name", "VpcEndpointService-NewConnectionsByEndpointId-v1"),
+				),
+			},
+			{
+				Config: testAccContributorInsightRuleConfig_template(rName, "DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContributorInsightRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rule_state", "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContributorInsightRuleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		_, err := tfcloudwatch.FindInsightRuleByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckContributorInsightRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cloudwatch_contributor_insight_rule" {
+				continue
+			}
+
+			_, err := tfcloudwatch.FindInsightRuleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CloudWatch Contributor Insight Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContributorInsightRuleConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccContributorInsightRuleConfig_basic(rName, key string) string {
+	return testAccContributorInsightRuleConfig_state(rName, key, "ENABLED")
+}
+
+func testAccContributorInsightRuleConfig_state(rName, key, state string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name  = %[1]q
+  rule_state = %[3]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    LogFormat     = "JSON"
+    Contribution = {
+      Keys    = [%[2]q]
+      Filters = []
+    }
+    AggregateOn = "Count"
+  })
+}
+`, rName, key, state))
+}
+
+func testAccContributorInsightRuleConfig_heredoc(rName, key string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name = %[1]q
+
+  rule_definition = <<EOF
+{
+  "AggregateOn": "Count",
+  "Contribution": {
+    "Filters": [],
+    "Keys": [
+      %[2]q
+    ]
+  },
+  "LogFormat": "JSON",
+  "LogGroupNames": [
+    "${aws_cloudwatch_log_group.test.name}"
+  ],
+  "Schema": {
+    "Name": "CloudWatchLogRule",
+    "Version": 1
+  }
+}
+EOF
+}
+`, rName, key))
+}
+
+func testAccContributorInsightRuleConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name = %[1]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    LogFormat     = "JSON"
+    Contribution = {
+      Keys    = ["$.ip"]
+      Filters = []
+    }
+    AggregateOn = "Count"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccContributorInsightRuleConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccContributorInsightRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  rule_name = %[1]q
+
+  rule_definition = jsonencode({
+    Schema = {
+      Name    = "CloudWatchLogRule"
+      Version = 1
+    }
+    LogGroupNames = [aws_cloudwatch_log_group.test.name]
+    LogFormat     = "JSON"
+    Contribution = {
+      Keys    = ["$.ip"]
+      Filters = []
+    }
+    AggregateOn = "Count"
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccContributorInsightRuleConfig_template(rName, state string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  load_balancer_type = "network"
+  name               = %[1]q
+
+  subnets = aws_subnet.test[*].id
+
+  internal                   = true
+  enable_deletion_protection = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = false
+  network_load_balancer_arns = [aws_lb.test.arn]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_cloudwatch_contributor_insight_rule" "test" {
+  template_name = "VpcEndpointService-NewConnectionsByEndpointId-v1"
+  resource_arn  = aws_vpc_endpoint_service.test.arn
+  rule_state    = %[2]q
+}
+`, rName, state))
+}
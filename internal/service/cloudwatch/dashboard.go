@@ -5,7 +5,12 @@ package cloudwatch
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
@@ -33,9 +38,9 @@ func resourceDashboard() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		// Note that we specify both the `dashboard_body` and
-		// the `dashboard_name` as being required, even though
-		// according to the REST API documentation both are
+		// Note that we require the `dashboard_name` and one of
+		// `dashboard_body` or `widget`, even though according to
+		// the REST API documentation both parameters are
 		// optional: http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutDashboard.html#API_PutDashboard_RequestParameters
 		Schema: map[string]*schema.Schema{
 			"dashboard_arn": {
@@ -44,7 +49,9 @@ func resourceDashboard() *schema.Resource {
 			},
 			"dashboard_body": {
 				Type:                  schema.TypeString,
-				Required:              true,
+				Optional:              true,
+				Computed:              true,
+				ExactlyOneOf:          []string{"dashboard_body", "widget"},
 				ValidateFunc:          validation.StringIsJSON,
 				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
 				DiffSuppressOnRefresh: true,
@@ -59,7 +66,180 @@ func resourceDashboard() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validDashboardName,
 			},
+			"widget": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"dashboard_body", "widget"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"height": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"log": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group_names": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										MaxItems: 50,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      dashboardLogWidgetViewTable,
+										ValidateFunc: validation.StringInSlice(dashboardLogWidgetView_Values(), false),
+									},
+								},
+							},
+						},
+						"metric": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"period": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"series": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dimensions": {
+													Type:     schema.TypeMap,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"id": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"label": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"metric_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"namespace": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"period": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validation.IntAtLeast(1),
+												},
+												"stat": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"visible": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  true,
+												},
+											},
+										},
+									},
+									"stacked": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"stat": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"view": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      dashboardMetricWidgetViewTimeSeries,
+										ValidateFunc: validation.StringInSlice(dashboardMetricWidgetView_Values(), false),
+									},
+								},
+							},
+						},
+						"text": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"background": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(dashboardTextWidgetBackground_Values(), false),
+									},
+									"markdown": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"width": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      6,
+							ValidateFunc: validation.IntBetween(1, 24),
+						},
+						"x": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"y": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: resourceDashboardCustomizeDiff,
 	}
 }
 
@@ -68,8 +248,19 @@ func resourceDashboardPut(ctx context.Context, d *schema.ResourceData, meta inte
 	conn := meta.(*conns.AWSClient).CloudWatchClient(ctx)
 
 	name := d.Get("dashboard_name").(string)
+	body := d.Get("dashboard_body").(string)
+
+	if v, ok := d.GetOk("widget"); ok {
+		var err error
+		body, err = expandDashboardWidgets(v.([]interface{}), meta.(*conns.AWSClient).Region)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	input := &cloudwatch.PutDashboardInput{
-		DashboardBody: aws.String(d.Get("dashboard_body").(string)),
+		DashboardBody: aws.String(body),
 		DashboardName: aws.String(name),
 	}
 
@@ -125,6 +316,33 @@ func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// resourceDashboardCustomizeDiff compiles any `widget` blocks into the dashboard body
+// so that the plan shows the body that will be sent and drift in it is detected.
+func resourceDashboardCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("widget") {
+		return d.SetNewComputed("dashboard_body")
+	}
+
+	v, ok := d.GetOk("widget")
+	if !ok {
+		return nil
+	}
+
+	body, err := expandDashboardWidgets(v.([]interface{}), meta.(*conns.AWSClient).Region)
+
+	if err != nil {
+		return err
+	}
+
+	// Only replace the body when the compiled widgets differ from it, so that switching
+	// between `widget` and an equivalent `dashboard_body` produces no changes.
+	if o, _ := d.GetChange("dashboard_body"); verify.JSONStringsEqual(o.(string), body) {
+		return nil
+	}
+
+	return d.SetNew("dashboard_body", body)
+}
+
 func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name string) (*cloudwatch.GetDashboardOutput, error) {
 	input := &cloudwatch.GetDashboardInput{
 		DashboardName: aws.String(name),
@@ -149,3 +367,216 @@ func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name stri
 
 	return output, nil
 }
+
+// expandDashboardWidgets compiles `widget` blocks into a normalized dashboard body.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+func expandDashboardWidgets(tfList []interface{}, region string) (string, error) {
+	apiObjects := make([]interface{}, 0, len(tfList))
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := map[string]interface{}{
+			"height": tfMap["height"].(int),
+			"width":  tfMap["width"].(int),
+			"x":      tfMap["x"].(int),
+			"y":      tfMap["y"].(int),
+		}
+
+		var types []string
+		var err error
+
+		if v, ok := tfMap["log"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			types = append(types, "log")
+			apiObject["type"] = "log"
+			apiObject["properties"] = expandDashboardLogWidgetProperties(v[0].(map[string]interface{}), region)
+		}
+
+		if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			types = append(types, "metric")
+			apiObject["type"] = "metric"
+			apiObject["properties"], err = expandDashboardMetricWidgetProperties(v[0].(map[string]interface{}), region)
+
+			if err != nil {
+				return "", fmt.Errorf("widget.%d.metric: %w", i, err)
+			}
+		}
+
+		if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			types = append(types, "text")
+			apiObject["type"] = "text"
+			apiObject["properties"] = expandDashboardTextWidgetProperties(v[0].(map[string]interface{}))
+		}
+
+		if len(types) != 1 {
+			return "", fmt.Errorf("widget.%d: exactly one of `log`, `metric` or `text` must be specified", i)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"widgets": apiObjects,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(body))
+}
+
+func expandDashboardLogWidgetProperties(tfMap map[string]interface{}, region string) map[string]interface{} {
+	// Logs Insights widgets select their log groups with SOURCE commands prefixed to the query.
+	var sources []string
+	for _, v := range tfMap["log_group_names"].([]interface{}) {
+		sources = append(sources, fmt.Sprintf("SOURCE '%s'", v.(string)))
+	}
+
+	apiObject := map[string]interface{}{
+		"query":  strings.Join(append(sources, tfMap["query"].(string)), " | "),
+		"region": region,
+		"view":   tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject["stacked"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	return apiObject
+}
+
+func expandDashboardMetricWidgetProperties(tfMap map[string]interface{}, region string) (map[string]interface{}, error) {
+	apiObject := map[string]interface{}{
+		"region": region,
+		"view":   tfMap["view"].(string),
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		apiObject["period"] = v
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject["region"] = v
+	}
+
+	if v, ok := tfMap["stacked"].(bool); ok && v {
+		apiObject["stacked"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		apiObject["stat"] = v
+	}
+
+	if v, ok := tfMap["title"].(string); ok && v != "" {
+		apiObject["title"] = v
+	}
+
+	var metrics []interface{}
+
+	for i, v := range tfMap["series"].([]interface{}) {
+		series, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		metric, err := expandDashboardMetricWidgetSeries(series)
+
+		if err != nil {
+			return nil, fmt.Errorf("series.%d: %w", i, err)
+		}
+
+		metrics = append(metrics, metric)
+	}
+
+	apiObject["metrics"] = metrics
+
+	return apiObject, nil
+}
+
+// expandDashboardMetricWidgetSeries returns a metric widget array entry, either
+// [Namespace, MetricName, DimensionName, DimensionValue, ..., {rendering properties}]
+// or [{"expression": ..., rendering properties}].
+func expandDashboardMetricWidgetSeries(tfMap map[string]interface{}) ([]interface{}, error) {
+	expression := tfMap["expression"].(string)
+	metricName, namespace := tfMap["metric_name"].(string), tfMap["namespace"].(string)
+
+	if expression != "" && (metricName != "" || namespace != "") {
+		return nil, errors.New("`expression` conflicts with `metric_name` and `namespace`")
+	}
+
+	if expression == "" && (metricName == "" || namespace == "") {
+		return nil, errors.New("one of `expression`, or `metric_name` and `namespace`, must be specified")
+	}
+
+	options := map[string]interface{}{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		options["id"] = v
+	}
+
+	if v, ok := tfMap["label"].(string); ok && v != "" {
+		options["label"] = v
+	}
+
+	if v, ok := tfMap["period"].(int); ok && v != 0 {
+		options["period"] = v
+	}
+
+	if v, ok := tfMap["stat"].(string); ok && v != "" {
+		options["stat"] = v
+	}
+
+	if v, ok := tfMap["visible"].(bool); ok && !v {
+		options["visible"] = v
+	}
+
+	if expression != "" {
+		options["expression"] = expression
+
+		return []interface{}{options}, nil
+	}
+
+	apiObject := []interface{}{namespace, metricName}
+
+	if v, ok := tfMap["dimensions"].(map[string]interface{}); ok {
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			apiObject = append(apiObject, k, v[k].(string))
+		}
+	}
+
+	if len(options) > 0 {
+		apiObject = append(apiObject, options)
+	}
+
+	return apiObject, nil
+}
+
+func expandDashboardTextWidgetProperties(tfMap map[string]interface{}) map[string]interface{} {
+	apiObject := map[string]interface{}{
+		"markdown": tfMap["markdown"].(string),
+	}
+
+	if v, ok := tfMap["background"].(string); ok && v != "" {
+		apiObject["background"] = v
+	}
+
+	return apiObject
+}
@@ -78,6 +78,186 @@ func TestAccCloudWatchDashboard_disappears(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_widgets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_widgets(rName, "Hi there from Terraform: CloudWatch"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "dashboard_body"),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "3"),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_widgets(rName, "Hi there from Terraform: CloudWatch - updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+					resource.TestCheckResourceAttr(resourceName, "widget.#", "3"),
+				),
+			},
+			// Authoring the same dashboard as raw JSON produces no changes.
+			{
+				Config:   testAccDashboardConfig_widgetsBody(rName, "Hi there from Terraform: CloudWatch - updated"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestExpandDashboardWidgets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		widgets     []interface{}
+		expected    string
+		expectedErr bool
+	}{
+		"empty": {
+			widgets:  []interface{}{},
+			expected: `{"widgets":[]}`,
+		},
+		"text": {
+			widgets: []interface{}{
+				testDashboardWidget(0, 0, "text", map[string]interface{}{
+					"background": "",
+					"markdown":   "Hello world",
+				}),
+			},
+			expected: `{"widgets":[{"height":6,"properties":{"markdown":"Hello world"},"type":"text","width":6,"x":0,"y":0}]}`,
+		},
+		"log": {
+			widgets: []interface{}{
+				testDashboardWidget(0, 6, "log", map[string]interface{}{
+					"log_group_names": []interface{}{"lg1", "lg2"},
+					"query":           "fields @timestamp, @message",
+					"region":          "",
+					"stacked":         false,
+					"title":           "Logs",
+					"view":            "table",
+				}),
+			},
+			expected: `{"widgets":[{"height":6,"properties":{"query":"SOURCE 'lg1' | SOURCE 'lg2' | fields @timestamp, @message","region":"us-west-2","title":"Logs","view":"table"},"type":"log","width":6,"x":0,"y":6}]}`,
+		},
+		"metric": {
+			widgets: []interface{}{
+				testDashboardWidget(6, 0, "metric", map[string]interface{}{
+					"period": 300,
+					"region": "us-east-1",
+					"series": []interface{}{
+						testDashboardMetricSeries(map[string]interface{}{
+							"dimensions":  map[string]interface{}{"InstanceId": "i-012345", "AutoScalingGroupName": "asg"},
+							"id":          "m1",
+							"metric_name": "CPUUtilization",
+							"namespace":   "AWS/EC2",
+							"visible":     false,
+						}),
+						testDashboardMetricSeries(map[string]interface{}{
+							"expression": "m1 * 2",
+							"label":      "Doubled",
+						}),
+					},
+					"stacked": false,
+					"stat":    "Average",
+					"title":   "EC2 Instance CPU",
+					"view":    "timeSeries",
+				}),
+			},
+			expected: `{"widgets":[{"height":6,"properties":{"metrics":[["AWS/EC2","CPUUtilization","AutoScalingGroupName","asg","InstanceId","i-012345",{"id":"m1","visible":false}],[{"expression":"m1 * 2","label":"Doubled"}]],"period":300,"region":"us-east-1","stat":"Average","title":"EC2 Instance CPU","view":"timeSeries"},"type":"metric","width":6,"x":6,"y":0}]}`,
+		},
+		"no type": {
+			widgets: []interface{}{
+				testDashboardWidget(0, 0, "", nil),
+			},
+			expectedErr: true,
+		},
+		"series without metric": {
+			widgets: []interface{}{
+				testDashboardWidget(0, 0, "metric", map[string]interface{}{
+					"period": 0,
+					"region": "",
+					"series": []interface{}{
+						testDashboardMetricSeries(map[string]interface{}{
+							"namespace": "AWS/EC2",
+						}),
+					},
+					"stacked": false,
+					"stat":    "",
+					"title":   "",
+					"view":    "timeSeries",
+				}),
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudwatch.ExpandDashboardWidgets(testCase.widgets, "us-west-2")
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("expandDashboardWidgets() err %t, want %t: %v", got, want, err)
+			}
+
+			if err == nil && got != testCase.expected {
+				t.Errorf("expandDashboardWidgets() = %s, want %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func testDashboardWidget(x, y int, widgetType string, properties map[string]interface{}) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"height": 6,
+		"log":    []interface{}{},
+		"metric": []interface{}{},
+		"text":   []interface{}{},
+		"width":  6,
+		"x":      x,
+		"y":      y,
+	}
+
+	if widgetType != "" {
+		tfMap[widgetType] = []interface{}{properties}
+	}
+
+	return tfMap
+}
+
+func testDashboardMetricSeries(tfMap map[string]interface{}) map[string]interface{} {
+	series := map[string]interface{}{
+		"dimensions":  map[string]interface{}{},
+		"expression":  "",
+		"id":          "",
+		"label":       "",
+		"metric_name": "",
+		"namespace":   "",
+		"period":      0,
+		"stat":        "",
+		"visible":     true,
+	}
+
+	for k, v := range tfMap {
+		series[k] = v
+	}
+
+	return series
+}
+
 func testAccCheckDashboardExists(ctx context.Context, n string, v *cloudwatch.GetDashboardOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -168,3 +348,127 @@ EOF
 }
 `, rName, body)
 }
+
+func testAccDashboardConfig_widgets(rName, markdown string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+
+  widget {
+    x = 0
+    y = 0
+
+    text {
+      markdown = %[2]q
+    }
+  }
+
+  widget {
+    x     = 6
+    y     = 0
+    width = 12
+
+    metric {
+      title  = "Lambda invocations"
+      region = data.aws_region.current.name
+      stat   = "Sum"
+      period = 300
+
+      series {
+        id          = "m1"
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        dimensions = {
+          FunctionName = %[1]q
+        }
+      }
+
+      series {
+        expression = "m1 * 2"
+        label      = "Doubled"
+      }
+    }
+  }
+
+  widget {
+    x      = 0
+    y      = 6
+    width  = 24
+    height = 3
+
+    log {
+      log_group_names = [aws_cloudwatch_log_group.test.name]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+    }
+  }
+}
+`, rName, markdown)
+}
+
+func testAccDashboardConfig_widgetsBody(rName, markdown string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+
+  dashboard_body = jsonencode({
+    widgets = [
+      {
+        type   = "text"
+        x      = 0
+        y      = 0
+        width  = 6
+        height = 6
+
+        properties = {
+          markdown = %[2]q
+        }
+      },
+      {
+        type   = "metric"
+        x      = 6
+        y      = 0
+        width  = 12
+        height = 6
+
+        properties = {
+          title  = "Lambda invocations"
+          region = data.aws_region.current.name
+          stat   = "Sum"
+          period = 300
+          view   = "timeSeries"
+          metrics = [
+            ["AWS/Lambda", "Invocations", "FunctionName", %[1]q, { id = "m1" }],
+            [{ expression = "m1 * 2", label = "Doubled" }],
+          ]
+        }
+      },
+      {
+        type   = "log"
+        x      = 0
+        y      = 6
+        width  = 24
+        height = 3
+
+        properties = {
+          query  = "SOURCE '${aws_cloudwatch_log_group.test.name}' | fields @timestamp, @message | sort @timestamp desc | limit 20"
+          region = data.aws_region.current.name
+          view   = "table"
+        }
+      },
+    ]
+  })
+}
+`, rName, markdown)
+}
@@ -5,13 +5,17 @@ package cloudwatch
 
 // Exports for use in tests only.
 var (
-	ResourceCompositeAlarm = resourceCompositeAlarm
-	ResourceDashboard      = resourceDashboard
-	ResourceMetricAlarm    = resourceMetricAlarm
-	ResourceMetricStream   = resourceMetricStream
+	ResourceCompositeAlarm         = resourceCompositeAlarm
+	ResourceContributorInsightRule = resourceContributorInsightRule
+	ResourceDashboard              = resourceDashboard
+	ResourceMetricAlarm            = resourceMetricAlarm
+	ResourceMetricStream           = resourceMetricStream
 
 	FindCompositeAlarmByName = findCompositeAlarmByName
 	FindDashboardByName      = findDashboardByName
+	FindInsightRuleByName    = findInsightRuleByName
 	FindMetricAlarmByName    = findMetricAlarmByName
 	FindMetricStreamByName   = findMetricStreamByName
+
+	ExpandDashboardWidgets = expandDashboardWidgets
 )
@@ -36,6 +36,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceContributorInsightRule,
+			TypeName: "aws_cloudwatch_contributor_insight_rule",
+			Name:     "Contributor Insight Rule",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceDashboard,
 			TypeName: "aws_cloudwatch_dashboard",
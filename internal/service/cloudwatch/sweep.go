@@ -21,6 +21,11 @@ func RegisterSweepers() {
 		F:    sweepCompositeAlarms,
 	})
 
+	resource.AddTestSweepers("aws_cloudwatch_contributor_insight_rule", &resource.Sweeper{
+		Name: "aws_cloudwatch_contributor_insight_rule",
+		F:    sweepContributorInsightRules,
+	})
+
 	resource.AddTestSweepers("aws_cloudwatch_dashboard", &resource.Sweeper{
 		Name: "aws_cloudwatch_dashboard",
 		F:    sweepDashboards,
@@ -80,6 +85,47 @@ func sweepCompositeAlarms(region string) error {
 	return nil
 }
 
+func sweepContributorInsightRules(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.CloudWatchClient(ctx)
+	input := &cloudwatch.DescribeInsightRulesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatch.NewDescribeInsightRulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Contributor Insight Rule sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Contributor Insight Rules (%s): %w", region, err)
+		}
+
+		for _, v := range page.InsightRules {
+			r := resourceContributorInsightRule()
+			d := r.Data(nil)
+			d.SetId(aws.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Contributor Insight Rules (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepDashboards(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -5,12 +5,14 @@ package codebuild
 
 // Exports for use in tests only.
 var (
+	ResourceFleet            = resourceFleet
 	ResourceProject          = resourceProject
 	ResourceReportGroup      = resourceReportGroup
 	ResourceResourcePolicy   = resourceResourcePolicy
 	ResourceSourceCredential = resourceSourceCredential
 	ResourceWebhook          = resourceWebhook
 
+	FindFleetByARN             = findFleetByARN
 	FindProjectByNameOrARN     = findProjectByNameOrARN
 	FindReportGroupByARN       = findReportGroupByARN
 	FindResourcePolicyByARN    = findResourcePolicyByARN
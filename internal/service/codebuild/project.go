@@ -156,6 +156,14 @@ func resourceProject() *schema.Resource {
 											ValidateDiagFunc: enum.Validate[types.ComputeType](),
 										},
 									},
+									"fleets_allowed": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidARN,
+										},
+									},
 									"maximum_builds_allowed": {
 										Type:         schema.TypeInt,
 										Optional:     true,
@@ -273,6 +281,20 @@ func resourceProject() *schema.Resource {
 								},
 							},
 						},
+						"fleet": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fleet_arn": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"image": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -1330,6 +1352,18 @@ func expandProjectEnvironment(tfMap map[string]interface{}) *types.ProjectEnviro
 		apiObject.ComputeType = types.ComputeType(v)
 	}
 
+	if v, ok := tfMap["fleet"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		projectFleet := &types.ProjectFleet{}
+
+		if v, ok := tfMap["fleet_arn"].(string); ok && v != "" {
+			projectFleet.FleetArn = aws.String(v)
+		}
+
+		apiObject.Fleet = projectFleet
+	}
+
 	if v, ok := tfMap["image"].(string); ok && v != "" {
 		apiObject.Image = aws.String(v)
 	}
@@ -1496,6 +1530,10 @@ func expandBatchRestrictions(tfMap map[string]interface{}) *types.BatchRestricti
 		apiObject.ComputeTypesAllowed = flex.ExpandStringValueList(v)
 	}
 
+	if v, ok := tfMap["fleets_allowed"].([]interface{}); ok && len(v) > 0 {
+		apiObject.FleetsAllowed = flex.ExpandStringValueList(v)
+	}
+
 	if v, ok := tfMap["maximum_builds_allowed"].(int); ok && v != 0 {
 		apiObject.MaximumBuildsAllowed = aws.Int32(int32(v))
 	}
@@ -1797,6 +1835,7 @@ func flattenProjectEnvironment(apiObject *types.ProjectEnvironment) []interface{
 		names.AttrType:                apiObject.Type,
 	}
 
+	tfMap["fleet"] = flattenProjectFleet(apiObject.Fleet)
 	tfMap["image"] = aws.ToString(apiObject.Image)
 	tfMap[names.AttrCertificate] = aws.ToString(apiObject.Certificate)
 	tfMap["privileged_mode"] = aws.ToBool(apiObject.PrivilegedMode)
@@ -1809,6 +1848,18 @@ func flattenProjectEnvironment(apiObject *types.ProjectEnvironment) []interface{
 	return []interface{}{tfMap}
 }
 
+func flattenProjectFleet(apiObject *types.ProjectFleet) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"fleet_arn": aws.ToString(apiObject.FleetArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenRegistryCredential(apiObject *types.RegistryCredential) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -1950,6 +2001,7 @@ func flattenBuildBatchRestrictionsConfig(apiObject *types.BatchRestrictions) []i
 
 	tfMap := map[string]interface{}{
 		"compute_types_allowed":  apiObject.ComputeTypesAllowed,
+		"fleets_allowed":         apiObject.FleetsAllowed,
 		"maximum_builds_allowed": aws.ToInt32(apiObject.MaximumBuildsAllowed),
 	}
 
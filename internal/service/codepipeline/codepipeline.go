@@ -37,6 +37,102 @@ const (
 	gitHubActionConfigurationOAuthToken = "OAuthToken"
 )
 
+func conditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"result": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[types.Result](),
+				},
+				names.AttrRule: {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 5,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrConfiguration: {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"input_artifacts": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.All(
+									validation.StringLenBetween(1, 100),
+									validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
+								),
+							},
+							names.AttrRegion: {
+								Type:     schema.TypeString,
+								Optional: true,
+								Computed: true,
+							},
+							names.AttrRoleARN: {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+							"rule_type_id": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"category": {
+											Type:             schema.TypeString,
+											Required:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleCategory](),
+										},
+										names.AttrOwner: {
+											Type:             schema.TypeString,
+											Optional:         true,
+											ValidateDiagFunc: enum.Validate[types.RuleOwner](),
+										},
+										"provider": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 35),
+												validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+											),
+										},
+										names.AttrVersion: {
+											Type:     schema.TypeString,
+											Optional: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 9),
+												validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_-]+`), ""),
+											),
+										},
+									},
+								},
+							},
+							"timeout_in_minutes": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ValidateFunc: validation.IntBetween(5, 86400),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // @SDKResource("aws_codepipeline", name="Pipeline")
 // @Tags(identifierAttribute="arn")
 func resourcePipeline() *schema.Resource {
@@ -209,6 +305,16 @@ func resourcePipeline() *schema.Resource {
 								},
 							},
 						},
+						"before_entry": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: conditionSchema(),
+								},
+							},
+						},
 						names.AttrName: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -217,6 +323,45 @@ func resourcePipeline() *schema.Resource {
 								validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z_.@-]+`), ""),
 							),
 						},
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: conditionSchema(),
+									"result": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.Result](),
+									},
+									"retry_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retry_mode": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ValidateDiagFunc: enum.Validate[types.StageRetryMode](),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrCondition: conditionSchema(),
+								},
+							},
+						},
 					},
 				},
 			},
@@ -791,10 +936,186 @@ func expandStageDeclaration(tfMap map[string]interface{}) *types.StageDeclaratio
 		apiObject.Actions = expandActionDeclarations(v)
 	}
 
+	if v, ok := tfMap["before_entry"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.BeforeEntry = expandBeforeEntryConditions(v[0].(map[string]interface{}))
+	}
+
 	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
 		apiObject.Name = aws.String(v)
 	}
 
+	if v, ok := tfMap["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnFailure = expandFailureConditions(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["on_success"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.OnSuccess = expandSuccessConditions(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandBeforeEntryConditions(tfMap map[string]interface{}) *types.BeforeEntryConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.BeforeEntryConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandFailureConditions(tfMap map[string]interface{}) *types.FailureConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.FailureConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	if v, ok := tfMap["result"].(string); ok && v != "" {
+		apiObject.Result = types.Result(v)
+	}
+
+	if v, ok := tfMap["retry_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		retryConfiguration := &types.RetryConfiguration{}
+
+		if v, ok := tfMap["retry_mode"].(string); ok && v != "" {
+			retryConfiguration.RetryMode = types.StageRetryMode(v)
+		}
+
+		apiObject.RetryConfiguration = retryConfiguration
+	}
+
+	return apiObject
+}
+
+func expandSuccessConditions(tfMap map[string]interface{}) *types.SuccessConditions {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SuccessConditions{}
+
+	if v, ok := tfMap[names.AttrCondition].([]interface{}); ok && len(v) > 0 {
+		apiObject.Conditions = expandConditions(v)
+	}
+
+	return apiObject
+}
+
+func expandConditions(tfList []interface{}) []types.Condition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.Condition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Condition{}
+
+		if v, ok := tfMap["result"].(string); ok && v != "" {
+			apiObject.Result = types.Result(v)
+		}
+
+		if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 {
+			apiObject.Rules = expandRuleDeclarations(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleDeclarations(tfList []interface{}) []types.RuleDeclaration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.RuleDeclaration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.RuleDeclaration{}
+
+		if v, ok := tfMap[names.AttrConfiguration].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Configuration = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["input_artifacts"].([]interface{}); ok && len(v) > 0 {
+			apiObject.InputArtifacts = expandInputArtifacts(v)
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrRegion].(string); ok && v != "" {
+			apiObject.Region = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["rule_type_id"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RuleTypeId = expandRuleTypeID(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["timeout_in_minutes"].(int); ok && v != 0 {
+			apiObject.TimeoutInMinutes = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandRuleTypeID(tfMap map[string]interface{}) *types.RuleTypeId {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.RuleTypeId{}
+
+	if v, ok := tfMap["category"].(string); ok && v != "" {
+		apiObject.Category = types.RuleCategory(v)
+	}
+
+	if v, ok := tfMap[names.AttrOwner].(string); ok && v != "" {
+		apiObject.Owner = types.RuleOwner(v)
+	}
+
+	if v, ok := tfMap["provider"].(string); ok && v != "" {
+		apiObject.Provider = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
 	return apiObject
 }
 
@@ -1278,13 +1599,93 @@ func flattenStageDeclaration(d *schema.ResourceData, i int, apiObject types.Stag
 		tfMap[names.AttrAction] = flattenActionDeclarations(d, i, v)
 	}
 
+	if v := apiObject.BeforeEntry; v != nil {
+		tfMap["before_entry"] = []interface{}{map[string]interface{}{
+			names.AttrCondition: flattenConditions(v.Conditions),
+		}}
+	}
+
 	if v := apiObject.Name; v != nil {
 		tfMap[names.AttrName] = aws.ToString(v)
 	}
 
+	if v := apiObject.OnFailure; v != nil {
+		tfMap["on_failure"] = []interface{}{flattenFailureConditions(v)}
+	}
+
+	if v := apiObject.OnSuccess; v != nil {
+		tfMap["on_success"] = []interface{}{map[string]interface{}{
+			names.AttrCondition: flattenConditions(v.Conditions),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenFailureConditions(apiObject *types.FailureConditions) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrCondition: flattenConditions(apiObject.Conditions),
+		"result":            apiObject.Result,
+	}
+
+	if v := apiObject.RetryConfiguration; v != nil {
+		tfMap["retry_configuration"] = []interface{}{map[string]interface{}{
+			"retry_mode": v.RetryMode,
+		}}
+	}
+
 	return tfMap
 }
 
+func flattenConditions(apiObjects []types.Condition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"result":       apiObject.Result,
+			names.AttrRule: flattenRuleDeclarations(apiObject.Rules),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRuleDeclarations(apiObjects []types.RuleDeclaration) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrConfiguration: apiObject.Configuration,
+			"input_artifacts":       flattenInputArtifacts(apiObject.InputArtifacts),
+			names.AttrName:          aws.ToString(apiObject.Name),
+			names.AttrRegion:        aws.ToString(apiObject.Region),
+			names.AttrRoleARN:       aws.ToString(apiObject.RoleArn),
+			"timeout_in_minutes":    aws.ToInt32(apiObject.TimeoutInMinutes),
+		}
+
+		if v := apiObject.RuleTypeId; v != nil {
+			tfMap["rule_type_id"] = []interface{}{map[string]interface{}{
+				"category":        v.Category,
+				names.AttrOwner:   v.Owner,
+				"provider":        aws.ToString(v.Provider),
+				names.AttrVersion: aws.ToString(v.Version),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenStageDeclarations(d *schema.ResourceData, apiObjects []types.StageDeclaration) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
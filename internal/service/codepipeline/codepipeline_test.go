@@ -974,6 +974,62 @@ func TestAccCodePipeline_pipelinetype(t *testing.T) {
 	})
 }
 
+func TestAccCodePipeline_stageConditions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var p types.PipelineDeclaration
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_codepipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodePipelineConfig_stageConditions(rName, "FAIL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_type", string(types.PipelineTypeV2)),
+					resource.TestCheckResourceAttr(resourceName, "stage.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "stage.0.before_entry.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.result", "FAIL"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.name", "DeploymentWindow"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.category", "Rule"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.rule_type_id.0.provider", "DeploymentWindow"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.before_entry.0.condition.0.rule.0.configuration.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", "FAIL"),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.0.condition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_success.0.condition.0.rule.0.rule_type_id.0.provider", "DeploymentWindow"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCodePipelineConfig_stageConditions(rName, "ROLLBACK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(ctx, resourceName, &p),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "stage.1.on_failure.0.result", "ROLLBACK"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPipelineExists(ctx context.Context, n string, v *types.PipelineDeclaration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2449,3 +2505,108 @@ resource "aws_codepipeline" "test" {
 }
 `, rName))
 }
+
+func testAccCodePipelineConfig_stageConditions(rName, onFailureResult string) string { // nosemgrep:ci.codepipeline-in-func-name
+	return acctest.ConfigCompose(
+		testAccS3DefaultBucket(rName),
+		testAccServiceIAMRole(rName),
+		fmt.Sprintf(`
+resource "aws_codepipeline" "test" {
+  name          = "test-pipeline-%[1]s"
+  pipeline_type = "V2"
+  role_arn      = aws_iam_role.codepipeline_role.arn
+
+  artifact_store {
+    location = aws_s3_bucket.test.bucket
+    type     = "S3"
+  }
+
+  stage {
+    name = "Source"
+
+    action {
+      name             = "Source"
+      category         = "Source"
+      owner            = "AWS"
+      provider         = "CodeStarSourceConnection"
+      version          = "1"
+      output_artifacts = ["test"]
+
+      configuration = {
+        ConnectionArn    = aws_codestarconnections_connection.test.arn
+        FullRepositoryId = "lifesum-terraform/test"
+        BranchName       = "main"
+      }
+    }
+  }
+
+  stage {
+    name = "Build"
+
+    before_entry {
+      condition {
+        result = "FAIL"
+
+        rule {
+          name = "DeploymentWindow"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "DeploymentWindow"
+            version  = "1"
+          }
+
+          configuration = {
+            Cron     = "* * * * * ?"
+            TimeZone = "UTC"
+          }
+        }
+      }
+    }
+
+    on_success {
+      condition {
+        rule {
+          name = "DeploymentWindow"
+
+          rule_type_id {
+            category = "Rule"
+            owner    = "AWS"
+            provider = "DeploymentWindow"
+            version  = "1"
+          }
+
+          configuration = {
+            Cron     = "* * * * * ?"
+            TimeZone = "UTC"
+          }
+        }
+      }
+    }
+
+    on_failure {
+      result = %[2]q
+    }
+
+    action {
+      name            = "Build"
+      category        = "Build"
+      owner           = "AWS"
+      provider        = "CodeBuild"
+      input_artifacts = ["test"]
+      version         = "1"
+
+      configuration = {
+        ProjectName = "test"
+      }
+    }
+  }
+}
+
+resource "aws_codestarconnections_connection" "test" {
+  name          = %[1]q
+  provider_type = "GitHub"
+}
+`, rName, onFailureResult))
+}
@@ -5,9 +5,13 @@ package codestarconnections
 
 // Exports for use in tests only.
 var (
-	FindConnectionByARN = findConnectionByARN
-	FindHostByARN       = findHostByARN
+	FindConnectionByARN               = findConnectionByARN
+	FindHostByARN                     = findHostByARN
+	FindRepositoryLinkByID            = findRepositoryLinkByID
+	FindSyncConfigurationByTwoPartKey = findSyncConfigurationByTwoPartKey
 
-	ResourceConnection = resourceConnection
-	ResourceHost       = resourceHost
+	ResourceConnection        = resourceConnection
+	ResourceHost              = resourceHost
+	ResourceRepositoryLink    = resourceRepositoryLink
+	ResourceSyncConfiguration = resourceSyncConfiguration
 )
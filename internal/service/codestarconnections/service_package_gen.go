@@ -46,6 +46,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_codestarconnections_host",
 			Name:     "Host",
 		},
+		{
+			Factory:  resourceRepositoryLink,
+			TypeName: "aws_codestarconnections_repository_link",
+			Name:     "Repository Link",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceSyncConfiguration,
+			TypeName: "aws_codestarconnections_sync_configuration",
+			Name:     "Sync Configuration",
+		},
 	}
 }
 
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarconnections
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections"
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	syncConfigurationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_codestarconnections_sync_configuration", name="Sync Configuration")
+func resourceSyncConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSyncConfigurationCreate,
+		ReadWithoutTimeout:   resourceSyncConfigurationRead,
+		UpdateWithoutTimeout: resourceSyncConfigurationUpdate,
+		DeleteWithoutTimeout: resourceSyncConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"branch": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"config_file": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"publish_deployment_status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.PublishDeploymentStatus](),
+			},
+			"repository_link_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrRepositoryName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sync_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.SyncConfigurationType](),
+			},
+			"trigger_resource_update_on": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.TriggerResourceUpdateOn](),
+			},
+		},
+	}
+}
+
+func resourceSyncConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	resourceName, syncType := d.Get("resource_name").(string), d.Get("sync_type").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{resourceName, syncType}, syncConfigurationResourceIDPartCount, false))
+	input := &codestarconnections.CreateSyncConfigurationInput{
+		Branch:           aws.String(d.Get("branch").(string)),
+		ConfigFile:       aws.String(d.Get("config_file").(string)),
+		RepositoryLinkId: aws.String(d.Get("repository_link_id").(string)),
+		ResourceName:     aws.String(resourceName),
+		RoleArn:          aws.String(d.Get(names.AttrRoleARN).(string)),
+		SyncType:         types.SyncConfigurationType(syncType),
+	}
+
+	if v, ok := d.GetOk("publish_deployment_status"); ok {
+		input.PublishDeploymentStatus = types.PublishDeploymentStatus(v.(string))
+	}
+
+	if v, ok := d.GetOk("trigger_resource_update_on"); ok {
+		input.TriggerResourceUpdateOn = types.TriggerResourceUpdateOn(v.(string))
+	}
+
+	_, err := conn.CreateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeStar Connections Sync Configuration (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceSyncConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSyncConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), syncConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findSyncConfigurationByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeStar Connections Sync Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("branch", output.Branch)
+	d.Set("config_file", output.ConfigFile)
+	d.Set("owner_id", output.OwnerId)
+	d.Set("provider_type", output.ProviderType)
+	d.Set("publish_deployment_status", output.PublishDeploymentStatus)
+	d.Set("repository_link_id", output.RepositoryLinkId)
+	d.Set(names.AttrRepositoryName, output.RepositoryName)
+	d.Set("resource_name", output.ResourceName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("sync_type", output.SyncType)
+	d.Set("trigger_resource_update_on", output.TriggerResourceUpdateOn)
+
+	return diags
+}
+
+func resourceSyncConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), syncConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &codestarconnections.UpdateSyncConfigurationInput{
+		ResourceName: aws.String(parts[0]),
+		SyncType:     types.SyncConfigurationType(parts[1]),
+	}
+
+	if d.HasChange("branch") {
+		input.Branch = aws.String(d.Get("branch").(string))
+	}
+
+	if d.HasChange("config_file") {
+		input.ConfigFile = aws.String(d.Get("config_file").(string))
+	}
+
+	if d.HasChange("publish_deployment_status") {
+		input.PublishDeploymentStatus = types.PublishDeploymentStatus(d.Get("publish_deployment_status").(string))
+	}
+
+	if d.HasChange("repository_link_id") {
+		input.RepositoryLinkId = aws.String(d.Get("repository_link_id").(string))
+	}
+
+	if d.HasChange(names.AttrRoleARN) {
+		input.RoleArn = aws.String(d.Get(names.AttrRoleARN).(string))
+	}
+
+	if d.HasChange("trigger_resource_update_on") {
+		input.TriggerResourceUpdateOn = types.TriggerResourceUpdateOn(d.Get("trigger_resource_update_on").(string))
+	}
+
+	_, err = conn.UpdateSyncConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSyncConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSyncConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), syncConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeStar Connections Sync Configuration: %s", d.Id())
+	_, err = conn.DeleteSyncConfiguration(ctx, &codestarconnections.DeleteSyncConfigurationInput{
+		ResourceName: aws.String(parts[0]),
+		SyncType:     types.SyncConfigurationType(parts[1]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeStar Connections Sync Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSyncConfigurationByTwoPartKey(ctx context.Context, conn *codestarconnections.Client, resourceName, syncType string) (*types.SyncConfiguration, error) {
+	input := &codestarconnections.GetSyncConfigurationInput{
+		ResourceName: aws.String(resourceName),
+		SyncType:     types.SyncConfigurationType(syncType),
+	}
+
+	output, err := conn.GetSyncConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SyncConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SyncConfiguration, nil
+}
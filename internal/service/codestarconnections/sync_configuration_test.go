@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codestarconnections_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codestarconnections/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodestarconnections "github.com/hashicorp/terraform-provider-aws/internal/service/codestarconnections"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCodeStarConnectionsSyncConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	owner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	var v types.SyncConfiguration
+	resourceName := "aws_codestarconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repositoryName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "branch", "main"),
+					resource.TestCheckResourceAttr(resourceName, "config_file", "deployment.yaml"),
+					resource.TestCheckResourceAttr(resourceName, "owner_id", owner),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", "DISABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_link_id", "aws_codestarconnections_repository_link.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrRepositoryName, repositoryName),
+					resource.TestCheckResourceAttr(resourceName, "resource_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_type", "CFN_STACK_SYNC"),
+					resource.TestCheckResourceAttr(resourceName, "trigger_resource_update_on", "FILE_CHANGE"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repositoryName, "ENABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish_deployment_status", "ENABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCodeStarConnectionsSyncConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	connectionARN := acctest.SkipIfEnvVarNotSet(t, envVarConnectionARN)
+	owner := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryOwner)
+	repositoryName := acctest.SkipIfEnvVarNotSet(t, envVarRepositoryName)
+	var v types.SyncConfiguration
+	resourceName := "aws_codestarconnections_sync_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeStarConnectionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeStarConnectionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSyncConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repositoryName, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSyncConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodestarconnections.ResourceSyncConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSyncConfigurationExists(ctx context.Context, n string, v *types.SyncConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+		output, err := tfcodestarconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], rs.Primary.Attributes["sync_type"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSyncConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeStarConnectionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codestarconnections_sync_configuration" {
+				continue
+			}
+
+			_, err := tfcodestarconnections.FindSyncConfigurationByTwoPartKey(ctx, conn, rs.Primary.Attributes["resource_name"], rs.Primary.Attributes["sync_type"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeStar Connections Sync Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSyncConfigurationConfig_basic(rName, connectionARN, owner, repositoryName, publishDeploymentStatus string) string {
+	return acctest.ConfigCompose(testAccRepositoryLinkConfig_basic(connectionARN, owner, repositoryName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "cloudformation.sync.codeconnections.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_codestarconnections_sync_configuration" "test" {
+  branch                     = "main"
+  config_file                = "deployment.yaml"
+  publish_deployment_status  = %[2]q
+  repository_link_id         = aws_codestarconnections_repository_link.test.id
+  resource_name              = %[1]q
+  role_arn                   = aws_iam_role.test.arn
+  sync_type                  = "CFN_STACK_SYNC"
+  trigger_resource_update_on = "FILE_CHANGE"
+}
+`, rName, publishDeploymentStatus))
+}
@@ -17,4 +17,6 @@ var (
 	ValidRoleMappingsRulesConfiguration                 = validRoleMappingsRulesConfiguration
 	ValidRoles                                          = validRoles
 	ValidSupportedLoginProviders                        = validSupportedLoginProviders
+
+	PrincipalTagProviderName = principalTagProviderName
 )
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidentity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cognito_identity_pool_provider_mapping", name="Pool Provider Mapping")
+func dataSourcePoolProviderMapping() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePoolProviderMappingRead,
+
+		Schema: map[string]*schema.Schema{
+			"ambiguous_role_resolution": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authenticated_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fallback_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"identity_provider": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"mapping_rule": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"match_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrRoleARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"principal_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_mapping_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unauthenticated_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"use_defaults": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNamePoolProviderMapping = "Pool Provider Mapping Data Source"
+)
+
+func dataSourcePoolProviderMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CognitoIdentityClient(ctx)
+
+	poolID, provider := d.Get("identity_pool_id").(string), d.Get("identity_provider").(string)
+	id := fmt.Sprintf("%s:%s", poolID, provider)
+
+	roles, err := findPoolRolesByID(ctx, conn, poolID)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.CognitoIdentity, create.ErrActionReading, DSNamePoolProviderMapping, id, err)
+	}
+
+	authenticatedRoleARN, unauthenticatedRoleARN := roles.Roles["authenticated"], roles.Roles["unauthenticated"]
+
+	d.SetId(id)
+	d.Set("authenticated_role_arn", authenticatedRoleARN)
+	d.Set("unauthenticated_role_arn", unauthenticatedRoleARN)
+
+	// Without a role mapping, authenticated users of the provider receive the pool's authenticated role.
+	// With one, that role is only used if the mapping cannot choose a role and ambiguous role resolution allows it.
+	if roleMapping, ok := roles.RoleMappings[provider]; ok {
+		d.Set("ambiguous_role_resolution", roleMapping.AmbiguousRoleResolution)
+		d.Set("role_mapping_type", roleMapping.Type)
+
+		if roleMapping.AmbiguousRoleResolution == awstypes.AmbiguousRoleResolutionTypeAuthenticatedRole {
+			d.Set("fallback_role_arn", authenticatedRoleARN)
+		} else {
+			d.Set("fallback_role_arn", "")
+		}
+
+		var rules []interface{}
+		if v := roleMapping.RulesConfiguration; v != nil {
+			rules = flattenIdentityPoolRolesAttachmentMappingRules(v.Rules)
+		}
+		if err := d.Set("mapping_rule", rules); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting mapping_rule: %s", err)
+		}
+	} else {
+		d.Set("ambiguous_role_resolution", "")
+		d.Set("fallback_role_arn", authenticatedRoleARN)
+		d.Set("mapping_rule", nil)
+		d.Set("role_mapping_type", "")
+	}
+
+	principalTags, err := findPrincipalTagAttributeMapByTwoPartKey(ctx, conn, poolID, principalTagProviderName(provider))
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("principal_tags", nil)
+		d.Set("use_defaults", false)
+	case err != nil:
+		return create.AppendDiagError(diags, names.CognitoIdentity, create.ErrActionReading, DSNamePoolProviderMapping, id, err)
+	default:
+		d.Set("principal_tags", principalTags.PrincipalTags)
+		d.Set("use_defaults", principalTags.UseDefaults)
+	}
+
+	return diags
+}
+
+// principalTagProviderName returns the identity provider name under which principal tag attribute mappings are stored.
+// Role mappings for Amazon Cognito user pools are keyed by "<provider name>:<app client ID>",
+// whereas principal tags are set for the user pool as a whole.
+// SAML and OpenID Connect providers use their ARN for both.
+func principalTagProviderName(provider string) string {
+	if strings.HasPrefix(provider, "cognito-idp.") {
+		if i := strings.LastIndex(provider, ":"); i != -1 {
+			return provider[:i]
+		}
+	}
+
+	return provider
+}
+
+func findPoolRolesByID(ctx context.Context, conn *cognitoidentity.Client, id string) (*cognitoidentity.GetIdentityPoolRolesOutput, error) {
+	input := &cognitoidentity.GetIdentityPoolRolesInput{
+		IdentityPoolId: aws.String(id),
+	}
+
+	output, err := conn.GetIdentityPoolRoles(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findPrincipalTagAttributeMapByTwoPartKey(ctx context.Context, conn *cognitoidentity.Client, poolID, providerName string) (*cognitoidentity.GetPrincipalTagAttributeMapOutput, error) {
+	input := &cognitoidentity.GetPrincipalTagAttributeMapInput{
+		IdentityPoolId:       aws.String(poolID),
+		IdentityProviderName: aws.String(providerName),
+	}
+
+	output, err := conn.GetPrincipalTagAttributeMap(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcognitoidentity "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidentity"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPrincipalTagProviderName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu:7d8op0ar6h8ahv4f2ub3cl4gbd": "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+		"cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu":                            "cognito-idp.us-east-1.amazonaws.com/us-east-1_Zr231apJu",
+		"arn:aws:iam::123456789012:saml-provider/example":                                    "arn:aws:iam::123456789012:saml-provider/example",
+		"arn:aws:iam::123456789012:oidc-provider/accounts.example.com":                       "arn:aws:iam::123456789012:oidc-provider/accounts.example.com",
+		"graph.facebook.com": "graph.facebook.com",
+	}
+
+	for provider, want := range testCases {
+		if got := tfcognitoidentity.PrincipalTagProviderName(provider); got != want {
+			t.Errorf("PrincipalTagProviderName(%q) = %q, want %q", provider, got, want)
+		}
+	}
+}
+
+func TestAccCognitoIdentityPoolProviderMappingDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+	dataSourceName := "data.aws_cognito_identity_pool_provider_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIdentityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolProviderMappingDataSourceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ambiguous_role_resolution", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "authenticated_role_arn", "aws_iam_role.authenticated", names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fallback_role_arn", "aws_iam_role.authenticated", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "mapping_rule.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "principal_tags.%", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "role_mapping_type", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "unauthenticated_role_arn", "aws_iam_role.unauthenticated", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCognitoIdentityPoolProviderMappingDataSource_samlRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	name := sdkacctest.RandString(10)
+	idpEntityID := fmt.Sprintf("https://%s", acctest.RandomDomainName())
+	dataSourceName := "data.aws_cognito_identity_pool_provider_mapping.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIdentityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolProviderMappingDataSourceConfig_samlRules(name, idpEntityID, "Deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ambiguous_role_resolution", "Deny"),
+					resource.TestCheckResourceAttr(dataSourceName, "fallback_role_arn", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identity_provider", "aws_iam_saml_provider.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "mapping_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "mapping_rule.0.claim", "department"),
+					resource.TestCheckResourceAttr(dataSourceName, "mapping_rule.0.match_type", "Equals"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mapping_rule.0.role_arn", "aws_iam_role.authenticated", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "mapping_rule.0.value", "engineering"),
+					resource.TestCheckResourceAttr(dataSourceName, "principal_tags.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "principal_tags.department", "department"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_mapping_type", "Rules"),
+					resource.TestCheckResourceAttr(dataSourceName, "use_defaults", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccPoolProviderMappingDataSourceConfig_samlRules(name, idpEntityID, "AuthenticatedRole"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ambiguous_role_resolution", "AuthenticatedRole"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fallback_role_arn", "aws_iam_role.authenticated", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccPoolProviderMappingDataSourceConfig_basic(name string) string {
+	return acctest.ConfigCompose(testAccPoolRolesAttachmentConfig(name), `
+resource "aws_cognito_identity_pool_roles_attachment" "test" {
+  identity_pool_id = aws_cognito_identity_pool.main.id
+
+  roles = {
+    "authenticated"   = aws_iam_role.authenticated.arn
+    "unauthenticated" = aws_iam_role.unauthenticated.arn
+  }
+}
+
+data "aws_cognito_identity_pool_provider_mapping" "test" {
+  identity_pool_id  = aws_cognito_identity_pool_roles_attachment.test.identity_pool_id
+  identity_provider = "graph.facebook.com"
+}
+`)
+}
+
+func testAccPoolProviderMappingDataSourceConfig_samlRules(name, idpEntityID, ambiguousRoleResolution string) string {
+	return acctest.ConfigCompose(fmt.Sprintf(`
+resource "aws_iam_saml_provider" "test" {
+  name                   = "provider-%[1]s"
+  saml_metadata_document = templatefile("./test-fixtures/saml-metadata.xml.tpl", { entity_id = %[2]q })
+}
+
+resource "aws_cognito_identity_pool" "test" {
+  identity_pool_name               = "identity pool %[1]s"
+  allow_unauthenticated_identities = false
+
+  saml_provider_arns = [aws_iam_saml_provider.test.arn]
+}
+
+resource "aws_iam_role" "authenticated" {
+  name = "cognito_authenticated_%[1]s"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Federated = "cognito-identity.amazonaws.com" }
+      Action    = ["sts:AssumeRoleWithWebIdentity", "sts:TagSession"]
+      Condition = {
+        StringEquals = {
+          "cognito-identity.amazonaws.com:aud" = aws_cognito_identity_pool.test.id
+        }
+        "ForAnyValue:StringLike" = {
+          "cognito-identity.amazonaws.com:amr" = "authenticated"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_cognito_identity_pool_roles_attachment" "test" {
+  identity_pool_id = aws_cognito_identity_pool.test.id
+
+  role_mapping {
+    identity_provider         = aws_iam_saml_provider.test.arn
+    ambiguous_role_resolution = %[3]q
+    type                      = "Rules"
+
+    mapping_rule {
+      claim      = "department"
+      match_type = "Equals"
+      role_arn   = aws_iam_role.authenticated.arn
+      value      = "engineering"
+    }
+  }
+
+  roles = {
+    "authenticated" = aws_iam_role.authenticated.arn
+  }
+}
+
+resource "aws_cognito_identity_pool_provider_principal_tag" "test" {
+  identity_pool_id       = aws_cognito_identity_pool.test.id
+  identity_provider_name = aws_iam_saml_provider.test.arn
+  use_defaults           = false
+
+  principal_tags = {
+    department = "department"
+  }
+}
+
+data "aws_cognito_identity_pool_provider_mapping" "test" {
+  identity_pool_id  = aws_cognito_identity_pool_roles_attachment.test.identity_pool_id
+  identity_provider = aws_cognito_identity_pool_provider_principal_tag.test.identity_provider_name
+}
+`, name, idpEntityID, ambiguousRoleResolution))
+}
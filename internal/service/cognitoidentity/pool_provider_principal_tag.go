@@ -75,16 +75,13 @@ func resourcePoolProviderPrincipalTagCreate(ctx context.Context, d *schema.Resou
 	params := &cognitoidentity.SetPrincipalTagAttributeMapInput{
 		IdentityPoolId:       aws.String(poolId),
 		IdentityProviderName: aws.String(providerName),
+		UseDefaults:          aws.Bool(d.Get("use_defaults").(bool)),
 	}
 
 	if v, ok := d.GetOk("principal_tags"); ok {
 		params.PrincipalTags = flex.ExpandStringValueMap(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("use_defaults"); ok {
-		params.UseDefaults = aws.Bool(v.(bool))
-	}
-
 	_, err := conn.SetPrincipalTagAttributeMap(ctx, params)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Cognito Identity Provider Principal Tags: %s", err)
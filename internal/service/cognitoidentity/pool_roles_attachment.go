@@ -96,7 +96,6 @@ func resourcePoolRolesAttachment() *schema.Resource {
 			"roles": {
 				Type:     schema.TypeMap,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: verify.ValidARN,
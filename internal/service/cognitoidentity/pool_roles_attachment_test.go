@@ -15,6 +15,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentity/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -116,6 +117,43 @@ func TestAccCognitoIdentityPoolRolesAttachment_roleMappings(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIdentityPoolRolesAttachment_roles(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_cognito_identity_pool_roles_attachment.test"
+	name := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIdentityServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoolRolesAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolRolesAttachmentConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "roles.%", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "roles.authenticated", "aws_iam_role.authenticated", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccPoolRolesAttachmentConfig_unauthenticated(name),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPoolRolesAttachmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "roles.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "roles.authenticated", "aws_iam_role.authenticated", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "roles.unauthenticated", "aws_iam_role.unauthenticated", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIdentityPoolRolesAttachment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_cognito_identity_pool_roles_attachment.test"
@@ -367,6 +405,19 @@ resource "aws_cognito_identity_pool_roles_attachment" "test" {
 `)
 }
 
+func testAccPoolRolesAttachmentConfig_unauthenticated(name string) string {
+	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name) + `
+resource "aws_cognito_identity_pool_roles_attachment" "test" {
+  identity_pool_id = aws_cognito_identity_pool.main.id
+
+  roles = {
+    "authenticated"   = aws_iam_role.authenticated.arn
+    "unauthenticated" = aws_iam_role.unauthenticated.arn
+  }
+}
+`)
+}
+
 func testAccPoolRolesAttachmentConfig_roleMappings(name string) string {
 	return fmt.Sprintf(testAccPoolRolesAttachmentConfig(name) + `
 resource "aws_cognito_identity_pool_roles_attachment" "test" {
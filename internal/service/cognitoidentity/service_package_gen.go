@@ -32,6 +32,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  dataSourcePoolProviderMapping,
+			TypeName: "aws_cognito_identity_pool_provider_mapping",
+			Name:     "Pool Provider Mapping",
+		},
 	}
 }
 
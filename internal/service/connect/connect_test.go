@@ -143,6 +143,12 @@ func TestAccConnect_serial(t *testing.T) {
 			acctest.CtDisappears: testAccUserHierarchyStructure_disappears,
 			"dataSource_id":      testAccUserHierarchyStructureDataSource_instanceID,
 		},
+		"Users": {
+			acctest.CtBasic:      testAccUsers_basic,
+			acctest.CtDisappears: testAccUsers_disappears,
+			"update":             testAccUsers_update,
+			"hierarchyGroupName": testAccUsers_hierarchyGroupName,
+		},
 		"Vocabulary": {
 			acctest.CtBasic:      testAccVocabulary_basic,
 			acctest.CtDisappears: testAccVocabulary_disappears,
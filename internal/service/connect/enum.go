@@ -53,6 +53,9 @@ const (
 	// ListUserHierarchyGroupsMaxResults Valid Range: Minimum value of 1. Maximum value of 1000.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListUserHierarchyGroups.html
 	ListUserHierarchyGroupsMaxResults = 60
+	// SearchUsersMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_SearchUsers.html
+	SearchUsersMaxResults = 100
 	// SearchVocabulariesMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
 	// https://docs.aws.amazon.com/connect/latest/APIReference/API_SearchVocabularies.html#connect-SearchVocabularies-request-MaxResults
 	SearchVocabulariesMaxResults = 60
@@ -108,3 +108,75 @@ func FindLambdaFunctionAssociationByARNWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindUsersByInstanceIDWithContext(ctx context.Context, conn *connect.Connect, instanceID string) ([]*connect.UserSearchSummary, error) {
+	var result []*connect.UserSearchSummary
+
+	input := &connect.SearchUsersInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(SearchUsersMaxResults),
+	}
+
+	err := conn.SearchUsersPagesWithContext(ctx, input, func(page *connect.SearchUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Users {
+			if v != nil {
+				result = append(result, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func FindUserHierarchyGroupsByInstanceIDWithContext(ctx context.Context, conn *connect.Connect, instanceID string) ([]*connect.HierarchyGroupSummary, error) {
+	var result []*connect.HierarchyGroupSummary
+
+	input := &connect.ListUserHierarchyGroupsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListUserHierarchyGroupsMaxResults),
+	}
+
+	err := conn.ListUserHierarchyGroupsPagesWithContext(ctx, input, func(page *connect.ListUserHierarchyGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.UserHierarchyGroupSummaryList {
+			if v != nil {
+				result = append(result, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
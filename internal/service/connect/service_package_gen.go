@@ -194,6 +194,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserHierarchyStructure,
 			TypeName: "aws_connect_user_hierarchy_structure",
 		},
+		{
+			Factory:  ResourceUsers,
+			TypeName: "aws_connect_users",
+			Name:     "Users",
+		},
 		{
 			Factory:  ResourceVocabulary,
 			TypeName: "aws_connect_vocabulary",
@@ -0,0 +1,615 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_connect_users", name="Users")
+func ResourceUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsersCreate,
+		ReadWithoutTimeout:   resourceUsersRead,
+		UpdateWithoutTimeout: resourceUsersUpdate,
+		DeleteWithoutTimeout: resourceUsersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUsersImport,
+		},
+		Schema: map[string]*schema.Schema{
+			names.AttrInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"user": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_user_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hierarchy_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hierarchy_group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"identity_info": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrEmail: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"first_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+									"last_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						names.AttrPassword: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringLenBetween(8, 64),
+						},
+						"phone_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"after_contact_work_time_limit": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"auto_accept": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"desk_phone_number": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validDeskPhoneNumber,
+									},
+									"phone_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(connect.PhoneType_Values(), false),
+									},
+								},
+							},
+						},
+						"routing_profile_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"security_profile_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"user_arns": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"user_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceUsersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Get(names.AttrInstanceID).(string)
+	tfList := d.Get("user").(*schema.Set).List()
+
+	groupIDs, err := findUserHierarchyGroupIDsByName(ctx, conn, instanceID, tfList)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Connect Users (%s): %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	// Users are created one at a time. A failure doesn't stop the remaining users from being created,
+	// and the users that were created are recorded in state by the read below.
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if err := createUsersUser(ctx, conn, instanceID, tfMap, groupIDs); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating Connect User (%s): %s", tfMap[names.AttrName].(string), err)
+		}
+	}
+
+	return append(diags, resourceUsersRead(ctx, d, meta)...)
+}
+
+func resourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Id()
+	users, err := FindUsersByInstanceIDWithContext(ctx, conn, instanceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Connect Users (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Connect Users (%s): %s", d.Id(), err)
+	}
+
+	tfList := d.Get("user").(*schema.Set).List()
+	oldUsers := usersByName(tfList)
+
+	var groupNames map[string]string
+	if usersReferenceHierarchyGroupByName(tfList) {
+		groups, err := FindUserHierarchyGroupsByInstanceIDWithContext(ctx, conn, instanceID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Users (%s) hierarchy groups: %s", d.Id(), err)
+		}
+
+		groupNames = make(map[string]string)
+		for _, v := range groups {
+			groupNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+		}
+	}
+
+	// Only the users managed by this resource are read; other users in the instance are ignored.
+	tfList = []interface{}{}
+	userARNs := make(map[string]interface{})
+	userIDs := make(map[string]interface{})
+	for _, user := range users {
+		name := aws.StringValue(user.Username)
+		old, ok := oldUsers[name]
+		if !ok {
+			continue
+		}
+
+		tfList = append(tfList, flattenUsersUser(user, old, groupNames))
+		userARNs[name] = aws.StringValue(user.Arn)
+		userIDs[name] = aws.StringValue(user.Id)
+	}
+
+	d.Set(names.AttrInstanceID, instanceID)
+	if err := d.Set("user", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting user: %s", err)
+	}
+	d.Set("user_arns", userARNs)
+	d.Set("user_ids", userIDs)
+
+	return diags
+}
+
+func resourceUsersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Id()
+
+	if d.HasChange("user") {
+		o, n := d.GetChange("user")
+		oldUsers := usersByName(o.(*schema.Set).List())
+		newList := n.(*schema.Set).List()
+		newUsers := usersByName(newList)
+
+		users, err := FindUsersByInstanceIDWithContext(ctx, conn, instanceID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Connect Users (%s): %s", d.Id(), err)
+		}
+
+		userIDs := make(map[string]string)
+		for _, v := range users {
+			userIDs[aws.StringValue(v.Username)] = aws.StringValue(v.Id)
+		}
+
+		groupIDs, err := findUserHierarchyGroupIDsByName(ctx, conn, instanceID, newList)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Connect Users (%s): %s", d.Id(), err)
+		}
+
+		// Delete removed users first to free up capacity in the instance.
+		for name := range oldUsers {
+			if _, ok := newUsers[name]; ok {
+				continue
+			}
+
+			userID, ok := userIDs[name]
+			if !ok {
+				continue
+			}
+
+			if err := deleteUsersUser(ctx, conn, instanceID, userID); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "deleting Connect User (%s): %s", name, err)
+			}
+		}
+
+		for name, tfMap := range newUsers {
+			userID, ok := userIDs[name]
+
+			if !ok {
+				if err := createUsersUser(ctx, conn, instanceID, tfMap, groupIDs); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "creating Connect User (%s): %s", name, err)
+				}
+
+				continue
+			}
+
+			if old, ok := oldUsers[name]; ok {
+				if err := updateUsersUser(ctx, conn, instanceID, userID, old, tfMap, groupIDs); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "updating Connect User (%s): %s", name, err)
+				}
+			}
+		}
+	}
+
+	return append(diags, resourceUsersRead(ctx, d, meta)...)
+}
+
+func resourceUsersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	instanceID := d.Id()
+
+	for name, userID := range d.Get("user_ids").(map[string]interface{}) {
+		if err := deleteUsersUser(ctx, conn, instanceID, userID.(string)); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting Connect User (%s): %s", name, err)
+		}
+	}
+
+	return diags
+}
+
+// resourceUsersImport adopts every user in the instance. Attributes that the API does not return,
+// such as passwords and email addresses, are left empty.
+func resourceUsersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
+
+	users, err := FindUsersByInstanceIDWithContext(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Connect Users (%s): %w", d.Id(), err)
+	}
+
+	tfList := []interface{}{}
+	for _, v := range users {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.StringValue(v.Username),
+		})
+	}
+
+	if err := d.Set("user", tfList); err != nil {
+		return nil, fmt.Errorf("setting user: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func createUsersUser(ctx context.Context, conn *connect.Connect, instanceID string, tfMap map[string]interface{}, groupIDs map[string]string) error {
+	input := &connect.CreateUserInput{
+		InstanceId:         aws.String(instanceID),
+		PhoneConfig:        expandPhoneConfig(tfMap["phone_config"].([]interface{})),
+		RoutingProfileId:   aws.String(tfMap["routing_profile_id"].(string)),
+		SecurityProfileIds: flex.ExpandStringSet(tfMap["security_profile_ids"].(*schema.Set)),
+		Username:           aws.String(tfMap[names.AttrName].(string)),
+	}
+
+	if v, ok := tfMap["directory_user_id"].(string); ok && v != "" {
+		input.DirectoryUserId = aws.String(v)
+	}
+
+	groupID, err := usersHierarchyGroupID(tfMap, groupIDs)
+
+	if err != nil {
+		return err
+	}
+
+	if groupID != "" {
+		input.HierarchyGroupId = aws.String(groupID)
+	}
+
+	if v, ok := tfMap["identity_info"].([]interface{}); ok && len(v) > 0 {
+		input.IdentityInfo = expandIdentityInfo(v)
+	}
+
+	if v, ok := tfMap[names.AttrPassword].(string); ok && v != "" {
+		input.Password = aws.String(v)
+	}
+
+	_, err = conn.CreateUserWithContext(ctx, input)
+
+	return err
+}
+
+// updateUsersUser applies the differences between the old and new configuration of a single user.
+// As with aws_connect_user, changes to directory_user_id and password are not sent to the API.
+func updateUsersUser(ctx context.Context, conn *connect.Connect, instanceID, userID string, old, new map[string]interface{}, groupIDs map[string]string) error {
+	if old["hierarchy_group_id"].(string) != new["hierarchy_group_id"].(string) || old["hierarchy_group_name"].(string) != new["hierarchy_group_name"].(string) {
+		groupID, err := usersHierarchyGroupID(new, groupIDs)
+
+		if err != nil {
+			return err
+		}
+
+		input := &connect.UpdateUserHierarchyInput{
+			InstanceId: aws.String(instanceID),
+			UserId:     aws.String(userID),
+		}
+
+		if groupID != "" {
+			input.HierarchyGroupId = aws.String(groupID)
+		}
+
+		if _, err := conn.UpdateUserHierarchyWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating hierarchy group: %w", err)
+		}
+	}
+
+	if !reflect.DeepEqual(old["identity_info"], new["identity_info"]) {
+		input := &connect.UpdateUserIdentityInfoInput{
+			IdentityInfo: expandIdentityInfo(new["identity_info"].([]interface{})),
+			InstanceId:   aws.String(instanceID),
+			UserId:       aws.String(userID),
+		}
+
+		if _, err := conn.UpdateUserIdentityInfoWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating identity info: %w", err)
+		}
+	}
+
+	if !reflect.DeepEqual(old["phone_config"], new["phone_config"]) {
+		input := &connect.UpdateUserPhoneConfigInput{
+			InstanceId:  aws.String(instanceID),
+			PhoneConfig: expandPhoneConfig(new["phone_config"].([]interface{})),
+			UserId:      aws.String(userID),
+		}
+
+		if _, err := conn.UpdateUserPhoneConfigWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating phone config: %w", err)
+		}
+	}
+
+	if old["routing_profile_id"].(string) != new["routing_profile_id"].(string) {
+		input := &connect.UpdateUserRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(new["routing_profile_id"].(string)),
+			UserId:           aws.String(userID),
+		}
+
+		if _, err := conn.UpdateUserRoutingProfileWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating routing profile: %w", err)
+		}
+	}
+
+	if !old["security_profile_ids"].(*schema.Set).Equal(new["security_profile_ids"]) {
+		input := &connect.UpdateUserSecurityProfilesInput{
+			InstanceId:         aws.String(instanceID),
+			SecurityProfileIds: flex.ExpandStringSet(new["security_profile_ids"].(*schema.Set)),
+			UserId:             aws.String(userID),
+		}
+
+		if _, err := conn.UpdateUserSecurityProfilesWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating security profiles: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func deleteUsersUser(ctx context.Context, conn *connect.Connect, instanceID, userID string) error {
+	_, err := conn.DeleteUserWithContext(ctx, &connect.DeleteUserInput{
+		InstanceId: aws.String(instanceID),
+		UserId:     aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	return err
+}
+
+// findUserHierarchyGroupIDsByName returns the instance's hierarchy group IDs keyed by group name.
+// The groups are listed once, and only if a user references a hierarchy group by name.
+func findUserHierarchyGroupIDsByName(ctx context.Context, conn *connect.Connect, instanceID string, tfList []interface{}) (map[string]string, error) {
+	if !usersReferenceHierarchyGroupByName(tfList) {
+		return nil, nil
+	}
+
+	groups, err := FindUserHierarchyGroupsByInstanceIDWithContext(ctx, conn, instanceID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading hierarchy groups: %w", err)
+	}
+
+	groupIDs := make(map[string]string)
+	for _, v := range groups {
+		name := aws.StringValue(v.Name)
+
+		// An empty ID marks a name shared by more than one group.
+		if _, ok := groupIDs[name]; ok {
+			groupIDs[name] = ""
+			continue
+		}
+
+		groupIDs[name] = aws.StringValue(v.Id)
+	}
+
+	return groupIDs, nil
+}
+
+func usersHierarchyGroupID(tfMap map[string]interface{}, groupIDs map[string]string) (string, error) {
+	name, _ := tfMap["hierarchy_group_name"].(string)
+
+	if name == "" {
+		groupID, _ := tfMap["hierarchy_group_id"].(string)
+
+		return groupID, nil
+	}
+
+	if v, _ := tfMap["hierarchy_group_id"].(string); v != "" {
+		return "", fmt.Errorf("only one of hierarchy_group_id or hierarchy_group_name can be set")
+	}
+
+	groupID, ok := groupIDs[name]
+
+	if !ok {
+		return "", fmt.Errorf("hierarchy group (%s) not found", name)
+	}
+
+	if groupID == "" {
+		return "", fmt.Errorf("more than one hierarchy group is named %s", name)
+	}
+
+	return groupID, nil
+}
+
+func usersReferenceHierarchyGroupByName(tfList []interface{}) bool {
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			if v, ok := tfMap["hierarchy_group_name"].(string); ok && v != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func usersByName(tfList []interface{}) map[string]map[string]interface{} {
+	tfMaps := make(map[string]map[string]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tfMaps[tfMap[names.AttrName].(string)] = tfMap
+	}
+
+	return tfMaps
+}
+
+func flattenUsersUser(user *connect.UserSearchSummary, old map[string]interface{}, groupNames map[string]string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"directory_user_id":    aws.StringValue(user.DirectoryUserId),
+		"hierarchy_group_id":   "",
+		"hierarchy_group_name": "",
+		names.AttrName:         aws.StringValue(user.Username),
+		"phone_config":         flattenPhoneConfig(user.PhoneConfig),
+		"routing_profile_id":   aws.StringValue(user.RoutingProfileId),
+		"security_profile_ids": flex.FlattenStringSet(user.SecurityProfileIds),
+	}
+
+	if v, ok := old["hierarchy_group_name"].(string); ok && v != "" {
+		tfMap["hierarchy_group_name"] = groupNames[aws.StringValue(user.HierarchyGroupId)]
+	} else {
+		tfMap["hierarchy_group_id"] = aws.StringValue(user.HierarchyGroupId)
+	}
+
+	// The search API doesn't return email addresses or passwords, so the values in state are kept.
+	var email string
+	if v, ok := old["identity_info"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		email, _ = v[0].(map[string]interface{})[names.AttrEmail].(string)
+	}
+
+	tfMap["identity_info"] = flattenUsersIdentityInfo(user.IdentityInfo, email)
+
+	if v, ok := old[names.AttrPassword].(string); ok {
+		tfMap[names.AttrPassword] = v
+	}
+
+	return tfMap
+}
+
+func flattenUsersIdentityInfo(identityInfo *connect.UserIdentityInfoLite, email string) []interface{} {
+	values := map[string]interface{}{}
+
+	if email != "" {
+		values[names.AttrEmail] = email
+	}
+
+	if identityInfo != nil {
+		if v := identityInfo.FirstName; v != nil {
+			values["first_name"] = aws.StringValue(v)
+		}
+
+		if v := identityInfo.LastName; v != nil {
+			values["last_name"] = aws.StringValue(v)
+		}
+	}
+
+	if len(values) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{values}
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package connect_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccUsers_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_users.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersConfig_basic(rName, rName2, rName3, rName4, rName5, []string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrInstanceID, "aws_connect_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "user.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"identity_info.#":            acctest.Ct1,
+						"identity_info.0.first_name": "alice",
+						names.AttrName:               rName5 + "-alice",
+						names.AttrPassword:           "Password123",
+						"phone_config.#":             acctest.Ct1,
+						"phone_config.0.phone_type":  "SOFT_PHONE",
+						"security_profile_ids.#":     acctest.Ct1,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "user_arns.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("user_ids.%s-bob", rName5)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user", "user_arns", "user_ids"},
+			},
+		},
+	})
+}
+
+func testAccUsers_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_users.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersConfig_basic(rName, rName2, rName3, rName4, rName5, []string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfconnect.ResourceUsers(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccUsers_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_users.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersConfig_basic(rName, rName2, rName3, rName4, rName5, []string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 2),
+				),
+			},
+			{
+				Config: testAccUsersConfig_basic(rName, rName2, rName3, rName4, rName5, []string{"bob", "carol", "dave"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "user.#", acctest.Ct3),
+					resource.TestCheckNoResourceAttr(resourceName, fmt.Sprintf("user_ids.%s-alice", rName5)),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("user_ids.%s-dave", rName5)),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsers_hierarchyGroupName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName3 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName4 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName5 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_users.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsersDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersConfig_hierarchyGroupName(rName, rName2, rName3, rName4, rName5, "parent"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"hierarchy_group_id":   "",
+						"hierarchy_group_name": rName3,
+					}),
+				),
+			},
+			{
+				Config: testAccUsersConfig_hierarchyGroupName(rName, rName2, rName3, rName4, rName5, "child"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsersExist(ctx, resourceName, 1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "user.*", map[string]string{
+						"hierarchy_group_id":   "",
+						"hierarchy_group_name": rName4,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsersExist(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+		users, err := tfconnect.FindUsersByInstanceIDWithContext(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var found int
+		for _, v := range users {
+			if _, ok := rs.Primary.Attributes["user_ids."+aws.StringValue(v.Username)]; ok {
+				found++
+			}
+		}
+
+		if found != count {
+			return fmt.Errorf("Connect Users (%s): found %d users, expected %d", rs.Primary.ID, found, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUsersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_connect_users" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn(ctx)
+
+			users, err := tfconnect.FindUsersByInstanceIDWithContext(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				// The instance itself may have been deleted.
+				continue
+			}
+
+			for _, v := range users {
+				if _, ok := rs.Primary.Attributes["user_ids."+aws.StringValue(v.Username)]; ok {
+					return fmt.Errorf("Connect User %s still exists", aws.StringValue(v.Username))
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccUsersConfig_basic(rName, rName2, rName3, rName4, rName5 string, users []string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_base(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+locals {
+  users = toset(%[2]s)
+}
+
+resource "aws_connect_users" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  dynamic "user" {
+    for_each = local.users
+
+    content {
+      name               = "%[1]s-${user.value}"
+      password           = "Password123"
+      routing_profile_id = data.aws_connect_routing_profile.test.routing_profile_id
+
+      security_profile_ids = [
+        data.aws_connect_security_profile.agent.security_profile_id
+      ]
+
+      identity_info {
+        first_name = user.value
+        last_name  = "example"
+      }
+
+      phone_config {
+        after_contact_work_time_limit = 0
+        phone_type                    = "SOFT_PHONE"
+      }
+    }
+  }
+}
+`, rName5, fmt.Sprintf(`["%s"]`, strings.Join(users, `", "`))))
+}
+
+func testAccUsersConfig_hierarchyGroupName(rName, rName2, rName3, rName4, rName5, group string) string {
+	return acctest.ConfigCompose(
+		testAccUserConfig_base(rName, rName2, rName3, rName4),
+		fmt.Sprintf(`
+resource "aws_connect_users" "test" {
+  instance_id = aws_connect_instance.test.id
+
+  user {
+    name                 = %[1]q
+    password             = "Password123"
+    routing_profile_id   = data.aws_connect_routing_profile.test.routing_profile_id
+    hierarchy_group_name = aws_connect_user_hierarchy_group.%[2]s.name
+
+    security_profile_ids = [
+      data.aws_connect_security_profile.agent.security_profile_id
+    ]
+
+    phone_config {
+      phone_type = "SOFT_PHONE"
+    }
+  }
+}
+`, rName5, group))
+}
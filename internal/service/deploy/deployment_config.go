@@ -134,6 +134,47 @@ func resourceDeploymentConfig() *schema.Resource {
 					},
 				},
 			},
+			"zonal_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_zone_monitor_duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"minimum_healthy_hosts_per_zone": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrType: {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsPerZoneType](),
+									},
+									names.AttrValue: {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"monitor_duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -150,6 +191,10 @@ func resourceDeploymentConfigCreate(ctx context.Context, d *schema.ResourceData,
 		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
 	}
 
+	if v, ok := d.GetOk("zonal_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ZonalConfig = expandZonalConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateDeploymentConfig(ctx, input)
 
 	if err != nil {
@@ -195,6 +240,9 @@ func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, m
 	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(deploymentConfig.TrafficRoutingConfig)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting traffic_routing_config: %s", err)
 	}
+	if err := d.Set("zonal_config", flattenZonalConfig(deploymentConfig.ZonalConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting zonal_config: %s", err)
+	}
 
 	return diags
 }
@@ -354,3 +402,70 @@ func flattenTimeBasedLinear(linear *types.TimeBasedLinear) []map[string]interfac
 
 	return append(result, item)
 }
+
+func expandZonalConfig(tfMap map[string]interface{}) *types.ZonalConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ZonalConfig{}
+
+	if v, ok := tfMap["first_zone_monitor_duration_in_seconds"].(int); ok && v != 0 {
+		apiObject.FirstZoneMonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["minimum_healthy_hosts_per_zone"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MinimumHealthyHostsPerZone = expandMinimumHealthyHostsPerZone(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["monitor_duration_in_seconds"].(int); ok && v != 0 {
+		apiObject.MonitorDurationInSeconds = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandMinimumHealthyHostsPerZone(tfMap map[string]interface{}) *types.MinimumHealthyHostsPerZone {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MinimumHealthyHostsPerZone{}
+
+	if v, ok := tfMap[names.AttrType].(string); ok && v != "" {
+		apiObject.Type = types.MinimumHealthyHostsPerZoneType(v)
+	}
+
+	if v, ok := tfMap[names.AttrValue].(int); ok {
+		apiObject.Value = int32(v)
+	}
+
+	return apiObject
+}
+
+func flattenZonalConfig(apiObject *types.ZonalConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"first_zone_monitor_duration_in_seconds": aws.ToInt64(apiObject.FirstZoneMonitorDurationInSeconds),
+		"minimum_healthy_hosts_per_zone":         flattenMinimumHealthyHostsPerZone(apiObject.MinimumHealthyHostsPerZone),
+		"monitor_duration_in_seconds":            aws.ToInt64(apiObject.MonitorDurationInSeconds),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMinimumHealthyHostsPerZone(apiObject *types.MinimumHealthyHostsPerZone) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrType:  apiObject.Type,
+		names.AttrValue: apiObject.Value,
+	}
+
+	return []interface{}{tfMap}
+}
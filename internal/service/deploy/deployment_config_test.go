@@ -140,6 +140,39 @@ func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_zonalConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "120"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config1, config2 types.DeploymentConfigInfo
@@ -323,6 +356,29 @@ resource "aws_codedeploy_deployment_config" "test" {
 `, rName, value)
 }
 
+func testAccDeploymentConfigConfig_zonalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 50
+  }
+
+  zonal_config {
+    first_zone_monitor_duration_in_seconds = 60
+    monitor_duration_in_seconds            = 120
+
+    minimum_healthy_hosts_per_zone {
+      type  = "FLEET_PERCENT"
+      value = 50
+    }
+  }
+}
+`, rName)
+}
+
 func testAccDeploymentConfigConfig_trafficCanary(rName string, interval, percentage int) string {
 	return fmt.Sprintf(`
 resource "aws_codedeploy_deployment_config" "test" {
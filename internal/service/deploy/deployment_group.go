@@ -420,6 +420,11 @@ func resourceDeploymentGroup() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"termination_hook_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"trigger_configuration": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -513,6 +518,10 @@ func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData,
 		input.OutdatedInstancesStrategy = types.OutdatedInstancesStrategy(v.(string))
 	}
 
+	if v, ok := d.GetOk("termination_hook_enabled"); ok {
+		input.TerminationHookEnabled = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("trigger_configuration"); ok {
 		input.TriggerConfigurations = expandTriggerConfigs(v.(*schema.Set).List())
 	}
@@ -606,6 +615,7 @@ func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("outdated_instances_strategy", group.OutdatedInstancesStrategy)
 	d.Set(names.AttrServiceRoleARN, group.ServiceRoleArn)
+	d.Set("termination_hook_enabled", group.TerminationHookEnabled)
 	if err := d.Set("trigger_configuration", flattenTriggerConfigs(group.TriggerConfigurations)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting trigger_configuration: %s", err)
 	}
@@ -709,6 +719,10 @@ func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if d.HasChange("termination_hook_enabled") {
+			input.TerminationHookEnabled = aws.Bool(d.Get("termination_hook_enabled").(bool))
+		}
+
 		log.Printf("[DEBUG] Updating CodeDeploy DeploymentGroup %s", d.Id())
 
 		var err error
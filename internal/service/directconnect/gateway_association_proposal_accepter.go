@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_dx_gateway_association_proposal_accepter")
+func ResourceGatewayAssociationProposalAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGatewayAssociationProposalAccepterCreate,
+		ReadWithoutTimeout:   resourceGatewayAssociationProposalAccepterRead,
+		UpdateWithoutTimeout: resourceGatewayAssociationProposalAccepterUpdate,
+		DeleteWithoutTimeout: resourceGatewayAssociationProposalAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGatewayAssociationProposalAccepterImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allowed_prefixes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"associated_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"associated_gateway_owner_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+
+			"associated_gateway_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dx_gateway_association_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dx_gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"dx_gateway_owner_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"proposal_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func resourceGatewayAssociationProposalAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	directConnectGatewayID := d.Get("dx_gateway_id").(string)
+	proposalID := d.Get("proposal_id").(string)
+
+	// The proposal is created in another account and may not be immediately visible.
+	if _, err := waitGatewayAssociationProposalRequested(ctx, conn, proposalID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association Proposal (%s) to be requested: %s", proposalID, err)
+	}
+
+	input := &directconnect.AcceptDirectConnectGatewayAssociationProposalInput{
+		AssociatedGatewayOwnerAccount: aws.String(d.Get("associated_gateway_owner_account_id").(string)),
+		DirectConnectGatewayId:        aws.String(directConnectGatewayID),
+		ProposalId:                    aws.String(proposalID),
+	}
+
+	if v, ok := d.GetOk("allowed_prefixes"); ok && v.(*schema.Set).Len() > 0 {
+		input.OverrideAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(v.(*schema.Set).List())
+	}
+
+	log.Printf("[DEBUG] Accepting Direct Connect Gateway Association Proposal: %s", input)
+	output, err := conn.AcceptDirectConnectGatewayAssociationProposalWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting Direct Connect Gateway Association Proposal (%s): %s", proposalID, err)
+	}
+
+	d.SetId(aws.StringValue(output.DirectConnectGatewayAssociation.AssociationId))
+
+	if _, err := waitGatewayAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGatewayAssociationProposalAccepterRead(ctx, d, meta)...)
+}
+
+func resourceGatewayAssociationProposalAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	output, err := FindGatewayAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Direct Connect Gateway Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("allowed_prefixes", flattenRouteFilterPrefixes(output.AllowedPrefixesToDirectConnectGateway)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting allowed_prefixes: %s", err)
+	}
+
+	d.Set("associated_gateway_id", output.AssociatedGateway.Id)
+	d.Set("associated_gateway_owner_account_id", output.AssociatedGateway.OwnerAccount)
+	d.Set("associated_gateway_type", output.AssociatedGateway.Type)
+	d.Set("dx_gateway_association_id", output.AssociationId)
+	d.Set("dx_gateway_id", output.DirectConnectGatewayId)
+	d.Set("dx_gateway_owner_account_id", output.DirectConnectGatewayOwnerAccount)
+
+	return diags
+}
+
+func resourceGatewayAssociationProposalAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	input := &directconnect.UpdateDirectConnectGatewayAssociationInput{
+		AssociationId: aws.String(d.Id()),
+	}
+
+	oraw, nraw := d.GetChange("allowed_prefixes")
+	o, n := oraw.(*schema.Set), nraw.(*schema.Set)
+
+	if add := n.Difference(o); add.Len() > 0 {
+		input.AddAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(add.List())
+	}
+
+	if del := o.Difference(n); del.Len() > 0 {
+		input.RemoveAllowedPrefixesToDirectConnectGateway = expandRouteFilterPrefixes(del.List())
+	}
+
+	log.Printf("[DEBUG] Updating Direct Connect Gateway Association: %s", input)
+	_, err := conn.UpdateDirectConnectGatewayAssociationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Direct Connect Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitGatewayAssociationUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to update: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceGatewayAssociationProposalAccepterRead(ctx, d, meta)...)
+}
+
+func resourceGatewayAssociationProposalAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	log.Printf("[DEBUG] Deleting Direct Connect Gateway Association: %s", d.Id())
+	_, err := conn.DeleteDirectConnectGatewayAssociationWithContext(ctx, &directconnect.DeleteDirectConnectGatewayAssociationInput{
+		AssociationId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrMessageContains(err, directconnect.ErrCodeClientException, "does not exist") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Direct Connect Gateway Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitGatewayAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Direct Connect Gateway Association (%s) to delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceGatewayAssociationProposalAccepterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Incorrect resource ID format: %q. Expected PROPOSALID/DXGATEWAYASSOCIATIONID", d.Id())
+	}
+
+	d.SetId(parts[1])
+	d.Set("proposal_id", parts[0])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdirectconnect "github.com/hashicorp/terraform-provider-aws/internal/service/directconnect"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDirectConnectGatewayAssociationProposalAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dx_gateway_association_proposal_accepter.test"
+	resourceNameDxGw := "aws_dx_gateway.test"
+	resourceNameProposal := "aws_dx_gateway_association_proposal.test"
+	resourceNameVgw := "aws_vpn_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	var ga directconnect.GatewayAssociation
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAlternateAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGatewayAssociationProposalAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAssociationProposalAccepterConfig_basic(rName, rBgpAsn),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationProposalAccepterExists(ctx, resourceName, &ga),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "associated_gateway_id", resourceNameVgw, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "associated_gateway_type", "virtualPrivateGateway"),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_id", resourceNameDxGw, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "dx_gateway_owner_account_id", resourceNameDxGw, names.AttrOwnerAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "proposal_id", resourceNameProposal, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccGatewayAssociationProposalAccepterImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"proposal_id"},
+			},
+		},
+	})
+}
+
+func TestAccDirectConnectGatewayAssociationProposalAccepter_allowedPrefixes(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dx_gateway_association_proposal_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	var ga directconnect.GatewayAssociation
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAlternateAccount(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGatewayAssociationProposalAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayAssociationProposalAccepterConfig_allowedPrefixes(rName, rBgpAsn, "10.255.255.8/29"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationProposalAccepterExists(ctx, resourceName, &ga),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.255.8/29"),
+				),
+			},
+			{
+				Config: testAccGatewayAssociationProposalAccepterConfig_allowedPrefixes(rName, rBgpAsn, "10.255.255.0/28"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayAssociationProposalAccepterExists(ctx, resourceName, &ga),
+					resource.TestCheckResourceAttr(resourceName, "allowed_prefixes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_prefixes.*", "10.255.255.0/28"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGatewayAssociationProposalAccepterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["proposal_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckGatewayAssociationProposalAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dx_gateway_association_proposal_accepter" {
+				continue
+			}
+
+			_, err := tfdirectconnect.FindGatewayAssociationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Direct Connect Gateway Association %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckGatewayAssociationProposalAccepterExists(ctx context.Context, name string, v *directconnect.GatewayAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DirectConnectConn(ctx)
+
+		output, err := tfdirectconnect.FindGatewayAssociationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccGatewayAssociationProposalAccepterConfig_basic(rName string, rBgpAsn int) string {
+	return acctest.ConfigCompose(
+		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
+		`
+# Creator
+resource "aws_dx_gateway_association_proposal" "test" {
+  dx_gateway_id               = aws_dx_gateway.test.id
+  dx_gateway_owner_account_id = aws_dx_gateway.test.owner_account_id
+  associated_gateway_id       = aws_vpn_gateway_attachment.test.vpn_gateway_id
+
+  allowed_prefixes = [
+    "10.255.255.0/30",
+    "10.255.255.8/30",
+  ]
+}
+
+# Accepter
+resource "aws_dx_gateway_association_proposal_accepter" "test" {
+  provider = "awsalternate"
+
+  proposal_id                         = aws_dx_gateway_association_proposal.test.id
+  dx_gateway_id                       = aws_dx_gateway.test.id
+  associated_gateway_owner_account_id = data.aws_caller_identity.creator.account_id
+}
+`)
+}
+
+func testAccGatewayAssociationProposalAccepterConfig_allowedPrefixes(rName string, rBgpAsn int, allowedPrefix string) string {
+	return acctest.ConfigCompose(
+		testAccGatewayAssociationConfigBase_vpnGatewayCrossAccount(rName, rBgpAsn),
+		fmt.Sprintf(`
+# Creator
+resource "aws_dx_gateway_association_proposal" "test" {
+  dx_gateway_id               = aws_dx_gateway.test.id
+  dx_gateway_owner_account_id = aws_dx_gateway.test.owner_account_id
+  associated_gateway_id       = aws_vpn_gateway_attachment.test.vpn_gateway_id
+
+  lifecycle {
+    # Accepting the proposal with overridden prefixes changes the requested prefixes.
+    ignore_changes = [allowed_prefixes]
+  }
+}
+
+# Accepter
+resource "aws_dx_gateway_association_proposal_accepter" "test" {
+  provider = "awsalternate"
+
+  proposal_id                         = aws_dx_gateway_association_proposal.test.id
+  dx_gateway_id                       = aws_dx_gateway.test.id
+  associated_gateway_owner_account_id = data.aws_caller_identity.creator.account_id
+
+  allowed_prefixes = [%[1]q]
+}
+`, allowedPrefix))
+}
@@ -82,6 +82,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGatewayAssociationProposal,
 			TypeName: "aws_dx_gateway_association_proposal",
 		},
+		{
+			Factory:  ResourceGatewayAssociationProposalAccepter,
+			TypeName: "aws_dx_gateway_association_proposal_accepter",
+		},
 		{
 			Factory:  ResourceHostedConnection,
 			TypeName: "aws_dx_hosted_connection",
@@ -60,6 +60,22 @@ func statusGatewayAssociationState(ctx context.Context, conn *directconnect.Dire
 	}
 }
 
+func statusGatewayAssociationProposalState(ctx context.Context, conn *directconnect.DirectConnect, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGatewayAssociationProposalByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ProposalState), nil
+	}
+}
+
 func statusHostedConnectionState(ctx context.Context, conn *directconnect.DirectConnect, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindHostedConnectionByID(ctx, conn, id)
@@ -151,6 +151,24 @@ func waitGatewayAssociationDeleted(ctx context.Context, conn *directconnect.Dire
 	return nil, err
 }
 
+// waitGatewayAssociationProposalRequested waits for a cross-account proposal to become visible to the Direct Connect gateway owner.
+func waitGatewayAssociationProposalRequested(ctx context.Context, conn *directconnect.DirectConnect, id string, timeout time.Duration) (*directconnect.GatewayAssociationProposal, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{},
+		Target:  []string{directconnect.GatewayAssociationProposalStateRequested},
+		Refresh: statusGatewayAssociationProposalState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.GatewayAssociationProposal); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitHostedConnectionDeleted(ctx context.Context, conn *directconnect.DirectConnect, id string) (*directconnect.Connection, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{directconnect.ConnectionStatePending, directconnect.ConnectionStateOrdering, directconnect.ConnectionStateAvailable, directconnect.ConnectionStateRequested, directconnect.ConnectionStateDeleting},
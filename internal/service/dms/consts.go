@@ -32,6 +32,28 @@ const (
 	replicationTaskStatusStarting  = "starting"
 )
 
+const (
+	dataProviderEngineAurora           = "aurora"
+	dataProviderEngineAuroraPostgreSQL = "aurora_postgresql"
+	dataProviderEngineDocDB            = "docdb"
+	dataProviderEngineMariaDB          = "mariadb"
+	dataProviderEngineMongoDB          = "mongodb"
+	dataProviderEngineMySQL            = "mysql"
+	dataProviderEnginePostgres         = "postgres"
+)
+
+func dataProviderEngine_Values() []string {
+	return []string{
+		dataProviderEngineAurora,
+		dataProviderEngineAuroraPostgreSQL,
+		dataProviderEngineDocDB,
+		dataProviderEngineMariaDB,
+		dataProviderEngineMongoDB,
+		dataProviderEngineMySQL,
+		dataProviderEnginePostgres,
+	}
+}
+
 const (
 	engineNameAurora                     = "aurora"
 	engineNameAuroraPostgresql           = "aurora-postgresql"
@@ -159,11 +181,37 @@ const (
 	replicationStatusReplicationStarting  = "replication_starting"
 )
 
+// Data migration statuses are compared lowercased.
+const (
+	dataMigrationStatusCreating = "creating"
+	dataMigrationStatusDeleting = "deleting"
+	dataMigrationStatusFailed   = "failed"
+	dataMigrationStatusReady    = "ready"
+	dataMigrationStatusRunning  = "running"
+	dataMigrationStatusStarting = "starting"
+	dataMigrationStatusStopped  = "stopped"
+	dataMigrationStatusStopping = "stopping"
+)
+
 const (
 	replicationTypeValueStartReplication = "creating"
 	replicationTypeValueResumeProcessing = "resume-processing"
 )
 
+const (
+	startReplicationTypeReloadTarget     = "reload-target"
+	startReplicationTypeResumeProcessing = "resume-processing"
+	startReplicationTypeStartReplication = "start-replication"
+)
+
+func startReplicationType_Values() []string {
+	return []string{
+		startReplicationTypeReloadTarget,
+		startReplicationTypeResumeProcessing,
+		startReplicationTypeStartReplication,
+	}
+}
+
 const (
 	networkTypeDual = "DUAL"
 	networkTypeIPv4 = "IPV4"
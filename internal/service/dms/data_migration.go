@@ -0,0 +1,601 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_data_migration", name="Data Migration")
+// @Tags(identifierAttribute="arn")
+func resourceDataMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataMigrationCreate,
+		ReadWithoutTimeout:   resourceDataMigrationRead,
+		UpdateWithoutTimeout: resourceDataMigrationUpdate,
+		DeleteWithoutTimeout: resourceDataMigrationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_migration_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"data_migration_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_migration_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.MigrationTypeValue](),
+			},
+			"enable_cloudwatch_logs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"migration_project_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"number_of_jobs": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"public_ip_addresses": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"selection_rules": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_data_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cdc_start_position": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cdc_start_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"cdc_stop_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"slot_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"start_data_migration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDataMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	input := &dms.CreateDataMigrationInput{
+		DataMigrationType:          awstypes.MigrationTypeValue(d.Get("data_migration_type").(string)),
+		MigrationProjectIdentifier: aws.String(d.Get("migration_project_identifier").(string)),
+		ServiceAccessRoleArn:       aws.String(d.Get("service_access_role_arn").(string)),
+		Tags:                       getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("data_migration_name"); ok {
+		input.DataMigrationName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOkExists("enable_cloudwatch_logs"); ok {
+		input.EnableCloudwatchLogs = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("number_of_jobs"); ok {
+		input.NumberOfJobs = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("selection_rules"); ok {
+		input.SelectionRules = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_data_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SourceDataSettings = expandSourceDataSettings(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateDataMigration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Data Migration: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DataMigration.DataMigrationArn))
+
+	if _, err := waitDataMigrationReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Data Migration (%s) create: %s", d.Id(), err)
+	}
+
+	if d.Get("start_data_migration").(bool) {
+		if err := startDataMigration(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceDataMigrationRead(ctx, d, meta)...)
+}
+
+func resourceDataMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	dataMigration, err := findDataMigrationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Data Migration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Data Migration (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, dataMigration.DataMigrationArn)
+	d.Set("data_migration_name", dataMigration.DataMigrationName)
+	d.Set("data_migration_status", dataMigration.DataMigrationStatus)
+	d.Set("data_migration_type", dataMigration.DataMigrationType)
+	if v := dataMigration.DataMigrationSettings; v != nil {
+		d.Set("enable_cloudwatch_logs", v.CloudwatchLogsEnabled)
+		d.Set("number_of_jobs", v.NumberOfJobs)
+		d.Set("selection_rules", v.SelectionRules)
+	}
+	d.Set("public_ip_addresses", dataMigration.PublicIpAddresses)
+	d.Set("service_access_role_arn", dataMigration.ServiceAccessRoleArn)
+	if err := d.Set("source_data_settings", flattenSourceDataSettings(dataMigration.SourceDataSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source_data_settings: %s", err)
+	}
+
+	return diags
+}
+
+func resourceDataMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_data_migration") {
+		if err := stopDataMigration(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &dms.ModifyDataMigrationInput{
+			DataMigrationIdentifier: aws.String(d.Id()),
+		}
+
+		if d.HasChange("data_migration_name") {
+			input.DataMigrationName = aws.String(d.Get("data_migration_name").(string))
+		}
+
+		if d.HasChange("data_migration_type") {
+			input.DataMigrationType = awstypes.MigrationTypeValue(d.Get("data_migration_type").(string))
+		}
+
+		if d.HasChange("enable_cloudwatch_logs") {
+			input.EnableCloudwatchLogs = aws.Bool(d.Get("enable_cloudwatch_logs").(bool))
+		}
+
+		if d.HasChange("number_of_jobs") {
+			input.NumberOfJobs = aws.Int32(int32(d.Get("number_of_jobs").(int)))
+		}
+
+		if d.HasChange("selection_rules") {
+			input.SelectionRules = aws.String(d.Get("selection_rules").(string))
+		}
+
+		if d.HasChange("service_access_role_arn") {
+			input.ServiceAccessRoleArn = aws.String(d.Get("service_access_role_arn").(string))
+		}
+
+		if d.HasChange("source_data_settings") {
+			if v, ok := d.GetOk("source_data_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.SourceDataSettings = expandSourceDataSettings(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		_, err := conn.ModifyDataMigration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DMS Data Migration (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitDataMigrationReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for DMS Data Migration (%s) update: %s", d.Id(), err)
+		}
+
+		if d.Get("start_data_migration").(bool) {
+			if err := startDataMigration(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	if d.HasChange("start_data_migration") {
+		var f func(context.Context, *dms.Client, string, time.Duration) error
+		if d.Get("start_data_migration").(bool) {
+			f = startDataMigration
+		} else {
+			f = stopDataMigration
+		}
+		if err := f(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceDataMigrationRead(ctx, d, meta)...)
+}
+
+func resourceDataMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	if err := stopDataMigration(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting DMS Data Migration: %s", d.Id())
+	_, err := conn.DeleteDataMigration(ctx, &dms.DeleteDataMigrationInput{
+		DataMigrationIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Data Migration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitDataMigrationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for DMS Data Migration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDataMigrationByARN(ctx context.Context, conn *dms.Client, arn string) (*awstypes.DataMigration, error) {
+	input := &dms.DescribeDataMigrationsInput{
+		Filters: []awstypes.Filter{{
+			Name:   aws.String("data-migration-identifier"),
+			Values: []string{arn},
+		}},
+	}
+
+	return findDataMigration(ctx, conn, input)
+}
+
+func findDataMigration(ctx context.Context, conn *dms.Client, input *dms.DescribeDataMigrationsInput) (*awstypes.DataMigration, error) {
+	output, err := findDataMigrations(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findDataMigrations(ctx context.Context, conn *dms.Client, input *dms.DescribeDataMigrationsInput) ([]awstypes.DataMigration, error) {
+	var output []awstypes.DataMigration
+
+	pages := dms.NewDescribeDataMigrationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DataMigrations...)
+	}
+
+	return output, nil
+}
+
+func statusDataMigration(ctx context.Context, conn *dms.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataMigrationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, strings.ToLower(aws.ToString(output.DataMigrationStatus)), nil
+	}
+}
+
+func setLastDataMigrationError(err error, dataMigration *awstypes.DataMigration) {
+	var failures []error
+
+	if v := aws.ToString(dataMigration.LastFailureMessage); v != "" {
+		failures = append(failures, errors.New(v))
+	}
+	if v := aws.ToString(dataMigration.StopReason); v != "" {
+		failures = append(failures, errors.New(v))
+	}
+
+	tfresource.SetLastError(err, errors.Join(failures...))
+}
+
+func waitDataMigrationReady(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.DataMigration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dataMigrationStatusCreating},
+		Target:     []string{dataMigrationStatusReady, dataMigrationStatusStopped, dataMigrationStatusFailed},
+		Refresh:    statusDataMigration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataMigration); ok {
+		setLastDataMigrationError(err, output)
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataMigrationRunning(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.DataMigration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dataMigrationStatusReady, dataMigrationStatusStarting},
+		Target:     []string{dataMigrationStatusRunning, dataMigrationStatusStopped},
+		Refresh:    statusDataMigration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataMigration); ok {
+		setLastDataMigrationError(err, output)
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataMigrationStopped(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.DataMigration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dataMigrationStatusStopping, dataMigrationStatusRunning},
+		Target:     []string{dataMigrationStatusStopped},
+		Refresh:    statusDataMigration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataMigration); ok {
+		setLastDataMigrationError(err, output)
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataMigrationDeleted(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) (*awstypes.DataMigration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{dataMigrationStatusDeleting, dataMigrationStatusReady, dataMigrationStatusStopped, dataMigrationStatusFailed},
+		Target:     []string{},
+		Refresh:    statusDataMigration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataMigration); ok {
+		setLastDataMigrationError(err, output)
+		return output, err
+	}
+
+	return nil, err
+}
+
+func startDataMigration(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) error {
+	dataMigration, err := findDataMigrationByARN(ctx, conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("reading DMS Data Migration (%s): %w", arn, err)
+	}
+
+	status := strings.ToLower(aws.ToString(dataMigration.DataMigrationStatus))
+	if status == dataMigrationStatusRunning || status == dataMigrationStatusStarting {
+		return nil
+	}
+
+	startType := awstypes.StartReplicationMigrationTypeValueStartReplication
+	if status != dataMigrationStatusReady {
+		startType = awstypes.StartReplicationMigrationTypeValueResumeProcessing
+	}
+	input := &dms.StartDataMigrationInput{
+		DataMigrationIdentifier: aws.String(arn),
+		StartType:               startType,
+	}
+
+	_, err = conn.StartDataMigration(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("starting DMS Data Migration (%s): %w", arn, err)
+	}
+
+	if _, err := waitDataMigrationRunning(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for DMS Data Migration (%s) start: %w", arn, err)
+	}
+
+	return nil
+}
+
+func stopDataMigration(ctx context.Context, conn *dms.Client, arn string, timeout time.Duration) error {
+	dataMigration, err := findDataMigrationByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading DMS Data Migration (%s): %w", arn, err)
+	}
+
+	status := strings.ToLower(aws.ToString(dataMigration.DataMigrationStatus))
+	if status != dataMigrationStatusRunning && status != dataMigrationStatusStarting && status != dataMigrationStatusStopping {
+		return nil
+	}
+
+	if status != dataMigrationStatusStopping {
+		input := &dms.StopDataMigrationInput{
+			DataMigrationIdentifier: aws.String(arn),
+		}
+
+		_, err = conn.StopDataMigration(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("stopping DMS Data Migration (%s): %w", arn, err)
+		}
+	}
+
+	if _, err := waitDataMigrationStopped(ctx, conn, arn, timeout); err != nil {
+		return fmt.Errorf("waiting for DMS Data Migration (%s) stop: %w", arn, err)
+	}
+
+	return nil
+}
+
+func expandSourceDataSettings(tfMap map[string]interface{}) []awstypes.SourceDataSetting {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := awstypes.SourceDataSetting{}
+
+	if v, ok := tfMap["cdc_start_position"].(string); ok && v != "" {
+		apiObject.CDCStartPosition = aws.String(v)
+	}
+
+	if v, ok := tfMap["cdc_start_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.CDCStartTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["cdc_stop_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		apiObject.CDCStopTime = aws.Time(t)
+	}
+
+	if v, ok := tfMap["slot_name"].(string); ok && v != "" {
+		apiObject.SlotName = aws.String(v)
+	}
+
+	return []awstypes.SourceDataSetting{apiObject}
+}
+
+func flattenSourceDataSettings(apiObjects []awstypes.SourceDataSetting) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	apiObject := apiObjects[0]
+	tfMap := map[string]interface{}{
+		"cdc_start_position": aws.ToString(apiObject.CDCStartPosition),
+		"slot_name":          aws.ToString(apiObject.SlotName),
+	}
+
+	if v := apiObject.CDCStartTime; v != nil {
+		tfMap["cdc_start_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.CDCStopTime; v != nil {
+		tfMap["cdc_stop_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
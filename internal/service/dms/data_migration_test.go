@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Data migrations require a DMS Schema Conversion migration project, which
+// can't yet be managed by this provider.
+const envVarMigrationProjectARN = "DMS_MIGRATION_PROJECT_ARN"
+
+func TestAccDMSDataMigration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := acctest.SkipIfEnvVarNotSet(t, envVarMigrationProjectARN)
+	resourceName := "aws_dms_data_migration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataMigrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMigrationConfig_basic(rName, projectARN, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataMigrationExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "dms", regexache.MustCompile(`data-migration:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_migration_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "data_migration_status"),
+					resource.TestCheckResourceAttr(resourceName, "data_migration_type", "full-load"),
+					resource.TestCheckResourceAttr(resourceName, "migration_project_identifier", projectARN),
+					resource.TestCheckResourceAttr(resourceName, "number_of_jobs", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "service_access_role_arn", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "start_data_migration", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"migration_project_identifier", "start_data_migration"},
+			},
+			{
+				Config: testAccDataMigrationConfig_basic(rName, projectARN, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataMigrationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "number_of_jobs", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSDataMigration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := acctest.SkipIfEnvVarNotSet(t, envVarMigrationProjectARN)
+	resourceName := "aws_dms_data_migration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataMigrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMigrationConfig_basic(rName, projectARN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataMigrationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceDataMigration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSDataMigration_startDataMigration(t *testing.T) {
+	ctx := acctest.Context(t)
+	projectARN := acctest.SkipIfEnvVarNotSet(t, envVarMigrationProjectARN)
+	resourceName := "aws_dms_data_migration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataMigrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataMigrationConfig_start(rName, projectARN, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataMigrationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_data_migration", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDataMigrationConfig_start(rName, projectARN, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataMigrationExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "data_migration_status", regexache.MustCompile(`(?i)^stopped$`)),
+					resource.TestCheckResourceAttr(resourceName, "start_data_migration", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataMigrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_data_migration" {
+				continue
+			}
+
+			_, err := tfdms.FindDataMigrationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Data Migration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataMigrationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+		_, err := tfdms.FindDataMigrationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDataMigrationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "dms.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonDMSVPCManagementRole"
+}
+`, rName)
+}
+
+func testAccDataMigrationConfig_basic(rName, projectARN string, numberOfJobs int) string {
+	return acctest.ConfigCompose(testAccDataMigrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_data_migration" "test" {
+  data_migration_name          = %[1]q
+  data_migration_type          = "full-load"
+  migration_project_identifier = %[2]q
+  number_of_jobs               = %[3]d
+  service_access_role_arn      = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, projectARN, numberOfJobs))
+}
+
+func testAccDataMigrationConfig_start(rName, projectARN string, start bool) string {
+	return acctest.ConfigCompose(testAccDataMigrationConfig_base(rName), fmt.Sprintf(`
+resource "aws_dms_data_migration" "test" {
+  data_migration_name          = %[1]q
+  data_migration_type          = "full-load"
+  migration_project_identifier = %[2]q
+  service_access_role_arn      = aws_iam_role.test.arn
+  start_data_migration         = %[3]t
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, projectARN, start))
+}
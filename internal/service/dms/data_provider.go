@@ -0,0 +1,467 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	dms "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_dms_data_provider", name="Data Provider")
+// @Tags(identifierAttribute="arn")
+func resourceDataProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataProviderCreate,
+		ReadWithoutTimeout:   resourceDataProviderRead,
+		UpdateWithoutTimeout: resourceDataProviderUpdate,
+		DeleteWithoutTimeout: resourceDataProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_provider_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"docdb_settings": dataProviderSettingsSchema(true, false),
+			names.AttrEngine: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dataProviderEngine_Values(), false),
+			},
+			"mariadb_settings":  dataProviderSettingsSchema(false, false),
+			"mongodb_settings":  dataProviderSettingsSchema(true, true),
+			"mysql_settings":    dataProviderSettingsSchema(false, false),
+			"postgres_settings": dataProviderSettingsSchema(true, false),
+			names.AttrTags:      tftags.TagsSchema(),
+			names.AttrTagsAll:   tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+var dataProviderSettingsKeys = []string{
+	"docdb_settings",
+	"mariadb_settings",
+	"mongodb_settings",
+	"mysql_settings",
+	"postgres_settings",
+}
+
+func dataProviderSettingsSchema(withDatabaseName, withMongoDBAuth bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		names.AttrCertificateARN: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidARN,
+		},
+		names.AttrPort: {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"server_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"ssl_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: enum.Validate[awstypes.DmsSslModeValue](),
+		},
+	}
+
+	if withDatabaseName {
+		s[names.AttrDatabaseName] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		}
+	}
+
+	if withMongoDBAuth {
+		s["auth_mechanism"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: enum.Validate[awstypes.AuthMechanismValue](),
+		}
+		s["auth_source"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		}
+		s["auth_type"] = &schema.Schema{
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: enum.Validate[awstypes.AuthTypeValue](),
+		}
+	}
+
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: dataProviderSettingsKeys,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func resourceDataProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	input := &dms.CreateDataProviderInput{
+		Engine:   aws.String(d.Get(names.AttrEngine).(string)),
+		Settings: expandDataProviderSettings(d),
+		Tags:     getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("data_provider_name"); ok {
+		input.DataProviderName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDataProvider(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DMS Data Provider: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DataProvider.DataProviderArn))
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	dataProvider, err := findDataProviderByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS Data Provider (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, dataProvider.DataProviderArn)
+	d.Set("data_provider_name", dataProvider.DataProviderName)
+	d.Set(names.AttrDescription, dataProvider.Description)
+	d.Set(names.AttrEngine, dataProvider.Engine)
+	if err := flattenDataProviderSettings(d, dataProvider.Settings); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func resourceDataProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &dms.ModifyDataProviderInput{
+			DataProviderIdentifier: aws.String(d.Id()),
+			DataProviderName:       aws.String(d.Get("data_provider_name").(string)),
+			Description:            aws.String(d.Get(names.AttrDescription).(string)),
+			Engine:                 aws.String(d.Get(names.AttrEngine).(string)),
+			ExactSettings:          aws.Bool(true),
+			Settings:               expandDataProviderSettings(d),
+		}
+
+		_, err := conn.ModifyDataProvider(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying DMS Data Provider (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDataProviderRead(ctx, d, meta)...)
+}
+
+func resourceDataProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DMSClient(ctx)
+
+	log.Printf("[DEBUG] Deleting DMS Data Provider: %s", d.Id())
+	_, err := conn.DeleteDataProvider(ctx, &dms.DeleteDataProviderInput{
+		DataProviderIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DMS Data Provider (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDataProviderByARN(ctx context.Context, conn *dms.Client, arn string) (*awstypes.DataProvider, error) {
+	input := &dms.DescribeDataProvidersInput{
+		Filters: []awstypes.Filter{{
+			Name:   aws.String("data-provider-identifier"),
+			Values: []string{arn},
+		}},
+	}
+
+	return findDataProvider(ctx, conn, input)
+}
+
+func findDataProvider(ctx context.Context, conn *dms.Client, input *dms.DescribeDataProvidersInput) (*awstypes.DataProvider, error) {
+	output, err := findDataProviders(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findDataProviders(ctx context.Context, conn *dms.Client, input *dms.DescribeDataProvidersInput) ([]awstypes.DataProvider, error) {
+	var output []awstypes.DataProvider
+
+	pages := dms.NewDescribeDataProvidersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.DataProviders...)
+	}
+
+	return output, nil
+}
+
+func expandDataProviderSettings(d *schema.ResourceData) awstypes.DataProviderSettings {
+	getTfMap := func(k string) (map[string]interface{}, bool) {
+		if v, ok := d.GetOk(k); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			return v.([]interface{})[0].(map[string]interface{}), true
+		}
+		return nil, false
+	}
+
+	if tfMap, ok := getTfMap("docdb_settings"); ok {
+		apiObject := awstypes.DocDbDataProviderSettings{
+			Port:       aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			ServerName: aws.String(tfMap["server_name"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+			apiObject.DatabaseName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ssl_mode"].(string); ok && v != "" {
+			apiObject.SslMode = awstypes.DmsSslModeValue(v)
+		}
+
+		return &awstypes.DataProviderSettingsMemberDocDbSettings{Value: apiObject}
+	}
+
+	if tfMap, ok := getTfMap("mariadb_settings"); ok {
+		apiObject := awstypes.MariaDbDataProviderSettings{
+			Port:       aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			ServerName: aws.String(tfMap["server_name"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["ssl_mode"].(string); ok && v != "" {
+			apiObject.SslMode = awstypes.DmsSslModeValue(v)
+		}
+
+		return &awstypes.DataProviderSettingsMemberMariaDbSettings{Value: apiObject}
+	}
+
+	if tfMap, ok := getTfMap("mongodb_settings"); ok {
+		apiObject := awstypes.MongoDbDataProviderSettings{
+			Port:       aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			ServerName: aws.String(tfMap["server_name"].(string)),
+		}
+
+		if v, ok := tfMap["auth_mechanism"].(string); ok && v != "" {
+			apiObject.AuthMechanism = awstypes.AuthMechanismValue(v)
+		}
+
+		if v, ok := tfMap["auth_source"].(string); ok && v != "" {
+			apiObject.AuthSource = aws.String(v)
+		}
+
+		if v, ok := tfMap["auth_type"].(string); ok && v != "" {
+			apiObject.AuthType = awstypes.AuthTypeValue(v)
+		}
+
+		if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+			apiObject.DatabaseName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ssl_mode"].(string); ok && v != "" {
+			apiObject.SslMode = awstypes.DmsSslModeValue(v)
+		}
+
+		return &awstypes.DataProviderSettingsMemberMongoDbSettings{Value: apiObject}
+	}
+
+	if tfMap, ok := getTfMap("mysql_settings"); ok {
+		apiObject := awstypes.MySqlDataProviderSettings{
+			Port:       aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			ServerName: aws.String(tfMap["server_name"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["ssl_mode"].(string); ok && v != "" {
+			apiObject.SslMode = awstypes.DmsSslModeValue(v)
+		}
+
+		return &awstypes.DataProviderSettingsMemberMySqlSettings{Value: apiObject}
+	}
+
+	if tfMap, ok := getTfMap("postgres_settings"); ok {
+		apiObject := awstypes.PostgreSqlDataProviderSettings{
+			Port:       aws.Int32(int32(tfMap[names.AttrPort].(int))),
+			ServerName: aws.String(tfMap["server_name"].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrCertificateARN].(string); ok && v != "" {
+			apiObject.CertificateArn = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrDatabaseName].(string); ok && v != "" {
+			apiObject.DatabaseName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ssl_mode"].(string); ok && v != "" {
+			apiObject.SslMode = awstypes.DmsSslModeValue(v)
+		}
+
+		return &awstypes.DataProviderSettingsMemberPostgreSqlSettings{Value: apiObject}
+	}
+
+	return nil
+}
+
+func flattenDataProviderSettings(d *schema.ResourceData, apiObject awstypes.DataProviderSettings) error {
+	var key string
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.DataProviderSettingsMemberDocDbSettings:
+		key = "docdb_settings"
+		tfMap[names.AttrCertificateARN] = aws.ToString(v.Value.CertificateArn)
+		tfMap[names.AttrDatabaseName] = aws.ToString(v.Value.DatabaseName)
+		tfMap[names.AttrPort] = aws.ToInt32(v.Value.Port)
+		tfMap["server_name"] = aws.ToString(v.Value.ServerName)
+		tfMap["ssl_mode"] = string(v.Value.SslMode)
+	case *awstypes.DataProviderSettingsMemberMariaDbSettings:
+		key = "mariadb_settings"
+		tfMap[names.AttrCertificateARN] = aws.ToString(v.Value.CertificateArn)
+		tfMap[names.AttrPort] = aws.ToInt32(v.Value.Port)
+		tfMap["server_name"] = aws.ToString(v.Value.ServerName)
+		tfMap["ssl_mode"] = string(v.Value.SslMode)
+	case *awstypes.DataProviderSettingsMemberMongoDbSettings:
+		key = "mongodb_settings"
+		tfMap["auth_mechanism"] = string(v.Value.AuthMechanism)
+		tfMap["auth_source"] = aws.ToString(v.Value.AuthSource)
+		tfMap["auth_type"] = string(v.Value.AuthType)
+		tfMap[names.AttrCertificateARN] = aws.ToString(v.Value.CertificateArn)
+		tfMap[names.AttrDatabaseName] = aws.ToString(v.Value.DatabaseName)
+		tfMap[names.AttrPort] = aws.ToInt32(v.Value.Port)
+		tfMap["server_name"] = aws.ToString(v.Value.ServerName)
+		tfMap["ssl_mode"] = string(v.Value.SslMode)
+	case *awstypes.DataProviderSettingsMemberMySqlSettings:
+		key = "mysql_settings"
+		tfMap[names.AttrCertificateARN] = aws.ToString(v.Value.CertificateArn)
+		tfMap[names.AttrPort] = aws.ToInt32(v.Value.Port)
+		tfMap["server_name"] = aws.ToString(v.Value.ServerName)
+		tfMap["ssl_mode"] = string(v.Value.SslMode)
+	case *awstypes.DataProviderSettingsMemberPostgreSqlSettings:
+		key = "postgres_settings"
+		tfMap[names.AttrCertificateARN] = aws.ToString(v.Value.CertificateArn)
+		tfMap[names.AttrDatabaseName] = aws.ToString(v.Value.DatabaseName)
+		tfMap[names.AttrPort] = aws.ToInt32(v.Value.Port)
+		tfMap["server_name"] = aws.ToString(v.Value.ServerName)
+		tfMap["ssl_mode"] = string(v.Value.SslMode)
+	default:
+		log.Printf("[WARN] Unsupported DMS Data Provider settings type: %T", apiObject)
+	}
+
+	for _, k := range dataProviderSettingsKeys {
+		var v []interface{}
+		if k == key {
+			v = []interface{}{tfMap}
+		}
+
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
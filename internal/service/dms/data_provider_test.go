@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDMSDataProvider_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_data_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "dms", regexache.MustCompile(`data-provider:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "data_provider_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngine, "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.certificate_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.database_name", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.port", "5432"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.server_name", "postgres.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.ssl_mode", "none"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_data_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdms.ResourceDataProvider(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_data_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngine, "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccDataProviderConfig_mysql(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "MySQL source"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEngine, "mysql"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.port", "3306"),
+					resource.TestCheckResourceAttr(resourceName, "mysql_settings.0.server_name", "mysql.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_certificate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_data_provider.test"
+	certificateResourceName := "aws_dms_certificate.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "postgres.example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_certificate(rName, acctest.TLSPEMEscapeNewlines(certificate), "verify-ca"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "postgres_settings.0.certificate_arn", certificateResourceName, names.AttrCertificateARN),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.ssl_mode", "verify-ca"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataProviderConfig_certificate(rName, acctest.TLSPEMEscapeNewlines(certificate), "verify-full"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "postgres_settings.0.certificate_arn", certificateResourceName, names.AttrCertificateARN),
+					resource.TestCheckResourceAttr(resourceName, "postgres_settings.0.ssl_mode", "verify-full"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSDataProvider_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_dms_data_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataProviderConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataProviderConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccDataProviderConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataProviderExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataProviderDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dms_data_provider" {
+				continue
+			}
+
+			_, err := tfdms.FindDataProviderByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DMS Data Provider %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataProviderExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DMSClient(ctx)
+
+		_, err := tfdms.FindDataProviderByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDataProviderConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  postgres_settings {
+    database_name = "postgres"
+    port          = 5432
+    server_name   = "postgres.example.com"
+    ssl_mode      = "none"
+  }
+}
+`, rName)
+}
+
+func testAccDataProviderConfig_mysql(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  description        = "MySQL source"
+  engine             = "mysql"
+
+  mysql_settings {
+    port        = 3306
+    server_name = "mysql.example.com"
+    ssl_mode    = "none"
+  }
+}
+`, rName)
+}
+
+func testAccDataProviderConfig_certificate(rName, certificate, sslMode string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_certificate" "test" {
+  certificate_id  = %[1]q
+  certificate_pem = "%[2]s"
+}
+
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  postgres_settings {
+    certificate_arn = aws_dms_certificate.test.certificate_arn
+    database_name   = "postgres"
+    port            = 5432
+    server_name     = "postgres.example.com"
+    ssl_mode        = %[3]q
+  }
+}
+`, rName, certificate, sslMode)
+}
+
+func testAccDataProviderConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  postgres_settings {
+    port        = 5432
+    server_name = "postgres.example.com"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccDataProviderConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_dms_data_provider" "test" {
+  data_provider_name = %[1]q
+  engine             = "postgres"
+
+  postgres_settings {
+    port        = 5432
+    server_name = "postgres.example.com"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
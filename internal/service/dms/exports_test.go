@@ -5,6 +5,11 @@ package dms
 
 // Exports for use in tests only.
 var (
+	ResourceDataMigration = resourceDataMigration
+	ResourceDataProvider  = resourceDataProvider
+
+	FindDataMigrationByARN        = findDataMigrationByARN
+	FindDataProviderByARN         = findDataProviderByARN
 	TaskSettingsEqual             = taskSettingsEqual
 	ValidEndpointID               = validEndpointID
 	ValidReplicationInstanceID    = validReplicationInstanceID
@@ -108,6 +108,38 @@ func ResourceReplicationConfig() *schema.Resource {
 					},
 				},
 			},
+			"provision_data": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date_new_provisioning_data_available": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_provisioned": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_new_provisioning_available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"provision_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provisioned_capacity_units": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason_for_new_provisioning_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"replication_config_identifier": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -147,6 +179,15 @@ func ResourceReplicationConfig() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"start_replication_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(startReplicationType_Values(), false),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"supplemental_settings": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -211,7 +252,7 @@ func resourceReplicationConfigCreate(ctx context.Context, d *schema.ResourceData
 	d.SetId(aws.StringValue(output.ReplicationConfig.ReplicationConfigArn))
 
 	if d.Get("start_replication").(bool) {
-		if err := startReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := startReplication(ctx, conn, d.Id(), d.Get("start_replication_type").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -247,6 +288,21 @@ func resourceReplicationConfigRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("table_mappings", replicationConfig.TableMappings)
 	d.Set("target_endpoint_arn", replicationConfig.TargetEndpointArn)
 
+	replication, err := findReplicationByReplicationConfigARN(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("provision_data", nil)
+		d.Set(names.AttrStatus, nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading DMS Replication Config (%s) replication: %s", d.Id(), err)
+	default:
+		if err := d.Set("provision_data", flattenProvisionData(replication.ProvisionData)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting provision_data: %s", err)
+		}
+		d.Set(names.AttrStatus, replication.Status)
+	}
+
 	return diags
 }
 
@@ -254,7 +310,7 @@ func resourceReplicationConfigUpdate(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DMSConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_replication", "start_replication_type") {
 		if err := stopReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -300,20 +356,20 @@ func resourceReplicationConfigUpdate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if d.Get("start_replication").(bool) {
-			if err := startReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := startReplication(ctx, conn, d.Id(), d.Get("start_replication_type").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
 	}
 
 	if d.HasChange("start_replication") {
-		var f func(context.Context, *dms.DatabaseMigrationService, string, time.Duration) error
+		var err error
 		if d.Get("start_replication").(bool) {
-			f = startReplication
+			err = startReplication(ctx, conn, d.Id(), d.Get("start_replication_type").(string), d.Timeout(schema.TimeoutUpdate))
 		} else {
-			f = stopReplication
+			err = stopReplication(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 		}
-		if err := f(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -554,7 +610,7 @@ func waitReplicationDeleted(ctx context.Context, conn *dms.DatabaseMigrationServ
 	return nil, err
 }
 
-func startReplication(ctx context.Context, conn *dms.DatabaseMigrationService, arn string, timeout time.Duration) error {
+func startReplication(ctx context.Context, conn *dms.DatabaseMigrationService, arn, startReplicationType string, timeout time.Duration) error {
 	replication, err := findReplicationByReplicationConfigARN(ctx, conn, arn)
 
 	if err != nil {
@@ -566,9 +622,11 @@ func startReplication(ctx context.Context, conn *dms.DatabaseMigrationService, a
 		return nil
 	}
 
-	startReplicationType := replicationTypeValueStartReplication
-	if replicationStatus != replicationStatusReady {
-		startReplicationType = replicationTypeValueResumeProcessing
+	if startReplicationType == "" {
+		startReplicationType = replicationTypeValueStartReplication
+		if replicationStatus != replicationStatusReady {
+			startReplicationType = replicationTypeValueResumeProcessing
+		}
 	}
 	input := &dms.StartReplicationInput{
 		ReplicationConfigArn: aws.String(arn),
@@ -641,6 +699,29 @@ func flattenComputeConfig(apiObject *dms.ComputeConfig) []interface{} {
 	return []interface{}{tfMap}
 }
 
+func flattenProvisionData(apiObject *dms.ProvisionData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"is_new_provisioning_available":    aws.BoolValue(apiObject.IsNewProvisioningAvailable),
+		"provision_state":                  aws.StringValue(apiObject.ProvisionState),
+		"provisioned_capacity_units":       aws.Int64Value(apiObject.ProvisionedCapacityUnits),
+		"reason_for_new_provisioning_data": aws.StringValue(apiObject.ReasonForNewProvisioningData),
+	}
+
+	if v := apiObject.DateNewProvisioningDataAvailable; v != nil {
+		tfMap["date_new_provisioning_data_available"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.DateProvisioned; v != nil {
+		tfMap["date_provisioned"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandComputeConfigInput(tfMap map[string]interface{}) *dms.ComputeConfig {
 	if tfMap == nil {
 		return nil
@@ -353,7 +353,10 @@ func TestAccDMSReplicationConfig_startReplication(t *testing.T) {
 				Config: testAccReplicationConfigConfig_startReplication(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "provision_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "provision_data.0.provision_state"),
 					resource.TestCheckResourceAttr(resourceName, "start_replication", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "running"),
 				),
 			},
 			{
@@ -367,6 +370,16 @@ func TestAccDMSReplicationConfig_startReplication(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
 					resource.TestCheckResourceAttr(resourceName, "start_replication", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "stopped"),
+				),
+			},
+			{
+				Config: testAccReplicationConfigConfig_startReplicationType(rName, "resume-processing"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckReplicationConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "start_replication", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "start_replication_type", "resume-processing"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "running"),
 				),
 			},
 		},
@@ -663,6 +676,33 @@ resource "aws_dms_replication_config" "test" {
 `, rName, start))
 }
 
+func testAccReplicationConfigConfig_startReplicationType(rName, startReplicationType string) string {
+	return acctest.ConfigCompose(
+		testAccReplicationConfigConfig_base_ValidDatabase(rName),
+		fmt.Sprintf(`
+resource "aws_dms_replication_config" "test" {
+  replication_config_identifier = %[1]q
+  resource_identifier           = %[1]q
+  replication_type              = "cdc"
+  source_endpoint_arn           = aws_dms_endpoint.source.endpoint_arn
+  target_endpoint_arn           = aws_dms_endpoint.target.endpoint_arn
+  table_mappings                = "{\"rules\":[{\"rule-type\":\"selection\",\"rule-id\":\"1\",\"rule-name\":\"1\",\"object-locator\":{\"schema-name\":\"%%\",\"table-name\":\"%%\"},\"rule-action\":\"include\"}]}"
+
+  start_replication      = true
+  start_replication_type = %[2]q
+
+  compute_config {
+    replication_subnet_group_id  = aws_dms_replication_subnet_group.test.replication_subnet_group_id
+    max_capacity_units           = "128"
+    min_capacity_units           = "2"
+    preferred_maintenance_window = "sun:23:45-mon:00:30"
+  }
+
+  depends_on = [aws_rds_cluster_instance.source, aws_rds_cluster_instance.target]
+}
+`, rName, startReplicationType))
+}
+
 func testAccReplicationConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccReplicationConfigConfig_base_DummyDatabase(rName),
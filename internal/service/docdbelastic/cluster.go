@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -81,6 +82,16 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					enum.FrameworkValidate[awstypes.Auth](),
 				},
 			},
+			"backup_retention_period": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 35),
+				},
+			},
 			names.AttrEndpoint: schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -102,6 +113,13 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"preferred_backup_window": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			names.AttrPreferredMaintenanceWindow: schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -118,6 +136,22 @@ func (r *resourceCluster) Schema(ctx context.Context, _ resource.SchemaRequest,
 					int64validator.Between(1, 32),
 				},
 			},
+			"shard_instance_count": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"snapshot_arn": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrSubnetIDs: schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -161,45 +195,82 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	input := &docdbelastic.CreateClusterInput{
-		ClientToken:       aws.String(id.UniqueId()),
-		AdminUserName:     flex.StringFromFramework(ctx, plan.AdminUserName),
-		AdminUserPassword: flex.StringFromFramework(ctx, plan.AdminUserPassword),
-		AuthType:          awstypes.Auth(plan.AuthType.ValueString()),
-		ClusterName:       flex.StringFromFramework(ctx, plan.Name),
-		ShardCapacity:     flex.Int32FromFramework(ctx, plan.ShardCapacity),
-		ShardCount:        flex.Int32FromFramework(ctx, plan.ShardCount),
-		Tags:              getTagsIn(ctx),
-	}
+	var clusterARN *string
+	if !plan.SnapshotARN.IsNull() && !plan.SnapshotARN.IsUnknown() {
+		input := &docdbelastic.RestoreClusterFromSnapshotInput{
+			ClusterName:         flex.StringFromFramework(ctx, plan.Name),
+			ShardCapacity:       flex.Int32FromFramework(ctx, plan.ShardCapacity),
+			ShardInstanceCount:  flex.Int32FromFramework(ctx, plan.ShardInstanceCount),
+			SnapshotArn:         flex.StringFromFramework(ctx, plan.SnapshotARN),
+			KmsKeyId:            flex.StringFromFramework(ctx, plan.KmsKeyID),
+			SubnetIds:           flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds),
+			Tags:                getTagsIn(ctx),
+			VpcSecurityGroupIds: flex.ExpandFrameworkStringValueSet(ctx, plan.VpcSecurityGroupIds),
+		}
 
-	if !plan.KmsKeyID.IsNull() || !plan.KmsKeyID.IsUnknown() {
-		input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
-	}
+		out, err := conn.RestoreClusterFromSnapshot(ctx, input)
 
-	if !plan.PreferredMaintenanceWindow.IsNull() || !plan.PreferredMaintenanceWindow.IsUnknown() {
-		input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
-	}
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
 
-	if !plan.SubnetIds.IsNull() || !plan.SubnetIds.IsUnknown() {
-		input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
-	}
+		clusterARN = out.Cluster.ClusterArn
+	} else {
+		input := &docdbelastic.CreateClusterInput{
+			ClientToken:        aws.String(id.UniqueId()),
+			AdminUserName:      flex.StringFromFramework(ctx, plan.AdminUserName),
+			AdminUserPassword:  flex.StringFromFramework(ctx, plan.AdminUserPassword),
+			AuthType:           awstypes.Auth(plan.AuthType.ValueString()),
+			ClusterName:        flex.StringFromFramework(ctx, plan.Name),
+			ShardCapacity:      flex.Int32FromFramework(ctx, plan.ShardCapacity),
+			ShardCount:         flex.Int32FromFramework(ctx, plan.ShardCount),
+			ShardInstanceCount: flex.Int32FromFramework(ctx, plan.ShardInstanceCount),
+			Tags:               getTagsIn(ctx),
+		}
 
-	if !plan.VpcSecurityGroupIds.IsNull() || !plan.VpcSecurityGroupIds.IsUnknown() {
-		input.VpcSecurityGroupIds = flex.ExpandFrameworkStringValueSet(ctx, plan.VpcSecurityGroupIds)
-	}
+		if !plan.BackupRetentionPeriod.IsNull() && !plan.BackupRetentionPeriod.IsUnknown() {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+		}
 
-	createOut, err := conn.CreateCluster(ctx, input)
+		if !plan.KmsKeyID.IsNull() || !plan.KmsKeyID.IsUnknown() {
+			input.KmsKeyId = flex.StringFromFramework(ctx, plan.KmsKeyID)
+		}
 
-	if err != nil {
-		response.Diagnostics.AddError(
-			create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
-			err.Error(),
-		)
-		return
+		if !plan.PreferredBackupWindow.IsNull() && !plan.PreferredBackupWindow.IsUnknown() {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+		}
+
+		if !plan.PreferredMaintenanceWindow.IsNull() || !plan.PreferredMaintenanceWindow.IsUnknown() {
+			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
+		}
+
+		if !plan.SubnetIds.IsNull() || !plan.SubnetIds.IsUnknown() {
+			input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
+		}
+
+		if !plan.VpcSecurityGroupIds.IsNull() || !plan.VpcSecurityGroupIds.IsUnknown() {
+			input.VpcSecurityGroupIds = flex.ExpandFrameworkStringValueSet(ctx, plan.VpcSecurityGroupIds)
+		}
+
+		out, err := conn.CreateCluster(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		clusterARN = out.Cluster.ClusterArn
 	}
 
 	state := plan
-	state.ID = flex.StringToFramework(ctx, createOut.Cluster.ClusterArn)
+	state.ID = flex.StringToFramework(ctx, clusterARN)
 
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
 	out, err := waitClusterCreated(ctx, conn, state.ID.ValueString(), createTimeout)
@@ -212,6 +283,41 @@ func (r *resourceCluster) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	// Settings that RestoreClusterFromSnapshot doesn't accept are applied once the restored cluster is active.
+	if !plan.SnapshotARN.IsNull() && !plan.SnapshotARN.IsUnknown() {
+		input := &docdbelastic.UpdateClusterInput{
+			AdminUserPassword: flex.StringFromFramework(ctx, plan.AdminUserPassword),
+			ClientToken:       aws.String(id.UniqueId()),
+			ClusterArn:        flex.StringFromFramework(ctx, state.ID),
+		}
+
+		if v := plan.BackupRetentionPeriod; !v.IsNull() && !v.IsUnknown() && v.ValueInt64() != int64(aws.ToInt32(out.BackupRetentionPeriod)) {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, v)
+		}
+
+		if v := plan.PreferredBackupWindow; !v.IsNull() && !v.IsUnknown() && v.ValueString() != aws.ToString(out.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, v)
+		}
+
+		if v := plan.PreferredMaintenanceWindow; !v.IsNull() && !v.IsUnknown() && v.ValueString() != aws.ToString(out.PreferredMaintenanceWindow) {
+			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, v)
+		}
+
+		if v := plan.ShardCount; v.ValueInt64() != int64(aws.ToInt32(out.ShardCount)) {
+			input.ShardCount = flex.Int32FromFramework(ctx, v)
+		}
+
+		out, err = updateCluster(ctx, conn, input, createTimeout)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionCreating, ResNameCluster, plan.Name.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	state.refreshFromOutput(ctx, out)
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
@@ -263,62 +369,96 @@ func (r *resourceCluster) Update(ctx context.Context, request resource.UpdateReq
 	}
 
 	if clusterHasChanges(ctx, plan, state) {
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
 		input := &docdbelastic.UpdateClusterInput{
 			ClientToken: aws.String(id.UniqueId()),
 			ClusterArn:  flex.StringFromFramework(ctx, state.ID),
 		}
+		var inputChanged bool
 
 		if !plan.AdminUserPassword.Equal(state.AdminUserPassword) {
 			input.AdminUserPassword = flex.StringFromFramework(ctx, plan.AdminUserPassword)
+			inputChanged = true
 		}
 
 		if !plan.AuthType.Equal(state.AuthType) {
 			input.AuthType = awstypes.Auth(plan.AuthType.ValueString())
+			inputChanged = true
+		}
+
+		if !plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) {
+			input.BackupRetentionPeriod = flex.Int32FromFramework(ctx, plan.BackupRetentionPeriod)
+			inputChanged = true
+		}
+
+		if !plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) {
+			input.PreferredBackupWindow = flex.StringFromFramework(ctx, plan.PreferredBackupWindow)
+			inputChanged = true
 		}
 
 		if !plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) {
 			input.PreferredMaintenanceWindow = flex.StringFromFramework(ctx, plan.PreferredMaintenanceWindow)
+			inputChanged = true
 		}
 
 		if !plan.ShardCapacity.Equal(state.ShardCapacity) {
 			input.ShardCapacity = flex.Int32FromFramework(ctx, plan.ShardCapacity)
+			inputChanged = true
 		}
 
-		if !plan.ShardCount.Equal(state.ShardCount) {
-			input.ShardCount = flex.Int32FromFramework(ctx, plan.ShardCount)
+		if !plan.ShardInstanceCount.Equal(state.ShardInstanceCount) {
+			input.ShardInstanceCount = flex.Int32FromFramework(ctx, plan.ShardInstanceCount)
+			inputChanged = true
 		}
 
 		if !plan.SubnetIds.Equal(state.SubnetIds) {
 			input.SubnetIds = flex.ExpandFrameworkStringValueSet(ctx, plan.SubnetIds)
+			inputChanged = true
 		}
 
 		if !plan.VpcSecurityGroupIds.Equal(state.VpcSecurityGroupIds) {
 			input.VpcSecurityGroupIds = flex.ExpandFrameworkStringValueSet(ctx, plan.VpcSecurityGroupIds)
+			inputChanged = true
 		}
 
-		_, err := conn.UpdateCluster(ctx, input)
-
-		if err != nil {
-			response.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
-				err.Error(),
-			)
-			return
+		var out *awstypes.Cluster
+		if inputChanged {
+			var err error
+			out, err = updateCluster(ctx, conn, input, updateTimeout)
+
+			if err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
 		}
 
-		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
-		out, err := waitClusterUpdated(ctx, conn, state.ID.ValueString(), updateTimeout)
-
-		if err != nil {
-			response.Diagnostics.AddError(
-				create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionWaitingForUpdate, ResNameCluster, state.ID.ValueString(), err),
-				err.Error(),
-			)
-			return
+		// Changing the shard count rebalances data across shards (splitting or merging),
+		// so it's applied on its own once any other modifications have completed.
+		if !plan.ShardCount.Equal(state.ShardCount) {
+			input := &docdbelastic.UpdateClusterInput{
+				ClientToken: aws.String(id.UniqueId()),
+				ClusterArn:  flex.StringFromFramework(ctx, state.ID),
+				ShardCount:  flex.Int32FromFramework(ctx, plan.ShardCount),
+			}
+
+			var err error
+			out, err = updateCluster(ctx, conn, input, updateTimeout)
+
+			if err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.DocDBElastic, create.ErrActionUpdating, ResNameCluster, state.ID.ValueString(), err),
+					err.Error(),
+				)
+				return
+			}
 		}
 
-		plan.refreshFromOutput(ctx, out)
-		response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+		if out != nil {
+			plan.refreshFromOutput(ctx, out)
+		}
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
@@ -381,13 +521,17 @@ type resourceClusterData struct {
 	AdminUserPassword          types.String   `tfsdk:"admin_user_password"`
 	ARN                        types.String   `tfsdk:"arn"`
 	AuthType                   types.String   `tfsdk:"auth_type"`
+	BackupRetentionPeriod      types.Int64    `tfsdk:"backup_retention_period"`
 	Endpoint                   types.String   `tfsdk:"endpoint"`
 	ID                         types.String   `tfsdk:"id"`
 	KmsKeyID                   types.String   `tfsdk:"kms_key_id"`
 	Name                       types.String   `tfsdk:"name"`
+	PreferredBackupWindow      types.String   `tfsdk:"preferred_backup_window"`
 	PreferredMaintenanceWindow types.String   `tfsdk:"preferred_maintenance_window"`
 	ShardCapacity              types.Int64    `tfsdk:"shard_capacity"`
 	ShardCount                 types.Int64    `tfsdk:"shard_count"`
+	ShardInstanceCount         types.Int64    `tfsdk:"shard_instance_count"`
+	SnapshotARN                types.String   `tfsdk:"snapshot_arn"`
 	SubnetIds                  types.Set      `tfsdk:"subnet_ids"`
 	Tags                       types.Map      `tfsdk:"tags"`
 	TagsAll                    types.Map      `tfsdk:"tags_all"`
@@ -415,7 +559,7 @@ func waitClusterCreated(ctx context.Context, conn *docdbelastic.Client, id strin
 
 func waitClusterUpdated(ctx context.Context, conn *docdbelastic.Client, id string, timeout time.Duration) (*awstypes.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:                   enum.Slice(awstypes.StatusUpdating),
+		Pending:                   enum.Slice(awstypes.StatusUpdating, awstypes.StatusModifying, awstypes.StatusSplitting, awstypes.StatusMerging),
 		Target:                    enum.Slice(awstypes.StatusActive),
 		Refresh:                   statusCluster(ctx, conn, id),
 		Timeout:                   timeout,
@@ -447,6 +591,16 @@ func waitClusterDeleted(ctx context.Context, conn *docdbelastic.Client, id strin
 	return nil, err
 }
 
+func updateCluster(ctx context.Context, conn *docdbelastic.Client, input *docdbelastic.UpdateClusterInput, timeout time.Duration) (*awstypes.Cluster, error) {
+	_, err := conn.UpdateCluster(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitClusterUpdated(ctx, conn, aws.ToString(input.ClusterArn), timeout)
+}
+
 func statusCluster(ctx context.Context, conn *docdbelastic.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		out, err := findClusterByID(ctx, conn, id)
@@ -490,12 +644,15 @@ func (r *resourceClusterData) refreshFromOutput(ctx context.Context, output *aws
 	r.AdminUserName = flex.StringToFrameworkLegacy(ctx, output.AdminUserName)
 	r.AuthType = flex.StringValueToFramework(ctx, string(output.AuthType))
 	r.ARN = flex.StringToFramework(ctx, output.ClusterArn)
+	r.BackupRetentionPeriod = flex.Int32ToFramework(ctx, output.BackupRetentionPeriod)
 	r.Endpoint = flex.StringToFramework(ctx, output.ClusterEndpoint)
 	r.KmsKeyID = flex.StringToFramework(ctx, output.KmsKeyId)
 	r.Name = flex.StringToFramework(ctx, output.ClusterName)
+	r.PreferredBackupWindow = flex.StringToFramework(ctx, output.PreferredBackupWindow)
 	r.PreferredMaintenanceWindow = flex.StringToFramework(ctx, output.PreferredMaintenanceWindow)
 	r.ShardCapacity = flex.Int32ToFramework(ctx, output.ShardCapacity)
 	r.ShardCount = flex.Int32ToFramework(ctx, output.ShardCount)
+	r.ShardInstanceCount = flex.Int32ToFramework(ctx, output.ShardInstanceCount)
 	r.SubnetIds = flex.FlattenFrameworkStringValueSet(ctx, output.SubnetIds)
 	r.VpcSecurityGroupIds = flex.FlattenFrameworkStringValueSet(ctx, output.VpcSecurityGroupIds)
 }
@@ -504,9 +661,12 @@ func clusterHasChanges(_ context.Context, plan, state resourceClusterData) bool
 	return !plan.Name.Equal(state.Name) ||
 		!plan.AdminUserPassword.Equal(state.AdminUserPassword) ||
 		!plan.AuthType.Equal(state.AuthType) ||
+		!plan.BackupRetentionPeriod.Equal(state.BackupRetentionPeriod) ||
+		!plan.PreferredBackupWindow.Equal(state.PreferredBackupWindow) ||
 		!plan.PreferredMaintenanceWindow.Equal(state.PreferredMaintenanceWindow) ||
 		!plan.ShardCapacity.Equal(state.ShardCapacity) ||
 		!plan.ShardCount.Equal(state.ShardCount) ||
+		!plan.ShardInstanceCount.Equal(state.ShardInstanceCount) ||
 		!plan.SubnetIds.Equal(state.SubnetIds) ||
 		!plan.VpcSecurityGroupIds.Equal(state.VpcSecurityGroupIds)
 }
@@ -7,5 +7,6 @@ package ds
 var (
 	DirectoryIDValidator           = directoryIDValidator
 	DomainWithTrailingDotValidator = domainWithTrailingDotValidator
+	SchemaExtensionParseResourceID = schemaExtensionParseResourceID
 	TrustPasswordValidator         = trustPasswordValidator
 )
@@ -245,3 +245,79 @@ func FindSharedDirectory(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return sharedDirectory, nil
 }
+
+func FindSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) (*directoryservice.SchemaExtensionInfo, error) {
+	input := &directoryservice.ListSchemaExtensionsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+	var output []*directoryservice.SchemaExtensionInfo
+
+	err := listSchemaExtensionsPages(ctx, conn, input, func(page *directoryservice.ListSchemaExtensionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SchemaExtensionsInfo {
+			if v != nil && aws.StringValue(v.SchemaExtensionId) == schemaExtensionID {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	schemaExtension := output[0]
+
+	if status := aws.StringValue(schemaExtension.SchemaExtensionStatus); status == directoryservice.SchemaExtensionStatusCancelled {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return schemaExtension, nil
+}
+
+func FindSnapshotLimits(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) (*directoryservice.SnapshotLimits, error) {
+	input := &directoryservice.GetSnapshotLimitsInput{
+		DirectoryId: aws.String(directoryID),
+	}
+
+	output, err := conn.GetSnapshotLimitsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SnapshotLimits == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SnapshotLimits, nil
+}
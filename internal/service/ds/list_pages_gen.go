@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectories,DescribeRegions"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeDirectories,DescribeRegions,ListSchemaExtensions"; DO NOT EDIT.
 
 package ds
 
@@ -42,3 +42,19 @@ func describeRegionsPages(ctx context.Context, conn directoryserviceiface.Direct
 	}
 	return nil
 }
+func listSchemaExtensionsPages(ctx context.Context, conn directoryserviceiface.DirectoryServiceAPI, input *directoryservice.ListSchemaExtensionsInput, fn func(*directoryservice.ListSchemaExtensionsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListSchemaExtensionsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
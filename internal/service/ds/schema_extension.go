@@ -0,0 +1,258 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_directory_service_schema_extension", name="Schema Extension")
+func ResourceSchemaExtension() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchemaExtensionCreate,
+		ReadWithoutTimeout:   resourceSchemaExtensionRead,
+		DeleteWithoutTimeout: resourceSchemaExtensionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"create_snapshot_before_schema_extension": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			"directory_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(directoryIDRegex, "must be a valid Directory Service Directory ID"),
+			},
+			"end_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ldif_content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 500000),
+			},
+			"schema_extension_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusReason: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSchemaExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID := d.Get("directory_id").(string)
+	createSnapshot := d.Get("create_snapshot_before_schema_extension").(bool)
+
+	if createSnapshot {
+		diags = append(diags, checkSnapshotLimits(ctx, conn, directoryID)...)
+	}
+
+	input := &directoryservice.StartSchemaExtensionInput{
+		CreateSnapshotBeforeSchemaExtension: aws.Bool(createSnapshot),
+		Description:                         aws.String(d.Get(names.AttrDescription).(string)),
+		DirectoryId:                         aws.String(directoryID),
+		LdifContent:                         aws.String(d.Get("ldif_content").(string)),
+	}
+
+	output, err := conn.StartSchemaExtensionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Directory Service Directory (%s) schema extension: %s", directoryID, err)
+	}
+
+	d.SetId(schemaExtensionCreateResourceID(directoryID, aws.StringValue(output.SchemaExtensionId)))
+
+	if _, err := waitSchemaExtensionCompleted(ctx, conn, directoryID, aws.StringValue(output.SchemaExtensionId), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Directory (%s) schema extension (%s) complete: %s", directoryID, aws.StringValue(output.SchemaExtensionId), err)
+	}
+
+	return append(diags, resourceSchemaExtensionRead(ctx, d, meta)...)
+}
+
+func resourceSchemaExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, schemaExtensionID, err := schemaExtensionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Directory Service Directory (%s) schema extension (%s) not found, removing from state", directoryID, schemaExtensionID)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) schema extension (%s): %s", directoryID, schemaExtensionID, err)
+	}
+
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("directory_id", output.DirectoryId)
+	if output.EndDateTime != nil {
+		d.Set("end_date_time", aws.TimeValue(output.EndDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_date_time", nil)
+	}
+	d.Set("schema_extension_id", output.SchemaExtensionId)
+	if output.StartDateTime != nil {
+		d.Set("start_date_time", aws.TimeValue(output.StartDateTime).Format(time.RFC3339))
+	} else {
+		d.Set("start_date_time", nil)
+	}
+	d.Set(names.AttrStatus, output.SchemaExtensionStatus)
+	d.Set(names.AttrStatusReason, output.SchemaExtensionStatusReason)
+
+	return diags
+}
+
+func resourceSchemaExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DSConn(ctx)
+
+	directoryID, schemaExtensionID, err := schemaExtensionParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Directory Service Directory (%s) schema extension (%s): %s", directoryID, schemaExtensionID, err)
+	}
+
+	// Schema extensions that have been applied cannot be removed from the directory.
+	// Only an in-progress extension can be cancelled.
+	switch status := aws.StringValue(output.SchemaExtensionStatus); status {
+	case directoryservice.SchemaExtensionStatusInitializing,
+		directoryservice.SchemaExtensionStatusCreatingSnapshot,
+		directoryservice.SchemaExtensionStatusUpdatingSchema:
+		log.Printf("[DEBUG] Cancelling Directory Service Directory (%s) schema extension (%s)", directoryID, schemaExtensionID)
+		_, err := conn.CancelSchemaExtensionWithContext(ctx, &directoryservice.CancelSchemaExtensionInput{
+			DirectoryId:       aws.String(directoryID),
+			SchemaExtensionId: aws.String(schemaExtensionID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, directoryservice.ErrCodeEntityDoesNotExistException) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "cancelling Directory Service Directory (%s) schema extension (%s): %s", directoryID, schemaExtensionID, err)
+		}
+
+		if _, err := waitSchemaExtensionCancelled(ctx, conn, directoryID, schemaExtensionID, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Directory Service Directory (%s) schema extension (%s) cancel: %s", directoryID, schemaExtensionID, err)
+		}
+	case directoryservice.SchemaExtensionStatusCompleted,
+		directoryservice.SchemaExtensionStatusReplicating:
+		diags = append(diags, errs.NewWarningDiagnostic(
+			"Schema Extension Not Removed",
+			fmt.Sprintf("Directory Service Directory (%s) schema extension (%s) has been applied and cannot be removed from the directory. It has been removed from Terraform state only.", directoryID, schemaExtensionID),
+		))
+	}
+
+	return diags
+}
+
+// checkSnapshotLimits returns a warning if the directory has reached its manual snapshot limit.
+func checkSnapshotLimits(ctx context.Context, conn *directoryservice.DirectoryService, directoryID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	output, err := FindSnapshotLimits(ctx, conn, directoryID)
+
+	if err != nil {
+		return append(diags, errs.NewWarningDiagnostic(
+			"Unable to Read Snapshot Limits",
+			fmt.Sprintf("reading Directory Service Directory (%s) snapshot limits: %s", directoryID, err),
+		))
+	}
+
+	if aws.BoolValue(output.ManualSnapshotsLimitReached) {
+		diags = append(diags, errs.NewWarningDiagnostic(
+			"Snapshot Limit Reached",
+			fmt.Sprintf("Directory Service Directory (%s) has reached its manual snapshot limit (%d of %d). The snapshot taken before the schema extension may fail; delete unused snapshots to free capacity.",
+				directoryID, aws.Int64Value(output.ManualSnapshotsCurrentCount), aws.Int64Value(output.ManualSnapshotsLimit)),
+		))
+	}
+
+	return diags
+}
+
+const schemaExtensionIDSeparator = "," // nosemgrep:ci.ds-in-const-name,ci.ds-in-var-name
+
+func schemaExtensionCreateResourceID(directoryID, schemaExtensionID string) string {
+	parts := []string{directoryID, schemaExtensionID}
+	id := strings.Join(parts, schemaExtensionIDSeparator)
+
+	return id
+}
+
+func schemaExtensionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, schemaExtensionIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DirectoryID%[2]sSchemaExtensionID", id, schemaExtensionIDSeparator)
+}
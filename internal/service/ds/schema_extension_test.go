@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/directoryservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSSchemaExtension_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v directoryservice.SchemaExtensionInfo
+	resourceName := "aws_directory_service_schema_extension.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Applied schema extensions cannot be removed; they are destroyed with the directory.
+		CheckDestroy: testAccCheckDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaExtensionConfig_basic(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSchemaExtensionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "create_snapshot_before_schema_extension", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "directory_id", "aws_directory_service_directory.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "end_date_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "schema_extension_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_date_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, directoryservice.SchemaExtensionStatusCompleted),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_snapshot_before_schema_extension", "ldif_content"},
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaExtensionExists(ctx context.Context, n string, v *directoryservice.SchemaExtensionInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		directoryID, schemaExtensionID, err := tfds.SchemaExtensionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DSConn(ctx)
+
+		output, err := tfds.FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSchemaExtensionConfig_basic(rName, domain string) string {
+	return acctest.ConfigCompose(testAccDirectoryConfig_microsoft(rName, domain), fmt.Sprintf(`
+locals {
+  domain_dn = join(",", [for dc in split(".", aws_directory_service_directory.test.name) : "DC=${dc}"])
+}
+
+resource "aws_directory_service_schema_extension" "test" {
+  directory_id = aws_directory_service_directory.test.id
+  description  = %[1]q
+
+  ldif_content = <<-EOT
+dn: CN=tfacctest-EmployeeBadge,CN=Schema,CN=Configuration,${local.domain_dn}
+changetype: add
+objectClass: attributeSchema
+attributeID: 1.2.840.113556.1.8000.2554.41227.18045.49826.18927.47286.9519836.7412203.1
+attributeSyntax: 2.5.5.12
+isSingleValued: TRUE
+lDAPDisplayName: tfacctestEmployeeBadge
+adminDisplayName: tfacctest-EmployeeBadge
+oMSyntax: 64
+searchFlags: 1
+
+dn:
+changetype: modify
+add: schemaUpdateNow
+schemaUpdateNow: 1
+-
+EOT
+}
+`, rName))
+}
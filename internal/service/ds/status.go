@@ -107,3 +107,19 @@ func statusSharedDirectory(ctx context.Context, conn *directoryservice.Directory
 		return output, aws.StringValue(output.ShareStatus), nil
 	}
 }
+
+func statusSchemaExtension(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSchemaExtension(ctx, conn, directoryID, schemaExtensionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.SchemaExtensionStatus), nil
+	}
+}
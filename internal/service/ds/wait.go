@@ -146,3 +146,54 @@ func waitDirectoryShared(ctx context.Context, conn *directoryservice.DirectorySe
 
 	return nil, err
 }
+
+func waitSchemaExtensionCompleted(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusReplicating,
+		},
+		Target:     []string{directoryservice.SchemaExtensionStatusCompleted},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSchemaExtensionCancelled(ctx context.Context, conn *directoryservice.DirectoryService, directoryID, schemaExtensionID string, timeout time.Duration) (*directoryservice.SchemaExtensionInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			directoryservice.SchemaExtensionStatusInitializing,
+			directoryservice.SchemaExtensionStatusCreatingSnapshot,
+			directoryservice.SchemaExtensionStatusUpdatingSchema,
+			directoryservice.SchemaExtensionStatusCancelInProgress,
+			directoryservice.SchemaExtensionStatusRollbackInProgress,
+		},
+		Target:     []string{},
+		Refresh:    statusSchemaExtension(ctx, conn, directoryID, schemaExtensionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directoryservice.SchemaExtensionInfo); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.SchemaExtensionStatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -11,6 +11,8 @@ import (
 	"strings"
 	"time"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -82,6 +84,24 @@ func ResourceAMI() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -382,6 +402,12 @@ func resourceAMICreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s): %s", name, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -457,6 +483,17 @@ func resourceAMIRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return sdkdiag.AppendErrorf(diags, "setting ephemeral_block_device: %s", err)
 	}
 
+	// Deregistration protection is managed using the AWS SDK for Go v2.
+	imageV2, err := FindImageByIDV2(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 AMI (%s) deregistration protection: %s", d.Id(), err)
+	}
+
+	if err := d.Set("deregistration_protection", flattenImageDeregistrationProtection(aws_sdkv2.ToString(imageV2.DeregistrationProtection), d.Get("deregistration_protection").([]interface{}))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deregistration_protection: %s", err)
+	}
+
 	setTagsOut(ctx, image.Tags)
 
 	return diags
@@ -485,6 +522,18 @@ func resourceAMIUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("deregistration_protection") {
+		tfMap := map[string]interface{}{}
+
+		if v := d.Get("deregistration_protection").([]interface{}); len(v) > 0 && v[0] != nil {
+			tfMap = v[0].(map[string]interface{})
+		}
+
+		if err := updateImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id(), tfMap); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 AMI (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
 
@@ -492,6 +541,15 @@ func resourceAMIDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	// A protected image must have its protection disabled before it can be deregistered.
+	if err := disableImageDeregistrationProtectionForDelete(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id()); err != nil {
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deregistering EC2 AMI (%s): %s", d.Id(), err)
+	}
+
 	log.Printf("[INFO] Deleting EC2 AMI: %s", d.Id())
 	_, err := conn.DeregisterImageWithContext(ctx, &ec2.DeregisterImageInput{
 		ImageId: aws.String(d.Id()),
@@ -602,6 +660,114 @@ func disableImageDeprecation(ctx context.Context, conn *ec2.EC2, id string) erro
 	return nil
 }
 
+const (
+	imageDeregistrationProtectionDisabledUntilPrefix = "disabled-until "
+	imageDeregistrationProtectionEnabled             = "enabled"
+	imageDeregistrationProtectionEnabledWithCooldown = "enabled-with-cooldown"
+)
+
+func updateImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string, tfMap map[string]interface{}) error {
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok && v {
+		withCooldown, _ := tfMap["with_cooldown"].(bool)
+		input := &ec2_sdkv2.EnableImageDeregistrationProtectionInput{
+			ImageId:      aws_sdkv2.String(id),
+			WithCooldown: aws_sdkv2.Bool(withCooldown),
+		}
+
+		if _, err := conn.EnableImageDeregistrationProtection(ctx, input); err != nil {
+			return fmt.Errorf("enabling deregistration protection: %w", err)
+		}
+
+		expected := imageDeregistrationProtectionEnabled
+		if withCooldown {
+			expected = imageDeregistrationProtectionEnabledWithCooldown
+		}
+
+		if err := waitImageDeregistrationProtectionUpdated(ctx, conn, id, func(v string) bool { return v == expected }); err != nil {
+			return fmt.Errorf("enabling deregistration protection: waiting for completion: %w", err)
+		}
+
+		return nil
+	}
+
+	return disableImageDeregistrationProtection(ctx, conn, id)
+}
+
+func disableImageDeregistrationProtection(ctx context.Context, conn *ec2_sdkv2.Client, id string) error {
+	input := &ec2_sdkv2.DisableImageDeregistrationProtectionInput{
+		ImageId: aws_sdkv2.String(id),
+	}
+
+	if _, err := conn.DisableImageDeregistrationProtection(ctx, input); err != nil {
+		return fmt.Errorf("disabling deregistration protection: %w", err)
+	}
+
+	if err := waitImageDeregistrationProtectionUpdated(ctx, conn, id, func(v string) bool { return !imageDeregistrationProtectionIsEnabled(v) }); err != nil {
+		return fmt.Errorf("disabling deregistration protection: waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
+// disableImageDeregistrationProtectionForDelete disables any deregistration protection on the image.
+// If protection was enabled with a cooldown, the image can't be deregistered until the cooldown period ends,
+// which is longer than any reasonable delete timeout, so an error is returned instead of waiting.
+func disableImageDeregistrationProtectionForDelete(ctx context.Context, conn *ec2_sdkv2.Client, id string) error {
+	image, err := FindImageByIDV2(ctx, conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	status := aws_sdkv2.ToString(image.DeregistrationProtection)
+
+	if imageDeregistrationProtectionIsEnabled(status) {
+		if err := disableImageDeregistrationProtection(ctx, conn, id); err != nil {
+			return err
+		}
+
+		image, err = FindImageByIDV2(ctx, conn, id)
+
+		if err != nil {
+			return err
+		}
+
+		status = aws_sdkv2.ToString(image.DeregistrationProtection)
+	}
+
+	if until, ok := strings.CutPrefix(status, imageDeregistrationProtectionDisabledUntilPrefix); ok {
+		return fmt.Errorf("deregistration protection was enabled with a cooldown; the image can't be deregistered until %s", until)
+	}
+
+	return nil
+}
+
+func imageDeregistrationProtectionIsEnabled(status string) bool {
+	return status == imageDeregistrationProtectionEnabled || status == imageDeregistrationProtectionEnabledWithCooldown
+}
+
+func flattenImageDeregistrationProtection(status string, configured []interface{}) []interface{} {
+	switch status {
+	case imageDeregistrationProtectionEnabled, imageDeregistrationProtectionEnabledWithCooldown:
+		return []interface{}{map[string]interface{}{
+			names.AttrEnabled: true,
+			"with_cooldown":   status == imageDeregistrationProtectionEnabledWithCooldown,
+		}}
+	}
+
+	// Protection is disabled. Keep an explicitly disabled configuration block to avoid a perpetual diff.
+	if len(configured) > 0 && configured[0] != nil {
+		tfMap := configured[0].(map[string]interface{})
+
+		return []interface{}{map[string]interface{}{
+			names.AttrEnabled: false,
+			"with_cooldown":   tfMap["with_cooldown"],
+		}}
+	}
+
+	return nil
+}
+
 func expandBlockDeviceMappingForAMIEBSBlockDevice(tfMap map[string]interface{}) *ec2.BlockDeviceMapping {
 	if tfMap == nil {
 		return nil
@@ -911,3 +1077,24 @@ func waitImageDeprecationTimeDisabled(ctx context.Context, conn *ec2.EC2, imageI
 		},
 	)
 }
+
+func waitImageDeregistrationProtectionUpdated(ctx context.Context, conn *ec2_sdkv2.Client, imageID string, expected func(string) bool) error {
+	return tfresource.WaitUntil(ctx, imageDeprecationPropagationTimeout, func() (bool, error) {
+		output, err := FindImageByIDV2(ctx, conn, imageID)
+
+		if tfresource.NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return expected(aws_sdkv2.ToString(output.DeregistrationProtection)), nil
+	},
+		tfresource.WaitOpts{
+			Delay:      amiRetryDelay,
+			MinTimeout: amiRetryMinTimeout,
+		},
+	)
+}
@@ -62,6 +62,24 @@ func ResourceAMICopy() *schema.Resource {
 				DiffSuppressFunc:      verify.SuppressEquivalentRoundedTime(time.RFC3339, time.Minute),
 				DiffSuppressOnRefresh: true,
 			},
+			"deregistration_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"with_cooldown": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -316,5 +334,11 @@ func resourceAMICopyCreate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if v, ok := d.GetOk("deregistration_protection"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := updateImageDeregistrationProtection(ctx, meta.(*conns.AWSClient).EC2Client(ctx), d.Id(), v.([]interface{})[0].(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 AMI (%s) from source EC2 AMI (%s): %s", name, sourceImageID, err)
+		}
+	}
+
 	return append(diags, resourceAMIRead(ctx, d, meta)...)
 }
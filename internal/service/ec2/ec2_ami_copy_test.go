@@ -46,6 +46,32 @@ func TestAccEC2AMICopy_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMICopy_deprecationAndDeregistrationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var image ec2.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_copy.test"
+	deprecateAt := "2027-10-15T13:17:00.000Z"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMICopyConfig_deprecationAndDeregistrationProtection(rName, deprecateAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecateAt),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.0.enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMICopy_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var image ec2.Image
@@ -301,6 +327,32 @@ resource "aws_ami_copy" "test" {
 `, rName, rName))
 }
 
+func testAccAMICopyConfig_deprecationAndDeregistrationProtection(rName, deprecateAt string) string {
+	return acctest.ConfigCompose(testAccAMICopyBaseConfig(rName), fmt.Sprintf(`
+resource "aws_ami" "test" {
+  name                = "%[1]s-source"
+  virtualization_type = "hvm"
+  root_device_name    = "/dev/sda1"
+
+  ebs_block_device {
+    device_name = "/dev/sda1"
+    snapshot_id = aws_ebs_snapshot.test.id
+  }
+}
+
+resource "aws_ami_copy" "test" {
+  name              = %[1]q
+  deprecation_time  = %[2]q
+  source_ami_id     = aws_ami.test.id
+  source_ami_region = data.aws_region.current.name
+
+  deregistration_protection {
+    enabled = true
+  }
+}
+`, rName, deprecateAt))
+}
+
 func testAccAMICopyConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(testAccAMICopyBaseConfig(rName), fmt.Sprintf(`
 resource "aws_ami" "test" {
@@ -46,6 +46,32 @@ func TestAccEC2AMIFromInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccEC2AMIFromInstance_deprecationAndDeregistrationProtection(t *testing.T) {
+	ctx := acctest.Context(t)
+	var image ec2.Image
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ami_from_instance.test"
+	deprecateAt := "2027-10-15T13:17:00.000Z"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAMIDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAMIFromInstanceConfig_deprecationAndDeregistrationProtection(rName, deprecateAt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAMIExists(ctx, resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "deprecation_time", deprecateAt),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deregistration_protection.0.enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2AMIFromInstance_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var image ec2.Image
@@ -139,6 +165,22 @@ resource "aws_ami_from_instance" "test" {
 `, rName))
 }
 
+func testAccAMIFromInstanceConfig_deprecationAndDeregistrationProtection(rName, deprecateAt string) string {
+	return acctest.ConfigCompose(
+		testAccAMIFromInstanceBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_ami_from_instance" "test" {
+  name               = %[1]q
+  deprecation_time   = %[2]q
+  source_instance_id = aws_instance.test.id
+
+  deregistration_protection {
+    enabled = true
+  }
+}
+`, rName, deprecateAt))
+}
+
 func testAccAMIFromInstanceConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccAMIFromInstanceBaseConfig(rName),
@@ -96,6 +96,11 @@ func resourceEIP() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"network_border_group": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -171,6 +176,10 @@ func resourceEIPCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		input.Domain = types.DomainTypeVpc
 	}
 
+	if v, ok := d.GetOk("ipam_pool_id"); ok {
+		input.IpamPoolId = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("network_border_group"); ok {
 		input.NetworkBorderGroup = aws.String(v.(string))
 	}
@@ -503,6 +503,42 @@ func TestAccEC2EIP_PublicIPv4Pool_custom(t *testing.T) {
 	})
 }
 
+func TestAccEC2EIP_ipamPoolID(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_EC2_EIP_IPAM_POOL_ID"
+	poolID := os.Getenv(key)
+	if poolID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var conf types.Address
+	resourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEIPDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPConfig_ipamPoolID(rName, poolID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "ipam_pool_id", poolID),
+					resource.TestCheckResourceAttrSet(resourceName, "public_ip"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipam_pool_id"},
+			},
+		},
+	})
+}
+
 func TestAccEC2EIP_customerOwnedIPv4Pool(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf types.Address
@@ -1137,6 +1173,19 @@ resource "aws_eip" "test" {
 `, rName)
 }
 
+func testAccEIPConfig_ipamPoolID(rName, poolID string) string {
+	return fmt.Sprintf(`
+resource "aws_eip" "test" {
+  domain       = "vpc"
+  ipam_pool_id = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, poolID)
+}
+
 func testAccEIPConfig_publicIPv4PoolCustom(rName, poolName string) string {
 	return fmt.Sprintf(`
 resource "aws_eip" "test" {
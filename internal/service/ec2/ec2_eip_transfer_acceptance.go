@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_eip_transfer_acceptance", name="EIP Transfer Acceptance")
+func newEIPTransferAcceptanceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &eipTransferAcceptanceResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(3 * time.Minute)
+
+	return r, nil
+}
+
+type eipTransferAcceptanceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[eipTransferAcceptanceResourceModel]
+	framework.WithTimeouts
+}
+
+func (*eipTransferAcceptanceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_eip_transfer_acceptance"
+}
+
+func (r *eipTransferAcceptanceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAddress: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allocation_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *eipTransferAcceptanceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data eipTransferAcceptanceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	address := data.Address.ValueString()
+	input := &ec2.AcceptAddressTransferInput{
+		Address: aws.String(address),
+	}
+
+	_, err := conn.AcceptAddressTransfer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("accepting EC2 EIP (%s) transfer", address), err.Error())
+
+		return
+	}
+
+	// The address is allocated to this account once the transfer completes.
+	output, err := waitEIPTransferAccepted(ctx, conn, address, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP (%s) transfer", address), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+	data.ID = data.AllocationID
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *eipTransferAcceptanceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data eipTransferAcceptanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	output, err := findEIPByAllocationID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 EIP Transfer Acceptance (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Address = fwflex.StringToFramework(ctx, output.PublicIp)
+	data.AllocationID = fwflex.StringToFramework(ctx, output.AllocationId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *eipTransferAcceptanceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data eipTransferAcceptanceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	// The accepted address is owned by this account, so it is released like any other EIP.
+	_, err := conn.ReleaseAddress(ctx, &ec2.ReleaseAddressInput{
+		AllocationId: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("releasing EC2 EIP (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, r.DeleteTimeout(ctx, data.Timeouts), func() (interface{}, error) {
+		return findEIPByAllocationID(ctx, conn, data.ID.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 EIP (%s) release", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type eipTransferAcceptanceResourceModel struct {
+	Address      types.String   `tfsdk:"address"`
+	AllocationID types.String   `tfsdk:"allocation_id"`
+	ID           types.String   `tfsdk:"id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
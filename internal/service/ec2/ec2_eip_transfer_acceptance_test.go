@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The Elastic IP address must have a pending transfer to the test account,
+// enabled from the source account with `aws ec2 enable-address-transfer`.
+func TestAccEC2EIPTransferAcceptance_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_EC2_EIP_TRANSFER_ADDRESS"
+	address := os.Getenv(key)
+	if address == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_eip_transfer_acceptance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEIPTransferAcceptanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPTransferAcceptanceConfig_basic(address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPTransferAcceptanceExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAddress, address),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEIPTransferAcceptanceExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindEIPByAllocationID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEIPTransferAcceptanceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_transfer_acceptance" {
+				continue
+			}
+
+			_, err := tfec2.FindEIPByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 EIP %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEIPTransferAcceptanceConfig_basic(address string) string {
+	return fmt.Sprintf(`
+resource "aws_eip_transfer_acceptance" "test" {
+  address = %[1]q
+}
+`, address)
+}
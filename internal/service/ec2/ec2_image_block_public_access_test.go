@@ -37,6 +37,11 @@ func testAccImageBlockPublicAccess_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, "unblocked"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccImageBlockPublicAccessConfig_basic("block-new-sharing"),
 				Check: resource.ComposeTestCheckFunc(
@@ -39,6 +39,7 @@ func newInstanceMetadataDefaultsResource(_ context.Context) (resource.ResourceWi
 
 type instanceMetadataDefaultsResource struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByID
 }
 
 func (*instanceMetadataDefaultsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -20,6 +20,8 @@ var (
 	ResourceInstanceConnectEndpoint          = newInstanceConnectEndpointResource
 	ResourceInstanceMetadataDefaults         = newInstanceMetadataDefaultsResource
 	ResourceIPAM                             = resourceIPAM
+	ResourceIPAMByoasn                       = resourceIPAMByoasn
+	ResourceIPAMByoasnAssociation            = resourceIPAMByoasnAssociation
 	ResourceIPAMOrganizationAdminAccount     = resourceIPAMOrganizationAdminAccount
 	ResourceIPAMPool                         = resourceIPAMPool
 	ResourceIPAMPoolCIDR                     = resourceIPAMPoolCIDR
@@ -60,6 +62,8 @@ var (
 	FindFastSnapshotRestoreByTwoPartKey                    = findFastSnapshotRestoreByTwoPartKey
 	FindInstanceMetadataDefaults                           = findInstanceMetadataDefaults
 	FindIPAMByID                                           = findIPAMByID
+	FindIPAMByoasnAssociationByTwoPartKey                  = findIPAMByoasnAssociationByTwoPartKey
+	FindIPAMByoasnByTwoPartKey                             = findIPAMByoasnByTwoPartKey
 	FindIPAMPoolAllocationByTwoPartKey                     = findIPAMPoolAllocationByTwoPartKey
 	FindIPAMPoolByID                                       = findIPAMPoolByID
 	FindIPAMPoolCIDRByTwoPartKey                           = findIPAMPoolCIDRByTwoPartKey
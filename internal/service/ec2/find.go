@@ -338,6 +338,27 @@ func findEIPByAllocationID(ctx context.Context, conn *ec2_sdkv2.Client, id strin
 	return output, nil
 }
 
+func findEIPByPublicIP(ctx context.Context, conn *ec2_sdkv2.Client, ip string) (*awstypes.Address, error) {
+	input := &ec2_sdkv2.DescribeAddressesInput{
+		PublicIps: []string{ip},
+	}
+
+	output, err := findEIP(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws_sdkv2.ToString(output.PublicIp) != ip {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findEIPByAssociationID(ctx context.Context, conn *ec2_sdkv2.Client, id string) (*awstypes.Address, error) {
 	input := &ec2_sdkv2.DescribeAddressesInput{
 		Filters: newAttributeFilterListV2(map[string]string{
@@ -548,6 +569,68 @@ func FindImageByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.Image, e
 	return output, nil
 }
 
+func FindImagesV2(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeImagesInput) ([]awstypes.Image, error) {
+	var output []awstypes.Image
+
+	pages := ec2_sdkv2.NewDescribeImagesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr_sdkv2.ErrCodeEquals(err, errCodeInvalidAMIIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Images...)
+	}
+
+	return output, nil
+}
+
+func FindImageV2(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeImagesInput) (*awstypes.Image, error) {
+	output, err := FindImagesV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func FindImageByIDV2(ctx context.Context, conn *ec2_sdkv2.Client, id string) (*awstypes.Image, error) {
+	input := &ec2_sdkv2.DescribeImagesInput{
+		ImageIds: []string{id},
+	}
+
+	output, err := FindImageV2(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.ImageStateDeregistered {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws_sdkv2.ToString(output.ImageId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func FindImageAttribute(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeImageAttributeInput) (*ec2.DescribeImageAttributeOutput, error) {
 	output, err := conn.DescribeImageAttributeWithContext(ctx, input)
 
@@ -5612,6 +5695,21 @@ func FindImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func FindSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) (awstypes.SnapshotBlockPublicAccessState, error) {
+	input := &ec2_sdkv2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.State == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.State, nil
+}
+
 func FindVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, input *ec2_sdkv2.DescribeVerifiedAccessEndpointsInput) (*awstypes.VerifiedAccessEndpoint, error) {
 	output, err := FindVerifiedAccessEndpoints(ctx, conn, input)
 
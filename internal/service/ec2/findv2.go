@@ -1421,6 +1421,32 @@ func findVPNConnectionByID(ctx context.Context, conn *ec2.Client, id string) (*a
 	return output, nil
 }
 
+func findVPNTunnelReplacementStatusByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, outsideIPAddress string) (*ec2.GetVpnTunnelReplacementStatusOutput, error) {
+	input := &ec2.GetVpnTunnelReplacementStatusInput{
+		VpnConnectionId:           aws.String(vpnConnectionID),
+		VpnTunnelOutsideIpAddress: aws.String(outsideIPAddress),
+	}
+
+	output, err := conn.GetVpnTunnelReplacementStatus(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MaintenanceDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func findVPNConnectionRouteByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, cidrBlock string) (*awstypes.VpnStaticRoute, error) {
 	input := &ec2.DescribeVpnConnectionsInput{
 		Filters: newAttributeFilterListV2(map[string]string{
@@ -1683,6 +1709,123 @@ func findIPAMByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.I
 	return output, nil
 }
 
+func findIPAMByoasn(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamByoasnInput, filter tfslices.Predicate[*awstypes.Byoasn]) (*awstypes.Byoasn, error) {
+	output, err := findIPAMByoasns(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findIPAMByoasns(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamByoasnInput, filter tfslices.Predicate[*awstypes.Byoasn]) ([]awstypes.Byoasn, error) {
+	var output []awstypes.Byoasn
+
+	for {
+		page, err := conn.DescribeIpamByoasn(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Byoasns {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findIPAMByoasnByTwoPartKey(ctx context.Context, conn *ec2.Client, asn, ipamID string) (*awstypes.Byoasn, error) {
+	input := &ec2.DescribeIpamByoasnInput{}
+
+	output, err := findIPAMByoasn(ctx, conn, input, func(v *awstypes.Byoasn) bool {
+		return aws.ToString(v.Asn) == asn && aws.ToString(v.IpamId) == ipamID
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.AsnStateDeprovisioned {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findByoipCIDRs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeByoipCidrsInput, filter tfslices.Predicate[*awstypes.ByoipCidr]) ([]awstypes.ByoipCidr, error) {
+	var output []awstypes.ByoipCidr
+
+	pages := ec2.NewDescribeByoipCidrsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ByoipCidrs {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findIPAMByoasnAssociationByTwoPartKey(ctx context.Context, conn *ec2.Client, asn, cidrBlock string) (*awstypes.AsnAssociation, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	byoipCIDRs, err := findByoipCIDRs(ctx, conn, input, func(v *awstypes.ByoipCidr) bool {
+		return aws.ToString(v.Cidr) == cidrBlock
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	byoipCIDR, err := tfresource.AssertSingleValueResult(byoipCIDRs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	associations := tfslices.Filter(byoipCIDR.AsnAssociations, func(v awstypes.AsnAssociation) bool {
+		return aws.ToString(v.Asn) == asn
+	})
+
+	output, err := tfresource.AssertSingleValueResult(associations)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := output.State; state == awstypes.AsnAssociationStateDisassociated {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findIPAMPool(ctx context.Context, conn *ec2.Client, input *ec2.DescribeIpamPoolsInput) (*awstypes.IpamPool, error) {
 	output, err := findIPAMPools(ctx, conn, input)
 
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_ipam_byoasn", name="IPAM BYOASN")
+func resourceIPAMByoasn() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAMByoasnCreate,
+		ReadWithoutTimeout:   resourceIPAMByoasnRead,
+		DeleteWithoutTimeout: resourceIPAMByoasnDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"asn_authorization_context": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"signature": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"ipam_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const ipamByoasnIDPartCount = 2
+
+func resourceIPAMByoasnCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	asn, ipamID := d.Get("asn").(string), d.Get("ipam_id").(string)
+	id, err := flex.FlattenResourceId([]string{asn, ipamID}, ipamByoasnIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &ec2.ProvisionIpamByoasnInput{
+		Asn:    aws.String(asn),
+		IpamId: aws.String(ipamID),
+	}
+
+	if v, ok := d.GetOk("asn_authorization_context"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AsnAuthorizationContext = expandASNAuthorizationContext(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	_, err = conn.ProvisionIpamByoasn(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IPAM BYOASN (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitIPAMByoasnProvisioned(ctx, conn, asn, ipamID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIPAMByoasnRead(ctx, d, meta)...)
+}
+
+func resourceIPAMByoasnRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ipamByoasnIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	asn, ipamID := parts[0], parts[1]
+	output, err := findIPAMByoasnByTwoPartKey(ctx, conn, asn, ipamID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IPAM BYOASN (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM BYOASN (%s): %s", d.Id(), err)
+	}
+
+	d.Set("asn", output.Asn)
+	d.Set("ipam_id", output.IpamId)
+	d.Set(names.AttrState, output.State)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+
+	return diags
+}
+
+func resourceIPAMByoasnDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ipamByoasnIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	asn, ipamID := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting IPAM BYOASN: %s", d.Id())
+	_, err = conn.DeprovisionIpamByoasn(ctx, &ec2.DeprovisionIpamByoasnInput{
+		Asn:    aws.String(asn),
+		IpamId: aws.String(ipamID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidIPAMIdNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IPAM BYOASN (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIPAMByoasnDeprovisioned(ctx, conn, asn, ipamID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func expandASNAuthorizationContext(tfMap map[string]interface{}) *awstypes.AsnAuthorizationContext {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.AsnAuthorizationContext{}
+
+	if v, ok := tfMap[names.AttrMessage].(string); ok && v != "" {
+		apiObject.Message = aws.String(v)
+	}
+
+	if v, ok := tfMap["signature"].(string); ok && v != "" {
+		apiObject.Signature = aws.String(v)
+	}
+
+	return apiObject
+}
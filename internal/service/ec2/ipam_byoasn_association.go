@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpc_ipam_byoasn_association", name="IPAM BYOASN Association")
+func resourceIPAMByoasnAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceIPAMByoasnAssociationCreate,
+		ReadWithoutTimeout:   resourceIPAMByoasnAssociationRead,
+		DeleteWithoutTimeout: resourceIPAMByoasnAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.Any(
+					verify.ValidIPv4CIDRNetworkAddress,
+					verify.ValidIPv6CIDRNetworkAddress,
+				),
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const ipamByoasnAssociationIDPartCount = 2
+
+func resourceIPAMByoasnAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	asn, cidrBlock := d.Get("asn").(string), d.Get("cidr").(string)
+	id, err := flex.FlattenResourceId([]string{asn, cidrBlock}, ipamByoasnAssociationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &ec2.AssociateIpamByoasnInput{
+		Asn:  aws.String(asn),
+		Cidr: aws.String(cidrBlock),
+	}
+
+	_, err = conn.AssociateIpamByoasn(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IPAM BYOASN Association (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitIPAMByoasnAssociationCreated(ctx, conn, asn, cidrBlock, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN Association (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceIPAMByoasnAssociationRead(ctx, d, meta)...)
+}
+
+func resourceIPAMByoasnAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ipamByoasnAssociationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	asn, cidrBlock := parts[0], parts[1]
+	output, err := findIPAMByoasnAssociationByTwoPartKey(ctx, conn, asn, cidrBlock)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IPAM BYOASN Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM BYOASN Association (%s): %s", d.Id(), err)
+	}
+
+	d.Set("asn", output.Asn)
+	d.Set("cidr", output.Cidr)
+	d.Set(names.AttrState, output.State)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+
+	return diags
+}
+
+func resourceIPAMByoasnAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), ipamByoasnAssociationIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	asn, cidrBlock := parts[0], parts[1]
+
+	log.Printf("[DEBUG] Deleting IPAM BYOASN Association: %s", d.Id())
+	_, err = conn.DisassociateIpamByoasn(ctx, &ec2.DisassociateIpamByoasnInput{
+		Asn:  aws.String(asn),
+		Cidr: aws.String(cidrBlock),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IPAM BYOASN Association (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitIPAMByoasnAssociationDeleted(ctx, conn, asn, cidrBlock, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IPAM BYOASN Association (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
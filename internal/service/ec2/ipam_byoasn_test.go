@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Due to the nature of BYOASN, the provisioning and association of an ASN are
+// exercised in a single test so that the dependencies can be shared.
+func TestAccIPAM_byoasn(t *testing.T) {
+	ctx := acctest.Context(t)
+	asn, message, signature, cidr := os.Getenv("IPAM_BYOASN_ASN"), os.Getenv("IPAM_BYOASN_MESSAGE"), os.Getenv("IPAM_BYOASN_SIGNATURE"), os.Getenv("IPAM_BYOASN_CIDR")
+	if asn == "" || message == "" || signature == "" || cidr == "" {
+		t.Skip("Environment variable IPAM_BYOASN_ASN, IPAM_BYOASN_MESSAGE, IPAM_BYOASN_SIGNATURE, or IPAM_BYOASN_CIDR is not set")
+	}
+
+	var byoasn awstypes.Byoasn
+	var association awstypes.AsnAssociation
+	resourceName := "aws_vpc_ipam_byoasn.test"
+	associationResourceName := "aws_vpc_ipam_byoasn_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckIPAMByoasnAssociationDestroy(ctx),
+			testAccCheckIPAMByoasnDestroy(ctx),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMByoasnConfig_basic(asn, message, signature),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMByoasnExists(ctx, resourceName, &byoasn),
+					resource.TestCheckResourceAttr(resourceName, "asn", asn),
+					resource.TestCheckResourceAttrPair(resourceName, "ipam_id", "aws_vpc_ipam.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.AsnStateProvisioned)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"asn_authorization_context"},
+			},
+			{
+				Config: testAccIPAMByoasnConfig_association(asn, message, signature, cidr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMByoasnAssociationExists(ctx, associationResourceName, &association),
+					resource.TestCheckResourceAttr(associationResourceName, "asn", asn),
+					resource.TestCheckResourceAttr(associationResourceName, "cidr", cidr),
+					resource.TestCheckResourceAttr(associationResourceName, names.AttrState, string(awstypes.AsnAssociationStateAssociated)),
+				),
+			},
+			{
+				ResourceName:      associationResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIPAMByoasnExists(ctx context.Context, n string, v *awstypes.Byoasn) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindIPAMByoasnByTwoPartKey(ctx, conn, rs.Primary.Attributes["asn"], rs.Primary.Attributes["ipam_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMByoasnDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_ipam_byoasn" {
+				continue
+			}
+
+			_, err := tfec2.FindIPAMByoasnByTwoPartKey(ctx, conn, rs.Primary.Attributes["asn"], rs.Primary.Attributes["ipam_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IPAM BYOASN %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMByoasnAssociationExists(ctx context.Context, n string, v *awstypes.AsnAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindIPAMByoasnAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["asn"], rs.Primary.Attributes["cidr"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIPAMByoasnAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_ipam_byoasn_association" {
+				continue
+			}
+
+			_, err := tfec2.FindIPAMByoasnAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["asn"], rs.Primary.Attributes["cidr"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IPAM BYOASN Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccIPAMByoasnConfig_basic(asn, message, signature string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_ipam" "test" {
+  tier = "advanced"
+
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+}
+
+resource "aws_vpc_ipam_byoasn" "test" {
+  asn     = %[1]q
+  ipam_id = aws_vpc_ipam.test.id
+
+  asn_authorization_context {
+    message   = %[2]q
+    signature = %[3]q
+  }
+}
+`, asn, message, signature)
+}
+
+func testAccIPAMByoasnConfig_association(asn, message, signature, cidr string) string {
+	return acctest.ConfigCompose(testAccIPAMByoasnConfig_basic(asn, message, signature), fmt.Sprintf(`
+resource "aws_vpc_ipam_byoasn_association" "test" {
+  asn  = aws_vpc_ipam_byoasn.test.asn
+  cidr = %[1]q
+}
+`, cidr))
+}
@@ -75,6 +75,18 @@ func resourceIPAMResourceDiscovery() *schema.Resource {
 					},
 				},
 			},
+			"organizational_unit_exclusion": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organizations_entity_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			names.AttrOwnerID: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -129,6 +141,24 @@ func resourceIPAMResourceDiscoveryCreate(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "waiting for IPAM Resource Discovery (%s) create: %s", d.Id(), err)
 	}
 
+	// Organizational unit exclusions can only be configured once the resource discovery exists.
+	if v, ok := d.GetOk("organizational_unit_exclusion"); ok && v.(*schema.Set).Len() > 0 {
+		input := &ec2.ModifyIpamResourceDiscoveryInput{
+			AddOrganizationalUnitExclusions: expandIPAMResourceDiscoveryOrganizationalUnitExclusionsUpdateAdd(v.(*schema.Set).List()),
+			IpamResourceDiscoveryId:         aws.String(d.Id()),
+		}
+
+		_, err := conn.ModifyIpamResourceDiscovery(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "modifying IPAM Resource Discovery (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitIPAMResourceDiscoveryUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IPAM Resource Discovery (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceIPAMResourceDiscoveryRead(ctx, d, meta)...)
 }
 
@@ -155,6 +185,9 @@ func resourceIPAMResourceDiscoveryRead(ctx context.Context, d *schema.ResourceDa
 	if err := d.Set("operating_regions", flattenIPAMResourceDiscoveryOperatingRegions(rd.OperatingRegions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting operating_regions: %s", err)
 	}
+	if err := d.Set("organizational_unit_exclusion", flattenIPAMResourceDiscoveryOrganizationalUnitExclusions(rd.OrganizationalUnitExclusions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting organizational_unit_exclusion: %s", err)
+	}
 	d.Set(names.AttrOwnerID, rd.OwnerId)
 
 	setTagsOutV2(ctx, rd.Tags)
@@ -198,6 +231,19 @@ func resourceIPAMResourceDiscoveryUpdate(ctx context.Context, d *schema.Resource
 			}
 		}
 
+		if d.HasChange("organizational_unit_exclusion") {
+			o, n := d.GetChange("organizational_unit_exclusion")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			if add := expandIPAMResourceDiscoveryOrganizationalUnitExclusionsUpdateAdd(ns.Difference(os).List()); len(add) != 0 {
+				input.AddOrganizationalUnitExclusions = add
+			}
+
+			if remove := expandIPAMResourceDiscoveryOrganizationalUnitExclusionsUpdateRemove(os.Difference(ns).List()); len(remove) != 0 {
+				input.RemoveOrganizationalUnitExclusions = remove
+			}
+		}
+
 		_, err := conn.ModifyIpamResourceDiscovery(ctx, input)
 
 		if err != nil {
@@ -281,3 +327,35 @@ func expandIPAMResourceDiscoveryOperatingRegionsUpdateDeleteRegion(operatingRegi
 	}
 	return regionUpdate
 }
+
+func flattenIPAMResourceDiscoveryOrganizationalUnitExclusions(apiObjects []awstypes.IpamOrganizationalUnitExclusion) []interface{} {
+	tfList := []interface{}{}
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"organizations_entity_path": aws.ToString(apiObject.OrganizationsEntityPath),
+		})
+	}
+	return tfList
+}
+
+func expandIPAMResourceDiscoveryOrganizationalUnitExclusionsUpdateAdd(tfList []interface{}) []awstypes.AddIpamOrganizationalUnitExclusion {
+	apiObjects := make([]awstypes.AddIpamOrganizationalUnitExclusion, 0, len(tfList))
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		apiObjects = append(apiObjects, awstypes.AddIpamOrganizationalUnitExclusion{
+			OrganizationsEntityPath: aws.String(tfMap["organizations_entity_path"].(string)),
+		})
+	}
+	return apiObjects
+}
+
+func expandIPAMResourceDiscoveryOrganizationalUnitExclusionsUpdateRemove(tfList []interface{}) []awstypes.RemoveIpamOrganizationalUnitExclusion {
+	apiObjects := make([]awstypes.RemoveIpamOrganizationalUnitExclusion, 0, len(tfList))
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		apiObjects = append(apiObjects, awstypes.RemoveIpamOrganizationalUnitExclusion{
+			OrganizationsEntityPath: aws.String(tfMap["organizations_entity_path"].(string)),
+		})
+	}
+	return apiObjects
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -26,6 +27,7 @@ func TestAccIPAMResourceDiscovery_serial(t *testing.T) {
 		"ResourceDiscovery": {
 			acctest.CtBasic:      testAccIPAMResourceDiscovery_basic,
 			"modify":             testAccIPAMResourceDiscovery_modify,
+			"ouExclusion":        testAccIPAMResourceDiscovery_organizationalUnitExclusion,
 			acctest.CtDisappears: testAccIPAMResourceDiscovery_disappears,
 			"tags":               testAccIPAMResourceDiscovery_tags,
 		},
@@ -121,6 +123,51 @@ func testAccIPAMResourceDiscovery_modify(t *testing.T) {
 	})
 }
 
+func testAccIPAMResourceDiscovery_organizationalUnitExclusion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rd awstypes.IpamResourceDiscovery
+	resourceName := "aws_vpc_ipam_resource_discovery.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIPAMResourceDiscoveryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMResourceDiscoveryConfig_organizationalUnitExclusion(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMResourceDiscoveryExists(ctx, resourceName, &rd),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_exclusion.#", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIPAMResourceDiscoveryConfig_organizationalUnitExclusion(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMResourceDiscoveryExists(ctx, resourceName, &rd),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_exclusion.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccIPAMResourceDiscoveryConfig_organizationalUnitExclusion(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPAMResourceDiscoveryExists(ctx, resourceName, &rd),
+					resource.TestCheckResourceAttr(resourceName, "organizational_unit_exclusion.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccIPAMResourceDiscovery_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var rd awstypes.IpamResourceDiscovery
@@ -313,3 +360,33 @@ resource "aws_vpc_ipam_resource_discovery" "test" {
 }
 	`, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccIPAMResourceDiscoveryConfig_organizationalUnitExclusion(rName string, count int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+data "aws_organizations_organization" "current" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  count = 2
+
+  name      = "%[1]s-${count.index}"
+  parent_id = data.aws_organizations_organization.current.roots[0].id
+}
+
+resource "aws_vpc_ipam_resource_discovery" "test" {
+  description = "test"
+  operating_regions {
+    region_name = data.aws_region.current.name
+  }
+
+  dynamic "organizational_unit_exclusion" {
+    for_each = slice(aws_organizations_organizational_unit.test, 0, %[2]d)
+
+    content {
+      organizations_entity_path = "${data.aws_organizations_organization.current.id}/${data.aws_organizations_organization.current.roots[0].id}/${organizational_unit_exclusion.value.id}/"
+    }
+  }
+}
+`, rName, count)
+}
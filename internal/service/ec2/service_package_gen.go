@@ -35,6 +35,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newEIPDomainNameResource,
 			Name:    "EIP Domain Name",
 		},
+		{
+			Factory: newEIPTransferAcceptanceResource,
+			Name:    "EIP Transfer Acceptance",
+		},
 		{
 			Factory: newInstanceConnectEndpointResource,
 			Name:    "Instance Connect Endpoint",
@@ -528,6 +532,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  ResourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -1113,6 +1122,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceIPAMByoasn,
+			TypeName: "aws_vpc_ipam_byoasn",
+			Name:     "IPAM BYOASN",
+		},
+		{
+			Factory:  resourceIPAMByoasnAssociation,
+			TypeName: "aws_vpc_ipam_byoasn_association",
+			Name:     "IPAM BYOASN Association",
+		},
 		{
 			Factory:  resourceIPAMOrganizationAdminAccount,
 			TypeName: "aws_vpc_ipam_organization_admin_account",
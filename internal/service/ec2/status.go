@@ -9,6 +9,7 @@ import (
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
 	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -867,6 +868,23 @@ func StatusVPCPeeringConnectionDeleted(ctx context.Context, conn *ec2.EC2, id st
 	}
 }
 
+// statusEIPTransferAccepted returns the accepted status once a transferred Elastic IP address is owned by the caller's account.
+func statusEIPTransferAccepted(ctx context.Context, conn *ec2_sdkv2.Client, publicIP string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEIPByPublicIP(ctx, conn, publicIP)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(awstypes.AddressTransferStatusAccepted), nil
+	}
+}
+
 func statusEIPDomainNameAttribute(ctx context.Context, conn *ec2_sdkv2.Client, allocationID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findEIPDomainNameAttributeByAllocationID(ctx, conn, allocationID)
@@ -1134,6 +1152,22 @@ func StatusImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Clie
 	}
 }
 
+func StatusSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSnapshotBlockPublicAccessState(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output), nil
+	}
+}
+
 func StatusVerifiedAccessEndpoint(ctx context.Context, conn *ec2_sdkv2.Client, id string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindVerifiedAccessEndpointByID(ctx, conn, id)
@@ -465,6 +465,38 @@ func statusIPAMPool(ctx context.Context, conn *ec2.Client, id string) retry.Stat
 	}
 }
 
+func statusIPAMByoasn(ctx context.Context, conn *ec2.Client, asn, ipamID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIPAMByoasnByTwoPartKey(ctx, conn, asn, ipamID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func statusIPAMByoasnAssociation(ctx context.Context, conn *ec2.Client, asn, cidrBlock string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findIPAMByoasnAssociationByTwoPartKey(ctx, conn, asn, cidrBlock)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
 func statusIPAMPoolCIDR(ctx context.Context, conn *ec2.Client, cidrBlock, poolID, poolCIDRID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		if cidrBlock == "" {
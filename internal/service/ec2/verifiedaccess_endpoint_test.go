@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -117,6 +118,61 @@ func testAccVerifiedAccessEndpoint_networkInterface(t *testing.T, semaphore tfsy
 	})
 }
 
+func testAccVerifiedAccessEndpoint_cidr(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v1, v2 types.VerifiedAccessEndpoint
+	resourceName := "aws_verifiedaccess_endpoint.test"
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckVerifiedAccessSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVerifiedAccessEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), 22),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "attachment_type", "vpc"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "cidr_options.0.cidr", "aws_subnet.test.0", names.AttrCIDRBlock),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.port_range.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "22",
+						"to_port":   "22",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEndpointType, "cidr"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVerifiedAccessEndpointConfig_cidr(rName, acctest.TLSPEMEscapeNewlines(key), acctest.TLSPEMEscapeNewlines(certificate), 2222),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVerifiedAccessEndpointExists(ctx, resourceName, &v2),
+					testAccCheckVerifiedAccessEndpointNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "cidr_options.0.port_range.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cidr_options.0.port_range.*", map[string]string{
+						"from_port": "2222",
+						"to_port":   "2222",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccVerifiedAccessEndpoint_tags(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var v types.VerifiedAccessEndpoint
@@ -292,6 +348,16 @@ func testAccCheckVerifiedAccessEndpointExists(ctx context.Context, n string, v *
 	}
 }
 
+func testAccCheckVerifiedAccessEndpointNotRecreated(before, after *types.VerifiedAccessEndpoint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.VerifiedAccessEndpointId), aws.ToString(after.VerifiedAccessEndpointId); before != after {
+			return fmt.Errorf("Verified Access Endpoint (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccVerifiedAccessEndpointConfig_base(rName, key, certificate string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -447,6 +513,34 @@ resource "aws_verifiedaccess_endpoint" "test" {
 `, rName, key, certificate))
 }
 
+func testAccVerifiedAccessEndpointConfig_cidr(rName, key, certificate string, port int) string {
+	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
+resource "aws_verifiedaccess_endpoint" "test" {
+  attachment_type = "vpc"
+  description     = "example"
+  endpoint_type   = "cidr"
+
+  cidr_options {
+    cidr       = aws_subnet.test[0].cidr_block
+    protocol   = "tcp"
+    subnet_ids = [for subnet in aws_subnet.test : subnet.id]
+
+    port_range {
+      from_port = %[2]d
+      to_port   = %[2]d
+    }
+  }
+
+  security_group_ids       = [aws_security_group.test.id]
+  verified_access_group_id = aws_verifiedaccess_group.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, port))
+}
+
 func testAccVerifiedAccessEndpointConfig_tags1(rName, key, certificate, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccVerifiedAccessEndpointConfig_base(rName, key, certificate), fmt.Sprintf(`
 
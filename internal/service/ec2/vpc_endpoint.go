@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -159,10 +160,43 @@ func ResourceVPCEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"service_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"subnet_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ipv4": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrSubnetIDs: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -191,7 +225,10 @@ func ResourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			vpcEndpointIPAddressTypeCustomizeDiff,
+		),
 	}
 }
 
@@ -245,6 +282,14 @@ func resourceVPCEndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("service_region"); ok {
+		input.ServiceRegion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("subnet_configuration"); ok && v.(*schema.Set).Len() > 0 {
+		input.SubnetConfigurations = expandSubnetConfigurations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk(names.AttrSubnetIDs); ok && v.(*schema.Set).Len() > 0 {
 		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -334,7 +379,23 @@ func resourceVPCEndpointRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("route_table_ids", vpce.RouteTableIds)
 	d.Set(names.AttrSecurityGroupIDs, flattenSecurityGroupIdentifiers(vpce.Groups))
 	d.Set(names.AttrServiceName, serviceName)
+	d.Set("service_region", vpce.ServiceRegion)
 	d.Set(names.AttrState, vpce.State)
+	if len(vpce.NetworkInterfaceIds) > 0 {
+		networkInterfaces, err := findNetworkInterfacesV2(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+			NetworkInterfaceIds: vpce.NetworkInterfaceIds,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading VPC Endpoint (%s) network interfaces: %s", d.Id(), err)
+		}
+
+		if err := d.Set("subnet_configuration", flattenSubnetConfigurations(networkInterfaces)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting subnet_configuration: %s", err)
+		}
+	} else {
+		d.Set("subnet_configuration", nil)
+	}
 	d.Set(names.AttrSubnetIDs, vpce.SubnetIds)
 	// VPC endpoints don't have types in GovCloud, so set type to default if empty
 	if v := string(vpce.VpcEndpointType); v == "" {
@@ -384,7 +445,7 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChanges("dns_options", names.AttrIPAddressType, names.AttrPolicy, "private_dns_enabled", names.AttrSecurityGroupIDs, "route_table_ids", names.AttrSubnetIDs) {
+	if d.HasChanges("dns_options", names.AttrIPAddressType, names.AttrPolicy, "private_dns_enabled", names.AttrSecurityGroupIDs, "route_table_ids", "subnet_configuration", names.AttrSubnetIDs) {
 		input := &ec2.ModifyVpcEndpointInput{
 			VpcEndpointId: aws.String(d.Id()),
 		}
@@ -415,6 +476,12 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = flattenAddAndRemoveStringValueLists(d, names.AttrSecurityGroupIDs)
 		input.AddSubnetIds, input.RemoveSubnetIds = flattenAddAndRemoveStringValueLists(d, names.AttrSubnetIDs)
 
+		if d.HasChange("subnet_configuration") {
+			if v, ok := d.GetOk("subnet_configuration"); ok && v.(*schema.Set).Len() > 0 {
+				input.SubnetConfigurations = expandSubnetConfigurations(v.(*schema.Set).List())
+			}
+		}
+
 		if d.HasChange(names.AttrPolicy) {
 			o, n := d.GetChange(names.AttrPolicy)
 
@@ -500,6 +567,53 @@ func vpcEndpointAccept(ctx context.Context, conn *ec2.Client, vpceID, serviceNam
 	return nil
 }
 
+// vpcEndpointIPAddressTypeCustomizeDiff validates that the DNS record IP type and
+// the per-subnet IP addresses are compatible with the endpoint's IP address type.
+func vpcEndpointIPAddressTypeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	ipAddressType := awstypes.IpAddressType(diff.Get(names.AttrIPAddressType).(string))
+
+	if ipAddressType == "" {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("dns_options"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		dnsRecordIPType := awstypes.DnsRecordIpType(v.([]interface{})[0].(map[string]interface{})["dns_record_ip_type"].(string))
+
+		switch ipAddressType {
+		case awstypes.IpAddressTypeIpv4:
+			if dnsRecordIPType == awstypes.DnsRecordIpTypeIpv6 || dnsRecordIPType == awstypes.DnsRecordIpTypeDualstack {
+				return fmt.Errorf("dns_options.0.dns_record_ip_type %q is not supported with ip_address_type %q", dnsRecordIPType, ipAddressType)
+			}
+		case awstypes.IpAddressTypeIpv6:
+			if dnsRecordIPType == awstypes.DnsRecordIpTypeIpv4 || dnsRecordIPType == awstypes.DnsRecordIpTypeDualstack {
+				return fmt.Errorf("dns_options.0.dns_record_ip_type %q is not supported with ip_address_type %q", dnsRecordIPType, ipAddressType)
+			}
+		}
+	}
+
+	if v, ok := diff.GetOk("subnet_configuration"); ok {
+		for _, tfMapRaw := range v.(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch ipAddressType {
+			case awstypes.IpAddressTypeIpv4:
+				if v, ok := tfMap["ipv6"].(string); ok && v != "" {
+					return fmt.Errorf("subnet_configuration.ipv6 cannot be specified with ip_address_type %q", ipAddressType)
+				}
+			case awstypes.IpAddressTypeIpv6:
+				if v, ok := tfMap["ipv4"].(string); ok && v != "" {
+					return fmt.Errorf("subnet_configuration.ipv4 cannot be specified with ip_address_type %q", ipAddressType)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func isAmazonS3VPCEndpoint(serviceName string) bool {
 	ok, _ := regexp.MatchString("com\\.amazonaws\\.([a-z]+\\-[a-z]+\\-[0-9])\\.s3", serviceName)
 	return ok
@@ -585,6 +699,78 @@ func flattenDNSOptions(apiObject *awstypes.DnsOptions) map[string]interface{} {
 	return tfMap
 }
 
+func expandSubnetConfiguration(tfMap map[string]interface{}) *awstypes.SubnetConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.SubnetConfiguration{}
+
+	if v, ok := tfMap["ipv4"].(string); ok && v != "" {
+		apiObject.Ipv4 = aws.String(v)
+	}
+
+	if v, ok := tfMap["ipv6"].(string); ok && v != "" {
+		apiObject.Ipv6 = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrSubnetID].(string); ok && v != "" {
+		apiObject.SubnetId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSubnetConfigurations(tfList []interface{}) []awstypes.SubnetConfiguration {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.SubnetConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandSubnetConfiguration(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+// flattenSubnetConfigurations returns the per-subnet IP addresses of an interface endpoint's network interfaces.
+func flattenSubnetConfigurations(apiObjects []awstypes.NetworkInterface) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"ipv4":             aws.ToString(apiObject.PrivateIpAddress),
+			names.AttrSubnetID: aws.ToString(apiObject.SubnetId),
+		}
+
+		if len(apiObject.Ipv6Addresses) > 0 {
+			tfMap["ipv6"] = aws.ToString(apiObject.Ipv6Addresses[0].Ipv6Address)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenSecurityGroupIdentifiers(apiObjects []awstypes.SecurityGroupIdentifier) []string {
 	if len(apiObjects) == 0 {
 		return nil
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,12 +50,24 @@ func (r *resourceEndpointServicePrivateDNSVerification) Metadata(_ context.Conte
 func (r *resourceEndpointServicePrivateDNSVerification) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"private_dns_name": schema.StringAttribute{
+				Computed: true,
+			},
 			"service_id": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			names.AttrState: schema.StringAttribute{
+				Computed: true,
+			},
+			"txt_record_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"txt_record_value": schema.StringAttribute{
+				Computed: true,
+			},
 			"wait_for_verification": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -102,9 +116,21 @@ func (r *resourceEndpointServicePrivateDNSVerification) Create(ctx context.Conte
 		return
 	}
 
+	serviceConfiguration, err := findVPCEndpointServiceConfigurationByIDV2(ctx, conn, plan.ServiceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameEndpointServicePrivateDNSVerification, plan.ServiceID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.PrivateDNSName = flex.StringToFramework(ctx, serviceConfiguration.PrivateDnsName)
+	plan.setPrivateDNSNameConfiguration(ctx, serviceConfiguration.PrivateDnsNameConfiguration)
+
 	if plan.WaitForVerification.ValueBool() {
 		createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
-		_, err := waitVPCEndpointServicePrivateDNSNameVerifiedV2(ctx, conn, plan.ServiceID.ValueString(), createTimeout)
+		out, err := waitVPCEndpointServicePrivateDNSNameVerifiedV2(ctx, conn, plan.ServiceID.ValueString(), createTimeout)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.EC2, create.ErrActionWaitingForCreation, ResNameEndpointServicePrivateDNSVerification, plan.ServiceID.String(), err),
@@ -112,13 +138,33 @@ func (r *resourceEndpointServicePrivateDNSVerification) Create(ctx context.Conte
 			)
 			return
 		}
+
+		plan.setPrivateDNSNameConfiguration(ctx, out)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 type resourceEndpointServicePrivateDNSVerificationData struct {
+	PrivateDNSName      types.String   `tfsdk:"private_dns_name"`
 	ServiceID           types.String   `tfsdk:"service_id"`
+	State               types.String   `tfsdk:"state"`
 	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	TXTRecordName       types.String   `tfsdk:"txt_record_name"`
+	TXTRecordValue      types.String   `tfsdk:"txt_record_value"`
 	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
 }
+
+func (data *resourceEndpointServicePrivateDNSVerificationData) setPrivateDNSNameConfiguration(ctx context.Context, apiObject *awstypes.PrivateDnsNameConfiguration) {
+	if apiObject == nil {
+		data.State = types.StringNull()
+		data.TXTRecordName = types.StringNull()
+		data.TXTRecordValue = types.StringNull()
+
+		return
+	}
+
+	data.State = flex.StringValueToFramework(ctx, apiObject.State)
+	data.TXTRecordName = flex.StringToFramework(ctx, apiObject.Name)
+	data.TXTRecordValue = flex.StringToFramework(ctx, apiObject.Value)
+}
@@ -38,6 +38,10 @@ func TestAccVPCEndpointServicePrivateDNSVerification_basic(t *testing.T) {
 				Config: testAccVPCEndpointServicePrivateDNSVerificationConfig_basic(rName, domainName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrPair(resourceName, "service_id", endpointServiceResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "private_dns_name", endpointServiceResourceName, "private_dns_name"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(resourceName, "txt_record_name", endpointServiceResourceName, "private_dns_name_configuration.0.name"),
+					resource.TestCheckResourceAttrPair(resourceName, "txt_record_value", endpointServiceResourceName, "private_dns_name_configuration.0.value"),
 				),
 			},
 		},
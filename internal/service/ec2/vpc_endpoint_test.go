@@ -430,6 +430,74 @@ func TestAccVPCEndpoint_ipAddressType(t *testing.T) {
 	})
 }
 
+func TestAccVPCEndpoint_subnetConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var endpoint awstypes.VpcEndpoint
+	resourceName := "aws_vpc_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCEndpointConfig_subnetConfiguration(rName, 100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, names.AttrIPAddressType, "dualstack"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subnet_configuration.*", map[string]string{
+						"ipv4": "10.0.0.100",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subnet_configuration.*", map[string]string{
+						"ipv4": "10.0.1.100",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_accept"},
+			},
+			{
+				Config: testAccVPCEndpointConfig_subnetConfiguration(rName, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCEndpointExists(ctx, resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "subnet_configuration.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subnet_configuration.*", map[string]string{
+						"ipv4": "10.0.0.200",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subnet_configuration.*", map[string]string{
+						"ipv4": "10.0.1.200",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCEndpoint_ipAddressTypeMismatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCEndpointConfig_ipAddressTypeMismatch(rName),
+				ExpectError: regexache.MustCompile(`dns_options.0.dns_record_ip_type "ipv6" is not supported with ip_address_type "ipv4"`),
+			},
+		},
+	})
+}
+
 func TestAccVPCEndpoint_interfaceWithSubnetAndSecurityGroup(t *testing.T) {
 	ctx := acctest.Context(t)
 	var endpoint awstypes.VpcEndpoint
@@ -894,6 +962,65 @@ resource "aws_vpc_endpoint" "test" {
 `, rName, addressType))
 }
 
+func testAccVPCEndpointConfig_subnetConfiguration(rName string, hostNum int) string {
+	return acctest.ConfigCompose(testAccVPCEndpointServiceConfig_baseSupportedIPAddressTypes(rName), fmt.Sprintf(`
+resource "aws_vpc_endpoint_service" "test" {
+  acceptance_required        = false
+  network_load_balancer_arns = aws_lb.test[*].arn
+  supported_ip_address_types = ["ipv4", "ipv6"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id              = aws_vpc.test.id
+  service_name        = aws_vpc_endpoint_service.test.service_name
+  vpc_endpoint_type   = "Interface"
+  private_dns_enabled = false
+  auto_accept         = true
+  ip_address_type     = "dualstack"
+  subnet_ids          = aws_subnet.test[*].id
+
+  dynamic "subnet_configuration" {
+    for_each = aws_subnet.test
+
+    content {
+      ipv4      = cidrhost(subnet_configuration.value.cidr_block, %[2]d)
+      ipv6      = cidrhost(subnet_configuration.value.ipv6_cidr_block, %[2]d)
+      subnet_id = subnet_configuration.value.id
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, hostNum))
+}
+
+func testAccVPCEndpointConfig_ipAddressTypeMismatch(rName string) string {
+	return acctest.ConfigCompose(testAccVPCEndpointConfig_vpcBase(rName), fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "test" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.ec2"
+  vpc_endpoint_type = "Interface"
+  ip_address_type   = "ipv4"
+
+  dns_options {
+    dns_record_ip_type = "ipv6"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCEndpointConfig_gatewayPolicy(rName, policy string) string {
 	return fmt.Sprintf(`
 data "aws_vpc_endpoint_service" "test" {
@@ -19,6 +19,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -157,6 +158,11 @@ func resourceVPNConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tunnel1_apply_pending_maintenance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tunnel1_bgp_asn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -217,6 +223,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel1_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -250,6 +260,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel1_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel1_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel1_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -315,11 +333,25 @@ func resourceVPNConnection() *schema.Resource {
 				},
 			},
 			"tunnel1_preshared_key": {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Computed:      true,
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+				ConflictsWith: []string{"tunnel1_preshared_key_wo"},
+			},
+			"tunnel1_preshared_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+				ConflictsWith: []string{"tunnel1_preshared_key"},
+			},
+			"tunnel1_preshared_key_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				Computed:     true,
-				ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+				RequiredWith: []string{"tunnel1_preshared_key_wo"},
 			},
 			"tunnel1_rekey_fuzz_percentage": {
 				Type:         schema.TypeInt,
@@ -373,6 +405,11 @@ func resourceVPNConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tunnel2_apply_pending_maintenance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tunnel2_bgp_asn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -433,6 +470,10 @@ func resourceVPNConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validVPNConnectionTunnelInsideIPv6CIDR(),
 			},
+			"tunnel2_last_maintenance_applied": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_log_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -466,6 +507,14 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel2_maintenance_auto_applied_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel2_pending_maintenance": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tunnel2_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -531,11 +580,25 @@ func resourceVPNConnection() *schema.Resource {
 				},
 			},
 			"tunnel2_preshared_key": {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Computed:      true,
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+				ConflictsWith: []string{"tunnel2_preshared_key_wo"},
+			},
+			"tunnel2_preshared_key_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+				ConflictsWith: []string{"tunnel2_preshared_key"},
+			},
+			"tunnel2_preshared_key_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				Computed:     true,
-				ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+				RequiredWith: []string{"tunnel2_preshared_key_wo"},
 			},
 			"tunnel2_rekey_fuzz_percentage": {
 				Type:         schema.TypeInt,
@@ -632,6 +695,7 @@ func resourceVPNConnection() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffValidateOutsideIPAddressType,
+			customizeDiffApplyPendingTunnelMaintenance,
 			verify.SetTagsDiff,
 		),
 	}
@@ -696,6 +760,18 @@ func resourceVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, me
 		input.VpnGatewayId = aws.String(v.(string))
 	}
 
+	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		v, err := vpnTunnelWriteOnlyPreSharedKey(d, prefix)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		if v != "" {
+			input.Options.TunnelOptions[i].PreSharedKey = aws.String(v)
+		}
+	}
+
 	output, err := conn.CreateVpnConnection(ctx, input)
 
 	if err != nil {
@@ -846,6 +922,24 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel2_vgw_inside_address", nil)
 	}
 
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		// Maintenance details are only meaningful when the customer controls tunnel endpoint replacement.
+		if address := d.Get(prefix + names.AttrAddress).(string); address != "" && d.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			output, err := findVPNTunnelReplacementStatusByTwoPartKey(ctx, conn, d.Id(), address)
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) tunnel (%s) replacement status: %s", d.Id(), address, err)
+			default:
+				flattenMaintenanceDetails(d, prefix, output.MaintenanceDetails)
+				continue
+			}
+		}
+
+		flattenMaintenanceDetails(d, prefix, nil)
+	}
+
 	return diags
 }
 
@@ -914,7 +1008,25 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
-		if options, address := expandModifyVPNTunnelOptionsSpecification(d, prefix), d.Get(prefix+names.AttrAddress).(string); options != nil && address != "" {
+		options := expandModifyVPNTunnelOptionsSpecification(d, prefix)
+
+		if d.HasChange(prefix + "preshared_key_wo_version") {
+			v, err := vpnTunnelWriteOnlyPreSharedKey(d, prefix)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if v != "" {
+				if options == nil {
+					options = &awstypes.ModifyVpnTunnelOptionsSpecification{}
+				}
+
+				options.PreSharedKey = aws.String(v)
+			}
+		}
+
+		if address := d.Get(prefix + names.AttrAddress).(string); options != nil && address != "" {
 			input := &ec2.ModifyVpnTunnelOptionsInput{
 				TunnelOptions:             options,
 				VpnConnectionId:           aws.String(d.Id()),
@@ -933,6 +1045,31 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		// The pending maintenance attribute is only marked as changing when maintenance is to be applied.
+		if key := prefix + "pending_maintenance"; d.HasChange(key) && d.Get(prefix+"apply_pending_maintenance").(bool) {
+			if o, _ := d.GetChange(key); o.(string) == "" {
+				continue
+			}
+
+			input := &ec2.ReplaceVpnTunnelInput{
+				ApplyPendingMaintenance:   aws.Bool(true),
+				VpnConnectionId:           aws.String(d.Id()),
+				VpnTunnelOutsideIpAddress: aws.String(d.Get(prefix + names.AttrAddress).(string)),
+			}
+
+			_, err := conn.ReplaceVpnTunnel(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "replacing EC2 VPN Connection (%s) tunnel (%d): %s", d.Id(), i+1, err)
+			}
+
+			if _, err := waitVPNConnectionUpdated(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 VPN Connection (%s) tunnel (%d) replacement: %s", d.Id(), i+1, err)
+			}
+		}
+	}
+
 	return append(diags, resourceVPNConnectionRead(ctx, d, meta)...)
 }
 
@@ -1428,6 +1565,28 @@ func flattenTunnelOption(d *schema.ResourceData, prefix string, apiObject awstyp
 	return nil
 }
 
+func flattenMaintenanceDetails(d *schema.ResourceData, prefix string, apiObject *awstypes.MaintenanceDetails) {
+	if apiObject == nil {
+		d.Set(prefix+"last_maintenance_applied", nil)
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+		d.Set(prefix+"pending_maintenance", nil)
+
+		return
+	}
+
+	if v := apiObject.LastMaintenanceApplied; v != nil {
+		d.Set(prefix+"last_maintenance_applied", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"last_maintenance_applied", nil)
+	}
+	if v := apiObject.MaintenanceAutoAppliedAfter; v != nil {
+		d.Set(prefix+"maintenance_auto_applied_after", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set(prefix+"maintenance_auto_applied_after", nil)
+	}
+	d.Set(prefix+"pending_maintenance", apiObject.PendingMaintenance)
+}
+
 func flattenVPNStaticRoute(apiObject awstypes.VpnStaticRoute) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -1637,6 +1796,23 @@ func CustomerGatewayConfigurationToTunnelInfo(xmlConfig string, tunnel1PreShared
 	return tunnelInfo, nil
 }
 
+// vpnTunnelWriteOnlyPreSharedKey returns the tunnel's write-only preshared key from the raw configuration.
+// Write-only values are only available in the configuration during apply and are never stored in state.
+func vpnTunnelWriteOnlyPreSharedKey(d *schema.ResourceData, prefix string) (string, error) {
+	key := prefix + "preshared_key_wo"
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath(key))
+
+	if diags.HasError() {
+		return "", fmt.Errorf("reading %s: %v", key, diags)
+	}
+
+	if !v.Type().Equals(cty.String) || !v.IsKnown() || v.IsNull() {
+		return "", nil
+	}
+
+	return v.AsString(), nil
+}
+
 func validVPNConnectionTunnelPreSharedKey() schema.SchemaValidateFunc {
 	return validation.All(
 		validation.StringLenBetween(8, 64),
@@ -1673,6 +1849,29 @@ func validVPNConnectionTunnelInsideIPv6CIDR() schema.SchemaValidateFunc {
 }
 
 // customizeDiffValidateOutsideIPAddressType validates that if provided `outside_ip_address_type` is `PrivateIpv4` then `transport_transit_gateway_attachment_id` must be provided
+// customizeDiffApplyPendingTunnelMaintenance marks a tunnel's pending maintenance as changing when it is to be applied,
+// causing the tunnel endpoint to be replaced during apply.
+func customizeDiffApplyPendingTunnelMaintenance(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		if !diff.Get(prefix+"apply_pending_maintenance").(bool) || !diff.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			continue
+		}
+
+		// A maintenance auto-apply time is only reported while maintenance is pending.
+		if key := prefix + "pending_maintenance"; diff.Get(prefix+"maintenance_auto_applied_after").(string) != "" {
+			if err := diff.SetNewComputed(key); err != nil {
+				return fmt.Errorf("setting %s to unknown: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func customizeDiffValidateOutsideIPAddressType(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 	if v, ok := diff.GetOk("outside_ip_address_type"); !ok || v.(string) == OutsideIPAddressTypePublicIPv4 {
 		return nil
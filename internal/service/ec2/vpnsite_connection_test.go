@@ -12,9 +12,11 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -527,6 +529,119 @@ func TestAccSiteVPNConnection_tunnel1PreSharedKey(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_preSharedKeyRotation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnel1PresharedKey(rName, rBgpAsn, "tunnel1presharedkey", "tunnel2presharedkey"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", "tunnel1presharedkey"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", "tunnel2presharedkey"),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnel1PresharedKey(rName, rBgpAsn, "tunnel1rotatedkey", "tunnel2rotatedkey"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", "tunnel1rotatedkey"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", "tunnel2rotatedkey"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteVPNConnection_preSharedKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		CheckDestroy: testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_presharedKeyWriteOnly(rName, rBgpAsn, "tunnel1presharedkey", "tunnel2presharedkey", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", "tunnel1presharedkey"),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel1_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", "tunnel2presharedkey"),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel2_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_presharedKeyWriteOnly(rName, rBgpAsn, "tunnel1rotatedkey", "tunnel2rotatedkey", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", "tunnel1rotatedkey"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", "tunnel2rotatedkey"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteVPNConnection_tunnelLifecycleControl(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn1, vpn2 awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelLifecycleControl(rName, rBgpAsn, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn1),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_apply_pending_maintenance", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_enable_tunnel_lifecycle_control", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_apply_pending_maintenance", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_enable_tunnel_lifecycle_control", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelLifecycleControl(rName, rBgpAsn, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn2),
+					testAccCheckVPNConnectionNotRecreated(&vpn1, &vpn2),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_apply_pending_maintenance", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_apply_pending_maintenance", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_tunnelOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2398,6 +2513,40 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn, tunnel1InsideIpv6Cidr, tunnel2InsideIpv6Cidr)
 }
 
+func testAccSiteVPNConnectionConfig_presharedKeyWriteOnly(rName string, rBgpAsn int, tunnel1PresharedKey, tunnel2PresharedKey string, woVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id              = aws_customer_gateway.test.id
+  tunnel1_preshared_key_wo         = %[3]q
+  tunnel1_preshared_key_wo_version = %[5]d
+  tunnel2_preshared_key_wo         = %[4]q
+  tunnel2_preshared_key_wo_version = %[5]d
+  type                             = "ipsec.1"
+  vpn_gateway_id                   = aws_vpn_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey, woVersion)
+}
+
 func testAccSiteVPNConnectionConfig_tunnel1PresharedKey(rName string, rBgpAsn int, tunnel1PresharedKey string, tunnel2PresharedKey string) string {
 	return fmt.Sprintf(`
 resource "aws_vpn_gateway" "test" {
@@ -2430,6 +2579,41 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey)
 }
 
+func testAccSiteVPNConnectionConfig_tunnelLifecycleControl(rName string, rBgpAsn int, applyPendingMaintenance bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id = aws_customer_gateway.test.id
+  type                = "ipsec.1"
+  vpn_gateway_id      = aws_vpn_gateway.test.id
+
+  tunnel1_enable_tunnel_lifecycle_control = true
+  tunnel1_apply_pending_maintenance       = %[3]t
+  tunnel2_enable_tunnel_lifecycle_control = true
+  tunnel2_apply_pending_maintenance       = %[3]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, applyPendingMaintenance)
+}
+
 func testAccSiteVPNConnectionConfig_tunnelOptions(
 	rName string,
 	rBgpAsn int,
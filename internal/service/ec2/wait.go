@@ -1555,6 +1555,24 @@ func WaitNATGatewayAddressUnassigned(ctx context.Context, conn *ec2.EC2, natGate
 	return nil, err
 }
 
+func waitEIPTransferAccepted(ctx context.Context, conn *ec2_sdkv2.Client, publicIP string, timeout time.Duration) (*awstypes.Address, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{},
+		Target:         enum.Slice(awstypes.AddressTransferStatusAccepted),
+		Timeout:        timeout,
+		Refresh:        statusEIPTransferAccepted(ctx, conn, publicIP),
+		NotFoundChecks: 60,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Address); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitEIPDomainNameAttributeUpdated(ctx context.Context, conn *ec2_sdkv2.Client, allocationID string, timeout time.Duration) (*awstypes.AddressAttribute, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{PTRUpdateStatusPending},
@@ -2227,6 +2245,18 @@ func WaitImageBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client
 	return err
 }
 
+func WaitSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2_sdkv2.Client, target string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Target:  []string{target},
+		Refresh: StatusSnapshotBlockPublicAccessState(ctx, conn),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 func WaitVerifiedAccessEndpointCreated(ctx context.Context, conn *ec2_sdkv2.Client, id string, timeout time.Duration) (*awstypes.VerifiedAccessEndpoint, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(awstypes.VerifiedAccessEndpointStatusCodePending),
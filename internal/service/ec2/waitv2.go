@@ -1033,6 +1033,94 @@ func waitIPAMPoolDeleted(ctx context.Context, conn *ec2.Client, id string, timeo
 	return nil, err
 }
 
+func waitIPAMByoasnProvisioned(ctx context.Context, conn *ec2.Client, asn, ipamID string, timeout time.Duration) (*types.Byoasn, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.AsnStatePendingProvision),
+		Target:  enum.Slice(types.AsnStateProvisioned),
+		Refresh: statusIPAMByoasn(ctx, conn, asn, ipamID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Byoasn); ok {
+		if output.State == types.AsnStateFailedProvision {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIPAMByoasnDeprovisioned(ctx context.Context, conn *ec2.Client, asn, ipamID string, timeout time.Duration) (*types.Byoasn, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.AsnStateProvisioned, types.AsnStatePendingDeprovision),
+		Target:  []string{},
+		Refresh: statusIPAMByoasn(ctx, conn, asn, ipamID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Byoasn); ok {
+		if output.State == types.AsnStateFailedDeprovision {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIPAMByoasnAssociationCreated(ctx context.Context, conn *ec2.Client, asn, cidrBlock string, timeout time.Duration) (*types.AsnAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.AsnAssociationStatePendingAssociation),
+		Target:  enum.Slice(types.AsnAssociationStateAssociated),
+		Refresh: statusIPAMByoasnAssociation(ctx, conn, asn, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.AsnAssociation); ok {
+		if output.State == types.AsnAssociationStateFailedAssociation {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitIPAMByoasnAssociationDeleted(ctx context.Context, conn *ec2.Client, asn, cidrBlock string, timeout time.Duration) (*types.AsnAssociation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.AsnAssociationStateAssociated, types.AsnAssociationStatePendingDisassociation),
+		Target:  []string{},
+		Refresh: statusIPAMByoasnAssociation(ctx, conn, asn, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.AsnAssociation); ok {
+		if output.State == types.AsnAssociationStateFailedDisassociation {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitIPAMPoolCIDRCreated(ctx context.Context, conn *ec2.Client, poolCIDRID, poolID, cidrBlock string, timeout time.Duration) (*types.IpamPoolCidr, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(types.IpamPoolCidrStatePendingProvision),
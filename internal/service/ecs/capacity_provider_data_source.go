@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecs_capacity_provider")
+func DataSourceCapacityProvider() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityProviderRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_capacity": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"in_service_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"min_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"auto_scaling_group_provider": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_scaling_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_draining": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"managed_scaling": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"instance_warmup_period": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"maximum_scaling_step_size": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"minimum_scaling_step_size": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"target_capacity": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"managed_termination_protection": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"update_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCapacityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get(names.AttrName).(string)
+	capacityProvider, err := FindCapacityProviderByARN(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Capacity Provider (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(capacityProvider.CapacityProviderArn))
+	d.Set(names.AttrARN, capacityProvider.CapacityProviderArn)
+	if err := d.Set("auto_scaling_group_provider", flattenAutoScalingGroupProvider(capacityProvider.AutoScalingGroupProvider)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting auto_scaling_group_provider: %s", err)
+	}
+	d.Set(names.AttrName, capacityProvider.Name)
+	d.Set(names.AttrStatus, capacityProvider.Status)
+	d.Set("update_status", capacityProvider.UpdateStatus)
+	d.Set("update_status_reason", capacityProvider.UpdateStatusReason)
+
+	if p := capacityProvider.AutoScalingGroupProvider; p != nil {
+		asgName, err := autoScalingGroupNameFromARN(aws.StringValue(p.AutoScalingGroupArn))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		group, err := findAutoScalingGroupByName(ctx, meta.(*conns.AWSClient).AutoScalingClient(ctx), asgName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Capacity Provider (%s) Auto Scaling Group (%s): %s", name, asgName, err)
+		}
+
+		if err := d.Set("auto_scaling_group", []interface{}{flattenCapacityProviderAutoScalingGroup(group)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting auto_scaling_group: %s", err)
+		}
+	} else {
+		d.Set("auto_scaling_group", nil)
+	}
+
+	tags := KeyValueTags(ctx, capacityProvider.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	if err := d.Set(names.AttrTags, tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	return diags
+}
+
+// autoScalingGroupNameFromARN returns the name of the Auto Scaling group with the specified ARN.
+// e.g. arn:aws:autoscaling:us-west-2:123456789012:autoScalingGroup:<uuid>:autoScalingGroupName/my-asg.
+func autoScalingGroupNameFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	const prefix = "autoScalingGroupName/"
+	i := strings.Index(v.Resource, prefix)
+
+	if i == -1 {
+		return "", fmt.Errorf("unexpected format for Auto Scaling Group ARN (%s)", s)
+	}
+
+	return v.Resource[i+len(prefix):], nil
+}
+
+func findAutoScalingGroupByName(ctx context.Context, conn *autoscaling.Client, name string) (*autoscalingtypes.AutoScalingGroup, error) {
+	input := &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	}
+
+	output, err := conn.DescribeAutoScalingGroups(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.AutoScalingGroups)
+}
+
+func flattenCapacityProviderAutoScalingGroup(apiObject *autoscalingtypes.AutoScalingGroup) map[string]interface{} {
+	var inService int
+
+	for _, v := range apiObject.Instances {
+		if v.LifecycleState == autoscalingtypes.LifecycleStateInService {
+			inService++
+		}
+	}
+
+	return map[string]interface{}{
+		"desired_capacity":          aws_sdkv2.ToInt32(apiObject.DesiredCapacity),
+		"in_service_instance_count": inService,
+		"instance_count":            len(apiObject.Instances),
+		"max_size":                  aws_sdkv2.ToInt32(apiObject.MaxSize),
+		"min_size":                  aws_sdkv2.ToInt32(apiObject.MinSize),
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSCapacityProviderDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_capacity_provider.test"
+	resourceName := "aws_ecs_capacity_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityProviderDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.0.desired_capacity", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.0.in_service_instance_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.0.instance_count", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.0.max_size", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group.0.min_size", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_scaling_group_provider.0.auto_scaling_group_arn", resourceName, "auto_scaling_group_provider.0.auto_scaling_group_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_scaling_group_provider.0.managed_draining", resourceName, "auto_scaling_group_provider.0.managed_draining"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_group_provider.0.managed_scaling.0.target_capacity", "75"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "update_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityProviderDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCapacityProviderConfig_managedScaling(rName, "ENABLED", 300, 10, 1, 75), `
+data "aws_ecs_capacity_provider" "test" {
+  name = aws_ecs_capacity_provider.test.name
+}
+`)
+}
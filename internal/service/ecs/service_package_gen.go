@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceCapacityProvider,
+			TypeName: "aws_ecs_capacity_provider",
+		},
 		{
 			Factory:  DataSourceCluster,
 			TypeName: "aws_ecs_cluster",
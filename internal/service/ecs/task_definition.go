@@ -93,6 +93,10 @@ func ResourceTaskDefinition() *schema.Resource {
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					networkMode, ok := d.GetOk("network_mode")
 					isAWSVPC := ok && networkMode.(string) == ecs.NetworkModeAwsvpc
+					if d.Get("normalize_container_definitions").(bool) {
+						equal, _ := ContainerDefinitionsAreEquivalentIgnoringDefaults(old, new, isAWSVPC)
+						return equal
+					}
 					equal, _ := ContainerDefinitionsAreEquivalent(old, new, isAWSVPC)
 					return equal
 				},
@@ -171,6 +175,11 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ecs.NetworkMode_Values(), false),
 			},
+			"normalize_container_definitions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"pid_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -226,6 +235,10 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			"registered_container_definitions": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"requires_compatibilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -615,6 +628,7 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("arn_without_revision", StripRevision(aws.StringValue(taskDefinition.TaskDefinitionArn)))
 	d.Set(names.AttrFamily, taskDefinition.Family)
 	d.Set("revision", taskDefinition.Revision)
+	d.Set("normalize_container_definitions", d.Get("normalize_container_definitions"))
 	d.Set("track_latest", d.Get("track_latest"))
 
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
@@ -628,10 +642,27 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
-	err = d.Set("container_definitions", defs)
+
+	registeredDefs, err := structure.NormalizeJsonString(defs)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
+	d.Set("registered_container_definitions", registeredDefs)
+
+	// When normalizing, keep the configured container definitions in state as long as they match the
+	// registered revision, so that values filled in by ECS don't show up in plans.
+	setContainerDefinitions := true
+	if d.Get("normalize_container_definitions").(bool) {
+		isAWSVPC := aws.StringValue(taskDefinition.NetworkMode) == ecs.NetworkModeAwsvpc
+		equal, _ := ContainerDefinitionsAreEquivalentIgnoringDefaults(d.Get("container_definitions").(string), defs, isAWSVPC)
+		setContainerDefinitions = !equal
+	}
+	if setContainerDefinitions {
+		err = d.Set("container_definitions", defs)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
+		}
+	}
 
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set(names.AttrExecutionRoleARN, taskDefinition.ExecutionRoleArn)
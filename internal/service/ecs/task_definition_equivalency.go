@@ -19,11 +19,24 @@ import (
 // ContainerDefinitionsAreEquivalent determines equality between two ECS container definition JSON strings
 // Note: This function will be moved out of the aws package in the future.
 func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool, error) {
+	return containerDefinitionsAreEquivalent(def1, def2, isAWSVPC, false)
+}
+
+// ContainerDefinitionsAreEquivalentIgnoringDefaults determines equality between two ECS container definition JSON strings,
+// additionally ignoring values that ECS fills in when a task definition revision is registered.
+func ContainerDefinitionsAreEquivalentIgnoringDefaults(def1, def2 string, isAWSVPC bool) (bool, error) {
+	return containerDefinitionsAreEquivalent(def1, def2, isAWSVPC, true)
+}
+
+func containerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC, ignoreDefaults bool) (bool, error) {
 	var obj1 containerDefinitions
 	err := json.Unmarshal([]byte(def1), &obj1)
 	if err != nil {
 		return false, err
 	}
+	if ignoreDefaults {
+		obj1.ReduceDefaults()
+	}
 	err = obj1.Reduce(isAWSVPC)
 	if err != nil {
 		return false, err
@@ -38,6 +51,9 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	if err != nil {
 		return false, err
 	}
+	if ignoreDefaults {
+		obj2.ReduceDefaults()
+	}
 	err = obj2.Reduce(isAWSVPC)
 	if err != nil {
 		return false, err
@@ -107,6 +123,63 @@ func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
 	return nil
 }
 
+// ReduceDefaults removes values that ECS fills in on registration when they are left unset,
+// and orders lists that ECS may return in a different order.
+func (cd containerDefinitions) ReduceDefaults() {
+	for _, def := range cd {
+		if len(def.DockerLabels) == 0 {
+			def.DockerLabels = nil
+		}
+		if hc := def.HealthCheck; hc != nil {
+			if aws.Int64Value(hc.Interval) == 30 {
+				hc.Interval = nil
+			}
+			if aws.Int64Value(hc.Retries) == 3 {
+				hc.Retries = nil
+			}
+			if aws.Int64Value(hc.StartPeriod) == 0 {
+				hc.StartPeriod = nil
+			}
+			if aws.Int64Value(hc.Timeout) == 5 {
+				hc.Timeout = nil
+			}
+		}
+		if lp := def.LinuxParameters; lp != nil {
+			if len(lp.Devices) == 0 {
+				lp.Devices = nil
+			}
+			if len(lp.Tmpfs) == 0 {
+				lp.Tmpfs = nil
+			}
+		}
+		if lc := def.LogConfiguration; lc != nil {
+			if len(lc.Options) == 0 {
+				lc.Options = nil
+			}
+			if len(lc.SecretOptions) == 0 {
+				lc.SecretOptions = nil
+			}
+		}
+		for _, mp := range def.MountPoints {
+			if !aws.BoolValue(mp.ReadOnly) {
+				mp.ReadOnly = nil
+			}
+		}
+		for _, vf := range def.VolumesFrom {
+			if !aws.BoolValue(vf.ReadOnly) {
+				vf.ReadOnly = nil
+			}
+		}
+
+		sort.Slice(def.SystemControls, func(i, j int) bool {
+			return aws.StringValue(def.SystemControls[i].Namespace) < aws.StringValue(def.SystemControls[j].Namespace)
+		})
+		sort.Slice(def.Ulimits, func(i, j int) bool {
+			return aws.StringValue(def.Ulimits[i].Name) < aws.StringValue(def.Ulimits[j].Name)
+		})
+	}
+}
+
 func (cd containerDefinitions) OrderEnvironmentVariables() {
 	for _, def := range cd {
 		sort.Slice(def.Environment, func(i, j int) bool {
@@ -578,3 +578,111 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalentIgnoringDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+      },
+      "mountPoints": [
+        {"sourceVolume": "data", "containerPath": "/data"}
+      ],
+      "ulimits": [
+        {"name": "nproc", "softLimit": 1024, "hardLimit": 2048},
+        {"name": "core", "softLimit": 0, "hardLimit": 0}
+      ]
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "cpu": 0,
+        "memory": 500,
+        "essential": true,
+        "environment": [],
+        "mountPoints": [
+            {"sourceVolume": "data", "containerPath": "/data", "readOnly": false}
+        ],
+        "volumesFrom": [],
+        "systemControls": [],
+        "dockerLabels": {},
+        "healthCheck": {
+            "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+            "interval": 30,
+            "timeout": 5,
+            "retries": 3
+        },
+        "ulimits": [
+            {"name": "core", "softLimit": 0, "hardLimit": 0},
+            {"name": "nproc", "softLimit": 1024, "hardLimit": 2048}
+        ]
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalent(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+
+	equal, err = tfecs.ContainerDefinitionsAreEquivalentIgnoringDefaults(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equal {
+		t.Fatal("Expected definitions to be equal.")
+	}
+}
+
+func TestContainerDefinitionsAreEquivalentIgnoringDefaults_negative(t *testing.T) {
+	t.Parallel()
+
+	cfgRepresention := `
+[
+    {
+      "name": "wordpress",
+      "image": "wordpress",
+      "memory": 500,
+      "healthCheck": {
+        "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+        "interval": 60
+      }
+    }
+]`
+
+	apiRepresentation := `
+[
+    {
+        "name": "wordpress",
+        "image": "wordpress",
+        "memory": 500,
+        "essential": true,
+        "healthCheck": {
+            "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+            "interval": 30,
+            "timeout": 5,
+            "retries": 3
+        }
+    }
+]`
+
+	equal, err := tfecs.ContainerDefinitionsAreEquivalentIgnoringDefaults(cfgRepresention, apiRepresentation, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if equal {
+		t.Fatal("Expected definitions to differ.")
+	}
+}
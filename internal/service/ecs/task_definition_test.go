@@ -1195,6 +1195,37 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_normalizeContainerDefinitions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_normalizeContainerDefinitions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "normalize_container_definitions", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "registered_container_definitions"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"container_definitions", "normalize_container_definitions", names.AttrSkipDestroy, "track_latest"},
+			},
+		},
+	})
+}
+
 func testAccTaskDefinitionConfig_proxyConfiguration(rName string, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) string {
@@ -2956,6 +2987,43 @@ TASK_DEFINITION
 `, rName)
 }
 
+func testAccTaskDefinitionConfig_normalizeContainerDefinitions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<TASK_DEFINITION
+[
+	{
+		"healthCheck": {
+			"command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+		},
+		"image": "nginx",
+		"memory": 128,
+		"mountPoints": [
+			{
+				"containerPath": "/data",
+				"sourceVolume": "data"
+			}
+		],
+		"name": "nginx",
+		"ulimits": [
+			{"hardLimit": 2048, "name": "nproc", "softLimit": 1024},
+			{"hardLimit": 0, "name": "core", "softLimit": 0}
+		]
+	}
+]
+TASK_DEFINITION
+
+  volume {
+    name = "data"
+  }
+
+  normalize_container_definitions = true
+}
+`, rName)
+}
+
 func testAccTaskDefinitionConfig_trackLatest(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
@@ -5,11 +5,13 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -78,7 +80,7 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: tfresource.WithCapacityProgress(ctx, "aws_ecs_service", id, statusServiceWaitForStable(ctx, conn, id, cluster), serviceCapacityProgress),
 		Timeout: timeout,
 	}
 
@@ -91,6 +93,31 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	return nil, err
 }
 
+func serviceCapacityProgress(result any) (tfresource.CapacityProgress, bool) {
+	service, ok := result.(*ecs.Service)
+
+	if !ok {
+		return tfresource.CapacityProgress{}, false
+	}
+
+	progress := tfresource.CapacityProgress{
+		Current: int(aws.Int64Value(service.RunningCount)),
+		Desired: int(aws.Int64Value(service.DesiredCount)),
+	}
+
+	for _, v := range service.Deployments {
+		if n := aws.Int64Value(v.FailedTasks); n > 0 {
+			progress.Failures = append(progress.Failures, fmt.Sprintf("deployment %s: %d failed task(s)", aws.StringValue(v.Id), n))
+		}
+
+		if aws.StringValue(v.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			progress.Failures = append(progress.Failures, fmt.Sprintf("deployment %s: %s", aws.StringValue(v.Id), aws.StringValue(v.RolloutStateReason)))
+		}
+	}
+
+	return progress, true
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
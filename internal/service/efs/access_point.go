@@ -5,6 +5,7 @@ package efs
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -34,7 +36,10 @@ func ResourceAccessPoint() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceAccessPointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"file_system_arn": {
@@ -50,6 +55,11 @@ func ResourceAccessPoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"force_root_directory_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrOwnerID: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -245,6 +255,20 @@ func resourceAccessPointDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceAccessPointCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// EFS has no API to update an access point, so a new root directory path replaces it.
+	// Files under the old path are not deleted but are no longer reachable through the access point.
+	if d.Id() == "" || !d.HasChange("root_directory.0.path") || !d.NewValueKnown("root_directory.0.path") {
+		return nil
+	}
+
+	if o, n := d.GetChange("root_directory.0.path"); o.(string) != "" && n.(string) != "" && !d.Get("force_root_directory_change").(bool) {
+		return fmt.Errorf("changing root_directory.0.path from %q to %q replaces EFS Access Point (%s) and data under %q will no longer be reachable through it; set force_root_directory_change = true to proceed", o, n, d.Id(), o)
+	}
+
+	return nil
+}
+
 func hasEmptyAccessPoints(aps *efs.DescribeAccessPointsOutput) bool {
 	if aps != nil && len(aps.AccessPoints) > 0 {
 		return false
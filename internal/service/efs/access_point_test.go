@@ -49,9 +49,10 @@ func TestAccEFSAccessPoint_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
 			},
 		},
 	})
@@ -79,9 +80,47 @@ func TestAccEFSAccessPoint_Root_directory(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
+			},
+		},
+	})
+}
+
+func TestAccEFSAccessPoint_RootDirectory_change(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ap1, ap2 efs.AccessPointDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_efs_access_point.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EFSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessPointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessPointConfig_rootDirectory(rName, "/home/test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &ap1),
+					resource.TestCheckResourceAttr(resourceName, "force_root_directory_change", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.path", "/home/test"),
+				),
+			},
+			{
+				Config:      testAccAccessPointConfig_rootDirectory(rName, "/home/test2"),
+				ExpectError: regexache.MustCompile(`set force_root_directory_change = true to proceed`),
+			},
+			{
+				Config: testAccAccessPointConfig_rootDirectoryForceChange(rName, "/home/test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccessPointExists(ctx, resourceName, &ap2),
+					testAccCheckAccessPointRecreated(&ap1, &ap2),
+					resource.TestCheckResourceAttr(resourceName, "force_root_directory_change", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "root_directory.0.path", "/home/test2"),
+				),
 			},
 		},
 	})
@@ -112,9 +151,10 @@ func TestAccEFSAccessPoint_RootDirectoryCreation_info(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
 			},
 		},
 	})
@@ -143,9 +183,10 @@ func TestAccEFSAccessPoint_POSIX_user(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
 			},
 		},
 	})
@@ -173,9 +214,10 @@ func TestAccEFSAccessPoint_POSIXUserSecondary_gids(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "posix_user.0.secondary_gids.#", acctest.Ct1)),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
 			},
 		},
 	})
@@ -202,9 +244,10 @@ func TestAccEFSAccessPoint_tags(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_root_directory_change"},
 			},
 			{
 				Config: testAccAccessPointConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -312,6 +355,16 @@ func testAccCheckAccessPointExists(ctx context.Context, resourceID string, mount
 	}
 }
 
+func testAccCheckAccessPointRecreated(i, j *efs.AccessPointDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.AccessPointId) == aws.StringValue(j.AccessPointId) {
+			return fmt.Errorf("EFS Access Point (%s) not recreated", aws.StringValue(i.AccessPointId))
+		}
+
+		return nil
+	}
+}
+
 func testAccAccessPointConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_efs_file_system" "test" {
@@ -339,6 +392,23 @@ resource "aws_efs_access_point" "test" {
 `, rName, dir)
 }
 
+func testAccAccessPointConfig_rootDirectoryForceChange(rName, dir string) string {
+	return fmt.Sprintf(`
+resource "aws_efs_file_system" "test" {
+  creation_token = %[1]q
+}
+
+resource "aws_efs_access_point" "test" {
+  file_system_id = aws_efs_file_system.test.id
+  root_directory {
+    path = %[2]q
+  }
+
+  force_root_directory_change = true
+}
+`, rName, dir)
+}
+
 func testAccAccessPointConfig_rootDirectoryCreationInfo(rName, dir string) string {
 	return fmt.Sprintf(`
 resource "aws_efs_file_system" "test" {
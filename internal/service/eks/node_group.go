@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -373,7 +375,11 @@ func resourceNodeGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(groupID)
 
-	if _, err := waitNodegroupCreated(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	progress := newNodegroupCapacityProgressWatcher(ctx, meta.(*conns.AWSClient), clusterName, nodeGroupName)
+	_, err = waitNodegroupCreated(ctx, conn, clusterName, nodeGroupName, d.Timeout(schema.TimeoutCreate), progress)
+	diags = append(diags, progress.Diagnostics()...)
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) create: %s", d.Id(), err)
 	}
 
@@ -503,7 +509,11 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		progress := newNodegroupCapacityProgressWatcher(ctx, meta.(*conns.AWSClient), clusterName, nodeGroupName)
+		_, err = waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate), progress)
+		diags = append(diags, progress.Diagnostics()...)
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -540,7 +550,11 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		progress := newNodegroupCapacityProgressWatcher(ctx, meta.(*conns.AWSClient), clusterName, nodeGroupName)
+		_, err = waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate), progress)
+		diags = append(diags, progress.Diagnostics()...)
+
+		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) config update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -664,11 +678,11 @@ func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, n
 	}
 }
 
-func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
+func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration, progress *tfresource.CapacityProgressWatcher) (*types.Nodegroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.NodegroupStatusCreating),
 		Target:  enum.Slice(types.NodegroupStatusActive),
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
+		Refresh: progress.Refresh(ctx, statusNodegroup(ctx, conn, clusterName, nodeGroupName)),
 		Timeout: timeout,
 	}
 
@@ -706,11 +720,11 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 	return nil, err
 }
 
-func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
+func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration, progress *tfresource.CapacityProgressWatcher) (*types.Update, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.UpdateStatusInProgress),
 		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
+		Refresh: progress.Refresh(ctx, statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id)),
 		Timeout: timeout,
 	}
 
@@ -731,6 +745,95 @@ func issueError(apiObject types.Issue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
 
+// newNodegroupCapacityProgressWatcher returns a watcher that reports a node group's healthy and desired
+// node counts and health issues.
+// Node group updates report only the update's status, so the node group is read on each refresh.
+func newNodegroupCapacityProgressWatcher(ctx context.Context, client *conns.AWSClient, clusterName, nodeGroupName string) *tfresource.CapacityProgressWatcher {
+	conn, autoscalingConn := client.EKSClient(ctx), client.AutoScalingClient(ctx)
+
+	return tfresource.NewCapacityProgressWatcher("aws_eks_node_group", NodeGroupCreateResourceID(clusterName, nodeGroupName), func(result any) (tfresource.CapacityProgress, bool) {
+		var nodegroup *types.Nodegroup
+
+		switch v := result.(type) {
+		case *types.Nodegroup:
+			nodegroup = v
+		case *types.Update:
+			output, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+
+			if err != nil {
+				return tfresource.CapacityProgress{}, false
+			}
+
+			nodegroup = output
+		default:
+			return tfresource.CapacityProgress{}, false
+		}
+
+		return nodegroupCapacityProgress(nodegroup, findNodegroupInstances(ctx, autoscalingConn, nodegroup)), true
+	})
+}
+
+// nodegroupCapacityProgress reports a node group's healthy and desired node counts and health issues.
+// A nil instances slice means the node group's instances could not be read.
+func nodegroupCapacityProgress(nodegroup *types.Nodegroup, instances []autoscalingtypes.Instance) tfresource.CapacityProgress {
+	progress := tfresource.CapacityProgress{
+		Current: tfresource.CapacityUnknown,
+		Desired: tfresource.CapacityUnknown,
+	}
+
+	if instances != nil {
+		progress.Current = 0
+
+		for _, v := range instances {
+			if v.LifecycleState == autoscalingtypes.LifecycleStateInService && aws.ToString(v.HealthStatus) == "Healthy" {
+				progress.Current++
+			}
+		}
+	}
+
+	if v := nodegroup.ScalingConfig; v != nil && v.DesiredSize != nil {
+		progress.Desired = int(aws.ToInt32(v.DesiredSize))
+	}
+
+	if v := nodegroup.Health; v != nil {
+		for _, v := range v.Issues {
+			progress.Failures = append(progress.Failures, fmt.Sprintf("%s: %s", strings.Join(v.ResourceIds, ", "), issueError(v)))
+		}
+	}
+
+	return progress
+}
+
+// findNodegroupInstances returns the instances in a node group's Auto Scaling groups.
+// It returns nil if the node group has no Auto Scaling groups yet or they cannot be read.
+func findNodegroupInstances(ctx context.Context, conn *autoscaling.Client, nodegroup *types.Nodegroup) []autoscalingtypes.Instance {
+	if nodegroup.Resources == nil || len(nodegroup.Resources.AutoScalingGroups) == 0 {
+		return nil
+	}
+
+	input := &autoscaling.DescribeAutoScalingGroupsInput{}
+	for _, v := range nodegroup.Resources.AutoScalingGroups {
+		input.AutoScalingGroupNames = append(input.AutoScalingGroupNames, aws.ToString(v.Name))
+	}
+
+	instances := []autoscalingtypes.Instance{}
+	pages := autoscaling.NewDescribeAutoScalingGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			log.Printf("[DEBUG] reading EKS Node Group (%s) Auto Scaling groups: %s", aws.ToString(nodegroup.NodegroupName), err)
+			return nil
+		}
+
+		for _, v := range page.AutoScalingGroups {
+			instances = append(instances, v.Instances...)
+		}
+	}
+
+	return instances
+}
+
 func issuesError(apiObjects []types.Issue) error {
 	var errs []error
 
@@ -10,7 +10,9 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -25,6 +27,73 @@ func init() {
 	acctest.RegisterServiceErrorCheckFunc(names.EKSServiceID, testAccErrorCheckSkip)
 }
 
+func TestNodegroupCapacityProgress(t *testing.T) {
+	t.Parallel()
+
+	nodegroup := &types.Nodegroup{
+		Health: &types.NodegroupHealth{
+			Issues: []types.Issue{
+				{
+					Code:        types.NodegroupIssueCodeAsgInstanceLaunchFailures,
+					Message:     aws.String("Instance launch failed"),
+					ResourceIds: []string{"eks-test-asg"},
+				},
+			},
+		},
+		ScalingConfig: &types.NodegroupScalingConfig{
+			DesiredSize: aws.Int32(3),
+		},
+	}
+
+	testCases := map[string]struct {
+		instances []autoscalingtypes.Instance
+		expected  tfresource.CapacityProgress
+	}{
+		"instances unknown": {
+			expected: tfresource.CapacityProgress{
+				Current:  tfresource.CapacityUnknown,
+				Desired:  3,
+				Failures: []string{"eks-test-asg: AsgInstanceLaunchFailures: Instance launch failed"},
+			},
+		},
+		"no instances": {
+			instances: []autoscalingtypes.Instance{},
+			expected: tfresource.CapacityProgress{
+				Current:  0,
+				Desired:  3,
+				Failures: []string{"eks-test-asg: AsgInstanceLaunchFailures: Instance launch failed"},
+			},
+		},
+		"instances": {
+			instances: []autoscalingtypes.Instance{
+				{HealthStatus: aws.String("Healthy"), LifecycleState: autoscalingtypes.LifecycleStateInService},
+				{HealthStatus: aws.String("Healthy"), LifecycleState: autoscalingtypes.LifecycleStatePending},
+				{HealthStatus: aws.String("Unhealthy"), LifecycleState: autoscalingtypes.LifecycleStateInService},
+				{HealthStatus: aws.String("Healthy"), LifecycleState: autoscalingtypes.LifecycleStateInService},
+			},
+			expected: tfresource.CapacityProgress{
+				Current:  2,
+				Desired:  3,
+				Failures: []string{"eks-test-asg: AsgInstanceLaunchFailures: Instance launch failed"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfeks.NodegroupCapacityProgress(nodegroup, testCase.instances)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccEKSNodeGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var nodeGroup types.Nodegroup
@@ -154,6 +154,10 @@ func loadBalancingAnomalyMitigationType_Values() []string {
 	}
 }
 
+const (
+	healthyThresholdOff = "off"
+)
+
 const (
 	loadBalancingCrossZoneEnabledTrue                         = "true"
 	loadBalancingCrossZoneEnabledFalse                        = "false"
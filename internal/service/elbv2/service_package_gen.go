@@ -39,6 +39,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTargetGroup,
 			TypeName: "aws_alb_target_group",
 		},
+		{
+			Factory:  DataSourceTargetHealth,
+			TypeName: "aws_elbv2_target_health",
+		},
 		{
 			Factory:  DataSourceLoadBalancer,
 			TypeName: "aws_lb",
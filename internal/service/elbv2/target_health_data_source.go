@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elbv2_target_health")
+func DataSourceTargetHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTargetHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrState: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.TargetHealthStateEnum](),
+				},
+			},
+			names.AttrTarget: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"target_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"target_health_descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTargetHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Client(ctx)
+
+	targetGroupARN := d.Get("target_group_arn").(string)
+	input := &elasticloadbalancingv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	}
+
+	if v, ok := d.GetOk(names.AttrTarget); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Targets = []awstypes.TargetDescription{expandTargetDescription(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	states := flex.ExpandStringValueSet(d.Get(names.AttrState).(*schema.Set))
+	filter := tfslices.PredicateTrue[*awstypes.TargetHealthDescription]()
+	if len(states) > 0 {
+		filter = func(v *awstypes.TargetHealthDescription) bool {
+			return v.TargetHealth != nil && slices.Contains(states, string(v.TargetHealth.State))
+		}
+	}
+
+	output, err := findTargetHealthDescriptionsV2(ctx, conn, input, filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Target Group (%s) target health: %s", targetGroupARN, err)
+	}
+
+	d.SetId(targetGroupARN)
+	if err := d.Set("target_health_descriptions", flattenTargetHealthDescriptions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_health_descriptions: %s", err)
+	}
+
+	return diags
+}
+
+func findTargetHealthDescriptionsV2(ctx context.Context, conn *elasticloadbalancingv2.Client, input *elasticloadbalancingv2.DescribeTargetHealthInput, filter tfslices.Predicate[*awstypes.TargetHealthDescription]) ([]awstypes.TargetHealthDescription, error) {
+	output, err := conn.DescribeTargetHealth(ctx, input)
+
+	if errs.IsA[*awstypes.TargetGroupNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfslices.Filter(output.TargetHealthDescriptions, func(v awstypes.TargetHealthDescription) bool {
+		return filter(&v)
+	}), nil
+}
+
+func expandTargetDescription(tfMap map[string]interface{}) awstypes.TargetDescription {
+	apiObject := awstypes.TargetDescription{}
+
+	if v, ok := tfMap[names.AttrAvailabilityZone].(string); ok && v != "" {
+		apiObject.AvailabilityZone = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrID].(string); ok && v != "" {
+		apiObject.Id = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrPort].(int); ok && v != 0 {
+		apiObject.Port = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenTargetHealthDescriptions(apiObjects []awstypes.TargetHealthDescription) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"health_check_port": aws.ToString(apiObject.HealthCheckPort),
+		}
+
+		if v := apiObject.Target; v != nil {
+			tfMap[names.AttrAvailabilityZone] = aws.ToString(v.AvailabilityZone)
+			tfMap[names.AttrPort] = aws.ToInt32(v.Port)
+			tfMap["target_id"] = aws.ToString(v.Id)
+		}
+
+		if v := apiObject.TargetHealth; v != nil {
+			tfMap[names.AttrDescription] = aws.ToString(v.Description)
+			tfMap["reason"] = string(v.Reason)
+			tfMap[names.AttrState] = string(v.State)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2TargetHealthDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elbv2_target_health.test"
+	attachmentResourceName := "aws_lb_target_group_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_group_arn", attachmentResourceName, "target_group_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_health_descriptions.0.target_id", attachmentResourceName, "target_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.port", "443"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.state", "unused"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_health_descriptions.0.reason", "Target.NotInUse"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccELBV2TargetHealthDataSource_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceNameHealthy := "data.aws_elbv2_target_health.healthy"
+	dataSourceNameUnused := "data.aws_elbv2_target_health.unused"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetHealthDataSourceConfig_state(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceNameHealthy, "target_health_descriptions.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceNameUnused, "target_health_descriptions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceNameUnused, "target_health_descriptions.0.state", "unused"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTargetHealthDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  name        = %[1]q
+  port        = 443
+  protocol    = "HTTPS"
+  target_type = "ip"
+  vpc_id      = aws_vpc.test.id
+}
+
+resource "aws_lb_target_group_attachment" "test" {
+  target_group_arn = aws_lb_target_group.test.arn
+  target_id        = cidrhost(aws_subnet.test[0].cidr_block, 10)
+}
+`, rName))
+}
+
+func testAccTargetHealthDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTargetHealthDataSourceConfig_base(rName), `
+data "aws_elbv2_target_health" "test" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+
+  target {
+    id = aws_lb_target_group_attachment.test.target_id
+  }
+}
+`)
+}
+
+func testAccTargetHealthDataSourceConfig_state(rName string) string {
+	return acctest.ConfigCompose(testAccTargetHealthDataSourceConfig_base(rName), `
+data "aws_elbv2_target_health" "healthy" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+  state            = ["healthy"]
+}
+
+data "aws_elbv2_target_health" "unused" {
+  target_group_arn = aws_lb_target_group_attachment.test.target_group_arn
+  state            = ["unused", "unavailable"]
+}
+`)
+}
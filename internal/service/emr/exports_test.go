@@ -14,11 +14,12 @@ var (
 	ResourceStudio                         = resourceStudio
 	ResourceStudioSessionMapping           = resourceStudioSessionMapping
 
-	FetchInstanceGroup                 = fetchInstanceGroup
-	FindBlockPublicAccessConfiguration = findBlockPublicAccessConfiguration
-	FindClusterByID                    = findClusterByID
-	FindInstanceFleetByTwoPartKey      = findInstanceFleetByTwoPartKey
-	FindSecurityConfigurationByName    = findSecurityConfigurationByName
-	FindStudioByID                     = findStudioByID
-	FindStudioSessionMappingByIDOrName = findStudioSessionMappingByIDOrName
+	FetchInstanceGroup                  = fetchInstanceGroup
+	FindBlockPublicAccessConfiguration  = findBlockPublicAccessConfiguration
+	FindClusterByID                     = findClusterByID
+	FindInstanceFleetByTwoPartKey       = findInstanceFleetByTwoPartKey
+	FindSecurityConfigurationByName     = findSecurityConfigurationByName
+	FindStudioByID                      = findStudioByID
+	FindStudioSessionMappingByIDOrName  = findStudioSessionMappingByIDOrName
+	FindStudioSessionMappingsByStudioID = findStudioSessionMappingsByStudioID
 )
@@ -88,6 +88,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_emr_studio_session_mapping",
 			Name:     "Studio Session Mapping",
 		},
+		{
+			Factory:  resourceStudioSessionMappings,
+			TypeName: "aws_emr_studio_session_mappings",
+			Name:     "Studio Session Mappings",
+		},
 	}
 }
 
@@ -58,11 +58,30 @@ func resourceStudio() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
+			"encryption_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
 			"engine_security_group_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"idc_instance_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"idc_user_assignment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(emr.IdcUserAssignment_Values(), false),
+			},
 			"idp_auth_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -93,6 +112,12 @@ func resourceStudio() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trusted_identity_propagation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"user_role": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -137,6 +162,18 @@ func resourceStudioCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("encryption_key_arn"); ok {
+		input.EncryptionKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idc_instance_arn"); ok {
+		input.IdcInstanceArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idc_user_assignment"); ok {
+		input.IdcUserAssignment = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("idp_auth_url"); ok {
 		input.IdpAuthUrl = aws.String(v.(string))
 	}
@@ -145,6 +182,10 @@ func resourceStudioCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.IdpRelayStateParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOkExists("trusted_identity_propagation_enabled"); ok {
+		input.TrustedIdentityPropagationEnabled = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("user_role"); ok {
 		input.UserRole = aws.String(v.(string))
 	}
@@ -197,6 +238,10 @@ func resourceStudioUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.DefaultS3Location = aws.String(d.Get("default_s3_location").(string))
 		}
 
+		if d.HasChange("encryption_key_arn") {
+			input.EncryptionKeyArn = aws.String(d.Get("encryption_key_arn").(string))
+		}
+
 		if d.HasChange(names.AttrSubnetIDs) {
 			input.SubnetIds = flex.ExpandStringSet(d.Get(names.AttrSubnetIDs).(*schema.Set))
 		}
@@ -231,11 +276,15 @@ func resourceStudioRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("auth_mode", studio.AuthMode)
 	d.Set("default_s3_location", studio.DefaultS3Location)
 	d.Set(names.AttrDescription, studio.Description)
+	d.Set("encryption_key_arn", studio.EncryptionKeyArn)
 	d.Set("engine_security_group_id", studio.EngineSecurityGroupId)
+	d.Set("idc_instance_arn", studio.IdcInstanceArn)
+	d.Set("idc_user_assignment", studio.IdcUserAssignment)
 	d.Set("idp_auth_url", studio.IdpAuthUrl)
 	d.Set("idp_relay_state_parameter_name", studio.IdpRelayStateParameterName)
 	d.Set(names.AttrName, studio.Name)
 	d.Set(names.AttrServiceRole, studio.ServiceRole)
+	d.Set("trusted_identity_propagation_enabled", studio.TrustedIdentityPropagationEnabled)
 	d.Set(names.AttrURL, studio.Url)
 	d.Set("user_role", studio.UserRole)
 	d.Set(names.AttrVPCID, studio.VpcId)
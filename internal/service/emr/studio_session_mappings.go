@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_emr_studio_session_mappings", name="Studio Session Mappings")
+func resourceStudioSessionMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStudioSessionMappingsCreate,
+		ReadWithoutTimeout:   resourceStudioSessionMappingsRead,
+		UpdateWithoutTimeout: resourceStudioSessionMappingsUpdate,
+		DeleteWithoutTimeout: resourceStudioSessionMappingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("studio_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mapping": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"identity_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"identity_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(emr.IdentityType_Values(), false),
+						},
+						"session_policy_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"studio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceStudioSessionMappingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	studioID := d.Get("studio_id").(string)
+	mappings, err := expandStudioSessionMappings(d.Get("mapping").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Set the ID before creating any mappings so that a partial failure leaves
+	// a tainted resource whose Delete removes the mappings that were created.
+	d.SetId(studioID)
+
+	for _, v := range mappings {
+		if err := createStudioSessionMapping(ctx, conn, studioID, v); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceStudioSessionMappingsRead(ctx, d, meta)...)
+}
+
+func resourceStudioSessionMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	summaries, err := findStudioSessionMappingsByStudioID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Studio (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Studio (%s) Session Mappings: %s", d.Id(), err)
+	}
+
+	// Identities configured by name are kept by name.
+	configured, _ := expandStudioSessionMappings(d.Get("mapping").(*schema.Set).List())
+	byName := make(map[string]bool)
+	for _, v := range configured {
+		if v.identityName != "" {
+			byName[v.identityType+":"+v.identityName] = true
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(summaries))
+	for _, v := range summaries {
+		tfMap := map[string]interface{}{
+			"identity_type":      aws.StringValue(v.IdentityType),
+			"session_policy_arn": aws.StringValue(v.SessionPolicyArn),
+		}
+
+		if byName[aws.StringValue(v.IdentityType)+":"+aws.StringValue(v.IdentityName)] {
+			tfMap["identity_name"] = aws.StringValue(v.IdentityName)
+		} else {
+			tfMap["identity_id"] = aws.StringValue(v.IdentityId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	if err := d.Set("mapping", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting mapping: %s", err)
+	}
+	d.Set("studio_id", d.Id())
+
+	return diags
+}
+
+func resourceStudioSessionMappingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	o, n := d.GetChange("mapping")
+	oldMappings, err := expandStudioSessionMappings(o.(*schema.Set).List())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	newMappings, err := expandStudioSessionMappings(n.(*schema.Set).List())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	oldByKey := make(map[string]studioSessionMapping, len(oldMappings))
+	for _, v := range oldMappings {
+		oldByKey[v.key()] = v
+	}
+	newByKey := make(map[string]studioSessionMapping, len(newMappings))
+	for _, v := range newMappings {
+		newByKey[v.key()] = v
+	}
+
+	for k, v := range oldByKey {
+		if _, ok := newByKey[k]; !ok {
+			if err := deleteStudioSessionMapping(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	for k, v := range newByKey {
+		if o, ok := oldByKey[k]; !ok {
+			if err := createStudioSessionMapping(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		} else if o.sessionPolicyARN != v.sessionPolicyARN {
+			if err := updateStudioSessionMapping(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceStudioSessionMappingsRead(ctx, d, meta)...)
+}
+
+func resourceStudioSessionMappingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	mappings, err := expandStudioSessionMappings(d.Get("mapping").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting EMR Studio (%s) Session Mappings", d.Id())
+	for _, v := range mappings {
+		if err := deleteStudioSessionMapping(ctx, conn, d.Id(), v); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return diags
+}
+
+type studioSessionMapping struct {
+	identityID       string
+	identityName     string
+	identityType     string
+	sessionPolicyARN string
+}
+
+func (m studioSessionMapping) key() string {
+	if m.identityID != "" {
+		return fmt.Sprintf("%s:%s", m.identityType, m.identityID)
+	}
+
+	return fmt.Sprintf("%s:%s", m.identityType, m.identityName)
+}
+
+func expandStudioSessionMappings(tfList []interface{}) ([]studioSessionMapping, error) {
+	apiObjects := make([]studioSessionMapping, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := studioSessionMapping{
+			identityID:       tfMap["identity_id"].(string),
+			identityName:     tfMap["identity_name"].(string),
+			identityType:     tfMap["identity_type"].(string),
+			sessionPolicyARN: tfMap["session_policy_arn"].(string),
+		}
+
+		if (apiObject.identityID == "") == (apiObject.identityName == "") {
+			return nil, fmt.Errorf("exactly one of identity_id or identity_name must be specified for each EMR Studio Session Mapping")
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func createStudioSessionMapping(ctx context.Context, conn *emr.EMR, studioID string, mapping studioSessionMapping) error {
+	input := &emr.CreateStudioSessionMappingInput{
+		IdentityType:     aws.String(mapping.identityType),
+		SessionPolicyArn: aws.String(mapping.sessionPolicyARN),
+		StudioId:         aws.String(studioID),
+	}
+
+	if mapping.identityID != "" {
+		input.IdentityId = aws.String(mapping.identityID)
+	} else {
+		input.IdentityName = aws.String(mapping.identityName)
+	}
+
+	if _, err := conn.CreateStudioSessionMappingWithContext(ctx, input); err != nil {
+		return fmt.Errorf("creating EMR Studio (%s) Session Mapping (%s): %w", studioID, mapping.key(), err)
+	}
+
+	return nil
+}
+
+func updateStudioSessionMapping(ctx context.Context, conn *emr.EMR, studioID string, mapping studioSessionMapping) error {
+	input := &emr.UpdateStudioSessionMappingInput{
+		IdentityType:     aws.String(mapping.identityType),
+		SessionPolicyArn: aws.String(mapping.sessionPolicyARN),
+		StudioId:         aws.String(studioID),
+	}
+
+	if mapping.identityID != "" {
+		input.IdentityId = aws.String(mapping.identityID)
+	} else {
+		input.IdentityName = aws.String(mapping.identityName)
+	}
+
+	if _, err := conn.UpdateStudioSessionMappingWithContext(ctx, input); err != nil {
+		return fmt.Errorf("updating EMR Studio (%s) Session Mapping (%s): %w", studioID, mapping.key(), err)
+	}
+
+	return nil
+}
+
+func deleteStudioSessionMapping(ctx context.Context, conn *emr.EMR, studioID string, mapping studioSessionMapping) error {
+	input := &emr.DeleteStudioSessionMappingInput{
+		IdentityType: aws.String(mapping.identityType),
+		StudioId:     aws.String(studioID),
+	}
+
+	if mapping.identityID != "" {
+		input.IdentityId = aws.String(mapping.identityID)
+	} else {
+		input.IdentityName = aws.String(mapping.identityName)
+	}
+
+	_, err := conn.DeleteStudioSessionMappingWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio session mapping does not exist") ||
+		tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EMR Studio (%s) Session Mapping (%s): %w", studioID, mapping.key(), err)
+	}
+
+	return nil
+}
+
+func findStudioSessionMappingsByStudioID(ctx context.Context, conn *emr.EMR, studioID string) ([]*emr.SessionMappingSummary, error) {
+	input := &emr.ListStudioSessionMappingsInput{
+		StudioId: aws.String(studioID),
+	}
+	var output []*emr.SessionMappingSummary
+
+	err := conn.ListStudioSessionMappingsPagesWithContext(ctx, input, func(page *emr.ListStudioSessionMappingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.SessionMappings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio does not exist") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
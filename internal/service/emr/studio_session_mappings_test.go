@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemr "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRStudioSessionMappings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_studio_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	updatedName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	uName := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
+	gName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckUserID(t)
+			testAccPreCheckGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioSessionMappingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioSessionMappingsConfig_basic(rName, uName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioSessionMappingsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", "aws_emr_studio.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "mapping.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mapping.*", map[string]string{
+						"identity_id":   uName,
+						"identity_type": "USER",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStudioSessionMappingsConfig_updated(rName, uName, gName, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioSessionMappingsExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "mapping.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mapping.*", map[string]string{
+						"identity_id":   uName,
+						"identity_type": "USER",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mapping.*", map[string]string{
+						"identity_name": gName,
+						"identity_type": "GROUP",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "mapping.*.session_policy_arn", "aws_iam_policy.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRStudioSessionMappings_reconcile(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_studio_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	uName := os.Getenv("AWS_IDENTITY_STORE_USER_ID")
+	gName := os.Getenv("AWS_IDENTITY_STORE_GROUP_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckUserID(t)
+			testAccPreCheckGroupName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioSessionMappingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioSessionMappingsConfig_basic(rName, uName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioSessionMappingsExists(ctx, resourceName, 1),
+					testAccCheckStudioSessionMappingsCreateGroupMapping(ctx, resourceName, gName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStudioSessionMappingsConfig_basic(rName, uName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioSessionMappingsExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "mapping.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStudioSessionMappingsExists(ctx context.Context, resourceName string, n int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn(ctx)
+
+		output, err := tfemr.FindStudioSessionMappingsByStudioID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) != n {
+			return fmt.Errorf("EMR Studio (%s) has %d Session Mappings, expected %d", rs.Primary.ID, len(output), n)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckStudioSessionMappingsCreateGroupMapping maps a group to the Studio outside of Terraform.
+func testAccCheckStudioSessionMappingsCreateGroupMapping(ctx context.Context, resourceName, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn(ctx)
+
+		_, err := conn.CreateStudioSessionMappingWithContext(ctx, &emr.CreateStudioSessionMappingInput{
+			IdentityName:     aws.String(groupName),
+			IdentityType:     aws.String(emr.IdentityTypeGroup),
+			SessionPolicyArn: aws.String(rs.Primary.Attributes["mapping.0.session_policy_arn"]),
+			StudioId:         aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckStudioSessionMappingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emr_studio_session_mappings" {
+				continue
+			}
+
+			output, err := tfemr.FindStudioSessionMappingsByStudioID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) > 0 {
+				return fmt.Errorf("EMR Studio (%s) Session Mappings still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStudioSessionMappingsConfig_basic(rName, uName string) string {
+	return acctest.ConfigCompose(testAccStudioSessionMappingConfigBase(rName), fmt.Sprintf(`
+resource "aws_emr_studio_session_mappings" "test" {
+  studio_id = aws_emr_studio.test.id
+
+  mapping {
+    identity_type      = "USER"
+    identity_id        = %[1]q
+    session_policy_arn = aws_iam_policy.test.arn
+  }
+}
+`, uName))
+}
+
+func testAccStudioSessionMappingsConfig_updated(rName, uName, gName, updatedName string) string {
+	return acctest.ConfigCompose(testAccStudioSessionMappingConfigBase(rName), fmt.Sprintf(`
+resource "aws_iam_policy" "test2" {
+  name   = %[3]q
+  policy = aws_iam_policy.test.policy
+}
+
+resource "aws_emr_studio_session_mappings" "test" {
+  studio_id = aws_emr_studio.test.id
+
+  mapping {
+    identity_type      = "USER"
+    identity_id        = %[1]q
+    session_policy_arn = aws_iam_policy.test2.arn
+  }
+
+  mapping {
+    identity_type      = "GROUP"
+    identity_name      = %[2]q
+    session_policy_arn = aws_iam_policy.test.arn
+  }
+}
+`, uName, gName, updatedName))
+}
@@ -112,6 +112,42 @@ func TestAccEMRStudio_iam(t *testing.T) {
 	})
 }
 
+func TestAccEMRStudio_encryptionKeyARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioConfig_encryptionKeyARN(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test.0", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "trusted_identity_propagation_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStudioConfig_encryptionKeyARN(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test.1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRStudio_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var studio emr.Studio
@@ -322,6 +358,30 @@ resource "aws_emr_studio" "test" {
 `, rName))
 }
 
+func testAccStudioConfig_encryptionKeyARN(rName string, keyIndex int) string {
+	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  count = 2
+
+  description             = "%[1]s-${count.index}"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  encryption_key_arn          = aws_kms_key.test[%[2]d].arn
+  engine_security_group_id    = aws_security_group.test.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = aws_subnet.test[*].id
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.test.id
+}
+`, rName, keyIndex))
+}
+
 func testAccStudioConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
 resource "aws_emr_studio" "test" {
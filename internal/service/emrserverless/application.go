@@ -155,6 +155,26 @@ func resourceApplication() *schema.Resource {
 					},
 				},
 			},
+			"interactive_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"livy_endpoint_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"studio_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"maximum_capacity": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -211,6 +231,27 @@ func resourceApplication() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"scheduler_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_concurrent_runs": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 1000),
+						},
+						"queue_timeout_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(15, 720),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
@@ -258,6 +299,10 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.InitialCapacity = expandInitialCapacity(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("interactive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InteractiveConfiguration = expandInteractiveConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -266,6 +311,10 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.NetworkConfiguration = expandNetworkConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("scheduler_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SchedulerConfiguration = expandSchedulerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	output, err := conn.CreateApplication(ctx, input)
 
 	if err != nil {
@@ -319,6 +368,10 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting initial_capacity: %s", err)
 	}
 
+	if err := d.Set("interactive_configuration", flattenInteractiveConfiguration(application.InteractiveConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting interactive_configuration: %s", err)
+	}
+
 	if err := d.Set("maximum_capacity", []interface{}{flattenMaximumCapacity(application.MaximumCapacity)}); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting maximum_capacity: %s", err)
 	}
@@ -327,6 +380,10 @@ func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
 
+	if err := d.Set("scheduler_configuration", flattenSchedulerConfiguration(application.SchedulerConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduler_configuration: %s", err)
+	}
+
 	setTagsOut(ctx, application.Tags)
 
 	return diags
@@ -362,6 +419,10 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.InitialCapacity = expandInitialCapacity(v.(*schema.Set))
 		}
 
+		if v, ok := d.GetOk("interactive_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.InteractiveConfiguration = expandInteractiveConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		if v, ok := d.GetOk("maximum_capacity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			input.MaximumCapacity = expandMaximumCapacity(v.([]interface{})[0].(map[string]interface{}))
 		}
@@ -374,6 +435,13 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.ReleaseLabel = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("scheduler_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.SchedulerConfiguration = expandSchedulerConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		} else if d.HasChange("scheduler_configuration") {
+			// An empty configuration disables job run queuing.
+			input.SchedulerConfiguration = &types.SchedulerConfiguration{}
+		}
+
 		_, err := conn.UpdateApplication(ctx, input)
 
 		if err != nil {
@@ -573,6 +641,78 @@ func flattenAutoStopConfig(apiObject *types.AutoStopConfig) map[string]interface
 	return tfMap
 }
 
+func expandInteractiveConfiguration(tfMap map[string]interface{}) *types.InteractiveConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.InteractiveConfiguration{}
+
+	if v, ok := tfMap["livy_endpoint_enabled"].(bool); ok {
+		apiObject.LivyEndpointEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["studio_enabled"].(bool); ok {
+		apiObject.StudioEnabled = aws.Bool(v)
+	}
+
+	return apiObject
+}
+
+func flattenInteractiveConfiguration(apiObject *types.InteractiveConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LivyEndpointEnabled; v != nil {
+		tfMap["livy_endpoint_enabled"] = aws.ToBool(v)
+	}
+
+	if v := apiObject.StudioEnabled; v != nil {
+		tfMap["studio_enabled"] = aws.ToBool(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandSchedulerConfiguration(tfMap map[string]interface{}) *types.SchedulerConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.SchedulerConfiguration{}
+
+	if v, ok := tfMap["max_concurrent_runs"].(int); ok && v != 0 {
+		apiObject.MaxConcurrentRuns = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["queue_timeout_minutes"].(int); ok && v != 0 {
+		apiObject.QueueTimeoutMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenSchedulerConfiguration(apiObject *types.SchedulerConfiguration) []interface{} {
+	if apiObject == nil || (apiObject.MaxConcurrentRuns == nil && apiObject.QueueTimeoutMinutes == nil) {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxConcurrentRuns; v != nil {
+		tfMap["max_concurrent_runs"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.QueueTimeoutMinutes; v != nil {
+		tfMap["queue_timeout_minutes"] = aws.ToInt32(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandMaximumCapacity(tfMap map[string]interface{}) *types.MaximumAllowedResources {
 	if tfMap == nil {
 		return nil
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -90,6 +91,31 @@ func resourceConnection() *schema.Resource {
 				}
 			}
 
+			connectivityParameters := func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_parameters": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_association_arn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"resource_configuration_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				}
+			}
+
 			return map[string]*schema.Schema{
 				names.AttrARN: {
 					Type:     schema.TypeString,
@@ -159,6 +185,12 @@ func resourceConnection() *schema.Resource {
 									},
 								},
 							},
+							"connectivity_parameters": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem:     connectivityParameters(),
+							},
 							"invocation_http_parameters": {
 								Type:     schema.TypeList,
 								Optional: true,
@@ -234,6 +266,12 @@ func resourceConnection() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(0, 512),
 				},
+				"invocation_connectivity_parameters": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem:     connectivityParameters(),
+				},
 				names.AttrName: {
 					Type:     schema.TypeString,
 					Required: true,
@@ -267,6 +305,10 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("invocation_connectivity_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InvocationConnectivityParameters = expandConnectivityResourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.CreateConnection(ctx, input)
 
 	if err != nil {
@@ -300,12 +342,29 @@ func resourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.Set(names.AttrARN, output.ConnectionArn)
 	if output.AuthParameters != nil {
-		if err := d.Set("auth_parameters", flattenConnectionAuthParameters(output.AuthParameters, d)); err != nil {
+		authParameters := flattenConnectionAuthParameters(output.AuthParameters, d)
+
+		// Credentials are stored in a Secrets Manager secret managed by EventBridge and are never returned.
+		// A deauthorized connection indicates that the stored credentials are no longer valid, e.g. the secret was
+		// modified outside of Terraform, so clear the credentials from state to force them to be sent again.
+		if output.ConnectionState == types.ConnectionStateDeauthorized {
+			log.Printf("[WARN] EventBridge Connection (%s) is deauthorized: %s", d.Id(), aws.ToString(output.StateReason))
+			clearConnectionAuthParametersSecrets(authParameters)
+		}
+
+		if err := d.Set("auth_parameters", authParameters); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting auth_parameters error: %s", err)
 		}
 	}
 	d.Set("authorization_type", output.AuthorizationType)
 	d.Set(names.AttrDescription, output.Description)
+	if output.InvocationConnectivityParameters != nil {
+		if err := d.Set("invocation_connectivity_parameters", []interface{}{flattenDescribeConnectionConnectivityParameters(output.InvocationConnectivityParameters)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting invocation_connectivity_parameters: %s", err)
+		}
+	} else {
+		d.Set("invocation_connectivity_parameters", nil)
+	}
 	d.Set(names.AttrName, output.Name)
 	d.Set("secret_arn", output.SecretArn)
 
@@ -332,6 +391,10 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("invocation_connectivity_parameters"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InvocationConnectivityParameters = expandConnectivityResourceParameters(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	_, err := conn.UpdateConnection(ctx, input)
 
 	if err != nil {
@@ -492,6 +555,9 @@ func expandCreateConnectionAuthRequestParameters(config []interface{}) *types.Cr
 		if val, ok := param["invocation_http_parameters"]; ok {
 			authParameters.InvocationHttpParameters = expandConnectionHTTPParameters(val.([]interface{}))
 		}
+		if val, ok := param["connectivity_parameters"].([]interface{}); ok && len(val) > 0 && val[0] != nil {
+			authParameters.ConnectivityParameters = expandConnectivityResourceParameters(val[0].(map[string]interface{}))
+		}
 	}
 
 	return authParameters
@@ -676,6 +742,10 @@ func flattenConnectionAuthParameters(authParameters *types.ConnectionAuthRespons
 		config["invocation_http_parameters"] = flattenConnectionHTTPParameters(authParameters.InvocationHttpParameters, d, "auth_parameters.0.invocation_http_parameters")
 	}
 
+	if authParameters.ConnectivityParameters != nil {
+		config["connectivity_parameters"] = []interface{}{flattenDescribeConnectionConnectivityParameters(authParameters.ConnectivityParameters)}
+	}
+
 	result := []map[string]interface{}{config}
 	return result
 }
@@ -823,6 +893,9 @@ func expandUpdateConnectionAuthRequestParameters(config []interface{}) *types.Up
 		if val, ok := param["invocation_http_parameters"]; ok {
 			authParameters.InvocationHttpParameters = expandConnectionHTTPParameters(val.([]interface{}))
 		}
+		if val, ok := param["connectivity_parameters"].([]interface{}); ok && len(val) > 0 && val[0] != nil {
+			authParameters.ConnectivityParameters = expandConnectivityResourceParameters(val[0].(map[string]interface{}))
+		}
 	}
 
 	return authParameters
@@ -898,3 +971,67 @@ func expandUpdateConnectionOAuthClientRequestParameters(config []interface{}) *t
 	}
 	return oAuthClientRequestParameters
 }
+
+func expandConnectivityResourceParameters(tfMap map[string]interface{}) *types.ConnectivityResourceParameters {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ConnectivityResourceParameters{}
+
+	if v, ok := tfMap["resource_parameters"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.ResourceParameters = &types.ConnectivityResourceConfigurationArn{}
+
+		if v, ok := tfMap["resource_configuration_arn"].(string); ok && v != "" {
+			apiObject.ResourceParameters.ResourceConfigurationArn = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenDescribeConnectionConnectivityParameters(apiObject *types.DescribeConnectionConnectivityParameters) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceParameters; v != nil {
+		tfMap["resource_parameters"] = []interface{}{map[string]interface{}{
+			"resource_association_arn":   aws.ToString(v.ResourceAssociationArn),
+			"resource_configuration_arn": aws.ToString(v.ResourceConfigurationArn),
+		}}
+	}
+
+	return tfMap
+}
+
+// clearConnectionAuthParametersSecrets removes secret values from flattened auth parameters.
+func clearConnectionAuthParametersSecrets(tfList []map[string]interface{}) {
+	for _, tfMap := range tfList {
+		for _, v := range []struct {
+			block, attr string
+		}{
+			{"api_key", names.AttrValue},
+			{"basic", names.AttrPassword},
+		} {
+			if tfList, ok := tfMap[v.block].([]map[string]interface{}); ok {
+				for _, tfMap := range tfList {
+					delete(tfMap, v.attr)
+				}
+			}
+		}
+
+		if tfList, ok := tfMap["oauth"].([]map[string]interface{}); ok {
+			for _, tfMap := range tfList {
+				if tfList, ok := tfMap["client_parameters"].([]map[string]interface{}); ok {
+					for _, tfMap := range tfList {
+						delete(tfMap, names.AttrClientSecret)
+					}
+				}
+			}
+		}
+	}
+}
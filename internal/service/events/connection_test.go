@@ -558,6 +558,39 @@ func TestAccEventsConnection_invocationHTTPParameters(t *testing.T) {
 	})
 }
 
+func TestAccEventsConnection_invocationConnectivityParameters(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceConfigurationARN := acctest.SkipIfEnvVarNotSet(t, "EVENTBRIDGE_CONNECTION_RESOURCE_CONFIGURATION_ARN")
+	var v eventbridge.DescribeConnectionOutput
+	name := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_invocationConnectivityParameters(name, resourceConfigurationARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "invocation_connectivity_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "invocation_connectivity_parameters.0.resource_parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "invocation_connectivity_parameters.0.resource_parameters.0.resource_configuration_arn", resourceConfigurationARN),
+					resource.TestCheckResourceAttrSet(resourceName, "invocation_connectivity_parameters.0.resource_parameters.0.resource_association_arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth_parameters.0.api_key.0.value"},
+			},
+		},
+	})
+}
+
 func TestAccEventsConnection_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v eventbridge.DescribeConnectionOutput
@@ -884,3 +917,25 @@ resource "aws_cloudwatch_event_connection" "oauth" {
 }
 `, name, description, authorizationType, authorizationEndpoint, httpMethod)
 }
+
+func testAccConnectionConfig_invocationConnectivityParameters(name, resourceConfigurationARN string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "test" {
+  name               = %[1]q
+  authorization_type = "API_KEY"
+
+  auth_parameters {
+    api_key {
+      key   = "testKey"
+      value = "testValue"
+    }
+  }
+
+  invocation_connectivity_parameters {
+    resource_parameters {
+      resource_configuration_arn = %[2]q
+    }
+  }
+}
+`, name, resourceConfigurationARN)
+}
@@ -291,7 +291,14 @@ func resourceKxVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 	log.Printf("[DEBUG] Updating FinSpace KxVolume (%s): %#v", d.Id(), in)
 
-	if _, err := conn.UpdateKxVolume(ctx, in); err != nil {
+	// Attaching a volume to a cluster puts it into the UPDATING state.
+	if _, err := waitKxVolumeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return create.AppendDiagError(diags, names.FinSpace, create.ErrActionUpdating, ResNameKxVolume, d.Id(), err)
+	}
+
+	if _, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		return conn.UpdateKxVolume(ctx, in)
+	}); err != nil {
 		return create.AppendDiagError(diags, names.FinSpace, create.ErrActionUpdating, ResNameKxVolume, d.Id(), err)
 	}
 	if _, err := waitKxVolumeUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
@@ -305,6 +312,11 @@ func resourceKxVolumeDelete(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).FinSpaceClient(ctx)
 
+	// Volumes can't be deleted while clusters are still being detached from them.
+	if _, err := waitKxVolumeDetached(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil && !tfresource.NotFound(err) {
+		return create.AppendDiagError(diags, names.FinSpace, create.ErrActionWaitingForDeletion, ResNameKxVolume, d.Id(), err)
+	}
+
 	log.Printf("[INFO] Deleting FinSpace Kx Volume: %s", d.Id())
 	_, err := conn.DeleteKxVolume(ctx, &finspace.DeleteKxVolumeInput{
 		VolumeName:    aws.String(d.Get(names.AttrName).(string)),
@@ -364,6 +376,23 @@ func waitKxVolumeUpdated(ctx context.Context, conn *finspace.Client, id string,
 	return nil, err
 }
 
+func waitKxVolumeDetached(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{kxVolumeAttachmentStatusDetaching},
+		Target:                    []string{kxVolumeAttachmentStatusDetached},
+		Refresh:                   statusKxVolumeAttachment(ctx, conn, id),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*finspace.GetKxVolumeOutput); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
 func waitKxVolumeDeleted(ctx context.Context, conn *finspace.Client, id string, timeout time.Duration) (*finspace.GetKxVolumeOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.KxVolumeStatusDeleting),
@@ -395,6 +424,34 @@ func statusKxVolume(ctx context.Context, conn *finspace.Client, id string) retry
 	}
 }
 
+const (
+	kxVolumeAttachmentStatusDetached  = "DETACHED"
+	kxVolumeAttachmentStatusDetaching = "DETACHING"
+)
+
+// statusKxVolumeAttachment reports whether any cluster attached to the volume
+// is still being deleted.
+func statusKxVolumeAttachment(ctx context.Context, conn *finspace.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := FindKxVolumeByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range out.AttachedClusters {
+			if v.ClusterStatus == types.KxClusterStatusDeleting {
+				return out, kxVolumeAttachmentStatusDetaching, nil
+			}
+		}
+
+		return out, kxVolumeAttachmentStatusDetached, nil
+	}
+}
+
 func FindKxVolumeByID(ctx context.Context, conn *finspace.Client, id string) (*finspace.GetKxVolumeOutput, error) {
 	parts, err := flex.ExpandResourceId(id, kxVolumeIDPartCount, false)
 	if err != nil {
@@ -0,0 +1,862 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/gamelift"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_gamelift_container_fleet", name="Container Fleet")
+// @Tags(identifierAttribute="arn")
+func ResourceContainerFleet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceContainerFleetCreate,
+		ReadWithoutTimeout:   resourceContainerFleetRead,
+		UpdateWithoutTimeout: resourceContainerFleetUpdate,
+		DeleteWithoutTimeout: resourceContainerFleetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ContainerFleetBillingType](),
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"impairment_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(awstypes.DeploymentImpairmentStrategyMaintain),
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentImpairmentStrategy](),
+						},
+						"minimum_healthy_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      75,
+							ValidateFunc: validation.IntBetween(30, 75),
+						},
+						"protection_strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(awstypes.DeploymentProtectionStrategyWithProtection),
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentProtectionStrategy](),
+						},
+					},
+				},
+			},
+			"deployment_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"latest_deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"fleet_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"game_server_container_group_definition_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"game_server_container_group_definition_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"game_server_container_groups_per_instance": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 5000),
+			},
+			"game_session_creation_limit_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"new_game_sessions_per_creator": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"policy_period_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"instance_connection_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			"instance_inbound_permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"ip_range": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrProtocol: {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.IpProtocol](),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+			names.AttrInstanceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_destination": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.LogDestination](),
+						},
+						"log_group_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrS3BucketName: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"maximum_game_server_container_groups_per_instance": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"metric_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			"new_game_session_protection_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ProtectionPolicy](),
+			},
+			"per_instance_container_group_definition_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"per_instance_container_group_definition_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceContainerFleetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	input := &gamelift.CreateContainerFleetInput{
+		FleetRoleArn: aws.String(d.Get("fleet_role_arn").(string)),
+		Tags:         getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("billing_type"); ok {
+		input.BillingType = awstypes.ContainerFleetBillingType(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_container_group_definition_name"); ok {
+		input.GameServerContainerGroupDefinitionName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_container_groups_per_instance"); ok {
+		input.GameServerContainerGroupsPerInstance = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("game_session_creation_limit_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.GameSessionCreationLimitPolicy = expandGameSessionCreationLimitPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_connection_port_range"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.InstanceConnectionPortRange = expandConnectionPortRange(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("instance_inbound_permission"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceInboundPermissions = expandContainerFleetIPPermissions(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk(names.AttrInstanceType); ok {
+		input.InstanceType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("locations"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range flex.ExpandStringValueSet(v.(*schema.Set)) {
+			input.Locations = append(input.Locations, awstypes.LocationConfiguration{
+				Location: aws.String(v),
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.LogConfiguration = expandLogConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("metric_groups"); ok && len(v.([]interface{})) > 0 {
+		input.MetricGroups = flex.ExpandStringValueList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("new_game_session_protection_policy"); ok {
+		input.NewGameSessionProtectionPolicy = awstypes.ProtectionPolicy(v.(string))
+	}
+
+	if v, ok := d.GetOk("per_instance_container_group_definition_name"); ok {
+		input.PerInstanceContainerGroupDefinitionName = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidRequestException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateContainerFleet(ctx, input)
+	}, "GameLift is not authorized to perform")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating GameLift Container Fleet: %s", err)
+	}
+
+	d.SetId(aws.ToString(outputRaw.(*gamelift.CreateContainerFleetOutput).ContainerFleet.FleetId))
+
+	if _, err := waitContainerFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	fleet, err := FindContainerFleetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Container Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, fleet.FleetArn)
+	d.Set("billing_type", fleet.BillingType)
+	if fleet.CreationTime != nil {
+		d.Set(names.AttrCreationTime, aws.ToTime(fleet.CreationTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreationTime, nil)
+	}
+	if fleet.DeploymentDetails != nil {
+		if err := d.Set("deployment_details", []interface{}{map[string]interface{}{
+			"latest_deployment_id": aws.ToString(fleet.DeploymentDetails.LatestDeploymentId),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_details: %s", err)
+		}
+	} else {
+		d.Set("deployment_details", nil)
+	}
+	d.Set(names.AttrDescription, fleet.Description)
+	d.Set("fleet_role_arn", fleet.FleetRoleArn)
+	// The container group definitions can be referenced by name or by (versioned) ARN.
+	// Keep whichever form is configured so that a fleet running a different version is reported as drift.
+	d.Set("game_server_container_group_definition_arn", fleet.GameServerContainerGroupDefinitionArn)
+	d.Set("game_server_container_group_definition_name", flattenContainerGroupDefinitionReference(d.Get("game_server_container_group_definition_name").(string), fleet.GameServerContainerGroupDefinitionName, fleet.GameServerContainerGroupDefinitionArn))
+	d.Set("game_server_container_groups_per_instance", fleet.GameServerContainerGroupsPerInstance)
+	if err := d.Set("game_session_creation_limit_policy", flattenGameSessionCreationLimitPolicy(fleet.GameSessionCreationLimitPolicy)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting game_session_creation_limit_policy: %s", err)
+	}
+	if err := d.Set("instance_connection_port_range", flattenConnectionPortRange(fleet.InstanceConnectionPortRange)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_connection_port_range: %s", err)
+	}
+	if err := d.Set("instance_inbound_permission", flattenContainerFleetIPPermissions(fleet.InstanceInboundPermissions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance_inbound_permission: %s", err)
+	}
+	d.Set(names.AttrInstanceType, fleet.InstanceType)
+	var locations []string
+	for _, v := range fleet.LocationAttributes {
+		// The fleet's home Region is always included in the location attributes.
+		if location := aws.ToString(v.Location); location != meta.(*conns.AWSClient).Region {
+			locations = append(locations, location)
+		}
+	}
+	d.Set("locations", locations)
+	if err := d.Set("log_configuration", flattenLogConfiguration(fleet.LogConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting log_configuration: %s", err)
+	}
+	d.Set("maximum_game_server_container_groups_per_instance", fleet.MaximumGameServerContainerGroupsPerInstance)
+	d.Set("metric_groups", fleet.MetricGroups)
+	d.Set("new_game_session_protection_policy", fleet.NewGameSessionProtectionPolicy)
+	d.Set("per_instance_container_group_definition_arn", fleet.PerInstanceContainerGroupDefinitionArn)
+	d.Set("per_instance_container_group_definition_name", flattenContainerGroupDefinitionReference(d.Get("per_instance_container_group_definition_name").(string), fleet.PerInstanceContainerGroupDefinitionName, fleet.PerInstanceContainerGroupDefinitionArn))
+	d.Set(names.AttrStatus, fleet.Status)
+
+	return diags
+}
+
+func resourceContainerFleetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "deployment_configuration") {
+		input := &gamelift.UpdateContainerFleetInput{
+			FleetId: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrDescription) {
+			input.Description = aws.String(d.Get(names.AttrDescription).(string))
+		}
+
+		if d.HasChanges("game_server_container_group_definition_name", "per_instance_container_group_definition_name") {
+			if v, ok := d.GetOk("deployment_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.DeploymentConfiguration = expandDeploymentConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("game_server_container_group_definition_name") {
+			input.GameServerContainerGroupDefinitionName = aws.String(d.Get("game_server_container_group_definition_name").(string))
+		}
+
+		if d.HasChange("game_server_container_groups_per_instance") {
+			input.GameServerContainerGroupsPerInstance = aws.Int32(int32(d.Get("game_server_container_groups_per_instance").(int)))
+		}
+
+		if d.HasChange("game_session_creation_limit_policy") {
+			if v, ok := d.GetOk("game_session_creation_limit_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.GameSessionCreationLimitPolicy = expandGameSessionCreationLimitPolicy(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("instance_connection_port_range") {
+			if v, ok := d.GetOk("instance_connection_port_range"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.InstanceConnectionPortRange = expandConnectionPortRange(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("instance_inbound_permission") {
+			o, n := d.GetChange("instance_inbound_permission")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			input.InstanceInboundPermissionAuthorizations = expandContainerFleetIPPermissions(ns.Difference(os).List())
+			input.InstanceInboundPermissionRevocations = expandContainerFleetIPPermissions(os.Difference(ns).List())
+		}
+
+		if d.HasChange("log_configuration") {
+			if v, ok := d.GetOk("log_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.LogConfiguration = expandLogConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
+		if d.HasChange("metric_groups") {
+			input.MetricGroups = flex.ExpandStringValueList(d.Get("metric_groups").([]interface{}))
+		}
+
+		if d.HasChange("new_game_session_protection_policy") {
+			input.NewGameSessionProtectionPolicy = awstypes.ProtectionPolicy(d.Get("new_game_session_protection_policy").(string))
+		}
+
+		if d.HasChange("per_instance_container_group_definition_name") {
+			if v, ok := d.GetOk("per_instance_container_group_definition_name"); ok {
+				input.PerInstanceContainerGroupDefinitionName = aws.String(v.(string))
+			} else {
+				input.RemoveAttributes = []awstypes.ContainerFleetRemoveAttribute{awstypes.ContainerFleetRemoveAttributePerInstanceContainerGroupDefinition}
+			}
+		}
+
+		output, err := conn.UpdateContainerFleet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating GameLift Container Fleet (%s): %s", d.Id(), err)
+		}
+
+		// Changing a container group definition starts a new fleet deployment.
+		if v := output.ContainerFleet.DeploymentDetails; v != nil {
+			if deploymentID := aws.ToString(v.LatestDeploymentId); deploymentID != "" && deploymentID != d.Get("deployment_details.0.latest_deployment_id").(string) {
+				if _, err := waitFleetDeploymentComplete(ctx, conn, d.Id(), deploymentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) deployment (%s): %s", d.Id(), deploymentID, err)
+				}
+			}
+		}
+
+		if _, err := waitContainerFleetActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceContainerFleetRead(ctx, d, meta)...)
+}
+
+func resourceContainerFleetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GameLiftClient(ctx)
+
+	log.Printf("[DEBUG] Deleting GameLift Container Fleet: %s", d.Id())
+	_, err := conn.DeleteContainerFleet(ctx, &gamelift.DeleteContainerFleetInput{
+		FleetId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting GameLift Container Fleet (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitContainerFleetDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for GameLift Container Fleet (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindContainerFleetByID(ctx context.Context, conn *gamelift.Client, id string) (*awstypes.ContainerFleet, error) {
+	input := &gamelift.DescribeContainerFleetInput{
+		FleetId: aws.String(id),
+	}
+
+	output, err := conn.DescribeContainerFleet(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ContainerFleet == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ContainerFleet, nil
+}
+
+func findFleetDeploymentByTwoPartKey(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string) (*awstypes.FleetDeployment, error) {
+	input := &gamelift.DescribeFleetDeploymentInput{
+		DeploymentId: aws.String(deploymentID),
+		FleetId:      aws.String(fleetID),
+	}
+
+	output, err := conn.DescribeFleetDeployment(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.FleetDeployment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FleetDeployment, nil
+}
+
+func statusContainerFleet(ctx context.Context, conn *gamelift.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindContainerFleetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusFleetDeployment(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFleetDeploymentByTwoPartKey(ctx, conn, fleetID, deploymentID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.DeploymentStatus), nil
+	}
+}
+
+func waitContainerFleetActive(ctx context.Context, conn *gamelift.Client, id string, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ContainerFleetStatusPending,
+			awstypes.ContainerFleetStatusCreating,
+			awstypes.ContainerFleetStatusCreated,
+			awstypes.ContainerFleetStatusActivating,
+			awstypes.ContainerFleetStatusUpdating,
+		),
+		Target:  enum.Slice(awstypes.ContainerFleetStatusActive),
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitContainerFleetDeleted(ctx context.Context, conn *gamelift.Client, id string, timeout time.Duration) (*awstypes.ContainerFleet, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ContainerFleetStatusActive, awstypes.ContainerFleetStatusDeleting),
+		Target:  []string{},
+		Refresh: statusContainerFleet(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ContainerFleet); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFleetDeploymentComplete(ctx context.Context, conn *gamelift.Client, fleetID, deploymentID string, timeout time.Duration) (*awstypes.FleetDeployment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.DeploymentStatusPending,
+			awstypes.DeploymentStatusInProgress,
+			awstypes.DeploymentStatusRollbackInProgress,
+		),
+		Target:  enum.Slice(awstypes.DeploymentStatusComplete),
+		Refresh: statusFleetDeployment(ctx, conn, fleetID, deploymentID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.FleetDeployment); ok {
+		switch output.DeploymentStatus {
+		case awstypes.DeploymentStatusRollbackComplete:
+			tfresource.SetLastError(err, errors.New("deployment was rolled back"))
+		case awstypes.DeploymentStatusImpaired:
+			if v := output.DeploymentConfiguration; v != nil {
+				tfresource.SetLastError(err, fmt.Errorf("deployment is impaired, fewer than %d%% of game server processes are healthy", aws.ToInt32(v.MinimumHealthyPercentage)))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenContainerGroupDefinitionReference returns the container group definition reference in the form used in configuration.
+func flattenContainerGroupDefinitionReference(configured string, name, definitionARN *string) string {
+	if definitionARN != nil && arn.IsARN(configured) {
+		return aws.ToString(definitionARN)
+	}
+
+	return aws.ToString(name)
+}
+
+func expandDeploymentConfiguration(tfMap map[string]interface{}) *awstypes.DeploymentConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.DeploymentConfiguration{}
+
+	if v, ok := tfMap["impairment_strategy"].(string); ok && v != "" {
+		apiObject.ImpairmentStrategy = awstypes.DeploymentImpairmentStrategy(v)
+	}
+
+	if v, ok := tfMap["minimum_healthy_percentage"].(int); ok && v != 0 {
+		apiObject.MinimumHealthyPercentage = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["protection_strategy"].(string); ok && v != "" {
+		apiObject.ProtectionStrategy = awstypes.DeploymentProtectionStrategy(v)
+	}
+
+	return apiObject
+}
+
+func expandGameSessionCreationLimitPolicy(tfMap map[string]interface{}) *awstypes.GameSessionCreationLimitPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.GameSessionCreationLimitPolicy{}
+
+	if v, ok := tfMap["new_game_sessions_per_creator"].(int); ok {
+		apiObject.NewGameSessionsPerCreator = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["policy_period_in_minutes"].(int); ok {
+		apiObject.PolicyPeriodInMinutes = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenGameSessionCreationLimitPolicy(apiObject *awstypes.GameSessionCreationLimitPolicy) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"new_game_sessions_per_creator": aws.ToInt32(apiObject.NewGameSessionsPerCreator),
+		"policy_period_in_minutes":      aws.ToInt32(apiObject.PolicyPeriodInMinutes),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandConnectionPortRange(tfMap map[string]interface{}) *awstypes.ConnectionPortRange {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &awstypes.ConnectionPortRange{
+		FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+		ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+	}
+}
+
+func flattenConnectionPortRange(apiObject *awstypes.ConnectionPortRange) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"from_port": aws.ToInt32(apiObject.FromPort),
+		"to_port":   aws.ToInt32(apiObject.ToPort),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func expandContainerFleetIPPermissions(tfList []interface{}) []awstypes.IpPermission {
+	var apiObjects []awstypes.IpPermission
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.IpPermission{
+			FromPort: aws.Int32(int32(tfMap["from_port"].(int))),
+			IpRange:  aws.String(tfMap["ip_range"].(string)),
+			Protocol: awstypes.IpProtocol(tfMap[names.AttrProtocol].(string)),
+			ToPort:   aws.Int32(int32(tfMap["to_port"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerFleetIPPermissions(apiObjects []awstypes.IpPermission) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"from_port":        aws.ToInt32(apiObject.FromPort),
+			"ip_range":         aws.ToString(apiObject.IpRange),
+			names.AttrProtocol: string(apiObject.Protocol),
+			"to_port":          aws.ToInt32(apiObject.ToPort),
+		})
+	}
+
+	return tfList
+}
+
+func expandLogConfiguration(tfMap map[string]interface{}) *awstypes.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.LogConfiguration{}
+
+	if v, ok := tfMap["log_destination"].(string); ok && v != "" {
+		apiObject.LogDestination = awstypes.LogDestination(v)
+	}
+
+	if v, ok := tfMap["log_group_arn"].(string); ok && v != "" {
+		apiObject.LogGroupArn = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrS3BucketName].(string); ok && v != "" {
+		apiObject.S3BucketName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenLogConfiguration(apiObject *awstypes.LogConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_destination":      string(apiObject.LogDestination),
+		"log_group_arn":        aws.ToString(apiObject.LogGroupArn),
+		names.AttrS3BucketName: aws.ToString(apiObject.S3BucketName),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package gamelift_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/gamelift/types"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The container image must contain a game server built with the GameLift server SDK
+// and be stored in an Amazon ECR repository in the same account and Region.
+const envVarContainerImageURI = "GAMELIFT_CONTAINER_IMAGE_URI"
+
+func TestAccGameLiftContainerGroupDefinition_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "container_group_type", string(awstypes.ContainerGroupTypeGameServer)),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.0.container_name", "server"),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.0.image_uri", imageURI),
+					resource.TestCheckResourceAttr(resourceName, "game_server_container_definition.0.port_configuration.0.container_port_range.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "game_server_container_definition.0.resolved_image_digest"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "operating_system", string(awstypes.ContainerOperatingSystemAmazonLinux2023)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ContainerGroupDefinitionStatusReady)),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definition.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "total_memory_limit_mebibytes", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "total_vcpu_limit", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgamelift.ResourceContainerGroupDefinition(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_tags1(rName, imageURI, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerGroupDefinitionConfig_tags2(rName, imageURI, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftContainerGroupDefinition_newVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.ContainerGroupDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_container_group_definition.test"
+	imageURI := acctest.SkipIfEnvVarNotSet(t, envVarContainerImageURI)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, gamelift.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GameLiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckContainerGroupDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerGroupDefinitionConfig_basic(rName, imageURI),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccContainerGroupDefinitionConfig_supportContainer(rName, imageURI),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("version_number")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerGroupDefinitionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definition.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definition.0.container_name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "support_container_definition.0.essential", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "support_container_definition.0.resolved_image_digest"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "add sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "version_number", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerGroupDefinitionExists(ctx context.Context, n string, v *awstypes.ContainerGroupDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		output, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckContainerGroupDefinitionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_gamelift_container_group_definition" {
+				continue
+			}
+
+			_, err := tfgamelift.FindContainerGroupDefinitionByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("GameLift Container Group Definition %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccContainerGroupDefinitionConfig_basic(rName, imageURI string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_range {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+}
+`, rName, imageURI)
+}
+
+func testAccContainerGroupDefinitionConfig_supportContainer(rName, imageURI string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+  version_description          = "add sidecar"
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+
+    port_configuration {
+      container_port_range {
+        from_port = 7777
+        to_port   = 7777
+        protocol  = "UDP"
+      }
+    }
+  }
+
+  support_container_definition {
+    container_name              = "sidecar"
+    essential                   = false
+    image_uri                   = %[2]q
+    memory_hard_limit_mebibytes = 256
+  }
+}
+`, rName, imageURI)
+}
+
+func testAccContainerGroupDefinitionConfig_tags1(rName, imageURI, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, imageURI, tagKey1, tagValue1)
+}
+
+func testAccContainerGroupDefinitionConfig_tags2(rName, imageURI, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_gamelift_container_group_definition" "test" {
+  name                         = %[1]q
+  operating_system             = "AMAZON_LINUX_2023"
+  total_memory_limit_mebibytes = 1024
+  total_vcpu_limit             = 1
+
+  game_server_container_definition {
+    container_name     = "server"
+    image_uri          = %[2]q
+    server_sdk_version = "5.2.0"
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, imageURI, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -8,12 +8,15 @@ import (
 	"fmt"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	gamelift_sdkv2 "github.com/aws/aws-sdk-go-v2/service/gamelift"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
 )
 
 func RegisterSweepers() {
@@ -35,6 +38,19 @@ func RegisterSweepers() {
 		F:    sweepScripts,
 	})
 
+	resource.AddTestSweepers("aws_gamelift_container_fleet", &resource.Sweeper{
+		Name: "aws_gamelift_container_fleet",
+		F:    sweepContainerFleets,
+	})
+
+	resource.AddTestSweepers("aws_gamelift_container_group_definition", &resource.Sweeper{
+		Name: "aws_gamelift_container_group_definition",
+		Dependencies: []string{
+			"aws_gamelift_container_fleet",
+		},
+		F: sweepContainerGroupDefinitions,
+	})
+
 	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
@@ -169,6 +185,88 @@ func sweepScripts(region string) error {
 	return nil
 }
 
+func sweepContainerFleets(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.GameLiftClient(ctx)
+	input := &gamelift_sdkv2.ListContainerFleetsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := gamelift_sdkv2.NewListContainerFleetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GameLift Container Fleet sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("listing GameLift Container Fleets (%s): %w", region, err)
+		}
+
+		for _, v := range page.ContainerFleets {
+			r := ResourceContainerFleet()
+			d := r.Data(nil)
+			d.SetId(aws_sdkv2.ToString(v.FleetId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Container Fleets (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepContainerGroupDefinitions(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	conn := client.GameLiftClient(ctx)
+	input := &gamelift_sdkv2.ListContainerGroupDefinitionsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := gamelift_sdkv2.NewListContainerGroupDefinitionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping GameLift Container Group Definition sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("listing GameLift Container Group Definitions (%s): %w", region, err)
+		}
+
+		for _, v := range page.ContainerGroupDefinitions {
+			r := ResourceContainerGroupDefinition()
+			d := r.Data(nil)
+			d.SetId(aws_sdkv2.ToString(v.Name))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("sweeping GameLift Container Group Definitions (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepFleets(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	customRoutingEndpointTrafficResourceIDPartCount = 2
+	// AllowCustomRoutingTraffic and DenyCustomRoutingTraffic accept at most 100 destination addresses per call.
+	customRoutingTrafficMaxDestinationAddresses = 100
+)
+
+// @SDKResource("aws_globalaccelerator_custom_routing_endpoint_traffic", name="Custom Routing Endpoint Traffic")
+func resourceCustomRoutingEndpointTraffic() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingEndpointTrafficCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingEndpointTrafficRead,
+		UpdateWithoutTimeout: resourceCustomRoutingEndpointTrafficUpdate,
+		DeleteWithoutTimeout: resourceCustomRoutingEndpointTrafficDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_all_traffic_to_endpoint": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"destination_addresses", "destination_ports"},
+				AtLeastOneOf:  []string{"allow_all_traffic_to_endpoint", "destination_addresses"},
+			},
+			"destination_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"destination_ports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+				RequiredWith: []string{"destination_addresses"},
+			},
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointTrafficCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID := d.Get("endpoint_group_arn").(string), d.Get("endpoint_id").(string)
+	id, err := flex.FlattenResourceId([]string{endpointGroupARN, endpointID}, customRoutingEndpointTrafficResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	traffic := expandCustomRoutingEndpointTraffic(d.Get("allow_all_traffic_to_endpoint").(bool), d.Get("destination_addresses").(*schema.Set), d.Get("destination_ports").(*schema.Set))
+
+	if err := allowCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, traffic); err != nil {
+		return sdkdiag.AppendErrorf(diags, "allowing Global Accelerator Custom Routing Endpoint Traffic (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceCustomRoutingEndpointTrafficRead(ctx, d, meta)...)
+}
+
+func resourceCustomRoutingEndpointTrafficRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), customRoutingEndpointTrafficResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	endpointGroupARN, endpointID := parts[0], parts[1]
+	portMappings, err := findCustomRoutingPortMappingsByEndpoint(ctx, conn, endpointGroupARN, endpointID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint Traffic (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+	}
+
+	// Destination address -> destination port -> allowed.
+	destinations := make(map[string]map[int32]bool)
+	allAllowed := len(portMappings) > 0
+	for _, v := range portMappings {
+		if v.DestinationSocketAddress == nil {
+			continue
+		}
+
+		address, port := aws.ToString(v.DestinationSocketAddress.IpAddress), aws.ToInt32(v.DestinationSocketAddress.Port)
+		allowed := v.DestinationTrafficState == awstypes.CustomRoutingDestinationTrafficStateAllow
+
+		if _, ok := destinations[address]; !ok {
+			destinations[address] = make(map[int32]bool)
+		}
+		destinations[address][port] = allowed
+		allAllowed = allAllowed && allowed
+	}
+
+	addresses, ports := d.Get("destination_addresses").(*schema.Set), d.Get("destination_ports").(*schema.Set)
+	allowAll := d.Get("allow_all_traffic_to_endpoint").(bool)
+
+	switch {
+	case allowAll:
+		d.Set("allow_all_traffic_to_endpoint", allAllowed)
+	case addresses.Len() == 0:
+		// Import.
+		if allAllowed {
+			d.Set("allow_all_traffic_to_endpoint", true)
+		} else {
+			var allowedAddresses []string
+
+			for address, ports := range destinations {
+				if allPortsAllowed(ports, nil) {
+					allowedAddresses = append(allowedAddresses, address)
+				}
+			}
+
+			d.Set("allow_all_traffic_to_endpoint", false)
+			d.Set("destination_addresses", allowedAddresses)
+		}
+	default:
+		// Only report the configured destination addresses for which all the configured ports are allowed.
+		var allowedAddresses []string
+
+		for _, address := range flex.ExpandStringValueSet(addresses) {
+			if v, ok := destinations[address]; ok && allPortsAllowed(v, flex.ExpandInt32ValueSet(ports)) {
+				allowedAddresses = append(allowedAddresses, address)
+			}
+		}
+
+		d.Set("destination_addresses", allowedAddresses)
+	}
+	d.Set("endpoint_group_arn", endpointGroupARN)
+	d.Set("endpoint_id", endpointID)
+
+	return diags
+}
+
+func resourceCustomRoutingEndpointTrafficUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID := d.Get("endpoint_group_arn").(string), d.Get("endpoint_id").(string)
+
+	o, n := d.GetChange("allow_all_traffic_to_endpoint")
+	oldAllowAll, newAllowAll := o.(bool), n.(bool)
+	o, n = d.GetChange("destination_addresses")
+	oldAddresses, newAddresses := o.(*schema.Set), n.(*schema.Set)
+	o, n = d.GetChange("destination_ports")
+	oldPorts, newPorts := o.(*schema.Set), n.(*schema.Set)
+
+	oldTraffic := expandCustomRoutingEndpointTraffic(oldAllowAll, oldAddresses, oldPorts)
+	newTraffic := expandCustomRoutingEndpointTraffic(newAllowAll, newAddresses, newPorts)
+
+	// No destination ports means all ports.
+	if oldAllowAll || newAllowAll || (oldPorts.Len() == 0) != (newPorts.Len() == 0) {
+		if err := denyCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, oldTraffic); err != nil {
+			return sdkdiag.AppendErrorf(diags, "denying Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+		}
+
+		if err := allowCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, newTraffic); err != nil {
+			return sdkdiag.AppendErrorf(diags, "allowing Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+		}
+	} else {
+		// Allow the new destinations first to avoid interrupting traffic to unchanged destinations.
+		if err := allowCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, newTraffic); err != nil {
+			return sdkdiag.AppendErrorf(diags, "allowing Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+		}
+
+		// Deny all the old ports for removed destination addresses.
+		if v := oldAddresses.Difference(newAddresses); v.Len() > 0 {
+			if err := denyCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, expandCustomRoutingEndpointTraffic(false, v, oldPorts)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "denying Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+			}
+		}
+
+		// Deny removed ports for retained destination addresses.
+		if v, ports := oldAddresses.Intersection(newAddresses), oldPorts.Difference(newPorts); v.Len() > 0 && ports.Len() > 0 {
+			if err := denyCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, expandCustomRoutingEndpointTraffic(false, v, ports)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "denying Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceCustomRoutingEndpointTrafficRead(ctx, d, meta)...)
+}
+
+func resourceCustomRoutingEndpointTrafficDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	traffic := expandCustomRoutingEndpointTraffic(d.Get("allow_all_traffic_to_endpoint").(bool), d.Get("destination_addresses").(*schema.Set), d.Get("destination_ports").(*schema.Set))
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint Traffic (%s)", d.Id())
+	err := denyCustomRoutingTraffic(ctx, conn, d.Get("endpoint_group_arn").(string), d.Get("endpoint_id").(string), traffic)
+
+	if errs.IsA[*awstypes.EndpointGroupNotFoundException](err) || errs.IsA[*awstypes.EndpointNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// customRoutingEndpointTraffic represents the destinations to which traffic is allowed or denied.
+type customRoutingEndpointTraffic struct {
+	allTraffic bool
+	addresses  []string
+	ports      []int32 // No ports means all ports.
+}
+
+func expandCustomRoutingEndpointTraffic(allTraffic bool, addresses, ports *schema.Set) customRoutingEndpointTraffic {
+	return customRoutingEndpointTraffic{
+		allTraffic: allTraffic,
+		addresses:  flex.ExpandStringValueSet(addresses),
+		ports:      flex.ExpandInt32ValueSet(ports),
+	}
+}
+
+func allowCustomRoutingTraffic(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, traffic customRoutingEndpointTraffic) error {
+	if traffic.allTraffic {
+		_, err := conn.AllowCustomRoutingTraffic(ctx, &globalaccelerator.AllowCustomRoutingTrafficInput{
+			AllowAllTrafficToEndpoint: aws.Bool(true),
+			EndpointGroupArn:          aws.String(endpointGroupARN),
+			EndpointId:                aws.String(endpointID),
+		})
+
+		return err
+	}
+
+	for _, addresses := range tfslices.Chunks(traffic.addresses, customRoutingTrafficMaxDestinationAddresses) {
+		input := &globalaccelerator.AllowCustomRoutingTrafficInput{
+			DestinationAddresses: addresses,
+			EndpointGroupArn:     aws.String(endpointGroupARN),
+			EndpointId:           aws.String(endpointID),
+		}
+		if len(traffic.ports) > 0 {
+			input.DestinationPorts = traffic.ports
+		}
+
+		if _, err := conn.AllowCustomRoutingTraffic(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func denyCustomRoutingTraffic(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, traffic customRoutingEndpointTraffic) error {
+	if traffic.allTraffic {
+		_, err := conn.DenyCustomRoutingTraffic(ctx, &globalaccelerator.DenyCustomRoutingTrafficInput{
+			DenyAllTrafficToEndpoint: aws.Bool(true),
+			EndpointGroupArn:         aws.String(endpointGroupARN),
+			EndpointId:               aws.String(endpointID),
+		})
+
+		return err
+	}
+
+	for _, addresses := range tfslices.Chunks(traffic.addresses, customRoutingTrafficMaxDestinationAddresses) {
+		input := &globalaccelerator.DenyCustomRoutingTrafficInput{
+			DestinationAddresses: addresses,
+			EndpointGroupArn:     aws.String(endpointGroupARN),
+			EndpointId:           aws.String(endpointID),
+		}
+		if len(traffic.ports) > 0 {
+			input.DestinationPorts = traffic.ports
+		}
+
+		if _, err := conn.DenyCustomRoutingTraffic(ctx, input); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findCustomRoutingPortMappingsByEndpoint(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string) ([]awstypes.PortMapping, error) {
+	endpointGroup, err := findCustomRoutingEndpointGroupByARN(ctx, conn, endpointGroupARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !tfslices.Any(endpointGroup.EndpointDescriptions, func(v awstypes.CustomRoutingEndpointDescription) bool {
+		return aws.ToString(v.EndpointId) == endpointID
+	}) {
+		return nil, tfresource.NewEmptyResultError(fmt.Sprintf("endpoint %s", endpointID))
+	}
+
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	portMappings, err := findCustomRoutingPortMappings(ctx, conn, &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn:   aws.String(acceleratorARN),
+		EndpointGroupArn: aws.String(endpointGroupARN),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(portMappings, func(v awstypes.PortMapping) bool {
+		return aws.ToString(v.EndpointId) == endpointID
+	}), nil
+}
+
+// allPortsAllowed returns whether traffic to all the specified ports is allowed.
+// No specified ports means all ports.
+func allPortsAllowed(destinationPorts map[int32]bool, ports []int32) bool {
+	if len(ports) == 0 {
+		for _, allowed := range destinationPorts {
+			if !allowed {
+				return false
+			}
+		}
+
+		return len(destinationPorts) > 0
+	}
+
+	for _, port := range ports {
+		if !destinationPorts[port] {
+			return false
+		}
+	}
+
+	return true
+}
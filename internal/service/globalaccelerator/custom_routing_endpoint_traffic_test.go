@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.4", 8080),
+					testAccCheckCustomRoutingEndpointTrafficDenied(ctx, resourceName, "10.0.0.5", 8080),
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.4"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(rName, `"10.0.0.4", "10.0.0.5"`, `8080, 8081`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.4", 8081),
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.5", 8081),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(rName, `"10.0.0.4", "10.0.0.6"`, `8080`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.4", 8080),
+					testAccCheckCustomRoutingEndpointTrafficDenied(ctx, resourceName, "10.0.0.4", 8081),
+					testAccCheckCustomRoutingEndpointTrafficDenied(ctx, resourceName, "10.0.0.5", 8080),
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.6", 8080),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.4"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.6"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_allowAll(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficAllowed(ctx, resourceName, "10.0.0.5", 8081),
+					resource.TestCheckResourceAttr(resourceName, "allow_all_traffic_to_endpoint", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoutingEndpointTrafficState(ctx context.Context, n, address string, port int32, want awstypes.CustomRoutingDestinationTrafficState) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		output, err := tfglobalaccelerator.FindCustomRoutingPortMappingsByEndpoint(ctx, conn, rs.Primary.Attributes["endpoint_group_arn"], rs.Primary.Attributes["endpoint_id"])
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if v.DestinationSocketAddress == nil || *v.DestinationSocketAddress.IpAddress != address || *v.DestinationSocketAddress.Port != port {
+				continue
+			}
+
+			if got := v.DestinationTrafficState; got != want {
+				return fmt.Errorf("Global Accelerator Custom Routing Endpoint Traffic (%s) to %s:%d is %s, want %s", rs.Primary.ID, address, port, got, want)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("Global Accelerator Custom Routing Endpoint Traffic (%s) port mapping for %s:%d not found", rs.Primary.ID, address, port)
+	}
+}
+
+func testAccCheckCustomRoutingEndpointTrafficAllowed(ctx context.Context, n, address string, port int32) resource.TestCheckFunc {
+	return testAccCheckCustomRoutingEndpointTrafficState(ctx, n, address, port, awstypes.CustomRoutingDestinationTrafficStateAllow)
+}
+
+func testAccCheckCustomRoutingEndpointTrafficDenied(ctx context.Context, n, address string, port int32) resource.TestCheckFunc {
+	return testAccCheckCustomRoutingEndpointTrafficState(ctx, n, address, port, awstypes.CustomRoutingDestinationTrafficStateDeny)
+}
+
+func testAccCheckCustomRoutingEndpointTrafficDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_traffic" {
+				continue
+			}
+
+			output, err := tfglobalaccelerator.FindCustomRoutingPortMappingsByEndpoint(ctx, conn, rs.Primary.Attributes["endpoint_group_arn"], rs.Primary.Attributes["endpoint_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if v.DestinationTrafficState == awstypes.CustomRoutingDestinationTrafficStateAllow {
+					return fmt.Errorf("Global Accelerator Custom Routing Endpoint Traffic %s still exists", rs.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfiguration(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn    = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id           = aws_subnet.test.id
+  destination_addresses = ["10.0.0.4"]
+}
+`)
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_destinations(rName, addresses, ports string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfiguration(rName), fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn    = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id           = aws_subnet.test.id
+  destination_addresses = [%[1]s]
+  destination_ports     = [%[2]s]
+}
+`, addresses, ports))
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_allowAll(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointGroupConfig_endpointConfiguration(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn            = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id                   = aws_subnet.test.id
+  allow_all_traffic_to_endpoint = true
+}
+`)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Custom Routing Port Mappings")
+func newCustomRoutingPortMappingsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &customRoutingPortMappingsDataSource{}, nil
+}
+
+type customRoutingPortMappingsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*customRoutingPortMappingsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_globalaccelerator_custom_routing_port_mappings"
+}
+
+func (d *customRoutingPortMappingsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"accelerator_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"endpoint_group_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"endpoint_id": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"port_mappings": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[portMappingModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[portMappingModel](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *customRoutingPortMappingsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data customRoutingPortMappingsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().GlobalAcceleratorClient(ctx)
+
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn: fwflex.StringFromFramework(ctx, data.AcceleratorARN),
+	}
+	if !data.EndpointGroupARN.IsNull() {
+		input.EndpointGroupArn = fwflex.StringFromFramework(ctx, data.EndpointGroupARN)
+	}
+
+	portMappings, err := findCustomRoutingPortMappings(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Global Accelerator Custom Routing Accelerator (%s) port mappings", data.AcceleratorARN.ValueString()), err.Error())
+
+		return
+	}
+
+	// Filter by destination subnet.
+	if endpointID := data.EndpointID.ValueString(); endpointID != "" {
+		var filtered []awstypes.PortMapping
+
+		for _, v := range portMappings {
+			if aws.ToString(v.EndpointId) == endpointID {
+				filtered = append(filtered, v)
+			}
+		}
+
+		portMappings = filtered
+	}
+
+	output := &globalaccelerator.ListCustomRoutingPortMappingsOutput{
+		PortMappings: portMappings,
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, data.AcceleratorARN.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findCustomRoutingPortMappings(ctx context.Context, conn *globalaccelerator.Client, input *globalaccelerator.ListCustomRoutingPortMappingsInput) ([]awstypes.PortMapping, error) {
+	var output []awstypes.PortMapping
+
+	pages := globalaccelerator.NewListCustomRoutingPortMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.PortMappings...)
+	}
+
+	return output, nil
+}
+
+type customRoutingPortMappingsDataSourceModel struct {
+	AcceleratorARN   fwtypes.ARN                                       `tfsdk:"accelerator_arn"`
+	EndpointGroupARN fwtypes.ARN                                       `tfsdk:"endpoint_group_arn"`
+	EndpointID       types.String                                      `tfsdk:"endpoint_id"`
+	ID               types.String                                      `tfsdk:"id"`
+	PortMappings     fwtypes.ListNestedObjectValueOf[portMappingModel] `tfsdk:"port_mappings"`
+}
+
+type portMappingModel struct {
+	AcceleratorPort          types.Int64                                                       `tfsdk:"accelerator_port"`
+	DestinationSocketAddress fwtypes.ListNestedObjectValueOf[socketAddressModel]               `tfsdk:"destination_socket_address"`
+	DestinationTrafficState  fwtypes.StringEnum[awstypes.CustomRoutingDestinationTrafficState] `tfsdk:"destination_traffic_state"`
+	EndpointGroupARN         types.String                                                      `tfsdk:"endpoint_group_arn"`
+	EndpointID               types.String                                                      `tfsdk:"endpoint_id"`
+	Protocols                fwtypes.ListValueOf[types.String]                                 `tfsdk:"protocols"`
+}
+
+type socketAddressModel struct {
+	IPAddress types.String `tfsdk:"ip_address"`
+	Port      types.Int64  `tfsdk:"port"`
+}
@@ -5,21 +5,23 @@ package globalaccelerator
 
 // Exports for use in tests only.
 var (
-	ResourceAccelerator                = resourceAccelerator
-	ResourceCrossAccountAttachment     = newCrossAccountAttachmentResource
-	ResourceCustomRoutingAccelerator   = resourceCustomRoutingAccelerator
-	ResourceCustomRoutingEndpointGroup = resourceCustomRoutingEndpointGroup
-	ResourceCustomRoutingListener      = resourceCustomRoutingListener
-	ResourceEndpointGroup              = resourceEndpointGroup
-	ResourceListener                   = resourceListener
+	ResourceAccelerator                  = resourceAccelerator
+	ResourceCrossAccountAttachment       = newCrossAccountAttachmentResource
+	ResourceCustomRoutingAccelerator     = resourceCustomRoutingAccelerator
+	ResourceCustomRoutingEndpointGroup   = resourceCustomRoutingEndpointGroup
+	ResourceCustomRoutingEndpointTraffic = resourceCustomRoutingEndpointTraffic
+	ResourceCustomRoutingListener        = resourceCustomRoutingListener
+	ResourceEndpointGroup                = resourceEndpointGroup
+	ResourceListener                     = resourceListener
 
-	FindAcceleratorByARN                = findAcceleratorByARN
-	FindCrossAccountAttachmentByARN     = findCrossAccountAttachmentByARN
-	FindCustomRoutingAcceleratorByARN   = findCustomRoutingAcceleratorByARN
-	FindCustomRoutingEndpointGroupByARN = findCustomRoutingEndpointGroupByARN
-	FindCustomRoutingListenerByARN      = findCustomRoutingListenerByARN
-	FindEndpointGroupByARN              = findEndpointGroupByARN
-	FindListenerByARN                   = findListenerByARN
+	FindAcceleratorByARN                    = findAcceleratorByARN
+	FindCrossAccountAttachmentByARN         = findCrossAccountAttachmentByARN
+	FindCustomRoutingAcceleratorByARN       = findCustomRoutingAcceleratorByARN
+	FindCustomRoutingEndpointGroupByARN     = findCustomRoutingEndpointGroupByARN
+	FindCustomRoutingListenerByARN          = findCustomRoutingListenerByARN
+	FindCustomRoutingPortMappingsByEndpoint = findCustomRoutingPortMappingsByEndpoint
+	FindEndpointGroupByARN                  = findEndpointGroupByARN
+	FindListenerByARN                       = findListenerByARN
 
 	ListenerOrEndpointGroupARNToAcceleratorARN = listenerOrEndpointGroupARNToAcceleratorARN
 	EndpointGroupARNToListenerARN              = endpointGroupARNToListenerARN
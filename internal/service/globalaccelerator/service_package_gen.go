@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newAcceleratorDataSource,
 			Name:    "Accelerator",
 		},
+		{
+			Factory: newCustomRoutingPortMappingsDataSource,
+			Name:    "Custom Routing Port Mappings",
+		},
 	}
 }
 
@@ -66,6 +70,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_globalaccelerator_custom_routing_endpoint_group",
 			Name:     "Custom Routing Endpoint Group",
 		},
+		{
+			Factory:  resourceCustomRoutingEndpointTraffic,
+			TypeName: "aws_globalaccelerator_custom_routing_endpoint_traffic",
+			Name:     "Custom Routing Endpoint Traffic",
+		},
 		{
 			Factory:  resourceCustomRoutingListener,
 			TypeName: "aws_globalaccelerator_custom_routing_listener",
@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package greengrassv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/greengrassv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/greengrassv2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_greengrassv2_component_version", name="Component Version")
+// @Tags(identifierAttribute="arn")
+func resourceComponentVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentVersionCreate,
+		ReadWithoutTimeout:   resourceComponentVersionRead,
+		UpdateWithoutTimeout: resourceComponentVersionUpdate,
+		DeleteWithoutTimeout: resourceComponentVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"component_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inline_recipe": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     verify.ValidStringIsJSONOrYAML,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONOrYAMLDiffs,
+				StateFunc: func(v interface{}) string {
+					template, _ := verify.NormalizeJSONOrYAMLString(v)
+					return template
+				},
+			},
+			"publisher": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"component_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"errors": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceComponentVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	input := &greengrassv2.CreateComponentVersionInput{
+		InlineRecipe: []byte(d.Get("inline_recipe").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateComponentVersion(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Greengrass V2 Component Version: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	if _, err := waitComponentVersionDeployable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Greengrass V2 Component Version (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentVersionRead(ctx, d, meta)...)
+}
+
+func resourceComponentVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	output, err := findComponentVersionByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Greengrass V2 Component Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.Arn)
+	d.Set("component_name", output.ComponentName)
+	d.Set("component_version", output.ComponentVersion)
+	if output.CreationTimestamp != nil {
+		d.Set("creation_timestamp", aws.ToTime(output.CreationTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("creation_timestamp", nil)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("publisher", output.Publisher)
+	if err := d.Set(names.AttrStatus, flattenCloudComponentStatus(output.Status)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting status: %s", err)
+	}
+
+	// Component versions are immutable, so the recipe is only read back on import.
+	if _, ok := d.GetOk("inline_recipe"); !ok {
+		recipe, err := findComponentRecipeByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Greengrass V2 Component Version (%s) recipe: %s", d.Id(), err)
+		}
+
+		d.Set("inline_recipe", recipe)
+	}
+
+	return diags
+}
+
+func resourceComponentVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceComponentVersionRead(ctx, d, meta)
+}
+
+func resourceComponentVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GreengrassV2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting Greengrass V2 Component Version: %s", d.Id())
+	_, err := conn.DeleteComponent(ctx, &greengrassv2.DeleteComponentInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Greengrass V2 Component Version (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findComponentVersionByARN(ctx context.Context, conn *greengrassv2.Client, arn string) (*greengrassv2.DescribeComponentOutput, error) {
+	input := &greengrassv2.DescribeComponentInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Status == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findComponentRecipeByARN(ctx context.Context, conn *greengrassv2.Client, arn string) (string, error) {
+	input := &greengrassv2.GetComponentInput{
+		Arn:                aws.String(arn),
+		RecipeOutputFormat: awstypes.RecipeOutputFormatJson,
+	}
+
+	output, err := conn.GetComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || len(output.Recipe) == 0 {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return verify.NormalizeJSONOrYAMLString(string(output.Recipe))
+}
+
+func statusComponentVersion(ctx context.Context, conn *greengrassv2.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findComponentVersionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status.ComponentState), nil
+	}
+}
+
+func waitComponentVersionDeployable(ctx context.Context, conn *greengrassv2.Client, arn string, timeout time.Duration) (*greengrassv2.DescribeComponentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CloudComponentStateRequested, awstypes.CloudComponentStateInitiated),
+		Target:  enum.Slice(awstypes.CloudComponentStateDeployable),
+		Refresh: statusComponentVersion(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*greengrassv2.DescribeComponentOutput); ok {
+		if status := output.Status; status.ComponentState == awstypes.CloudComponentStateFailed {
+			tfresource.SetLastError(err, componentStatusError(status))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func componentStatusError(apiObject *awstypes.CloudComponentStatus) error {
+	var errList []error
+
+	if v := aws.ToString(apiObject.Message); v != "" {
+		errList = append(errList, errors.New(v))
+	}
+
+	for k, v := range apiObject.Errors {
+		errList = append(errList, fmt.Errorf("%s: %s", k, v))
+	}
+
+	return errors.Join(errList...)
+}
+
+func flattenCloudComponentStatus(apiObject *awstypes.CloudComponentStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"component_state": string(apiObject.ComponentState),
+		"errors":          apiObject.Errors,
+		names.AttrMessage: aws.ToString(apiObject.Message),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,825 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	configResourceIDPartCount = 2
+)
+
+var (
+	configDataTypes = map[string]awstypes.ConfigCapabilityType{
+		"antenna_downlink_config":              awstypes.ConfigCapabilityTypeAntennaDownlink,
+		"antenna_downlink_demod_decode_config": awstypes.ConfigCapabilityTypeAntennaDownlinkDemodDecode,
+		"antenna_uplink_config":                awstypes.ConfigCapabilityTypeAntennaUplink,
+		"dataflow_endpoint_config":             awstypes.ConfigCapabilityTypeDataflowEndpoint,
+		"s3_recording_config":                  awstypes.ConfigCapabilityTypeS3Recording,
+		"tracking_config":                      awstypes.ConfigCapabilityTypeTracking,
+		"uplink_echo_config":                   awstypes.ConfigCapabilityTypeUplinkEcho,
+	}
+)
+
+// @SDKResource("aws_groundstation_config", name="Config")
+// @Tags(identifierAttribute="arn")
+func resourceConfig() *schema.Resource {
+	frequencySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"units": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.FrequencyUnits](),
+					},
+					names.AttrValue: {
+						Type:     schema.TypeFloat,
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+	spectrumConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"bandwidth": {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"units": {
+									Type:             schema.TypeString,
+									Required:         true,
+									ValidateDiagFunc: enum.Validate[awstypes.BandwidthUnits](),
+								},
+								names.AttrValue: {
+									Type:     schema.TypeFloat,
+									Required: true,
+								},
+							},
+						},
+					},
+					"center_frequency": frequencySchema(),
+					"polarization": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Computed:         true,
+						ValidateDiagFunc: enum.Validate[awstypes.Polarization](),
+					},
+				},
+			},
+		}
+	}
+	unvalidatedJSONSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"unvalidated_json": {
+						Type:                  schema.TypeString,
+						Required:              true,
+						ValidateFunc:          validation.StringIsJSON,
+						DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+						DiffSuppressOnRefresh: true,
+						StateFunc: func(v interface{}) string {
+							json, _ := structure.NormalizeJsonString(v)
+							return json
+						},
+					},
+				},
+			},
+		}
+	}
+	configDataExactlyOneOf := []string{
+		"config_data.0.antenna_downlink_config",
+		"config_data.0.antenna_downlink_demod_decode_config",
+		"config_data.0.antenna_uplink_config",
+		"config_data.0.dataflow_endpoint_config",
+		"config_data.0.s3_recording_config",
+		"config_data.0.tracking_config",
+		"config_data.0.uplink_echo_config",
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceConfigCreate,
+		ReadWithoutTimeout:   resourceConfigRead,
+		UpdateWithoutTimeout: resourceConfigUpdate,
+		DeleteWithoutTimeout: resourceConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ForceNewIfChange("config_data", func(_ context.Context, old, new, meta interface{}) bool {
+				// The configuration type cannot be changed in-place.
+				return configDataType(old.([]interface{})) != configDataType(new.([]interface{}))
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_data": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"antenna_downlink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"decode_config":       unvalidatedJSONSchema(),
+									"demodulation_config": unvalidatedJSONSchema(),
+									"spectrum_config":     spectrumConfigSchema(),
+								},
+							},
+						},
+						"antenna_uplink_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"spectrum_config": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"center_frequency": frequencySchema(),
+												"polarization": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													Computed:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.Polarization](),
+												},
+											},
+										},
+									},
+									"target_eirp": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"units": {
+													Type:             schema.TypeString,
+													Required:         true,
+													ValidateDiagFunc: enum.Validate[awstypes.EirpUnits](),
+												},
+												names.AttrValue: {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+											},
+										},
+									},
+									"transmit_disabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"dataflow_endpoint_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dataflow_endpoint_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"dataflow_endpoint_region": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"s3_recording_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrPrefix: {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"tracking_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"autotrack": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.Criticality](),
+									},
+								},
+							},
+						},
+						"uplink_echo_config": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: configDataExactlyOneOf,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"antenna_uplink_config_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrEnabled: {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"config_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^[ a-zA-Z0-9_:-]+$`), ""),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &groundstation.CreateConfigInput{
+		ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateConfig(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Config (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{aws.ToString(output.ConfigId), string(output.ConfigType)}, configResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceConfigRead(ctx, d, meta)...)
+}
+
+func resourceConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	configID, configType := parts[0], parts[1]
+	output, err := findConfigByTwoPartKey(ctx, conn, configID, configType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.ConfigArn)
+	if err := d.Set("config_data", flattenConfigTypeData(output.ConfigData)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting config_data: %s", err)
+	}
+	d.Set("config_id", output.ConfigId)
+	d.Set("config_type", output.ConfigType)
+	d.Set(names.AttrName, output.Name)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: expandConfigTypeData(d.Get("config_data").([]interface{})),
+			ConfigId:   aws.String(parts[0]),
+			ConfigType: awstypes.ConfigCapabilityType(parts[1]),
+			Name:       aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err = conn.UpdateConfig(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Ground Station Config (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceConfigRead(ctx, d, meta)...)
+}
+
+func resourceConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), configResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Ground Station Config: %s", d.Id())
+	_, err = conn.DeleteConfig(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(parts[0]),
+		ConfigType: awstypes.ConfigCapabilityType(parts[1]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Config (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, configID, configType string) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: awstypes.ConfigCapabilityType(configType),
+	}
+
+	output, err := conn.GetConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfigData == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// configDataType returns the configuration type of the specified config_data.
+func configDataType(tfList []interface{}) awstypes.ConfigCapabilityType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for k, v := range configDataTypes {
+		if v2, ok := tfMap[k].([]interface{}); ok && len(v2) > 0 {
+			return v
+		}
+	}
+
+	return ""
+}
+
+func expandConfigTypeData(tfList []interface{}) awstypes.ConfigTypeData {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["antenna_downlink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.ConfigTypeDataMemberAntennaDownlinkConfig{
+			Value: awstypes.AntennaDownlinkConfig{
+				SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["antenna_downlink_demod_decode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig{
+			Value: awstypes.AntennaDownlinkDemodDecodeConfig{
+				DecodeConfig: &awstypes.DecodeConfig{
+					UnvalidatedJSON: expandUnvalidatedJSON(tfMap["decode_config"].([]interface{})),
+				},
+				DemodulationConfig: &awstypes.DemodulationConfig{
+					UnvalidatedJSON: expandUnvalidatedJSON(tfMap["demodulation_config"].([]interface{})),
+				},
+				SpectrumConfig: expandSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+			},
+		}
+	}
+
+	if v, ok := tfMap["antenna_uplink_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject := awstypes.AntennaUplinkConfig{
+			SpectrumConfig:   expandUplinkSpectrumConfig(tfMap["spectrum_config"].([]interface{})),
+			TargetEirp:       expandEirp(tfMap["target_eirp"].([]interface{})),
+			TransmitDisabled: aws.Bool(tfMap["transmit_disabled"].(bool)),
+		}
+
+		return &awstypes.ConfigTypeDataMemberAntennaUplinkConfig{
+			Value: apiObject,
+		}
+	}
+
+	if v, ok := tfMap["dataflow_endpoint_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject := awstypes.DataflowEndpointConfig{
+			DataflowEndpointName: aws.String(tfMap["dataflow_endpoint_name"].(string)),
+		}
+
+		if v, ok := tfMap["dataflow_endpoint_region"].(string); ok && v != "" {
+			apiObject.DataflowEndpointRegion = aws.String(v)
+		}
+
+		return &awstypes.ConfigTypeDataMemberDataflowEndpointConfig{
+			Value: apiObject,
+		}
+	}
+
+	if v, ok := tfMap["s3_recording_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject := awstypes.S3RecordingConfig{
+			BucketArn: aws.String(tfMap["bucket_arn"].(string)),
+			RoleArn:   aws.String(tfMap[names.AttrRoleARN].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrPrefix].(string); ok && v != "" {
+			apiObject.Prefix = aws.String(v)
+		}
+
+		return &awstypes.ConfigTypeDataMemberS3RecordingConfig{
+			Value: apiObject,
+		}
+	}
+
+	if v, ok := tfMap["tracking_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.ConfigTypeDataMemberTrackingConfig{
+			Value: awstypes.TrackingConfig{
+				Autotrack: awstypes.Criticality(tfMap["autotrack"].(string)),
+			},
+		}
+	}
+
+	if v, ok := tfMap["uplink_echo_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		return &awstypes.ConfigTypeDataMemberUplinkEchoConfig{
+			Value: awstypes.UplinkEchoConfig{
+				AntennaUplinkConfigArn: aws.String(tfMap["antenna_uplink_config_arn"].(string)),
+				Enabled:                aws.Bool(tfMap[names.AttrEnabled].(bool)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandSpectrumConfig(tfList []interface{}) *awstypes.SpectrumConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.SpectrumConfig{
+		Bandwidth:       expandFrequencyBandwidth(tfMap["bandwidth"].([]interface{})),
+		CenterFrequency: expandFrequency(tfMap["center_frequency"].([]interface{})),
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = awstypes.Polarization(v)
+	}
+
+	return apiObject
+}
+
+func expandUplinkSpectrumConfig(tfList []interface{}) *awstypes.UplinkSpectrumConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &awstypes.UplinkSpectrumConfig{
+		CenterFrequency: expandFrequency(tfMap["center_frequency"].([]interface{})),
+	}
+
+	if v, ok := tfMap["polarization"].(string); ok && v != "" {
+		apiObject.Polarization = awstypes.Polarization(v)
+	}
+
+	return apiObject
+}
+
+func expandFrequency(tfList []interface{}) *awstypes.Frequency {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.Frequency{
+		Units: awstypes.FrequencyUnits(tfMap["units"].(string)),
+		Value: aws.Float64(tfMap[names.AttrValue].(float64)),
+	}
+}
+
+func expandFrequencyBandwidth(tfList []interface{}) *awstypes.FrequencyBandwidth {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.FrequencyBandwidth{
+		Units: awstypes.BandwidthUnits(tfMap["units"].(string)),
+		Value: aws.Float64(tfMap[names.AttrValue].(float64)),
+	}
+}
+
+func expandEirp(tfList []interface{}) *awstypes.Eirp {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.Eirp{
+		Units: awstypes.EirpUnits(tfMap["units"].(string)),
+		Value: aws.Float64(tfMap[names.AttrValue].(float64)),
+	}
+}
+
+func expandUnvalidatedJSON(tfList []interface{}) *string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return aws.String(tfMap["unvalidated_json"].(string))
+}
+
+func flattenConfigTypeData(apiObject awstypes.ConfigTypeData) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkConfig:
+		tfMap["antenna_downlink_config"] = []interface{}{map[string]interface{}{
+			"spectrum_config": flattenSpectrumConfig(v.Value.SpectrumConfig),
+		}}
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig:
+		tfMap["antenna_downlink_demod_decode_config"] = []interface{}{map[string]interface{}{
+			"decode_config":       flattenDecodeConfig(v.Value.DecodeConfig),
+			"demodulation_config": flattenDemodulationConfig(v.Value.DemodulationConfig),
+			"spectrum_config":     flattenSpectrumConfig(v.Value.SpectrumConfig),
+		}}
+	case *awstypes.ConfigTypeDataMemberAntennaUplinkConfig:
+		tfMap["antenna_uplink_config"] = []interface{}{map[string]interface{}{
+			"spectrum_config":   flattenUplinkSpectrumConfig(v.Value.SpectrumConfig),
+			"target_eirp":       flattenEirp(v.Value.TargetEirp),
+			"transmit_disabled": aws.ToBool(v.Value.TransmitDisabled),
+		}}
+	case *awstypes.ConfigTypeDataMemberDataflowEndpointConfig:
+		tfMap["dataflow_endpoint_config"] = []interface{}{map[string]interface{}{
+			"dataflow_endpoint_name":   aws.ToString(v.Value.DataflowEndpointName),
+			"dataflow_endpoint_region": aws.ToString(v.Value.DataflowEndpointRegion),
+		}}
+	case *awstypes.ConfigTypeDataMemberS3RecordingConfig:
+		tfMap["s3_recording_config"] = []interface{}{map[string]interface{}{
+			"bucket_arn":      aws.ToString(v.Value.BucketArn),
+			names.AttrPrefix:  aws.ToString(v.Value.Prefix),
+			names.AttrRoleARN: aws.ToString(v.Value.RoleArn),
+		}}
+	case *awstypes.ConfigTypeDataMemberTrackingConfig:
+		tfMap["tracking_config"] = []interface{}{map[string]interface{}{
+			"autotrack": v.Value.Autotrack,
+		}}
+	case *awstypes.ConfigTypeDataMemberUplinkEchoConfig:
+		tfMap["uplink_echo_config"] = []interface{}{map[string]interface{}{
+			"antenna_uplink_config_arn": aws.ToString(v.Value.AntennaUplinkConfigArn),
+			names.AttrEnabled:           aws.ToBool(v.Value.Enabled),
+		}}
+	default:
+		log.Printf("[WARN] Unsupported Ground Station Config type: %T", v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSpectrumConfig(apiObject *awstypes.SpectrumConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"bandwidth":        flattenFrequencyBandwidth(apiObject.Bandwidth),
+		"center_frequency": flattenFrequency(apiObject.CenterFrequency),
+		"polarization":     apiObject.Polarization,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUplinkSpectrumConfig(apiObject *awstypes.UplinkSpectrumConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"center_frequency": flattenFrequency(apiObject.CenterFrequency),
+		"polarization":     apiObject.Polarization,
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrequency(apiObject *awstypes.Frequency) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"units":         apiObject.Units,
+		names.AttrValue: aws.ToFloat64(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrequencyBandwidth(apiObject *awstypes.FrequencyBandwidth) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"units":         apiObject.Units,
+		names.AttrValue: aws.ToFloat64(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEirp(apiObject *awstypes.Eirp) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"units":         apiObject.Units,
+		names.AttrValue: aws.ToFloat64(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDecodeConfig(apiObject *awstypes.DecodeConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"unvalidated_json": aws.ToString(apiObject.UnvalidatedJSON),
+	}}
+}
+
+func flattenDemodulationConfig(apiObject *awstypes.DemodulationConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"unvalidated_json": aws.ToString(apiObject.UnvalidatedJSON),
+	}}
+}
@@ -0,0 +1,297 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationConfig_tracking(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`config/tracking/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "config_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", "PREFERRED"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", "tracking"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tracking(rName, "REQUIRED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.tracking_config.0.autotrack", "REQUIRED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tracking(rName, "PREFERRED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_antennaDownlink(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_antennaDownlink(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.units", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.bandwidth.0.value", "30"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.units", "MHz"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.center_frequency.0.value", "7812"),
+					resource.TestCheckResourceAttr(resourceName, "config_data.0.antenna_downlink_config.0.spectrum_config.0.polarization", "RIGHT_HAND"),
+					resource.TestCheckResourceAttr(resourceName, "config_type", "antenna-downlink"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationConfig_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfigConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccConfigConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+	_, err := conn.ListConfigs(ctx, &groundstation.ListConfigsInput{})
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckConfigExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		parts := strings.Split(rs.Primary.ID, ",")
+		_, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		return err
+	}
+}
+
+func testAccCheckConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_config" {
+				continue
+			}
+
+			parts := strings.Split(rs.Primary.ID, ",")
+			_, err := tfgroundstation.FindConfigByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccConfigConfig_tracking(rName, autotrack string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = %[2]q
+    }
+  }
+}
+`, rName, autotrack)
+}
+
+func testAccConfigConfig_antennaDownlink(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccConfigConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "test" {
+  name = %[1]q
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_groundstation_dataflow_endpoint_group", name="Dataflow Endpoint Group")
+// @Tags(identifierAttribute="arn")
+func resourceDataflowEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDataflowEndpointGroupCreate,
+		ReadWithoutTimeout:   resourceDataflowEndpointGroupRead,
+		UpdateWithoutTimeout: resourceDataflowEndpointGroupUpdate,
+		DeleteWithoutTimeout: resourceDataflowEndpointGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_post_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(120, 480),
+			},
+			"contact_pre_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(120, 480),
+			},
+			"endpoint_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 500,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEndpoint: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAddress: {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												names.AttrPort: {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"mtu": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1400, 1500),
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"security_details": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrSecurityGroupIDs: {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrSubnetIDs: {
+										Type:     schema.TypeSet,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceDataflowEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	input := &groundstation.CreateDataflowEndpointGroupInput{
+		EndpointDetails: expandEndpointDetails(d.Get("endpoint_details").([]interface{})),
+		Tags:            getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+		input.ContactPostPassDurationSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+		input.ContactPrePassDurationSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.CreateDataflowEndpointGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Dataflow Endpoint Group: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.DataflowEndpointGroupId))
+
+	return append(diags, resourceDataflowEndpointGroupRead(ctx, d, meta)...)
+}
+
+func resourceDataflowEndpointGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	output, err := findDataflowEndpointGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Dataflow Endpoint Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.DataflowEndpointGroupArn)
+	d.Set("contact_post_pass_duration_seconds", output.ContactPostPassDurationSeconds)
+	d.Set("contact_pre_pass_duration_seconds", output.ContactPrePassDurationSeconds)
+	if err := d.Set("endpoint_details", flattenEndpointDetails(output.EndpointsDetails)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint_details: %s", err)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceDataflowEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceDataflowEndpointGroupRead(ctx, d, meta)...)
+}
+
+func resourceDataflowEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	log.Printf("[INFO] Deleting Ground Station Dataflow Endpoint Group: %s", d.Id())
+	_, err := conn.DeleteDataflowEndpointGroup(ctx, &groundstation.DeleteDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Dataflow Endpoint Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDataflowEndpointGroupByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetDataflowEndpointGroupOutput, error) {
+	input := &groundstation.GetDataflowEndpointGroupInput{
+		DataflowEndpointGroupId: aws.String(id),
+	}
+
+	output, err := conn.GetDataflowEndpointGroup(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandEndpointDetails(tfList []interface{}) []awstypes.EndpointDetails {
+	apiObjects := make([]awstypes.EndpointDetails, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.EndpointDetails{}
+
+		if v, ok := tfMap[names.AttrEndpoint].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Endpoint = expandDataflowEndpoint(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["security_details"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SecurityDetails = expandSecurityDetails(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandDataflowEndpoint(tfMap map[string]interface{}) *awstypes.DataflowEndpoint {
+	apiObject := &awstypes.DataflowEndpoint{
+		Name: aws.String(tfMap[names.AttrName].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrAddress].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		apiObject.Address = &awstypes.SocketAddress{
+			Name: aws.String(tfMap[names.AttrName].(string)),
+			Port: aws.Int32(int32(tfMap[names.AttrPort].(int))),
+		}
+	}
+
+	if v, ok := tfMap["mtu"].(int); ok && v != 0 {
+		apiObject.Mtu = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandSecurityDetails(tfMap map[string]interface{}) *awstypes.SecurityDetails {
+	return &awstypes.SecurityDetails{
+		RoleArn:          aws.String(tfMap[names.AttrRoleARN].(string)),
+		SecurityGroupIds: flex.ExpandStringValueSet(tfMap[names.AttrSecurityGroupIDs].(*schema.Set)),
+		SubnetIds:        flex.ExpandStringValueSet(tfMap[names.AttrSubnetIDs].(*schema.Set)),
+	}
+}
+
+func flattenEndpointDetails(apiObjects []awstypes.EndpointDetails) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{}
+
+		if v := apiObject.Endpoint; v != nil {
+			tfMap[names.AttrEndpoint] = []interface{}{flattenDataflowEndpoint(v)}
+		}
+
+		if v := apiObject.SecurityDetails; v != nil {
+			tfMap["security_details"] = []interface{}{map[string]interface{}{
+				names.AttrRoleARN:          aws.ToString(v.RoleArn),
+				names.AttrSecurityGroupIDs: v.SecurityGroupIds,
+				names.AttrSubnetIDs:        v.SubnetIds,
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenDataflowEndpoint(apiObject *awstypes.DataflowEndpoint) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"mtu":          aws.ToInt32(apiObject.Mtu),
+		names.AttrName: aws.ToString(apiObject.Name),
+	}
+
+	if v := apiObject.Address; v != nil {
+		tfMap[names.AttrAddress] = []interface{}{map[string]interface{}{
+			names.AttrName: aws.ToString(v.Name),
+			names.AttrPort: aws.ToInt32(v.Port),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,471 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_groundstation_ephemeris", name="Ephemeris")
+// @Tags(identifierAttribute="arn")
+func resourceEphemeris() *schema.Resource {
+	s3ObjectSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrBucket: {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					names.AttrKey: {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					names.AttrVersion: {
+						Type:     schema.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEphemerisCreate,
+		ReadWithoutTimeout:   resourceEphemerisRead,
+		UpdateWithoutTimeout: resourceEphemerisUpdate,
+		DeleteWithoutTimeout: resourceEphemerisDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"expiration_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"invalid_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKMSKeyARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"oem": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"oem", "tle"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oem_data": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"oem.0.oem_data", "oem.0.s3_object"},
+						},
+						"s3_object": s3ObjectSchema(),
+					},
+				},
+			},
+			names.AttrPriority: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 99999),
+			},
+			"satellite_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tle": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_object": s3ObjectSchema(),
+						"tle_data": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"tle.0.s3_object", "tle.0.tle_data"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tle_line_1": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(69, 69),
+									},
+									"tle_line_2": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(69, 69),
+									},
+									"valid_time_range": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end_time": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												names.AttrStartTime: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEphemerisCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &groundstation.CreateEphemerisInput{
+		Enabled:     aws.Bool(d.Get(names.AttrEnabled).(bool)),
+		Name:        aws.String(name),
+		SatelliteId: aws.String(d.Get("satellite_id").(string)),
+		Tags:        getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("expiration_time"); ok {
+		v, _ := time.Parse(time.RFC3339, v.(string))
+		input.ExpirationTime = aws.Time(v)
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyARN); ok {
+		input.KmsKeyArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("oem"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Ephemeris = expandOEMEphemeris(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrPriority); ok {
+		input.Priority = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("tle"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Ephemeris = expandTLEEphemeris(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	output, err := conn.CreateEphemeris(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Ephemeris (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.EphemerisId))
+
+	if _, err := waitEphemerisValidated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Ground Station Ephemeris (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceEphemerisRead(ctx, d, meta)...)
+}
+
+func resourceEphemerisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	output, err := findEphemerisByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Ephemeris (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Ephemeris (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   "groundstation",
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  "ephemeris/" + d.Id(),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrEnabled, output.Enabled)
+	d.Set("invalid_reason", output.InvalidReason)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrPriority, output.Priority)
+	d.Set("satellite_id", output.SatelliteId)
+	d.Set(names.AttrStatus, output.Status)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceEphemerisUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &groundstation.UpdateEphemerisInput{
+			Enabled:     aws.Bool(d.Get(names.AttrEnabled).(bool)),
+			EphemerisId: aws.String(d.Id()),
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChange(names.AttrPriority) {
+			input.Priority = aws.Int32(int32(d.Get(names.AttrPriority).(int)))
+		}
+
+		_, err := conn.UpdateEphemeris(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Ground Station Ephemeris (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceEphemerisRead(ctx, d, meta)...)
+}
+
+func resourceEphemerisDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	log.Printf("[INFO] Deleting Ground Station Ephemeris: %s", d.Id())
+	_, err := conn.DeleteEphemeris(ctx, &groundstation.DeleteEphemerisInput{
+		EphemerisId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Ephemeris (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findEphemerisByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.DescribeEphemerisOutput, error) {
+	input := &groundstation.DescribeEphemerisInput{
+		EphemerisId: aws.String(id),
+	}
+
+	output, err := conn.DescribeEphemeris(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEphemeris(ctx context.Context, conn *groundstation.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEphemerisByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEphemerisValidated(ctx context.Context, conn *groundstation.Client, id string, timeout time.Duration) (*groundstation.DescribeEphemerisOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EphemerisStatusValidating),
+		Target:  enum.Slice(awstypes.EphemerisStatusEnabled, awstypes.EphemerisStatusDisabled),
+		Refresh: statusEphemeris(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*groundstation.DescribeEphemerisOutput); ok {
+		if v := output.InvalidReason; v != "" {
+			tfresource.SetLastError(err, errors.New(string(v)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandOEMEphemeris(tfMap map[string]interface{}) awstypes.EphemerisData {
+	apiObject := awstypes.OEMEphemeris{}
+
+	if v, ok := tfMap["oem_data"].(string); ok && v != "" {
+		apiObject.OemData = aws.String(v)
+	}
+
+	if v, ok := tfMap["s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Object = expandS3Object(v[0].(map[string]interface{}))
+	}
+
+	return &awstypes.EphemerisDataMemberOem{
+		Value: apiObject,
+	}
+}
+
+func expandTLEEphemeris(tfMap map[string]interface{}) awstypes.EphemerisData {
+	apiObject := awstypes.TLEEphemeris{}
+
+	if v, ok := tfMap["s3_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Object = expandS3Object(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["tle_data"].([]interface{}); ok && len(v) > 0 {
+		apiObject.TleData = expandTLEData(v)
+	}
+
+	return &awstypes.EphemerisDataMemberTle{
+		Value: apiObject,
+	}
+}
+
+func expandS3Object(tfMap map[string]interface{}) *awstypes.S3Object {
+	apiObject := &awstypes.S3Object{
+		Bucket: aws.String(tfMap[names.AttrBucket].(string)),
+		Key:    aws.String(tfMap[names.AttrKey].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandTLEData(tfList []interface{}) []awstypes.TLEData {
+	apiObjects := make([]awstypes.TLEData, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.TLEData{
+			TleLine1: aws.String(tfMap["tle_line_1"].(string)),
+			TleLine2: aws.String(tfMap["tle_line_2"].(string)),
+		}
+
+		if v, ok := tfMap["valid_time_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			startTime, _ := time.Parse(time.RFC3339, tfMap[names.AttrStartTime].(string))
+			endTime, _ := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+
+			apiObject.ValidTimeRange = &awstypes.TimeRange{
+				EndTime:   aws.Time(endTime),
+				StartTime: aws.Time(startTime),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
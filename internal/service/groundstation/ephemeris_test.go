@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationEphemeris_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_ephemeris.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	satelliteID := os.Getenv("GROUNDSTATION_SATELLITE_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.SkipIfEnvVarNotSet(t, "GROUNDSTATION_SATELLITE_ID")
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEphemerisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemerisConfig_tle(rName, satelliteID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "satellite_id", satelliteID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expiration_time", "tle"},
+			},
+			{
+				Config: testAccEphemerisConfig_tle(rName, satelliteID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEphemerisExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		_, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEphemerisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_ephemeris" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Ephemeris %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEphemerisConfig_tle(rName, satelliteID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_ephemeris" "test" {
+  name         = %[1]q
+  satellite_id = %[2]q
+  enabled      = %[3]t
+  priority     = 1
+
+  tle {
+    tle_data {
+      tle_line_1 = "1 25994U 99068A   24001.50000000  .00000074  00000-0  26488-4 0  9990"
+      tle_line_2 = "2 25994  98.1929  76.4364 0001344  91.6823 268.4525 14.57108429288839"
+
+      valid_time_range {
+        start_time = timeadd(plantimestamp(), "-1h")
+        end_time   = timeadd(plantimestamp(), "72h")
+      }
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [tle]
+  }
+}
+`, rName, satelliteID, enabled)
+}
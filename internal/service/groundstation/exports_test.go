@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+// Exports for use in tests only.
+var (
+	ResourceConfig                = resourceConfig
+	ResourceDataflowEndpointGroup = resourceDataflowEndpointGroup
+	ResourceEphemeris             = resourceEphemeris
+	ResourceMissionProfile        = resourceMissionProfile
+
+	FindConfigByTwoPartKey        = findConfigByTwoPartKey
+	FindDataflowEndpointGroupByID = findDataflowEndpointGroupByID
+	FindEphemerisByID             = findEphemerisByID
+	FindMissionProfileByID        = findMissionProfileByID
+)
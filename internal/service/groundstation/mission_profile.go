@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_groundstation_mission_profile", name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func resourceMissionProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMissionProfileCreate,
+		ReadWithoutTimeout:   resourceMissionProfileRead,
+		UpdateWithoutTimeout: resourceMissionProfileUpdate,
+		DeleteWithoutTimeout: resourceMissionProfileDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_post_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 21600),
+			},
+			"contact_pre_pass_duration_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 21600),
+			},
+			"dataflow_edge": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrSource: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			"minimum_viable_contact_duration_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 21600),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^[ a-zA-Z0-9_:-]+$`), ""),
+				),
+			},
+			"streams_kms_key": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"streams_kms_role_arn"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_alias_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"streams_kms_key.0.kms_alias_arn", "streams_kms_key.0.kms_key_arn"},
+						},
+						names.AttrKMSKeyARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"streams_kms_key.0.kms_alias_arn", "streams_kms_key.0.kms_key_arn"},
+						},
+					},
+				},
+			},
+			"streams_kms_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"streams_kms_key"},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"tracking_config_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceMissionProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &groundstation.CreateMissionProfileInput{
+		DataflowEdges:                       expandDataflowEdges(d.Get("dataflow_edge").([]interface{})),
+		MinimumViableContactDurationSeconds: aws.Int32(int32(d.Get("minimum_viable_contact_duration_seconds").(int))),
+		Name:                                aws.String(name),
+		Tags:                                getTagsIn(ctx),
+		TrackingConfigArn:                   aws.String(d.Get("tracking_config_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("contact_post_pass_duration_seconds"); ok {
+		input.ContactPostPassDurationSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("contact_pre_pass_duration_seconds"); ok {
+		input.ContactPrePassDurationSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("streams_kms_key"); ok {
+		input.StreamsKmsKey = expandKMSKey(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("streams_kms_role_arn"); ok {
+		input.StreamsKmsRole = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateMissionProfile(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Ground Station Mission Profile (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.MissionProfileId))
+
+	return append(diags, resourceMissionProfileRead(ctx, d, meta)...)
+}
+
+func resourceMissionProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	output, err := findMissionProfileByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Ground Station Mission Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.MissionProfileArn)
+	d.Set("contact_post_pass_duration_seconds", output.ContactPostPassDurationSeconds)
+	d.Set("contact_pre_pass_duration_seconds", output.ContactPrePassDurationSeconds)
+	if err := d.Set("dataflow_edge", flattenDataflowEdges(output.DataflowEdges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting dataflow_edge: %s", err)
+	}
+	d.Set("minimum_viable_contact_duration_seconds", output.MinimumViableContactDurationSeconds)
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set("streams_kms_key", flattenKMSKey(output.StreamsKmsKey)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting streams_kms_key: %s", err)
+	}
+	d.Set("streams_kms_role_arn", output.StreamsKmsRole)
+	d.Set("tracking_config_arn", output.TrackingConfigArn)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceMissionProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &groundstation.UpdateMissionProfileInput{
+			MissionProfileId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("contact_post_pass_duration_seconds") {
+			input.ContactPostPassDurationSeconds = aws.Int32(int32(d.Get("contact_post_pass_duration_seconds").(int)))
+		}
+
+		if d.HasChange("contact_pre_pass_duration_seconds") {
+			input.ContactPrePassDurationSeconds = aws.Int32(int32(d.Get("contact_pre_pass_duration_seconds").(int)))
+		}
+
+		if d.HasChange("dataflow_edge") {
+			input.DataflowEdges = expandDataflowEdges(d.Get("dataflow_edge").([]interface{}))
+		}
+
+		if d.HasChange("minimum_viable_contact_duration_seconds") {
+			input.MinimumViableContactDurationSeconds = aws.Int32(int32(d.Get("minimum_viable_contact_duration_seconds").(int)))
+		}
+
+		if d.HasChange(names.AttrName) {
+			input.Name = aws.String(d.Get(names.AttrName).(string))
+		}
+
+		if d.HasChanges("streams_kms_key", "streams_kms_role_arn") {
+			input.StreamsKmsKey = expandKMSKey(d.Get("streams_kms_key").([]interface{}))
+			input.StreamsKmsRole = aws.String(d.Get("streams_kms_role_arn").(string))
+		}
+
+		if d.HasChange("tracking_config_arn") {
+			input.TrackingConfigArn = aws.String(d.Get("tracking_config_arn").(string))
+		}
+
+		_, err := conn.UpdateMissionProfile(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Ground Station Mission Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMissionProfileRead(ctx, d, meta)...)
+}
+
+func resourceMissionProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GroundStationClient(ctx)
+
+	log.Printf("[INFO] Deleting Ground Station Mission Profile: %s", d.Id())
+	_, err := conn.DeleteMissionProfile(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Ground Station Mission Profile (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandDataflowEdges(tfList []interface{}) [][]string {
+	apiObjects := make([][]string, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, []string{tfMap[names.AttrSource].(string), tfMap[names.AttrDestination].(string)})
+	}
+
+	return apiObjects
+}
+
+func flattenDataflowEdges(apiObjects [][]string) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if len(apiObject) != 2 {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDestination: apiObject[1],
+			names.AttrSource:      apiObject[0],
+		})
+	}
+
+	return tfList
+}
+
+func expandKMSKey(tfList []interface{}) awstypes.KmsKey {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["kms_alias_arn"].(string); ok && v != "" {
+		return &awstypes.KmsKeyMemberKmsAliasArn{
+			Value: v,
+		}
+	}
+
+	if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+		return &awstypes.KmsKeyMemberKmsKeyArn{
+			Value: v,
+		}
+	}
+
+	return nil
+}
+
+func flattenKMSKey(apiObject awstypes.KmsKey) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *awstypes.KmsKeyMemberKmsAliasArn:
+		tfMap["kms_alias_arn"] = v.Value
+	case *awstypes.KmsKeyMemberKmsKeyArn:
+		tfMap[names.AttrKMSKeyARN] = v.Value
+	default:
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationMissionProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_mission_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 180),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`mission-profile/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "dataflow_edge.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.source", "aws_groundstation_config.downlink", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.destination", "aws_groundstation_config.recording", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "180"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "streams_kms_key.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "tracking_config_arn", "aws_groundstation_config.tracking", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_mission_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 180),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceMissionProfile(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_streamsKMSKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_groundstation_mission_profile.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_streamsKMSKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "streams_kms_key.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_key.0.kms_key_arn", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_role_arn", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMissionProfileExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		_, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckMissionProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_mission_profile" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Mission Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccMissionProfileConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = "aws-groundstation-%[1]s"
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "groundstation.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_groundstation_config" "tracking" {
+  name = "%[1]s-tracking"
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+
+resource "aws_groundstation_config" "downlink" {
+  name = "%[1]s-downlink"
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+
+resource "aws_groundstation_config" "recording" {
+  name = "%[1]s-recording"
+
+  config_data {
+    s3_recording_config {
+      bucket_arn = aws_s3_bucket.test.arn
+      role_arn   = aws_iam_role.test.arn
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMissionProfileConfig_basic(rName string, minimumViableContactDuration int) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = %[2]d
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.recording.arn
+  }
+}
+`, rName, minimumViableContactDuration))
+}
+
+func testAccMissionProfileConfig_streamsKMSKey(rName string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 180
+  streams_kms_role_arn                    = aws_iam_role.test.arn
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.downlink.arn
+    destination = aws_groundstation_config.recording.arn
+  }
+
+  streams_kms_key {
+    kms_key_arn = aws_kms_key.test.arn
+  }
+}
+`, rName))
+}
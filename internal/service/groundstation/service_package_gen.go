@@ -27,7 +27,40 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceConfig,
+			TypeName: "aws_groundstation_config",
+			Name:     "Config",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceDataflowEndpointGroup,
+			TypeName: "aws_groundstation_dataflow_endpoint_group",
+			Name:     "Dataflow Endpoint Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceEphemeris,
+			TypeName: "aws_groundstation_ephemeris",
+			Name:     "Ephemeris",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory:  resourceMissionProfile,
+			TypeName: "aws_groundstation_mission_profile",
+			Name:     "Mission Profile",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
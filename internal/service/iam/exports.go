@@ -7,6 +7,7 @@ package iam
 var (
 	ResourceRole = resourceRole
 
+	DeleteRole              = deleteRole
 	DeleteServiceLinkedRole = deleteServiceLinkedRole
 	FindRoleByName          = findRoleByName
 	ListGroupsForUserPages  = listGroupsForUserPages
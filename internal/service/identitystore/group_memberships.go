@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameGroupMemberships = "GroupMemberships"
+)
+
+// @SDKResource("aws_identitystore_group_memberships")
+func ResourceGroupMemberships() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGroupMembershipsCreate,
+		ReadWithoutTimeout:   resourceGroupMembershipsRead,
+		UpdateWithoutTimeout: resourceGroupMembershipsUpdate,
+		DeleteWithoutTimeout: resourceGroupMembershipsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 36),
+			},
+
+			"member_external_id": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						names.AttrIssuer: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+					},
+				},
+			},
+
+			"member_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 47),
+				},
+			},
+
+			"membership_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"resolved_external_id": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrIssuer: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id := fmt.Sprintf("%s/%s", identityStoreID, groupID)
+
+	resolved, err := reconcileGroupMemberships(ctx, conn, identityStoreID, groupID, d)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionCreating, ResNameGroupMemberships, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("resolved_external_id", resolved)
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID, err := resourceGroupMembershipsParseID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IdentityStore GroupMemberships (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionReading, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	// Members that were added by external ID are reported as external IDs, every other member as a user ID.
+	// An external ID whose user is no longer a member drops out of state, which shows up as drift.
+	var externalIDs, resolved []interface{}
+	fromExternalID := make(map[string]bool)
+	for _, v := range d.Get("resolved_external_id").([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		userID := tfMap["user_id"].(string)
+
+		if _, ok := memberships[userID]; !ok {
+			continue
+		}
+
+		fromExternalID[userID] = true
+		resolved = append(resolved, tfMap)
+		externalIDs = append(externalIDs, map[string]interface{}{
+			names.AttrID:     tfMap[names.AttrID],
+			names.AttrIssuer: tfMap[names.AttrIssuer],
+		})
+	}
+
+	var memberIDs []string
+	membershipIDs := make(map[string]interface{})
+	for userID, membershipID := range memberships {
+		membershipIDs[userID] = membershipID
+
+		if !fromExternalID[userID] {
+			memberIDs = append(memberIDs, userID)
+		}
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("member_external_id", externalIDs)
+	d.Set("member_ids", memberIDs)
+	d.Set("membership_ids", membershipIDs)
+	d.Set("resolved_external_id", resolved)
+
+	return diags
+}
+
+func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	identityStoreID, groupID, err := resourceGroupMembershipsParseID(d.Id())
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	resolved, err := reconcileGroupMemberships(ctx, conn, identityStoreID, groupID, d)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionUpdating, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	d.Set("resolved_external_id", resolved)
+
+	return append(diags, resourceGroupMembershipsRead(ctx, d, meta)...)
+}
+
+func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).IdentityStoreClient(ctx)
+
+	log.Printf("[INFO] Deleting IdentityStore GroupMemberships %s", d.Id())
+
+	identityStoreID := d.Get("identity_store_id").(string)
+
+	var failures []error
+	for userID, membershipID := range d.Get("membership_ids").(map[string]interface{}) {
+		if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID.(string)); err != nil {
+			failures = append(failures, fmt.Errorf("removing user (%s): %w", userID, err))
+		}
+	}
+
+	if err := errors.Join(failures...); err != nil {
+		return create.AppendDiagError(diags, names.IdentityStore, create.ErrActionDeleting, ResNameGroupMemberships, d.Id(), err)
+	}
+
+	return diags
+}
+
+// reconcileGroupMemberships makes the group's members match the configuration, adding missing members
+// and removing all others. Only the differences result in API calls. It returns the external IDs
+// with the user IDs they resolved to.
+func reconcileGroupMemberships(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string, d *schema.ResourceData) ([]interface{}, error) {
+	want := make(map[string]bool)
+	for _, v := range flex.ExpandStringValueSet(d.Get("member_ids").(*schema.Set)) {
+		want[v] = true
+	}
+
+	// Reuse earlier resolutions so that unchanged external IDs aren't looked up again.
+	previous := make(map[[2]string]string)
+	for _, v := range d.Get("resolved_external_id").([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		previous[[2]string{tfMap[names.AttrIssuer].(string), tfMap[names.AttrID].(string)}] = tfMap["user_id"].(string)
+	}
+
+	var resolved []interface{}
+	for _, v := range d.Get("member_external_id").(*schema.Set).List() {
+		tfMap := v.(map[string]interface{})
+		externalID := types.ExternalId{
+			Id:     aws.String(tfMap[names.AttrID].(string)),
+			Issuer: aws.String(tfMap[names.AttrIssuer].(string)),
+		}
+
+		userID, ok := previous[[2]string{aws.ToString(externalID.Issuer), aws.ToString(externalID.Id)}]
+		if !ok {
+			var err error
+			userID, err = findUserIDByExternalID(ctx, conn, identityStoreID, externalID)
+
+			if err != nil {
+				return nil, fmt.Errorf("resolving external ID (%s/%s): %w", aws.ToString(externalID.Issuer), aws.ToString(externalID.Id), err)
+			}
+		}
+
+		if want[userID] {
+			return nil, fmt.Errorf("user (%s) is specified in both member_ids and member_external_id", userID)
+		}
+
+		want[userID] = true
+		resolved = append(resolved, map[string]interface{}{
+			names.AttrID:     tfMap[names.AttrID],
+			names.AttrIssuer: tfMap[names.AttrIssuer],
+			"user_id":        userID,
+		})
+	}
+
+	memberships, err := findGroupMembershipsByGroupID(ctx, conn, identityStoreID, groupID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []error
+	for userID, membershipID := range memberships {
+		if want[userID] {
+			continue
+		}
+
+		if err := deleteGroupMembership(ctx, conn, identityStoreID, membershipID); err != nil {
+			failures = append(failures, fmt.Errorf("removing user (%s): %w", userID, err))
+		}
+	}
+
+	for userID := range want {
+		if _, ok := memberships[userID]; ok {
+			continue
+		}
+
+		input := &identitystore.CreateGroupMembershipInput{
+			GroupId:         aws.String(groupID),
+			IdentityStoreId: aws.String(identityStoreID),
+			MemberId:        &types.MemberIdMemberUserId{Value: userID},
+		}
+
+		if _, err := conn.CreateGroupMembership(ctx, input); err != nil {
+			var e *types.ConflictException
+			if !errors.As(err, &e) {
+				failures = append(failures, fmt.Errorf("adding user (%s): %w", userID, err))
+			}
+		}
+	}
+
+	return resolved, errors.Join(failures...)
+}
+
+func deleteGroupMembership(ctx context.Context, conn *identitystore.Client, identityStoreID, membershipID string) error {
+	_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	})
+
+	if err != nil {
+		var e *types.ResourceNotFoundException
+		if errors.As(err, &e) {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func resourceGroupMembershipsParseID(id string) (identityStoreID, groupID string, err error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = errors.New("expected a resource id in the form: identity-store-id/group-id")
+		return
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// findGroupMembershipsByGroupID returns the group's membership IDs keyed by member user ID.
+func findGroupMembershipsByGroupID(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) (map[string]string, error) {
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		MaxResults:      aws.Int32(100),
+	}
+	output := make(map[string]string)
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			var e *types.ResourceNotFoundException
+			if errors.As(err, &e) {
+				return nil, &retry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				}
+			}
+
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			userID, err := getMemberIdMemberUserId(v.MemberId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			output[aws.ToString(userID)] = aws.ToString(v.MembershipId)
+		}
+	}
+
+	return output, nil
+}
+
+func findUserIDByExternalID(ctx context.Context, conn *identitystore.Client, identityStoreID string, externalID types.ExternalId) (string, error) {
+	input := &identitystore.GetUserIdInput{
+		AlternateIdentifier: &types.AlternateIdentifierMemberExternalId{
+			Value: externalID,
+		},
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	output, err := conn.GetUserId(ctx, input)
+
+	if err != nil {
+		var e *types.ResourceNotFoundException
+		if errors.As(err, &e) {
+			return "", &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		return "", err
+	}
+
+	if output == nil || output.UserId == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.UserId), nil
+}
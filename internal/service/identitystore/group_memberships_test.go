@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitystore_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	"github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIdentityStoreGroupMemberships_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var memberIDs []string
+
+	groupResourceName := "aws_identitystore_group.test"
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", groupResourceName, "group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity_store_id"),
+					resource.TestCheckResourceAttr(resourceName, "member_external_id.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member_ids.*", "aws_identitystore_user.test.1", "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "membership_ids.%", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resolved_external_id"},
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var memberIDs []string
+
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfidentitystore.ResourceGroupMemberships(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var memberIDs []string
+
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "membership_ids.%", "1"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "membership_ids.%", "3"),
+				),
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "membership_ids.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreGroupMemberships_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var memberIDs []string
+
+	resourceName := "aws_identitystore_group_memberships.test"
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IdentityStoreServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupMembershipsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					testAccCheckGroupMembershipsRemoveMember(ctx, resourceName, "aws_identitystore_user.test.0"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGroupMembershipsConfig_basic(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupMembershipsExists(ctx, resourceName, &memberIDs),
+					resource.TestCheckResourceAttr(resourceName, "member_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "membership_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGroupMembershipsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_identitystore_group_memberships" {
+				continue
+			}
+
+			out, err := conn.ListGroupMemberships(ctx, &identitystore.ListGroupMembershipsInput{
+				GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+				IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+			})
+			if err != nil {
+				var nfe *types.ResourceNotFoundException
+				if errors.As(err, &nfe) {
+					continue
+				}
+				return err
+			}
+
+			if len(out.GroupMemberships) == 0 {
+				continue
+			}
+
+			return create.Error(names.IdentityStore, create.ErrActionCheckingDestroyed, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsExists(ctx context.Context, name string, memberIDs *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		input := &identitystore.ListGroupMembershipsInput{
+			GroupId:         aws.String(rs.Primary.Attributes["group_id"]),
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+		}
+		var ids []string
+
+		pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, err)
+			}
+
+			for _, v := range page.GroupMemberships {
+				if v, ok := v.MemberId.(*types.MemberIdMemberUserId); ok {
+					ids = append(ids, v.Value)
+				}
+			}
+		}
+
+		if got, want := fmt.Sprint(len(ids)), rs.Primary.Attributes["membership_ids.%"]; got != want {
+			return create.Error(names.IdentityStore, create.ErrActionCheckingExistence, tfidentitystore.ResNameGroupMemberships, rs.Primary.ID, fmt.Errorf("expected %s members, got %s", want, got))
+		}
+
+		*memberIDs = ids
+
+		return nil
+	}
+}
+
+func testAccCheckGroupMembershipsRemoveMember(ctx context.Context, name, userResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		user, ok := s.RootModule().Resources[userResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", userResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreClient(ctx)
+
+		_, err := conn.DeleteGroupMembership(ctx, &identitystore.DeleteGroupMembershipInput{
+			IdentityStoreId: aws.String(rs.Primary.Attributes["identity_store_id"]),
+			MembershipId:    aws.String(rs.Primary.Attributes["membership_ids."+user.Primary.Attributes["user_id"]]),
+		})
+
+		return err
+	}
+}
+
+func testAccGroupMembershipsConfig_basic(rName string, userCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  count = %[2]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_group_memberships" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id   = aws_identitystore_group.test.group_id
+  member_ids = aws_identitystore_user.test[*].user_id
+}
+`, rName, userCount)
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iot_billing_group_membership", name="Billing Group Membership")
+func ResourceBillingGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBillingGroupMembershipCreate,
+		ReadWithoutTimeout:   resourceBillingGroupMembershipRead,
+		DeleteWithoutTimeout: resourceBillingGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"billing_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"thing_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBillingGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	billingGroupName := d.Get("billing_group_name").(string)
+	thingName := d.Get("thing_name").(string)
+	input := &iot.AddThingToBillingGroupInput{
+		BillingGroupName: aws.String(billingGroupName),
+		ThingName:        aws.String(thingName),
+	}
+
+	_, err := conn.AddThingToBillingGroupWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding IoT Thing (%s) to IoT Billing Group (%s): %s", thingName, billingGroupName, err)
+	}
+
+	d.SetId(BillingGroupMembershipCreateResourceID(billingGroupName, thingName))
+
+	return append(diags, resourceBillingGroupMembershipRead(ctx, d, meta)...)
+}
+
+func resourceBillingGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	billingGroupName, thingName, err := BillingGroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	err = FindBillingGroupMembership(ctx, conn, billingGroupName, thingName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Billing Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Billing Group Membership (%s): %s", d.Id(), err)
+	}
+
+	d.Set("billing_group_name", billingGroupName)
+	d.Set("thing_name", thingName)
+
+	return diags
+}
+
+func resourceBillingGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTConn(ctx)
+
+	billingGroupName, thingName, err := BillingGroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Billing Group Membership: %s", d.Id())
+	_, err = conn.RemoveThingFromBillingGroupWithContext(ctx, &iot.RemoveThingFromBillingGroupInput{
+		BillingGroupName: aws.String(billingGroupName),
+		ThingName:        aws.String(thingName),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Billing Group Membership (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// FindBillingGroupMembership returns a NotFoundError unless the thing belongs to the billing group.
+// A thing can belong to at most one billing group.
+func FindBillingGroupMembership(ctx context.Context, conn *iot.IoT, billingGroupName, thingName string) error {
+	output, err := FindThingByName(ctx, conn, thingName)
+
+	if err != nil {
+		return err
+	}
+
+	if aws.StringValue(output.BillingGroupName) != billingGroupName {
+		return &retry.NotFoundError{
+			Message: fmt.Sprintf("IoT Thing (%s) is not a member of IoT Billing Group (%s)", thingName, billingGroupName),
+		}
+	}
+
+	return nil
+}
+
+const billingGroupMembershipResourceIDSeparator = "/"
+
+func BillingGroupMembershipCreateResourceID(billingGroupName, thingName string) string {
+	parts := []string{billingGroupName, thingName}
+	id := strings.Join(parts, billingGroupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func BillingGroupMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, billingGroupMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected billing-group-name%[2]sthing-name", id, billingGroupMembershipResourceIDSeparator)
+}
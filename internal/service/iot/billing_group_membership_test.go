@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiot "github.com/hashicorp/terraform-provider-aws/internal/service/iot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTBillingGroupMembership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_billing_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBillingGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupMembershipConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBillingGroupMembershipExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "billing_group_name", rName1),
+					resource.TestCheckResourceAttr(resourceName, "thing_name", rName2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTBillingGroupMembership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_billing_group_membership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBillingGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupMembershipConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBillingGroupMembershipExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceBillingGroupMembership(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTBillingGroupMembership_disappears_Thing(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_billing_group_membership.test"
+	thingResourceName := "aws_iot_thing.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBillingGroupMembershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBillingGroupMembershipConfig_basic(rName1, rName2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBillingGroupMembershipExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiot.ResourceThing(), thingResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBillingGroupMembershipExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IoT Billing Group Membership ID is set")
+		}
+
+		billingGroupName, thingName, err := tfiot.BillingGroupMembershipParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		return tfiot.FindBillingGroupMembership(ctx, conn, billingGroupName, thingName)
+	}
+}
+
+func testAccCheckBillingGroupMembershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iot_billing_group_membership" {
+				continue
+			}
+
+			billingGroupName, thingName, err := tfiot.BillingGroupMembershipParseResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			err = tfiot.FindBillingGroupMembership(ctx, conn, billingGroupName, thingName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Billing Group Membership %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccBillingGroupMembershipConfig_basic(rName1, rName2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_billing_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_iot_thing" "test" {
+  name = %[2]q
+}
+
+resource "aws_iot_billing_group_membership" "test" {
+  billing_group_name = aws_iot_billing_group.test.name
+  thing_name         = aws_iot_thing.test.name
+}
+`, rName1, rName2)
+}
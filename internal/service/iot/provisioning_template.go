@@ -5,13 +5,19 @@ package iot
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -65,6 +71,15 @@ func ResourceProvisioningTemplate() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"latest_version_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"make_default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -118,7 +133,10 @@ func ResourceProvisioningTemplate() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidateProvisioningTemplateBody,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -186,10 +204,34 @@ func resourceProvisioningTemplateRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading IoT Provisioning Template (%s): %s", d.Id(), err)
 	}
 
+	versions, err := findProvisioningTemplateVersionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Provisioning Template (%s) versions: %s", d.Id(), err)
+	}
+
+	templateBody := output.TemplateBody
+	latestVersionID := aws.Int64Value(output.DefaultVersionId)
+	for _, v := range versions {
+		latestVersionID = max(latestVersionID, aws.Int64Value(v.VersionId))
+	}
+
+	// If new versions aren't made the default, the configured body is that of the latest version.
+	if !d.Get("make_default_version").(bool) && latestVersionID != aws.Int64Value(output.DefaultVersionId) {
+		version, err := findProvisioningTemplateVersionByTwoPartKey(ctx, conn, d.Id(), latestVersionID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT Provisioning Template (%s) version (%d): %s", d.Id(), latestVersionID, err)
+		}
+
+		templateBody = version.TemplateBody
+	}
+
 	d.Set(names.AttrARN, output.TemplateArn)
 	d.Set("default_version_id", output.DefaultVersionId)
 	d.Set(names.AttrDescription, output.Description)
 	d.Set(names.AttrEnabled, output.Enabled)
+	d.Set("latest_version_id", latestVersionID)
 	d.Set(names.AttrName, output.TemplateName)
 	if output.PreProvisioningHook != nil {
 		if err := d.Set("pre_provisioning_hook", []interface{}{flattenProvisioningHook(output.PreProvisioningHook)}); err != nil {
@@ -199,7 +241,7 @@ func resourceProvisioningTemplateRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("pre_provisioning_hook", nil)
 	}
 	d.Set("provisioning_role_arn", output.ProvisioningRoleArn)
-	d.Set("template_body", output.TemplateBody)
+	d.Set("template_body", templateBody)
 	d.Set(names.AttrType, output.Type)
 
 	return diags
@@ -210,22 +252,25 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
+	makeDefaultVersion := d.Get("make_default_version").(bool)
+
 	if d.HasChange("template_body") {
 		input := &iot.CreateProvisioningTemplateVersionInput{
-			SetAsDefault: aws.Bool(true),
+			SetAsDefault: aws.Bool(makeDefaultVersion),
 			TemplateBody: aws.String(d.Get("template_body").(string)),
 			TemplateName: aws.String(d.Id()),
 		}
 
 		log.Printf("[DEBUG] Creating IoT Provisioning Template version: %s", input)
-		_, err := conn.CreateProvisioningTemplateVersionWithContext(ctx, input)
-
-		if err != nil {
+		if err := createProvisioningTemplateVersion(ctx, conn, input); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating IoT Provisioning Template (%s) version: %s", d.Id(), err)
 		}
 	}
 
-	if d.HasChanges(names.AttrDescription, names.AttrEnabled, "provisioning_role_arn") {
+	// Promote the latest version if it was previously created without being made the default.
+	promoteLatestVersion := !d.HasChange("template_body") && d.HasChange("make_default_version") && makeDefaultVersion && d.Get("latest_version_id").(int) != d.Get("default_version_id").(int)
+
+	if d.HasChanges(names.AttrDescription, names.AttrEnabled, "pre_provisioning_hook", "provisioning_role_arn") || promoteLatestVersion {
 		input := &iot.UpdateProvisioningTemplateInput{
 			Description:         aws.String(d.Get(names.AttrDescription).(string)),
 			Enabled:             aws.Bool(d.Get(names.AttrEnabled).(bool)),
@@ -233,6 +278,18 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 			TemplateName:        aws.String(d.Id()),
 		}
 
+		if d.HasChange("pre_provisioning_hook") {
+			if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PreProvisioningHook = expandProvisioningHook(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.RemovePreProvisioningHook = aws.Bool(true)
+			}
+		}
+
+		if promoteLatestVersion {
+			input.DefaultVersionId = aws.Int64(int64(d.Get("latest_version_id").(int)))
+		}
+
 		log.Printf("[DEBUG] Updating IoT Provisioning Template: %s", input)
 		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout,
 			func() (interface{}, error) {
@@ -269,6 +326,160 @@ func resourceProvisioningTemplateDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+// createProvisioningTemplateVersion creates a new template version.
+// If the template already has the maximum number of versions the oldest non-default version is deleted first.
+func createProvisioningTemplateVersion(ctx context.Context, conn *iot.IoT, input *iot.CreateProvisioningTemplateVersionInput) error {
+	_, err := conn.CreateProvisioningTemplateVersionWithContext(ctx, input)
+
+	if !tfawserr.ErrCodeEquals(err, iot.ErrCodeVersionsLimitExceededException) {
+		return err
+	}
+
+	name := aws.StringValue(input.TemplateName)
+	versions, err := findProvisioningTemplateVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		return err
+	}
+
+	var oldest *iot.ProvisioningTemplateVersionSummary
+	for _, v := range versions {
+		if aws.BoolValue(v.IsDefaultVersion) {
+			continue
+		}
+
+		if oldest == nil || aws.Int64Value(v.VersionId) < aws.Int64Value(oldest.VersionId) {
+			oldest = v
+		}
+	}
+
+	if oldest == nil {
+		return errors.New("version limit exceeded and no non-default version can be deleted")
+	}
+
+	log.Printf("[INFO] Deleting IoT Provisioning Template (%s) version: %d", name, aws.Int64Value(oldest.VersionId))
+	_, err = conn.DeleteProvisioningTemplateVersionWithContext(ctx, &iot.DeleteProvisioningTemplateVersionInput{
+		TemplateName: aws.String(name),
+		VersionId:    oldest.VersionId,
+	})
+
+	if err != nil {
+		return fmt.Errorf("deleting version (%d): %w", aws.Int64Value(oldest.VersionId), err)
+	}
+
+	_, err = conn.CreateProvisioningTemplateVersionWithContext(ctx, input)
+
+	return err
+}
+
+func customizeDiffValidateProvisioningTemplateBody(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("template_body") || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	templateType := d.Get(names.AttrType).(string)
+	if templateType == "" {
+		templateType = iot.TemplateTypeFleetProvisioning
+	}
+
+	if err := validProvisioningTemplateBody(d.Get("template_body").(string), templateType); err != nil {
+		return fmt.Errorf("template_body: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	provisioningTemplateResourceTypeCertificate = "AWS::IoT::Certificate"
+	provisioningTemplateResourceTypePolicy      = "AWS::IoT::Policy"
+	provisioningTemplateResourceTypeThing       = "AWS::IoT::Thing"
+
+	provisioningTemplateParameterCertificateID = "AWS::IoT::Certificate::Id"
+)
+
+func provisioningTemplateResourceType_Values() []string {
+	return []string{
+		provisioningTemplateResourceTypeCertificate,
+		provisioningTemplateResourceTypePolicy,
+		provisioningTemplateResourceTypeThing,
+	}
+}
+
+// validProvisioningTemplateBody checks a provisioning template body against the structure described in
+// https://docs.aws.amazon.com/iot/latest/developerguide/provision-template.html.
+func validProvisioningTemplateBody(body, templateType string) error {
+	var template struct {
+		Parameters map[string]json.RawMessage `json:"Parameters"`
+		Resources  map[string]struct {
+			Properties map[string]interface{} `json:"Properties"`
+			Type       string                 `json:"Type"`
+		} `json:"Resources"`
+	}
+
+	if err := json.Unmarshal([]byte(body), &template); err != nil {
+		return err
+	}
+
+	if len(template.Resources) == 0 {
+		return errors.New("must contain at least one resource")
+	}
+
+	var errs []error
+	var certificateID interface{}
+	for logicalID, resource := range template.Resources {
+		if !slices.Contains(provisioningTemplateResourceType_Values(), resource.Type) {
+			errs = append(errs, fmt.Errorf("resource %q: unsupported type %q, expected one of %s", logicalID, resource.Type, strings.Join(provisioningTemplateResourceType_Values(), ", ")))
+			continue
+		}
+
+		if resource.Type == provisioningTemplateResourceTypeCertificate {
+			certificateID = resource.Properties["CertificateId"]
+		}
+
+		for _, ref := range provisioningTemplateRefs(resource.Properties) {
+			if _, ok := template.Parameters[ref]; ok {
+				continue
+			}
+
+			if _, ok := template.Resources[ref]; ok || strings.HasPrefix(ref, "AWS::") {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("resource %q: reference to undeclared parameter %q", logicalID, ref))
+		}
+	}
+
+	// Just-in-time provisioning templates register the certificate presented by the device.
+	if templateType == iot.TemplateTypeJitp {
+		if v, ok := certificateID.(map[string]interface{}); !ok || v["Ref"] != provisioningTemplateParameterCertificateID {
+			errs = append(errs, fmt.Errorf("%s templates must contain an %s resource with CertificateId set to {\"Ref\": %q}", iot.TemplateTypeJitp, provisioningTemplateResourceTypeCertificate, provisioningTemplateParameterCertificateID))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// provisioningTemplateRefs returns the targets of all {"Ref": ...} expressions in v.
+func provisioningTemplateRefs(v interface{}) []string {
+	var refs []string
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["Ref"].(string); ok && len(v) == 1 {
+			return []string{ref}
+		}
+		for _, v := range v {
+			refs = append(refs, provisioningTemplateRefs(v)...)
+		}
+	case []interface{}:
+		for _, v := range v {
+			refs = append(refs, provisioningTemplateRefs(v)...)
+		}
+	}
+
+	return refs
+}
+
 func flattenProvisioningHook(apiObject *iot.ProvisioningHook) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -329,3 +540,63 @@ func FindProvisioningTemplateByName(ctx context.Context, conn *iot.IoT, name str
 
 	return output, nil
 }
+
+func findProvisioningTemplateVersionsByName(ctx context.Context, conn *iot.IoT, name string) ([]*iot.ProvisioningTemplateVersionSummary, error) {
+	input := &iot.ListProvisioningTemplateVersionsInput{
+		TemplateName: aws.String(name),
+	}
+	var output []*iot.ProvisioningTemplateVersionSummary
+
+	err := conn.ListProvisioningTemplateVersionsPagesWithContext(ctx, input, func(page *iot.ListProvisioningTemplateVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Versions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findProvisioningTemplateVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, name string, versionID int64) (*iot.DescribeProvisioningTemplateVersionOutput, error) {
+	input := &iot.DescribeProvisioningTemplateVersionInput{
+		TemplateName: aws.String(name),
+		VersionId:    aws.Int64(versionID),
+	}
+
+	output, err := conn.DescribeProvisioningTemplateVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -177,6 +178,75 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTProvisioningTemplate_makeDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_makeDefaultVersion(rName, "Active", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "latest_version_id", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_makeDefaultVersion(rName, "Inactive", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "latest_version_id", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "make_default_version", acctest.CtFalse),
+					resource.TestMatchResourceAttr(resourceName, "template_body", regexache.MustCompile(`Inactive`)),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_makeDefaultVersion(rName, "Inactive", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version_id", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "make_default_version", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTProvisioningTemplate_invalidTemplateBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProvisioningTemplateConfig_invalidResourceType(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`unsupported type "AWS::IoT::Widget"`),
+			},
+			{
+				Config:      testAccProvisioningTemplateConfig_invalidJITP(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`JITP templates must contain an AWS::IoT::Certificate resource`),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -428,3 +498,86 @@ resource "aws_iot_provisioning_template" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningTemplateConfig_makeDefaultVersion(rName, status string, makeDefaultVersion bool) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  make_default_version  = %[3]t
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = %[2]q
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName, status, makeDefaultVersion))
+}
+
+func testAccProvisioningTemplateConfig_invalidResourceType(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+
+  template_body = jsonencode({
+    Resources = {
+      widget = {
+        Type = "AWS::IoT::Widget"
+      }
+    }
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
+func testAccProvisioningTemplateConfig_invalidJITP(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+  type                  = "JITP"
+
+  template_body = jsonencode({
+    Parameters = {
+      "AWS::IoT::Certificate::CommonName" = { Type = "String" }
+    }
+
+    Resources = {
+      thing = {
+        Properties = {
+          ThingName = { Ref = "AWS::IoT::Certificate::CommonName" }
+        }
+        Type = "AWS::IoT::Thing"
+      }
+    }
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
@@ -51,6 +51,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceBillingGroupMembership,
+			TypeName: "aws_iot_billing_group_membership",
+			Name:     "Billing Group Membership",
+		},
 		{
 			Factory:  ResourceCACertificate,
 			TypeName: "aws_iot_ca_certificate",
@@ -77,6 +82,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iot_event_configurations",
 			Name:     "Event Configurations",
 		},
+		{
+			Factory:  ResourceFleetMetric,
+			TypeName: "aws_iot_fleet_metric",
+			Name:     "Fleet Metric",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceIndexingConfiguration,
 			TypeName: "aws_iot_indexing_configuration",
@@ -0,0 +1,825 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotfleetwise/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Campaign")
+// @Tags(identifierAttribute="arn")
+func newCampaignResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &campaignResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type campaignResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*campaignResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotfleetwise_campaign"
+}
+
+func (r *campaignResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAction: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UpdateCampaignAction](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.UpdateCampaignActionApprove, awstypes.UpdateCampaignActionResume, awstypes.UpdateCampaignActionSuspend)...),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"compression": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Compression](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_extra_dimensions": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(5),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"diagnostics_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DiagnosticsMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiry_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"post_trigger_collection_duration": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 4294967295),
+				},
+			},
+			"signal_catalog_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"spooling_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SpoolingMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.CampaignStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTargetARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"collection_scheme": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[collectionSchemeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"condition_based_collection_scheme": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionBasedCollectionSchemeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("time_based_collection_scheme"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"condition_language_version": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+									},
+									"minimum_trigger_interval_ms": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.Int64{
+											int64planmodifier.UseStateForUnknown(),
+										},
+									},
+									"trigger_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TriggerMode](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"time_based_collection_scheme": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[timeBasedCollectionSchemeModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"period_ms": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(10000, 86400000),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"data_destination_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataDestinationConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("timestream_config"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"data_format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DataFormat](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrPrefix: schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"storage_compression_format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.StorageCompressionFormat](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"timestream_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[timestreamConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExecutionRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"timestream_table_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"signals_to_collect": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[signalInformationModel](ctx),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1000),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_sample_count": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 4294967295),
+							},
+						},
+						"minimum_sampling_interval_ms": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 4294967295),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *campaignResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data campaignResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	input := &iotfleetwise.CreateCampaignInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// We can't use AutoFlEx with the collection scheme or data destinations because the API structure uses Go interfaces.
+	collectionScheme, diags := data.expandCollectionScheme(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	dataDestinationConfigs, diags := data.expandDataDestinationConfigs(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.CollectionScheme = collectionScheme
+	input.DataDestinationConfigs = dataDestinationConfigs
+	input.Tags = getTagsIn(ctx)
+
+	name := data.Name.ValueString()
+	output, err := conn.CreateCampaign(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT FleetWise Campaign (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	if _, err := waitCampaignCreated(ctx, conn, name, timeout); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for IoT FleetWise Campaign (%s) create", name), err.Error())
+
+		return
+	}
+
+	if action := data.Action.ValueEnum(); action != "" {
+		if err := updateCampaignAction(ctx, conn, name, action); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Campaign (%s) action (%s)", name, action), err.Error())
+
+			return
+		}
+	}
+
+	campaign, err := findCampaignByName(ctx, conn, name)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Campaign (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns after creation is complete.
+	response.Diagnostics.Append(data.flatten(ctx, campaign)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *campaignResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data campaignResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	output, err := findCampaignByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Campaign (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *campaignResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new campaignResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := new.Name.ValueString()
+
+	if !new.DataExtraDimensions.Equal(old.DataExtraDimensions) || !new.Description.Equal(old.Description) {
+		input := &iotfleetwise.UpdateCampaignInput{
+			Action:              awstypes.UpdateCampaignActionUpdate,
+			DataExtraDimensions: fwflex.ExpandFrameworkStringValueList(ctx, new.DataExtraDimensions),
+			Description:         fwflex.StringFromFramework(ctx, new.Description),
+			Name:                aws.String(name),
+		}
+
+		_, err := conn.UpdateCampaign(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Campaign (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	if action := new.Action.ValueEnum(); action != "" && !new.Action.Equal(old.Action) {
+		if err := updateCampaignAction(ctx, conn, name, action); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Campaign (%s) action (%s)", name, action), err.Error())
+
+			return
+		}
+	}
+
+	campaign, err := findCampaignByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Campaign (%s)", name), err.Error())
+
+		return
+	}
+
+	new.Status = fwtypes.StringEnumValue(campaign.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *campaignResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data campaignResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	_, err := conn.DeleteCampaign(ctx, &iotfleetwise.DeleteCampaignInput{
+		Name: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT FleetWise Campaign (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *campaignResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateCampaignAction(ctx context.Context, conn *iotfleetwise.Client, name string, action awstypes.UpdateCampaignAction) error {
+	input := &iotfleetwise.UpdateCampaignInput{
+		Action: action,
+		Name:   aws.String(name),
+	}
+
+	_, err := conn.UpdateCampaign(ctx, input)
+
+	return err
+}
+
+func findCampaignByName(ctx context.Context, conn *iotfleetwise.Client, name string) (*iotfleetwise.GetCampaignOutput, error) {
+	input := &iotfleetwise.GetCampaignInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetCampaign(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCampaign(ctx context.Context, conn *iotfleetwise.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCampaignByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCampaignCreated(ctx context.Context, conn *iotfleetwise.Client, name string, timeout time.Duration) (*iotfleetwise.GetCampaignOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.CampaignStatusCreating),
+		Target:  enum.Slice(awstypes.CampaignStatusWaitingForApproval),
+		Refresh: statusCampaign(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetCampaignOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type campaignResourceModel struct {
+	Action                        fwtypes.StringEnum[awstypes.UpdateCampaignAction]           `tfsdk:"action"`
+	ARN                           types.String                                                `tfsdk:"arn"`
+	CollectionScheme              fwtypes.ListNestedObjectValueOf[collectionSchemeModel]      `tfsdk:"collection_scheme"`
+	Compression                   fwtypes.StringEnum[awstypes.Compression]                    `tfsdk:"compression"`
+	DataDestinationConfigs        fwtypes.ListNestedObjectValueOf[dataDestinationConfigModel] `tfsdk:"data_destination_config"`
+	DataExtraDimensions           fwtypes.ListValueOf[types.String]                           `tfsdk:"data_extra_dimensions"`
+	Description                   types.String                                                `tfsdk:"description"`
+	DiagnosticsMode               fwtypes.StringEnum[awstypes.DiagnosticsMode]                `tfsdk:"diagnostics_mode"`
+	ExpiryTime                    timetypes.RFC3339                                           `tfsdk:"expiry_time"`
+	ID                            types.String                                                `tfsdk:"id"`
+	Name                          types.String                                                `tfsdk:"name"`
+	PostTriggerCollectionDuration types.Int64                                                 `tfsdk:"post_trigger_collection_duration"`
+	SignalCatalogARN              fwtypes.ARN                                                 `tfsdk:"signal_catalog_arn"`
+	SignalsToCollect              fwtypes.SetNestedObjectValueOf[signalInformationModel]      `tfsdk:"signals_to_collect"`
+	SpoolingMode                  fwtypes.StringEnum[awstypes.SpoolingMode]                   `tfsdk:"spooling_mode"`
+	StartTime                     timetypes.RFC3339                                           `tfsdk:"start_time"`
+	Status                        fwtypes.StringEnum[awstypes.CampaignStatus]                 `tfsdk:"status"`
+	Tags                          types.Map                                                   `tfsdk:"tags"`
+	TagsAll                       types.Map                                                   `tfsdk:"tags_all"`
+	TargetARN                     fwtypes.ARN                                                 `tfsdk:"target_arn"`
+	Timeouts                      timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+func (data *campaignResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *campaignResourceModel) setID() {
+	data.ID = data.Name
+}
+
+// flatten sets the model's values from the API response.
+func (data *campaignResourceModel) flatten(ctx context.Context, apiObject *iotfleetwise.GetCampaignOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// We can't use AutoFlEx with the collection scheme or data destinations because the API structure uses Go interfaces.
+	var collectionScheme collectionSchemeModel
+	collectionScheme.TimeBasedCollectionScheme = fwtypes.NewListNestedObjectValueOfNull[timeBasedCollectionSchemeModel](ctx)
+	collectionScheme.ConditionBasedCollectionScheme = fwtypes.NewListNestedObjectValueOfNull[conditionBasedCollectionSchemeModel](ctx)
+
+	switch v := apiObject.CollectionScheme.(type) {
+	case *awstypes.CollectionSchemeMemberConditionBasedCollectionScheme:
+		var tfObject conditionBasedCollectionSchemeModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		collectionScheme.ConditionBasedCollectionScheme = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+	case *awstypes.CollectionSchemeMemberTimeBasedCollectionScheme:
+		var tfObject timeBasedCollectionSchemeModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		collectionScheme.TimeBasedCollectionScheme = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+	}
+
+	data.CollectionScheme = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &collectionScheme)
+
+	dataDestinationConfigs := make([]*dataDestinationConfigModel, 0, len(apiObject.DataDestinationConfigs))
+
+	for _, v := range apiObject.DataDestinationConfigs {
+		dataDestinationConfig := dataDestinationConfigModel{
+			S3Config:         fwtypes.NewListNestedObjectValueOfNull[s3ConfigModel](ctx),
+			TimestreamConfig: fwtypes.NewListNestedObjectValueOfNull[timestreamConfigModel](ctx),
+		}
+
+		switch v := v.(type) {
+		case *awstypes.DataDestinationConfigMemberS3Config:
+			var tfObject s3ConfigModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			dataDestinationConfig.S3Config = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+		case *awstypes.DataDestinationConfigMemberTimestreamConfig:
+			var tfObject timestreamConfigModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &tfObject)...)
+			if diags.HasError() {
+				return diags
+			}
+
+			dataDestinationConfig.TimestreamConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+		default:
+			// Unsupported destination type.
+			continue
+		}
+
+		dataDestinationConfigs = append(dataDestinationConfigs, &dataDestinationConfig)
+	}
+
+	data.DataDestinationConfigs = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, dataDestinationConfigs)
+
+	return diags
+}
+
+func (data *campaignResourceModel) expandCollectionScheme(ctx context.Context) (awstypes.CollectionScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	collectionSchemeData, d := data.CollectionScheme.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || collectionSchemeData == nil {
+		return nil, diags
+	}
+
+	if conditionBasedData, d := collectionSchemeData.ConditionBasedCollectionScheme.ToPtr(ctx); conditionBasedData != nil {
+		diags.Append(d...)
+
+		apiObject := &awstypes.CollectionSchemeMemberConditionBasedCollectionScheme{}
+		diags.Append(fwflex.Expand(ctx, conditionBasedData, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	if timeBasedData, d := collectionSchemeData.TimeBasedCollectionScheme.ToPtr(ctx); timeBasedData != nil {
+		diags.Append(d...)
+
+		apiObject := &awstypes.CollectionSchemeMemberTimeBasedCollectionScheme{}
+		diags.Append(fwflex.Expand(ctx, timeBasedData, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func (data *campaignResourceModel) expandDataDestinationConfigs(ctx context.Context) ([]awstypes.DataDestinationConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	dataDestinationConfigsData, d := data.DataDestinationConfigs.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObjects []awstypes.DataDestinationConfig
+
+	for _, dataDestinationConfigData := range dataDestinationConfigsData {
+		if s3ConfigData, d := dataDestinationConfigData.S3Config.ToPtr(ctx); s3ConfigData != nil {
+			diags.Append(d...)
+
+			apiObject := &awstypes.DataDestinationConfigMemberS3Config{}
+			diags.Append(fwflex.Expand(ctx, s3ConfigData, &apiObject.Value)...)
+			apiObjects = append(apiObjects, apiObject)
+
+			continue
+		}
+
+		if timestreamConfigData, d := dataDestinationConfigData.TimestreamConfig.ToPtr(ctx); timestreamConfigData != nil {
+			diags.Append(d...)
+
+			apiObject := &awstypes.DataDestinationConfigMemberTimestreamConfig{}
+			diags.Append(fwflex.Expand(ctx, timestreamConfigData, &apiObject.Value)...)
+			apiObjects = append(apiObjects, apiObject)
+		}
+	}
+
+	return apiObjects, diags
+}
+
+type collectionSchemeModel struct {
+	ConditionBasedCollectionScheme fwtypes.ListNestedObjectValueOf[conditionBasedCollectionSchemeModel] `tfsdk:"condition_based_collection_scheme"`
+	TimeBasedCollectionScheme      fwtypes.ListNestedObjectValueOf[timeBasedCollectionSchemeModel]      `tfsdk:"time_based_collection_scheme"`
+}
+
+type conditionBasedCollectionSchemeModel struct {
+	ConditionLanguageVersion types.Int64                              `tfsdk:"condition_language_version"`
+	Expression               types.String                             `tfsdk:"expression"`
+	MinimumTriggerIntervalMs types.Int64                              `tfsdk:"minimum_trigger_interval_ms"`
+	TriggerMode              fwtypes.StringEnum[awstypes.TriggerMode] `tfsdk:"trigger_mode"`
+}
+
+type timeBasedCollectionSchemeModel struct {
+	PeriodMs types.Int64 `tfsdk:"period_ms"`
+}
+
+type dataDestinationConfigModel struct {
+	S3Config         fwtypes.ListNestedObjectValueOf[s3ConfigModel]         `tfsdk:"s3_config"`
+	TimestreamConfig fwtypes.ListNestedObjectValueOf[timestreamConfigModel] `tfsdk:"timestream_config"`
+}
+
+type s3ConfigModel struct {
+	BucketARN                fwtypes.ARN                                           `tfsdk:"bucket_arn"`
+	DataFormat               fwtypes.StringEnum[awstypes.DataFormat]               `tfsdk:"data_format"`
+	Prefix                   types.String                                          `tfsdk:"prefix"`
+	StorageCompressionFormat fwtypes.StringEnum[awstypes.StorageCompressionFormat] `tfsdk:"storage_compression_format"`
+}
+
+type timestreamConfigModel struct {
+	ExecutionRoleARN   fwtypes.ARN `tfsdk:"execution_role_arn"`
+	TimestreamTableARN fwtypes.ARN `tfsdk:"timestream_table_arn"`
+}
+
+type signalInformationModel struct {
+	MaxSampleCount            types.Int64  `tfsdk:"max_sample_count"`
+	MinimumSamplingIntervalMs types.Int64  `tfsdk:"minimum_sampling_interval_ms"`
+	Name                      types.String `tfsdk:"name"`
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCampaign_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrAction),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "campaign/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.0.condition_based_collection_scheme.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.0.time_based_collection_scheme.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "collection_scheme.0.time_based_collection_scheme.0.period_ms", "10000"),
+					resource.TestCheckResourceAttrSet(resourceName, "compression"),
+					resource.TestCheckResourceAttrSet(resourceName, "diagnostics_mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiry_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "signal_catalog_arn", "aws_iotfleetwise_signal_catalog.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "signals_to_collect.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "signals_to_collect.*", map[string]string{
+						names.AttrName: "Vehicle.Speed",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "spooling_mode"),
+					resource.TestCheckResourceAttrSet(resourceName, "start_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "WAITING_FOR_APPROVAL"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_iotfleetwise_vehicle.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCampaign_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceCampaign, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCampaign_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetCampaignOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_campaign.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCampaignDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCampaignConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "WAITING_FOR_APPROVAL"),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_updated(rName, "APPROVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "APPROVE"),
+					resource.TestCheckResourceAttr(resourceName, "data_extra_dimensions.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "data_extra_dimensions.0", "Vehicle.VIN"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				Config: testAccCampaignConfig_updated(rName, "SUSPEND"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCampaignExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAction, "SUSPEND"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCampaignDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_campaign" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindCampaignByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Campaign %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCampaignExists(ctx context.Context, n string, v *iotfleetwise.GetCampaignOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		output, err := tfiotfleetwise.FindCampaignByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCampaignConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVehicleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_campaign" "test" {
+  name               = %[1]q
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+  target_arn         = aws_iotfleetwise_vehicle.test.arn
+
+  collection_scheme {
+    time_based_collection_scheme {
+      period_ms = 10000
+    }
+  }
+
+  signals_to_collect {
+    name = "Vehicle.Speed"
+  }
+
+  depends_on = [aws_iotfleetwise_model_manifest.test]
+}
+`, rName))
+}
+
+func testAccCampaignConfig_updated(rName, action string) string {
+	return acctest.ConfigCompose(testAccVehicleConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_campaign" "test" {
+  name                  = %[1]q
+  action                = %[2]q
+  description           = "updated"
+  data_extra_dimensions = ["Vehicle.VIN"]
+  signal_catalog_arn    = aws_iotfleetwise_signal_catalog.test.arn
+  target_arn            = aws_iotfleetwise_vehicle.test.arn
+
+  collection_scheme {
+    time_based_collection_scheme {
+      period_ms = 10000
+    }
+  }
+
+  signals_to_collect {
+    name = "Vehicle.Speed"
+  }
+
+  depends_on = [aws_iotfleetwise_model_manifest.test]
+}
+`, rName, action))
+}
@@ -0,0 +1,744 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotfleetwise/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Decoder Manifest")
+// @Tags(identifierAttribute="arn")
+func newDecoderManifestResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &decoderManifestResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type decoderManifestResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*decoderManifestResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotfleetwise_decoder_manifest"
+}
+
+func (r *decoderManifestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"model_manifest_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ManifestStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.ManifestStatusActive, awstypes.ManifestStatusDraft)...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"network_interface": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[networkInterfaceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interface_id": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.NetworkInterfaceType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"can_interface": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[canInterfaceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"protocol_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"protocol_version": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"obd_interface": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[obdInterfaceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dtc_request_interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Default:  int64default.StaticInt64(0),
+									},
+									"has_transmission_ecu": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"obd_standard": schema.StringAttribute{
+										Optional: true,
+									},
+									"pid_request_interval_seconds": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Default:  int64default.StaticInt64(0),
+									},
+									"request_message_id": schema.Int64Attribute{
+										Required: true,
+									},
+									"use_extended_ids": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+								},
+							},
+						},
+						"vehicle_middleware": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[vehicleMiddlewareModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									"protocol_name": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.VehicleMiddlewareProtocol](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"signal_decoder": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[signalDecoderModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"fully_qualified_name": schema.StringAttribute{
+							Required: true,
+						},
+						"interface_id": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.SignalDecoderType](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"can_signal": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[canSignalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"factor": schema.Float64Attribute{
+										Required: true,
+									},
+									"is_big_endian": schema.BoolAttribute{
+										Required: true,
+									},
+									"is_signed": schema.BoolAttribute{
+										Required: true,
+									},
+									"length": schema.Int64Attribute{
+										Required: true,
+									},
+									"message_id": schema.Int64Attribute{
+										Required: true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Optional: true,
+									},
+									"offset": schema.Float64Attribute{
+										Required: true,
+									},
+									"start_bit": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+						"obd_signal": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[obdSignalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bit_mask_length": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Default:  int64default.StaticInt64(0),
+									},
+									"bit_right_shift": schema.Int64Attribute{
+										Optional: true,
+										Computed: true,
+										Default:  int64default.StaticInt64(0),
+									},
+									"byte_length": schema.Int64Attribute{
+										Required: true,
+									},
+									"offset": schema.Float64Attribute{
+										Required: true,
+									},
+									"pid": schema.Int64Attribute{
+										Required: true,
+									},
+									"pid_response_length": schema.Int64Attribute{
+										Required: true,
+									},
+									"scaling": schema.Float64Attribute{
+										Required: true,
+									},
+									"service_mode": schema.Int64Attribute{
+										Required: true,
+									},
+									"start_byte": schema.Int64Attribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *decoderManifestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data decoderManifestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	input := &iotfleetwise.CreateDecoderManifestInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	name := data.Name.ValueString()
+	output, err := conn.CreateDecoderManifest(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT FleetWise Decoder Manifest (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	// Decoder manifests are created in DRAFT status.
+	status := awstypes.ManifestStatusDraft
+	if data.Status.ValueEnum() == awstypes.ManifestStatusActive {
+		if err := activateDecoderManifest(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("activating IoT FleetWise Decoder Manifest (%s)", name), err.Error())
+
+			return
+		}
+
+		status = awstypes.ManifestStatusActive
+	}
+	data.Status = fwtypes.StringEnumValue(status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *decoderManifestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data decoderManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findDecoderManifestByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Decoder Manifest (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	networkInterfaces, err := findDecoderManifestNetworkInterfacesByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Decoder Manifest (%s) network interfaces", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, networkInterfaces, &data.NetworkInterfaces)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	signalDecoders, err := findDecoderManifestSignalDecodersByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Decoder Manifest (%s) signal decoders", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, signalDecoders, &data.SignalDecoders)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *decoderManifestResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new decoderManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := new.Name.ValueString()
+
+	if !new.Description.Equal(old.Description) ||
+		!new.NetworkInterfaces.Equal(old.NetworkInterfaces) ||
+		!new.SignalDecoders.Equal(old.SignalDecoders) {
+		input := &iotfleetwise.UpdateDecoderManifestInput{
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        aws.String(name),
+		}
+
+		var oldNetworkInterfaces, newNetworkInterfaces []awstypes.NetworkInterface
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.NetworkInterfaces, &oldNetworkInterfaces)...)
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.NetworkInterfaces, &newNetworkInterfaces)...)
+		var oldSignalDecoders, newSignalDecoders []awstypes.SignalDecoder
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.SignalDecoders, &oldSignalDecoders)...)
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.SignalDecoders, &newSignalDecoders)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.NetworkInterfacesToAdd, input.NetworkInterfacesToRemove, input.NetworkInterfacesToUpdate = diffByKey(oldNetworkInterfaces, newNetworkInterfaces, func(v awstypes.NetworkInterface) string {
+			return aws.ToString(v.InterfaceId)
+		})
+		input.SignalDecodersToAdd, input.SignalDecodersToRemove, input.SignalDecodersToUpdate = diffByKey(oldSignalDecoders, newSignalDecoders, func(v awstypes.SignalDecoder) string {
+			return aws.ToString(v.FullyQualifiedName)
+		})
+
+		_, err := conn.UpdateDecoderManifest(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Decoder Manifest (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Status.IsUnknown() && !new.Status.Equal(old.Status) && new.Status.ValueEnum() == awstypes.ManifestStatusActive {
+		if err := activateDecoderManifest(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("activating IoT FleetWise Decoder Manifest (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if new.Status.IsUnknown() {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *decoderManifestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data decoderManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	_, err := conn.DeleteDecoderManifest(ctx, &iotfleetwise.DeleteDecoderManifestInput{
+		Name: fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT FleetWise Decoder Manifest (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *decoderManifestResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func activateDecoderManifest(ctx context.Context, conn *iotfleetwise.Client, name string, timeout time.Duration) error {
+	input := &iotfleetwise.UpdateDecoderManifestInput{
+		Name:   aws.String(name),
+		Status: awstypes.ManifestStatusActive,
+	}
+
+	if _, err := conn.UpdateDecoderManifest(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitDecoderManifestActive(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for activation: %w", err)
+	}
+
+	return nil
+}
+
+func findDecoderManifestByName(ctx context.Context, conn *iotfleetwise.Client, name string) (*iotfleetwise.GetDecoderManifestOutput, error) {
+	input := &iotfleetwise.GetDecoderManifestInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetDecoderManifest(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findDecoderManifestNetworkInterfacesByName(ctx context.Context, conn *iotfleetwise.Client, name string) ([]awstypes.NetworkInterface, error) {
+	input := &iotfleetwise.ListDecoderManifestNetworkInterfacesInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.NetworkInterface
+
+	pages := iotfleetwise.NewListDecoderManifestNetworkInterfacesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.NetworkInterfaces...)
+	}
+
+	return output, nil
+}
+
+func findDecoderManifestSignalDecodersByName(ctx context.Context, conn *iotfleetwise.Client, name string) ([]awstypes.SignalDecoder, error) {
+	input := &iotfleetwise.ListDecoderManifestSignalsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.SignalDecoder
+
+	pages := iotfleetwise.NewListDecoderManifestSignalsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SignalDecoders...)
+	}
+
+	return output, nil
+}
+
+func statusDecoderManifest(ctx context.Context, conn *iotfleetwise.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDecoderManifestByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDecoderManifestActive(ctx context.Context, conn *iotfleetwise.Client, name string, timeout time.Duration) (*iotfleetwise.GetDecoderManifestOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ManifestStatusDraft, awstypes.ManifestStatusValidating),
+		Target:  enum.Slice(awstypes.ManifestStatusActive),
+		Refresh: statusDecoderManifest(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetDecoderManifestOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// diffByKey returns the elements to add, the keys of the elements to remove and the elements to update.
+func diffByKey[T any](old, new []T, key func(T) string) ([]T, []string, []T) {
+	var add, update []T
+	var remove []string
+
+	oldElems := make(map[string]T)
+	for _, v := range old {
+		oldElems[key(v)] = v
+	}
+
+	newKeys := make(map[string]struct{})
+	for _, v := range new {
+		k := key(v)
+		newKeys[k] = struct{}{}
+
+		if o, ok := oldElems[k]; !ok {
+			add = append(add, v)
+		} else if !reflect.DeepEqual(o, v) {
+			update = append(update, v)
+		}
+	}
+
+	for _, v := range old {
+		if k := key(v); k != "" {
+			if _, ok := newKeys[k]; !ok {
+				remove = append(remove, k)
+			}
+		}
+	}
+
+	return add, remove, update
+}
+
+type decoderManifestResourceModel struct {
+	ARN               types.String                                          `tfsdk:"arn"`
+	Description       types.String                                          `tfsdk:"description"`
+	ID                types.String                                          `tfsdk:"id"`
+	ModelManifestARN  fwtypes.ARN                                           `tfsdk:"model_manifest_arn"`
+	Name              types.String                                          `tfsdk:"name"`
+	NetworkInterfaces fwtypes.SetNestedObjectValueOf[networkInterfaceModel] `tfsdk:"network_interface"`
+	SignalDecoders    fwtypes.SetNestedObjectValueOf[signalDecoderModel]    `tfsdk:"signal_decoder"`
+	Status            fwtypes.StringEnum[awstypes.ManifestStatus]           `tfsdk:"status"`
+	Tags              types.Map                                             `tfsdk:"tags"`
+	TagsAll           types.Map                                             `tfsdk:"tags_all"`
+	Timeouts          timeouts.Value                                        `tfsdk:"timeouts"`
+}
+
+func (data *decoderManifestResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *decoderManifestResourceModel) setID() {
+	data.ID = data.Name
+}
+
+type networkInterfaceModel struct {
+	CanInterface      fwtypes.ListNestedObjectValueOf[canInterfaceModel]      `tfsdk:"can_interface"`
+	InterfaceID       types.String                                            `tfsdk:"interface_id"`
+	ObdInterface      fwtypes.ListNestedObjectValueOf[obdInterfaceModel]      `tfsdk:"obd_interface"`
+	Type              fwtypes.StringEnum[awstypes.NetworkInterfaceType]       `tfsdk:"type"`
+	VehicleMiddleware fwtypes.ListNestedObjectValueOf[vehicleMiddlewareModel] `tfsdk:"vehicle_middleware"`
+}
+
+type canInterfaceModel struct {
+	Name            types.String `tfsdk:"name"`
+	ProtocolName    types.String `tfsdk:"protocol_name"`
+	ProtocolVersion types.String `tfsdk:"protocol_version"`
+}
+
+type obdInterfaceModel struct {
+	DtcRequestIntervalSeconds types.Int64  `tfsdk:"dtc_request_interval_seconds"`
+	HasTransmissionEcu        types.Bool   `tfsdk:"has_transmission_ecu"`
+	Name                      types.String `tfsdk:"name"`
+	ObdStandard               types.String `tfsdk:"obd_standard"`
+	PidRequestIntervalSeconds types.Int64  `tfsdk:"pid_request_interval_seconds"`
+	RequestMessageID          types.Int64  `tfsdk:"request_message_id"`
+	UseExtendedIDs            types.Bool   `tfsdk:"use_extended_ids"`
+}
+
+type vehicleMiddlewareModel struct {
+	Name         types.String                                           `tfsdk:"name"`
+	ProtocolName fwtypes.StringEnum[awstypes.VehicleMiddlewareProtocol] `tfsdk:"protocol_name"`
+}
+
+type signalDecoderModel struct {
+	CanSignal          fwtypes.ListNestedObjectValueOf[canSignalModel] `tfsdk:"can_signal"`
+	FullyQualifiedName types.String                                    `tfsdk:"fully_qualified_name"`
+	InterfaceID        types.String                                    `tfsdk:"interface_id"`
+	ObdSignal          fwtypes.ListNestedObjectValueOf[obdSignalModel] `tfsdk:"obd_signal"`
+	Type               fwtypes.StringEnum[awstypes.SignalDecoderType]  `tfsdk:"type"`
+}
+
+type canSignalModel struct {
+	Factor      types.Float64 `tfsdk:"factor"`
+	IsBigEndian types.Bool    `tfsdk:"is_big_endian"`
+	IsSigned    types.Bool    `tfsdk:"is_signed"`
+	Length      types.Int64   `tfsdk:"length"`
+	MessageID   types.Int64   `tfsdk:"message_id"`
+	Name        types.String  `tfsdk:"name"`
+	Offset      types.Float64 `tfsdk:"offset"`
+	StartBit    types.Int64   `tfsdk:"start_bit"`
+}
+
+type obdSignalModel struct {
+	BitMaskLength     types.Int64   `tfsdk:"bit_mask_length"`
+	BitRightShift     types.Int64   `tfsdk:"bit_right_shift"`
+	ByteLength        types.Int64   `tfsdk:"byte_length"`
+	Offset            types.Float64 `tfsdk:"offset"`
+	Pid               types.Int64   `tfsdk:"pid"`
+	PidResponseLength types.Int64   `tfsdk:"pid_response_length"`
+	Scaling           types.Float64 `tfsdk:"scaling"`
+	ServiceMode       types.Int64   `tfsdk:"service_mode"`
+	StartByte         types.Int64   `tfsdk:"start_byte"`
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccDecoderManifest_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetDecoderManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_decoder_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDecoderManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDecoderManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "decoder-manifest/"+rName),
+					resource.TestCheckResourceAttrPair(resourceName, "model_manifest_arn", "aws_iotfleetwise_model_manifest.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "network_interface.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "network_interface.*", map[string]string{
+						"interface_id":                  acctest.Ct1,
+						names.AttrType:                  "CAN_INTERFACE",
+						"can_interface.#":               acctest.Ct1,
+						"can_interface.0.name":          "can0",
+						"can_interface.0.protocol_name": "CAN",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "signal_decoder.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "signal_decoder.*", map[string]string{
+						"fully_qualified_name":    "Vehicle.Speed",
+						"interface_id":            acctest.Ct1,
+						names.AttrType:            "CAN_SIGNAL",
+						"can_signal.#":            acctest.Ct1,
+						"can_signal.0.message_id": "100",
+						"can_signal.0.start_bit":  "8",
+						"can_signal.0.length":     "16",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccDecoderManifest_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetDecoderManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_decoder_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDecoderManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDecoderManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceDecoderManifest, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccDecoderManifest_activate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetDecoderManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_decoder_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDecoderManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDecoderManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+				),
+			},
+			{
+				Config: testAccDecoderManifestConfig_basic(rName, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDecoderManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDecoderManifestDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_decoder_manifest" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindDecoderManifestByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Decoder Manifest %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDecoderManifestExists(ctx context.Context, n string, v *iotfleetwise.GetDecoderManifestOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		output, err := tfiotfleetwise.FindDecoderManifestByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDecoderManifestConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccModelManifestConfig_basic(rName, "ACTIVE"), fmt.Sprintf(`
+resource "aws_iotfleetwise_decoder_manifest" "test" {
+  name               = %[1]q
+  model_manifest_arn = aws_iotfleetwise_model_manifest.test.arn
+  status             = %[2]q
+
+  network_interface {
+    interface_id = "1"
+    type         = "CAN_INTERFACE"
+
+    can_interface {
+      name          = "can0"
+      protocol_name = "CAN"
+    }
+  }
+
+  signal_decoder {
+    fully_qualified_name = "Vehicle.Speed"
+    interface_id         = "1"
+    type                 = "CAN_SIGNAL"
+
+    can_signal {
+      factor        = 1
+      is_big_endian = true
+      is_signed     = false
+      length        = 16
+      message_id    = 100
+      offset        = 0
+      start_bit     = 8
+    }
+  }
+}
+`, rName, status))
+}
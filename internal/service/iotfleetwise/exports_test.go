@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+// Exports for use in tests only.
+var (
+	ResourceCampaign        = newCampaignResource
+	ResourceDecoderManifest = newDecoderManifestResource
+	ResourceFleet           = newFleetResource
+	ResourceModelManifest   = newModelManifestResource
+	ResourceSignalCatalog   = newSignalCatalogResource
+	ResourceVehicle         = newVehicleResource
+
+	FindCampaignByName        = findCampaignByName
+	FindDecoderManifestByName = findDecoderManifestByName
+	FindFleetByID             = findFleetByID
+	FindModelManifestByName   = findModelManifestByName
+	FindSignalCatalogByName   = findSignalCatalogByName
+	FindVehicleByName         = findVehicleByName
+)
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// Only one signal catalog is allowed per account and Region, so every test that depends on one must run serially.
+func TestAccIoTFleetWise_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"Campaign": {
+			acctest.CtBasic:      testAccCampaign_basic,
+			acctest.CtDisappears: testAccCampaign_disappears,
+			"update":             testAccCampaign_update,
+		},
+		"DecoderManifest": {
+			acctest.CtBasic:      testAccDecoderManifest_basic,
+			acctest.CtDisappears: testAccDecoderManifest_disappears,
+			"activate":           testAccDecoderManifest_activate,
+		},
+		"Fleet": {
+			acctest.CtBasic:      testAccFleet_basic,
+			acctest.CtDisappears: testAccFleet_disappears,
+			"update":             testAccFleet_update,
+		},
+		"ModelManifest": {
+			acctest.CtBasic:      testAccModelManifest_basic,
+			acctest.CtDisappears: testAccModelManifest_disappears,
+			"update":             testAccModelManifest_update,
+		},
+		"SignalCatalog": {
+			acctest.CtBasic:      testAccSignalCatalog_basic,
+			acctest.CtDisappears: testAccSignalCatalog_disappears,
+			"tags":               testAccSignalCatalog_tags,
+			"update":             testAccSignalCatalog_update,
+		},
+		"Vehicle": {
+			acctest.CtBasic:      testAccVehicle_basic,
+			acctest.CtDisappears: testAccVehicle_disappears,
+			"fleets":             testAccVehicle_fleets,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotfleetwise/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Model Manifest")
+// @Tags(identifierAttribute="arn")
+func newModelManifestResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &modelManifestResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type modelManifestResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*modelManifestResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotfleetwise_model_manifest"
+}
+
+func (r *modelManifestResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"nodes": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"signal_catalog_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ManifestStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.ManifestStatusActive, awstypes.ManifestStatusDraft)...),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *modelManifestResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data modelManifestResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	input := &iotfleetwise.CreateModelManifestInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	name := data.Name.ValueString()
+	output, err := conn.CreateModelManifest(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT FleetWise Model Manifest (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	// Model manifests are created in DRAFT status.
+	status := awstypes.ManifestStatusDraft
+	if data.Status.ValueEnum() == awstypes.ManifestStatusActive {
+		if err := activateModelManifest(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("activating IoT FleetWise Model Manifest (%s)", name), err.Error())
+
+			return
+		}
+
+		status = awstypes.ManifestStatusActive
+	}
+	data.Status = fwtypes.StringEnumValue(status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *modelManifestResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data modelManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	output, err := findModelManifestByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Model Manifest (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	nodes, err := findModelManifestNodesByName(ctx, conn, data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Model Manifest (%s) nodes", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Nodes = flattenNodeNames(ctx, nodes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *modelManifestResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new modelManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := new.Name.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.Nodes.Equal(old.Nodes) {
+		input := &iotfleetwise.UpdateModelManifestInput{
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        aws.String(name),
+		}
+
+		oldNodes, newNodes := fwflex.ExpandFrameworkStringValueSet(ctx, old.Nodes), fwflex.ExpandFrameworkStringValueSet(ctx, new.Nodes)
+		input.NodesToAdd, input.NodesToRemove = newNodes.Difference(oldNodes), oldNodes.Difference(newNodes)
+
+		_, err := conn.UpdateModelManifest(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Model Manifest (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Status.IsUnknown() && !new.Status.Equal(old.Status) && new.Status.ValueEnum() == awstypes.ManifestStatusActive {
+		if err := activateModelManifest(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("activating IoT FleetWise Model Manifest (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if new.Status.IsUnknown() {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *modelManifestResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data modelManifestResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	_, err := conn.DeleteModelManifest(ctx, &iotfleetwise.DeleteModelManifestInput{
+		Name: fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT FleetWise Model Manifest (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *modelManifestResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func activateModelManifest(ctx context.Context, conn *iotfleetwise.Client, name string, timeout time.Duration) error {
+	input := &iotfleetwise.UpdateModelManifestInput{
+		Name:   aws.String(name),
+		Status: awstypes.ManifestStatusActive,
+	}
+
+	if _, err := conn.UpdateModelManifest(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitModelManifestActive(ctx, conn, name, timeout); err != nil {
+		return fmt.Errorf("waiting for activation: %w", err)
+	}
+
+	return nil
+}
+
+func findModelManifestByName(ctx context.Context, conn *iotfleetwise.Client, name string) (*iotfleetwise.GetModelManifestOutput, error) {
+	input := &iotfleetwise.GetModelManifestInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetModelManifest(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelManifestNodesByName(ctx context.Context, conn *iotfleetwise.Client, name string) ([]awstypes.Node, error) {
+	input := &iotfleetwise.ListModelManifestNodesInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.Node
+
+	pages := iotfleetwise.NewListModelManifestNodesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Nodes...)
+	}
+
+	return output, nil
+}
+
+func statusModelManifest(ctx context.Context, conn *iotfleetwise.Client, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findModelManifestByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelManifestActive(ctx context.Context, conn *iotfleetwise.Client, name string, timeout time.Duration) (*iotfleetwise.GetModelManifestOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ManifestStatusDraft, awstypes.ManifestStatusValidating),
+		Target:  enum.Slice(awstypes.ManifestStatusActive),
+		Refresh: statusModelManifest(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotfleetwise.GetModelManifestOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type modelManifestResourceModel struct {
+	ARN              types.String                                `tfsdk:"arn"`
+	Description      types.String                                `tfsdk:"description"`
+	ID               types.String                                `tfsdk:"id"`
+	Name             types.String                                `tfsdk:"name"`
+	Nodes            fwtypes.SetValueOf[types.String]            `tfsdk:"nodes"`
+	SignalCatalogARN fwtypes.ARN                                 `tfsdk:"signal_catalog_arn"`
+	Status           fwtypes.StringEnum[awstypes.ManifestStatus] `tfsdk:"status"`
+	Tags             types.Map                                   `tfsdk:"tags"`
+	TagsAll          types.Map                                   `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                              `tfsdk:"timeouts"`
+}
+
+func (data *modelManifestResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *modelManifestResourceModel) setID() {
+	data.ID = data.Name
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccModelManifest_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "model-manifest/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "nodes.*", "Vehicle.Speed"),
+					resource.TestCheckResourceAttrPair(resourceName, "signal_catalog_arn", "aws_iotfleetwise_signal_catalog.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccModelManifest_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceModelManifest, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccModelManifest_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetModelManifestOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_model_manifest.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckModelManifestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccModelManifestConfig_basic(rName, "DRAFT"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DRAFT"),
+				),
+			},
+			{
+				Config: testAccModelManifestConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckModelManifestExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "nodes.*", "Vehicle.Speed"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "nodes.*", "Vehicle.RPM"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckModelManifestDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_model_manifest" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindModelManifestByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Model Manifest %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckModelManifestExists(ctx context.Context, n string, v *iotfleetwise.GetModelManifestOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		output, err := tfiotfleetwise.FindModelManifestByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccModelManifestConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+
+  branch {
+    fully_qualified_name = "Vehicle"
+  }
+
+  attribute {
+    fully_qualified_name = "Vehicle.VIN"
+    data_type            = "STRING"
+  }
+
+  sensor {
+    fully_qualified_name = "Vehicle.Speed"
+    data_type            = "DOUBLE"
+    unit                 = "km/h"
+  }
+
+  sensor {
+    fully_qualified_name = "Vehicle.RPM"
+    data_type            = "DOUBLE"
+    unit                 = "rpm"
+  }
+}
+`, rName)
+}
+
+func testAccModelManifestConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccModelManifestConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_model_manifest" "test" {
+  name               = %[1]q
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+  nodes              = ["Vehicle.Speed"]
+  status             = %[2]q
+}
+`, rName, status))
+}
+
+func testAccModelManifestConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccModelManifestConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_model_manifest" "test" {
+  name               = %[1]q
+  description        = "updated"
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+  nodes              = ["Vehicle.Speed", "Vehicle.RPM"]
+  status             = "ACTIVE"
+}
+`, rName))
+}
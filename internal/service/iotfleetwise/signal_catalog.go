@@ -0,0 +1,544 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotfleetwise/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Signal Catalog")
+// @Tags(identifierAttribute="arn")
+func newSignalCatalogResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &signalCatalogResource{}
+
+	return r, nil
+}
+
+type signalCatalogResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*signalCatalogResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotfleetwise_signal_catalog"
+}
+
+func (r *signalCatalogResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	signalAttributes := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"allowed_values": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"comment": schema.StringAttribute{
+				Optional: true,
+			},
+			"data_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.NodeDataType](),
+				Required:   true,
+			},
+			"deprecation_message": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"fully_qualified_name": schema.StringAttribute{
+				Required: true,
+			},
+			"max": schema.Float64Attribute{
+				Optional: true,
+			},
+			"min": schema.Float64Attribute{
+				Optional: true,
+			},
+			names.AttrUnit: schema.StringAttribute{
+				Optional: true,
+			},
+		}
+	}
+	attributeAttributes := signalAttributes()
+	attributeAttributes[names.AttrDefaultValue] = schema.StringAttribute{
+		Optional: true,
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"actuator": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[signalModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: signalAttributes(),
+				},
+			},
+			"attribute": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[attributeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: attributeAttributes,
+				},
+			},
+			"branch": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[branchModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comment": schema.StringAttribute{
+							Optional: true,
+						},
+						"deprecation_message": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Optional: true,
+						},
+						"fully_qualified_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"sensor": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[signalModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: signalAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *signalCatalogResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data signalCatalogResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &iotfleetwise.CreateSignalCatalogInput{
+		Description: fwflex.StringFromFramework(ctx, data.Description),
+		Name:        aws.String(name),
+		Tags:        getTagsIn(ctx),
+	}
+
+	nodes, diags := data.expandNodes(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Nodes = nodes
+
+	output, err := conn.CreateSignalCatalog(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT FleetWise Signal Catalog (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *signalCatalogResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data signalCatalogResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	output, err := findSignalCatalogByName(ctx, conn, data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Signal Catalog (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.Description = fwflex.StringToFramework(ctx, output.Description)
+
+	nodes, err := findSignalCatalogNodesByName(ctx, conn, data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Signal Catalog (%s) nodes", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenNodes(ctx, nodes)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *signalCatalogResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new signalCatalogResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	if !new.Actuators.Equal(old.Actuators) ||
+		!new.Attributes.Equal(old.Attributes) ||
+		!new.Branches.Equal(old.Branches) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Sensors.Equal(old.Sensors) {
+		input := &iotfleetwise.UpdateSignalCatalogInput{
+			Description: fwflex.StringFromFramework(ctx, new.Description),
+			Name:        fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		oldNodes, diags := old.expandNodes(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newNodes, diags := new.expandNodes(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.NodesToAdd, input.NodesToRemove, input.NodesToUpdate = diffByKey(oldNodes, newNodes, nodeFullyQualifiedName)
+
+		_, err := conn.UpdateSignalCatalog(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Signal Catalog (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *signalCatalogResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data signalCatalogResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	_, err := conn.DeleteSignalCatalog(ctx, &iotfleetwise.DeleteSignalCatalogInput{
+		Name: fwflex.StringFromFramework(ctx, data.Name),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT FleetWise Signal Catalog (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *signalCatalogResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSignalCatalogByName(ctx context.Context, conn *iotfleetwise.Client, name string) (*iotfleetwise.GetSignalCatalogOutput, error) {
+	input := &iotfleetwise.GetSignalCatalogInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.GetSignalCatalog(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findSignalCatalogNodesByName(ctx context.Context, conn *iotfleetwise.Client, name string) ([]awstypes.Node, error) {
+	input := &iotfleetwise.ListSignalCatalogNodesInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.Node
+
+	pages := iotfleetwise.NewListSignalCatalogNodesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Nodes...)
+	}
+
+	return output, nil
+}
+
+type signalCatalogResourceModel struct {
+	Actuators   fwtypes.SetNestedObjectValueOf[signalModel]    `tfsdk:"actuator"`
+	ARN         types.String                                   `tfsdk:"arn"`
+	Attributes  fwtypes.SetNestedObjectValueOf[attributeModel] `tfsdk:"attribute"`
+	Branches    fwtypes.SetNestedObjectValueOf[branchModel]    `tfsdk:"branch"`
+	Description types.String                                   `tfsdk:"description"`
+	ID          types.String                                   `tfsdk:"id"`
+	Name        types.String                                   `tfsdk:"name"`
+	Sensors     fwtypes.SetNestedObjectValueOf[signalModel]    `tfsdk:"sensor"`
+	Tags        types.Map                                      `tfsdk:"tags"`
+	TagsAll     types.Map                                      `tfsdk:"tags_all"`
+}
+
+func (data *signalCatalogResourceModel) InitFromID() error {
+	data.Name = data.ID
+
+	return nil
+}
+
+func (data *signalCatalogResourceModel) setID() {
+	data.ID = data.Name
+}
+
+// We can't use AutoFlEx with the signal catalog nodes because the API structure uses Go interfaces.
+
+func (data *signalCatalogResourceModel) expandNodes(ctx context.Context) ([]awstypes.Node, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []awstypes.Node
+
+	// Parent branches first.
+	v, d := expandNodeSet(ctx, data.Branches, func(apiObject awstypes.Branch) awstypes.Node {
+		return &awstypes.NodeMemberBranch{Value: apiObject}
+	})
+	diags.Append(d...)
+	apiObjects = append(apiObjects, v...)
+
+	v, d = expandNodeSet(ctx, data.Attributes, func(apiObject awstypes.Attribute) awstypes.Node {
+		return &awstypes.NodeMemberAttribute{Value: apiObject}
+	})
+	diags.Append(d...)
+	apiObjects = append(apiObjects, v...)
+
+	v, d = expandNodeSet(ctx, data.Sensors, func(apiObject awstypes.Sensor) awstypes.Node {
+		return &awstypes.NodeMemberSensor{Value: apiObject}
+	})
+	diags.Append(d...)
+	apiObjects = append(apiObjects, v...)
+
+	v, d = expandNodeSet(ctx, data.Actuators, func(apiObject awstypes.Actuator) awstypes.Node {
+		return &awstypes.NodeMemberActuator{Value: apiObject}
+	})
+	diags.Append(d...)
+	apiObjects = append(apiObjects, v...)
+
+	return apiObjects, diags
+}
+
+func (data *signalCatalogResourceModel) flattenNodes(ctx context.Context, apiObjects []awstypes.Node) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	actuators, attributes, branches, sensors := make([]*signalModel, 0), make([]*attributeModel, 0), make([]*branchModel, 0), make([]*signalModel, 0)
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.NodeMemberActuator:
+			var data signalModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &data)...)
+			actuators = append(actuators, &data)
+		case *awstypes.NodeMemberAttribute:
+			var data attributeModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &data)...)
+			attributes = append(attributes, &data)
+		case *awstypes.NodeMemberBranch:
+			var data branchModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &data)...)
+			branches = append(branches, &data)
+		case *awstypes.NodeMemberSensor:
+			var data signalModel
+			diags.Append(fwflex.Flatten(ctx, &v.Value, &data)...)
+			sensors = append(sensors, &data)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	data.Actuators = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, actuators)
+	data.Attributes = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, attributes)
+	data.Branches = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, branches)
+	data.Sensors = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, sensors)
+
+	return diags
+}
+
+func expandNodeSet[T, U any](ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[T], newNode func(U) awstypes.Node) ([]awstypes.Node, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.Node, 0, len(data))
+
+	for _, v := range data {
+		var apiObject U
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObjects = append(apiObjects, newNode(apiObject))
+	}
+
+	return apiObjects, diags
+}
+
+func flattenNodeNames(ctx context.Context, apiObjects []awstypes.Node) fwtypes.SetValueOf[types.String] {
+	elems := make([]attr.Value, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if name := nodeFullyQualifiedName(apiObject); name != "" {
+			elems = append(elems, types.StringValue(name))
+		}
+	}
+
+	return fwtypes.NewSetValueOfMust[types.String](ctx, elems)
+}
+
+func nodeFullyQualifiedName(apiObject awstypes.Node) string {
+	switch v := apiObject.(type) {
+	case *awstypes.NodeMemberActuator:
+		return aws.ToString(v.Value.FullyQualifiedName)
+	case *awstypes.NodeMemberAttribute:
+		return aws.ToString(v.Value.FullyQualifiedName)
+	case *awstypes.NodeMemberBranch:
+		return aws.ToString(v.Value.FullyQualifiedName)
+	case *awstypes.NodeMemberSensor:
+		return aws.ToString(v.Value.FullyQualifiedName)
+	default:
+		return ""
+	}
+}
+
+type branchModel struct {
+	Comment            types.String `tfsdk:"comment"`
+	DeprecationMessage types.String `tfsdk:"deprecation_message"`
+	Description        types.String `tfsdk:"description"`
+	FullyQualifiedName types.String `tfsdk:"fully_qualified_name"`
+}
+
+// signalModel represents a sensor or an actuator.
+type signalModel struct {
+	AllowedValues      fwtypes.ListValueOf[types.String]         `tfsdk:"allowed_values"`
+	Comment            types.String                              `tfsdk:"comment"`
+	DataType           fwtypes.StringEnum[awstypes.NodeDataType] `tfsdk:"data_type"`
+	DeprecationMessage types.String                              `tfsdk:"deprecation_message"`
+	Description        types.String                              `tfsdk:"description"`
+	FullyQualifiedName types.String                              `tfsdk:"fully_qualified_name"`
+	Max                types.Float64                             `tfsdk:"max"`
+	Min                types.Float64                             `tfsdk:"min"`
+	Unit               types.String                              `tfsdk:"unit"`
+}
+
+type attributeModel struct {
+	AllowedValues      fwtypes.ListValueOf[types.String]         `tfsdk:"allowed_values"`
+	Comment            types.String                              `tfsdk:"comment"`
+	DataType           fwtypes.StringEnum[awstypes.NodeDataType] `tfsdk:"data_type"`
+	DefaultValue       types.String                              `tfsdk:"default_value"`
+	DeprecationMessage types.String                              `tfsdk:"deprecation_message"`
+	Description        types.String                              `tfsdk:"description"`
+	FullyQualifiedName types.String                              `tfsdk:"fully_qualified_name"`
+	Max                types.Float64                             `tfsdk:"max"`
+	Min                types.Float64                             `tfsdk:"min"`
+	Unit               types.String                              `tfsdk:"unit"`
+}
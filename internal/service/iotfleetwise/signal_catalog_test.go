@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSignalCatalog_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "signal-catalog/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "actuator.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "branch.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "branch.*", map[string]string{
+						"fully_qualified_name": "Vehicle",
+					}),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "sensor.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sensor.*", map[string]string{
+						"data_type":            "DOUBLE",
+						"fully_qualified_name": "Vehicle.Speed",
+						names.AttrUnit:         "km/h",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceSignalCatalog, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalCatalogConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccSignalCatalogConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignalCatalog_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetSignalCatalogOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_signal_catalog.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalCatalogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalCatalogConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actuator.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "sensor.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccSignalCatalogConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalCatalogExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "actuator.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "actuator.*", map[string]string{
+						"data_type":            "BOOLEAN",
+						"fully_qualified_name": "Vehicle.Horn",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "attribute.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute.*", map[string]string{
+						"data_type":            "STRING",
+						names.AttrDefaultValue: "unknown",
+						"fully_qualified_name": "Vehicle.VIN",
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "sensor.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "sensor.*", map[string]string{
+						"data_type":            "DOUBLE",
+						"fully_qualified_name": "Vehicle.Speed",
+						names.AttrUnit:         "mph",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSignalCatalogDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_signal_catalog" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindSignalCatalogByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Signal Catalog %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSignalCatalogExists(ctx context.Context, n string, v *iotfleetwise.GetSignalCatalogOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		output, err := tfiotfleetwise.FindSignalCatalogByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSignalCatalogConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+
+  branch {
+    fully_qualified_name = "Vehicle"
+  }
+
+  sensor {
+    fully_qualified_name = "Vehicle.Speed"
+    data_type            = "DOUBLE"
+    unit                 = "km/h"
+  }
+}
+`, rName)
+}
+
+func testAccSignalCatalogConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name        = %[1]q
+  description = "updated"
+
+  branch {
+    fully_qualified_name = "Vehicle"
+  }
+
+  actuator {
+    fully_qualified_name = "Vehicle.Horn"
+    data_type            = "BOOLEAN"
+  }
+
+  attribute {
+    fully_qualified_name = "Vehicle.VIN"
+    data_type            = "STRING"
+    default_value        = "unknown"
+  }
+
+  sensor {
+    fully_qualified_name = "Vehicle.Speed"
+    data_type            = "DOUBLE"
+    unit                 = "mph"
+  }
+}
+`, rName)
+}
+
+func testAccSignalCatalogConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+
+  branch {
+    fully_qualified_name = "Vehicle"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSignalCatalogConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotfleetwise_signal_catalog" "test" {
+  name = %[1]q
+
+  branch {
+    fully_qualified_name = "Vehicle"
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
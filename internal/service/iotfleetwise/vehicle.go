@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iotfleetwise/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Vehicle")
+// @Tags(identifierAttribute="arn")
+func newVehicleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vehicleResource{}
+
+	return r, nil
+}
+
+type vehicleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*vehicleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iotfleetwise_vehicle"
+}
+
+func (r *vehicleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"association_behavior": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.VehicleAssociationBehavior](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrAttributes: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"decoder_manifest_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"fleet_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"model_manifest_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_:-]+$`), "must contain only alphanumeric characters, underscores, colons and hyphens"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *vehicleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vehicleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	input := &iotfleetwise.CreateVehicleInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	name := data.VehicleName.ValueString()
+	output, err := conn.CreateVehicle(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IoT FleetWise Vehicle (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	for _, fleetID := range fwflex.ExpandFrameworkStringValueSet(ctx, data.FleetIDs) {
+		if err := associateVehicleFleet(ctx, conn, name, fleetID); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("associating IoT FleetWise Vehicle (%s) with Fleet (%s)", name, fleetID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vehicleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vehicleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	output, err := findVehicleByName(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Vehicle (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	fleetIDs, err := findFleetIDsByVehicleName(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IoT FleetWise Vehicle (%s) fleets", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.FleetIDs = fwflex.FlattenFrameworkStringValueSet(ctx, fleetIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vehicleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new vehicleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	name := new.ID.ValueString()
+
+	if !new.Attributes.Equal(old.Attributes) ||
+		!new.DecoderManifestARN.Equal(old.DecoderManifestARN) ||
+		!new.ModelManifestARN.Equal(old.ModelManifestARN) {
+		input := &iotfleetwise.UpdateVehicleInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.AttributeUpdateMode = awstypes.UpdateModeOverwrite
+
+		// An empty attributes map must be sent explicitly to clear all attributes.
+		if input.Attributes == nil && !old.Attributes.IsNull() {
+			input.Attributes = map[string]string{}
+		}
+
+		_, err := conn.UpdateVehicle(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating IoT FleetWise Vehicle (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	if !new.FleetIDs.Equal(old.FleetIDs) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.FleetIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.FleetIDs)
+
+		for _, fleetID := range o.Difference(n) {
+			input := &iotfleetwise.DisassociateVehicleFleetInput{
+				FleetId:     aws.String(fleetID),
+				VehicleName: aws.String(name),
+			}
+
+			_, err := conn.DisassociateVehicleFleet(ctx, input)
+
+			if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating IoT FleetWise Vehicle (%s) from Fleet (%s)", name, fleetID), err.Error())
+
+				return
+			}
+		}
+
+		for _, fleetID := range n.Difference(o) {
+			if err := associateVehicleFleet(ctx, conn, name, fleetID); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating IoT FleetWise Vehicle (%s) with Fleet (%s)", name, fleetID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *vehicleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vehicleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IoTFleetWiseClient(ctx)
+
+	_, err := conn.DeleteVehicle(ctx, &iotfleetwise.DeleteVehicleInput{
+		VehicleName: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting IoT FleetWise Vehicle (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *vehicleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func associateVehicleFleet(ctx context.Context, conn *iotfleetwise.Client, vehicleName, fleetID string) error {
+	input := &iotfleetwise.AssociateVehicleFleetInput{
+		FleetId:     aws.String(fleetID),
+		VehicleName: aws.String(vehicleName),
+	}
+
+	_, err := conn.AssociateVehicleFleet(ctx, input)
+
+	return err
+}
+
+func findVehicleByName(ctx context.Context, conn *iotfleetwise.Client, name string) (*iotfleetwise.GetVehicleOutput, error) {
+	input := &iotfleetwise.GetVehicleInput{
+		VehicleName: aws.String(name),
+	}
+
+	output, err := conn.GetVehicle(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findFleetIDsByVehicleName(ctx context.Context, conn *iotfleetwise.Client, name string) ([]string, error) {
+	input := &iotfleetwise.ListFleetsForVehicleInput{
+		VehicleName: aws.String(name),
+	}
+	var output []string
+
+	pages := iotfleetwise.NewListFleetsForVehiclePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Fleets...)
+	}
+
+	return output, nil
+}
+
+type vehicleResourceModel struct {
+	ARN                 types.String                                            `tfsdk:"arn"`
+	AssociationBehavior fwtypes.StringEnum[awstypes.VehicleAssociationBehavior] `tfsdk:"association_behavior"`
+	Attributes          fwtypes.MapValueOf[types.String]                        `tfsdk:"attributes"`
+	DecoderManifestARN  fwtypes.ARN                                             `tfsdk:"decoder_manifest_arn"`
+	FleetIDs            types.Set                                               `tfsdk:"fleet_ids"`
+	ID                  types.String                                            `tfsdk:"id"`
+	ModelManifestARN    fwtypes.ARN                                             `tfsdk:"model_manifest_arn"`
+	Tags                types.Map                                               `tfsdk:"tags"`
+	TagsAll             types.Map                                               `tfsdk:"tags_all"`
+	VehicleName         types.String                                            `tfsdk:"name"`
+}
+
+func (data *vehicleResourceModel) InitFromID() error {
+	data.VehicleName = data.ID
+
+	return nil
+}
+
+func (data *vehicleResourceModel) setID() {
+	data.ID = data.VehicleName
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotfleetwise_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/iotfleetwise"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotfleetwise "github.com/hashicorp/terraform-provider-aws/internal/service/iotfleetwise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccVehicle_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotfleetwise", "vehicle/"+rName),
+					resource.TestCheckResourceAttr(resourceName, "association_behavior", "ValidateIotThingExists"),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrAttributes),
+					resource.TestCheckResourceAttrPair(resourceName, "decoder_manifest_arn", "aws_iotfleetwise_decoder_manifest.test", names.AttrARN),
+					resource.TestCheckNoResourceAttr(resourceName, "fleet_ids"),
+					resource.TestCheckResourceAttrPair(resourceName, "model_manifest_arn", "aws_iotfleetwise_model_manifest.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"association_behavior"},
+			},
+		},
+	})
+}
+
+func testAccVehicle_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfiotfleetwise.ResourceVehicle, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccVehicle_fleets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v iotfleetwise.GetVehicleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotfleetwise_vehicle.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.IoTFleetWiseEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTFleetWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVehicleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVehicleConfig_fleets(rName, "aws_iotfleetwise_fleet.test1.fleet_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fleet_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "fleet_ids.*", "aws_iotfleetwise_fleet.test1", "fleet_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"association_behavior"},
+			},
+			{
+				Config: testAccVehicleConfig_fleets(rName, "aws_iotfleetwise_fleet.test1.fleet_id", "aws_iotfleetwise_fleet.test2.fleet_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fleet_ids.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccVehicleConfig_fleets(rName, "aws_iotfleetwise_fleet.test2.fleet_id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVehicleExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "fleet_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "fleet_ids.*", "aws_iotfleetwise_fleet.test2", "fleet_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVehicleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotfleetwise_vehicle" {
+				continue
+			}
+
+			_, err := tfiotfleetwise.FindVehicleByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT FleetWise Vehicle %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckVehicleExists(ctx context.Context, n string, v *iotfleetwise.GetVehicleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTFleetWiseClient(ctx)
+
+		output, err := tfiotfleetwise.FindVehicleByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccVehicleConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccDecoderManifestConfig_basic(rName, "ACTIVE"), fmt.Sprintf(`
+resource "aws_iot_thing" "test" {
+  name = %[1]q
+}
+`, rName))
+}
+
+func testAccVehicleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVehicleConfig_base(rName), `
+resource "aws_iotfleetwise_vehicle" "test" {
+  name                 = aws_iot_thing.test.name
+  association_behavior = "ValidateIotThingExists"
+  decoder_manifest_arn = aws_iotfleetwise_decoder_manifest.test.arn
+  model_manifest_arn   = aws_iotfleetwise_model_manifest.test.arn
+}
+`)
+}
+
+func testAccVehicleConfig_fleets(rName string, fleetIDs ...string) string {
+	return acctest.ConfigCompose(testAccVehicleConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotfleetwise_fleet" "test1" {
+  fleet_id           = "%[1]s-1"
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+}
+
+resource "aws_iotfleetwise_fleet" "test2" {
+  fleet_id           = "%[1]s-2"
+  signal_catalog_arn = aws_iotfleetwise_signal_catalog.test.arn
+}
+
+resource "aws_iotfleetwise_vehicle" "test" {
+  name                 = aws_iot_thing.test.name
+  association_behavior = "ValidateIotThingExists"
+  decoder_manifest_arn = aws_iotfleetwise_decoder_manifest.test.arn
+  model_manifest_arn   = aws_iotfleetwise_model_manifest.test.arn
+
+  fleet_ids = [%[2]s]
+}
+`, rName, strings.Join(fleetIDs, ", ")))
+}
@@ -6,6 +6,7 @@ package ivs
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -13,12 +14,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ivs"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -31,6 +35,7 @@ func ResourceRecordingConfiguration() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRecordingConfigurationCreate,
 		ReadWithoutTimeout:   resourceRecordingConfigurationRead,
+		UpdateWithoutTimeout: resourceRecordingConfigurationUpdate,
 		DeleteWithoutTimeout: resourceRecordingConfigurationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -85,11 +90,37 @@ func ResourceRecordingConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 300),
 			},
+			"rendition_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rendition_selection": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRenditionSelection_Values(), false),
+						},
+						"renditions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.RenditionConfigurationRendition_Values(), false),
+							},
+						},
+					},
+				},
+			},
 			names.AttrState: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrTags:    tftags.TagsSchemaForceNew(),
+			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"thumbnail_configuration": {
 				Type:     schema.TypeList,
@@ -105,6 +136,21 @@ func ResourceRecordingConfiguration() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(ivs.RecordingMode_Values(), false),
 						},
+						"resolution": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationResolution_Values(), false),
+						},
+						"storage": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(ivs.ThumbnailConfigurationStorage_Values(), false),
+							},
+						},
 						"target_interval_seconds": {
 							Type:         schema.TypeInt,
 							Optional:     true,
@@ -116,7 +162,10 @@ func ResourceRecordingConfiguration() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceRecordingConfigurationCustomizeDiff,
+		),
 	}
 }
 
@@ -142,6 +191,10 @@ func resourceRecordingConfigurationCreate(ctx context.Context, d *schema.Resourc
 		in.RecordingReconnectWindowSeconds = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("rendition_configuration"); ok {
+		in.RenditionConfiguration = expandRenditionConfiguration(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("thumbnail_configuration"); ok {
 		in.ThumbnailConfiguration = expandThumbnailConfiguration(v.([]interface{}))
 
@@ -193,6 +246,11 @@ func resourceRecordingConfigurationRead(ctx context.Context, d *schema.ResourceD
 
 	d.Set(names.AttrName, out.Name)
 	d.Set("recording_reconnect_window_seconds", out.RecordingReconnectWindowSeconds)
+
+	if err := d.Set("rendition_configuration", flattenRenditionConfiguration(out.RenditionConfiguration)); err != nil {
+		return create.AppendDiagError(diags, names.IVS, create.ErrActionSetting, ResNameRecordingConfiguration, d.Id(), err)
+	}
+
 	d.Set(names.AttrState, out.State)
 
 	if err := d.Set("thumbnail_configuration", flattenThumbnailConfiguration(out.ThumbnailConfiguration)); err != nil {
@@ -202,6 +260,11 @@ func resourceRecordingConfigurationRead(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceRecordingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceRecordingConfigurationRead(ctx, d, meta)
+}
+
 func resourceRecordingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -228,6 +291,33 @@ func resourceRecordingConfigurationDelete(ctx context.Context, d *schema.Resourc
 	return diags
 }
 
+func resourceRecordingConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Validate against the configuration as both attributes are Optional+Computed.
+	v := d.GetRawConfig().GetAttr("rendition_configuration")
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	v = v.Index(cty.NumberIntVal(0))
+	selection, renditions := v.GetAttr("rendition_selection"), v.GetAttr("renditions")
+	if !selection.IsKnown() || !renditions.IsKnown() {
+		return nil
+	}
+
+	isCustom := !selection.IsNull() && selection.AsString() == ivs.RenditionConfigurationRenditionSelectionCustom
+	hasRenditions := !renditions.IsNull() && renditions.LengthInt() > 0
+
+	if isCustom && !hasRenditions {
+		return fmt.Errorf("rendition_configuration.0.renditions must be set if rendition_selection is %q", ivs.RenditionConfigurationRenditionSelectionCustom)
+	}
+
+	if !isCustom && hasRenditions {
+		return fmt.Errorf("rendition_configuration.0.renditions can only be set if rendition_selection is %q", ivs.RenditionConfigurationRenditionSelectionCustom)
+	}
+
+	return nil
+}
+
 func flattenDestinationConfiguration(apiObject *ivs.DestinationConfiguration) []interface{} {
 	if apiObject == nil {
 		return []interface{}{}
@@ -267,6 +357,14 @@ func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []inte
 		m["recording_mode"] = aws.StringValue(v)
 	}
 
+	if v := apiObject.Resolution; v != nil {
+		m["resolution"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Storage; v != nil {
+		m["storage"] = aws.StringValueSlice(v)
+	}
+
 	if v := apiObject.TargetIntervalSeconds; v != nil {
 		m["target_interval_seconds"] = aws.Int64Value(v)
 	}
@@ -274,6 +372,24 @@ func flattenThumbnailConfiguration(apiObject *ivs.ThumbnailConfiguration) []inte
 	return []interface{}{m}
 }
 
+func flattenRenditionConfiguration(apiObject *ivs.RenditionConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.RenditionSelection; v != nil {
+		m["rendition_selection"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Renditions; v != nil {
+		m["renditions"] = aws.StringValueSlice(v)
+	}
+
+	return []interface{}{m}
+}
+
 func expandDestinationConfiguration(vSettings []interface{}) *ivs.DestinationConfiguration {
 	if len(vSettings) == 0 || vSettings[0] == nil {
 		return nil
@@ -314,9 +430,35 @@ func expandThumbnailConfiguration(vSettings []interface{}) *ivs.ThumbnailConfigu
 		a.RecordingMode = aws.String(v)
 	}
 
+	if v, ok := tfMap["resolution"].(string); ok && v != "" {
+		a.Resolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage"].(*schema.Set); ok && v.Len() > 0 {
+		a.Storage = flex.ExpandStringSet(v)
+	}
+
 	if v, ok := tfMap["target_interval_seconds"].(int); ok {
 		a.TargetIntervalSeconds = aws.Int64(int64(v))
 	}
 
 	return a
 }
+
+func expandRenditionConfiguration(vSettings []interface{}) *ivs.RenditionConfiguration {
+	if len(vSettings) == 0 || vSettings[0] == nil {
+		return nil
+	}
+	a := &ivs.RenditionConfiguration{}
+	tfMap := vSettings[0].(map[string]interface{})
+
+	if v, ok := tfMap["rendition_selection"].(string); ok && v != "" {
+		a.RenditionSelection = aws.String(v)
+	}
+
+	if v, ok := tfMap["renditions"].(*schema.Set); ok && v.Len() > 0 {
+		a.Renditions = flex.ExpandStringSet(v)
+	}
+
+	return a
+}
@@ -168,9 +168,62 @@ func TestAccIVSRecordingConfiguration_disappears_S3Bucket(t *testing.T) {
 	})
 }
 
+func TestAccIVSRecordingConfiguration_renditionAndThumbnailConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 ivs.RecordingConfiguration
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_recording_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ivs.EndpointsID)
+			testAccRecordingConfigurationPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.IVSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordingConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName, "CUSTOM", "", "HD", "SEQUENTIAL"),
+				ExpectError: regexache.MustCompile(`renditions must be set if rendition_selection is "CUSTOM"`),
+			},
+			{
+				Config: testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName, "CUSTOM", `"HD", "SD"`, "HD", "SEQUENTIAL"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "CUSTOM"),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.renditions.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "HD"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rendition_configuration.0.renditions.*", "SD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "thumbnail_configuration.0.storage.*", "SEQUENTIAL"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName, "ALL", "", "FULL_HD", `SEQUENTIAL", "LATEST`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &v2),
+					testAccCheckRecordingConfigurationRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "rendition_configuration.0.rendition_selection", "ALL"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.resolution", "FULL_HD"),
+					resource.TestCheckResourceAttr(resourceName, "thumbnail_configuration.0.storage.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIVSRecordingConfiguration_tags(t *testing.T) {
 	ctx := acctest.Context(t)
-	var recordingConfiguration ivs.RecordingConfiguration
+	var recordingConfiguration, recordingConfigurationUpdated ivs.RecordingConfiguration
 	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_ivs_recording_configuration.test"
 
@@ -200,7 +253,8 @@ func TestAccIVSRecordingConfiguration_tags(t *testing.T) {
 			{
 				Config: testAccRecordingConfigurationConfig_tags2(bucketName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckRecordingConfigurationExists(ctx, resourceName, &recordingConfiguration),
+					testAccCheckRecordingConfigurationExists(ctx, resourceName, &recordingConfigurationUpdated),
+					testAccCheckRecordingConfigurationNotRecreated(&recordingConfiguration, &recordingConfigurationUpdated),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
 					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
@@ -280,6 +334,16 @@ func testAccCheckRecordingConfigurationRecreated(before, after *ivs.RecordingCon
 	}
 }
 
+func testAccCheckRecordingConfigurationNotRecreated(before, after *ivs.RecordingConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return fmt.Errorf("Expected Recording Configuration IDs not to change, %s -> %s", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccRecordingConfigurationPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn(ctx)
 
@@ -353,6 +417,32 @@ resource "aws_ivs_recording_configuration" "test" {
 `, rName, recordingReconnectWindowSeconds, recordingMode, targetIntervalSeconds))
 }
 
+func testAccRecordingConfigurationConfig_renditionAndThumbnail(bucketName, renditionSelection, renditions, resolution, storage string) string {
+	return acctest.ConfigCompose(
+		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
+		fmt.Sprintf(`
+resource "aws_ivs_recording_configuration" "test" {
+  destination_configuration {
+    s3 {
+      bucket_name = aws_s3_bucket.test.id
+    }
+  }
+
+  rendition_configuration {
+    rendition_selection = %[1]q
+    renditions          = [%[2]s]
+  }
+
+  thumbnail_configuration {
+    recording_mode          = "INTERVAL"
+    target_interval_seconds = 30
+    resolution              = %[3]q
+    storage                 = ["%[4]s"]
+  }
+}
+`, renditionSelection, renditions, resolution, storage))
+}
+
 func testAccRecordingConfigurationConfig_tags1(bucketName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		testAccRecordingConfigurationConfig_s3Bucket(bucketName),
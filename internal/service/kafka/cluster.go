@@ -54,6 +54,10 @@ func resourceCluster() *schema.Resource {
 			customdiff.ForceNewIfChange("kafka_version", func(_ context.Context, old, new, meta interface{}) bool {
 				return semver.LessThan(new.(string), old.(string))
 			}),
+			customdiff.ForceNewIfChange("broker_node_group_info.0.instance_type", func(_ context.Context, old, new, meta interface{}) bool {
+				// Clusters can't be converted between Standard and Express brokers.
+				return isExpressBrokerInstanceType(old.(string)) != isExpressBrokerInstanceType(new.(string))
+			}),
 			verify.SetTagsDiff,
 		),
 
@@ -751,6 +755,17 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	// Remove brokers before changing the broker type or storage so that fewer brokers are updated.
+	// Brokers are added after those changes so that the new brokers are launched with the target configuration.
+	o, n := d.GetChange("number_of_broker_nodes")
+	brokerCountDecreased := n.(int) < o.(int)
+
+	if d.HasChange("number_of_broker_nodes") && brokerCountDecreased {
+		if err := updateClusterBrokerCount(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange("broker_node_group_info.0.instance_type") {
 		input := &kafka.UpdateBrokerTypeInput{
 			ClusterArn:         aws.String(d.Id()),
@@ -808,17 +823,17 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("number_of_broker_nodes") {
-		input := &kafka.UpdateBrokerCountInput{
-			ClusterArn:                aws.String(d.Id()),
-			CurrentVersion:            aws.String(d.Get("current_version").(string)),
-			TargetNumberOfBrokerNodes: aws.Int32(int32(d.Get("number_of_broker_nodes").(int))),
+	if d.HasChange("storage_mode") {
+		input := &kafka.UpdateStorageInput{
+			ClusterArn:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get("current_version").(string)),
+			StorageMode:    types.StorageMode(d.Get("storage_mode").(string)),
 		}
 
-		output, err := conn.UpdateBrokerCount(ctx, input)
+		output, err := conn.UpdateStorage(ctx, input)
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating MSK Cluster (%s) broker count: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "updating MSK Cluster (%s) storage mode: %s", d.Id(), err)
 		}
 
 		clusterOperationARN := aws.ToString(output.ClusterOperationArn)
@@ -833,6 +848,12 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("number_of_broker_nodes") && !brokerCountDecreased {
+		if err := updateClusterBrokerCount(ctx, d, meta); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChanges("enhanced_monitoring", "logging_info", "open_monitoring") {
 		input := &kafka.UpdateMonitoringInput{
 			ClusterArn:         aws.String(d.Id()),
@@ -994,6 +1015,31 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func updateClusterBrokerCount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
+
+	input := &kafka.UpdateBrokerCountInput{
+		ClusterArn:                aws.String(d.Id()),
+		CurrentVersion:            aws.String(d.Get("current_version").(string)),
+		TargetNumberOfBrokerNodes: aws.Int32(int32(d.Get("number_of_broker_nodes").(int))),
+	}
+
+	output, err := conn.UpdateBrokerCount(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating MSK Cluster (%s) broker count: %w", d.Id(), err)
+	}
+
+	clusterOperationARN := aws.ToString(output.ClusterOperationArn)
+
+	if _, err := waitClusterOperationCompleted(ctx, conn, clusterOperationARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("waiting for MSK Cluster (%s) operation (%s) complete: %w", d.Id(), clusterOperationARN, err)
+	}
+
+	// refresh the current_version attribute after each update
+	return refreshClusterVersion(ctx, d, meta)
+}
+
 func refreshClusterVersion(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).KafkaClient(ctx)
 
@@ -1136,6 +1182,15 @@ func statusClusterOperationState(ctx context.Context, conn *kafka.Client, arn st
 			return nil, "", err
 		}
 
+		// Report progress through the operation's steps.
+		for i, step := range output.OperationSteps {
+			if step.StepInfo == nil {
+				continue
+			}
+
+			log.Printf("[DEBUG] MSK Cluster operation (%s) step %d/%d (%s): %s", arn, i+1, len(output.OperationSteps), aws.ToString(step.StepName), aws.ToString(step.StepInfo.StepStatus))
+		}
+
 		return output, aws.ToString(output.OperationState), nil
 	}
 }
@@ -1183,6 +1238,8 @@ func waitClusterDeleted(ctx context.Context, conn *kafka.Client, arn string, tim
 }
 
 func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Client, arn string, timeout time.Duration) (*types.ClusterOperationInfo, error) { //nolint:unparam
+	log.Printf("[INFO] Waiting for MSK Cluster operation (%s) to complete", arn)
+
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{clusterOperationStatePending, clusterOperationStateUpdateInProgress},
 		Target:  []string{clusterOperationStateUpdateComplete},
@@ -1203,6 +1260,11 @@ func waitClusterOperationCompleted(ctx context.Context, conn *kafka.Client, arn
 	return nil, err
 }
 
+// isExpressBrokerInstanceType returns whether the specified instance type is an MSK Express broker type.
+func isExpressBrokerInstanceType(instanceType string) bool {
+	return strings.HasPrefix(instanceType, "express.")
+}
+
 func clusterUUIDFromARN(clusterARN string) (string, error) {
 	parsedARN, err := arn.Parse(clusterARN)
 	if err != nil {
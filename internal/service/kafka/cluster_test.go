@@ -980,7 +980,7 @@ func TestAccKafkaCluster_openMonitoring(t *testing.T) {
 
 func TestAccKafkaCluster_storageMode(t *testing.T) {
 	ctx := acctest.Context(t)
-	var cluster types.ClusterInfo
+	var cluster1, cluster2 types.ClusterInfo
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_msk_cluster.test"
 
@@ -991,10 +991,18 @@ func TestAccKafkaCluster_storageMode(t *testing.T) {
 		CheckDestroy:             testAccCheckClusterDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccClusterConfig_storageMode(rName, "TIERED", "2.8.2.tiered"),
+				Config: testAccClusterConfig_storageMode(rName, "LOCAL", "2.8.2.tiered"),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckClusterExists(ctx, resourceName, &cluster),
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "kafka", regexache.MustCompile(`cluster/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode", "LOCAL"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_storageMode(rName, "TIERED", "2.8.2.tiered"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
 					resource.TestCheckResourceAttr(resourceName, "storage_mode", "TIERED"),
 				),
 			},
@@ -1002,6 +1010,55 @@ func TestAccKafkaCluster_storageMode(t *testing.T) {
 	})
 }
 
+func TestAccKafkaCluster_expressBrokers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 types.ClusterInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_msk_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KafkaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_expressBrokers(rName, "express.m7g.large", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "express.m7g.large"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_broker_nodes", acctest.Ct3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"current_version",
+				},
+			},
+			{
+				Config: testAccClusterConfig_expressBrokers(rName, "express.m7g.xlarge", 6),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "broker_node_group_info.0.instance_type", "express.m7g.xlarge"),
+					resource.TestCheckResourceAttr(resourceName, "number_of_broker_nodes", "6"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_expressBrokers(rName, "express.m7g.xlarge", 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "number_of_broker_nodes", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKafkaCluster_loggingInfo(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2 types.ClusterInfo
@@ -1967,6 +2024,22 @@ resource "aws_msk_cluster" "test" {
 `, rName, storageMode, kafkaVersion))
 }
 
+func testAccClusterConfig_expressBrokers(rName, instanceType string, brokerCount int) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_msk_cluster" "test" {
+  cluster_name           = %[1]q
+  kafka_version          = "3.6.0"
+  number_of_broker_nodes = %[3]d
+
+  broker_node_group_info {
+    client_subnets  = aws_subnet.test[*].id
+    instance_type   = %[2]q
+    security_groups = [aws_security_group.test.id]
+  }
+}
+`, rName, instanceType, brokerCount))
+}
+
 func testAccClusterConfig_numberOfBrokerNodes(rName string, brokerCount int) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 resource "aws_msk_cluster" "test" {
@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lightsail_certificate_validation", name="Certificate Validation")
+func ResourceCertificateValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCertificateValidationCreate,
+		ReadWithoutTimeout:   resourceCertificateValidationRead,
+		DeleteWithoutTimeout: resourceCertificateValidationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route53_zone_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"validation_record_fqdns"},
+			},
+			"validation_record_fqdns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCertificateValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	name := d.Get("certificate_name").(string)
+
+	if v, ok := d.GetOk("route53_zone_id"); ok {
+		// Validation records are populated shortly after the certificate is requested.
+		outputRaw, err := tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+			return findCertificateDomainValidationRecords(ctx, conn, name)
+		})
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResCertificate, name, err)
+		}
+
+		records := outputRaw.([]types.ResourceRecord)
+		zoneID := strings.TrimPrefix(v.(string), "/hostedzone/")
+
+		if err := changeCertificateValidationRecords(ctx, meta.(*conns.AWSClient).Route53Client(ctx), zoneID, route53types.ChangeActionUpsert, records); err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionCreating, ResCertificateValidation, name, err)
+		}
+
+		var fqdns []string
+		for _, record := range records {
+			fqdns = append(fqdns, strings.TrimSuffix(aws.ToString(record.Name), "."))
+		}
+		d.Set("validation_record_fqdns", fqdns)
+	} else if v, ok := d.GetOk("validation_record_fqdns"); ok && v.(*schema.Set).Len() > 0 {
+		certificate, err := FindCertificateById(ctx, conn, name)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResCertificate, name, err)
+		}
+
+		fqdns := make(map[string]types.DomainValidationRecord)
+
+		for _, domainValidationRecord := range certificate.DomainValidationRecords {
+			if v := domainValidationRecord.ResourceRecord; v != nil {
+				if v := aws.ToString(v.Name); v != "" {
+					fqdns[strings.TrimSuffix(v, ".")] = domainValidationRecord
+				}
+			}
+		}
+
+		for _, v := range v.(*schema.Set).List() {
+			delete(fqdns, strings.TrimSuffix(v.(string), "."))
+		}
+
+		if len(fqdns) > 0 {
+			var errList []error
+
+			for fqdn, domainValidationRecord := range fqdns {
+				errList = append(errList, fmt.Errorf("missing %s DNS validation record: %s", aws.ToString(domainValidationRecord.DomainName), fqdn))
+			}
+
+			return sdkdiag.AppendFromErr(diags, errors.Join(errList...))
+		}
+	}
+
+	if _, err := waitCertificateIssued(ctx, conn, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lightsail Certificate (%s) to be issued: %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return append(diags, resourceCertificateValidationRead(ctx, d, meta)...)
+}
+
+func resourceCertificateValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	certificate, err := findCertificateValidationByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.Lightsail, create.ErrActionReading, ResCertificateValidation, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResCertificateValidation, d.Id(), err)
+	}
+
+	d.Set("certificate_name", certificate.Name)
+
+	return diags
+}
+
+func resourceCertificateValidationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := d.GetOk("route53_zone_id")
+
+	if !ok {
+		return diags
+	}
+
+	conn := meta.(*conns.AWSClient).LightsailClient(ctx)
+
+	records, err := findCertificateDomainValidationRecords(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionReading, ResCertificate, d.Id(), err)
+	}
+
+	zoneID := strings.TrimPrefix(v.(string), "/hostedzone/")
+
+	if err := changeCertificateValidationRecords(ctx, meta.(*conns.AWSClient).Route53Client(ctx), zoneID, route53types.ChangeActionDelete, records); err != nil {
+		return create.AppendDiagError(diags, names.Lightsail, create.ErrActionDeleting, ResCertificateValidation, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findCertificateValidationByName(ctx context.Context, conn *lightsail.Client, name string) (*types.Certificate, error) {
+	output, err := FindCertificateById(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status != types.CertificateStatusIssued {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: name,
+		}
+	}
+
+	return output, nil
+}
+
+// findCertificateDomainValidationRecords returns the unique DNS validation records for a certificate.
+// Subject alternative names such as wildcards can share a validation record with the domain name.
+func findCertificateDomainValidationRecords(ctx context.Context, conn *lightsail.Client, name string) ([]types.ResourceRecord, error) {
+	certificate, err := FindCertificateById(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.ResourceRecord
+	seen := make(map[string]bool)
+
+	for _, domainValidationRecord := range certificate.DomainValidationRecords {
+		record := domainValidationRecord.ResourceRecord
+
+		if record == nil || aws.ToString(record.Name) == "" {
+			continue
+		}
+
+		if key := strings.ToLower(strings.TrimSuffix(aws.ToString(record.Name), ".")); !seen[key] {
+			seen[key] = true
+			records = append(records, *record)
+		}
+	}
+
+	if len(records) == 0 {
+		return nil, &retry.NotFoundError{
+			Message:     "no DNS validation records",
+			LastRequest: name,
+		}
+	}
+
+	return records, nil
+}
+
+func changeCertificateValidationRecords(ctx context.Context, conn *route53.Client, zoneID string, action route53types.ChangeAction, records []types.ResourceRecord) error {
+	input := &route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53types.ChangeBatch{
+			Comment: aws.String("Managed by Terraform"),
+		},
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	for _, record := range records {
+		input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, route53types.Change{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: record.Name,
+				ResourceRecords: []route53types.ResourceRecord{{
+					Value: record.Value,
+				}},
+				TTL:  aws.Int64(60),
+				Type: route53types.RRType(aws.ToString(record.Type)),
+			},
+		})
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+	if action == route53types.ChangeActionDelete && errs.IsAErrorMessageContains[*route53types.InvalidChangeBatch](err, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("changing Route 53 Hosted Zone (%s) records: %w", zoneID, err)
+	}
+
+	if output.ChangeInfo != nil {
+		if err := waitRoute53ChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+			return fmt.Errorf("waiting for Route 53 Hosted Zone (%s) records synchronize: %w", zoneID, err)
+		}
+	}
+
+	return nil
+}
+
+func waitRoute53ChangeInsync(ctx context.Context, conn *route53.Client, id string) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(route53types.ChangeStatusPending),
+		Target:  enum.Slice(route53types.ChangeStatusInsync),
+		Refresh: func() (interface{}, string, error) {
+			output, err := conn.GetChange(ctx, &route53.GetChangeInput{
+				Id: aws.String(id),
+			})
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			return output.ChangeInfo, string(output.ChangeInfo.Status), nil
+		},
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 15 * time.Second,
+		Timeout:      30 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lightsail_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflightsail "github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLightsailCertificateValidation_route53ZoneID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lightsail_certificate_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateValidationConfig_route53ZoneID(rName, rootDomain, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateValidationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_name", "aws_lightsail_certificate.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "route53_zone_id", "data.aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "validation_record_fqdns.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLightsailCertificateValidation_validationRecordFQDNs(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainName := acctest.ACMCertificateRandomSubDomain(acctest.RandomDomainName())
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateValidationConfig_validationRecordFQDNs(rName, domainName),
+				ExpectError: regexache.MustCompile(`timeout while waiting for state to become 'ISSUED'|missing .+ DNS validation record: .+`),
+			},
+		},
+	})
+}
+
+func testAccCheckCertificateValidationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Certificate Validation ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LightsailClient(ctx)
+
+		certificate, err := tflightsail.FindCertificateById(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if status := string(certificate.Status); status != "ISSUED" {
+			return fmt.Errorf("Certificate %q has status %s, expected ISSUED", rs.Primary.ID, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCertificateValidationConfig_route53ZoneID(rName, rootDomain, domainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[2]q
+  private_zone = false
+}
+
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[3]q
+}
+
+resource "aws_lightsail_certificate_validation" "test" {
+  certificate_name = aws_lightsail_certificate.test.name
+  route53_zone_id  = data.aws_route53_zone.test.zone_id
+}
+`, rName, rootDomain, domainName)
+}
+
+func testAccCertificateValidationConfig_validationRecordFQDNs(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_certificate" "test" {
+  name        = %[1]q
+  domain_name = %[2]q
+}
+
+resource "aws_lightsail_certificate_validation" "test" {
+  certificate_name        = aws_lightsail_certificate.test.name
+  validation_record_fqdns = ["wrong.%[2]s"]
+
+  timeouts {
+    create = "1m"
+  }
+}
+`, rName, domainName)
+}
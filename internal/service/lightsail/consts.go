@@ -8,6 +8,7 @@ const (
 	ResBucketAccessKey                    = "Bucket Access Key"
 	ResBucketResourceAccess               = "Bucket Resource Access"
 	ResCertificate                        = "Certificate"
+	ResCertificateValidation              = "Certificate Validation"
 	ResDatabase                           = "Database"
 	ResDisk                               = "Disk"
 	ResDiskAttachment                     = "Disk Attachment"
@@ -88,6 +88,7 @@ func ResourceDistribution() *schema.Resource {
 			"cache_behavior_settings": {
 				Type:        schema.TypeList,
 				Optional:    true,
+				Computed:    true,
 				MaxItems:    1,
 				Description: "An object that describes the cache behavior settings of the distribution.",
 				Elem: &schema.Resource{
@@ -471,12 +472,18 @@ func resourceDistributionUpdate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	if d.HasChanges("cache_behavior_settings") {
-		in.CacheBehaviorSettings = expandCacheSettings(d.Get("cache_behavior_settings").([]interface{})[0].(map[string]interface{}))
-		update = true
+		if v, ok := d.GetOk("cache_behavior_settings"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			in.CacheBehaviorSettings = expandCacheSettings(v.([]interface{})[0].(map[string]interface{}))
+			update = true
+		}
 	}
 
 	if d.HasChanges("cache_behavior") {
+		// An empty list removes all per-path cache behaviors; nil leaves them unchanged.
 		in.CacheBehaviors = expandCacheBehaviorsPerPath(d.Get("cache_behavior").(*schema.Set).List())
+		if in.CacheBehaviors == nil {
+			in.CacheBehaviors = []types.CacheBehaviorPerPath{}
+		}
 		update = true
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lightsail/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -164,6 +165,7 @@ func testAccDistribution_cacheBehavior(t *testing.T) {
 	path1 := "/path1"
 	behaviorCache := "cache"
 	path2 := "/path2"
+	behaviorDontCache := "dont-cache"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -195,7 +197,12 @@ func testAccDistribution_cacheBehavior(t *testing.T) {
 				ImportStateVerify: true,
 			},
 			{
-				Config: testAccDistributionConfig_cacheBehavior2(rName, bucketName, path1, behaviorCache, path2, behaviorCache),
+				Config: testAccDistributionConfig_cacheBehavior2(rName, bucketName, path1, behaviorCache, path2, behaviorDontCache),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDistributionExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "cache_behavior.#", acctest.Ct2),
@@ -209,10 +216,22 @@ func testAccDistribution_cacheBehavior(t *testing.T) {
 						names.AttrPath: path2,
 					}),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "cache_behavior.*", map[string]string{
-						"behavior": behaviorCache,
+						"behavior": behaviorDontCache,
 					}),
 				),
 			},
+			{
+				Config: testAccDistributionConfig_basic(rName, bucketName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cache_behavior.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
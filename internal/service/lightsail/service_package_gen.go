@@ -52,6 +52,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Certificate",
 			},
 		},
+		{
+			Factory:  ResourceCertificateValidation,
+			TypeName: "aws_lightsail_certificate_validation",
+			Name:     "Certificate Validation",
+		},
 		{
 			Factory:  ResourceContainerService,
 			TypeName: "aws_lightsail_container_service",
@@ -59,6 +59,31 @@ func waitOperation(ctx context.Context, conn *lightsail.Client, oid *string) err
 	return err
 }
 
+// waitCertificateIssued waits for a Certificate to be validated and issued
+func waitCertificateIssued(ctx context.Context, conn *lightsail.Client, name string, timeout time.Duration) (*types.Certificate, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.CertificateStatusPendingValidation),
+		Target:  enum.Slice(types.CertificateStatusIssued),
+		Refresh: statusCertificate(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Certificate); ok {
+		switch output.Status {
+		case types.CertificateStatusFailed:
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.RequestFailureReason)))
+		case types.CertificateStatusRevoked:
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.RevocationReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 // waitDatabaseModified waits for a Modified Database return available
 func waitDatabaseModified(ctx context.Context, conn *lightsail.Client, db *string) (*lightsail.GetRelationalDatabaseOutput, error) {
 	stateConf := &retry.StateChangeConf{
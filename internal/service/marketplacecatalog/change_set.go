@@ -0,0 +1,527 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/marketplacecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/marketplacecatalog/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Change Set")
+// @Tags(identifierAttribute="arn")
+func newChangeSetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &changeSetResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type changeSetResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*changeSetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_marketplacecatalog_change_set"
+}
+
+func (r *changeSetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"catalog": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(catalogAWSMarketplace),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"change_set_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"change_set_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"change_summary": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[changeSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[changeSummaryModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failure_code": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FailureCode](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"failure_description": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"intent": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Intent](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChangeStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"change": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[changeModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(20),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"change_name": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 72),
+							},
+						},
+						"change_type": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						"details": schema.StringAttribute{
+							CustomType: jsontypes.NormalizedType{},
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"entity": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[entityModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrIdentifier: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+									names.AttrType: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 255),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *changeSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data changeSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MarketplaceCatalogClient(ctx)
+
+	input := &marketplacecatalog.StartChangeSetInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ChangeSetTags = getTagsIn(ctx)
+	input.ClientRequestToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.StartChangeSet(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Marketplace Catalog Change Set (%s)", data.ChangeSetName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ChangeSetID = fwflex.StringToFramework(ctx, output.ChangeSetId)
+	data.setID()
+
+	changeSet, err := waitChangeSetSucceeded(ctx, conn, data.Catalog.ValueString(), data.ChangeSetID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Marketplace Catalog Change Set (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, changeSet)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *changeSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data changeSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().MarketplaceCatalogClient(ctx)
+
+	output, err := findChangeSetByTwoPartKey(ctx, conn, data.Catalog.ValueString(), data.ChangeSetID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Marketplace Catalog Change Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *changeSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new changeSetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Change sets are immutable. Only tags (handled by the transparent tagging interceptor) and timeouts can be updated.
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *changeSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data changeSetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Completed change sets are retained by the catalog and can't be deleted.
+	// Only change sets that are still in progress are cancelled.
+	if status := data.Status.ValueEnum(); status != awstypes.ChangeStatusPreparing && status != awstypes.ChangeStatusApplying {
+		return
+	}
+
+	conn := r.Meta().MarketplaceCatalogClient(ctx)
+
+	tflog.Debug(ctx, "cancelling Marketplace Catalog Change Set", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	_, err := conn.CancelChangeSet(ctx, &marketplacecatalog.CancelChangeSetInput{
+		Catalog:     fwflex.StringFromFramework(ctx, data.Catalog),
+		ChangeSetId: fwflex.StringFromFramework(ctx, data.ChangeSetID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Marketplace Catalog Change Set (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitChangeSetCancelled(ctx, conn, data.Catalog.ValueString(), data.ChangeSetID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Marketplace Catalog Change Set (%s) cancel", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *changeSetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findChangeSetByTwoPartKey(ctx context.Context, conn *marketplacecatalog.Client, catalog, changeSetID string) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	input := &marketplacecatalog.DescribeChangeSetInput{
+		Catalog:     aws.String(catalog),
+		ChangeSetId: aws.String(changeSetID),
+	}
+
+	output, err := conn.DescribeChangeSet(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusChangeSet(ctx context.Context, conn *marketplacecatalog.Client, catalog, changeSetID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findChangeSetByTwoPartKey(ctx, conn, catalog, changeSetID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitChangeSetSucceeded(ctx context.Context, conn *marketplacecatalog.Client, catalog, changeSetID string, timeout time.Duration) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ChangeStatusPreparing, awstypes.ChangeStatusApplying),
+		Target:     enum.Slice(awstypes.ChangeStatusSucceeded),
+		Refresh:    statusChangeSet(ctx, conn, catalog, changeSetID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*marketplacecatalog.DescribeChangeSetOutput); ok {
+		tfresource.SetLastError(err, changeSetError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChangeSetCancelled(ctx context.Context, conn *marketplacecatalog.Client, catalog, changeSetID string, timeout time.Duration) (*marketplacecatalog.DescribeChangeSetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ChangeStatusPreparing, awstypes.ChangeStatusApplying),
+		Target:     enum.Slice(awstypes.ChangeStatusCancelled),
+		Refresh:    statusChangeSet(ctx, conn, catalog, changeSetID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*marketplacecatalog.DescribeChangeSetOutput); ok {
+		tfresource.SetLastError(err, changeSetError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// changeSetError returns the failure reason and any per-change errors reported for a change set.
+func changeSetError(output *marketplacecatalog.DescribeChangeSetOutput) error {
+	var failures []error
+
+	if output.FailureCode != "" {
+		failures = append(failures, fmt.Errorf("%s: %s", output.FailureCode, aws.ToString(output.FailureDescription)))
+	}
+
+	for _, change := range output.ChangeSet {
+		for _, v := range change.ErrorDetailList {
+			failures = append(failures, fmt.Errorf("%s (%s): %s", aws.ToString(change.ChangeName), aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+type changeSetResourceModel struct {
+	Catalog            types.String                                        `tfsdk:"catalog"`
+	ChangeSet          fwtypes.ListNestedObjectValueOf[changeModel]        `tfsdk:"change"`
+	ChangeSetARN       types.String                                        `tfsdk:"arn"`
+	ChangeSetID        types.String                                        `tfsdk:"change_set_id"`
+	ChangeSetName      types.String                                        `tfsdk:"change_set_name"`
+	ChangeSummaries    fwtypes.ListNestedObjectValueOf[changeSummaryModel] `tfsdk:"change_summary"`
+	EndTime            types.String                                        `tfsdk:"end_time"`
+	FailureCode        fwtypes.StringEnum[awstypes.FailureCode]            `tfsdk:"failure_code"`
+	FailureDescription types.String                                        `tfsdk:"failure_description"`
+	ID                 types.String                                        `tfsdk:"id"`
+	Intent             fwtypes.StringEnum[awstypes.Intent]                 `tfsdk:"intent"`
+	StartTime          types.String                                        `tfsdk:"start_time"`
+	Status             fwtypes.StringEnum[awstypes.ChangeStatus]           `tfsdk:"status"`
+	Tags               types.Map                                           `tfsdk:"tags"`
+	TagsAll            types.Map                                           `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+const (
+	changeSetResourceIDPartCount = 2
+)
+
+func (data *changeSetResourceModel) InitFromID() error {
+	id := data.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, changeSetResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.Catalog = types.StringValue(parts[0])
+	data.ChangeSetID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *changeSetResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.Catalog.ValueString(), data.ChangeSetID.ValueString()}, changeSetResourceIDPartCount, false)))
+}
+
+// flatten sets the model's computed values from the API's view of the change set.
+// The configured changes are not refreshed as the API may return details in a different form.
+func (data *changeSetResourceModel) flatten(ctx context.Context, output *marketplacecatalog.DescribeChangeSetOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data, func(o *fwflex.AutoFlexOptions) {
+		o.AddIgnoredField("ChangeSet")
+	})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(fwflex.Flatten(ctx, output.ChangeSet, &data.ChangeSummaries)...)
+
+	return diags
+}
+
+type changeModel struct {
+	ChangeName types.String                                 `tfsdk:"change_name"`
+	ChangeType types.String                                 `tfsdk:"change_type"`
+	Details    jsontypes.Normalized                         `tfsdk:"details"`
+	Entity     fwtypes.ListNestedObjectValueOf[entityModel] `tfsdk:"entity"`
+}
+
+type entityModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+	Type       types.String `tfsdk:"type"`
+}
+
+type changeSummaryModel struct {
+	ChangeName types.String                                 `tfsdk:"change_name"`
+	ChangeType types.String                                 `tfsdk:"change_type"`
+	Entity     fwtypes.ListNestedObjectValueOf[entityModel] `tfsdk:"entity"`
+}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/marketplacecatalog/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmarketplacecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/marketplacecatalog"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Change sets can only be started by a registered AWS Marketplace seller
+// against a product owned by that seller.
+const envVarSaaSProductID = "MARKETPLACECATALOG_SAAS_PRODUCT_ID"
+
+func TestAccMarketplaceCatalogChangeSet_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, envVarSaaSProductID)
+	var v awstypes.ChangeStatus
+	resourceName := "aws_marketplacecatalog_change_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChangeSetConfig_basic(rName, productID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChangeSetExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "aws-marketplace", regexache.MustCompile(`AWSMarketplace/ChangeSet/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "catalog", "AWSMarketplace"),
+					resource.TestCheckResourceAttr(resourceName, "change.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "change_set_id"),
+					resource.TestCheckResourceAttr(resourceName, "change_set_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "change_summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "change_summary.0.change_type", "UpdateInformation"),
+					resource.TestCheckResourceAttr(resourceName, "intent", "APPLY"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change"},
+			},
+		},
+	})
+}
+
+func TestAccMarketplaceCatalogChangeSet_validate(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, envVarSaaSProductID)
+	var v awstypes.ChangeStatus
+	resourceName := "aws_marketplacecatalog_change_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChangeSetConfig_intent(rName, productID, "VALIDATE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChangeSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "intent", "VALIDATE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMarketplaceCatalogChangeSet_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, envVarSaaSProductID)
+	var v awstypes.ChangeStatus
+	resourceName := "aws_marketplacecatalog_change_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChangeSetConfig_tags1(rName, productID, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChangeSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccChangeSetConfig_tags1(rName, productID, acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckChangeSetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChangeSetExists(ctx context.Context, n string, v *awstypes.ChangeStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MarketplaceCatalogClient(ctx)
+
+		output, err := tfmarketplacecatalog.FindChangeSetByTwoPartKey(ctx, conn, rs.Primary.Attributes["catalog"], rs.Primary.Attributes["change_set_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = output.Status
+
+		return nil
+	}
+}
+
+func testAccChangeSetConfig_basic(rName, productID string) string {
+	return fmt.Sprintf(`
+resource "aws_marketplacecatalog_change_set" "test" {
+  change_set_name = %[1]q
+
+  change {
+    change_type = "UpdateInformation"
+
+    entity {
+      identifier = "%[2]s@1"
+      type       = "SaaSProduct@1.0"
+    }
+
+    details = jsonencode({
+      ShortDescription = %[1]q
+    })
+  }
+}
+`, rName, productID)
+}
+
+func testAccChangeSetConfig_intent(rName, productID, intent string) string {
+	return fmt.Sprintf(`
+resource "aws_marketplacecatalog_change_set" "test" {
+  change_set_name = %[1]q
+  intent          = %[3]q
+
+  change {
+    change_type = "UpdateInformation"
+
+    entity {
+      identifier = "%[2]s@1"
+      type       = "SaaSProduct@1.0"
+    }
+
+    details = jsonencode({
+      ShortDescription = %[1]q
+    })
+  }
+}
+`, rName, productID, intent)
+}
+
+func testAccChangeSetConfig_tags1(rName, productID, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_marketplacecatalog_change_set" "test" {
+  change_set_name = %[1]q
+
+  change {
+    change_type = "UpdateInformation"
+
+    entity {
+      identifier = "%[2]s@1"
+      type       = "SaaSProduct@1.0"
+    }
+
+    details = jsonencode({
+      ShortDescription = %[1]q
+    })
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, productID, tagKey1, tagValue1)
+}
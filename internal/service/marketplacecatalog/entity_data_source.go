@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/marketplacecatalog"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/marketplacecatalog/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Entity")
+func newEntityDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &entityDataSource{}, nil
+}
+
+type entityDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*entityDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_marketplacecatalog_entity"
+}
+
+func (d *entityDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"catalog": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"details": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Computed:   true,
+			},
+			"entity_id": schema.StringAttribute{
+				Required: true,
+			},
+			"entity_identifier": schema.StringAttribute{
+				Computed: true,
+			},
+			"entity_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"last_modified_date": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *entityDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data entityDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Catalog.IsNull() {
+		data.Catalog = types.StringValue(catalogAWSMarketplace)
+	}
+
+	conn := d.Meta().MarketplaceCatalogClient(ctx)
+
+	output, err := findEntityByTwoPartKey(ctx, conn, data.Catalog.ValueString(), data.EntityID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Marketplace Catalog Entity (%s)", data.EntityID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findEntityByTwoPartKey(ctx context.Context, conn *marketplacecatalog.Client, catalog, entityID string) (*marketplacecatalog.DescribeEntityOutput, error) {
+	input := &marketplacecatalog.DescribeEntityInput{
+		Catalog:  aws.String(catalog),
+		EntityId: aws.String(entityID),
+	}
+
+	output, err := conn.DescribeEntity(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type entityDataSourceModel struct {
+	Catalog          types.String         `tfsdk:"catalog"`
+	Details          jsontypes.Normalized `tfsdk:"details"`
+	EntityARN        types.String         `tfsdk:"arn"`
+	EntityID         types.String         `tfsdk:"entity_id"`
+	EntityIdentifier types.String         `tfsdk:"entity_identifier"`
+	EntityType       types.String         `tfsdk:"entity_type"`
+	LastModifiedDate types.String         `tfsdk:"last_modified_date"`
+}
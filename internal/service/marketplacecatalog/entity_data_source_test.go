@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package marketplacecatalog_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMarketplaceCatalogEntityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	productID := acctest.SkipIfEnvVarNotSet(t, envVarSaaSProductID)
+	dataSourceName := "data.aws_marketplacecatalog_entity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckRegion(t, names.USEast1RegionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MarketplaceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityDataSourceConfig_basic(productID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "catalog", "AWSMarketplace"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "details"),
+					resource.TestCheckResourceAttr(dataSourceName, "entity_id", productID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "entity_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "entity_type", "SaaSProduct@1.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_modified_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntityDataSourceConfig_basic(productID string) string {
+	return fmt.Sprintf(`
+data "aws_marketplacecatalog_entity" "test" {
+  entity_id = %[1]q
+}
+`, productID)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	providerCapabilityKindDataSource        = "data_source"
+	providerCapabilityKindEphemeralResource = "ephemeral_resource"
+	providerCapabilityKindResource          = "resource"
+)
+
+// @FrameworkDataSource
+func newDataSourceProviderCapabilities(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceProviderCapabilities{}
+
+	return d, nil
+}
+
+type dataSourceProviderCapabilities struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceProviderCapabilities) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_provider_capabilities"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceProviderCapabilities) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arguments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"kind": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(providerCapabilityKindDataSource, providerCapabilityKindEphemeralResource, providerCapabilityKindResource),
+				},
+			},
+			"provider_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"supported": schema.BoolAttribute{
+				Computed: true,
+			},
+			"supported_arguments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"type_name": schema.StringAttribute{
+				Required: true,
+			},
+			"type_supported": schema.BoolAttribute{
+				Computed: true,
+			},
+			"unsupported_arguments": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceProviderCapabilities) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceProviderCapabilitiesData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Kind.IsNull() {
+		data.Kind = types.StringValue(providerCapabilityKindResource)
+	}
+
+	kind, typeName := data.Kind.ValueString(), data.TypeName.ValueString()
+	hasArgument, typeSupported := d.findTypeName(ctx, kind, typeName)
+
+	var supportedArguments, unsupportedArguments []string
+	for _, v := range flex.ExpandFrameworkStringValueSet(ctx, data.Arguments) {
+		if typeSupported && hasArgument(strings.Split(v, ".")) {
+			supportedArguments = append(supportedArguments, v)
+		} else {
+			unsupportedArguments = append(unsupportedArguments, v)
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", kind, typeName))
+	data.ProviderVersion = types.StringValue(version.ProviderVersion)
+	data.Supported = types.BoolValue(typeSupported && len(unsupportedArguments) == 0)
+	data.SupportedArguments = flex.FlattenFrameworkStringValueSetLegacy(ctx, supportedArguments)
+	data.TypeSupported = types.BoolValue(typeSupported)
+	data.UnsupportedArguments = flex.FlattenFrameworkStringValueSetLegacy(ctx, unsupportedArguments)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findTypeName looks up the named resource, data source or ephemeral resource type in the service packages registered with the running provider.
+// If found, a function reporting whether an argument (as a path of attribute or block names) is present in the type's schema is returned.
+func (d *dataSourceProviderCapabilities) findTypeName(ctx context.Context, kind, typeName string) (func([]string) bool, bool) {
+	providerCapabilitiesIndex.once.Do(func() {
+		providerCapabilitiesIndex.types = newProviderCapabilitiesIndex(ctx, d.Meta().ServicePackages)
+	})
+
+	hasPath, ok := providerCapabilitiesIndex.types[kind][typeName]
+
+	return hasPath, ok
+}
+
+// providerCapabilitiesIndex maps kind and type name to a function reporting whether an argument is present in the type's schema.
+// The registered service packages don't change while the provider is running, so the index is built once, on first use.
+var providerCapabilitiesIndex struct {
+	once  sync.Once
+	types map[string]map[string]func([]string) bool
+}
+
+func newProviderCapabilitiesIndex(ctx context.Context, servicePackages map[string]conns.ServicePackage) map[string]map[string]func([]string) bool {
+	index := map[string]map[string]func([]string) bool{
+		providerCapabilityKindDataSource:        make(map[string]func([]string) bool),
+		providerCapabilityKindEphemeralResource: make(map[string]func([]string) bool),
+		providerCapabilityKindResource:          make(map[string]func([]string) bool),
+	}
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.SDKDataSources(ctx) {
+			s := v.Factory().Schema
+			index[providerCapabilityKindDataSource][v.TypeName] = func(path []string) bool { return sdkSchemaHasPath(s, path) }
+		}
+
+		for _, v := range sp.FrameworkDataSources(ctx) {
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			metadataResponse := datasource.MetadataResponse{}
+			inner.Metadata(ctx, datasource.MetadataRequest{}, &metadataResponse)
+
+			schemaResponse := datasource.SchemaResponse{}
+			inner.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+
+			t := schemaResponse.Schema.Type()
+			index[providerCapabilityKindDataSource][metadataResponse.TypeName] = func(path []string) bool { return frameworkTypeHasPath(t, path) }
+		}
+
+		if sp, ok := sp.(conns.ServicePackageWithEphemeralResources); ok {
+			for _, v := range sp.EphemeralResources(ctx) {
+				inner, err := v.Factory(ctx)
+				if err != nil {
+					continue
+				}
+
+				metadataResponse := ephemeral.MetadataResponse{}
+				inner.Metadata(ctx, ephemeral.MetadataRequest{}, &metadataResponse)
+
+				schemaResponse := ephemeral.SchemaResponse{}
+				inner.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+
+				t := schemaResponse.Schema.Type()
+				index[providerCapabilityKindEphemeralResource][metadataResponse.TypeName] = func(path []string) bool { return frameworkTypeHasPath(t, path) }
+			}
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			s := v.Factory().Schema
+			index[providerCapabilityKindResource][v.TypeName] = func(path []string) bool { return sdkSchemaHasPath(s, path) }
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			inner, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			metadataResponse := resource.MetadataResponse{}
+			inner.Metadata(ctx, resource.MetadataRequest{}, &metadataResponse)
+
+			schemaResponse := resource.SchemaResponse{}
+			inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+			t := schemaResponse.Schema.Type()
+			index[providerCapabilityKindResource][metadataResponse.TypeName] = func(path []string) bool { return frameworkTypeHasPath(t, path) }
+		}
+	}
+
+	return index
+}
+
+func sdkSchemaHasPath(s map[string]*sdkschema.Schema, path []string) bool {
+	for i, name := range path {
+		v, ok := s[name]
+		if !ok {
+			return false
+		}
+
+		if i == len(path)-1 {
+			return true
+		}
+
+		elem, ok := v.Elem.(*sdkschema.Resource)
+		if !ok {
+			return false
+		}
+
+		s = elem.Schema
+	}
+
+	return false
+}
+
+func frameworkTypeHasPath(t attr.Type, path []string) bool {
+	for len(path) > 0 {
+		switch v := t.(type) {
+		case attr.TypeWithAttributeTypes:
+			next, ok := v.AttributeTypes()[path[0]]
+			if !ok {
+				return false
+			}
+
+			t, path = next, path[1:]
+		case attr.TypeWithElementType:
+			// Step through list, set and map element types (nested blocks and nested attributes).
+			t = v.ElementType()
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+type dataSourceProviderCapabilitiesData struct {
+	Arguments            types.Set    `tfsdk:"arguments"`
+	ID                   types.String `tfsdk:"id"`
+	Kind                 types.String `tfsdk:"kind"`
+	ProviderVersion      types.String `tfsdk:"provider_version"`
+	Supported            types.Bool   `tfsdk:"supported"`
+	SupportedArguments   types.Set    `tfsdk:"supported_arguments"`
+	TypeName             types.String `tfsdk:"type_name"`
+	TypeSupported        types.Bool   `tfsdk:"type_supported"`
+	UnsupportedArguments types.Set    `tfsdk:"unsupported_arguments"`
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaProviderCapabilitiesDataSource_sdkResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCapabilitiesDataSourceConfig_basic("resource", "aws_ivs_recording_configuration", `"name", "rendition_configuration.rendition_selection", "thumbnail_configuration.resolution"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "resource/aws_ivs_recording_configuration"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "provider_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "supported", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "supported_arguments.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "type_supported", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_arguments.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderCapabilitiesDataSource_frameworkResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCapabilitiesDataSourceConfig_basic("resource", "aws_verifiedpermissions_policy", `"definition.static.statement", "definition.not_an_argument"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "supported", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "supported_arguments.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "supported_arguments.*", "definition.static.statement"),
+					resource.TestCheckResourceAttr(dataSourceName, "type_supported", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_arguments.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "unsupported_arguments.*", "definition.not_an_argument"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderCapabilitiesDataSource_dataSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCapabilitiesDataSourceConfig_basic("data_source", "aws_partition", `"dns_suffix"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "supported", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "type_supported", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderCapabilitiesDataSource_ephemeralResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCapabilitiesDataSourceConfig_basic("ephemeral_resource", "aws_s3_presigned_url", `"expires_in"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "supported", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "type_supported", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderCapabilitiesDataSource_unsupportedType(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_capabilities.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderCapabilitiesDataSourceConfig_basic("resource", "aws_not_a_resource", `"name"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "supported", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "supported_arguments.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "type_supported", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_arguments.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderCapabilitiesDataSourceConfig_basic(kind, typeName, arguments string) string {
+	return fmt.Sprintf(`
+data "aws_provider_capabilities" "test" {
+  kind      = %[1]q
+  type_name = %[2]q
+  arguments = [%[3]s]
+}
+`, kind, typeName, arguments)
+}
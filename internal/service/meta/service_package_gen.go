@@ -28,6 +28,9 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourcePartition,
 		},
+		{
+			Factory: newDataSourceProviderCapabilities,
+		},
 		{
 			Factory: newDataSourceRegion,
 		},
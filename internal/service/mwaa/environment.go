@@ -180,12 +180,24 @@ func ResourceEnvironment() *schema.Resource {
 					},
 				},
 			},
+			"max_webservers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
 			"max_workers": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			"min_webservers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 5),
+			},
 			"min_workers": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -285,6 +297,11 @@ func ResourceEnvironment() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"worker_replacement_strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.WorkerReplacementStrategy](),
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -348,11 +365,19 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.LoggingConfiguration = expandEnvironmentLoggingConfiguration(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("max_webservers"); ok {
+		input.MaxWebservers = aws.Int32(int32(v.(int)))
+	}
+
 	// input.MaxWorkers = aws.Int32(int32(90))
 	if v, ok := d.GetOk("max_workers"); ok {
 		input.MaxWorkers = aws.Int32(int32(v.(int)))
 	}
 
+	if v, ok := d.GetOk("min_webservers"); ok {
+		input.MinWebservers = aws.Int32(int32(v.(int)))
+	}
+
 	if v, ok := d.GetOk("min_workers"); ok {
 		input.MinWorkers = aws.Int32(int32(v.(int)))
 	}
@@ -449,7 +474,9 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	if err := d.Set(names.AttrLoggingConfiguration, flattenLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
 	}
+	d.Set("max_webservers", environment.MaxWebservers)
 	d.Set("max_workers", environment.MaxWorkers)
+	d.Set("min_webservers", environment.MinWebservers)
 	d.Set("min_workers", environment.MinWorkers)
 	d.Set(names.AttrName, environment.Name)
 	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
@@ -480,7 +507,8 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	conn := meta.(*conns.AWSClient).MWAAClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	// worker_replacement_strategy only controls how other changes are applied.
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "worker_replacement_strategy") {
 		input := &mwaa.UpdateEnvironmentInput{
 			Name: aws.String(d.Get(names.AttrName).(string)),
 		}
@@ -514,10 +542,18 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.LoggingConfiguration = expandEnvironmentLoggingConfiguration(d.Get(names.AttrLoggingConfiguration).([]interface{}))
 		}
 
+		if d.HasChange("max_webservers") {
+			input.MaxWebservers = aws.Int32(int32(d.Get("max_webservers").(int)))
+		}
+
 		if d.HasChange("max_workers") {
 			input.MaxWorkers = aws.Int32(int32(d.Get("max_workers").(int)))
 		}
 
+		if d.HasChange("min_webservers") {
+			input.MinWebservers = aws.Int32(int32(d.Get("min_webservers").(int)))
+		}
+
 		if d.HasChange("min_workers") {
 			input.MinWorkers = aws.Int32(int32(d.Get("min_workers").(int)))
 		}
@@ -566,6 +602,10 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.WeeklyMaintenanceWindowStart = aws.String(d.Get("weekly_maintenance_window_start").(string))
 		}
 
+		if v, ok := d.GetOk("worker_replacement_strategy"); ok {
+			input.WorkerReplacementStrategy = awstypes.WorkerReplacementStrategy(v.(string))
+		}
+
 		_, err := conn.UpdateEnvironment(ctx, input)
 
 		if err != nil {
@@ -62,7 +62,9 @@ func TestAccMWAAEnvironment_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.log_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "max_webservers", acctest.Ct2),
 					resource.TestCheckResourceAttr(resourceName, "max_workers", acctest.Ct10),
+					resource.TestCheckResourceAttr(resourceName, "min_webservers", acctest.Ct2),
 					resource.TestCheckResourceAttr(resourceName, "min_workers", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", acctest.Ct1),
@@ -289,7 +291,9 @@ func TestAccMWAAEnvironment_full(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "logging_configuration.0.worker_logs.0.cloud_watch_log_group_arn"),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.enabled", acctest.CtTrue),
 					resource.TestCheckResourceAttr(resourceName, "logging_configuration.0.worker_logs.0.log_level", "WARNING"),
+					resource.TestCheckResourceAttr(resourceName, "max_webservers", "5"),
 					resource.TestCheckResourceAttr(resourceName, "max_workers", "20"),
+					resource.TestCheckResourceAttr(resourceName, "min_webservers", acctest.Ct3),
 					resource.TestCheckResourceAttr(resourceName, "min_workers", "15"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, "network_configuration.#", acctest.Ct1),
@@ -787,9 +791,11 @@ resource "aws_mwaa_environment" "test" {
     }
   }
 
-  max_workers = 20
-  min_workers = 15
-  name        = %[1]q
+  max_webservers = 5
+  max_workers    = 20
+  min_webservers = 3
+  min_workers    = 15
+  name           = %[1]q
 
   network_configuration {
     security_group_ids = [aws_security_group.test.id]
@@ -142,7 +142,8 @@ func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "unsupported Network Manager Attachment type: %s", attachmentType)
 	}
 
-	if state == networkmanager.AttachmentStatePendingAttachmentAcceptance || state == networkmanager.AttachmentStatePendingTagAcceptance {
+	switch state {
+	case networkmanager.AttachmentStatePendingAttachmentAcceptance, networkmanager.AttachmentStatePendingTagAcceptance:
 		input := &networkmanager.AcceptAttachmentInput{
 			AttachmentId: aws.String(attachmentID),
 		}
@@ -153,6 +154,11 @@ func resourceAttachmentAccepterCreate(ctx context.Context, d *schema.ResourceDat
 			return sdkdiag.AppendErrorf(diags, "accepting Network Manager Attachment (%s): %s", attachmentID, err)
 		}
 
+		fallthrough
+
+	// An attachment that has already been accepted is not usable until the core network has applied
+	// the policy version that associates it with its segment or network function group.
+	case networkmanager.AttachmentStateCreating, networkmanager.AttachmentStatePendingNetworkUpdate:
 		switch attachmentType {
 		case networkmanager.AttachmentTypeVpc:
 			if _, err := waitVPCAttachmentAvailable(ctx, conn, attachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
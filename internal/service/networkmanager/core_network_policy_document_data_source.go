@@ -101,6 +101,12 @@ func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"add_to_network_function_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]{0,63}$`),
+											"must begin with a letter and contain only alphanumeric characters"),
+									},
 									"association_method": {
 										Type:     schema.TypeString,
 										Required: true,
@@ -186,6 +192,28 @@ func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"network_function_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]{0,63}$`),
+								"must begin with a letter and contain only alphanumeric characters"),
+						},
+						"require_attachment_acceptance": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"segments": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -256,6 +284,8 @@ func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								"share",
 								"create-route",
+								"send-via",
+								"send-to",
 							}, false),
 						},
 
@@ -289,6 +319,8 @@ func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 							Optional: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"attachment-route",
+								"single-hop",
+								"dual-hop",
 							}, false),
 						},
 						"segment": {
@@ -299,6 +331,50 @@ func DataSourceCoreNetworkPolicyDocument() *schema.Resource {
 						},
 						"share_with":        setOfString,
 						"share_with_except": setOfString,
+						"via": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"network_function_groups": setOfString,
+									"with_edge_override": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"edge_sets": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeList,
+														Elem: &schema.Schema{
+															Type:         schema.TypeString,
+															ValidateFunc: verify.ValidRegionName,
+														},
+													},
+												},
+												"use_edge": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidRegionName,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"when_sent_to": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"segments": setOfString,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -334,6 +410,13 @@ func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.Reso
 	}
 	mergedDoc.AttachmentPolicies = attachmentPolicies
 
+	// NetworkFunctionGroups
+	networkFunctionGroups, err := expandDataCoreNetworkPolicyNetworkFunctionGroups(d.Get("network_function_groups").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Network Manager Core Network Policy Document: %s", err)
+	}
+	mergedDoc.NetworkFunctionGroups = networkFunctionGroups
+
 	// SegmentActions
 	segment_actions, err := expandDataCoreNetworkPolicySegmentActions(d.Get("segment_actions").([]interface{}))
 	if err != nil {
@@ -348,6 +431,10 @@ func dataSourceCoreNetworkPolicyDocumentRead(ctx context.Context, d *schema.Reso
 	}
 	mergedDoc.Segments = segments
 
+	if err := validateDataCoreNetworkPolicyNetworkFunctionGroupReferences(mergedDoc); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Network Manager Core Network Policy Document: %s", err)
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -394,6 +481,49 @@ func expandDataCoreNetworkPolicySegmentActions(cfgSegmentActionsIntf []interface
 			}
 		}
 
+		if action == "share" {
+			if mode := cfgSA[names.AttrMode].(string); mode != "" && mode != "attachment-route" {
+				return nil, fmt.Errorf("\"mode\" must be \"attachment-route\" if action = \"share\". See segment_actions[%s].", strconv.Itoa(i))
+			}
+		}
+
+		if action == "send-via" || action == "send-to" {
+			via, err := expandDataCoreNetworkPolicySegmentActionVia(cfgSA["via"].([]interface{}))
+			if err != nil {
+				return nil, fmt.Errorf("%s. See segment_actions[%s].", err, strconv.Itoa(i))
+			}
+			sgmtAction.Via = via
+
+			if v := cfgSA["when_sent_to"].([]interface{}); len(v) > 0 && v[0] != nil {
+				if segments := v[0].(map[string]interface{})["segments"].(*schema.Set).List(); len(segments) > 0 {
+					sgmtAction.WhenSentTo = &CoreNetworkPolicySegmentActionWhenSentTo{
+						Segments: CoreNetworkPolicyDecodeConfigStringList(segments),
+					}
+				}
+			}
+
+			mode := cfgSA[names.AttrMode].(string)
+			switch action {
+			case "send-via":
+				if mode != "single-hop" && mode != "dual-hop" {
+					return nil, fmt.Errorf("\"mode\" must be \"single-hop\" or \"dual-hop\" if action = \"send-via\". See segment_actions[%s].", strconv.Itoa(i))
+				}
+				if sgmtAction.WhenSentTo == nil {
+					return nil, fmt.Errorf("You must specify \"when_sent_to\" if action = \"send-via\". See segment_actions[%s].", strconv.Itoa(i))
+				}
+				sgmtAction.Mode = mode
+			case "send-to":
+				if mode != "" {
+					return nil, fmt.Errorf("Cannot specify \"mode\" if action = \"send-to\". See segment_actions[%s].", strconv.Itoa(i))
+				}
+				if sgmtAction.Via.WithEdgeOverrides != nil {
+					return nil, fmt.Errorf("Cannot specify \"with_edge_override\" if action = \"send-to\". See segment_actions[%s].", strconv.Itoa(i))
+				}
+			}
+		} else if len(cfgSA["via"].([]interface{})) > 0 || len(cfgSA["when_sent_to"].([]interface{})) > 0 {
+			return nil, fmt.Errorf("Cannot specify \"via\" or \"when_sent_to\" unless action = \"send-via\" or \"send-to\". See segment_actions[%s].", strconv.Itoa(i))
+		}
+
 		if action == "create-route" {
 			if mode := cfgSA[names.AttrMode]; mode != "" {
 				return nil, fmt.Errorf("Cannot specify \"mode\" if action = \"create-route\". See segment_actions[%s].", strconv.Itoa(i))
@@ -417,6 +547,46 @@ func expandDataCoreNetworkPolicySegmentActions(cfgSegmentActionsIntf []interface
 	return sgmtActions, nil
 }
 
+func expandDataCoreNetworkPolicySegmentActionVia(tfList []interface{}) (*CoreNetworkPolicySegmentActionVia, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, fmt.Errorf("You must specify \"via\" with at least one network function group")
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	via := &CoreNetworkPolicySegmentActionVia{}
+
+	nfgs := tfMap["network_function_groups"].(*schema.Set).List()
+	if len(nfgs) == 0 {
+		return nil, fmt.Errorf("You must specify \"via\" with at least one network function group")
+	}
+	via.NetworkFunctionGroups = CoreNetworkPolicyDecodeConfigStringList(nfgs)
+
+	for _, tfMapRaw := range tfMap["with_edge_override"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		override := &CoreNetworkPolicySegmentActionViaEdgeOverride{}
+
+		for _, edgeSetRaw := range tfMap["edge_sets"].([]interface{}) {
+			edgeSet, ok := edgeSetRaw.([]interface{})
+			if !ok || len(edgeSet) == 0 {
+				continue
+			}
+			override.EdgeSets = append(override.EdgeSets, CoreNetworkPolicyDecodeConfigStringList(edgeSet).([]string))
+		}
+
+		if v := tfMap["use_edge"].(string); v != "" {
+			override.UseEdge = v
+		}
+
+		via.WithEdgeOverrides = append(via.WithEdgeOverrides, override)
+	}
+
+	return via, nil
+}
+
 func expandDataCoreNetworkPolicyAttachmentPolicies(cfgAttachmentPolicyIntf []interface{}) ([]*CoreNetworkAttachmentPolicy, error) {
 	aPolicies := make([]*CoreNetworkAttachmentPolicy, len(cfgAttachmentPolicyIntf))
 	ruleMap := make(map[string]struct{})
@@ -538,12 +708,77 @@ func expandDataCoreNetworkPolicyAttachmentPoliciesAction(tfList []interface{}) (
 		}
 		aP.TagValueOfKey = tag.(string)
 	}
+	if nfg := cfgAP["add_to_network_function_group"]; nfg != "" {
+		if aP.Segment != "" {
+			return nil, fmt.Errorf("Cannot set both \"segment\" and \"add_to_network_function_group\" arguments.")
+		}
+		aP.AddToNetworkFunctionGroup = nfg.(string)
+	}
 	if acceptance, ok := cfgAP["require_acceptance"]; ok {
 		aP.RequireAcceptance = acceptance.(bool)
 	}
 	return aP, nil
 }
 
+func expandDataCoreNetworkPolicyNetworkFunctionGroups(tfList []interface{}) ([]*CoreNetworkPolicyNetworkFunctionGroup, error) {
+	nfgs := make([]*CoreNetworkPolicyNetworkFunctionGroup, len(tfList))
+	nameMap := make(map[string]struct{})
+
+	for i, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		nfg := &CoreNetworkPolicyNetworkFunctionGroup{}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := nameMap[name]; ok {
+			return nil, fmt.Errorf("duplicate Network Function Group Name (%s). Remove the Name or ensure the Name is unique.", name)
+		}
+		nfg.Name = name
+		nameMap[name] = struct{}{}
+
+		if description, ok := tfMap[names.AttrDescription]; ok {
+			nfg.Description = description.(string)
+		}
+		if b, ok := tfMap["require_attachment_acceptance"]; ok {
+			nfg.RequireAttachmentAcceptance = b.(bool)
+		}
+
+		nfgs[i] = nfg
+	}
+
+	return nfgs, nil
+}
+
+// validateDataCoreNetworkPolicyNetworkFunctionGroupReferences ensures that every network function group referenced
+// by an attachment policy or segment action is declared in the policy document.
+func validateDataCoreNetworkPolicyNetworkFunctionGroupReferences(doc *CoreNetworkPolicyDoc) error {
+	nfgs := make(map[string]struct{}, len(doc.NetworkFunctionGroups))
+	for _, nfg := range doc.NetworkFunctionGroups {
+		nfgs[nfg.Name] = struct{}{}
+	}
+
+	for i, policy := range doc.AttachmentPolicies {
+		if v := policy.Action.AddToNetworkFunctionGroup; v != "" {
+			if _, ok := nfgs[v]; !ok {
+				return fmt.Errorf("Network Function Group (%s) is not defined in \"network_function_groups\". See attachment_policies[%s].action.", v, strconv.Itoa(i))
+			}
+		}
+	}
+
+	for i, action := range doc.SegmentActions {
+		if action.Via == nil {
+			continue
+		}
+
+		for _, v := range action.Via.NetworkFunctionGroups.([]string) {
+			if _, ok := nfgs[v]; !ok {
+				return fmt.Errorf("Network Function Group (%s) is not defined in \"network_function_groups\". See segment_actions[%s].via.", v, strconv.Itoa(i))
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandDataCoreNetworkPolicySegments(cfgSgmtIntf []interface{}) ([]*CoreNetworkPolicySegment, error) {
 	Sgmts := make([]*CoreNetworkPolicySegment, len(cfgSgmtIntf))
 	nameMap := make(map[string]struct{})
@@ -4,8 +4,10 @@
 package networkmanager_test
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -33,6 +35,29 @@ func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_basic(t *testing.T
 	})
 }
 
+func TestAccNetworkManagerCoreNetworkPolicyDocumentDataSource_serviceInsertion(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertion("InspectionVpcs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_networkmanager_core_network_policy_document.test", names.AttrJSON,
+						testAccPolicyDocumentServiceInsertionExpectedJSON(),
+					),
+				),
+			},
+			{
+				Config:      testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertion("UndefinedGroup"),
+				ExpectError: regexache.MustCompile(`Network Function Group \(UndefinedGroup\) is not defined`),
+			},
+		},
+	})
+}
+
 // lintignore:AWSAT003
 var testAccCoreNetworkPolicyDocumentDataSourceConfig_basic = `
 data "aws_networkmanager_core_network_policy_document" "test" {
@@ -513,3 +538,172 @@ func testAccPolicyDocumentExpectedJSON() string {
   ]
 }`
 }
+
+// lintignore:AWSAT003
+func testAccCoreNetworkPolicyDocumentDataSourceConfig_serviceInsertion(networkFunctionGroup string) string {
+	return fmt.Sprintf(`
+data "aws_networkmanager_core_network_policy_document" "test" {
+  core_network_configuration {
+    asn_ranges = ["64512-65534"]
+
+    edge_locations {
+      location = "us-east-1"
+    }
+
+    edge_locations {
+      location = "us-west-2"
+    }
+  }
+
+  segments {
+    name                          = "development"
+    require_attachment_acceptance = false
+  }
+
+  segments {
+    name                          = "production"
+    require_attachment_acceptance = false
+  }
+
+  network_function_groups {
+    name                          = "InspectionVpcs"
+    require_attachment_acceptance = true
+  }
+
+  attachment_policies {
+    rule_number     = 125
+    condition_logic = "and"
+
+    conditions {
+      type = "tag-exists"
+      key  = "InspectionVpcs"
+    }
+
+    action {
+      association_method            = "constant"
+      add_to_network_function_group = %[1]q
+    }
+  }
+
+  segment_actions {
+    action  = "send-via"
+    segment = "development"
+    mode    = "single-hop"
+
+    when_sent_to {
+      segments = ["production"]
+    }
+
+    via {
+      network_function_groups = [%[1]q]
+
+      with_edge_override {
+        edge_sets = [["us-east-1", "us-west-2"]]
+        use_edge  = "us-east-1"
+      }
+    }
+  }
+
+  segment_actions {
+    action  = "send-to"
+    segment = "production"
+
+    via {
+      network_function_groups = [%[1]q]
+    }
+  }
+}
+`, networkFunctionGroup)
+}
+
+// lintignore:AWSAT003
+func testAccPolicyDocumentServiceInsertionExpectedJSON() string {
+	return `{
+  "version": "2021.12",
+  "core-network-configuration": {
+    "asn-ranges": [
+      "64512-65534"
+    ],
+    "vpn-ecmp-support": true,
+    "edge-locations": [
+      {
+        "location": "us-east-1"
+      },
+      {
+        "location": "us-west-2"
+      }
+    ]
+  },
+  "segments": [
+    {
+      "name": "development",
+      "isolate-attachments": false,
+      "require-attachment-acceptance": false
+    },
+    {
+      "name": "production",
+      "isolate-attachments": false,
+      "require-attachment-acceptance": false
+    }
+  ],
+  "attachment-policies": [
+    {
+      "rule-number": 125,
+      "action": {
+        "association-method": "constant",
+        "add-to-network-function-group": "InspectionVpcs"
+      },
+      "conditions": [
+        {
+          "type": "tag-exists",
+          "key": "InspectionVpcs"
+        }
+      ],
+      "condition-logic": "and"
+    }
+  ],
+  "segment-actions": [
+    {
+      "action": "send-via",
+      "mode": "single-hop",
+      "segment": "development",
+      "via": {
+        "network-function-groups": [
+          "InspectionVpcs"
+        ],
+        "with-edge-overrides": [
+          {
+            "edge-sets": [
+              [
+                "us-west-2",
+                "us-east-1"
+              ]
+            ],
+            "use-edge": "us-east-1"
+          }
+        ]
+      },
+      "when-sent-to": {
+        "segments": [
+          "production"
+        ]
+      }
+    },
+    {
+      "action": "send-to",
+      "segment": "production",
+      "via": {
+        "network-function-groups": [
+          "InspectionVpcs"
+        ]
+      }
+    }
+  ],
+  "network-function-groups": [
+    {
+      "name": "InspectionVpcs",
+      "require-attachment-acceptance": true
+    }
+  ]
+}`
+}
@@ -14,16 +14,33 @@ type CoreNetworkPolicyDoc struct {
 	Segments                 []*CoreNetworkPolicySegment                `json:"segments"`
 	AttachmentPolicies       []*CoreNetworkAttachmentPolicy             `json:"attachment-policies,omitempty"`
 	SegmentActions           []*CoreNetworkPolicySegmentAction          `json:"segment-actions,omitempty"`
+	NetworkFunctionGroups    []*CoreNetworkPolicyNetworkFunctionGroup   `json:"network-function-groups,omitempty"`
 }
 
 type CoreNetworkPolicySegmentAction struct {
-	Action                string      `json:"action"`
-	Destinations          interface{} `json:"destinations,omitempty"`
-	DestinationCidrBlocks interface{} `json:"destination-cidr-blocks,omitempty"`
-	Mode                  string      `json:"mode,omitempty"`
-	Segment               string      `json:"segment,omitempty"`
-	ShareWith             interface{} `json:"share-with,omitempty"`
-	ShareWithExcept       interface{} `json:",omitempty"`
+	Action                string                                    `json:"action"`
+	Destinations          interface{}                               `json:"destinations,omitempty"`
+	DestinationCidrBlocks interface{}                               `json:"destination-cidr-blocks,omitempty"`
+	Mode                  string                                    `json:"mode,omitempty"`
+	Segment               string                                    `json:"segment,omitempty"`
+	ShareWith             interface{}                               `json:"share-with,omitempty"`
+	ShareWithExcept       interface{}                               `json:",omitempty"`
+	Via                   *CoreNetworkPolicySegmentActionVia        `json:"via,omitempty"`
+	WhenSentTo            *CoreNetworkPolicySegmentActionWhenSentTo `json:"when-sent-to,omitempty"`
+}
+
+type CoreNetworkPolicySegmentActionVia struct {
+	NetworkFunctionGroups interface{}                                      `json:"network-function-groups,omitempty"`
+	WithEdgeOverrides     []*CoreNetworkPolicySegmentActionViaEdgeOverride `json:"with-edge-overrides,omitempty"`
+}
+
+type CoreNetworkPolicySegmentActionViaEdgeOverride struct {
+	EdgeSets [][]string `json:"edge-sets,omitempty"`
+	UseEdge  string     `json:"use-edge,omitempty"`
+}
+
+type CoreNetworkPolicySegmentActionWhenSentTo struct {
+	Segments interface{} `json:"segments,omitempty"`
 }
 
 type CoreNetworkAttachmentPolicy struct {
@@ -39,6 +56,8 @@ type CoreNetworkAttachmentPolicyAction struct {
 	Segment           string `json:"segment,omitempty"`
 	TagValueOfKey     string `json:"tag-value-of-key,omitempty"`
 	RequireAcceptance bool   `json:"require-acceptance,omitempty"`
+	// AddToNetworkFunctionGroup is mutually exclusive with Segment.
+	AddToNetworkFunctionGroup string `json:"add-to-network-function-group,omitempty"`
 }
 
 type CoreNetworkAttachmentPolicyCondition struct {
@@ -58,6 +77,12 @@ type CoreNetworkPolicySegment struct {
 	RequireAttachmentAcceptance bool        `json:"require-attachment-acceptance"`
 }
 
+type CoreNetworkPolicyNetworkFunctionGroup struct {
+	Name                        string `json:"name"`
+	Description                 string `json:"description,omitempty"`
+	RequireAttachmentAcceptance bool   `json:"require-attachment-acceptance"`
+}
+
 type CoreNetworkPolicyCoreNetworkConfiguration struct {
 	AsnRanges        interface{}                `json:"asn-ranges"`
 	VpnEcmpSupport   bool                       `json:"vpn-ecmp-support"`
@@ -97,6 +122,8 @@ func (c CoreNetworkPolicySegmentAction) MarshalJSON() ([]byte, error) {
 		DestinationCidrBlocks: c.DestinationCidrBlocks,
 		Segment:               c.Segment,
 		ShareWith:             share,
+		Via:                   c.Via,
+		WhenSentTo:            c.WhenSentTo,
 	})
 }
 
@@ -8,6 +8,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/pipes/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -18,6 +20,14 @@ func logConfigurationSchema() *schema.Schema {
 		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
+				"include_execution_data": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: enum.Validate[types.IncludeExecutionDataOption](),
+					},
+				},
 				"level": {
 					Type:             schema.TypeString,
 					Required:         true,
@@ -30,8 +40,9 @@ func logConfigurationSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"log_group_arn": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
 							},
 						},
 					},
@@ -43,8 +54,9 @@ func logConfigurationSchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"delivery_stream_arn": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidARN,
 							},
 						},
 					},
@@ -60,8 +72,9 @@ func logConfigurationSchema() *schema.Schema {
 								Required: true,
 							},
 							"bucket_owner": {
-								Type:     schema.TypeString,
-								Required: true,
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: verify.ValidAccountID,
 							},
 							"output_format": {
 								Type:             schema.TypeString,
@@ -87,6 +100,10 @@ func expandPipeLogConfigurationParameters(tfMap map[string]interface{}) *types.P
 
 	apiObject := &types.PipeLogConfigurationParameters{}
 
+	if v, ok := tfMap["include_execution_data"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IncludeExecutionData = flex.ExpandStringyValueSet[types.IncludeExecutionDataOption](v)
+	}
+
 	if v, ok := tfMap["level"].(string); ok && v != "" {
 		apiObject.Level = types.LogLevel(v)
 	}
@@ -167,6 +184,10 @@ func flattenPipeLogConfiguration(apiObject *types.PipeLogConfiguration) map[stri
 
 	tfMap := map[string]interface{}{}
 
+	if v := apiObject.IncludeExecutionData; v != nil {
+		tfMap["include_execution_data"] = flex.FlattenStringyValueSet(v)
+	}
+
 	if v := apiObject.Level; v != "" {
 		tfMap["level"] = v
 	}
@@ -284,6 +284,7 @@ func sourceParametersSchema() *schema.Schema {
 							"consumer_group_id": {
 								Type:     schema.TypeString,
 								Optional: true,
+								ForceNew: true,
 								ValidateFunc: validation.All(
 									validation.StringLenBetween(1, 200),
 									validation.StringMatch(regexache.MustCompile(`^[^.]([0-9A-Za-z_.-]+)$`), ""),
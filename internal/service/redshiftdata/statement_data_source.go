@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_redshiftdata_statement", name="Statement")
+func dataSourceStatement() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceStatementRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterIdentifier: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"column_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrDatabase: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_records": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(1, 10000),
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sql": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validSelectStatement,
+			},
+			"workgroup_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftDataClient(ctx)
+
+	input := &redshiftdata.ExecuteStatementInput{
+		Database: aws.String(d.Get(names.AttrDatabase).(string)),
+		Sql:      aws.String(d.Get("sql").(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrClusterIdentifier); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_user"); ok {
+		input.DbUser = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]interface{})) > 0 {
+		input.Parameters = expandParameters(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workgroup_name"); ok {
+		input.WorkgroupName = aws.String(v.(string))
+	}
+
+	output, err := conn.ExecuteStatement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "executing Redshift Data Statement: %s", err)
+	}
+
+	id := aws.ToString(output.Id)
+
+	statement, err := waitStatementFinished(ctx, conn, id, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		if tfresource.TimedOut(err) {
+			// Don't leave the statement running after giving up on it.
+			if _, err := conn.CancelStatement(ctx, &redshiftdata.CancelStatementInput{
+				Id: aws.String(id),
+			}); err != nil {
+				diags = sdkdiag.AppendWarningf(diags, "cancelling Redshift Data Statement (%s): %s", id, err)
+			}
+		}
+
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Statement (%s) finish: %s", id, err)
+	}
+
+	var columnNames []string
+	var records []interface{}
+
+	if aws.ToBool(statement.HasResultSet) {
+		columnNames, records, err = findStatementResultByID(ctx, conn, id, d.Get("max_records").(int))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Redshift Data Statement (%s) result: %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+	d.Set("column_names", columnNames)
+	if err := d.Set("records", records); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+	}
+
+	return diags
+}
+
+func findStatementResultByID(ctx context.Context, conn *redshiftdata.Client, id string, maxRecords int) ([]string, []interface{}, error) {
+	input := &redshiftdata.GetStatementResultInput{
+		Id: aws.String(id),
+	}
+	var columnNames []string
+	var records []interface{}
+
+	pages := redshiftdata.NewGetStatementResultPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if columnNames == nil {
+			for _, v := range page.ColumnMetadata {
+				columnNames = append(columnNames, aws.ToString(v.Name))
+			}
+		}
+
+		for _, v := range page.Records {
+			if len(records) >= maxRecords {
+				return nil, nil, fmt.Errorf("result set exceeds max_records (%d)", maxRecords)
+			}
+
+			records = append(records, flattenRecord(columnNames, v))
+		}
+	}
+
+	return columnNames, records, nil
+}
+
+func flattenRecord(columnNames []string, apiObjects []types.Field) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	for i, apiObject := range apiObjects {
+		if i >= len(columnNames) {
+			break
+		}
+
+		if v, ok := flattenField(apiObject); ok {
+			tfMap[columnNames[i]] = v
+		}
+	}
+
+	return tfMap
+}
+
+// flattenField returns the string representation of a field value.
+// NULL values are reported as not ok and omitted from the record.
+func flattenField(apiObject types.Field) (string, bool) {
+	switch v := apiObject.(type) {
+	case *types.FieldMemberBlobValue:
+		return base64.StdEncoding.EncodeToString(v.Value), true
+	case *types.FieldMemberBooleanValue:
+		return strconv.FormatBool(v.Value), true
+	case *types.FieldMemberDoubleValue:
+		return strconv.FormatFloat(v.Value, 'f', -1, 64), true
+	case *types.FieldMemberIsNull:
+		return "", false
+	case *types.FieldMemberLongValue:
+		return strconv.FormatInt(v.Value, 10), true
+	case *types.FieldMemberStringValue:
+		return v.Value, true
+	}
+
+	return "", false
+}
+
+// validSelectStatement validates that the SQL text is a single SELECT statement, optionally preceded by a WITH clause.
+// String literals, quoted identifiers and comments are ignored when looking for statement separators.
+func validSelectStatement(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	code := strings.TrimRight(strings.TrimSpace(sqlCode(value)), "; \t\r\n")
+
+	if strings.Contains(code, ";") {
+		errors = append(errors, fmt.Errorf("%q must contain a single SQL statement", k))
+		return
+	}
+
+	keyword, _, _ := strings.Cut(strings.ToLower(code), " ")
+	keyword, _, _ = strings.Cut(keyword, "(")
+	keyword = strings.TrimSpace(keyword)
+
+	if keyword != "select" && keyword != "with" {
+		errors = append(errors, fmt.Errorf("%q must be a SELECT statement, optionally preceded by a WITH clause", k))
+	}
+
+	return
+}
+
+// sqlCode returns the SQL text with string literals, quoted identifiers and comments replaced by spaces.
+func sqlCode(sql string) string {
+	var sb strings.Builder
+	runes := []rune(sql)
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\'' || r == '"':
+			for i++; i < len(runes) && runes[i] != r; i++ {
+			}
+			sb.WriteRune(' ')
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for ; i < len(runes) && runes[i] != '\n'; i++ {
+			}
+			sb.WriteRune(' ')
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			for i += 3; i < len(runes) && !(runes[i-1] == '*' && runes[i] == '/'); i++ {
+			}
+			sb.WriteRune(' ')
+		case r == '\t' || r == '\r' || r == '\n':
+			sb.WriteRune(' ')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfredshiftdata "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidSelectStatement(t *testing.T) {
+	t.Parallel()
+
+	validStatements := []string{
+		"SELECT 1",
+		"select 1;",
+		"  SELECT :name AS name, 42 AS answer;  ",
+		"WITH t AS (SELECT 1 AS a) SELECT a FROM t",
+		"with(SELECT 1)",
+		"SELECT 'a;b' AS \"c;d\" -- e;f\n",
+		"SELECT 1 /* ; */",
+		"SELECT 'it''s'",
+	}
+	for _, v := range validStatements {
+		_, errors := tfredshiftdata.ValidSelectStatement(v, "sql")
+		if len(errors) != 0 {
+			t.Errorf("%q should be a valid SELECT statement: %q", v, errors)
+		}
+	}
+
+	invalidStatements := []string{
+		"",
+		"INSERT INTO t VALUES (1)",
+		"DROP TABLE t",
+		"SELECT 1; DROP TABLE t",
+		"SELECT 1;; SELECT 2",
+		"-- SELECT\nDELETE FROM t",
+		"selection",
+	}
+	for _, v := range invalidStatements {
+		_, errors := tfredshiftdata.ValidSelectStatement(v, "sql")
+		if len(errors) == 0 {
+			t.Errorf("%q should be an invalid SELECT statement", v)
+		}
+	}
+}
+
+func TestAccRedshiftDataStatementDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshiftdata_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "column_names.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "column_names.0", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "column_names.1", "answer"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.answer", "42"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatementDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+data "aws_redshiftdata_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "SELECT :name AS name, 42 AS answer;"
+
+  parameters {
+    name  = "name"
+    value = %[1]q
+  }
+}
+`, rName)
+}
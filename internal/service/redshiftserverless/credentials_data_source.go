@@ -7,8 +7,8 @@ import (
 	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -55,19 +55,19 @@ func dataSourceCredentials() *schema.Resource {
 
 func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	workgroupName := d.Get("workgroup_name").(string)
 	input := &redshiftserverless.GetCredentialsInput{
 		WorkgroupName:   aws.String(workgroupName),
-		DurationSeconds: aws.Int64(int64(d.Get("duration_seconds").(int))),
+		DurationSeconds: aws.Int32(int32(d.Get("duration_seconds").(int))),
 	}
 
 	if v, ok := d.GetOk("db_name"); ok {
 		input.DbName = aws.String(v.(string))
 	}
 
-	creds, err := conn.GetCredentialsWithContext(ctx, input)
+	creds, err := conn.GetCredentials(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Credentials for Workgroup (%s): %s", workgroupName, err)
@@ -77,7 +77,7 @@ func dataSourceCredentialsRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("db_password", creds.DbPassword)
 	d.Set("db_user", creds.DbUser)
-	d.Set("expiration", aws.TimeValue(creds.Expiration).Format(time.RFC3339))
+	d.Set("expiration", aws.ToTime(creds.Expiration).Format(time.RFC3339))
 
 	return diags
 }
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_redshiftserverless_endpoint_authorization", name="Endpoint Authorization")
+func dataSourceEndpointAuthorization() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEndpointAuthorizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAddress: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPort: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrVPCEndpointID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCSecurityGroupIDs: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"owner_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"workgroup_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceEndpointAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
+
+	workgroupName := d.Get("workgroup_name").(string)
+	input := &redshiftserverless.ListEndpointAccessInput{
+		WorkgroupName: aws.String(workgroupName),
+	}
+
+	if v, ok := d.GetOk("owner_account"); ok {
+		input.OwnerAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrVPCID); ok {
+		input.VpcId = aws.String(v.(string))
+	}
+
+	endpoints, err := findEndpointAccesses(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Endpoint Authorization (%s): %s", workgroupName, err)
+	}
+
+	d.SetId(workgroupName)
+	if err := d.Set("endpoint", flattenEndpointAccesses(endpoints)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
+	}
+
+	return diags
+}
+
+func findEndpointAccesses(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.ListEndpointAccessInput) ([]awstypes.EndpointAccess, error) {
+	var output []awstypes.EndpointAccess
+
+	pages := redshiftserverless.NewListEndpointAccessPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Endpoints...)
+	}
+
+	return output, nil
+}
+
+func flattenEndpointAccesses(apiObjects []awstypes.EndpointAccess) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAddress:   aws.ToString(apiObject.Address),
+			names.AttrARN:       aws.ToString(apiObject.EndpointArn),
+			"endpoint_name":     aws.ToString(apiObject.EndpointName),
+			names.AttrPort:      aws.ToInt32(apiObject.Port),
+			names.AttrStatus:    aws.ToString(apiObject.EndpointStatus),
+			names.AttrSubnetIDs: apiObject.SubnetIds,
+			names.AttrVPCSecurityGroupIDs: tfslices.ApplyToAll(apiObject.VpcSecurityGroups, func(v awstypes.VpcSecurityGroupMembership) string {
+				return aws.ToString(v.VpcSecurityGroupId)
+			}),
+		}
+
+		if v := apiObject.VpcEndpoint; v != nil {
+			tfMap[names.AttrVPCEndpointID] = aws.ToString(v.VpcEndpointId)
+			tfMap[names.AttrVPCID] = aws.ToString(v.VpcId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
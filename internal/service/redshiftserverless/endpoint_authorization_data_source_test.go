@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftserverless_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftServerlessEndpointAuthorizationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshiftserverless_endpoint_authorization.test"
+	resourceName := "aws_redshiftserverless_endpoint_access.test"
+	rName := sdkacctest.RandStringFromCharSet(30, sdkacctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointAuthorizationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.address", resourceName, names.AttrAddress),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.endpoint_name", resourceName, "endpoint_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.port", resourceName, names.AttrPort),
+					resource.TestCheckResourceAttrSet(dataSourceName, "endpoint.0.status"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint.0.subnet_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.vpc_endpoint_id", resourceName, "vpc_endpoint.0.vpc_endpoint_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.vpc_id", "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workgroup_name", resourceName, "workgroup_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEndpointAuthorizationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEndpointAccessConfig_basic(rName), `
+data "aws_redshiftserverless_endpoint_authorization" "test" {
+  workgroup_name = aws_redshiftserverless_endpoint_access.test.workgroup_name
+  vpc_id         = aws_vpc.test.id
+}
+`)
+}
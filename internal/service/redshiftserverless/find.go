@@ -6,21 +6,22 @@ package redshiftserverless
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func findNamespaceByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
+func findNamespaceByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Namespace, error) {
 	input := &redshiftserverless.GetNamespaceInput{
 		NamespaceName: aws.String(name),
 	}
 
-	output, err := conn.GetNamespaceWithContext(ctx, input)
+	output, err := conn.GetNamespace(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -38,14 +39,14 @@ func findNamespaceByName(ctx context.Context, conn *redshiftserverless.RedshiftS
 	return output.Namespace, nil
 }
 
-func findEndpointAccessByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.EndpointAccess, error) {
+func findEndpointAccessByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.EndpointAccess, error) {
 	input := &redshiftserverless.GetEndpointAccessInput{
 		EndpointName: aws.String(name),
 	}
 
-	output, err := conn.GetEndpointAccessWithContext(ctx, input)
+	output, err := conn.GetEndpointAccess(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -63,14 +64,14 @@ func findEndpointAccessByName(ctx context.Context, conn *redshiftserverless.Reds
 	return output.Endpoint, nil
 }
 
-func findUsageLimitByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, id string) (*redshiftserverless.UsageLimit, error) {
+func findUsageLimitByName(ctx context.Context, conn *redshiftserverless.Client, id string) (*awstypes.UsageLimit, error) {
 	input := &redshiftserverless.GetUsageLimitInput{
 		UsageLimitId: aws.String(id),
 	}
 
-	output, err := conn.GetUsageLimitWithContext(ctx, input)
+	output, err := conn.GetUsageLimit(ctx, input)
 
-	if tfawserr.ErrMessageContains(err, redshiftserverless.ErrCodeValidationException, "does not exist") {
+	if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "does not exist") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -88,14 +89,14 @@ func findUsageLimitByName(ctx context.Context, conn *redshiftserverless.Redshift
 	return output.UsageLimit, nil
 }
 
-func findSnapshotByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Snapshot, error) {
+func findSnapshotByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Snapshot, error) {
 	input := &redshiftserverless.GetSnapshotInput{
 		SnapshotName: aws.String(name),
 	}
 
-	output, err := conn.GetSnapshotWithContext(ctx, input)
+	output, err := conn.GetSnapshot(ctx, input)
 
-	if tfawserr.ErrMessageContains(err, redshiftserverless.ErrCodeResourceNotFoundException, "snapshot") {
+	if errs.IsAErrorMessageContains[*awstypes.ResourceNotFoundException](err, "snapshot") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -113,14 +114,14 @@ func findSnapshotByName(ctx context.Context, conn *redshiftserverless.RedshiftSe
 	return output.Snapshot, nil
 }
 
-func findResourcePolicyByARN(ctx context.Context, conn *redshiftserverless.RedshiftServerless, arn string) (*redshiftserverless.ResourcePolicy, error) {
+func findResourcePolicyByARN(ctx context.Context, conn *redshiftserverless.Client, arn string) (*awstypes.ResourcePolicy, error) {
 	input := &redshiftserverless.GetResourcePolicyInput{
 		ResourceArn: aws.String(arn),
 	}
 
-	output, err := conn.GetResourcePolicyWithContext(ctx, input)
+	output, err := conn.GetResourcePolicy(ctx, input)
 
-	if tfawserr.ErrMessageContains(err, redshiftserverless.ErrCodeResourceNotFoundException, "does not exist") {
+	if errs.IsAErrorMessageContains[*awstypes.ResourceNotFoundException](err, "does not exist") {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -10,14 +10,15 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -96,8 +97,8 @@ func resourceNamespace() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(redshiftserverless.LogExport_Values(), false),
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.LogExport](),
 				},
 			},
 			"manage_admin_password": {
@@ -124,7 +125,7 @@ func resourceNamespace() *schema.Resource {
 
 func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	name := d.Get("namespace_name").(string)
 	input := &redshiftserverless.CreateNamespaceInput{
@@ -153,7 +154,7 @@ func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk("iam_roles"); ok && v.(*schema.Set).Len() > 0 {
-		input.IamRoles = flex.ExpandStringSet(v.(*schema.Set))
+		input.IamRoles = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
@@ -161,27 +162,27 @@ func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk("log_exports"); ok && v.(*schema.Set).Len() > 0 {
-		input.LogExports = flex.ExpandStringSet(v.(*schema.Set))
+		input.LogExports = flex.ExpandStringyValueSet[awstypes.LogExport](v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk("manage_admin_password"); ok {
 		input.ManageAdminPassword = aws.Bool(v.(bool))
 	}
 
-	output, err := conn.CreateNamespaceWithContext(ctx, input)
+	output, err := conn.CreateNamespace(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Namespace (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.Namespace.NamespaceName))
+	d.SetId(aws.ToString(output.Namespace.NamespaceName))
 
 	return append(diags, resourceNamespaceRead(ctx, d, meta)...)
 }
 
 func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	output, err := findNamespaceByName(ctx, conn, d.Id())
 
@@ -195,7 +196,7 @@ func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Namespace (%s): %s", d.Id(), err)
 	}
 
-	arn := aws.StringValue(output.NamespaceArn)
+	arn := aws.ToString(output.NamespaceArn)
 	d.Set("admin_password_secret_arn", output.AdminPasswordSecretArn)
 	d.Set("admin_password_secret_kms_key_id", output.AdminPasswordSecretKmsKeyId)
 	d.Set("admin_username", output.AdminUsername)
@@ -204,7 +205,7 @@ func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set("default_iam_role_arn", output.DefaultIamRoleArn)
 	d.Set("iam_roles", flattenNamespaceIAMRoles(output.IamRoles))
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
-	d.Set("log_exports", aws.StringValueSlice(output.LogExports))
+	d.Set("log_exports", output.LogExports)
 	d.Set("namespace_id", output.NamespaceId)
 	d.Set("namespace_name", output.NamespaceName)
 
@@ -213,7 +214,7 @@ func resourceNamespaceRead(ctx context.Context, d *schema.ResourceData, meta int
 
 func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &redshiftserverless.UpdateNamespaceInput{
@@ -234,7 +235,7 @@ func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		if d.HasChange("iam_roles") {
-			input.IamRoles = flex.ExpandStringSet(d.Get("iam_roles").(*schema.Set))
+			input.IamRoles = flex.ExpandStringValueSet(d.Get("iam_roles").(*schema.Set))
 		}
 
 		if d.HasChange(names.AttrKMSKeyID) {
@@ -242,14 +243,14 @@ func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		if d.HasChange("log_exports") {
-			input.LogExports = flex.ExpandStringSet(d.Get("log_exports").(*schema.Set))
+			input.LogExports = flex.ExpandStringyValueSet[awstypes.LogExport](d.Get("log_exports").(*schema.Set))
 		}
 
 		if d.HasChange("manage_admin_password") {
 			input.ManageAdminPassword = aws.Bool(d.Get("manage_admin_password").(bool))
 		}
 
-		_, err := conn.UpdateNamespaceWithContext(ctx, input)
+		_, err := conn.UpdateNamespace(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Redshift Serverless Namespace (%s): %s", d.Id(), err)
@@ -265,19 +266,19 @@ func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 func resourceNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Redshift Serverless Namespace: %s", d.Id())
-	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 10*time.Minute,
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ConflictException](ctx, 10*time.Minute,
 		func() (interface{}, error) {
-			return conn.DeleteNamespaceWithContext(ctx, &redshiftserverless.DeleteNamespaceInput{
+			return conn.DeleteNamespace(ctx, &redshiftserverless.DeleteNamespaceInput{
 				NamespaceName: aws.String(d.Id()),
 			})
 		},
 		// "ConflictException: There is an operation running on the namespace. Try deleting the namespace again later."
-		redshiftserverless.ErrCodeConflictException, "operation running")
+		"operation running")
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
 	}
 
@@ -296,12 +297,10 @@ var (
 	reIAMRole = regexache.MustCompile(`^\s*IamRole\((.*)\)\s*$`)
 )
 
-func flattenNamespaceIAMRoles(iamRoles []*string) []string {
+func flattenNamespaceIAMRoles(iamRoles []string) []string {
 	var tfList []string
 
 	for _, iamRole := range iamRoles {
-		iamRole := aws.StringValue(iamRole)
-
 		if arn.IsARN(iamRole) {
 			tfList = append(tfList, iamRole)
 			continue
@@ -244,7 +244,7 @@ func TestAccRedshiftServerlessNamespace_manageAdminPassword(t *testing.T) {
 
 func testAccCheckNamespaceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_redshiftserverless_namespace" {
@@ -279,7 +279,7 @@ func testAccCheckNamespaceExists(ctx context.Context, name string) resource.Test
 			return fmt.Errorf("Redshift Serverless Namespace is not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 		_, err := tfredshiftserverless.FindNamespaceByName(ctx, conn, rs.Primary.ID)
 
@@ -7,12 +7,13 @@ import (
 	"context"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -87,7 +88,7 @@ func resourceSnapshot() *schema.Resource {
 
 func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	input := redshiftserverless.CreateSnapshotInput{
 		NamespaceName: aws.String(d.Get("namespace_name").(string)),
@@ -95,16 +96,16 @@ func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if v, ok := d.GetOk(names.AttrRetentionPeriod); ok {
-		input.RetentionPeriod = aws.Int64(int64(v.(int)))
+		input.RetentionPeriod = aws.Int32(int32(v.(int)))
 	}
 
-	out, err := conn.CreateSnapshotWithContext(ctx, &input)
+	out, err := conn.CreateSnapshot(ctx, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Snapshot : %s", err)
 	}
 
-	d.SetId(aws.StringValue(out.Snapshot.SnapshotName))
+	d.SetId(aws.ToString(out.Snapshot.SnapshotName))
 
 	if _, err := waitSnapshotAvailable(ctx, conn, d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Serverless Snapshot (%s) to be Available: %s", d.Id(), err)
@@ -115,7 +116,7 @@ func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	out, err := findSnapshotByName(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -136,22 +137,22 @@ func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("admin_username", out.AdminUsername)
 	d.Set(names.AttrKMSKeyID, out.KmsKeyId)
 	d.Set("owner_account", out.OwnerAccount)
-	d.Set("accounts_with_provisioned_restore_access", flex.FlattenStringSet(out.AccountsWithRestoreAccess))
-	d.Set("accounts_with_restore_access", flex.FlattenStringSet(out.AccountsWithRestoreAccess))
+	d.Set("accounts_with_provisioned_restore_access", flex.FlattenStringValueSet(out.AccountsWithRestoreAccess))
+	d.Set("accounts_with_restore_access", flex.FlattenStringValueSet(out.AccountsWithRestoreAccess))
 
 	return diags
 }
 
 func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	input := &redshiftserverless.UpdateSnapshotInput{
 		SnapshotName:    aws.String(d.Id()),
-		RetentionPeriod: aws.Int64(int64(d.Get(names.AttrRetentionPeriod).(int))),
+		RetentionPeriod: aws.Int32(int32(d.Get(names.AttrRetentionPeriod).(int))),
 	}
 
-	_, err := conn.UpdateSnapshotWithContext(ctx, input)
+	_, err := conn.UpdateSnapshot(ctx, input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Redshift Serverless Snapshot (%s): %s", d.Id(), err)
 	}
@@ -161,14 +162,14 @@ func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Redshift Serverless Snapshot: %s", d.Id())
-	_, err := conn.DeleteSnapshotWithContext(ctx, &redshiftserverless.DeleteSnapshotInput{
+	_, err := conn.DeleteSnapshot(ctx, &redshiftserverless.DeleteSnapshotInput{
 		SnapshotName: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
 	}
 
@@ -86,7 +86,7 @@ func TestAccRedshiftServerlessSnapshot_disappears(t *testing.T) {
 
 func testAccCheckSnapshotDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 		for _, rs := range s.RootModule().Resources {
 			if rs.Type != "aws_redshiftserverless_snapshot" {
@@ -120,7 +120,7 @@ func testAccCheckSnapshotExists(ctx context.Context, name string) resource.TestC
 			return fmt.Errorf("Redshift Serverless Snapshot is not set")
 		}
 
-		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessConn(ctx)
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 		_, err := tfredshiftserverless.FindSnapshotByName(ctx, conn, rs.Primary.ID)
 
@@ -6,13 +6,13 @@ package redshiftserverless
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func statusNamespace(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) retry.StateRefreshFunc {
+func statusNamespace(ctx context.Context, conn *redshiftserverless.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findNamespaceByName(ctx, conn, name)
 
@@ -24,11 +24,11 @@ func statusNamespace(ctx context.Context, conn *redshiftserverless.RedshiftServe
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
 
-func statusEndpointAccess(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) retry.StateRefreshFunc {
+func statusEndpointAccess(ctx context.Context, conn *redshiftserverless.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findEndpointAccessByName(ctx, conn, name)
 
@@ -40,11 +40,11 @@ func statusEndpointAccess(ctx context.Context, conn *redshiftserverless.Redshift
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.EndpointStatus), nil
+		return output, aws.ToString(output.EndpointStatus), nil
 	}
 }
 
-func statusSnapshot(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) retry.StateRefreshFunc {
+func statusSnapshot(ctx context.Context, conn *redshiftserverless.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findSnapshotByName(ctx, conn, name)
 
@@ -56,6 +56,6 @@ func statusSnapshot(ctx context.Context, conn *redshiftserverless.RedshiftServer
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
@@ -7,13 +7,14 @@ import (
 	"context"
 	"log"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -42,17 +43,17 @@ func resourceUsageLimit() *schema.Resource {
 				Required: true,
 			},
 			"breach_action": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      redshiftserverless.UsageLimitBreachActionLog,
-				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitBreachAction_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.UsageLimitBreachActionLog,
+				ValidateDiagFunc: enum.Validate[awstypes.UsageLimitBreachAction](),
 			},
 			"period": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				Default:      redshiftserverless.UsageLimitPeriodMonthly,
-				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitPeriod_Values(), false),
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.UsageLimitPeriodMonthly,
+				ValidateDiagFunc: enum.Validate[awstypes.UsageLimitPeriod](),
 			},
 			names.AttrResourceARN: {
 				Type:         schema.TypeString,
@@ -61,9 +62,9 @@ func resourceUsageLimit() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			"usage_type": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice(redshiftserverless.UsageLimitUsageType_Values(), false),
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.UsageLimitUsageType](),
 			},
 		},
 	}
@@ -71,36 +72,36 @@ func resourceUsageLimit() *schema.Resource {
 
 func resourceUsageLimitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	input := redshiftserverless.CreateUsageLimitInput{
 		Amount:      aws.Int64(int64(d.Get("amount").(int))),
 		ResourceArn: aws.String(d.Get(names.AttrResourceARN).(string)),
-		UsageType:   aws.String(d.Get("usage_type").(string)),
+		UsageType:   awstypes.UsageLimitUsageType(d.Get("usage_type").(string)),
 	}
 
 	if v, ok := d.GetOk("period"); ok {
-		input.Period = aws.String(v.(string))
+		input.Period = awstypes.UsageLimitPeriod(v.(string))
 	}
 
 	if v, ok := d.GetOk("breach_action"); ok {
-		input.BreachAction = aws.String(v.(string))
+		input.BreachAction = awstypes.UsageLimitBreachAction(v.(string))
 	}
 
-	out, err := conn.CreateUsageLimitWithContext(ctx, &input)
+	out, err := conn.CreateUsageLimit(ctx, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Usage Limit : %s", err)
 	}
 
-	d.SetId(aws.StringValue(out.UsageLimit.UsageLimitId))
+	d.SetId(aws.ToString(out.UsageLimit.UsageLimitId))
 
 	return append(diags, resourceUsageLimitRead(ctx, d, meta)...)
 }
 
 func resourceUsageLimitRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	out, err := findUsageLimitByName(ctx, conn, d.Id())
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -125,7 +126,7 @@ func resourceUsageLimitRead(ctx context.Context, d *schema.ResourceData, meta in
 
 func resourceUsageLimitUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	input := &redshiftserverless.UpdateUsageLimitInput{
 		UsageLimitId: aws.String(d.Id()),
@@ -136,10 +137,10 @@ func resourceUsageLimitUpdate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if d.HasChange("breach_action") {
-		input.BreachAction = aws.String(d.Get("breach_action").(string))
+		input.BreachAction = awstypes.UsageLimitBreachAction(d.Get("breach_action").(string))
 	}
 
-	_, err := conn.UpdateUsageLimitWithContext(ctx, input)
+	_, err := conn.UpdateUsageLimit(ctx, input)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Redshift Serverless Usage Limit (%s): %s", d.Id(), err)
 	}
@@ -149,14 +150,14 @@ func resourceUsageLimitUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceUsageLimitDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Redshift Serverless Usage Limit: %s", d.Id())
-	_, err := conn.DeleteUsageLimitWithContext(ctx, &redshiftserverless.DeleteUsageLimitInput{
+	_, err := conn.DeleteUsageLimit(ctx, &redshiftserverless.DeleteUsageLimitInput{
 		UsageLimitId: aws.String(d.Id()),
 	})
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
 	}
 
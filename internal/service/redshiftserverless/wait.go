@@ -7,15 +7,15 @@ import (
 	"context"
 	"time"
 
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 )
 
-func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
+func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Namespace, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			redshiftserverless.NamespaceStatusDeleting,
-		},
+		Pending: enum.Slice(awstypes.NamespaceStatusDeleting),
 		Target:  []string{},
 		Refresh: statusNamespace(ctx, conn, name),
 		Timeout: 10 * time.Minute,
@@ -23,35 +23,31 @@ func waitNamespaceDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
+	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Namespace, error) {
+func waitNamespaceUpdated(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Namespace, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			redshiftserverless.NamespaceStatusModifying,
-		},
-		Target: []string{
-			redshiftserverless.NamespaceStatusAvailable,
-		},
+		Pending: enum.Slice(awstypes.NamespaceStatusModifying),
+		Target:  enum.Slice(awstypes.NamespaceStatusAvailable),
 		Refresh: statusNamespace(ctx, conn, name),
 		Timeout: 10 * time.Minute,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Namespace); ok {
+	if output, ok := outputRaw.(*awstypes.Namespace); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.EndpointAccess, error) { //nolint:unparam
+func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.EndpointAccess, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			"CREATING",
@@ -66,14 +62,14 @@ func waitEndpointAccessActive(ctx context.Context, conn *redshiftserverless.Reds
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
+	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.EndpointAccess, error) {
+func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.EndpointAccess, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
 			"DELETING",
@@ -85,39 +81,33 @@ func waitEndpointAccessDeleted(ctx context.Context, conn *redshiftserverless.Red
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.EndpointAccess); ok {
+	if output, ok := outputRaw.(*awstypes.EndpointAccess); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Snapshot, error) {
+func waitSnapshotAvailable(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Snapshot, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			redshiftserverless.SnapshotStatusCreating,
-		},
-		Target: []string{
-			redshiftserverless.SnapshotStatusAvailable,
-		},
+		Pending: enum.Slice(awstypes.SnapshotStatusCreating),
+		Target:  enum.Slice(awstypes.SnapshotStatusAvailable),
 		Refresh: statusSnapshot(ctx, conn, name),
 		Timeout: 10 * time.Minute,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
+	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Snapshot, error) {
+func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Snapshot, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{
-			redshiftserverless.SnapshotStatusAvailable,
-		},
+		Pending: enum.Slice(awstypes.SnapshotStatusAvailable),
 		Target:  []string{},
 		Refresh: statusSnapshot(ctx, conn, name),
 		Timeout: 10 * time.Minute,
@@ -125,7 +115,7 @@ func waitSnapshotDeleted(ctx context.Context, conn *redshiftserverless.RedshiftS
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Snapshot); ok {
+	if output, ok := outputRaw.(*awstypes.Snapshot); ok {
 		return output, err
 	}
 
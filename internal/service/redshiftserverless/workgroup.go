@@ -9,14 +9,16 @@ import (
 	"log"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshiftserverless"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -168,6 +170,26 @@ func resourceWorkgroup() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"price_performance_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"level": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 25, 50, 75, 100}),
+						},
+					},
+				},
+			},
 			names.AttrPubliclyAccessible: {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -207,7 +229,7 @@ func resourceWorkgroup() *schema.Resource {
 
 func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	name := d.Get("workgroup_name").(string)
 	input := redshiftserverless.CreateWorkgroupInput{
@@ -217,7 +239,7 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk("base_capacity"); ok {
-		input.BaseCapacity = aws.Int64(int64(v.(int)))
+		input.BaseCapacity = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk("config_parameter"); ok && v.(*schema.Set).Len() > 0 {
@@ -229,11 +251,15 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk(names.AttrMaxCapacity); ok {
-		input.MaxCapacity = aws.Int64(int64(v.(int)))
+		input.MaxCapacity = aws.Int32(int32(v.(int)))
 	}
 
 	if v, ok := d.GetOk(names.AttrPort); ok {
-		input.Port = aws.Int64(int64(v.(int)))
+		input.Port = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("price_performance_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PricePerformanceTarget = expandPerformanceTarget(v.([]interface{})[0].(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk(names.AttrPubliclyAccessible); ok {
@@ -241,20 +267,20 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	if v, ok := d.GetOk(names.AttrSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
-		input.SecurityGroupIds = flex.ExpandStringSet(v.(*schema.Set))
+		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
 	if v, ok := d.GetOk(names.AttrSubnetIDs); ok && v.(*schema.Set).Len() > 0 {
-		input.SubnetIds = flex.ExpandStringSet(v.(*schema.Set))
+		input.SubnetIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	output, err := conn.CreateWorkgroupWithContext(ctx, &input)
+	output, err := conn.CreateWorkgroup(ctx, &input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Redshift Serverless Workgroup (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.Workgroup.WorkgroupName))
+	d.SetId(aws.ToString(output.Workgroup.WorkgroupName))
 
 	if _, err := waitWorkgroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Serverless Workgroup (%s) create: %s", d.Id(), err)
@@ -265,7 +291,7 @@ func resourceWorkgroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	out, err := FindWorkgroupByName(ctx, conn, d.Id())
 
@@ -279,7 +305,7 @@ func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading Redshift Serverless Workgroup (%s): %s", d.Id(), err)
 	}
 
-	arn := aws.StringValue(out.WorkgroupArn)
+	arn := aws.ToString(out.WorkgroupArn)
 	d.Set(names.AttrARN, arn)
 	d.Set("base_capacity", out.BaseCapacity)
 	if err := d.Set("config_parameter", flattenConfigParameters(out.ConfigParameters)); err != nil {
@@ -292,9 +318,12 @@ func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta int
 	d.Set(names.AttrMaxCapacity, out.MaxCapacity)
 	d.Set("namespace_name", out.NamespaceName)
 	d.Set(names.AttrPort, flattenEndpoint(out.Endpoint)[names.AttrPort])
+	if err := d.Set("price_performance_target", flattenPerformanceTarget(out.PricePerformanceTarget)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting price_performance_target: %s", err)
+	}
 	d.Set(names.AttrPubliclyAccessible, out.PubliclyAccessible)
-	d.Set(names.AttrSecurityGroupIDs, flex.FlattenStringSet(out.SecurityGroupIds))
-	d.Set(names.AttrSubnetIDs, flex.FlattenStringSet(out.SubnetIds))
+	d.Set(names.AttrSecurityGroupIDs, flex.FlattenStringValueSet(out.SecurityGroupIds))
+	d.Set(names.AttrSubnetIDs, flex.FlattenStringValueSet(out.SubnetIds))
 	d.Set("workgroup_id", out.WorkgroupId)
 	d.Set("workgroup_name", out.WorkgroupName)
 
@@ -303,7 +332,7 @@ func resourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta int
 
 func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	checkCapacityChange := func(key string) (bool, int, int) {
 		o, n := d.GetChange(key)
@@ -329,47 +358,47 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	case hasMaxCapacityChange && newMaxCapacity == 0:
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int64(-1), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int32(-1), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	case hasBaseCapacityChange && hasMaxCapacityChange && (oldMaxCapacity == 0 || newBaseCapacity <= oldMaxCapacity):
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int64(int64(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int32(int32(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int64(int64(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int32(int32(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	case hasBaseCapacityChange && hasMaxCapacityChange && newBaseCapacity > oldMaxCapacity:
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int64(int64(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int32(int32(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int64(int64(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int32(int32(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	case hasBaseCapacityChange:
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int64(int64(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{BaseCapacity: aws.Int32(int32(newBaseCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	case hasMaxCapacityChange:
 		if err :=
 			updateWorkgroup(ctx, conn,
-				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int64(int64(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
+				&redshiftserverless.UpdateWorkgroupInput{MaxCapacity: aws.Int32(int32(newMaxCapacity)), WorkgroupName: aws.String(d.Id())},
 				d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -399,7 +428,7 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if d.HasChange(names.AttrPort) {
 		input := &redshiftserverless.UpdateWorkgroupInput{
-			Port:          aws.Int64(int64(d.Get(names.AttrPort).(int))),
+			Port:          aws.Int32(int32(d.Get(names.AttrPort).(int))),
 			WorkgroupName: aws.String(d.Id()),
 		}
 
@@ -408,6 +437,23 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		}
 	}
 
+	if d.HasChange("price_performance_target") {
+		input := &redshiftserverless.UpdateWorkgroupInput{
+			PricePerformanceTarget: &awstypes.PerformanceTarget{
+				Status: awstypes.PerformanceTargetStatusDisabled,
+			},
+			WorkgroupName: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("price_performance_target"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.PricePerformanceTarget = expandPerformanceTarget(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if err := updateWorkgroup(ctx, conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if d.HasChange(names.AttrPubliclyAccessible) {
 		input := &redshiftserverless.UpdateWorkgroupInput{
 			PubliclyAccessible: aws.Bool(d.Get(names.AttrPubliclyAccessible).(bool)),
@@ -421,7 +467,7 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if d.HasChange(names.AttrSecurityGroupIDs) {
 		input := &redshiftserverless.UpdateWorkgroupInput{
-			SecurityGroupIds: flex.ExpandStringSet(d.Get(names.AttrSecurityGroupIDs).(*schema.Set)),
+			SecurityGroupIds: flex.ExpandStringValueSet(d.Get(names.AttrSecurityGroupIDs).(*schema.Set)),
 			WorkgroupName:    aws.String(d.Id()),
 		}
 
@@ -432,7 +478,7 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if d.HasChange(names.AttrSubnetIDs) {
 		input := &redshiftserverless.UpdateWorkgroupInput{
-			SubnetIds:     flex.ExpandStringSet(d.Get(names.AttrSubnetIDs).(*schema.Set)),
+			SubnetIds:     flex.ExpandStringValueSet(d.Get(names.AttrSubnetIDs).(*schema.Set)),
 			WorkgroupName: aws.String(d.Id()),
 		}
 
@@ -446,19 +492,19 @@ func resourceWorkgroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 func resourceWorkgroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	log.Printf("[DEBUG] Deleting Redshift Serverless Workgroup: %s", d.Id())
-	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 10*time.Minute,
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ConflictException](ctx, 10*time.Minute,
 		func() (interface{}, error) {
-			return conn.DeleteWorkgroupWithContext(ctx, &redshiftserverless.DeleteWorkgroupInput{
+			return conn.DeleteWorkgroup(ctx, &redshiftserverless.DeleteWorkgroupInput{
 				WorkgroupName: aws.String(d.Id()),
 			})
 		},
 		// "ConflictException: There is an operation running on the workgroup. Try deleting the workgroup again later."
-		redshiftserverless.ErrCodeConflictException, "operation running")
+		"operation running")
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return diags
 	}
 
@@ -473,9 +519,9 @@ func resourceWorkgroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
-func updateWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServerless, input *redshiftserverless.UpdateWorkgroupInput, timeout time.Duration) error {
-	name := aws.StringValue(input.WorkgroupName)
-	_, err := conn.UpdateWorkgroupWithContext(ctx, input)
+func updateWorkgroup(ctx context.Context, conn *redshiftserverless.Client, input *redshiftserverless.UpdateWorkgroupInput, timeout time.Duration) error {
+	name := aws.ToString(input.WorkgroupName)
+	_, err := conn.UpdateWorkgroup(ctx, input)
 
 	if err != nil {
 		return fmt.Errorf("updating Redshift Serverless Workgroup (%s): %w", name, err)
@@ -488,14 +534,14 @@ func updateWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServe
 	return nil
 }
 
-func FindWorkgroupByName(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) (*redshiftserverless.Workgroup, error) {
+func FindWorkgroupByName(ctx context.Context, conn *redshiftserverless.Client, name string) (*awstypes.Workgroup, error) {
 	input := &redshiftserverless.GetWorkgroupInput{
 		WorkgroupName: aws.String(name),
 	}
 
-	output, err := conn.GetWorkgroupWithContext(ctx, input)
+	output, err := conn.GetWorkgroup(ctx, input)
 
-	if tfawserr.ErrCodeEquals(err, redshiftserverless.ErrCodeResourceNotFoundException) {
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
 		return nil, &retry.NotFoundError{
 			LastError:   err,
 			LastRequest: input,
@@ -513,7 +559,7 @@ func FindWorkgroupByName(ctx context.Context, conn *redshiftserverless.RedshiftS
 	return output.Workgroup, nil
 }
 
-func statusWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string) retry.StateRefreshFunc {
+func statusWorkgroup(ctx context.Context, conn *redshiftserverless.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindWorkgroupByName(ctx, conn, name)
 
@@ -525,30 +571,30 @@ func statusWorkgroup(ctx context.Context, conn *redshiftserverless.RedshiftServe
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status), nil
+		return output, string(output.Status), nil
 	}
 }
 
-func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string, wait time.Duration) (*redshiftserverless.Workgroup, error) { //nolint:unparam
+func waitWorkgroupAvailable(ctx context.Context, conn *redshiftserverless.Client, name string, wait time.Duration) (*awstypes.Workgroup, error) { //nolint:unparam
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{redshiftserverless.WorkgroupStatusCreating, redshiftserverless.WorkgroupStatusModifying},
-		Target:  []string{redshiftserverless.WorkgroupStatusAvailable},
+		Pending: enum.Slice(awstypes.WorkgroupStatusCreating, awstypes.WorkgroupStatusModifying),
+		Target:  enum.Slice(awstypes.WorkgroupStatusAvailable),
 		Refresh: statusWorkgroup(ctx, conn, name),
 		Timeout: wait,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
+	if output, ok := outputRaw.(*awstypes.Workgroup); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.RedshiftServerless, name string, wait time.Duration) (*redshiftserverless.Workgroup, error) {
+func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Client, name string, wait time.Duration) (*awstypes.Workgroup, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{redshiftserverless.WorkgroupStatusAvailable, redshiftserverless.WorkgroupStatusModifying, redshiftserverless.WorkgroupStatusDeleting},
+		Pending: enum.Slice(awstypes.WorkgroupStatusAvailable, awstypes.WorkgroupStatusModifying, awstypes.WorkgroupStatusDeleting),
 		Target:  []string{},
 		Refresh: statusWorkgroup(ctx, conn, name),
 		Timeout: wait,
@@ -556,19 +602,19 @@ func waitWorkgroupDeleted(ctx context.Context, conn *redshiftserverless.Redshift
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*redshiftserverless.Workgroup); ok {
+	if output, ok := outputRaw.(*awstypes.Workgroup); ok {
 		return output, err
 	}
 
 	return nil, err
 }
 
-func expandConfigParameter(tfMap map[string]interface{}) *redshiftserverless.ConfigParameter {
+func expandConfigParameter(tfMap map[string]interface{}) *awstypes.ConfigParameter {
 	if tfMap == nil {
 		return nil
 	}
 
-	apiObject := &redshiftserverless.ConfigParameter{}
+	apiObject := &awstypes.ConfigParameter{}
 
 	if v, ok := tfMap["parameter_key"].(string); ok {
 		apiObject.ParameterKey = aws.String(v)
@@ -581,12 +627,12 @@ func expandConfigParameter(tfMap map[string]interface{}) *redshiftserverless.Con
 	return apiObject
 }
 
-func expandConfigParameters(tfList []interface{}) []*redshiftserverless.ConfigParameter {
+func expandConfigParameters(tfList []interface{}) []awstypes.ConfigParameter {
 	if len(tfList) == 0 {
 		return nil
 	}
 
-	var apiObjects []*redshiftserverless.ConfigParameter
+	var apiObjects []awstypes.ConfigParameter
 
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -601,13 +647,13 @@ func expandConfigParameters(tfList []interface{}) []*redshiftserverless.ConfigPa
 			continue
 		}
 
-		apiObjects = append(apiObjects, apiObject)
+		apiObjects = append(apiObjects, *apiObject)
 	}
 
 	return apiObjects
 }
 
-func flattenConfigParameter(apiObject *redshiftserverless.ConfigParameter) map[string]interface{} {
+func flattenConfigParameter(apiObject *awstypes.ConfigParameter) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
@@ -615,16 +661,16 @@ func flattenConfigParameter(apiObject *redshiftserverless.ConfigParameter) map[s
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.ParameterKey; v != nil {
-		tfMap["parameter_key"] = aws.StringValue(v)
+		tfMap["parameter_key"] = aws.ToString(v)
 	}
 
 	if v := apiObject.ParameterValue; v != nil {
-		tfMap["parameter_value"] = aws.StringValue(v)
+		tfMap["parameter_value"] = aws.ToString(v)
 	}
 	return tfMap
 }
 
-func flattenConfigParameters(apiObjects []*redshiftserverless.ConfigParameter) []interface{} {
+func flattenConfigParameters(apiObjects []awstypes.ConfigParameter) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -632,28 +678,60 @@ func flattenConfigParameters(apiObjects []*redshiftserverless.ConfigParameter) [
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
-		tfList = append(tfList, flattenConfigParameter(apiObject))
+		tfList = append(tfList, flattenConfigParameter(&apiObject))
 	}
 
 	return tfList
 }
 
-func flattenEndpoint(apiObject *redshiftserverless.Endpoint) map[string]interface{} {
+func expandPerformanceTarget(tfMap map[string]interface{}) *awstypes.PerformanceTarget {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.PerformanceTarget{
+		Status: awstypes.PerformanceTargetStatusDisabled,
+	}
+
+	if v, ok := tfMap[names.AttrEnabled].(bool); ok && v {
+		apiObject.Status = awstypes.PerformanceTargetStatusEnabled
+	}
+
+	if v, ok := tfMap["level"].(int); ok && v != 0 {
+		apiObject.Level = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenPerformanceTarget(apiObject *awstypes.PerformanceTarget) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrEnabled: apiObject.Status == awstypes.PerformanceTargetStatusEnabled,
+	}
+
+	if v := apiObject.Level; v != nil {
+		tfMap["level"] = aws.ToInt32(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenEndpoint(apiObject *awstypes.Endpoint) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]interface{}{}
 	if v := apiObject.Address; v != nil {
-		tfMap[names.AttrAddress] = aws.StringValue(v)
+		tfMap[names.AttrAddress] = aws.ToString(v)
 	}
 
 	if v := apiObject.Port; v != nil {
-		tfMap[names.AttrPort] = aws.Int64Value(v)
+		tfMap[names.AttrPort] = aws.ToInt32(v)
 	}
 
 	if v := apiObject.VpcEndpoints; v != nil {
@@ -663,7 +741,7 @@ func flattenEndpoint(apiObject *redshiftserverless.Endpoint) map[string]interfac
 	return tfMap
 }
 
-func flattenVPCEndpoints(apiObjects []*redshiftserverless.VpcEndpoint) []interface{} {
+func flattenVPCEndpoints(apiObjects []awstypes.VpcEndpoint) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -671,17 +749,13 @@ func flattenVPCEndpoints(apiObjects []*redshiftserverless.VpcEndpoint) []interfa
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
-		tfList = append(tfList, flattenVPCEndpoint(apiObject))
+		tfList = append(tfList, flattenVPCEndpoint(&apiObject))
 	}
 
 	return tfList
 }
 
-func flattenVPCEndpoint(apiObject *redshiftserverless.VpcEndpoint) map[string]interface{} {
+func flattenVPCEndpoint(apiObject *awstypes.VpcEndpoint) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
@@ -689,11 +763,11 @@ func flattenVPCEndpoint(apiObject *redshiftserverless.VpcEndpoint) map[string]in
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.VpcEndpointId; v != nil {
-		tfMap[names.AttrVPCEndpointID] = aws.StringValue(v)
+		tfMap[names.AttrVPCEndpointID] = aws.ToString(v)
 	}
 
 	if v := apiObject.VpcId; v != nil {
-		tfMap[names.AttrVPCID] = aws.StringValue(v)
+		tfMap[names.AttrVPCID] = aws.ToString(v)
 	}
 
 	if v := apiObject.NetworkInterfaces; v != nil {
@@ -702,7 +776,7 @@ func flattenVPCEndpoint(apiObject *redshiftserverless.VpcEndpoint) map[string]in
 	return tfMap
 }
 
-func flattenNetworkInterfaces(apiObjects []*redshiftserverless.NetworkInterface) []interface{} {
+func flattenNetworkInterfaces(apiObjects []awstypes.NetworkInterface) []interface{} {
 	if len(apiObjects) == 0 {
 		return nil
 	}
@@ -710,17 +784,13 @@ func flattenNetworkInterfaces(apiObjects []*redshiftserverless.NetworkInterface)
 	var tfList []interface{}
 
 	for _, apiObject := range apiObjects {
-		if apiObject == nil {
-			continue
-		}
-
-		tfList = append(tfList, flattenNetworkInterface(apiObject))
+		tfList = append(tfList, flattenNetworkInterface(&apiObject))
 	}
 
 	return tfList
 }
 
-func flattenNetworkInterface(apiObject *redshiftserverless.NetworkInterface) map[string]interface{} {
+func flattenNetworkInterface(apiObject *awstypes.NetworkInterface) map[string]interface{} {
 	if apiObject == nil {
 		return nil
 	}
@@ -728,19 +798,19 @@ func flattenNetworkInterface(apiObject *redshiftserverless.NetworkInterface) map
 	tfMap := map[string]interface{}{}
 
 	if v := apiObject.AvailabilityZone; v != nil {
-		tfMap[names.AttrAvailabilityZone] = aws.StringValue(v)
+		tfMap[names.AttrAvailabilityZone] = aws.ToString(v)
 	}
 
 	if v := apiObject.NetworkInterfaceId; v != nil {
-		tfMap[names.AttrNetworkInterfaceID] = aws.StringValue(v)
+		tfMap[names.AttrNetworkInterfaceID] = aws.ToString(v)
 	}
 
 	if v := apiObject.PrivateIpAddress; v != nil {
-		tfMap["private_ip_address"] = aws.StringValue(v)
+		tfMap["private_ip_address"] = aws.ToString(v)
 	}
 
 	if v := apiObject.SubnetId; v != nil {
-		tfMap[names.AttrSubnetID] = aws.StringValue(v)
+		tfMap[names.AttrSubnetID] = aws.ToString(v)
 	}
 	return tfMap
 }
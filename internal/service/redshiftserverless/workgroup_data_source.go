@@ -119,7 +119,7 @@ func dataSourceWorkgroup() *schema.Resource {
 
 func dataSourceWorkgroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RedshiftServerlessConn(ctx)
+	conn := meta.(*conns.AWSClient).RedshiftServerlessClient(ctx)
 
 	workgroupName := d.Get("workgroup_name").(string)
 
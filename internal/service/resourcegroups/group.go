@@ -44,10 +44,20 @@ func resourceGroup() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"application_tag": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"criticality": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
 			names.AttrConfiguration: {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -83,11 +93,21 @@ func resourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 300),
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			names.AttrOwner: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 300),
+			},
 			"resource_query": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -126,6 +146,18 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		Tags:        getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("criticality"); ok {
+		input.Criticality = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		input.DisplayName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrOwner); ok {
+		input.Owner = aws.String(v.(string))
+	}
+
 	waitForConfigurationAttached := false
 	if groupCfg, set := d.GetOk(names.AttrConfiguration); set {
 		// Only expand and add configuration if its set
@@ -171,9 +203,13 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	arn := aws.ToString(group.GroupArn)
+	d.Set("application_tag", group.ApplicationTag)
 	d.Set(names.AttrARN, arn)
+	d.Set("criticality", aws.ToInt32(group.Criticality))
 	d.Set(names.AttrDescription, group.Description)
+	d.Set("display_name", group.DisplayName)
 	d.Set(names.AttrName, group.Name)
+	d.Set(names.AttrOwner, group.Owner)
 
 	q, err := conn.GetGroupQuery(ctx, &resourcegroups.GetGroupQueryInput{
 		GroupName: aws.String(d.Id()),
@@ -226,10 +262,16 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("conversion between resource-query and configuration group types is not possible")
 	}
 
-	if d.HasChange(names.AttrDescription) {
+	if d.HasChanges("criticality", names.AttrDescription, "display_name", names.AttrOwner) {
 		input := &resourcegroups.UpdateGroupInput{
 			Description: aws.String(d.Get(names.AttrDescription).(string)),
-			GroupName:   aws.String(d.Id()),
+			DisplayName: aws.String(d.Get("display_name").(string)),
+			Group:       aws.String(d.Id()),
+			Owner:       aws.String(d.Get(names.AttrOwner).(string)),
+		}
+
+		if v, ok := d.GetOk("criticality"); ok {
+			input.Criticality = aws.Int32(int32(v.(int)))
 		}
 
 		_, err := conn.UpdateGroup(ctx, input)
@@ -285,6 +285,45 @@ func TestAccResourceGroupsGroup_resourceQueryAndConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroupsGroup_application(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v types.Group
+	resourceName := "aws_resourcegroups_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_application(rName, 1, "Application", "team-a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criticality", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Application"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwner, "team-a"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGroupConfig_application(rName, 5, "Updated application", "team-b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceGroupExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "criticality", "5"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Updated application"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrOwner, "team-b"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceGroupExists(ctx context.Context, n string, v *types.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -502,3 +541,21 @@ JSON
 }
 `, rName, query, configType)
 }
+
+func testAccGroupConfig_application(rName string, criticality int, displayName, owner string) string {
+	return fmt.Sprintf(`
+resource "aws_resourcegroups_group" "test" {
+  name         = %[1]q
+  criticality  = %[2]d
+  display_name = %[3]q
+  owner        = %[4]q
+
+  resource_query {
+    query = <<JSON
+%[5]s
+JSON
+
+  }
+}
+`, rName, criticality, displayName, owner, testAccResourceGroupQueryConfig)
+}
@@ -41,6 +41,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_resourcegroups_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  resourceTagSyncTask,
+			TypeName: "aws_resourcegroups_tag_sync_task",
+			Name:     "Tag Sync Task",
+		},
 	}
 }
 
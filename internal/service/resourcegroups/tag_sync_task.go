@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroups
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_resourcegroups_tag_sync_task", name="Tag Sync Task")
+func resourceTagSyncTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagSyncTaskCreate,
+		ReadWithoutTimeout:   resourceTagSyncTaskRead,
+		DeleteWithoutTimeout: resourceTagSyncTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_query": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"resource_query", "tag_key"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          types.QueryTypeTagFilters10,
+							ValidateDiagFunc: enum.Validate[types.QueryType](),
+						},
+					},
+				},
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tag_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				RequiredWith: []string{"tag_value"},
+			},
+			"tag_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				RequiredWith: []string{"tag_key"},
+			},
+			"task_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTagSyncTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	group := d.Get("group").(string)
+	input := &resourcegroups.StartTagSyncTaskInput{
+		Group:   aws.String(group),
+		RoleArn: aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk("resource_query"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ResourceQuery = expandResourceQuery(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("tag_key"); ok {
+		input.TagKey = aws.String(v.(string))
+		input.TagValue = aws.String(d.Get("tag_value").(string))
+	}
+
+	output, err := conn.StartTagSyncTask(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("creating Resource Groups Tag Sync Task (%s): %s", group, err)
+	}
+
+	d.SetId(aws.ToString(output.TaskArn))
+
+	if _, err := waitTagSyncTaskActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("waiting for Resource Groups Tag Sync Task (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceTagSyncTaskRead(ctx, d, meta)
+}
+
+func resourceTagSyncTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	output, err := findTagSyncTaskByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource Groups Tag Sync Task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	if output.CreatedAt != nil {
+		d.Set(names.AttrCreatedAt, aws.ToTime(output.CreatedAt).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrCreatedAt, nil)
+	}
+	if _, ok := d.GetOk("group"); !ok {
+		d.Set("group", output.GroupArn)
+	}
+	d.Set("group_arn", output.GroupArn)
+	d.Set("group_name", output.GroupName)
+	if output.ResourceQuery != nil {
+		if err := d.Set("resource_query", []interface{}{flattenResourceQuery(output.ResourceQuery)}); err != nil {
+			return diag.Errorf("setting resource_query: %s", err)
+		}
+	} else {
+		d.Set("resource_query", nil)
+	}
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set("tag_key", output.TagKey)
+	d.Set("tag_value", output.TagValue)
+	d.Set("task_arn", output.TaskArn)
+
+	return nil
+}
+
+func resourceTagSyncTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Resource Groups Tag Sync Task: %s", d.Id())
+	_, err := conn.CancelTagSyncTask(ctx, &resourcegroups.CancelTagSyncTaskInput{
+		TaskArn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting Resource Groups Tag Sync Task (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func findTagSyncTaskByARN(ctx context.Context, conn *resourcegroups.Client, arn string) (*resourcegroups.GetTagSyncTaskOutput, error) {
+	input := &resourcegroups.GetTagSyncTaskInput{
+		TaskArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTagSyncTask(ctx, input)
+
+	if errs.IsA[*types.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusTagSyncTask(ctx context.Context, conn *resourcegroups.Client, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTagSyncTaskByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitTagSyncTaskActive(ctx context.Context, conn *resourcegroups.Client, arn string, timeout time.Duration) (*resourcegroups.GetTagSyncTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		// A newly started task may briefly be returned without a status.
+		Pending: []string{""},
+		Target:  enum.Slice(types.TagSyncTaskStatusActive),
+		Refresh: statusTagSyncTask(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resourcegroups.GetTagSyncTaskOutput); ok {
+		if output.Status == types.TagSyncTaskStatusError {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenResourceQuery(apiObject *types.ResourceQuery) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"query":        aws.ToString(apiObject.Query),
+		names.AttrType: apiObject.Type,
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroups"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroups "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroups"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTagSyncTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resourcegroups.GetTagSyncTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroups_tag_sync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagSyncTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagSyncTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagSyncTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "group_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "tag_key", "Project"),
+					resource.TestCheckResourceAttr(resourceName, "tag_value", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "task_arn", resourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group"},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTagSyncTask_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resourcegroups.GetTagSyncTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroups_tag_sync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagSyncTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagSyncTaskConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagSyncTaskExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfresourcegroups.ResourceTagSyncTask(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTagSyncTask_resourceQuery(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v resourcegroups.GetTagSyncTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroups_tag_sync_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagSyncTaskDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagSyncTaskConfig_resourceQuery(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagSyncTaskExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_query.0.type", "TAG_FILTERS_1_0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckNoResourceAttr(resourceName, "tag_key"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"group"},
+			},
+		},
+	})
+}
+
+func testAccCheckTagSyncTaskDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroups_tag_sync_task" {
+				continue
+			}
+
+			_, err := tfresourcegroups.FindTagSyncTaskByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resource Groups Tag Sync Task %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagSyncTaskExists(ctx context.Context, n string, v *resourcegroups.GetTagSyncTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsClient(ctx)
+
+		output, err := tfresourcegroups.FindTagSyncTaskByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTagSyncTaskConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_servicecatalogappregistry_application" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "resource-groups.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  role       = aws_iam_role.test.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ResourceGroupsTaggingAPITagUntagSupportedResources"
+}
+`, rName)
+}
+
+func testAccTagSyncTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTagSyncTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroups_tag_sync_task" "test" {
+  group     = aws_servicecatalogappregistry_application.test.application_tag["awsApplication"]
+  role_arn  = aws_iam_role.test.arn
+  tag_key   = "Project"
+  tag_value = %[1]q
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
+
+func testAccTagSyncTaskConfig_resourceQuery(rName string) string {
+	return acctest.ConfigCompose(testAccTagSyncTaskConfig_base(rName), fmt.Sprintf(`
+resource "aws_resourcegroups_tag_sync_task" "test" {
+  group    = aws_servicecatalogappregistry_application.test.application_tag["awsApplication"]
+  role_arn = aws_iam_role.test.arn
+
+  resource_query {
+    query = jsonencode({
+      ResourceTypeFilters = ["AWS::AllSupported"]
+      TagFilters = [{
+        Key    = "Project"
+        Values = [%[1]q]
+      }]
+    })
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName))
+}
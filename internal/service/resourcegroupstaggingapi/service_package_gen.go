@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceResources,
 			TypeName: "aws_resourcegroupstaggingapi_resources",
 		},
+		{
+			Factory:  dataSourceUntaggedResources,
+			TypeName: "aws_resourcegroupstaggingapi_untagged_resources",
+			Name:     "Untagged Resources",
+		},
 	}
 }
 
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_resourcegroupstaggingapi_untagged_resources", name="Untagged Resources")
+func dataSourceUntaggedResources() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUntaggedResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"required_tag_keys": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_type_filters": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"missing_tag_keys": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUntaggedResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	requiredTagKeys := flex.ExpandStringValueSet(d.Get("required_tag_keys").(*schema.Set))
+	slices.Sort(requiredTagKeys)
+
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: flex.ExpandStringValueSet(d.Get("resource_type_filters").(*schema.Set)),
+	}
+
+	var arns []string
+	var resources []interface{}
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			missingTagKeys := missingTagKeys(v.Tags, requiredTagKeys)
+
+			if len(missingTagKeys) == 0 {
+				continue
+			}
+
+			arn := aws.ToString(v.ResourceARN)
+			arns = append(arns, arn)
+			resources = append(resources, map[string]interface{}{
+				"missing_tag_keys":    missingTagKeys,
+				names.AttrResourceARN: arn,
+				names.AttrTags:        KeyValueTags(ctx, v.Tags).Map(),
+			})
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("resource_arns", arns)
+	if err := d.Set("resources", resources); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resources: %s", err)
+	}
+
+	return diags
+}
+
+// missingTagKeys returns the required tag keys (in order) that are not present in the specified tags.
+func missingTagKeys(tags []types.Tag, requiredTagKeys []string) []string {
+	var missing []string
+
+	for _, key := range requiredTagKeys {
+		if !slices.ContainsFunc(tags, func(v types.Tag) bool {
+			return aws.ToString(v.Key) == key
+		}) {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIUntaggedResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_untagged_resources.test"
+	resourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUntaggedResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "resource_arns.*", resourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resources.*", map[string]string{
+						"missing_tag_keys.#": acctest.Ct1,
+						"missing_tag_keys.0": rName + "-missing",
+						"tags.%":             acctest.Ct1,
+						"tags." + rName:      "present",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccUntaggedResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    %[1]q = "present"
+  }
+}
+
+data "aws_resourcegroupstaggingapi_untagged_resources" "test" {
+  resource_type_filters = ["ec2:vpc"]
+  required_tag_keys     = [%[1]q, "%[1]s-missing"]
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package robomaker
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/robomaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/robomaker/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_robomaker_simulation_application", name="Simulation Application")
+func dataSourceSimulationApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSimulationApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"environment_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"robot_software_suite": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"simulation_software_suite": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"architecture": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrS3Bucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSimulationApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RoboMakerClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	output, err := findSimulationApplicationByTwoPartKey(ctx, conn, arn, d.Get(names.AttrVersion).(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RoboMaker Simulation Application (%s): %s", arn, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+	d.Set(names.AttrARN, output.Arn)
+	if output.Environment != nil {
+		d.Set("environment_uri", output.Environment.Uri)
+	} else {
+		d.Set("environment_uri", nil)
+	}
+	d.Set("image_digest", output.ImageDigest)
+	if output.LastUpdatedAt != nil {
+		d.Set("last_updated_at", aws.ToTime(output.LastUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_at", nil)
+	}
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set("robot_software_suite", flattenRobotSoftwareSuite(output.RobotSoftwareSuite)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting robot_software_suite: %s", err)
+	}
+	if err := d.Set("simulation_software_suite", flattenSimulationSoftwareSuite(output.SimulationSoftwareSuite)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting simulation_software_suite: %s", err)
+	}
+	if err := d.Set("source", flattenSources(output.Sources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	d.Set(names.AttrVersion, output.Version)
+
+	return diags
+}
+
+func findSimulationApplicationByTwoPartKey(ctx context.Context, conn *robomaker.Client, arn, version string) (*robomaker.DescribeSimulationApplicationOutput, error) {
+	input := &robomaker.DescribeSimulationApplicationInput{
+		Application: aws.String(arn),
+	}
+	if version != "" {
+		input.ApplicationVersion = aws.String(version)
+	}
+
+	output, err := conn.DescribeSimulationApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenRobotSoftwareSuite(apiObject *awstypes.RobotSoftwareSuite) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrName:    string(apiObject.Name),
+		names.AttrVersion: string(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSimulationSoftwareSuite(apiObject *awstypes.SimulationSoftwareSuite) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrName:    string(apiObject.Name),
+		names.AttrVersion: aws.ToString(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSources(apiObjects []awstypes.Source) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"architecture":     string(apiObject.Architecture),
+			"etag":             aws.ToString(apiObject.Etag),
+			names.AttrS3Bucket: aws.ToString(apiObject.S3Bucket),
+			"s3_key":           aws.ToString(apiObject.S3Key),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package robomaker_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoboMakerSimulationApplicationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "ROBOMAKER_SIMULATION_APPLICATION_ARN"
+	arn := acctest.SkipIfEnvVarNotSet(t, key)
+	dataSourceName := "data.aws_robomaker_simulation_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.RoboMakerEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RoboMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSimulationApplicationDataSourceConfig_basic(arn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrARN, arn),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "simulation_software_suite.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func testAccSimulationApplicationDataSourceConfig_basic(arn string) string {
+	return fmt.Sprintf(`
+data "aws_robomaker_simulation_application" "test" {
+  arn = %[1]q
+}
+`, arn)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package robomaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/robomaker"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/robomaker/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_robomaker_simulation_applications", name="Simulation Applications")
+func dataSourceSimulationApplications() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSimulationApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSimulationApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RoboMakerClient(ctx)
+
+	input := &robomaker.ListSimulationApplicationsInput{}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Filters = []awstypes.Filter{{
+			Name:   aws.String(names.AttrName),
+			Values: []string{v.(string)},
+		}}
+	}
+
+	applications, err := findSimulationApplications(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RoboMaker Simulation Applications: %s", err)
+	}
+
+	var arns, applicationNames []string
+	for _, v := range applications {
+		arns = append(arns, aws.ToString(v.Arn))
+		applicationNames = append(applicationNames, aws.ToString(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, applicationNames)
+
+	return diags
+}
+
+func findSimulationApplications(ctx context.Context, conn *robomaker.Client, input *robomaker.ListSimulationApplicationsInput) ([]awstypes.SimulationApplicationSummary, error) {
+	var output []awstypes.SimulationApplicationSummary
+
+	pages := robomaker.NewListSimulationApplicationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SimulationApplicationSummaries...)
+	}
+
+	return output, nil
+}
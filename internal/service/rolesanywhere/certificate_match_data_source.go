@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_rolesanywhere_certificate_match", name="Certificate Match")
+func DataSourceCertificateMatch() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCertificateMatchRead,
+
+		Schema: map[string]*schema.Schema{
+			"expired": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role_arns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_anchors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revoked": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrSourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"x509_certificate_chain": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"x509_certificate_data": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceCertificateMatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	certificates, err := parseCertificates([]byte(d.Get("x509_certificate_data").(string)))
+
+	if err != nil {
+		return diag.Errorf("parsing x509_certificate_data: %s", err)
+	}
+
+	if len(certificates) == 0 {
+		return diag.Errorf("x509_certificate_data contains no certificates")
+	}
+
+	certificate := certificates[0]
+	intermediates := x509.NewCertPool()
+
+	// Any further certificates in the data are treated as part of the chain.
+	for _, v := range certificates[1:] {
+		intermediates.AddCert(v)
+	}
+
+	if v, ok := d.GetOk("x509_certificate_chain"); ok {
+		chain, err := parseCertificates([]byte(v.(string)))
+
+		if err != nil {
+			return diag.Errorf("parsing x509_certificate_chain: %s", err)
+		}
+
+		for _, v := range chain {
+			intermediates.AddCert(v)
+		}
+	}
+
+	trustAnchors, err := findTrustAnchors(ctx, conn, &rolesanywhere.ListTrustAnchorsInput{})
+
+	if err != nil {
+		return diag.Errorf("listing RolesAnywhere Trust Anchors: %s", err)
+	}
+
+	crls, err := findCRLs(ctx, conn, &rolesanywhere.ListCrlsInput{})
+
+	if err != nil {
+		return diag.Errorf("listing RolesAnywhere CRLs: %s", err)
+	}
+
+	now := time.Now()
+	var tfList []interface{}
+
+	for _, trustAnchor := range trustAnchors {
+		anchors, err := trustAnchorCertificates(ctx, meta.(*conns.AWSClient), trustAnchor.Source)
+
+		// A CA that cannot be read (e.g. a private CA in another account) cannot be matched against.
+		if err != nil {
+			log.Printf("[WARN] reading RolesAnywhere Trust Anchor (%s) certificates: %s", aws.ToString(trustAnchor.TrustAnchorId), err)
+			continue
+		}
+
+		if !certificateChainsTo(certificate, intermediates, anchors, now) {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:        aws.ToString(trustAnchor.TrustAnchorArn),
+			names.AttrEnabled:    aws.ToBool(trustAnchor.Enabled),
+			names.AttrID:         aws.ToString(trustAnchor.TrustAnchorId),
+			names.AttrName:       aws.ToString(trustAnchor.Name),
+			"revoked":            certificateRevoked(certificate, aws.ToString(trustAnchor.TrustAnchorArn), crls),
+			names.AttrSourceType: trustAnchor.Source.SourceType,
+		})
+	}
+
+	// Roles Anywhere does not associate profiles with trust anchors: a session may be created with
+	// any enabled profile, subject to the trust policies of the profile's roles.
+	profiles, err := findProfiles(ctx, conn, &rolesanywhere.ListProfilesInput{})
+
+	if err != nil {
+		return diag.Errorf("listing RolesAnywhere Profiles: %s", err)
+	}
+
+	var tfProfiles []interface{}
+
+	if len(tfList) > 0 {
+		for _, profile := range profiles {
+			if !aws.ToBool(profile.Enabled) {
+				continue
+			}
+
+			tfProfiles = append(tfProfiles, map[string]interface{}{
+				names.AttrARN:  aws.ToString(profile.ProfileArn),
+				names.AttrID:   aws.ToString(profile.ProfileId),
+				names.AttrName: aws.ToString(profile.Name),
+				"role_arns":    profile.RoleArns,
+			})
+		}
+	}
+
+	fingerprint := sha256.Sum256(certificate.Raw)
+	d.SetId(hex.EncodeToString(fingerprint[:]))
+	d.Set("expired", now.After(certificate.NotAfter))
+	d.Set("issuer", certificate.Issuer.String())
+	d.Set("not_after", certificate.NotAfter.Format(time.RFC3339))
+	d.Set("not_before", certificate.NotBefore.Format(time.RFC3339))
+	if err := d.Set("profiles", tfProfiles); err != nil {
+		return diag.Errorf("setting profiles: %s", err)
+	}
+	d.Set("serial_number", certificate.SerialNumber.Text(16))
+	d.Set("subject", certificate.Subject.String())
+	if err := d.Set("trust_anchors", tfList); err != nil {
+		return diag.Errorf("setting trust_anchors: %s", err)
+	}
+
+	return nil
+}
+
+// trustAnchorCertificates returns the CA certificates that the specified trust anchor source trusts.
+func trustAnchorCertificates(ctx context.Context, client *conns.AWSClient, source *types.Source) ([]*x509.Certificate, error) {
+	if source == nil {
+		return nil, nil
+	}
+
+	switch v := source.SourceData.(type) {
+	case *types.SourceDataMemberX509CertificateData:
+		return parseCertificates([]byte(v.Value))
+	case *types.SourceDataMemberAcmPcaArn:
+		output, err := client.ACMPCAClient(ctx).GetCertificateAuthorityCertificate(ctx, &acmpca.GetCertificateAuthorityCertificateInput{
+			CertificateAuthorityArn: aws.String(v.Value),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return parseCertificates([]byte(aws.ToString(output.Certificate)))
+	default:
+		return nil, nil
+	}
+}
+
+// certificateChainsTo returns whether the certificate chains to one of the anchors.
+// An expired certificate is still considered to chain to the anchor that issued it.
+func certificateChainsTo(certificate *x509.Certificate, intermediates *x509.CertPool, anchors []*x509.Certificate, now time.Time) bool {
+	if len(anchors) == 0 {
+		return false
+	}
+
+	roots := x509.NewCertPool()
+	for _, v := range anchors {
+		roots.AddCert(v)
+	}
+
+	opts := x509.VerifyOptions{
+		CurrentTime:   now,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		Roots:         roots,
+	}
+
+	// Validity is reported separately, so verify the chain at a time at which the certificate is valid.
+	if now.After(certificate.NotAfter) {
+		opts.CurrentTime = certificate.NotAfter
+	} else if now.Before(certificate.NotBefore) {
+		opts.CurrentTime = certificate.NotBefore
+	}
+
+	_, err := certificate.Verify(opts)
+
+	return err == nil
+}
+
+// certificateRevoked returns whether the certificate is listed in any enabled CRL attached to the trust anchor.
+func certificateRevoked(certificate *x509.Certificate, trustAnchorARN string, crls []types.CrlDetail) bool {
+	for _, crl := range crls {
+		if aws.ToString(crl.TrustAnchorArn) != trustAnchorARN || !aws.ToBool(crl.Enabled) {
+			continue
+		}
+
+		data := crl.CrlData
+		if block, _ := pem.Decode(data); block != nil {
+			data = block.Bytes
+		}
+
+		list, err := x509.ParseRevocationList(data)
+
+		if err != nil {
+			continue
+		}
+
+		for _, v := range list.RevokedCertificateEntries {
+			if v.SerialNumber.Cmp(certificate.SerialNumber) == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// parseCertificates parses PEM-encoded certificates, skipping any other PEM blocks.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certificates []*x509.Certificate
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 && len(data) > 0 {
+		return nil, errors.New("no PEM-encoded certificates found")
+	}
+
+	return certificates, nil
+}
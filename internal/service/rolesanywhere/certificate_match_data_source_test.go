@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere_test
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRolesAnywhereCertificateMatchDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_rolesanywhere_certificate_match.test"
+	trustAnchorResourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509LocallySignedCertificatePEM(t, caKey, caCertificate, key, acctest.RandomDomainName())
+
+	certificateBlock, _ := pem.Decode([]byte(certificate))
+	parsedCertificate, err := x509.ParseCertificate(certificateBlock.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crl := testAccCRLPEM(t, caKey, caCertificate, 1, parsedCertificate.SerialNumber)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateMatchDataSourceConfig_basic(rName, caCertificate, certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "expired", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "serial_number", parsedCertificate.SerialNumber.Text(16)),
+					resource.TestCheckResourceAttr(dataSourceName, "subject", parsedCertificate.Subject.String()),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "trust_anchors.*.arn", trustAnchorResourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "trust_anchors.*", map[string]string{
+						names.AttrName:       rName,
+						"revoked":            acctest.CtFalse,
+						names.AttrSourceType: "CERTIFICATE_BUNDLE",
+					}),
+				),
+			},
+			{
+				Config: testAccCertificateMatchDataSourceConfig_revoked(rName, caCertificate, certificate, crl),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "trust_anchors.*", map[string]string{
+						names.AttrName: rName,
+						"revoked":      acctest.CtTrue,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCertificateMatchDataSourceConfig_basic(rName, caCertificate, certificate string) string {
+	return acctest.ConfigCompose(testAccCRLConfig_base(rName, caCertificate), fmt.Sprintf(`
+data "aws_rolesanywhere_certificate_match" "test" {
+  x509_certificate_data = "%[1]s"
+
+  depends_on = [aws_rolesanywhere_trust_anchor.test]
+}
+`, acctest.TLSPEMEscapeNewlines(certificate)))
+}
+
+func testAccCertificateMatchDataSourceConfig_revoked(rName, caCertificate, certificate, crl string) string {
+	return acctest.ConfigCompose(testAccCRLConfig_basic(rName, caCertificate, crl), fmt.Sprintf(`
+data "aws_rolesanywhere_certificate_match" "test" {
+  x509_certificate_data = "%[1]s"
+
+  depends_on = [aws_rolesanywhere_crl.test]
+}
+`, acctest.TLSPEMEscapeNewlines(certificate)))
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
+	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_rolesanywhere_crl", name="CRL")
+// @Tags(identifierAttribute="arn")
+func ResourceCRL() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCRLCreate,
+		ReadWithoutTimeout:   resourceCRLRead,
+		UpdateWithoutTimeout: resourceCRLUpdate,
+		DeleteWithoutTimeout: resourceCRLDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"crl_data": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrEnabled: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"trust_anchor_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceCRLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &rolesanywhere.ImportCrlInput{
+		CrlData:        []byte(d.Get("crl_data").(string)),
+		Enabled:        aws.Bool(d.Get(names.AttrEnabled).(bool)),
+		Name:           aws.String(name),
+		Tags:           getTagsIn(ctx),
+		TrustAnchorArn: aws.String(d.Get("trust_anchor_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Importing RolesAnywhere CRL (%s)", name)
+	output, err := conn.ImportCrl(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("importing RolesAnywhere CRL (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Crl.CrlId))
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	crl, err := FindCRLByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RolesAnywhere CRL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("reading RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, crl.CrlArn)
+	d.Set(names.AttrEnabled, crl.Enabled)
+	d.Set(names.AttrName, crl.Name)
+	d.Set("trust_anchor_arn", crl.TrustAnchorArn)
+
+	// The service may return the revocation list in a different encoding than it was imported in,
+	// so only populate crl_data from the API when it is not already known (e.g. on import).
+	if d.Get("crl_data").(string) == "" {
+		d.Set("crl_data", string(crl.CrlData))
+	}
+
+	return nil
+}
+
+func resourceCRLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	// Replacing the revocation list in place keeps it attached to the trust anchor throughout,
+	// whereas re-importing it would leave a window in which revoked certificates are accepted.
+	if d.HasChanges("crl_data", names.AttrName) {
+		input := &rolesanywhere.UpdateCrlInput{
+			CrlId: aws.String(d.Id()),
+			Name:  aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if d.HasChange("crl_data") {
+			input.CrlData = []byte(d.Get("crl_data").(string))
+		}
+
+		log.Printf("[DEBUG] Updating RolesAnywhere CRL (%s)", d.Id())
+		_, err := conn.UpdateCrl(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("updating RolesAnywhere CRL (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange(names.AttrEnabled) {
+		if d.Get(names.AttrEnabled).(bool) {
+			_, err := conn.EnableCrl(ctx, &rolesanywhere.EnableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("enabling RolesAnywhere CRL (%s): %s", d.Id(), err)
+			}
+		} else {
+			_, err := conn.DisableCrl(ctx, &rolesanywhere.DisableCrlInput{
+				CrlId: aws.String(d.Id()),
+			})
+
+			if err != nil {
+				return diag.Errorf("disabling RolesAnywhere CRL (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceCRLRead(ctx, d, meta)
+}
+
+func resourceCRLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+	log.Printf("[DEBUG] Deleting RolesAnywhere CRL (%s)", d.Id())
+	_, err := conn.DeleteCrl(ctx, &rolesanywhere.DeleteCrlInput{
+		CrlId: aws.String(d.Id()),
+	})
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("deleting RolesAnywhere CRL (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
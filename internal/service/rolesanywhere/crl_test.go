@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rolesanywhere_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrolesanywhere "github.com/hashicorp/terraform-provider-aws/internal/service/rolesanywhere"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRolesAnywhereCRL_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl := testAccCRLPEM(t, caKey, caCertificate, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "rolesanywhere", regexache.MustCompile(`crl/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "trust_anchor_arn", "aws_rolesanywhere_trust_anchor.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"crl_data"},
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl := testAccCRLPEM(t, caKey, caCertificate, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrolesanywhere.ResourceCRL(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl1 := testAccCRLPEM(t, caKey, caCertificate, 1)
+	crl2 := testAccCRLPEM(t, caKey, caCertificate, 2)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_basic(rName, caCertificate, crl1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				Config: testAccCRLConfig_basic(rNameUpdated, caCertificate, crl2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "crl_data", crl2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRolesAnywhereCRL_enabled(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_crl.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+	crl := testAccCRLPEM(t, caKey, caCertificate, 1)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCRLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCRLConfig_enabled(rName, caCertificate, crl, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccCRLConfig_enabled(rName, caCertificate, crl, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCRLExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckCRLDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rolesanywhere_crl" {
+				continue
+			}
+
+			_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RolesAnywhere CRL %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCRLExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RolesAnywhere CRL ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
+
+		_, err := tfrolesanywhere.FindCRLByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+// testAccCRLPEM generates a PEM-encoded certificate revocation list, signed by the specified CA,
+// that revokes the certificates with the specified serial numbers.
+func testAccCRLPEM(t *testing.T, caKeyPem, caCertificatePem string, number int64, revokedSerialNumbers ...*big.Int) string {
+	t.Helper()
+
+	caCertificateBlock, _ := pem.Decode([]byte(caCertificatePem))
+
+	caCertificate, err := x509.ParseCertificate(caCertificateBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(caKeyPem))
+
+	caKey, err := x509.ParsePKCS1PrivateKey(caKeyBlock.Bytes)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	template := &x509.RevocationList{
+		NextUpdate: now.Add(24 * time.Hour),
+		Number:     big.NewInt(number),
+		ThisUpdate: now,
+	}
+
+	for _, v := range revokedSerialNumbers {
+		template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+			RevocationTime: now,
+			SerialNumber:   v,
+		})
+	}
+
+	crlBytes, err := x509.CreateRevocationList(rand.Reader, template, caCertificate, caKey)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Bytes: crlBytes,
+		Type:  "X509 CRL",
+	}))
+}
+
+func testAccCRLConfig_base(rName, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
+}
+
+func testAccCRLConfig_basic(rName, caCertificate, crl string) string {
+	return acctest.ConfigCompose(testAccCRLConfig_base(rName, caCertificate), fmt.Sprintf(`
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_data         = "%[2]s"
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl)))
+}
+
+func testAccCRLConfig_enabled(rName, caCertificate, crl string, enabled bool) string {
+	return acctest.ConfigCompose(testAccCRLConfig_base(rName, caCertificate), fmt.Sprintf(`
+resource "aws_rolesanywhere_crl" "test" {
+  name             = %[1]q
+  crl_data         = "%[2]s"
+  enabled          = %[3]t
+  trust_anchor_arn = aws_rolesanywhere_trust_anchor.test.arn
+}
+`, rName, acctest.TLSPEMEscapeNewlines(crl), enabled))
+}
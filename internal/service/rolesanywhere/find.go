@@ -65,3 +65,80 @@ func FindTrustAnchorByID(ctx context.Context, conn *rolesanywhere.Client, id str
 
 	return out.TrustAnchor, nil
 }
+
+func FindCRLByID(ctx context.Context, conn *rolesanywhere.Client, id string) (*types.CrlDetail, error) {
+	in := &rolesanywhere.GetCrlInput{
+		CrlId: aws.String(id),
+	}
+
+	out, err := conn.GetCrl(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Crl == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.Crl, nil
+}
+
+func findTrustAnchors(ctx context.Context, conn *rolesanywhere.Client, input *rolesanywhere.ListTrustAnchorsInput) ([]types.TrustAnchorDetail, error) {
+	var output []types.TrustAnchorDetail
+
+	pages := rolesanywhere.NewListTrustAnchorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.TrustAnchors...)
+	}
+
+	return output, nil
+}
+
+func findProfiles(ctx context.Context, conn *rolesanywhere.Client, input *rolesanywhere.ListProfilesInput) ([]types.ProfileDetail, error) {
+	var output []types.ProfileDetail
+
+	pages := rolesanywhere.NewListProfilesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Profiles...)
+	}
+
+	return output, nil
+}
+
+func findCRLs(ctx context.Context, conn *rolesanywhere.Client, input *rolesanywhere.ListCrlsInput) ([]types.CrlDetail, error) {
+	var output []types.CrlDetail
+
+	pages := rolesanywhere.NewListCrlsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Crls...)
+	}
+
+	return output, nil
+}
@@ -8,19 +8,24 @@ import (
 	"errors"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	notificationSettingConfiguredByService = "rolesanywhere.amazonaws.com"
+)
+
 // @SDKResource("aws_rolesanywhere_trust_anchor", name="Trust Anchor")
 // @Tags(identifierAttribute="arn")
 func ResourceTrustAnchor() *schema.Resource {
@@ -48,6 +53,39 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          string(types.NotificationChannelAll),
+							ValidateDiagFunc: enum.Validate[types.NotificationChannel](),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationEvent](),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			names.AttrSource: {
 				Type:     schema.TypeList,
 				Required: true,
@@ -101,6 +139,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -108,7 +150,7 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("creating RolesAnywhere Trust Anchor (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.TrustAnchor.TrustAnchorId))
+	d.SetId(aws.ToString(output.TrustAnchor.TrustAnchorId))
 
 	return resourceTrustAnchorRead(ctx, d, meta)
 }
@@ -132,6 +174,10 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrEnabled, trustAnchor.Enabled)
 	d.Set(names.AttrName, trustAnchor.Name)
 
+	if err := d.Set("notification_settings", flattenNotificationSettings(trustAnchor.NotificationSettings)); err != nil {
+		return diag.Errorf("setting notification_settings: %s", err)
+	}
+
 	if err := d.Set(names.AttrSource, flattenSource(trustAnchor.Source)); err != nil {
 		return diag.Errorf("setting source: %s", err)
 	}
@@ -142,7 +188,7 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChanges(names.AttrName, names.AttrSource) {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get(names.AttrName).(string)),
@@ -155,17 +201,49 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		if err != nil {
 			return diag.Errorf("updating RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
 		}
+	}
 
-		if d.HasChange(names.AttrEnabled) {
-			_, n := d.GetChange(names.AttrEnabled)
-			if n == true {
-				if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					diag.Errorf("enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
-			} else {
-				if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
-					diag.Errorf("disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
-				}
+	if d.HasChange(names.AttrEnabled) {
+		_, n := d.GetChange(names.AttrEnabled)
+		if n == true {
+			if err := enableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return diag.Errorf("enabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+			}
+		} else {
+			if err := disableTrustAnchor(ctx, d.Id(), meta); err != nil {
+				return diag.Errorf("disabling RolesAnywhere Trust Anchor (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Settings that are no longer configured revert to the service defaults.
+		if keys := notificationSettingKeysRemoved(os.List(), ns.List()); len(keys) > 0 {
+			input := &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: keys,
+				TrustAnchorId:           aws.String(d.Id()),
+			}
+
+			_, err := conn.ResetNotificationSettings(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+
+		if ns.Len() > 0 {
+			input := &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: expandNotificationSettings(ns.List()),
+				TrustAnchorId:        aws.String(d.Id()),
+			}
+
+			_, err := conn.PutNotificationSettings(ctx, input)
+
+			if err != nil {
+				return diag.Errorf("putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
 			}
 		}
 	}
@@ -274,6 +352,76 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 	return result
 }
 
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// notificationSettingKeysRemoved returns the keys of the notification settings in old that are not in new.
+func notificationSettingKeysRemoved(oldList, newList []interface{}) []types.NotificationSettingKey {
+	keys := make(map[types.NotificationSettingKey]struct{})
+
+	for _, v := range expandNotificationSettings(newList) {
+		keys[types.NotificationSettingKey{Channel: v.Channel, Event: v.Event}] = struct{}{}
+	}
+
+	var apiObjects []types.NotificationSettingKey
+
+	for _, v := range expandNotificationSettings(oldList) {
+		key := types.NotificationSettingKey{Channel: v.Channel, Event: v.Event}
+
+		if _, ok := keys[key]; !ok {
+			apiObjects = append(apiObjects, key)
+		}
+	}
+
+	return apiObjects
+}
+
+func flattenNotificationSettings(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		// Settings that have not been customized are reported with the service as the configurer.
+		if aws.ToString(apiObject.ConfiguredBy) == notificationSettingConfiguredByService {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":         apiObject.Channel,
+			"configured_by":   aws.ToString(apiObject.ConfiguredBy),
+			names.AttrEnabled: aws.ToBool(apiObject.Enabled),
+			"event":           apiObject.Event,
+			"threshold":       aws.ToInt32(apiObject.Threshold),
+		})
+	}
+
+	return tfList
+}
+
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -194,6 +195,77 @@ func TestAccRolesAnywhereTrustAnchor_enabled(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereTrustAnchor_notificationSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustAnchorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings1(rName, caCertificate, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":   "ALL",
+						"enabled":   acctest.CtTrue,
+						"event":     "CA_CERTIFICATE_EXPIRY",
+						"threshold": "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings2(rName, caCertificate, 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":   "ALL",
+						"enabled":   acctest.CtTrue,
+						"event":     "CA_CERTIFICATE_EXPIRY",
+						"threshold": "10",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":   "ALL",
+						"enabled":   acctest.CtFalse,
+						"event":     "END_ENTITY_CERTIFICATE_EXPIRY",
+						"threshold": "7",
+					}),
+				),
+			},
+			{
+				Config: testAccTrustAnchorConfig_certificateBundleWithCertificate(rName, caCertificate),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTrustAnchorDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
@@ -352,6 +424,67 @@ resource "aws_rolesanywhere_trust_anchor" "test" {
 `, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
 }
 
+func testAccTrustAnchorConfig_certificateBundleWithCertificate(rName, caCertificate string) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate))
+}
+
+func testAccTrustAnchorConfig_notificationSettings1(rName, caCertificate string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+
+  notification_settings {
+    enabled   = true
+    event     = "CA_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), threshold)
+}
+
+func testAccTrustAnchorConfig_notificationSettings2(rName, caCertificate string, threshold int) string {
+	return fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      x509_certificate_data = "%[2]s"
+    }
+    source_type = "CERTIFICATE_BUNDLE"
+  }
+
+  notification_settings {
+    enabled   = true
+    event     = "CA_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+
+  notification_settings {
+    channel   = "ALL"
+    enabled   = false
+    event     = "END_ENTITY_CERTIFICATE_EXPIRY"
+    threshold = 7
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(caCertificate), threshold)
+}
+
 func testAccTrustAnchorConfig_enabled(t *testing.T, rName string, enabled bool) string {
 	caKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
 	caCertificate := acctest.TLSRSAX509SelfSignedCACertificateForRolesAnywhereTrustAnchorPEM(t, caKey)
@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53recoverycontrolconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	r53rc "github.com/aws/aws-sdk-go/service/route53recoverycluster"
+	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_route53recoverycontrolconfig_routing_control_state")
+func ResourceRoutingControlState() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRoutingControlStateCreate,
+		ReadWithoutTimeout:   resourceRoutingControlStateRead,
+		UpdateWithoutTimeout: resourceRoutingControlStateUpdate,
+		DeleteWithoutTimeout: resourceRoutingControlStateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"routing_control_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"routing_control_state": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(r53rc.RoutingControlState_Values(), false),
+			},
+			"safety_rules_to_override": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
+func resourceRoutingControlStateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	arn := d.Get("routing_control_arn").(string)
+
+	if err := updateRoutingControlState(ctx, d, meta); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Control Config Routing Control (%s) state: %s", arn, err)
+	}
+
+	d.SetId(arn)
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	endpoints, err := findClusterEndpointsByRoutingControlARN(ctx, meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx), d.Id())
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, r53rcc.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Route53 Recovery Control Config Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control (%s) cluster endpoints: %s", d.Id(), err)
+	}
+
+	var output *r53rc.GetRoutingControlStateOutput
+	err = forEachClusterEndpoint(ctx, meta, endpoints, func(conn *r53rc.Route53RecoveryCluster) error {
+		var err error
+
+		output, err = conn.GetRoutingControlStateWithContext(ctx, &r53rc.GetRoutingControlStateInput{
+			RoutingControlArn: aws.String(d.Id()),
+		})
+
+		return err
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Route53 Recovery Control Config Routing Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Recovery Control Config Routing Control (%s) state: %s", d.Id(), err)
+	}
+
+	d.Set("routing_control_arn", output.RoutingControlArn)
+	d.Set("routing_control_state", output.RoutingControlState)
+
+	return diags
+}
+
+func resourceRoutingControlStateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("routing_control_state") {
+		if err := updateRoutingControlState(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Route53 Recovery Control Config Routing Control (%s) state: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRoutingControlStateRead(ctx, d, meta)...)
+}
+
+func resourceRoutingControlStateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Route53 Recovery Control Config Routing Control (%s) state is left unchanged, only removing from Terraform state", d.Id())
+
+	return diags
+}
+
+func updateRoutingControlState(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	arn := d.Get("routing_control_arn").(string)
+
+	endpoints, err := findClusterEndpointsByRoutingControlARN(ctx, meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx), arn)
+
+	if err != nil {
+		return fmt.Errorf("reading cluster endpoints: %w", err)
+	}
+
+	input := &r53rc.UpdateRoutingControlStateInput{
+		RoutingControlArn:   aws.String(arn),
+		RoutingControlState: aws.String(d.Get("routing_control_state").(string)),
+	}
+
+	if v, ok := d.GetOk("safety_rules_to_override"); ok && v.(*schema.Set).Len() > 0 {
+		input.SafetyRulesToOverride = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	return forEachClusterEndpoint(ctx, meta, endpoints, func(conn *r53rc.Route53RecoveryCluster) error {
+		_, err := conn.UpdateRoutingControlStateWithContext(ctx, input)
+
+		return err
+	})
+}
+
+// forEachClusterEndpoint calls f with a data plane client for each of the cluster's regional endpoints in turn,
+// stopping at the first success. This follows the recommended practice of retrying on another endpoint when one is unavailable.
+func forEachClusterEndpoint(ctx context.Context, meta interface{}, endpoints []*r53rcc.ClusterEndpoint, f func(*r53rc.Route53RecoveryCluster) error) error {
+	var errs []error
+
+	for _, v := range endpoints {
+		conn := meta.(*conns.AWSClient).Route53RecoveryClusterConnForEndpoint(ctx, aws.StringValue(v.Endpoint), aws.StringValue(v.Region))
+
+		err := f(conn)
+
+		if err == nil {
+			return nil
+		}
+
+		// Errors about the resource itself are not endpoint specific.
+		if tfawserr.ErrCodeEquals(err, r53rc.ErrCodeResourceNotFoundException, r53rc.ErrCodeValidationException, r53rc.ErrCodeConflictException, r53rc.ErrCodeAccessDeniedException) {
+			return err
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", aws.StringValue(v.Endpoint), err))
+	}
+
+	if len(errs) == 0 {
+		return errors.New("no cluster endpoints available")
+	}
+
+	return errors.Join(errs...)
+}
+
+func findClusterEndpointsByRoutingControlARN(ctx context.Context, conn *r53rcc.Route53RecoveryControlConfig, arn string) ([]*r53rcc.ClusterEndpoint, error) {
+	routingControl, err := conn.DescribeRoutingControlWithContext(ctx, &r53rcc.DescribeRoutingControlInput{
+		RoutingControlArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if routingControl == nil || routingControl.RoutingControl == nil {
+		return nil, errors.New("empty routing control response")
+	}
+
+	controlPanel, err := conn.DescribeControlPanelWithContext(ctx, &r53rcc.DescribeControlPanelInput{
+		ControlPanelArn: routingControl.RoutingControl.ControlPanelArn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if controlPanel == nil || controlPanel.ControlPanel == nil {
+		return nil, errors.New("empty control panel response")
+	}
+
+	cluster, err := conn.DescribeClusterWithContext(ctx, &r53rcc.DescribeClusterInput{
+		ClusterArn: controlPanel.ControlPanel.ClusterArn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster == nil || cluster.Cluster == nil {
+		return nil, errors.New("empty cluster response")
+	}
+
+	return cluster.Cluster.ClusterEndpoints, nil
+}
@@ -86,6 +86,18 @@ func ResourceEndpoint() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validResolverName,
 			},
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"preferred_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"outpost_arn"},
+			},
 			"resolver_endpoint_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -140,6 +152,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("outpost_arn"); ok {
+		input.OutpostArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_instance_type"); ok {
+		input.PreferredInstanceType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("protocols"); ok && v.(*schema.Set).Len() > 0 {
 		input.Protocols = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -183,6 +203,8 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("direction", ep.Direction)
 	d.Set("host_vpc_id", ep.HostVPCId)
 	d.Set(names.AttrName, ep.Name)
+	d.Set("outpost_arn", ep.OutpostArn)
+	d.Set("preferred_instance_type", ep.PreferredInstanceType)
 	d.Set("protocols", aws.StringValueSlice(ep.Protocols))
 	d.Set("resolver_endpoint_type", ep.ResolverEndpointType)
 	d.Set(names.AttrSecurityGroupIDs, aws.StringValueSlice(ep.SecurityGroupIds))
@@ -16,12 +16,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -46,6 +49,8 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 			Update: schema.DefaultTimeout(3 * time.Minute),
 		},
 
+		CustomizeDiff: validateLifecycleRulesCustomDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:         schema.TypeString,
@@ -251,6 +256,12 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"transition_default_minimum_object_size": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[types.TransitionDefaultMinimumObjectSize](),
+			},
 		},
 	}
 }
@@ -270,6 +281,9 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 	if expectedBucketOwner != "" {
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		input.TransitionDefaultMinimumObjectSize = types.TransitionDefaultMinimumObjectSize(v.(string))
+	}
 
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
@@ -306,14 +320,14 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
 	)
-	var lastOutput, output []types.LifecycleRule
+	var lastOutput, output *s3.GetBucketLifecycleConfigurationOutput
 
 	err = retry.RetryContext(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
 		var err error
 
 		time.Sleep(lifecycleConfigurationExtraRetryDelay)
 
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
 		if d.IsNewResource() && tfresource.NotFound(err) {
 			return retry.RetryableError(err)
@@ -323,7 +337,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 			return retry.NonRetryableError(err)
 		}
 
-		if lastOutput == nil || !lifecycleRulesEqual(lastOutput, output) {
+		if lastOutput == nil || !lifecycleRulesEqual(lastOutput.Rules, output.Rules) {
 			lastOutput = output
 			return retry.RetryableError(fmt.Errorf("S3 Bucket Lifecycle Configuration (%s) has not stablized; retrying", d.Id()))
 		}
@@ -332,7 +346,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -347,9 +361,10 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
-	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output)); err != nil {
+	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output.Rules)); err != nil {
 		return diag.Errorf("setting rule: %s", err)
 	}
+	d.Set("transition_default_minimum_object_size", output.TransitionDefaultMinimumObjectSize)
 
 	return nil
 }
@@ -372,6 +387,9 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 	if expectedBucketOwner != "" {
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		input.TransitionDefaultMinimumObjectSize = types.TransitionDefaultMinimumObjectSize(v.(string))
+	}
 
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
@@ -426,6 +444,160 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+// validateLifecycleRulesCustomDiff rejects, at plan time, combinations of rule arguments that the S3 API would reject at apply time.
+func validateLifecycleRulesCustomDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	var diags diag.Diagnostics
+
+	configRaw := d.GetRawConfig()
+	if !configRaw.IsKnown() || configRaw.IsNull() {
+		return nil
+	}
+
+	rulesPath := cty.GetAttrPath(names.AttrRule)
+	rules := configRaw.GetAttr(names.AttrRule)
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	it := rules.ElementIterator()
+	for it.Next() {
+		i, rule := it.Element()
+
+		lifecycleRulePlantimeValidate(rulesPath.Index(i), rule, &diags)
+	}
+
+	return sdkdiag.DiagnosticsError(diags)
+}
+
+func lifecycleRulePlantimeValidate(rulePath cty.Path, rule cty.Value, diags *diag.Diagnostics) {
+	if !rule.IsKnown() || rule.IsNull() {
+		return
+	}
+
+	// Days and Date based actions can't be mixed within a rule.
+	var datePath, daysPath cty.Path
+	var expiredObjectDeleteMarker bool
+
+	if expirations := rule.GetAttr("expiration"); expirations.IsKnown() && !expirations.IsNull() && expirations.LengthInt() > 0 {
+		expirationPath := rulePath.GetAttr("expiration").IndexInt(0)
+		expiration := expirations.Index(cty.NumberIntVal(0))
+
+		if expiration.IsKnown() && !expiration.IsNull() {
+			date, days := expiration.GetAttr("date"), expiration.GetAttr("days")
+			hasDate := date.IsKnown() && !date.IsNull() && date.AsString() != ""
+			// "days" defaults to 0, which is not sent to the API.
+			hasDays := days.IsKnown() && !days.IsNull() && !days.Equals(cty.Zero).True()
+
+			if hasDate && hasDays {
+				*diags = append(*diags, errs.NewAttributeErrorDiagnostic(expirationPath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Only one of %q or %q can be specified.",
+						errs.PathString(expirationPath.GetAttr("date")),
+						errs.PathString(expirationPath.GetAttr("days")),
+					),
+				))
+			}
+
+			if hasDate {
+				datePath = expirationPath.GetAttr("date")
+			}
+			if hasDays {
+				daysPath = expirationPath.GetAttr("days")
+			}
+
+			if v := expiration.GetAttr("expired_object_delete_marker"); v.IsKnown() && !v.IsNull() {
+				expiredObjectDeleteMarker = v.True()
+			}
+		}
+	}
+
+	if transitions := rule.GetAttr("transition"); transitions.IsKnown() && !transitions.IsNull() {
+		transitionsPath := rulePath.GetAttr("transition")
+
+		it := transitions.ElementIterator()
+		for it.Next() {
+			_, transition := it.Element()
+
+			if !transition.IsKnown() || transition.IsNull() {
+				continue
+			}
+
+			date, days := transition.GetAttr("date"), transition.GetAttr("days")
+			hasDate := date.IsKnown() && !date.IsNull() && date.AsString() != ""
+			hasDays := days.IsKnown() && !days.IsNull()
+
+			if hasDate && hasDays {
+				*diags = append(*diags, errs.NewAttributeErrorDiagnostic(transitionsPath,
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Only one of %q or %q can be specified in each transition.",
+						errs.PathString(transitionsPath.GetAttr("date")),
+						errs.PathString(transitionsPath.GetAttr("days")),
+					),
+				))
+
+				continue
+			}
+
+			if hasDate && datePath == nil {
+				datePath = transitionsPath
+			}
+			if hasDays && daysPath == nil {
+				daysPath = transitionsPath
+			}
+		}
+	}
+
+	if datePath != nil && daysPath != nil {
+		*diags = append(*diags, errs.NewAttributeErrorDiagnostic(rulePath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Expiration and transition actions in a rule must all be Date based or all be Days based; found %q and %q.",
+				errs.PathString(datePath),
+				errs.PathString(daysPath),
+			),
+		))
+	}
+
+	if expiredObjectDeleteMarker && lifecycleRuleHasTagFilter(rule) {
+		markerPath := rulePath.GetAttr("expiration").IndexInt(0).GetAttr("expired_object_delete_marker")
+		*diags = append(*diags, errs.NewAttributeErrorDiagnostic(markerPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%q can't be used with a tag based %q.",
+				errs.PathString(markerPath),
+				errs.PathString(rulePath.GetAttr(names.AttrFilter)),
+			),
+		))
+	}
+}
+
+func lifecycleRuleHasTagFilter(rule cty.Value) bool {
+	filter := rule.GetAttr(names.AttrFilter)
+	if !filter.IsKnown() || filter.IsNull() || filter.LengthInt() == 0 {
+		return false
+	}
+
+	filter = filter.Index(cty.NumberIntVal(0))
+	if !filter.IsKnown() || filter.IsNull() {
+		return false
+	}
+
+	if tag := filter.GetAttr("tag"); tag.IsKnown() && !tag.IsNull() && tag.LengthInt() > 0 {
+		return true
+	}
+
+	if and := filter.GetAttr("and"); and.IsKnown() && !and.IsNull() && and.LengthInt() > 0 {
+		and = and.Index(cty.NumberIntVal(0))
+		if !and.IsKnown() || and.IsNull() {
+			return false
+		}
+
+		if tags := and.GetAttr(names.AttrTags); tags.IsKnown() && !tags.IsNull() && tags.LengthInt() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 // suppressMissingFilterConfigurationBlock suppresses the diff that results from an omitted
 // filter configuration block and one returned from the S3 API.
 // To work around the issue, https://github.com/hashicorp/terraform-plugin-sdk/issues/743,
@@ -450,6 +622,16 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 }
 
 func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.LifecycleRule, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Rules, nil
+}
+
+func findBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	input := &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -474,7 +656,7 @@ func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Rules, nil
+	return output, nil
 }
 
 func lifecycleRulesEqual(rules1, rules2 []types.LifecycleRule) bool {
@@ -574,8 +756,8 @@ func expandLifecycleRules(ctx context.Context, l []interface{}) []types.Lifecycl
 			// apply the Default behavior from v3.x of the provider;
 			// otherwise, set the prefix as specified in Terraform.
 			if v == "" {
-				result.Filter = &types.LifecycleRuleFilterMemberPrefix{
-					Value: v,
+				result.Filter = &types.LifecycleRuleFilter{
+					Prefix: aws.String(v),
 				}
 			} else {
 				result.Prefix = aws.String(v)
@@ -652,97 +834,93 @@ func expandLifecycleExpiration(l []interface{}) *types.LifecycleExpiration {
 	return result
 }
 
-func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) types.LifecycleRuleFilter {
-	if len(l) == 0 {
+func expandLifecycleRuleFilter(ctx context.Context, l []interface{}) *types.LifecycleRuleFilter {
+	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
 
-	var result types.LifecycleRuleFilter
-
-	if l[0] == nil {
-		return result
-	}
+	var result *types.LifecycleRuleFilter
 
 	m := l[0].(map[string]interface{})
 
 	if v, ok := m["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberAnd(ctx, v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			And: expandLifecycleRuleAndOperator(ctx, v[0].(map[string]interface{})),
+		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_greater_than"].(string)).ValueInt64(); !null && v >= 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeGreaterThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeGreaterThan: aws.Int64(v),
 		}
 	}
 
 	if v, null, _ := nullable.Int(m["object_size_less_than"].(string)).ValueInt64(); !null && v > 0 {
-		result = &types.LifecycleRuleFilterMemberObjectSizeLessThan{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			ObjectSizeLessThan: aws.Int64(v),
 		}
 	}
 
 	if v, ok := m["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-		result = expandLifecycleRuleFilterMemberTag(v[0].(map[string]interface{}))
+		result = &types.LifecycleRuleFilter{
+			Tag: expandLifecycleRuleFilterTag(v[0].(map[string]interface{})),
+		}
 	}
 
 	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified";
 	// Specifying more than one of the listed parameters results in a MalformedXML error.
 	// In practice, this also includes ObjectSizeGreaterThan and ObjectSizeLessThan.
 	if v, ok := m[names.AttrPrefix].(string); ok && result == nil {
-		result = &types.LifecycleRuleFilterMemberPrefix{
-			Value: v,
+		result = &types.LifecycleRuleFilter{
+			Prefix: aws.String(v),
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberAnd(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleFilterMemberAnd {
+func expandLifecycleRuleAndOperator(ctx context.Context, m map[string]interface{}) *types.LifecycleRuleAndOperator {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberAnd{
-		Value: types.LifecycleRuleAndOperator{},
-	}
+	result := &types.LifecycleRuleAndOperator{}
 
 	if v, ok := m["object_size_greater_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeGreaterThan = aws.Int64(int64(v))
+		result.ObjectSizeGreaterThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m["object_size_less_than"].(int); ok && v > 0 {
-		result.Value.ObjectSizeLessThan = aws.Int64(int64(v))
+		result.ObjectSizeLessThan = aws.Int64(int64(v))
 	}
 
 	if v, ok := m[names.AttrPrefix].(string); ok {
-		result.Value.Prefix = aws.String(v)
+		result.Prefix = aws.String(v)
 	}
 
 	if v, ok := m[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
 		tags := Tags(tftags.New(ctx, v).IgnoreAWS())
 		if len(tags) > 0 {
-			result.Value.Tags = tags
+			result.Tags = tags
 		}
 	}
 
 	return result
 }
 
-func expandLifecycleRuleFilterMemberTag(m map[string]interface{}) *types.LifecycleRuleFilterMemberTag {
+func expandLifecycleRuleFilterTag(m map[string]interface{}) *types.Tag {
 	if len(m) == 0 {
 		return nil
 	}
 
-	result := &types.LifecycleRuleFilterMemberTag{
-		Value: types.Tag{},
-	}
+	result := &types.Tag{}
 
 	if key, ok := m[names.AttrKey].(string); ok {
-		result.Value.Key = aws.String(key)
+		result.Key = aws.String(key)
 	}
 
 	if value, ok := m[names.AttrValue].(string); ok {
-		result.Value.Value = aws.String(value)
+		result.Value = aws.String(value)
 	}
 
 	return result
@@ -924,24 +1102,24 @@ func flattenLifecycleExpiration(expiration *types.LifecycleExpiration) []interfa
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleFilter) []interface{} {
+func flattenLifecycleRuleFilter(ctx context.Context, filter *types.LifecycleRuleFilter) []interface{} {
 	if filter == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	switch v := filter.(type) {
-	case *types.LifecycleRuleFilterMemberAnd:
-		m["and"] = flattenLifecycleRuleFilterMemberAnd(ctx, v)
-	case *types.LifecycleRuleFilterMemberObjectSizeGreaterThan:
-		m["object_size_greater_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberObjectSizeLessThan:
-		m["object_size_less_than"] = strconv.FormatInt(v.Value, 10)
-	case *types.LifecycleRuleFilterMemberPrefix:
-		m[names.AttrPrefix] = v.Value
-	case *types.LifecycleRuleFilterMemberTag:
-		m["tag"] = flattenLifecycleRuleFilterMemberTag(v)
+	switch {
+	case filter.And != nil:
+		m["and"] = flattenLifecycleRuleAndOperator(ctx, filter.And)
+	case filter.ObjectSizeGreaterThan != nil:
+		m["object_size_greater_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeGreaterThan), 10)
+	case filter.ObjectSizeLessThan != nil:
+		m["object_size_less_than"] = strconv.FormatInt(aws.ToInt64(filter.ObjectSizeLessThan), 10)
+	case filter.Prefix != nil:
+		m[names.AttrPrefix] = aws.ToString(filter.Prefix)
+	case filter.Tag != nil:
+		m["tag"] = flattenLifecycleRuleFilterTag(filter.Tag)
 	default:
 		return nil
 	}
@@ -949,39 +1127,39 @@ func flattenLifecycleRuleFilter(ctx context.Context, filter types.LifecycleRuleF
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberAnd(ctx context.Context, andOp *types.LifecycleRuleFilterMemberAnd) []interface{} {
+func flattenLifecycleRuleAndOperator(ctx context.Context, andOp *types.LifecycleRuleAndOperator) []interface{} {
 	if andOp == nil {
 		return []interface{}{}
 	}
 
 	m := map[string]interface{}{
-		"object_size_greater_than": andOp.Value.ObjectSizeGreaterThan,
-		"object_size_less_than":    andOp.Value.ObjectSizeLessThan,
+		"object_size_greater_than": andOp.ObjectSizeGreaterThan,
+		"object_size_less_than":    andOp.ObjectSizeLessThan,
 	}
 
-	if v := andOp.Value.Prefix; v != nil {
+	if v := andOp.Prefix; v != nil {
 		m[names.AttrPrefix] = aws.ToString(v)
 	}
 
-	if v := andOp.Value.Tags; v != nil {
+	if v := andOp.Tags; v != nil {
 		m[names.AttrTags] = keyValueTags(ctx, v).IgnoreAWS().Map()
 	}
 
 	return []interface{}{m}
 }
 
-func flattenLifecycleRuleFilterMemberTag(op *types.LifecycleRuleFilterMemberTag) []interface{} {
-	if op == nil {
+func flattenLifecycleRuleFilterTag(tag *types.Tag) []interface{} {
+	if tag == nil {
 		return nil
 	}
 
 	m := make(map[string]interface{})
 
-	if v := op.Value.Key; v != nil {
+	if v := tag.Key; v != nil {
 		m[names.AttrKey] = aws.ToString(v)
 	}
 
-	if v := op.Value.Value; v != nil {
+	if v := tag.Value; v != nil {
 		m[names.AttrValue] = aws.ToString(v)
 	}
 
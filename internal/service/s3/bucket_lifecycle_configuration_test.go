@@ -1118,6 +1118,69 @@ func testAccCheckBucketLifecycleConfigurationExists(ctx context.Context, n strin
 	}
 }
 
+func TestAccS3BucketLifecycleConfiguration_transitionDefaultMinimumObjectSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, string(types.TransitionDefaultMinimumObjectSizeVariesByStorageClass)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transition_default_minimum_object_size", string(types.TransitionDefaultMinimumObjectSizeVariesByStorageClass)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, string(types.TransitionDefaultMinimumObjectSizeAllStorageClasses128k)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transition_default_minimum_object_size", string(types.TransitionDefaultMinimumObjectSizeAllStorageClasses128k)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_validateRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	currTime := time.Now()
+	date := time.Date(currTime.Year(), currTime.Month()+1, currTime.Day(), 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_mixedDaysAndDate(rName, date),
+				ExpectError: regexache.MustCompile(`must all be Date based or all be Days based`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_transitionDaysAndDate(rName, date),
+				ExpectError: regexache.MustCompile(`Only one of "rule\[0\]\.transition\.date" or "rule\[0\]\.transition\.days"`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_expiredObjectDeleteMarkerTagFilter(rName),
+				ExpectError: regexache.MustCompile(`can't be used with a tag based "rule\[0\]\.filter"`),
+			},
+		},
+	})
+}
+
 func testAccBucketLifecycleConfigurationConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1797,3 +1860,109 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 }
 `, rName))
 }
+
+func testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, minimumObjectSize string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket                                 = aws_s3_bucket.test.bucket
+  transition_default_minimum_object_size = %[2]q
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName, minimumObjectSize)
+}
+
+func testAccBucketLifecycleConfigurationConfig_mixedDaysAndDate(rName, date string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    expiration {
+      date = %[2]q
+    }
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName, date)
+}
+
+func testAccBucketLifecycleConfigurationConfig_transitionDaysAndDate(rName, date string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    transition {
+      date          = %[2]q
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName, date)
+}
+
+func testAccBucketLifecycleConfigurationConfig_expiredObjectDeleteMarkerTagFilter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {
+      tag {
+        key   = "Name"
+        value = %[1]q
+      }
+    }
+
+    expiration {
+      expired_object_delete_marker = true
+    }
+  }
+}
+`, rName)
+}
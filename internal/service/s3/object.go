@@ -40,6 +40,11 @@ import (
 	"github.com/mitchellh/go-homedir"
 )
 
+const (
+	// S3 limits each part of a multipart upload to 5 GiB.
+	objectUploadMaxPartSize = 5 * 1024 * 1024 * 1024
+)
+
 // @SDKResource("aws_s3_object", name="Object")
 // @Tags(identifierAttribute="arn", resourceType="Object")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/s3;s3.GetObjectOutput")
@@ -105,6 +110,10 @@ func resourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"checksum_crc64nvme": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"checksum_sha1": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -241,6 +250,16 @@ func resourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(int(manager.MinUploadPartSize), objectUploadMaxPartSize),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -294,6 +313,7 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("cache_control", output.CacheControl)
 	d.Set("checksum_crc32", output.ChecksumCRC32)
 	d.Set("checksum_crc32c", output.ChecksumCRC32C)
+	d.Set("checksum_crc64nvme", output.ChecksumCRC64NVME)
 	d.Set("checksum_sha1", output.ChecksumSHA1)
 	d.Set("checksum_sha256", output.ChecksumSHA256)
 	d.Set("content_disposition", output.ContentDisposition)
@@ -588,7 +608,18 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	// Bodies larger than the part size are uploaded in parts, concurrently.
+	// Each part request is retried independently by the client's retryer and
+	// the multipart upload is aborted if any part ultimately fails.
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -1615,6 +1616,66 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg="),
 				),
 			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC64NVME"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC64NVME"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc64nvme", "easTZmYRIl8="),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha1", ""),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 3 parts of at most 5 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5*1024*1024, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "upload_concurrency", "upload_part_size"},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+			{
+				// Changing the upload settings alone doesn't upload the object again,
+				// otherwise the ETag would reflect 2 parts of 6 MiB.
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6*1024*1024, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "4"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "6291456"),
+				),
+			},
 		},
 	})
 }
@@ -2908,6 +2969,23 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+
+  upload_part_size   = %[3]d
+  upload_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 15 * time.Minute
+	presignedURLMaxExpiresIn     = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(name="Presigned URL")
+func newPresignedURLEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (r *presignedURLEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_s3_presigned_url"
+}
+
+func (r *presignedURLEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+			},
+			"expiration": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(presignedURLMaxExpiresIn/time.Second)),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	bucket := data.Bucket.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	expiresIn := presignedURLDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() && !data.ExpiresIn.IsUnknown() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	method := http.MethodGet
+	if !data.Method.IsNull() && !data.Method.IsUnknown() {
+		method = data.Method.ValueString()
+	}
+
+	key := sdkv1CompatibleCleanKey(data.Key.ValueString())
+	presignClient := s3.NewPresignClient(conn, s3.WithPresignClientFromClientOptions(optFns...), s3.WithPresignExpires(expiresIn))
+	var output *v4.PresignedHTTPRequest
+	var err error
+
+	switch method {
+	case http.MethodPut:
+		if !data.VersionID.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root("version_id"), "Invalid Attribute Combination", "version_id can only be specified for GET presigned URLs")
+
+			return
+		}
+
+		output, err = presignClient.PresignPutObject(ctx, &s3.PutObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+	default:
+		output, err = presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       aws.String(key),
+			VersionId: fwflex.StringFromFramework(ctx, data.VersionID),
+		})
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("presigning S3 Object (%s) %s URL in Bucket (%s)", key, method, bucket), err.Error())
+
+		return
+	}
+
+	data.Expiration = types.StringValue(time.Now().Add(expiresIn).UTC().Format(time.RFC3339))
+	data.ExpiresIn = types.Int64Value(int64(expiresIn / time.Second))
+	data.Method = types.StringValue(method)
+	data.URL = types.StringValue(output.URL)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type presignedURLEphemeralResourceModel struct {
+	Bucket     types.String `tfsdk:"bucket"`
+	Expiration types.String `tfsdk:"expiration"`
+	ExpiresIn  types.Int64  `tfsdk:"expires_in"`
+	Key        types.String `tfsdk:"key"`
+	Method     types.String `tfsdk:"method"`
+	URL        types.String `tfsdk:"url"`
+	VersionID  types.String `tfsdk:"version_id"`
+}
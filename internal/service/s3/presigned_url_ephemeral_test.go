@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3PresignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"echo": echoprovider.NewProviderServer(),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralConfig_basic(rName, "GET"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_in"), knownvalue.Int64Exact(300)),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("method"), knownvalue.StringExact("GET")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`^https://.+X-Amz-Expires=300.*`))),
+				},
+			},
+			{
+				Config: testAccPresignedURLEphemeralConfig_basic(rName, "PUT"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("method"), knownvalue.StringExact("PUT")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`^https://.+X-Amz-Signature=.*`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccPresignedURLEphemeralConfig_basic(rName, method string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key        = "test-key"
+  method     = %[2]q
+  expires_in = 300
+}
+
+provider "echo" {
+  data = ephemeral.aws_s3_presigned_url.test
+}
+
+resource "echo" "test" {}
+`, rName, method)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Access Grant")
+func newAccessGrantDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &accessGrantDataSource{}
+
+	return d, nil
+}
+
+type accessGrantDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *accessGrantDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_s3control_access_grant"
+}
+
+func (d *accessGrantDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_grant_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_grant_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_grants_location_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"grant_scope": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"permission": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrTarget: schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"grantee": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[granteeModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"grantee_identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"grantee_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.GranteeType](),
+							Required:   true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.IsRequired(),
+				},
+			},
+		},
+	}
+}
+
+func (d *accessGrantDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+
+	grantee, diags := data.Grantee.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &s3control.ListAccessGrantsInput{
+		AccountId:         fwflex.StringFromFramework(ctx, data.AccountID),
+		ApplicationArn:    fwflex.StringFromFramework(ctx, data.ApplicationARN),
+		GranteeIdentifier: fwflex.StringFromFramework(ctx, grantee.GranteeIdentifier),
+		GranteeType:       grantee.GranteeType.ValueEnum(),
+	}
+	target := data.Target.ValueString()
+
+	output, err := findEffectiveAccessGrant(ctx, conn, input, target, data.Permission.ValueEnum())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Access Grant for %s", target), tfresource.SingularDataSourceFindError("S3 Access Grant", err).Error())
+
+		return
+	}
+
+	data.AccessGrantARN = fwflex.StringToFramework(ctx, output.AccessGrantArn)
+	data.AccessGrantID = fwflex.StringToFramework(ctx, output.AccessGrantId)
+	data.AccessGrantsLocationID = fwflex.StringToFramework(ctx, output.AccessGrantsLocationId)
+	data.GrantScope = fwflex.StringToFramework(ctx, output.GrantScope)
+	data.ID = fwflex.StringToFramework(ctx, output.AccessGrantId)
+	data.Permission = fwtypes.StringEnumValue(output.Permission)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findEffectiveAccessGrant returns the grant that S3 Access Grants would use for the grantee to access the target S3 path.
+// When more than one grant's scope covers the target, the most specific (longest) scope wins.
+func findEffectiveAccessGrant(ctx context.Context, conn *s3control.Client, input *s3control.ListAccessGrantsInput, target string, permission awstypes.Permission) (*awstypes.ListAccessGrantEntry, error) {
+	var output []awstypes.ListAccessGrantEntry
+
+	pages := s3control.NewListAccessGrantsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AccessGrantsList {
+			if accessGrantPermissionCovers(v.Permission, permission) && accessGrantScopeCovers(aws.ToString(v.GrantScope), target) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	var result *awstypes.ListAccessGrantEntry
+	for i, v := range output {
+		if result == nil || len(aws.ToString(v.GrantScope)) > len(aws.ToString(result.GrantScope)) {
+			result = &output[i]
+		}
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// accessGrantScopeCovers returns whether a grant scope (e.g. "s3://bucket/prefix*" or "s3://bucket/key") covers the target S3 path.
+func accessGrantScopeCovers(scope, target string) bool {
+	if v, ok := strings.CutSuffix(scope, "*"); ok {
+		return strings.HasPrefix(target, v)
+	}
+
+	return scope == target
+}
+
+// accessGrantPermissionCovers returns whether a grant's permission includes the wanted permission.
+// An empty wanted permission matches any grant.
+func accessGrantPermissionCovers(granted, wanted awstypes.Permission) bool {
+	return wanted == "" || granted == wanted || granted == awstypes.PermissionReadwrite
+}
+
+type accessGrantDataSourceModel struct {
+	AccessGrantARN         types.String                                  `tfsdk:"access_grant_arn"`
+	AccessGrantID          types.String                                  `tfsdk:"access_grant_id"`
+	AccessGrantsLocationID types.String                                  `tfsdk:"access_grants_location_id"`
+	AccountID              types.String                                  `tfsdk:"account_id"`
+	ApplicationARN         fwtypes.ARN                                   `tfsdk:"application_arn"`
+	Grantee                fwtypes.ListNestedObjectValueOf[granteeModel] `tfsdk:"grantee"`
+	GrantScope             types.String                                  `tfsdk:"grant_scope"`
+	ID                     types.String                                  `tfsdk:"id"`
+	Permission             fwtypes.StringEnum[awstypes.Permission]       `tfsdk:"permission"`
+	Target                 types.String                                  `tfsdk:"target"`
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccessGrantDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grant.test"
+	dataSourceName := "data.aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grant_arn", resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grant_id", resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants_location_id", resourceName, "access_grants_location_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grant_scope", resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(dataSourceName, "permission", "READ"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_basic(rName), `
+data "aws_s3control_access_grant" "test" {
+  target = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}/file.txt"
+
+  grantee {
+    grantee_type       = "IAM"
+    grantee_identifier = aws_iam_user.test.arn
+  }
+
+  depends_on = [aws_s3control_access_grant.test]
+}
+`)
+}
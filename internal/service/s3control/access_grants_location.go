@@ -5,19 +5,28 @@ package s3control
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -25,6 +34,7 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -72,9 +82,21 @@ func (r *accessGrantsLocationResource) Schema(ctx context.Context, request resou
 					fwvalidators.AWSAccountID(),
 				},
 			},
+			"create_iam_role": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrIAMRoleARN: schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
-				Required:   true,
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"location_scope": schema.StringAttribute{
 				Required: true,
@@ -103,6 +125,19 @@ func (r *accessGrantsLocationResource) Create(ctx context.Context, request resou
 	if data.AccountID.ValueString() == "" {
 		data.AccountID = types.StringValue(r.Meta().AccountID)
 	}
+
+	if data.CreateIAMRole.ValueBool() {
+		roleARN, err := createAccessGrantsLocationIAMRole(ctx, r.Meta(), data.AccountID.ValueString(), data.LocationScope.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Location (%s) IAM role", data.LocationScope.ValueString()), err.Error())
+
+			return
+		}
+
+		data.IAMRoleARN = fwtypes.ARNValue(roleARN)
+	}
+
 	input := &s3control.CreateAccessGrantsLocationInput{}
 	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
 	if response.Diagnostics.HasError() {
@@ -118,6 +153,12 @@ func (r *accessGrantsLocationResource) Create(ctx context.Context, request resou
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Access Grants Location (%s)", data.LocationScope.ValueString()), err.Error())
 
+		if data.CreateIAMRole.ValueBool() {
+			if err := deleteAccessGrantsLocationIAMRole(ctx, r.Meta(), data.IAMRoleARN.ValueString()); err != nil {
+				response.Diagnostics.AddWarning(fmt.Sprintf("deleting S3 Access Grants Location (%s) IAM role", data.LocationScope.ValueString()), err.Error())
+			}
+		}
+
 		return
 	}
 
@@ -168,6 +209,10 @@ func (r *accessGrantsLocationResource) Read(ctx context.Context, request resourc
 		return
 	}
 
+	if data.CreateIAMRole.IsNull() {
+		data.CreateIAMRole = types.BoolValue(false)
+	}
+
 	tags, err := listTags(ctx, conn, data.AccessGrantsLocationARN.ValueString(), data.AccountID.ValueString())
 
 	if err != nil {
@@ -257,6 +302,44 @@ func (r *accessGrantsLocationResource) Delete(ctx context.Context, request resou
 
 		return
 	}
+
+	if data.CreateIAMRole.ValueBool() {
+		if err := deleteAccessGrantsLocationIAMRole(ctx, r.Meta(), data.IAMRoleARN.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Access Grants Location (%s) IAM role", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *accessGrantsLocationResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data accessGrantsLocationResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CreateIAMRole.IsUnknown() || data.IAMRoleARN.IsUnknown() {
+		return
+	}
+
+	if data.CreateIAMRole.ValueBool() && !data.IAMRoleARN.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrIAMRoleARN),
+			"Invalid Attribute Combination",
+			`"iam_role_arn" can't be specified when "create_iam_role" is true.`,
+		)
+	}
+
+	if !data.CreateIAMRole.ValueBool() && data.IAMRoleARN.IsNull() {
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrIAMRoleARN),
+			"Missing Attribute Configuration",
+			`"iam_role_arn" must be specified unless "create_iam_role" is true.`,
+		)
+	}
 }
 
 func (r *accessGrantsLocationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
@@ -293,6 +376,7 @@ type accessGrantsLocationResourceModel struct {
 	AccessGrantsLocationARN types.String `tfsdk:"access_grants_location_arn"`
 	AccessGrantsLocationID  types.String `tfsdk:"access_grants_location_id"`
 	AccountID               types.String `tfsdk:"account_id"`
+	CreateIAMRole           types.Bool   `tfsdk:"create_iam_role"`
 	IAMRoleARN              fwtypes.ARN  `tfsdk:"iam_role_arn"`
 	ID                      types.String `tfsdk:"id"`
 	LocationScope           types.String `tfsdk:"location_scope"`
@@ -321,3 +405,155 @@ func (data *accessGrantsLocationResourceModel) InitFromID() error {
 func (data *accessGrantsLocationResourceModel) setID() {
 	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.AccountID.ValueString(), data.AccessGrantsLocationID.ValueString()}, accessGrantsLocationResourceIDPartCount, false)))
 }
+
+const (
+	accessGrantsLocationIAMRoleNamePrefix = "S3AccessGrantsLocation-"
+	accessGrantsLocationIAMRolePolicyName = "S3AccessGrantsLocation"
+	accessGrantsServicePrincipal          = "access-grants.s3.amazonaws.com"
+)
+
+// createAccessGrantsLocationIAMRole creates an IAM role that S3 Access Grants can assume to vend credentials for the specified location scope.
+// See https://docs.aws.amazon.com/AmazonS3/latest/userguide/access-grants-location-register.html.
+func createAccessGrantsLocationIAMRole(ctx context.Context, awsClient *conns.AWSClient, accountID, locationScope string) (string, error) {
+	conn := awsClient.IAMClient(ctx)
+
+	instanceARN := arn.ARN{
+		Partition: awsClient.Partition,
+		Service:   "s3",
+		Region:    awsClient.Region,
+		AccountID: accountID,
+		Resource:  "access-grants/default",
+	}.String()
+
+	assumeRolePolicy, err := json.Marshal(&tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Effect:     "Allow",
+				Actions:    []string{"sts:AssumeRole", "sts:SetContext", "sts:SetSourceIdentity"},
+				Principals: tfiam.IAMPolicyStatementPrincipalSet{{Type: "Service", Identifiers: accessGrantsServicePrincipal}},
+				Conditions: tfiam.IAMPolicyStatementConditionSet{
+					{Test: "StringEquals", Variable: "aws:SourceAccount", Values: accountID},
+					{Test: "ArnEquals", Variable: "aws:SourceArn", Values: instanceARN},
+				},
+			},
+		},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	bucketARN, objectARN := accessGrantsLocationScopeARNs(awsClient.Partition, locationScope)
+	conditions := tfiam.IAMPolicyStatementConditionSet{
+		{Test: "StringEquals", Variable: "aws:ResourceAccount", Values: accountID},
+		{Test: "ArnEquals", Variable: "s3:AccessGrantsInstanceArn", Values: instanceARN},
+	}
+	rolePolicy, err := json.Marshal(&tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			{
+				Sid:    "ObjectLevelReadPermissions",
+				Effect: "Allow",
+				Actions: []string{
+					"s3:GetObject",
+					"s3:GetObjectAcl",
+					"s3:GetObjectVersion",
+					"s3:GetObjectVersionAcl",
+					"s3:ListMultipartUploadParts",
+				},
+				Resources:  objectARN,
+				Conditions: conditions,
+			},
+			{
+				Sid:    "ObjectLevelWritePermissions",
+				Effect: "Allow",
+				Actions: []string{
+					"s3:AbortMultipartUpload",
+					"s3:DeleteObject",
+					"s3:DeleteObjectVersion",
+					"s3:PutObject",
+					"s3:PutObjectAcl",
+					"s3:PutObjectVersionAcl",
+				},
+				Resources:  objectARN,
+				Conditions: conditions,
+			},
+			{
+				Sid:        "BucketLevelReadPermissions",
+				Effect:     "Allow",
+				Actions:    "s3:ListBucket",
+				Resources:  bucketARN,
+				Conditions: conditions,
+			},
+		},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	roleName := sdkid.PrefixedUniqueId(accessGrantsLocationIAMRoleNamePrefix)
+	output, err := conn.CreateRole(ctx, &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(string(assumeRolePolicy)),
+		Description:              aws.String(fmt.Sprintf("S3 Access Grants location %s", locationScope)),
+		RoleName:                 aws.String(roleName),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("creating IAM Role (%s): %w", roleName, err)
+	}
+
+	_, err = conn.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+		PolicyDocument: aws.String(string(rolePolicy)),
+		PolicyName:     aws.String(accessGrantsLocationIAMRolePolicyName),
+		RoleName:       aws.String(roleName),
+	})
+
+	if err != nil {
+		err = fmt.Errorf("putting IAM Role (%s) policy: %w", roleName, err)
+
+		if err := tfiam.DeleteRole(ctx, conn, roleName, false, true, false); err != nil {
+			return "", fmt.Errorf("deleting IAM Role (%s): %w", roleName, err)
+		}
+
+		return "", err
+	}
+
+	return aws.ToString(output.Role.Arn), nil
+}
+
+func deleteAccessGrantsLocationIAMRole(ctx context.Context, awsClient *conns.AWSClient, roleARN string) error {
+	v, err := arn.Parse(roleARN)
+
+	if err != nil {
+		return err
+	}
+
+	roleName := v.Resource[strings.LastIndex(v.Resource, "/")+1:]
+
+	if err := tfiam.DeleteRole(ctx, awsClient.IAMClient(ctx), roleName, false, true, false); err != nil {
+		return fmt.Errorf("deleting IAM Role (%s): %w", roleName, err)
+	}
+
+	return nil
+}
+
+// accessGrantsLocationScopeARNs returns the bucket and object ARNs covered by an S3 Access Grants location scope.
+// The scope is either "s3://" (all buckets), "s3://<bucket>" or "s3://<bucket>/<prefix>".
+func accessGrantsLocationScopeARNs(partition, locationScope string) (string, string) {
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(locationScope, "s3://"), "/")
+	prefix = strings.TrimSuffix(prefix, "*")
+
+	if bucket == "" {
+		bucket = "*"
+	}
+
+	bucketARN := arn.ARN{
+		Partition: partition,
+		Service:   "s3",
+		Resource:  bucket,
+	}.String()
+
+	return bucketARN, bucketARN + "/" + prefix + "*"
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -153,6 +154,37 @@ func testAccAccessGrantsLocation_update(t *testing.T) {
 	})
 }
 
+func testAccAccessGrantsLocation_createIAMRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3control_access_grants_location.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantsLocationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsLocationConfig_createIAMRole(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccessGrantsLocationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_iam_role", acctest.CtTrue),
+					acctest.MatchResourceAttrGlobalARN(resourceName, names.AttrIAMRoleARN, "iam", regexache.MustCompile(`role/S3AccessGrantsLocation-.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"create_iam_role",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckAccessGrantsLocationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlClient(ctx)
@@ -340,3 +372,20 @@ resource "aws_s3control_access_grants_location" "test" {
 }
 `, rName))
 }
+
+func testAccAccessGrantsLocationConfig_createIAMRole(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3control_access_grants_instance" "test" {}
+
+resource "aws_s3control_access_grants_location" "test" {
+  depends_on = [aws_s3control_access_grants_instance.test]
+
+  create_iam_role = true
+  location_scope  = "s3://${aws_s3_bucket.test.bucket}/prefixA*"
+}
+`, rName)
+}
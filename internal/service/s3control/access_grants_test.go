@@ -24,6 +24,7 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			acctest.CtDisappears: testAccAccessGrantsLocation_disappears,
 			"tags":               testAccAccessGrantsLocation_tags,
 			"update":             testAccAccessGrantsLocation_update,
+			"createIAMRole":      testAccAccessGrantsLocation_createIAMRole,
 		},
 		"Grant": {
 			acctest.CtBasic:         testAccAccessGrant_basic,
@@ -31,6 +32,9 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			"tags":                  testAccAccessGrant_tags,
 			"locationConfiguration": testAccAccessGrant_locationConfiguration,
 		},
+		"GrantDataSource": {
+			acctest.CtBasic: testAccAccessGrantDataSource_basic,
+		},
 		"InstanceResourcePolicy": {
 			acctest.CtBasic:      testAccAccessGrantsInstanceResourcePolicy_basic,
 			acctest.CtDisappears: testAccAccessGrantsInstanceResourcePolicy_disappears,
@@ -5,8 +5,10 @@ package scheduler
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -703,3 +705,38 @@ func flattenTarget(ctx context.Context, apiObject *types.Target) map[string]inte
 
 	return m
 }
+
+// expandUniversalTargetARN returns the templated ARN of a universal target, e.g.
+// arn:aws:scheduler:::aws-sdk:sqs:createQueue.
+func expandUniversalTargetARN(partition string, tfMap map[string]interface{}) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   "scheduler",
+		Resource:  strings.Join([]string{"aws-sdk", tfMap["service"].(string), universalTargetAction(tfMap[names.AttrAction].(string))}, ":"),
+	}.String()
+}
+
+// parseUniversalTargetARN returns the service and action of a universal target ARN.
+func parseUniversalTargetARN(s string) (string, string, bool) {
+	v, err := arn.Parse(s)
+	if err != nil || v.Service != "scheduler" {
+		return "", "", false
+	}
+
+	parts := strings.Split(v.Resource, ":")
+	if len(parts) != 3 || parts[0] != "aws-sdk" {
+		return "", "", false
+	}
+
+	return parts[1], parts[2], true
+}
+
+// universalTargetAction converts an AWS API action name (e.g. CreateQueue) to the
+// camel case form (e.g. createQueue) used in universal target ARNs.
+func universalTargetAction(action string) string {
+	if action == "" {
+		return action
+	}
+
+	return strings.ToLower(action[:1]) + action[1:]
+}
@@ -6,6 +6,7 @@ package scheduler
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -44,6 +45,8 @@ func resourceSchedule() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: customizeDiffUniversalTarget,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -144,7 +147,9 @@ func resourceSchedule() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						names.AttrARN: {
 							Type:             schema.TypeString,
-							Required:         true,
+							Optional:         true,
+							Computed:         true,
+							ExactlyOneOf:     []string{"target.0.arn", "target.0.universal_target"},
 							ValidateDiagFunc: validation.ToDiagFunc(verify.ValidARN),
 						},
 						"dead_letter_config": {
@@ -420,6 +425,32 @@ func resourceSchedule() *schema.Resource {
 								},
 							},
 						},
+						"universal_target": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"target.0.arn", "target.0.universal_target"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAction: {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(
+											regexache.MustCompile(`^[A-Za-z][0-9A-Za-z]*$`),
+											"must be the name of an AWS API action, e.g. CreateQueue or createQueue",
+										)),
+									},
+									"service": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(
+											regexache.MustCompile(`^[0-9a-z]+$`),
+											"must be the lowercase name of an AWS SDK service, e.g. sqs or ec2",
+										)),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -477,6 +508,10 @@ func resourceScheduleCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	if v, ok := d.Get(names.AttrTarget).([]interface{}); ok && len(v) > 0 {
 		in.Target = expandTarget(ctx, v[0].(map[string]interface{}))
+
+		if v, ok := d.Get("target.0.universal_target").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			in.Target.Arn = aws.String(expandUniversalTargetARN(meta.(*conns.AWSClient).Partition, v[0].(map[string]interface{})))
+		}
 	}
 
 	out, err := retryWhenIAMNotPropagated(ctx, func() (*scheduler.CreateScheduleOutput, error) {
@@ -558,7 +593,20 @@ func resourceScheduleRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrState, string(out.State))
 
-	if err := d.Set(names.AttrTarget, []interface{}{flattenTarget(ctx, out.Target)}); err != nil {
+	target := flattenTarget(ctx, out.Target)
+
+	// Keep the configured universal target as long as the schedule still invokes it.
+	if v, ok := d.Get("target.0.universal_target").([]interface{}); ok && len(v) > 0 && v[0] != nil && out.Target != nil {
+		if service, action, ok := parseUniversalTargetARN(aws.ToString(out.Target.Arn)); ok {
+			tfMap := v[0].(map[string]interface{})
+
+			if tfMap["service"].(string) == service && universalTargetAction(tfMap[names.AttrAction].(string)) == action {
+				target["universal_target"] = v
+			}
+		}
+	}
+
+	if err := d.Set(names.AttrTarget, []interface{}{target}); err != nil {
 		return create.DiagError(names.Scheduler, create.ErrActionSetting, ResNameSchedule, d.Id(), err)
 	}
 
@@ -576,6 +624,10 @@ func resourceScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		Target:             expandTarget(ctx, d.Get(names.AttrTarget).([]interface{})[0].(map[string]interface{})),
 	}
 
+	if v, ok := d.Get("target.0.universal_target").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in.Target.Arn = aws.String(expandUniversalTargetARN(meta.(*conns.AWSClient).Partition, v[0].(map[string]interface{})))
+	}
+
 	if v, ok := d.Get(names.AttrDescription).(string); ok && v != "" {
 		in.Description = aws.String(v)
 	}
@@ -696,6 +748,31 @@ func ResourceScheduleParseID(id string) (groupName, scheduleName string, err err
 	return parts[0], parts[1], nil
 }
 
+// customizeDiffUniversalTarget validates the target input of universal targets at plan time.
+// The input of a universal target is passed as the request parameters of the AWS API action.
+func customizeDiffUniversalTarget(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.Get("target.0.universal_target").([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	if !d.NewValueKnown("target.0.input") {
+		return nil
+	}
+
+	input := d.Get("target.0.input").(string)
+
+	if input == "" {
+		return nil
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return fmt.Errorf("target.0.input must be a JSON object containing the request parameters of the universal target API action: %w", err)
+	}
+
+	return nil
+}
+
 func sagemakerPipelineParameterHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return create.StringHashcode(fmt.Sprintf("%s-%s", m[names.AttrName].(string), m[names.AttrValue].(string)))
@@ -1587,6 +1587,61 @@ func TestAccSchedulerSchedule_targetSQSParameters(t *testing.T) {
 	})
 }
 
+func TestAccSchedulerSchedule_targetUniversalTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var schedule scheduler.GetScheduleOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_scheduler_schedule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SchedulerEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SchedulerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccScheduleConfig_targetUniversalTarget(name, "SendMessage", `"not-an-object"`),
+				ExpectError: regexache.MustCompile(`target.0.input must be a JSON object`),
+			},
+			{
+				Config: testAccScheduleConfig_targetUniversalTarget(name, "SendMessage", `jsonencode({
+      MessageBody = "test1"
+      QueueUrl    = aws_sqs_queue.test.url
+    })`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					acctest.CheckResourceAttrGlobalARNNoAccount(resourceName, "target.0.arn", "scheduler", "aws-sdk:sqs:sendMessage"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.universal_target.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "target.0.universal_target.0.action", "SendMessage"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.universal_target.0.service", "sqs"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target.0.universal_target"},
+			},
+			{
+				Config: testAccScheduleConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduleExists(ctx, t, resourceName, &schedule),
+					resource.TestCheckResourceAttrPair(resourceName, "target.0.arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "target.0.universal_target.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScheduleDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).SchedulerClient(ctx)
@@ -2594,3 +2649,33 @@ resource "aws_scheduler_schedule" "test" {
 `, name, messageGroupId),
 	)
 }
+
+func testAccScheduleConfig_targetUniversalTarget(name, action, input string) string {
+	return acctest.ConfigCompose(
+		testAccScheduleConfig_base,
+		fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {}
+
+resource "aws_scheduler_schedule" "test" {
+  name = %[1]q
+
+  flexible_time_window {
+    mode = "OFF"
+  }
+
+  schedule_expression = "rate(1 hour)"
+
+  target {
+    role_arn = aws_iam_role.test.arn
+
+    universal_target {
+      service = "sqs"
+      action  = %[2]q
+    }
+
+    input = %[3]s
+  }
+}
+`, name, action, input),
+	)
+}
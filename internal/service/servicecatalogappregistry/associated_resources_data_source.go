@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Associated Resources")
+func newDataSourceAssociatedResources(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAssociatedResources{}, nil
+}
+
+const (
+	DSNameAssociatedResources = "Associated Resources Data Source"
+)
+
+type dataSourceAssociatedResources struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAssociatedResources) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_servicecatalogappregistry_associated_resources"
+}
+
+func (d *dataSourceAssociatedResources) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+			},
+			"attribute_group_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrResources: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[associatedResourceData](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[associatedResourceData](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceAssociatedResources) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ServiceCatalogAppRegistryClient(ctx)
+
+	var data dataSourceAssociatedResourcesData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applicationID := data.ApplicationID.ValueString()
+
+	var out servicecatalogappregistry.ListAssociatedResourcesOutput
+
+	pages := servicecatalogappregistry.NewListAssociatedResourcesPaginator(conn, &servicecatalogappregistry.ListAssociatedResourcesInput{
+		Application: aws.String(applicationID),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionReading, DSNameAssociatedResources, applicationID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		out.Resources = append(out.Resources, page.Resources...)
+	}
+
+	attributeGroupIDs, err := findAssociatedAttributeGroups(ctx, conn, &servicecatalogappregistry.ListAssociatedAttributeGroupsInput{
+		Application: aws.String(applicationID),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ServiceCatalogAppRegistry, create.ErrActionReading, DSNameAssociatedResources, applicationID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(applicationID)
+	data.AttributeGroupIDs = flex.FlattenFrameworkStringValueList(ctx, attributeGroupIDs)
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+type dataSourceAssociatedResourcesData struct {
+	ApplicationID     types.String                                            `tfsdk:"application_id"`
+	AttributeGroupIDs types.List                                              `tfsdk:"attribute_group_ids"`
+	ID                types.String                                            `tfsdk:"id"`
+	Resources         fwtypes.ListNestedObjectValueOf[associatedResourceData] `tfsdk:"resources"`
+}
+
+type associatedResourceData struct {
+	ARN          types.String `tfsdk:"arn"`
+	Name         types.String `tfsdk:"name"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogAppRegistryAssociatedResourcesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_servicecatalogappregistry_associated_resources.test"
+	attributeGroupResourceName := "aws_servicecatalogappregistry_attribute_group.test"
+	stackResourceName := "aws_cloudformation_stack.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociatedResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "attribute_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attribute_group_ids.0", attributeGroupResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.arn", stackResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.name", stackResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.resource_type", "CFN_STACK"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociatedResourcesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccResourceAssociationConfig_basic(rName), `
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = aws_servicecatalogappregistry_application.test.name
+
+  attributes = jsonencode({
+    app = "example"
+  })
+}
+
+resource "aws_servicecatalogappregistry_attribute_group_association" "test" {
+  application_id     = aws_servicecatalogappregistry_application.test.id
+  attribute_group_id = aws_servicecatalogappregistry_attribute_group.test.id
+}
+
+data "aws_servicecatalogappregistry_associated_resources" "test" {
+  application_id = aws_servicecatalogappregistry_application.test.id
+
+  depends_on = [
+    aws_servicecatalogappregistry_attribute_group_association.test,
+    aws_servicecatalogappregistry_resource_association.test,
+  ]
+}
+`)
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalogappregistry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/servicecatalogappregistry/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfservicecatalogappregistry "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalogappregistry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttributeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "servicecatalog", regexache.MustCompile(`/attribute-groups/+.`)),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"app":"example","key1":"value1"}`),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttributeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfservicecatalogappregistry.ResourceAttributeGroup, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccServiceCatalogAppRegistryAttributeGroup_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_servicecatalogappregistry_attribute_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ServiceCatalogAppRegistryEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogAppRegistryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAttributeGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAttributeGroupConfig_description(rName, "text1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"app":"example","key1":"value1"}`),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "text1"),
+				),
+			},
+			{
+				Config: testAccAttributeGroupConfig_description(rName, "text2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAttributeGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attributes", `{"app":"example","key1":"value2"}`),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "text2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAttributeGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicecatalogappregistry_attribute_group" {
+				continue
+			}
+
+			_, err := tfservicecatalogappregistry.FindAttributeGroupByID(ctx, conn, rs.Primary.ID)
+			if errs.IsA[*types.ResourceNotFoundException](err) {
+				return nil
+			}
+			if err != nil {
+				return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingDestroyed, tfservicecatalogappregistry.ResNameAttributeGroup, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingDestroyed, tfservicecatalogappregistry.ResNameAttributeGroup, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAttributeGroupExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingExistence, tfservicecatalogappregistry.ResNameAttributeGroup, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.ServiceCatalogAppRegistry, create.ErrActionCheckingExistence, tfservicecatalogappregistry.ResNameAttributeGroup, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogAppRegistryClient(ctx)
+
+		_, err := tfservicecatalogappregistry.FindAttributeGroupByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccAttributeGroupConfig_basic(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name = %[1]q
+
+  attributes = jsonencode({
+    app  = "example"
+    key1 = %[2]q
+  })
+}
+`, rName, value)
+}
+
+func testAccAttributeGroupConfig_description(rName, description, value string) string {
+	return fmt.Sprintf(`
+resource "aws_servicecatalogappregistry_attribute_group" "test" {
+  name        = %[1]q
+  description = %[2]q
+
+  attributes = jsonencode({
+    app  = "example"
+    key1 = %[3]q
+  })
+}
+`, rName, description, value)
+}
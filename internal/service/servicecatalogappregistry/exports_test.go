@@ -5,6 +5,12 @@ package servicecatalogappregistry
 
 // Exports for use in tests only.
 var (
-	FindApplicationByID = findApplicationByID
-	ResourceApplication = newResourceApplication
+	FindApplicationByID                       = findApplicationByID
+	FindAttributeGroupAssociationByTwoPartKey = findAttributeGroupAssociationByTwoPartKey
+	FindAttributeGroupByID                    = findAttributeGroupByID
+	FindResourceAssociationByID               = findResourceAssociationByID
+	ResourceApplication                       = newResourceApplication
+	ResourceAttributeGroup                    = newResourceAttributeGroup
+	ResourceAttributeGroupAssociation         = newResourceAttributeGroupAssociation
+	ResourceResourceAssociation               = newResourceResourceAssociation
 )
@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceApplication,
 			Name:    "Application",
 		},
+		{
+			Factory: newDataSourceAssociatedResources,
+			Name:    "Associated Resources",
+		},
 	}
 }
 
@@ -29,6 +33,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceApplication,
 			Name:    "Application",
 		},
+		{
+			Factory: newResourceAttributeGroup,
+			Name:    "Attribute Group",
+		},
+		{
+			Factory: newResourceAttributeGroupAssociation,
+			Name:    "Attribute Group Association",
+		},
+		{
+			Factory: newResourceResourceAssociation,
+			Name:    "Resource Association",
+		},
 	}
 }
 
@@ -5,5 +5,8 @@ package servicediscovery
 
 // Exports for use in tests only.
 var (
-	ValidNamespaceName = validNamespaceName
+	ResourceInstances = resourceInstances
+
+	FindInstancesByServiceID = findInstancesByServiceID
+	ValidNamespaceName       = validNamespaceName
 )
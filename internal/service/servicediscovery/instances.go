@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_service_discovery_instances", name="Instances")
+func resourceInstances() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceInstancesCreate,
+		ReadWithoutTimeout:   resourceInstancesRead,
+		UpdateWithoutTimeout: resourceInstancesUpdate,
+		DeleteWithoutTimeout: resourceInstancesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAttributes: {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validation.AllDiag(
+								validation.MapKeyLenBetween(1, 255),
+								validation.MapKeyMatch(regexache.MustCompile(`^[0-9A-Za-z!-~]+$`), ""),
+								validation.MapValueLenBetween(0, 1024),
+								validation.MapValueMatch(regexache.MustCompile(`^([0-9A-Za-z!-~][0-9A-Za-z \t!-~]*){0,1}[0-9A-Za-z!-~]{0,1}$`), ""),
+							),
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 64),
+								validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_/:.@-]+$`), ""),
+							),
+						},
+					},
+				},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"service_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceInstancesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+	if err := checkInstanceIDsUnique(d.Get("instance").(*schema.Set).List()); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	serviceID := d.Get("service_id").(string)
+	instances := expandInstances(d.Get("instance").(*schema.Set).List())
+
+	// Set the ID before registering so that any partially registered instances are tracked in state.
+	d.SetId(serviceID)
+
+	if err := registerInstances(ctx, conn, serviceID, instances, d.Get("parallelism").(int)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "registering Service Discovery Instances (%s): %s", serviceID, err)
+	}
+
+	return append(diags, resourceInstancesRead(ctx, d, meta)...)
+}
+
+func resourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+	instances, err := findInstancesByServiceID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Discovery Instances (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Discovery Instances (%s): %s", d.Id(), err)
+	}
+
+	// Only track the instances that are managed by this resource.
+	// On import all the service's instances are managed.
+	managed := expandInstances(d.Get("instance").(*schema.Set).List())
+	var tfList []interface{}
+
+	for _, v := range instances {
+		instanceID := aws.StringValue(v.Id)
+
+		if _, ok := managed[instanceID]; !ok && len(managed) > 0 {
+			continue
+		}
+
+		attributes := v.Attributes
+		// https://docs.aws.amazon.com/cloud-map/latest/api/API_RegisterInstance.html#cloudmap-RegisterInstance-request-Attributes.
+		// "When the AWS_EC2_INSTANCE_ID attribute is specified, then the AWS_INSTANCE_IPV4 attribute will be filled out with the primary private IPv4 address."
+		if _, ok := attributes["AWS_EC2_INSTANCE_ID"]; ok {
+			delete(attributes, "AWS_INSTANCE_IPV4")
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAttributes: aws.StringValueMap(attributes),
+			names.AttrInstanceID: instanceID,
+		})
+	}
+
+	if err := d.Set("instance", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instance: %s", err)
+	}
+	if _, ok := d.GetOk("parallelism"); !ok {
+		d.Set("parallelism", 10)
+	}
+	d.Set("service_id", d.Id())
+
+	return diags
+}
+
+func resourceInstancesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+	if d.HasChange("instance") {
+		o, n := d.GetChange("instance")
+		if err := checkInstanceIDsUnique(n.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		oldInstances, newInstances := expandInstances(o.(*schema.Set).List()), expandInstances(n.(*schema.Set).List())
+		parallelism := d.Get("parallelism").(int)
+
+		// Register new instances and re-register instances whose attributes have changed.
+		register := make(map[string]map[string]*string)
+		for instanceID, attributes := range newInstances {
+			if v, ok := oldInstances[instanceID]; !ok || !instanceAttributesEqual(v, attributes) {
+				register[instanceID] = attributes
+			}
+		}
+
+		var deregister []string
+		for instanceID := range oldInstances {
+			if _, ok := newInstances[instanceID]; !ok {
+				deregister = append(deregister, instanceID)
+			}
+		}
+
+		var errs []error
+
+		if err := registerInstances(ctx, conn, d.Id(), register, parallelism); err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := deregisterInstances(ctx, conn, d.Id(), deregister, parallelism); err != nil {
+			errs = append(errs, err)
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			// Keep the prior state. Registration is idempotent and missing instances are removed on refresh.
+			d.Partial(true)
+
+			return sdkdiag.AppendErrorf(diags, "updating Service Discovery Instances (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstancesRead(ctx, d, meta)...)
+}
+
+func resourceInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+	var instanceIDs []string
+	for instanceID := range expandInstances(d.Get("instance").(*schema.Set).List()) {
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	if err := deregisterInstances(ctx, conn, d.Id(), instanceIDs, d.Get("parallelism").(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Service Discovery Instances (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findInstancesByServiceID(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID string) ([]*servicediscovery.InstanceSummary, error) {
+	input := &servicediscovery.ListInstancesInput{
+		ServiceId: aws.String(serviceID),
+	}
+	var output []*servicediscovery.InstanceSummary
+
+	err := conn.ListInstancesPagesWithContext(ctx, input, func(page *servicediscovery.ListInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Instances {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeServiceNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func registerInstance(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID, instanceID string, attributes map[string]*string) error {
+	input := &servicediscovery.RegisterInstanceInput{
+		Attributes:       attributes,
+		CreatorRequestId: aws.String(id.UniqueId()),
+		InstanceId:       aws.String(instanceID),
+		ServiceId:        aws.String(serviceID),
+	}
+
+	log.Printf("[INFO] Registering Service Discovery Service (%s) Instance: %s", serviceID, instanceID)
+	output, err := conn.RegisterInstanceWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("registering Service Discovery Service (%s) Instance (%s): %w", serviceID, instanceID, err)
+	}
+
+	if output != nil && output.OperationId != nil {
+		if _, err := WaitOperationSuccess(ctx, conn, aws.StringValue(output.OperationId)); err != nil {
+			return fmt.Errorf("waiting for Service Discovery Service (%s) Instance (%s) create: %w", serviceID, instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+func registerInstances(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID string, instances map[string]map[string]*string, parallelism int) error {
+	var instanceIDs []string
+	for instanceID := range instances {
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	return forEachInstance(instanceIDs, parallelism, func(instanceID string) error {
+		return registerInstance(ctx, conn, serviceID, instanceID, instances[instanceID])
+	})
+}
+
+func deregisterInstances(ctx context.Context, conn *servicediscovery.ServiceDiscovery, serviceID string, instanceIDs []string, parallelism int) error {
+	return forEachInstance(instanceIDs, parallelism, func(instanceID string) error {
+		err := deregisterInstance(ctx, conn, serviceID, instanceID)
+
+		if tfawserr.ErrCodeEquals(err, servicediscovery.ErrCodeInstanceNotFound, servicediscovery.ErrCodeServiceNotFound) {
+			return nil
+		}
+
+		return err
+	})
+}
+
+// forEachInstance calls f for each instance ID, running at most parallelism calls concurrently.
+// API throttling errors are retried by the AWS SDK.
+func forEachInstance(instanceIDs []string, parallelism int, f func(string) error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	sem := make(chan struct{}, parallelism)
+
+	for _, instanceID := range instanceIDs {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(instanceID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(instanceID); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(instanceID)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func expandInstances(tfList []interface{}) map[string]map[string]*string {
+	instances := make(map[string]map[string]*string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		instances[tfMap[names.AttrInstanceID].(string)] = flex.ExpandStringMap(tfMap[names.AttrAttributes].(map[string]interface{}))
+	}
+
+	return instances
+}
+
+func checkInstanceIDsUnique(tfList []interface{}) error {
+	instanceIDs := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		instanceID := tfMap[names.AttrInstanceID].(string)
+
+		if _, ok := instanceIDs[instanceID]; ok {
+			return fmt.Errorf("duplicate instance_id: %s", instanceID)
+		}
+
+		instanceIDs[instanceID] = struct{}{}
+	}
+
+	return nil
+}
+
+func instanceAttributesEqual(a, b map[string]*string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || aws.StringValue(v) != aws.StringValue(w) {
+			return false
+		}
+	}
+
+	return true
+}
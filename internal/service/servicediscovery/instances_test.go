@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicediscovery_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicediscovery "github.com/hashicorp/terraform-provider-aws/internal/service/servicediscovery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccServiceDiscoveryInstances_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, servicediscovery.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 25, "10.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "aws_service_discovery_service.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "25"),
+					resource.TestCheckResourceAttr(resourceName, "parallelism", "5"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance.*", map[string]string{
+						names.AttrInstanceID:           fmt.Sprintf("%s-0", rName),
+						"attributes.%":                 "1",
+						"attributes.AWS_INSTANCE_IPV4": "10.0.0.0",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parallelism"},
+			},
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 10, "10.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance.#", "10"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "instance.*", map[string]string{
+						names.AttrInstanceID:           fmt.Sprintf("%s-9", rName),
+						"attributes.%":                 "1",
+						"attributes.AWS_INSTANCE_IPV4": "10.0.1.9",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceDiscoveryInstances_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_service_discovery_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, servicediscovery.EndpointsID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceDiscoveryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstancesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesConfig_basic(rName, domainName, 2, "10.0.0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstancesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfservicediscovery.ResourceInstances(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckInstancesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "instance.") || !strings.HasSuffix(k, ".instance_id") {
+				continue
+			}
+
+			if _, err := tfservicediscovery.FindInstanceByServiceIDAndInstanceID(ctx, conn, rs.Primary.ID, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckInstancesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceDiscoveryConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_service_discovery_instances" {
+				continue
+			}
+
+			instances, err := tfservicediscovery.FindInstancesByServiceID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(instances) > 0 {
+				return fmt.Errorf("Service Discovery Instances %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccInstancesConfig_basic(rName, domainName string, count int, ipPrefix string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_base(rName),
+		testAccInstanceConfig_privateNamespace(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_service_discovery_instances" "test" {
+  service_id  = aws_service_discovery_service.test.id
+  parallelism = 5
+
+  dynamic "instance" {
+    for_each = range(%[2]d)
+
+    content {
+      instance_id = "%[1]s-${instance.value}"
+
+      attributes = {
+        AWS_INSTANCE_IPV4 = "%[3]s.${instance.value}"
+      }
+    }
+  }
+}
+`, rName, count, ipPrefix))
+}
@@ -54,6 +54,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceInstance,
 			TypeName: "aws_service_discovery_instance",
 		},
+		{
+			Factory:  resourceInstances,
+			TypeName: "aws_service_discovery_instances",
+			Name:     "Instances",
+		},
 		{
 			Factory:  ResourcePrivateDNSNamespace,
 			TypeName: "aws_service_discovery_private_dns_namespace",
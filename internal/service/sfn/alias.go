@@ -6,18 +6,24 @@ package sfn
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -38,6 +44,9 @@ func ResourceAlias() *schema.Resource {
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+
+		CustomizeDiff: customizeDiffAliasDeploymentPreference,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -47,6 +56,41 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deployment_preference": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"deployment_preference", "routing_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarms": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrInterval: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 2100),
+						},
+						"percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"state_machine_version_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						names.AttrType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(aliasDeploymentType_Values(), false),
+						},
+					},
+				},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -57,9 +101,11 @@ func ResourceAlias() *schema.Resource {
 				ForceNew: true,
 			},
 			"routing_configuration": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"deployment_preference", "routing_configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"state_machine_version_arn": {
@@ -81,6 +127,20 @@ const (
 	ResNameAlias = "Alias"
 )
 
+const (
+	aliasDeploymentTypeAllAtOnce = "ALL_AT_ONCE"
+	aliasDeploymentTypeCanary    = "CANARY"
+	aliasDeploymentTypeLinear    = "LINEAR"
+)
+
+func aliasDeploymentType_Values() []string {
+	return []string{
+		aliasDeploymentTypeAllAtOnce,
+		aliasDeploymentTypeCanary,
+		aliasDeploymentTypeLinear,
+	}
+}
+
 func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).SFNConn(ctx)
 
@@ -93,6 +153,11 @@ func resourceAliasCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		in.RoutingConfiguration = expandAliasRoutingConfiguration(v.([]interface{}))
 	}
 
+	// There is no traffic to shift when the alias is created.
+	if v, ok := d.GetOk("deployment_preference"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		in.RoutingConfiguration = aliasRoutingConfiguration(expandAliasDeploymentPreference(v.([]interface{})[0].(map[string]interface{})).stateMachineVersionARN, "", 100)
+	}
+
 	out, err := conn.CreateStateMachineAliasWithContext(ctx, in)
 	if err != nil {
 		return create.DiagError(names.SFN, create.ErrActionCreating, ResNameAlias, d.Get(names.AttrName).(string), err)
@@ -148,19 +213,30 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		update = true
 	}
 
-	if d.HasChange("routing_configuration") {
+	v, deploy := d.GetOk("deployment_preference")
+
+	if !deploy && d.HasChange("routing_configuration") {
 		in.RoutingConfiguration = expandAliasRoutingConfiguration(d.Get("routing_configuration").([]interface{}))
 		update = true
 	}
 
-	if !update {
-		return nil
+	if update {
+		log.Printf("[DEBUG] Updating SFN Alias (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateStateMachineAliasWithContext(ctx, in)
+		if err != nil {
+			return create.DiagError(names.SFN, create.ErrActionUpdating, ResNameAlias, d.Id(), err)
+		}
 	}
 
-	log.Printf("[DEBUG] Updating SFN Alias (%s): %#v", d.Id(), in)
-	_, err := conn.UpdateStateMachineAliasWithContext(ctx, in)
-	if err != nil {
-		return create.DiagError(names.SFN, create.ErrActionUpdating, ResNameAlias, d.Id(), err)
+	if deploy && d.HasChange("deployment_preference.0.state_machine_version_arn") {
+		ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		preference := expandAliasDeploymentPreference(v.([]interface{})[0].(map[string]interface{}))
+
+		if err := deployAlias(ctx, conn, meta.(*conns.AWSClient).CloudWatchClient(ctx), d.Id(), preference); err != nil {
+			return create.DiagError(names.SFN, create.ErrActionUpdating, ResNameAlias, d.Id(), err)
+		}
 	}
 
 	return resourceAliasRead(ctx, d, meta)
@@ -204,6 +280,193 @@ func FindAliasByARN(ctx context.Context, conn *sfn.SFN, arn string) (*sfn.Descri
 	return out, nil
 }
 
+func customizeDiffAliasDeploymentPreference(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.Get("deployment_preference").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	preference := expandAliasDeploymentPreference(v[0].(map[string]interface{}))
+
+	if preference.deploymentType != aliasDeploymentTypeAllAtOnce && (preference.percentage == 0 || preference.interval == 0) {
+		return fmt.Errorf("deployment_preference.0.percentage and deployment_preference.0.interval must be specified for %s deployments", preference.deploymentType)
+	}
+
+	return nil
+}
+
+type aliasDeploymentPreference struct {
+	alarms                 []string
+	deploymentType         string
+	interval               time.Duration
+	percentage             int
+	stateMachineVersionARN string
+}
+
+func expandAliasDeploymentPreference(tfMap map[string]interface{}) aliasDeploymentPreference {
+	apiObject := aliasDeploymentPreference{
+		deploymentType:         tfMap[names.AttrType].(string),
+		interval:               time.Duration(tfMap[names.AttrInterval].(int)) * time.Minute,
+		percentage:             tfMap["percentage"].(int),
+		stateMachineVersionARN: tfMap["state_machine_version_arn"].(string),
+	}
+
+	if v, ok := tfMap["alarms"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.alarms = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+// weights returns the percentages of traffic routed to the new version at each step of the deployment.
+func (p aliasDeploymentPreference) weights() []int64 {
+	switch p.deploymentType {
+	case aliasDeploymentTypeCanary:
+		return []int64{int64(p.percentage), 100}
+	case aliasDeploymentTypeLinear:
+		var weights []int64
+		for w := p.percentage; w < 100; w += p.percentage {
+			weights = append(weights, int64(w))
+		}
+		return append(weights, 100)
+	default:
+		return []int64{100}
+	}
+}
+
+// aliasRoutingConfiguration routes weight percent of traffic to newVersionARN and the remainder to oldVersionARN.
+func aliasRoutingConfiguration(newVersionARN, oldVersionARN string, weight int64) []*sfn.RoutingConfigurationListItem {
+	if weight >= 100 || oldVersionARN == "" {
+		return []*sfn.RoutingConfigurationListItem{{
+			StateMachineVersionArn: aws.String(newVersionARN),
+			Weight:                 aws.Int64(100),
+		}}
+	}
+
+	return []*sfn.RoutingConfigurationListItem{
+		{
+			StateMachineVersionArn: aws.String(newVersionARN),
+			Weight:                 aws.Int64(weight),
+		},
+		{
+			StateMachineVersionArn: aws.String(oldVersionARN),
+			Weight:                 aws.Int64(100 - weight),
+		},
+	}
+}
+
+// deployAlias gradually shifts the alias traffic to the preferred state machine version.
+// If any of the configured CloudWatch alarms goes into the ALARM state while the new version is
+// baking, the alias is rolled back to its original routing configuration.
+func deployAlias(ctx context.Context, conn *sfn.SFN, cwConn *cloudwatch.Client, arn string, preference aliasDeploymentPreference) error {
+	alias, err := FindAliasByARN(ctx, conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("reading SFN Alias (%s): %w", arn, err)
+	}
+
+	var oldVersionARN string
+	var oldWeight int64
+	for _, v := range alias.RoutingConfiguration {
+		if versionARN := aws.StringValue(v.StateMachineVersionArn); versionARN != preference.stateMachineVersionARN && aws.Int64Value(v.Weight) > oldWeight {
+			oldVersionARN, oldWeight = versionARN, aws.Int64Value(v.Weight)
+		}
+	}
+
+	weights := preference.weights()
+	for i, weight := range weights {
+		input := &sfn.UpdateStateMachineAliasInput{
+			RoutingConfiguration: aliasRoutingConfiguration(preference.stateMachineVersionARN, oldVersionARN, weight),
+			StateMachineAliasArn: aws.String(arn),
+		}
+
+		log.Printf("[DEBUG] Shifting %d%% of SFN Alias (%s) traffic to %s", weight, arn, preference.stateMachineVersionARN)
+		if _, err := conn.UpdateStateMachineAliasWithContext(ctx, input); err != nil {
+			return fmt.Errorf("shifting traffic to %s: %w", preference.stateMachineVersionARN, err)
+		}
+
+		if i == len(weights)-1 || oldVersionARN == "" {
+			break
+		}
+
+		if err := waitAliasDeploymentBaked(ctx, cwConn, preference.alarms, preference.interval); err != nil {
+			input := &sfn.UpdateStateMachineAliasInput{
+				RoutingConfiguration: alias.RoutingConfiguration,
+				StateMachineAliasArn: aws.String(arn),
+			}
+
+			if _, rollbackErr := conn.UpdateStateMachineAliasWithContext(ctx, input); rollbackErr != nil {
+				return errors.Join(err, fmt.Errorf("rolling back: %w", rollbackErr))
+			}
+
+			return fmt.Errorf("deployment of %s rolled back: %w", preference.stateMachineVersionARN, err)
+		}
+	}
+
+	return nil
+}
+
+const (
+	aliasDeploymentAlarmPollInterval = 30 * time.Second
+)
+
+// waitAliasDeploymentBaked waits for the bake interval, failing as soon as any of the alarms is in the ALARM state.
+func waitAliasDeploymentBaked(ctx context.Context, conn *cloudwatch.Client, alarms []string, interval time.Duration) error {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	ticker := time.NewTicker(aliasDeploymentAlarmPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := checkAliasDeploymentAlarms(ctx, conn, alarms); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return checkAliasDeploymentAlarms(ctx, conn, alarms)
+		case <-ticker.C:
+		}
+	}
+}
+
+func checkAliasDeploymentAlarms(ctx context.Context, conn *cloudwatch.Client, alarms []string) error {
+	if len(alarms) == 0 {
+		return nil
+	}
+
+	input := &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarms,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+
+	var alarmNames []string
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return fmt.Errorf("reading CloudWatch Alarms: %w", err)
+		}
+
+		for _, v := range page.MetricAlarms {
+			alarmNames = append(alarmNames, aws.StringValue(v.AlarmName))
+		}
+		for _, v := range page.CompositeAlarms {
+			alarmNames = append(alarmNames, aws.StringValue(v.AlarmName))
+		}
+	}
+
+	if len(alarmNames) > 0 {
+		return fmt.Errorf("CloudWatch Alarms in ALARM state: %v", alarmNames)
+	}
+
+	return nil
+}
+
 func flattenAliasRoutingConfigurationItem(apiObject *sfn.RoutingConfigurationListItem) map[string]interface{} {
 	if apiObject == nil {
 		return nil
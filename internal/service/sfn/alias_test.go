@@ -87,6 +87,49 @@ func TestAccSFNAlias_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSFNAlias_deploymentPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var alias sfn.DescribeStateMachineAliasOutput
+	rString := sdkacctest.RandString(8)
+	stateMachineName := fmt.Sprintf("tf_acc_state_machine_alias_deploy_%s", rString)
+	aliasName := fmt.Sprintf("tf_acc_state_machine_alias_deploy_%s", rString)
+	resourceName := "aws_sfn_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineAliasConfig_deploymentPreference(stateMachineName, aliasName, 10, "ALL_AT_ONCE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.type", "ALL_AT_ONCE"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+			{
+				Config: testAccStateMachineAliasConfig_deploymentPreference(stateMachineName, aliasName, 5, "CANARY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "deployment_preference.0.type", "CANARY"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "routing_configuration.0.state_machine_version_arn", "aws_sfn_state_machine.test", "state_machine_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "routing_configuration.0.weight", "100"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasAttributes(mapping *sfn.DescribeStateMachineAliasOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		name := *mapping.Name
@@ -294,3 +337,34 @@ resource "aws_sfn_alias" "test" {
 }
 `, aliasName))
 }
+
+func testAccStateMachineAliasConfig_deploymentPreference(statemachineName, aliasName string, rMaxAttempts int, deploymentType string) string {
+	return acctest.ConfigCompose(testAccStateMachineAliasConfig_base(statemachineName, rMaxAttempts), fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "ExecutionsFailed"
+  namespace           = "AWS/States"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 1
+
+  dimensions = {
+    StateMachineArn = aws_sfn_state_machine.test.arn
+  }
+}
+
+resource "aws_sfn_alias" "test" {
+  name = %[1]q
+
+  deployment_preference {
+    state_machine_version_arn = aws_sfn_state_machine.test.state_machine_version_arn
+    type                      = %[2]q
+    percentage                = 10
+    interval                  = 1
+    alarms                    = [aws_cloudwatch_metric_alarm.test.alarm_name]
+  }
+}
+`, aliasName, deploymentType))
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -14,12 +15,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -59,6 +62,11 @@ func ResourceStateMachine() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
+			"definition_substitutions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -161,7 +169,10 @@ func ResourceStateMachine() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidateDefinition,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -170,7 +181,7 @@ func resourceStateMachineCreate(ctx context.Context, d *schema.ResourceData, met
 
 	name := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
 	input := &sfn.CreateStateMachineInput{
-		Definition: aws.String(d.Get("definition").(string)),
+		Definition: aws.String(expandDefinition(d.Get("definition").(string), d.Get("definition_substitutions").(map[string]interface{}))),
 		Name:       aws.String(name),
 		Publish:    aws.Bool(d.Get("publish").(bool)),
 		RoleArn:    aws.String(d.Get(names.AttrRoleARN).(string)),
@@ -225,7 +236,12 @@ func resourceStateMachineRead(ctx context.Context, d *schema.ResourceData, meta
 	} else {
 		d.Set(names.AttrCreationDate, nil)
 	}
-	d.Set("definition", output.Definition)
+	// Keep the configured definition if it still renders to the deployed one after substitution.
+	if definition, substitutions := d.Get("definition").(string), d.Get("definition_substitutions").(map[string]interface{}); len(substitutions) > 0 && verify.JSONBytesEqual([]byte(expandDefinition(definition, substitutions)), []byte(aws.StringValue(output.Definition))) {
+		d.Set("definition", definition)
+	} else {
+		d.Set("definition", output.Definition)
+	}
 	d.Set(names.AttrDescription, output.Description)
 	if output.LoggingConfiguration != nil {
 		if err := d.Set(names.AttrLoggingConfiguration, []interface{}{flattenLoggingConfiguration(output.LoggingConfiguration)}); err != nil {
@@ -274,8 +290,9 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
+		definition := expandDefinition(d.Get("definition").(string), d.Get("definition_substitutions").(map[string]interface{}))
 		input := &sfn.UpdateStateMachineInput{
-			Definition:      aws.String(d.Get("definition").(string)),
+			Definition:      aws.String(definition),
 			RoleArn:         aws.String(d.Get(names.AttrRoleARN).(string)),
 			StateMachineArn: aws.String(d.Id()),
 			Publish:         aws.Bool(d.Get("publish").(bool)),
@@ -311,7 +328,7 @@ func resourceStateMachineUpdate(ctx context.Context, d *schema.ResourceData, met
 				return retry.NonRetryableError(err)
 			}
 
-			if d.HasChange("definition") && !verify.JSONBytesEqual([]byte(aws.StringValue(output.Definition)), []byte(d.Get("definition").(string))) ||
+			if d.HasChanges("definition", "definition_substitutions") && !verify.JSONBytesEqual([]byte(aws.StringValue(output.Definition)), []byte(definition)) ||
 				d.HasChange(names.AttrRoleARN) && aws.StringValue(output.RoleArn) != d.Get(names.AttrRoleARN).(string) ||
 				//d.HasChange("publish") && aws.Bool(output.Publish) != d.Get("publish").(bool) ||
 				d.HasChange("tracing_configuration.0.enabled") && output.TracingConfiguration != nil && aws.BoolValue(output.TracingConfiguration.Enabled) != d.Get("tracing_configuration.0.enabled").(bool) ||
@@ -408,6 +425,71 @@ func waitStateMachineDeleted(ctx context.Context, conn *sfn.SFN, stateMachineArn
 	return nil, err
 }
 
+func customizeDiffValidateDefinition(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("definition", "definition_substitutions") {
+		return nil
+	}
+
+	// The definition may reference values not known until apply.
+	if !d.NewValueKnown("definition") || !d.NewValueKnown("definition_substitutions") || !d.NewValueKnown(names.AttrType) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SFNConn(ctx)
+
+	input := &sfn.ValidateStateMachineDefinitionInput{
+		Definition: aws.String(expandDefinition(d.Get("definition").(string), d.Get("definition_substitutions").(map[string]interface{}))),
+		Type:       aws.String(d.Get(names.AttrType).(string)),
+	}
+
+	output, err := conn.ValidateStateMachineDefinitionWithContext(ctx, input)
+
+	// Validation is best effort; don't block planning if the caller isn't allowed to validate.
+	if tfawserr.ErrCodeEquals(err, "AccessDeniedException") {
+		log.Printf("[WARN] skipping Step Functions State Machine definition validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating Step Functions State Machine definition: %w", err)
+	}
+
+	var errs []string
+	for _, v := range output.Diagnostics {
+		diagnostic := fmt.Sprintf("%s: %s", aws.StringValue(v.Code), aws.StringValue(v.Message))
+		if location := aws.StringValue(v.Location); location != "" {
+			diagnostic = fmt.Sprintf("%s (at %s)", diagnostic, location)
+		}
+
+		if aws.StringValue(v.Severity) != sfn.ValidateStateMachineDefinitionSeverityError {
+			log.Printf("[WARN] Step Functions State Machine definition: %s", diagnostic)
+			continue
+		}
+
+		errs = append(errs, diagnostic)
+	}
+
+	if len(errs) > 0 || aws.StringValue(output.Result) == sfn.ValidateStateMachineDefinitionResultCodeFail {
+		return fmt.Errorf("invalid Step Functions State Machine definition:\n%s", strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+// expandDefinition replaces each ${key} placeholder in the definition with the corresponding substitution value.
+func expandDefinition(definition string, substitutions map[string]interface{}) string {
+	if len(substitutions) == 0 {
+		return definition
+	}
+
+	oldnew := make([]string, 0, 2*len(substitutions))
+	for k, v := range flex.ExpandStringValueMap(substitutions) {
+		oldnew = append(oldnew, "${"+k+"}", v)
+	}
+
+	return strings.NewReplacer(oldnew...).Replace(definition)
+}
+
 func expandLoggingConfiguration(tfMap map[string]interface{}) *sfn.LoggingConfiguration {
 	if tfMap == nil {
 		return nil
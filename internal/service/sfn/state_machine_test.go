@@ -443,6 +443,58 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionSubstitutions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig_definitionSubstitutions(rName, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexache.MustCompile(`.*\$\{FunctionArn\}.*`)),
+					resource.TestCheckResourceAttr(resourceName, "definition_substitutions.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "definition_substitutions.FunctionArn", "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "definition_substitutions.MaxAttempts", "5"),
+				),
+			},
+			{
+				Config: testAccStateMachineConfig_definitionSubstitutions(rName, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckExists(ctx, resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "definition_substitutions.MaxAttempts", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_definitionInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_definitionInvalid(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`invalid Step Functions State Machine definition`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -950,3 +1002,65 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_definitionSubstitutions(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "$${FunctionArn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": $${MaxAttempts},
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+
+  definition_substitutions = {
+    FunctionArn = aws_lambda_function.test.arn
+    MaxAttempts = %[2]d
+  }
+}
+`, rName, rMaxAttempts))
+}
+
+func testAccStateMachineConfig_definitionInvalid(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+
+  definition = <<EOF
+{
+  "StartAt": "Missing",
+  "States": {
+    "HelloWorld": {
+      "Type": "Pass",
+      "End": true
+    }
+  }
+}
+EOF
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssoadmin_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSOAdminApplicationAuthenticationMethod_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_authentication_method.test"
+	applicationResourceName := "aws_ssoadmin_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAuthenticationMethodDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:CreateTokenWithIAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_arn", applicationResourceName, "application_arn"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_method_type", "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_method.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_method.0.iam.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "authentication_method.0.iam.0.actor_policy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationAuthenticationMethod_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_authentication_method.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAuthenticationMethodDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:CreateTokenWithIAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssoadmin.ResourceApplicationAuthenticationMethod, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminApplicationAuthenticationMethod_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssoadmin_application_authentication_method.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSOAdminEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationAuthenticationMethodDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:CreateTokenWithIAM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "authentication_method.0.iam.0.actor_policy", regexache.MustCompile(`"sso-oauth:CreateTokenWithIAM"`)),
+				),
+			},
+			{
+				Config: testAccApplicationAuthenticationMethodConfig_basic(rName, "sso-oauth:*"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckApplicationAuthenticationMethodExists(ctx, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "authentication_method.0.iam.0.actor_policy", regexache.MustCompile(`"sso-oauth:\*"`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckApplicationAuthenticationMethodDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssoadmin_application_authentication_method" {
+				continue
+			}
+
+			_, err := tfssoadmin.FindApplicationAuthenticationMethodByID(ctx, conn, rs.Primary.ID)
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return create.Error(names.SSOAdmin, create.ErrActionCheckingDestroyed, tfssoadmin.ResNameApplicationAuthenticationMethod, rs.Primary.ID, err)
+			}
+
+			return create.Error(names.SSOAdmin, create.ErrActionCheckingDestroyed, tfssoadmin.ResNameApplicationAuthenticationMethod, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckApplicationAuthenticationMethodExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SSOAdmin, create.ErrActionCheckingExistence, tfssoadmin.ResNameApplicationAuthenticationMethod, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSOAdmin, create.ErrActionCheckingExistence, tfssoadmin.ResNameApplicationAuthenticationMethod, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminClient(ctx)
+
+		_, err := tfssoadmin.FindApplicationAuthenticationMethodByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.SSOAdmin, create.ErrActionCheckingExistence, tfssoadmin.ResNameApplicationAuthenticationMethod, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccApplicationAuthenticationMethodConfig_basic(rName, action string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_application" "test" {
+  name                     = %[1]q
+  application_provider_arn = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_ssoadmin_application_authentication_method" "test" {
+  application_arn            = aws_ssoadmin_application.test.application_arn
+  authentication_method_type = "IAM"
+
+  authentication_method {
+    iam {
+      actor_policy = jsonencode({
+        Version = "2012-10-17"
+        Statement = [{
+          Effect = "Allow"
+          Principal = {
+            AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+          }
+          Action   = %[3]q
+          Resource = "*"
+        }]
+      })
+    }
+  }
+}
+`, rName, testAccApplicationProviderARN, action)
+}
@@ -9,11 +9,13 @@ var (
 	ResourceApplicationAssignment              = newResourceApplicationAssignment
 	ResourceApplicationAssignmentConfiguration = newResourceApplicationAssignmentConfiguration
 	ResourceApplicationAccessScope             = newResourceApplicationAccessScope
+	ResourceApplicationAuthenticationMethod    = newResourceApplicationAuthenticationMethod
 	ResourceTrustedTokenIssuer                 = newResourceTrustedTokenIssuer
 
 	FindApplicationByID                        = findApplicationByID
 	FindApplicationAssignmentByID              = findApplicationAssignmentByID
 	FindApplicationAssignmentConfigurationByID = findApplicationAssignmentConfigurationByID
 	FindApplicationAccessScopeByID             = findApplicationAccessScopeByID
+	FindApplicationAuthenticationMethodByID    = findApplicationAuthenticationMethodByID
 	FindTrustedTokenIssuerByARN                = findTrustedTokenIssuerByARN
 )
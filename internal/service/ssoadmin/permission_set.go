@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -78,6 +79,11 @@ func ResourcePermissionSet() *schema.Resource {
 					validation.StringMatch(regexache.MustCompile(`[\w+=,.@-]+`), "must match [\\w+=,.@-]"),
 				),
 			},
+			"provisioning_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
 			"relay_state": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -214,7 +220,13 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if v, ok := d.GetOk("provisioning_parallelism"); ok {
+			err = provisionPermissionSetByAccount(ctx, conn, permissionSetARN, instanceARN, v.(int), d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -309,6 +321,96 @@ func provisionPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissi
 	return nil
 }
 
+// provisionPermissionSetByAccount provisions the permission set to each account it is already provisioned to,
+// with at most parallelism requests in flight. Failures are collected and reported per account.
+func provisionPermissionSetByAccount(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string, parallelism int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	accountIDs, err := findAccountsForProvisionedPermissionSet(ctx, conn, permissionSetARN, instanceARN)
+
+	if err != nil {
+		return fmt.Errorf("listing accounts for SSO Permission Set (%s): %w", permissionSetARN, err)
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs []error
+	sem := make(chan struct{}, parallelism)
+
+	for _, accountID := range accountIDs {
+		sem <- struct{}{}
+		wg.Add(1)
+
+		go func(accountID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := provisionPermissionSetToAccount(ctx, conn, permissionSetARN, instanceARN, accountID, timeout); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("account (%s): %w", accountID, err))
+				mu.Unlock()
+			}
+		}(accountID)
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("provisioning SSO Permission Set (%s) to %d of %d accounts failed: %w", permissionSetARN, len(errs), len(accountIDs), err)
+	}
+
+	return nil
+}
+
+func provisionPermissionSetToAccount(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN, accountID string, timeout time.Duration) error {
+	input := &ssoadmin.ProvisionPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+		TargetId:         aws.String(accountID),
+		TargetType:       awstypes.ProvisionTargetTypeAwsAccount,
+	}
+
+	// Concurrent provisioning requests for the same permission set can be rejected while others are in progress.
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, timeout, func() (interface{}, error) {
+		return conn.ProvisionPermissionSet(ctx, input)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	requestID := aws.ToString(outputRaw.(*ssoadmin.ProvisionPermissionSetOutput).PermissionSetProvisioningStatus.RequestId)
+	if _, err := waitPermissionSetProvisioned(ctx, conn, instanceARN, requestID, timeout); err != nil {
+		return fmt.Errorf("waiting for provision: %w", err)
+	}
+
+	return nil
+}
+
+func findAccountsForProvisionedPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) ([]string, error) {
+	input := &ssoadmin.ListAccountsForProvisionedPermissionSetInput{
+		InstanceArn:      aws.String(instanceARN),
+		PermissionSetArn: aws.String(permissionSetARN),
+	}
+	var output []string
+
+	pages := ssoadmin.NewListAccountsForProvisionedPermissionSetPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountIds...)
+	}
+
+	return output, nil
+}
+
 func findPermissionSetProvisioningStatus(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string) (*awstypes.PermissionSetProvisioningStatus, error) {
 	input := &ssoadmin.DescribePermissionSetProvisioningStatusInput{
 		InstanceArn:                     aws.String(instanceARN),
@@ -135,6 +135,42 @@ func TestAccSSOAdminPermissionSet_updateDescription(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminPermissionSet_provisioningParallelism(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_permission_set.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckSSOAdminInstances(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSetConfig_provisioningParallelism(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_parallelism", "5"),
+				),
+			},
+			{
+				Config: testAccPermissionSetConfig_provisioningParallelism(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSOAdminPermissionSetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"provisioning_parallelism"},
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminPermissionSet_updateRelayState(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ssoadmin_permission_set.test"
@@ -351,6 +387,19 @@ resource "aws_ssoadmin_permission_set" "test" {
 `, rName)
 }
 
+func testAccPermissionSetConfig_provisioningParallelism(rName, description string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  name                     = %[1]q
+  description              = %[2]q
+  instance_arn             = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  provisioning_parallelism = 5
+}
+`, rName, description)
+}
+
 func testAccPermissionSetConfig_updateRelayState(rName string) string {
 	return fmt.Sprintf(`
 data "aws_ssoadmin_instances" "test" {}
@@ -52,6 +52,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceApplicationAssignmentConfiguration,
 			Name:    "Application Assignment Configuration",
 		},
+		{
+			Factory: newResourceApplicationAuthenticationMethod,
+			Name:    "Application Authentication Method",
+		},
 		{
 			Factory: newResourceTrustedTokenIssuer,
 			Name:    "Trusted Token Issuer",
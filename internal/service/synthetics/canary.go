@@ -5,6 +5,7 @@ package synthetics
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -90,6 +92,24 @@ func ResourceCanary() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Write-only attributes can't be nested in run_config, an Optional+Computed block.
+			"environment_variables_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"run_config.0.environment_variables"},
+			},
+			"environment_variables_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"environment_variables_wo"},
+			},
 			"engine_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -130,9 +150,10 @@ func ResourceCanary() *schema.Resource {
 							Optional: true,
 						},
 						"environment_variables": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:          schema.TypeMap,
+							Optional:      true,
+							Elem:          &schema.Schema{Type: schema.TypeString},
+							ConflictsWith: []string{"environment_variables_wo"},
 						},
 						"memory_in_mb": {
 							Type:     schema.TypeInt,
@@ -291,8 +312,13 @@ func resourceCanaryCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.Code = code
 	}
 
-	if v, ok := d.GetOk("run_config"); ok {
-		input.RunConfig = expandCanaryRunConfig(v.([]interface{}))
+	envVarsWO, err := canaryWriteOnlyEnvironmentVariables(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Synthetics Canary (%s): %s", name, err)
+	}
+
+	if v, ok := d.GetOk("run_config"); ok || len(envVarsWO) > 0 {
+		input.RunConfig = expandCanaryRunConfig(v.([]interface{}), envVarsWO)
 	}
 
 	if v, ok := d.GetOk("artifact_config"); ok {
@@ -400,7 +426,7 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	runConfig := &awstypes.CanaryRunConfigInput{}
 	if v, ok := d.GetOk("run_config"); ok {
-		runConfig = expandCanaryRunConfig(v.([]interface{}))
+		runConfig = expandCanaryRunConfig(v.([]interface{}), nil)
 	}
 
 	if err := d.Set("run_config", flattenCanaryRunConfig(canary.RunConfig, runConfig.EnvironmentVariables)); err != nil {
@@ -421,6 +447,9 @@ func resourceCanaryRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 	setTagsOut(ctx, canary.Tags)
 
+	// Reading happens during refresh, so any deprecation is reported when planning.
+	diags = append(diags, canaryRuntimeVersionDeprecationDiagnostics(ctx, conn, d.Id(), aws.ToString(canary.RuntimeVersion))...)
+
 	return diags
 }
 
@@ -428,7 +457,7 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SyntheticsClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "start_canary") {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "dry_run", "start_canary") {
 		input := &synthetics.UpdateCanaryInput{
 			Name: aws.String(d.Id()),
 		}
@@ -453,8 +482,13 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			}
 		}
 
-		if d.HasChange("run_config") {
-			input.RunConfig = expandCanaryRunConfig(d.Get("run_config").([]interface{}))
+		if d.HasChanges("run_config", "environment_variables_wo_version") {
+			envVarsWO, err := canaryWriteOnlyEnvironmentVariables(d)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): %s", d.Id(), err)
+			}
+
+			input.RunConfig = expandCanaryRunConfig(d.Get("run_config").([]interface{}), envVarsWO)
 		}
 
 		if d.HasChange("artifact_s3_location") {
@@ -480,6 +514,26 @@ func resourceCanaryUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.ExecutionRoleArn = aws.String(n.(string))
 		}
 
+		// A dry run validates the new configuration before it's applied. Schedule changes aren't part of a dry run.
+		if d.Get("dry_run").(bool) && d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "dry_run", names.AttrSchedule, "start_canary") {
+			dryRunID, err := startCanaryDryRun(ctx, conn, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): starting dry run: %s", d.Id(), err)
+			}
+
+			if _, err := waitCanaryDryRunPassed(ctx, conn, d.Id(), dryRunID); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Synthetics Canary (%s): waiting for dry run (%s) to pass: %s", d.Id(), dryRunID, err)
+			}
+
+			// When updating from a dry run, Schedule is the only other field that can be specified.
+			input = &synthetics.UpdateCanaryInput{
+				DryRunId: aws.String(dryRunID),
+				Name:     input.Name,
+				Schedule: input.Schedule,
+			}
+		}
+
 		status := d.Get(names.AttrStatus).(string)
 		if status == string(awstypes.CanaryStateRunning) {
 			if err := stopCanary(ctx, d.Id(), conn); err != nil {
@@ -687,8 +741,14 @@ func flattenCanarySchedule(canarySchedule *awstypes.CanaryScheduleOutput) []inte
 	return []interface{}{m}
 }
 
-func expandCanaryRunConfig(l []interface{}) *awstypes.CanaryRunConfigInput {
+func expandCanaryRunConfig(l []interface{}, envVarsWO map[string]string) *awstypes.CanaryRunConfigInput {
 	if len(l) == 0 || l[0] == nil {
+		if len(envVarsWO) > 0 {
+			return &awstypes.CanaryRunConfigInput{
+				EnvironmentVariables: envVarsWO,
+			}
+		}
+
 		return nil
 	}
 
@@ -710,9 +770,62 @@ func expandCanaryRunConfig(l []interface{}) *awstypes.CanaryRunConfigInput {
 		codeConfig.EnvironmentVariables = flex.ExpandStringValueMap(vars)
 	}
 
+	if len(envVarsWO) > 0 {
+		codeConfig.EnvironmentVariables = envVarsWO
+	}
+
 	return codeConfig
 }
 
+// canaryWriteOnlyEnvironmentVariables returns the write-only environment variables from the raw configuration.
+// Write-only values are only available in the configuration during apply and are never stored in state.
+func canaryWriteOnlyEnvironmentVariables(d *schema.ResourceData) (map[string]string, error) {
+	v, diags := d.GetRawConfigAt(cty.GetAttrPath("environment_variables_wo"))
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("reading environment_variables_wo: %v", diags)
+	}
+
+	if !v.Type().Equals(cty.String) || !v.IsKnown() || v.IsNull() {
+		return nil, nil
+	}
+
+	var envVars map[string]string
+	if err := json.Unmarshal([]byte(v.AsString()), &envVars); err != nil {
+		return nil, fmt.Errorf("decoding environment_variables_wo: %w", err)
+	}
+
+	return envVars, nil
+}
+
+func startCanaryDryRun(ctx context.Context, conn *synthetics.Client, update *synthetics.UpdateCanaryInput) (string, error) {
+	input := &synthetics.StartCanaryDryRunInput{
+		ArtifactConfig:               update.ArtifactConfig,
+		ArtifactS3Location:           update.ArtifactS3Location,
+		Code:                         update.Code,
+		ExecutionRoleArn:             update.ExecutionRoleArn,
+		FailureRetentionPeriodInDays: update.FailureRetentionPeriodInDays,
+		Name:                         update.Name,
+		RunConfig:                    update.RunConfig,
+		RuntimeVersion:               update.RuntimeVersion,
+		SuccessRetentionPeriodInDays: update.SuccessRetentionPeriodInDays,
+		VisualReference:              update.VisualReference,
+		VpcConfig:                    update.VpcConfig,
+	}
+
+	output, err := conn.StartCanaryDryRun(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.DryRunConfig == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.DryRunConfig.DryRunId), nil
+}
+
 func flattenCanaryRunConfig(canaryCodeOut *awstypes.CanaryRunConfigOutput, envVars map[string]string) []interface{} {
 	if canaryCodeOut == nil {
 		return []interface{}{}
@@ -784,6 +897,72 @@ func flattenCanaryTimeline(timeline *awstypes.CanaryTimeline) []interface{} {
 	return []interface{}{m}
 }
 
+// canaryRuntimeVersionDeprecationDiagnostics returns a warning if the canary's runtime version is deprecated or scheduled
+// for deprecation, naming the latest runtime version of the same family as its replacement.
+func canaryRuntimeVersionDeprecationDiagnostics(ctx context.Context, conn *synthetics.Client, name, runtimeVersion string) diag.Diagnostics {
+	runtimeVersions, err := findRuntimeVersions(ctx, conn)
+
+	// Failing to check for deprecation must not prevent the canary from being read.
+	if err != nil {
+		log.Printf("[WARN] reading Synthetics runtime versions: %s", err)
+		return nil
+	}
+
+	var current, latest *awstypes.RuntimeVersion
+	family := runtimeVersionFamily(runtimeVersion)
+
+	for i, v := range runtimeVersions {
+		if aws.ToString(v.VersionName) == runtimeVersion {
+			current = &runtimeVersions[i]
+		}
+
+		if v.DeprecationDate != nil || runtimeVersionFamily(aws.ToString(v.VersionName)) != family {
+			continue
+		}
+
+		if latest == nil || aws.ToTime(v.ReleaseDate).After(aws.ToTime(latest.ReleaseDate)) {
+			latest = &runtimeVersions[i]
+		}
+	}
+
+	if current == nil || current.DeprecationDate == nil {
+		return nil
+	}
+
+	var summary, detail string
+	deprecationDate := aws.ToTime(current.DeprecationDate)
+
+	if deprecationDate.After(time.Now()) {
+		summary = "Synthetics Canary runtime version scheduled for deprecation"
+		detail = fmt.Sprintf("Runtime version %s used by Synthetics Canary (%s) will be deprecated on %s. Canaries using a deprecated runtime version cannot be updated.", runtimeVersion, name, deprecationDate.Format(time.RFC3339))
+	} else {
+		summary = "Synthetics Canary runtime version deprecated"
+		detail = fmt.Sprintf("Runtime version %s used by Synthetics Canary (%s) was deprecated on %s. Canaries using a deprecated runtime version cannot be updated.", runtimeVersion, name, deprecationDate.Format(time.RFC3339))
+	}
+
+	if latest != nil {
+		detail += fmt.Sprintf(" Update `runtime_version` to %s.", aws.ToString(latest.VersionName))
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  summary,
+			Detail:   detail,
+		},
+	}
+}
+
+// runtimeVersionFamily returns the runtime version name without its version number,
+// e.g. "syn-nodejs-puppeteer" for "syn-nodejs-puppeteer-9.1".
+func runtimeVersionFamily(runtimeVersion string) string {
+	if i := strings.LastIndex(runtimeVersion, "-"); i != -1 {
+		return runtimeVersion[:i]
+	}
+
+	return runtimeVersion
+}
+
 func startCanary(ctx context.Context, name string, conn *synthetics.Client) error {
 	log.Printf("[DEBUG] Starting Synthetics Canary: (%s)", name)
 	_, err := conn.StartCanary(ctx, &synthetics.StartCanaryInput{
@@ -10,9 +10,11 @@ import (
 
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/synthetics/types"
+	"github.com/hashicorp/go-version"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfsynthetics "github.com/hashicorp/terraform-provider-aws/internal/service/synthetics"
@@ -62,7 +64,7 @@ func TestAccSyntheticsCanary_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_zipUpdated(rName),
@@ -120,7 +122,7 @@ func TestAccSyntheticsCanary_artifactEncryption(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_artifactEncryptionKMS(rName),
@@ -159,7 +161,7 @@ func TestAccSyntheticsCanary_runtimeVersion(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_runtimeVersion(rName, "syn-nodejs-puppeteer-6.1"),
@@ -196,7 +198,7 @@ func TestAccSyntheticsCanary_startCanary(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_start(rName, false),
@@ -246,7 +248,7 @@ func TestAccSyntheticsCanary_StartCanary_codeChanges(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_startZipUpdated(rName, true),
@@ -302,7 +304,7 @@ func TestAccSyntheticsCanary_s3(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrS3Bucket, "s3_key", "s3_version", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{names.AttrS3Bucket, "s3_key", "s3_version", "start_canary", "delete_lambda", "dry_run"},
 			},
 		},
 	})
@@ -333,7 +335,7 @@ func TestAccSyntheticsCanary_run(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_run2(rName),
@@ -378,7 +380,7 @@ func TestAccSyntheticsCanary_runTracing(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_runTracing(rName, false),
@@ -422,7 +424,7 @@ func TestAccSyntheticsCanary_runEnvironmentVariables(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "run_config.0.environment_variables"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run", "run_config.0.environment_variables"},
 			},
 			{
 				Config: testAccCanaryConfig_runEnvVariables2(rName),
@@ -437,6 +439,73 @@ func TestAccSyntheticsCanary_runEnvironmentVariables(t *testing.T) {
 	})
 }
 
+func TestAccSyntheticsCanary_dryRun(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf1 awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_dryRun(rName, "syn-nodejs-puppeteer-6.1", 840),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "dry_run", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-puppeteer-6.1"),
+				),
+			},
+			{
+				Config: testAccCanaryConfig_dryRun(rName, "syn-nodejs-puppeteer-6.2", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version", "syn-nodejs-puppeteer-6.2"),
+					resource.TestCheckResourceAttr(resourceName, "run_config.0.timeout_in_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSyntheticsCanary_runEnvironmentVariablesWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Canary
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(8))
+	resourceName := "aws_synthetics_canary.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SyntheticsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		CheckDestroy: testAccCheckCanaryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCanaryConfig_runEnvVariablesWriteOnly(rName, "result1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_variables_wo"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables_wo_version", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccCanaryConfig_runEnvVariablesWriteOnly(rName, "result2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCanaryExists(ctx, resourceName, &conf),
+					resource.TestCheckNoResourceAttr(resourceName, "environment_variables_wo"),
+					resource.TestCheckResourceAttr(resourceName, "environment_variables_wo_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSyntheticsCanary_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -466,7 +535,7 @@ func TestAccSyntheticsCanary_vpc(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_vpc2(rName),
@@ -514,7 +583,7 @@ func TestAccSyntheticsCanary_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda"},
+				ImportStateVerifyIgnore: []string{"zip_file", "start_canary", "delete_lambda", "dry_run"},
 			},
 			{
 				Config: testAccCanaryConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -867,6 +936,56 @@ resource "aws_synthetics_canary" "test" {
 `, rName))
 }
 
+func testAccCanaryConfig_runEnvVariablesWriteOnly(rName, value string, woVersion int) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = "syn-nodejs-puppeteer-6.1"
+  delete_lambda        = true
+
+  environment_variables_wo = jsonencode({
+    test1 = %[2]q
+  })
+  environment_variables_wo_version = %[3]d
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, value, woVersion))
+}
+
+func testAccCanaryConfig_dryRun(rName, runtimeVersion string, timeout int) string {
+	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
+resource "aws_synthetics_canary" "test" {
+  name                 = %[1]q
+  artifact_s3_location = "s3://${aws_s3_bucket.test.bucket}/"
+  execution_role_arn   = aws_iam_role.test.arn
+  handler              = "exports.handler"
+  zip_file             = "test-fixtures/lambdatest.zip"
+  runtime_version      = %[2]q
+  delete_lambda        = true
+  dry_run              = true
+
+  schedule {
+    expression = "rate(0 minute)"
+  }
+
+  run_config {
+    timeout_in_seconds = %[3]d
+  }
+
+  depends_on = [aws_iam_role.test, aws_iam_role_policy.test]
+}
+`, rName, runtimeVersion, timeout))
+}
+
 func testAccCanaryConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccCanaryConfig_base(rName), fmt.Sprintf(`
 resource "aws_synthetics_canary" "test" {
@@ -10,3 +10,9 @@ import (
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+const (
+	canaryDryRunStatusFailed  = "FAILED"
+	canaryDryRunStatusPassed  = "PASSED"
+	canaryDryRunStatusRunning = "RUNNING"
+)
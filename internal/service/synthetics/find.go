@@ -44,6 +44,33 @@ func FindCanaryByName(ctx context.Context, conn *synthetics.Client, name string)
 	return output.Canary, nil
 }
 
+// findCanaryDryRun returns the canary configuration associated with the specified dry run.
+func findCanaryDryRun(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.Canary, error) {
+	input := &synthetics.GetCanaryInput{
+		DryRunId: aws.String(dryRunID),
+		Name:     aws.String(name),
+	}
+
+	output, err := conn.GetCanary(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Canary == nil || output.Canary.DryRunConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Canary, nil
+}
+
 func FindGroupByName(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Group, error) {
 	input := &synthetics.GetGroupInput{
 		GroupIdentifier: aws.String(name),
@@ -102,3 +129,21 @@ func FindAssociatedGroup(ctx context.Context, conn *synthetics.Client, canaryArn
 
 	return &group, nil
 }
+
+func findRuntimeVersions(ctx context.Context, conn *synthetics.Client) ([]awstypes.RuntimeVersion, error) {
+	input := &synthetics.DescribeRuntimeVersionsInput{}
+	var output []awstypes.RuntimeVersion
+
+	pages := synthetics.NewDescribeRuntimeVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RuntimeVersions...)
+	}
+
+	return output, nil
+}
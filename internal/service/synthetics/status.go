@@ -6,6 +6,7 @@ package synthetics
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -26,3 +27,25 @@ func statusCanaryState(ctx context.Context, conn *synthetics.Client, name string
 		return output, string(output.Status.State), nil
 	}
 }
+
+func statusCanaryDryRun(ctx context.Context, conn *synthetics.Client, name, dryRunID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCanaryDryRun(ctx, conn, name, dryRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The execution status is only reported once the dry run has completed.
+		switch status := aws.ToString(output.DryRunConfig.LastDryRunExecutionStatus); status {
+		case canaryDryRunStatusPassed, canaryDryRunStatusFailed:
+			return output, status, nil
+		default:
+			return output, canaryDryRunStatusRunning, nil
+		}
+	}
+}
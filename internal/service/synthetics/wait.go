@@ -21,6 +21,7 @@ const (
 	canaryRunningTimeout = 5 * time.Minute
 	canaryStoppedTimeout = 5 * time.Minute
 	canaryDeletedTimeout = 5 * time.Minute
+	canaryDryRunTimeout  = 20 * time.Minute
 )
 
 func waitCanaryReady(ctx context.Context, conn *synthetics.Client, name string) (*awstypes.Canary, error) { //nolint:unparam
@@ -114,3 +115,24 @@ func waitCanaryDeleted(ctx context.Context, conn *synthetics.Client, name string
 
 	return nil, err
 }
+
+func waitCanaryDryRunPassed(ctx context.Context, conn *synthetics.Client, name, dryRunID string) (*awstypes.Canary, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{canaryDryRunStatusRunning},
+		Target:  []string{canaryDryRunStatusPassed},
+		Refresh: statusCanaryDryRun(ctx, conn, name, dryRunID),
+		Timeout: canaryDryRunTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Canary); ok {
+		if status := output.Status; status != nil && aws.ToString(status.StateReason) != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", status.StateReasonCode, aws.ToString(status.StateReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,575 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamwrite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Batch Load Task")
+func newBatchLoadTaskResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &batchLoadTaskResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type batchLoadTaskResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*batchLoadTaskResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_timestreamwrite_batch_load_task"
+}
+
+func (r *batchLoadTaskResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3BucketNameAttribute := schema.StringAttribute{
+		Required: true,
+	}
+	measureValueTypeAttribute := schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.ScalarMeasureValueType](),
+		Optional:   true,
+	}
+	multiMeasureAttributeMappingBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[multiMeasureAttributeMappingModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"measure_value_type": measureValueTypeAttribute,
+				"source_column": schema.StringAttribute{
+					Required: true,
+				},
+				"target_multi_measure_attribute_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"record_version": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"target_database_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_table_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.BatchLoadStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_model_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataModelConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"data_model": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataModelModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("data_model_s3_configuration")),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"measure_name_column": schema.StringAttribute{
+										Optional: true,
+									},
+									"time_column": schema.StringAttribute{
+										Optional: true,
+									},
+									"time_unit": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.TimeUnit](),
+										Optional:   true,
+										Computed:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dimension_mapping": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionMappingModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"destination_column": schema.StringAttribute{
+													Optional: true,
+												},
+												"source_column": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+										},
+									},
+									"mixed_measure_mapping": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[mixedMeasureMappingModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+												"measure_value_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.MeasureValueType](),
+													Required:   true,
+												},
+												"source_column": schema.StringAttribute{
+													Optional: true,
+												},
+												"target_measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingBlock,
+											},
+										},
+									},
+									"multi_measure_mappings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[multiMeasureMappingsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"target_multi_measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingBlock,
+											},
+										},
+									},
+								},
+							},
+						},
+						"data_model_s3_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataModelS3ConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucketName: s3BucketNameAttribute,
+									"object_key": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"data_source_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.BatchLoadDataFormat](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"csv_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[csvConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"column_separator": schema.StringAttribute{
+										Optional: true,
+									},
+									"escape_char": schema.StringAttribute{
+										Optional: true,
+									},
+									"null_value": schema.StringAttribute{
+										Optional: true,
+									},
+									"quote_char": schema.StringAttribute{
+										Optional: true,
+									},
+									"trim_white_space": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"data_source_s3_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataSourceS3ConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucketName: s3BucketNameAttribute,
+									"object_key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"report_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[reportConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"report_s3_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[reportS3ConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucketName: s3BucketNameAttribute,
+									"encryption_option": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3EncryptionOption](),
+										Optional:   true,
+										Computed:   true,
+									},
+									names.AttrKMSKeyID: schema.StringAttribute{
+										Optional: true,
+									},
+									"object_key_prefix": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *batchLoadTaskResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data batchLoadTaskResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamWriteClient(ctx)
+
+	input := &timestreamwrite.CreateBatchLoadTaskInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+
+	output, err := conn.CreateBatchLoadTask(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Timestream Batch Load Task (%s:%s)", data.TargetTableName.ValueString(), data.TargetDatabaseName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TaskID = fwflex.StringToFramework(ctx, output.TaskId)
+	data.setID()
+
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+	task, err := waitBatchLoadTaskSucceeded(ctx, conn, data.ID.ValueString(), timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Timestream Batch Load Task (%s) complete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, task, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *batchLoadTaskResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data batchLoadTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().TimestreamWriteClient(ctx)
+
+	output, err := findBatchLoadTaskByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream Batch Load Task (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findBatchLoadTaskByID(ctx context.Context, conn *timestreamwrite.Client, id string) (*awstypes.BatchLoadTaskDescription, error) {
+	input := &timestreamwrite.DescribeBatchLoadTaskInput{
+		TaskId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBatchLoadTask(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BatchLoadTaskDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.BatchLoadTaskDescription, nil
+}
+
+func statusBatchLoadTask(ctx context.Context, conn *timestreamwrite.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBatchLoadTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.TaskStatus), nil
+	}
+}
+
+func waitBatchLoadTaskSucceeded(ctx context.Context, conn *timestreamwrite.Client, id string, timeout time.Duration) (*awstypes.BatchLoadTaskDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.BatchLoadStatusCreated, awstypes.BatchLoadStatusInProgress, awstypes.BatchLoadStatusPendingResume),
+		Target:  enum.Slice(awstypes.BatchLoadStatusSucceeded),
+		Refresh: statusBatchLoadTask(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.BatchLoadTaskDescription); ok {
+		if status := output.TaskStatus; status == awstypes.BatchLoadStatusFailed || status == awstypes.BatchLoadStatusProgressStopped {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type batchLoadTaskResourceModel struct {
+	DataModelConfiguration  fwtypes.ListNestedObjectValueOf[dataModelConfigurationModel]  `tfsdk:"data_model_configuration"`
+	DataSourceConfiguration fwtypes.ListNestedObjectValueOf[dataSourceConfigurationModel] `tfsdk:"data_source_configuration"`
+	ID                      types.String                                                  `tfsdk:"id"`
+	RecordVersion           types.Int64                                                   `tfsdk:"record_version"`
+	ReportConfiguration     fwtypes.ListNestedObjectValueOf[reportConfigurationModel]     `tfsdk:"report_configuration"`
+	TargetDatabaseName      types.String                                                  `tfsdk:"target_database_name"`
+	TargetTableName         types.String                                                  `tfsdk:"target_table_name"`
+	TaskID                  types.String                                                  `tfsdk:"task_id"`
+	TaskStatus              fwtypes.StringEnum[awstypes.BatchLoadStatus]                  `tfsdk:"task_status"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+}
+
+func (data *batchLoadTaskResourceModel) InitFromID() error {
+	data.TaskID = data.ID
+
+	return nil
+}
+
+func (data *batchLoadTaskResourceModel) setID() {
+	data.ID = data.TaskID
+}
+
+type dataModelConfigurationModel struct {
+	DataModel                fwtypes.ListNestedObjectValueOf[dataModelModel]                `tfsdk:"data_model"`
+	DataModelS3Configuration fwtypes.ListNestedObjectValueOf[dataModelS3ConfigurationModel] `tfsdk:"data_model_s3_configuration"`
+}
+
+type dataModelModel struct {
+	DimensionMappings    fwtypes.ListNestedObjectValueOf[dimensionMappingModel]     `tfsdk:"dimension_mapping"`
+	MeasureNameColumn    types.String                                               `tfsdk:"measure_name_column"`
+	MixedMeasureMappings fwtypes.ListNestedObjectValueOf[mixedMeasureMappingModel]  `tfsdk:"mixed_measure_mapping"`
+	MultiMeasureMappings fwtypes.ListNestedObjectValueOf[multiMeasureMappingsModel] `tfsdk:"multi_measure_mappings"`
+	TimeColumn           types.String                                               `tfsdk:"time_column"`
+	TimeUnit             fwtypes.StringEnum[awstypes.TimeUnit]                      `tfsdk:"time_unit"`
+}
+
+type dimensionMappingModel struct {
+	DestinationColumn types.String `tfsdk:"destination_column"`
+	SourceColumn      types.String `tfsdk:"source_column"`
+}
+
+type mixedMeasureMappingModel struct {
+	MeasureName                   types.String                                                       `tfsdk:"measure_name"`
+	MeasureValueType              fwtypes.StringEnum[awstypes.MeasureValueType]                      `tfsdk:"measure_value_type"`
+	MultiMeasureAttributeMappings fwtypes.ListNestedObjectValueOf[multiMeasureAttributeMappingModel] `tfsdk:"multi_measure_attribute_mapping"`
+	SourceColumn                  types.String                                                       `tfsdk:"source_column"`
+	TargetMeasureName             types.String                                                       `tfsdk:"target_measure_name"`
+}
+
+type multiMeasureMappingsModel struct {
+	MultiMeasureAttributeMappings fwtypes.ListNestedObjectValueOf[multiMeasureAttributeMappingModel] `tfsdk:"multi_measure_attribute_mapping"`
+	TargetMultiMeasureName        types.String                                                       `tfsdk:"target_multi_measure_name"`
+}
+
+type multiMeasureAttributeMappingModel struct {
+	MeasureValueType                fwtypes.StringEnum[awstypes.ScalarMeasureValueType] `tfsdk:"measure_value_type"`
+	SourceColumn                    types.String                                        `tfsdk:"source_column"`
+	TargetMultiMeasureAttributeName types.String                                        `tfsdk:"target_multi_measure_attribute_name"`
+}
+
+type dataModelS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	ObjectKey  types.String `tfsdk:"object_key"`
+}
+
+type dataSourceConfigurationModel struct {
+	CsvConfiguration          fwtypes.ListNestedObjectValueOf[csvConfigurationModel]          `tfsdk:"csv_configuration"`
+	DataFormat                fwtypes.StringEnum[awstypes.BatchLoadDataFormat]                `tfsdk:"data_format"`
+	DataSourceS3Configuration fwtypes.ListNestedObjectValueOf[dataSourceS3ConfigurationModel] `tfsdk:"data_source_s3_configuration"`
+}
+
+type csvConfigurationModel struct {
+	ColumnSeparator types.String `tfsdk:"column_separator"`
+	EscapeChar      types.String `tfsdk:"escape_char"`
+	NullValue       types.String `tfsdk:"null_value"`
+	QuoteChar       types.String `tfsdk:"quote_char"`
+	TrimWhiteSpace  types.Bool   `tfsdk:"trim_white_space"`
+}
+
+type dataSourceS3ConfigurationModel struct {
+	BucketName      types.String `tfsdk:"bucket_name"`
+	ObjectKeyPrefix types.String `tfsdk:"object_key_prefix"`
+}
+
+type reportConfigurationModel struct {
+	ReportS3Configuration fwtypes.ListNestedObjectValueOf[reportS3ConfigurationModel] `tfsdk:"report_s3_configuration"`
+}
+
+type reportS3ConfigurationModel struct {
+	BucketName       types.String                                    `tfsdk:"bucket_name"`
+	EncryptionOption fwtypes.StringEnum[awstypes.S3EncryptionOption] `tfsdk:"encryption_option"`
+	KMSKeyID         types.String                                    `tfsdk:"kms_key_id"`
+	ObjectKeyPrefix  types.String                                    `tfsdk:"object_key_prefix"`
+}
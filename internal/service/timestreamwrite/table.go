@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -79,8 +80,9 @@ func resourceTable() *schema.Resource {
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												names.AttrBucketName: {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(3, 63),
 												},
 												"encryption_option": {
 													Type:             schema.TypeString,
@@ -93,8 +95,9 @@ func resourceTable() *schema.Resource {
 													ValidateFunc: verify.ValidARN,
 												},
 												"object_key_prefix": {
-													Type:     schema.TypeString,
-													Optional: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringLenBetween(1, 928),
 												},
 											},
 										},
@@ -176,7 +179,10 @@ func resourceTable() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceTableCustomizeDiff,
+		),
 	}
 }
 
@@ -602,6 +608,52 @@ func flattenPartitionKeys(apiObjects []types.PartitionKey) []interface{} {
 	return tfList
 }
 
+func resourceTableCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v := d.Get("magnetic_store_write_properties.0.magnetic_store_rejected_data_location").([]interface{}); len(v) > 0 {
+		if k := "magnetic_store_write_properties.0.enable_magnetic_store_writes"; d.NewValueKnown(k) && !d.Get(k).(bool) {
+			return fmt.Errorf("magnetic_store_rejected_data_location requires enable_magnetic_store_writes to be true")
+		}
+	}
+
+	const (
+		s3ConfigurationPrefix = "magnetic_store_write_properties.0.magnetic_store_rejected_data_location.0.s3_configuration.0."
+		encryptionOptionKey   = s3ConfigurationPrefix + "encryption_option"
+		kmsKeyIDKey           = s3ConfigurationPrefix + names.AttrKMSKeyID
+	)
+	if d.NewValueKnown(encryptionOptionKey) && d.NewValueKnown(kmsKeyIDKey) {
+		encryptionOption, kmsKeyID := types.S3EncryptionOption(d.Get(encryptionOptionKey).(string)), d.Get(kmsKeyIDKey).(string)
+
+		switch {
+		case encryptionOption == types.S3EncryptionOptionSseKms && kmsKeyID == "":
+			return fmt.Errorf("%s is required when encryption_option is %q", names.AttrKMSKeyID, encryptionOption)
+		case encryptionOption != types.S3EncryptionOptionSseKms && kmsKeyID != "":
+			return fmt.Errorf("%s can only be set when encryption_option is %q", names.AttrKMSKeyID, types.S3EncryptionOptionSseKms)
+		}
+	}
+
+	for i := range d.Get("schema.0.composite_partition_key").([]interface{}) {
+		prefix := fmt.Sprintf("schema.0.composite_partition_key.%d.", i)
+		if !d.NewValueKnown(prefix+names.AttrType) || !d.NewValueKnown(prefix+names.AttrName) {
+			continue
+		}
+
+		partitionKeyType, name, enforcementInRecord := types.PartitionKeyType(d.Get(prefix+names.AttrType).(string)), d.Get(prefix+names.AttrName).(string), d.Get(prefix+"enforcement_in_record").(string)
+
+		switch partitionKeyType {
+		case types.PartitionKeyTypeDimension:
+			if name == "" {
+				return fmt.Errorf("composite_partition_key name is required when type is %q", partitionKeyType)
+			}
+		case types.PartitionKeyTypeMeasure:
+			if name != "" || enforcementInRecord != "" {
+				return fmt.Errorf("composite_partition_key name and enforcement_in_record cannot be set when type is %q", partitionKeyType)
+			}
+		}
+	}
+
+	return nil
+}
+
 const tableIDSeparator = ":"
 
 func tableCreateResourceID(tableName, databaseName string) string {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/timestreamwrite/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -364,6 +365,40 @@ func TestAccTimestreamWriteTable_schema(t *testing.T) {
 	})
 }
 
+func TestAccTimestreamWriteTable_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamWriteServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_rejectedDataLocationMagneticStoreWritesDisabled(rName),
+				ExpectError: regexache.MustCompile(`magnetic_store_rejected_data_location requires enable_magnetic_store_writes to be true`),
+			},
+			{
+				Config:      testAccTableConfig_rejectedDataLocationEncryption(rName, "SSE_KMS", false),
+				ExpectError: regexache.MustCompile(`kms_key_id is required when encryption_option is "SSE_KMS"`),
+			},
+			{
+				Config:      testAccTableConfig_rejectedDataLocationEncryption(rName, "SSE_S3", true),
+				ExpectError: regexache.MustCompile(`kms_key_id can only be set when encryption_option is "SSE_KMS"`),
+			},
+			{
+				Config:      testAccTableConfig_schemaPartitionKey(rName, "DIMENSION", ""),
+				ExpectError: regexache.MustCompile(`composite_partition_key name is required when type is "DIMENSION"`),
+			},
+			{
+				Config:      testAccTableConfig_schemaPartitionKey(rName, "MEASURE", "attr1"),
+				ExpectError: regexache.MustCompile(`composite_partition_key name and enforcement_in_record cannot be set when type is "MEASURE"`),
+			},
+		},
+	})
+}
+
 func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamWriteClient(ctx)
@@ -577,3 +612,70 @@ resource "aws_timestreamwrite_table" "test" {
 }
 `, rName, enforcementInRecord))
 }
+
+func testAccTableConfig_rejectedDataLocationMagneticStoreWritesDisabled(rName string) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = false
+
+    magnetic_store_rejected_data_location {
+      s3_configuration {
+        bucket_name = %[1]q
+      }
+    }
+  }
+}
+`, rName))
+}
+
+func testAccTableConfig_rejectedDataLocationEncryption(rName, encryptionOption string, kmsKeyID bool) string {
+	kmsKeyIDAttribute := ""
+	if kmsKeyID {
+		kmsKeyIDAttribute = `kms_key_id = "arn:${data.aws_partition.current.partition}:kms:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:key/00000000-0000-0000-0000-000000000000"`
+	}
+
+	return acctest.ConfigCompose(testAccTableConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+
+    magnetic_store_rejected_data_location {
+      s3_configuration {
+        bucket_name       = %[1]q
+        encryption_option = %[2]q
+        %[3]s
+      }
+    }
+  }
+}
+`, rName, encryptionOption, kmsKeyIDAttribute))
+}
+
+func testAccTableConfig_schemaPartitionKey(rName, partitionKeyType, name string) string {
+	return acctest.ConfigCompose(testAccTableConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  schema {
+    composite_partition_key {
+      name = %[3]q
+      type = %[2]q
+    }
+  }
+}
+`, rName, partitionKeyType, name))
+}
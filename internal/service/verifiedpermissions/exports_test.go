@@ -5,17 +5,21 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
-	ResourcePolicy         = newResourcePolicy
-	ResourcePolicyStore    = newResourcePolicyStore
-	ResourcePolicyTemplate = newResourcePolicyTemplate
-	ResourceSchema         = newResourceSchema
+	ResourcePolicy                       = newResourcePolicy
+	ResourcePolicyStore                  = newResourcePolicyStore
+	ResourcePolicyTemplate               = newResourcePolicyTemplate
+	ResourcePolicyTemplateLinkedPolicies = newResourcePolicyTemplateLinkedPolicies
+	ResourcePolicyTemplateLinkedPolicy   = newResourcePolicyTemplateLinkedPolicy
+	ResourceSchema                       = newResourceSchema
 
-	FindPolicyByID            = findPolicyByID
-	FindPolicyStoreByID       = findPolicyStoreByID
-	FindPolicyTemplateByID    = findPolicyTemplateByID
-	FindSchemaByPolicyStoreID = findSchemaByPolicyStoreID
+	FindPolicyByID             = findPolicyByID
+	FindPolicyStoreByID        = findPolicyStoreByID
+	FindPolicyTemplateByID     = findPolicyTemplateByID
+	FindSchemaByPolicyStoreID  = findSchemaByPolicyStoreID
+	FindTemplateLinkedPolicies = findTemplateLinkedPolicies
 )
 
 var (
 	PolicyTemplateParseID = policyTemplateParseID
+	ValidateCedarSchema   = validateCedarSchema
 )
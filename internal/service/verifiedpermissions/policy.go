@@ -5,6 +5,10 @@ package verifiedpermissions
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
@@ -537,6 +541,147 @@ func (r *resourcePolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 			}
 		}
 	}
+
+	if !req.Plan.Raw.IsNull() {
+		var plan resourcePolicyData
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		r.validateStaticPolicyAgainstSchema(ctx, plan, resp)
+	}
+}
+
+// validateStaticPolicyAgainstSchema checks, at plan time, that the principal, action and resource
+// entities referenced by a static policy are declared in the policy store's schema.
+// Validation is only performed for policy stores in STRICT validation mode.
+func (r *resourcePolicy) validateStaticPolicyAgainstSchema(ctx context.Context, plan resourcePolicyData, resp *resource.ModifyPlanResponse) {
+	if plan.PolicyStoreID.IsUnknown() || plan.PolicyStoreID.IsNull() {
+		return
+	}
+
+	def, diags := plan.Definition.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || def == nil {
+		return
+	}
+
+	static, diags := def.Static.ToPtr(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || static == nil || static.Statement.IsUnknown() || static.Statement.IsNull() {
+		return
+	}
+
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+	policyStoreID := plan.PolicyStoreID.ValueString()
+
+	policyStore, err := findPolicyStoreByID(ctx, conn, policyStoreID)
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStore, policyStoreID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if policyStore.ValidationSettings == nil || policyStore.ValidationSettings.Mode != awstypes.ValidationModeStrict {
+		return
+	}
+
+	policyStoreSchema, err := findSchemaByPolicyStoreID(ctx, conn, policyStoreID)
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyStoreSchema, policyStoreID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if err := validateStatementAgainstSchema(static.Statement.ValueString(), aws.ToString(policyStoreSchema.Schema)); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definition").AtListIndex(0).AtName("static").AtListIndex(0).AtName("statement"),
+			"Policy statement does not conform to the policy store schema",
+			err.Error(),
+		)
+	}
+}
+
+type cedarSchemaNamespace struct {
+	Actions     map[string]json.RawMessage `json:"actions"`
+	EntityTypes map[string]json.RawMessage `json:"entityTypes"`
+}
+
+// validateStatementAgainstSchema returns an error if the Cedar policy statement references an entity type or action
+// that is not declared in the specified Cedar JSON schema.
+// Statements that cannot be parsed are ignored here; they are reported by the service on apply.
+func validateStatementAgainstSchema(statement, schema string) error {
+	var namespaces map[string]cedarSchemaNamespace
+	if err := json.Unmarshal([]byte(schema), &namespaces); err != nil {
+		return nil //nolint:nilerr // Only JSON schemas are validated.
+	}
+
+	entityTypes, actions := make(map[string]struct{}), make(map[string]struct{})
+	for ns, v := range namespaces {
+		for k := range v.EntityTypes {
+			entityTypes[qualifyCedarName(ns, k)] = struct{}{}
+		}
+		for k := range v.Actions {
+			actions[fmt.Sprintf("%s::%q", qualifyCedarName(ns, "Action"), k)] = struct{}{}
+		}
+	}
+
+	tokens, err := cedar.Tokenize([]byte(statement))
+	if err != nil {
+		return nil //nolint:nilerr // Parse errors are reported elsewhere.
+	}
+
+	policies, err := cedar.Parse(tokens)
+	if err != nil {
+		return nil //nolint:nilerr // Parse errors are reported elsewhere.
+	}
+
+	var problems []string
+	for _, policy := range policies {
+		for _, entityPath := range [][]string{policy.Principal.Entity.Path, policy.Resource.Entity.Path} {
+			// The last element of an entity path is the entity ID.
+			if n := len(entityPath); n > 1 {
+				v := strings.Join(entityPath[:n-1], "::")
+				if _, ok := entityTypes[v]; !ok {
+					problems = append(problems, fmt.Sprintf("entity type %q is not declared in the schema", v))
+				}
+			}
+		}
+
+		for _, entity := range policy.Action.Entities {
+			if n := len(entity.Path); n > 1 {
+				v := fmt.Sprintf("%s::%q", strings.Join(entity.Path[:n-1], "::"), entity.Path[n-1])
+				if _, ok := actions[v]; !ok {
+					problems = append(problems, fmt.Sprintf("action %s is not declared in the schema", v))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.New(strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func qualifyCedarName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "::" + name
 }
 
 func findPolicyByID(ctx context.Context, conn *verifiedpermissions.Client, id, policyStoreId string) (*verifiedpermissions.GetPolicyOutput, error) {
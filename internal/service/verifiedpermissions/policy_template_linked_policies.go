@@ -0,0 +1,497 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy Template Linked Policies")
+func newResourcePolicyTemplateLinkedPolicies(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePolicyTemplateLinkedPolicies{}
+
+	return r, nil
+}
+
+const (
+	ResNamePolicyTemplateLinkedPolicies = "Policy Template Linked Policies"
+)
+
+type resourcePolicyTemplateLinkedPolicies struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy_template_linked_policies"
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierAttributes := map[string]schema.Attribute{
+		"entity_id": schema.StringAttribute{
+			Required: true,
+		},
+		"entity_type": schema.StringAttribute{
+			Required: true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"link": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[policyTemplateLink](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrPrincipal: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedPrincipal](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: entityIdentifierAttributes,
+							},
+						},
+						"resource": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedResource](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: entityIdentifierAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan resourcePolicyTemplateLinkedPoliciesData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyTemplateID := plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString()
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", policyStoreID, policyTemplateID))
+
+	links, diags := expandPolicyTemplateLinks(ctx, plan.Link)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	created := make(map[policyTemplateLinkKey]struct{})
+	for key := range links {
+		if _, err := createTemplateLinkedPolicy(ctx, conn, policyStoreID, policyTemplateID, key); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicyTemplateLinkedPolicies, key.String(), err),
+				err.Error(),
+			)
+
+			continue
+		}
+
+		created[key] = struct{}{}
+	}
+
+	// Only record the links that were successfully created so that the next apply reconciles the remainder.
+	plan.Link = flattenPolicyTemplateLinks(ctx, created)
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePolicyTemplateLinkedPoliciesData
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyTemplateID, err := policyTemplateParseID(state.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicies, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := findPolicyTemplateByID(ctx, conn, policyStoreID, policyTemplateID); tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	} else if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicies, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	policies, err := findTemplateLinkedPolicies(ctx, conn, policyStoreID, policyTemplateID)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicies, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.PolicyStoreID = fwflex.StringValueToFramework(ctx, policyStoreID)
+	state.PolicyTemplateID = fwflex.StringValueToFramework(ctx, policyTemplateID)
+	state.Link = flattenPolicyTemplateLinks(ctx, policies)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan, state resourcePolicyTemplateLinkedPoliciesData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Link.Equal(state.Link) {
+		policyStoreID, policyTemplateID := state.PolicyStoreID.ValueString(), state.PolicyTemplateID.ValueString()
+
+		newLinks, diags := expandPolicyTemplateLinks(ctx, plan.Link)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		existing, err := findTemplateLinkedPolicies(ctx, conn, policyStoreID, policyTemplateID)
+
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicyTemplateLinkedPolicies, state.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		for key, policyID := range existing {
+			if _, ok := newLinks[key]; ok {
+				continue
+			}
+
+			if err := deleteTemplateLinkedPolicy(ctx, conn, policyStoreID, policyID); err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicyTemplateLinkedPolicies, key.String(), err),
+					err.Error(),
+				)
+
+				continue
+			}
+
+			delete(existing, key)
+		}
+
+		for key := range newLinks {
+			if _, ok := existing[key]; ok {
+				continue
+			}
+
+			if _, err := createTemplateLinkedPolicy(ctx, conn, policyStoreID, policyTemplateID, key); err != nil {
+				response.Diagnostics.AddError(
+					create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicyTemplateLinkedPolicies, key.String(), err),
+					err.Error(),
+				)
+
+				continue
+			}
+
+			existing[key] = ""
+		}
+
+		plan.Link = flattenPolicyTemplateLinks(ctx, existing)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePolicyTemplateLinkedPolicies) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePolicyTemplateLinkedPoliciesData
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyTemplateID := state.PolicyStoreID.ValueString(), state.PolicyTemplateID.ValueString()
+
+	links, diags := expandPolicyTemplateLinks(ctx, state.Link)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	existing, err := findTemplateLinkedPolicies(ctx, conn, policyStoreID, policyTemplateID)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicyTemplateLinkedPolicies, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	for key, policyID := range existing {
+		if _, ok := links[key]; !ok {
+			continue
+		}
+
+		if err := deleteTemplateLinkedPolicy(ctx, conn, policyStoreID, policyID); err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicyTemplateLinkedPolicies, key.String(), err),
+				err.Error(),
+			)
+		}
+	}
+}
+
+func createTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string, key policyTemplateLinkKey) (string, error) {
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Definition: &awstypes.PolicyDefinitionMemberTemplateLinked{
+			Value: awstypes.TemplateLinkedPolicyDefinition{
+				PolicyTemplateId: aws.String(policyTemplateID),
+				Principal:        key.principal(),
+				Resource:         key.resource(),
+			},
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.PolicyId), nil
+}
+
+func deleteTemplateLinkedPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) error {
+	_, err := conn.DeletePolicy(ctx, &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+// findTemplateLinkedPolicies returns the IDs of all policies linked to the specified policy template, keyed by principal and resource.
+func findTemplateLinkedPolicies(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyTemplateID string) (map[policyTemplateLinkKey]string, error) {
+	input := &verifiedpermissions.ListPoliciesInput{
+		Filter: &awstypes.PolicyFilter{
+			PolicyTemplateId: aws.String(policyTemplateID),
+			PolicyType:       awstypes.PolicyTypeTemplateLinked,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+	output := make(map[policyTemplateLinkKey]string)
+
+	pages := verifiedpermissions.NewListPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Policies {
+			output[newPolicyTemplateLinkKey(v.Principal, v.Resource)] = aws.ToString(v.PolicyId)
+		}
+	}
+
+	return output, nil
+}
+
+type resourcePolicyTemplateLinkedPoliciesData struct {
+	ID               types.String                                       `tfsdk:"id"`
+	Link             fwtypes.SetNestedObjectValueOf[policyTemplateLink] `tfsdk:"link"`
+	PolicyStoreID    types.String                                       `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String                                       `tfsdk:"policy_template_id"`
+}
+
+type policyTemplateLink struct {
+	Principal fwtypes.ListNestedObjectValueOf[templateLinkedPrincipal] `tfsdk:"principal"`
+	Resource  fwtypes.ListNestedObjectValueOf[templateLinkedResource]  `tfsdk:"resource"`
+}
+
+// policyTemplateLinkKey uniquely identifies a template-linked policy within a policy template.
+type policyTemplateLinkKey struct {
+	principalType, principalID string
+	resourceType, resourceID   string
+}
+
+func newPolicyTemplateLinkKey(principal, resource *awstypes.EntityIdentifier) policyTemplateLinkKey {
+	var key policyTemplateLinkKey
+
+	if principal != nil {
+		key.principalType, key.principalID = aws.ToString(principal.EntityType), aws.ToString(principal.EntityId)
+	}
+
+	if resource != nil {
+		key.resourceType, key.resourceID = aws.ToString(resource.EntityType), aws.ToString(resource.EntityId)
+	}
+
+	return key
+}
+
+func (k policyTemplateLinkKey) principal() *awstypes.EntityIdentifier {
+	if k.principalType == "" {
+		return nil
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   aws.String(k.principalID),
+		EntityType: aws.String(k.principalType),
+	}
+}
+
+func (k policyTemplateLinkKey) resource() *awstypes.EntityIdentifier {
+	if k.resourceType == "" {
+		return nil
+	}
+
+	return &awstypes.EntityIdentifier{
+		EntityId:   aws.String(k.resourceID),
+		EntityType: aws.String(k.resourceType),
+	}
+}
+
+func (k policyTemplateLinkKey) String() string {
+	return fmt.Sprintf("principal=%s::%q, resource=%s::%q", k.principalType, k.principalID, k.resourceType, k.resourceID)
+}
+
+func expandPolicyTemplateLinks(ctx context.Context, tfSet fwtypes.SetNestedObjectValueOf[policyTemplateLink]) (map[policyTemplateLinkKey]struct{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	links, d := tfSet.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make(map[policyTemplateLinkKey]struct{}, len(links))
+	for _, link := range links {
+		var principal, resource *awstypes.EntityIdentifier
+
+		if v, d := link.Principal.ToPtr(ctx); v != nil {
+			principal = &awstypes.EntityIdentifier{
+				EntityId:   fwflex.StringFromFramework(ctx, v.EntityID),
+				EntityType: fwflex.StringFromFramework(ctx, v.EntityType),
+			}
+		} else {
+			diags.Append(d...)
+		}
+
+		if v, d := link.Resource.ToPtr(ctx); v != nil {
+			resource = &awstypes.EntityIdentifier{
+				EntityId:   fwflex.StringFromFramework(ctx, v.EntityID),
+				EntityType: fwflex.StringFromFramework(ctx, v.EntityType),
+			}
+		} else {
+			diags.Append(d...)
+		}
+
+		apiObjects[newPolicyTemplateLinkKey(principal, resource)] = struct{}{}
+	}
+
+	return apiObjects, diags
+}
+
+func flattenPolicyTemplateLinks[V any](ctx context.Context, apiObjects map[policyTemplateLinkKey]V) fwtypes.SetNestedObjectValueOf[policyTemplateLink] {
+	links := make([]*policyTemplateLink, 0, len(apiObjects))
+
+	for key := range apiObjects {
+		link := &policyTemplateLink{
+			Principal: fwtypes.NewListNestedObjectValueOfNull[templateLinkedPrincipal](ctx),
+			Resource:  fwtypes.NewListNestedObjectValueOfNull[templateLinkedResource](ctx),
+		}
+
+		if v := key.principal(); v != nil {
+			link.Principal = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedPrincipal{
+				EntityID:   fwflex.StringToFramework(ctx, v.EntityId),
+				EntityType: fwflex.StringToFramework(ctx, v.EntityType),
+			})
+		}
+
+		if v := key.resource(); v != nil {
+			link.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedResource{
+				EntityID:   fwflex.StringToFramework(ctx, v.EntityId),
+				EntityType: fwflex.StringToFramework(ctx, v.EntityType),
+			})
+		}
+
+		links = append(links, link)
+	}
+
+	return fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, links)
+}
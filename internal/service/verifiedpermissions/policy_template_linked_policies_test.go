@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPoliciesConfig_basic([]string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPoliciesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "link.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"principal.0.entity_type": "PhotoFlash::User",
+						"principal.0.entity_id":   "alice",
+						"resource.0.entity_type":  "PhotoFlash::Photo",
+						"resource.0.entity_id":    "alice-photo",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"principal.0.entity_type": "PhotoFlash::User",
+						"principal.0.entity_id":   "bob",
+						"resource.0.entity_type":  "PhotoFlash::Photo",
+						"resource.0.entity_id":    "bob-photo",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicies_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPoliciesConfig_basic([]string{"alice", "bob"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPoliciesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "link.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccPolicyTemplateLinkedPoliciesConfig_basic([]string{"bob", "carol", "dave"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPoliciesExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "link.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "link.*", map[string]string{
+						"principal.0.entity_id": "carol",
+					}),
+				),
+			},
+			{
+				Config: testAccPolicyTemplateLinkedPoliciesConfig_basic([]string{"dave"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPoliciesExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "link.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicies_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPoliciesConfig_basic([]string{"alice"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPoliciesExists(ctx, resourceName, 1),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyTemplateLinkedPolicies, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTemplateLinkedPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy_template_linked_policies" {
+				continue
+			}
+
+			output, err := tfverifiedpermissions.FindTemplateLinkedPolicies(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicies, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTemplateLinkedPoliciesExists(ctx context.Context, name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicies, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicies, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+		output, err := tfverifiedpermissions.FindTemplateLinkedPolicies(ctx, conn, rs.Primary.Attributes["policy_store_id"], rs.Primary.Attributes["policy_template_id"])
+
+		if err != nil {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicies, rs.Primary.ID, err)
+		}
+
+		if got := len(output); got != want {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicies, rs.Primary.ID, fmt.Errorf("%d template-linked policies, want %d", got, want))
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyTemplateLinkedPoliciesConfig_basic(users []string) string {
+	var links string
+	for _, user := range users {
+		links += fmt.Sprintf(`
+  link {
+    principal {
+      entity_id   = %[1]q
+      entity_type = "PhotoFlash::User"
+    }
+
+    resource {
+      entity_id   = "%[1]s-photo"
+      entity_type = "PhotoFlash::Photo"
+    }
+  }
+`, user)
+	}
+
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy_template_linked_policies" "test" {
+  policy_store_id    = aws_verifiedpermissions_policy_store.test.id
+  policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+%[1]s
+}
+`, links)
+}
@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	interflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Policy Template Linked Policy")
+func newResourcePolicyTemplateLinkedPolicy(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePolicyTemplateLinkedPolicy{}
+
+	return r, nil
+}
+
+const (
+	ResNamePolicyTemplateLinkedPolicy = "Policy Template Linked Policy"
+)
+
+type resourcePolicyTemplateLinkedPolicy struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[resourcePolicyTemplateLinkedPolicyData]
+}
+
+func (r *resourcePolicyTemplateLinkedPolicy) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_verifiedpermissions_policy_template_linked_policy"
+}
+
+func (r *resourcePolicyTemplateLinkedPolicy) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	entityIdentifierAttributes := map[string]schema.Attribute{
+		"entity_id": schema.StringAttribute{
+			Required: true,
+		},
+		"entity_type": schema.StringAttribute{
+			Required: true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"created_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_template_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrPrincipal: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedPrincipal](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: entityIdentifierAttributes,
+				},
+			},
+			"resource": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[templateLinkedResource](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: entityIdentifierAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicyTemplateLinkedPolicy) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan resourcePolicyTemplateLinkedPolicyData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID, policyTemplateID := plan.PolicyStoreID.ValueString(), plan.PolicyTemplateID.ValueString()
+
+	var principal, res *awstypes.EntityIdentifier
+
+	if v, d := plan.Principal.ToPtr(ctx); v != nil {
+		principal = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, v.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, v.EntityType),
+		}
+	} else {
+		response.Diagnostics.Append(d...)
+	}
+
+	if v, d := plan.Resource.ToPtr(ctx); v != nil {
+		res = &awstypes.EntityIdentifier{
+			EntityId:   fwflex.StringFromFramework(ctx, v.EntityID),
+			EntityType: fwflex.StringFromFramework(ctx, v.EntityType),
+		}
+	} else {
+		response.Diagnostics.Append(d...)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	key := newPolicyTemplateLinkKey(principal, res)
+	policyID, err := createTemplateLinkedPolicy(ctx, conn, policyStoreID, policyTemplateID, key)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicyTemplateLinkedPolicy, key.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	rID, err := interflex.FlattenResourceId([]string{policyID, policyStoreID}, ResourcePolicyIDPartsCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicyTemplateLinkedPolicy, policyID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findPolicyByID(ctx, conn, policyID, policyStoreID)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicyTemplateLinkedPolicy, rID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.CreatedDate = timetypes.NewRFC3339TimePointerValue(output.CreatedDate)
+	plan.ID = fwflex.StringValueToFramework(ctx, rID)
+	plan.PolicyID = fwflex.StringValueToFramework(ctx, policyID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePolicyTemplateLinkedPolicy) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePolicyTemplateLinkedPolicyData
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rID, err := interflex.ExpandResourceId(state.ID.ValueString(), ResourcePolicyIDPartsCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	output, err := findPolicyByID(ctx, conn, rID[0], rID[1])
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	definition, ok := output.Definition.(*awstypes.PolicyDefinitionDetailMemberTemplateLinked)
+
+	if !ok || definition == nil {
+		err := fmt.Errorf("policy (%s) is not linked to a policy template", state.ID.ValueString())
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicyTemplateLinkedPolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.CreatedDate = timetypes.NewRFC3339TimePointerValue(output.CreatedDate)
+	state.PolicyID = fwflex.StringToFramework(ctx, output.PolicyId)
+	state.PolicyStoreID = fwflex.StringToFramework(ctx, output.PolicyStoreId)
+	state.PolicyTemplateID = fwflex.StringToFramework(ctx, definition.Value.PolicyTemplateId)
+
+	if v := definition.Value.Principal; v != nil {
+		state.Principal = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedPrincipal{
+			EntityID:   fwflex.StringToFramework(ctx, v.EntityId),
+			EntityType: fwflex.StringToFramework(ctx, v.EntityType),
+		})
+	} else {
+		state.Principal = fwtypes.NewListNestedObjectValueOfNull[templateLinkedPrincipal](ctx)
+	}
+
+	if v := definition.Value.Resource; v != nil {
+		state.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &templateLinkedResource{
+			EntityID:   fwflex.StringToFramework(ctx, v.EntityId),
+			EntityType: fwflex.StringToFramework(ctx, v.EntityType),
+		})
+	} else {
+		state.Resource = fwtypes.NewListNestedObjectValueOfNull[templateLinkedResource](ctx)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicyTemplateLinkedPolicy) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePolicyTemplateLinkedPolicyData
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteTemplateLinkedPolicy(ctx, conn, state.PolicyStoreID.ValueString(), state.PolicyID.ValueString()); err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicyTemplateLinkedPolicy, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourcePolicyTemplateLinkedPolicyData struct {
+	CreatedDate      timetypes.RFC3339                                        `tfsdk:"created_date"`
+	ID               types.String                                             `tfsdk:"id"`
+	PolicyID         types.String                                             `tfsdk:"policy_id"`
+	PolicyStoreID    types.String                                             `tfsdk:"policy_store_id"`
+	PolicyTemplateID types.String                                             `tfsdk:"policy_template_id"`
+	Principal        fwtypes.ListNestedObjectValueOf[templateLinkedPrincipal] `tfsdk:"principal"`
+	Resource         fwtypes.ListNestedObjectValueOf[templateLinkedResource]  `tfsdk:"resource"`
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPolicyConfig_basic("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_template_id", "aws_verifiedpermissions_policy_template.test", "policy_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal.0.entity_id", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "principal.0.entity_type", "PhotoFlash::User"),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_id", "alice-photo"),
+					resource.TestCheckResourceAttr(resourceName, "resource.0.entity_type", "PhotoFlash::Photo"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicy_replace(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy1, policy2 verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPolicyConfig_basic("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPolicyExists(ctx, resourceName, &policy1),
+				),
+			},
+			{
+				Config: testAccPolicyTemplateLinkedPolicyConfig_basic("bob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPolicyExists(ctx, resourceName, &policy2),
+					testAccCheckPolicyTemplateLinkedPolicyRecreated(&policy1, &policy2),
+					resource.TestCheckResourceAttr(resourceName, "principal.0.entity_id", "bob"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicyTemplateLinkedPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	resourceName := "aws_verifiedpermissions_policy_template_linked_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyTemplateLinkedPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyTemplateLinkedPolicyConfig_basic("alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyTemplateLinkedPolicyExists(ctx, resourceName, &policy),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicyTemplateLinkedPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPolicyTemplateLinkedPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policy_template_linked_policy" {
+				continue
+			}
+
+			_, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, rs.Primary.Attributes["policy_id"], rs.Primary.Attributes["policy_store_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicy, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTemplateLinkedPolicyExists(ctx context.Context, name string, v *verifiedpermissions.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicy, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicy, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+		output, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, rs.Primary.Attributes["policy_id"], rs.Primary.Attributes["policy_store_id"])
+
+		if err != nil {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicy, rs.Primary.ID, err)
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckPolicyTemplateLinkedPolicyRecreated(before, after *verifiedpermissions.GetPolicyOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.PolicyId), aws.ToString(after.PolicyId); before == after {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingRecreated, tfverifiedpermissions.ResNamePolicyTemplateLinkedPolicy, before, errors.New("not recreated"))
+		}
+
+		return nil
+	}
+}
+
+func testAccPolicyTemplateLinkedPolicyConfig_basic(user string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  validation_settings {
+    mode = "OFF"
+  }
+}
+
+resource "aws_verifiedpermissions_policy_template" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+  statement       = "permit (principal == ?principal, action in PhotoFlash::Action::\"FullPhotoAccess\", resource == ?resource);"
+}
+
+resource "aws_verifiedpermissions_policy_template_linked_policy" "test" {
+  policy_store_id    = aws_verifiedpermissions_policy_store.test.id
+  policy_template_id = aws_verifiedpermissions_policy_template.test.policy_template_id
+
+  principal {
+    entity_id   = %[1]q
+    entity_type = "PhotoFlash::User"
+  }
+
+  resource {
+    entity_id   = "%[1]s-photo"
+    entity_type = "PhotoFlash::Photo"
+  }
+}
+`, user)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -189,6 +190,46 @@ func TestAccVerifiedPermissionsPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsPolicy_schemaValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var policy verifiedpermissions.GetPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_strictBase(rName),
+			},
+			{
+				Config:      testAccPolicyConfig_strict(rName, "permit (principal == PhotoFlash::User::\"alice\", action == PhotoFlash::Action::\"delete\", resource);"),
+				ExpectError: regexache.MustCompile(`action PhotoFlash::Action::"delete" is not declared in the schema`),
+			},
+			{
+				Config:      testAccPolicyConfig_strict(rName, "permit (principal == PhotoFlash::Group::\"admins\", action == PhotoFlash::Action::\"view\", resource);"),
+				ExpectError: regexache.MustCompile(`entity type "PhotoFlash::Group" is not declared in the schema`),
+			},
+			{
+				Config: testAccPolicyConfig_strict(rName, "permit (principal == PhotoFlash::User::\"alice\", action == PhotoFlash::Action::\"view\", resource);"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &policy),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
@@ -318,3 +359,55 @@ resource "aws_verifiedpermissions_policy" "test" {
 }
 `, rName))
 }
+
+func testAccPolicyConfig_strictBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = jsonencode({
+      PhotoFlash = {
+        entityTypes = {
+          User  = {}
+          Photo = {}
+        }
+        actions = {
+          view = {
+            appliesTo = {
+              principalTypes = ["User"]
+              resourceTypes  = ["Photo"]
+            }
+          }
+        }
+      }
+    })
+  }
+}
+`, rName)
+}
+
+func testAccPolicyConfig_strict(rName, policyStatement string) string {
+	return acctest.ConfigCompose(
+		testAccPolicyConfig_strictBase(rName),
+		fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  definition {
+    static {
+      statement = %[1]q
+    }
+  }
+
+  depends_on = [aws_verifiedpermissions_schema.test]
+}
+`, policyStatement))
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -124,6 +125,29 @@ func TestAccVerifiedPermissionsSchema_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedPermissionsSchema_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSchemaConfig_undeclaredEntityType(),
+				ExpectError: regexache.MustCompile(`line 6: undeclared entity type "PhotoFlash::Photo"`),
+			},
+		},
+	})
+}
+
 func testAccCheckSchemaDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
@@ -191,3 +215,32 @@ resource "aws_verifiedpermissions_schema" "test" {
   }
 }`, namespace)
 }
+
+func testAccSchemaConfig_undeclaredEntityType() string {
+	return `
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = "Terraform acceptance test"
+  validation_settings {
+    mode = "STRICT"
+  }
+}
+
+resource "aws_verifiedpermissions_schema" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.policy_store_id
+
+  definition {
+    value = <<-EOT
+{
+  "PhotoFlash": {
+    "entityTypes": {"User": {}},
+    "actions": {
+      "ViewPhoto": {
+        "appliesTo": {"principalTypes": ["User"], "resourceTypes": ["PhotoFlash::Photo"]}
+      }
+    }
+  }
+}
+EOT
+  }
+}`
+}
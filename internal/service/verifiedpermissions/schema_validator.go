@@ -0,0 +1,379 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+)
+
+// cedarSchemaValidator validates that a string Attribute's value is a well-formed Cedar JSON schema.
+type cedarSchemaValidator struct{}
+
+func (v cedarSchemaValidator) Description(_ context.Context) string {
+	return "value must be a valid Cedar JSON schema"
+}
+
+func (v cedarSchemaValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cedarSchemaValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, err := range validateCedarSchema(request.ConfigValue.ValueString()) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Cedar Schema",
+			err.Error(),
+		)
+	}
+}
+
+// validCedarSchema returns a string validator which ensures that any configured value is a Cedar JSON schema
+// that the service will accept: every namespace declares entity types and actions, and every entity type, action
+// and attribute type referenced from the schema is declared in it.
+// Errors are reported with the line number of the offending element.
+func validCedarSchema() validator.String {
+	return cedarSchemaValidator{}
+}
+
+var (
+	cedarSchemaNamespaceKeys  = []string{"actions", "annotations", "commonTypes", "entityTypes"}
+	cedarSchemaPrimitiveTypes = []string{"Boolean", "Entity", "EntityOrCommon", "Extension", "Long", "Record", "Set", "String"}
+)
+
+type cedarSchemaNamespaceDefinition struct {
+	Actions     map[string]cedarSchemaAction     `json:"actions"`
+	CommonTypes map[string]cedarSchemaType       `json:"commonTypes"`
+	EntityTypes map[string]cedarSchemaEntityType `json:"entityTypes"`
+}
+
+type cedarSchemaEntityType struct {
+	MemberOfTypes []string         `json:"memberOfTypes"`
+	Shape         *cedarSchemaType `json:"shape"`
+}
+
+type cedarSchemaAction struct {
+	AppliesTo *cedarSchemaAppliesTo    `json:"appliesTo"`
+	MemberOf  []cedarSchemaActionGroup `json:"memberOf"`
+}
+
+type cedarSchemaActionGroup struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type cedarSchemaAppliesTo struct {
+	Context        *cedarSchemaType `json:"context"`
+	PrincipalTypes []string         `json:"principalTypes"`
+	ResourceTypes  []string         `json:"resourceTypes"`
+}
+
+type cedarSchemaType struct {
+	Attributes map[string]cedarSchemaType `json:"attributes"`
+	Element    *cedarSchemaType           `json:"element"`
+	Name       string                     `json:"name"`
+	Type       string                     `json:"type"`
+}
+
+// cedarSchemaChecker accumulates line-anchored errors while walking a Cedar JSON schema.
+type cedarSchemaChecker struct {
+	data        []byte
+	offsets     map[string]int64
+	entityTypes map[string]struct{}
+	actions     map[string]struct{}
+	commonTypes map[string]struct{}
+	errs        []error
+}
+
+// validateCedarSchema returns the problems found in the specified Cedar JSON schema.
+func validateCedarSchema(schema string) []error {
+	data := []byte(schema)
+
+	var namespaces map[string]json.RawMessage
+	if err := json.Unmarshal(data, &namespaces); err != nil {
+		return []error{jsonDecodeError(data, err)}
+	}
+
+	offsets, err := jsonPathOffsets(data)
+	if err != nil {
+		return []error{jsonDecodeError(data, err)}
+	}
+
+	c := &cedarSchemaChecker{
+		data:        data,
+		offsets:     offsets,
+		entityTypes: make(map[string]struct{}),
+		actions:     make(map[string]struct{}),
+		commonTypes: make(map[string]struct{}),
+	}
+
+	// Declarations are collected from every namespace first as references may cross namespaces.
+	definitions := make(map[string]cedarSchemaNamespaceDefinition, len(namespaces))
+	for _, namespace := range sortedKeys(namespaces) {
+		raw := namespaces[namespace]
+		nsPath := "/" + jsonPointerEscape(namespace)
+
+		var keys map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &keys); err != nil {
+			c.errorf(nsPath, "namespace %q must be a JSON object", namespace)
+			continue
+		}
+
+		for _, k := range sortedKeys(keys) {
+			if !slices.Contains(cedarSchemaNamespaceKeys, k) {
+				c.errorf(nsPath+"/"+jsonPointerEscape(k), "unexpected key %q in namespace %q, expected one of: %s", k, namespace, strings.Join(cedarSchemaNamespaceKeys, ", "))
+			}
+		}
+
+		for _, k := range []string{"entityTypes", "actions"} {
+			if _, ok := keys[k]; !ok {
+				c.errorf(nsPath, "namespace %q is missing required key %q", namespace, k)
+			}
+		}
+
+		var definition cedarSchemaNamespaceDefinition
+		if err := json.Unmarshal(raw, &definition); err != nil {
+			c.errs = append(c.errs, jsonDecodeError(data, err))
+			continue
+		}
+
+		for name := range definition.EntityTypes {
+			c.entityTypes[qualifyCedarName(namespace, name)] = struct{}{}
+		}
+		for name := range definition.Actions {
+			c.actions[qualifyCedarName(namespace, name)] = struct{}{}
+		}
+		for name := range definition.CommonTypes {
+			c.commonTypes[qualifyCedarName(namespace, name)] = struct{}{}
+		}
+
+		definitions[namespace] = definition
+	}
+
+	for _, namespace := range sortedKeys(definitions) {
+		definition := definitions[namespace]
+		nsPath := "/" + jsonPointerEscape(namespace)
+
+		for _, name := range sortedKeys(definition.CommonTypes) {
+			c.checkType(namespace, nsPath+"/commonTypes/"+jsonPointerEscape(name), definition.CommonTypes[name])
+		}
+
+		for _, name := range sortedKeys(definition.EntityTypes) {
+			entityType := definition.EntityTypes[name]
+			path := nsPath + "/entityTypes/" + jsonPointerEscape(name)
+
+			for i, v := range entityType.MemberOfTypes {
+				c.checkEntityTypeReference(namespace, fmt.Sprintf("%s/memberOfTypes/%d", path, i), v)
+			}
+
+			if entityType.Shape != nil {
+				c.checkType(namespace, path+"/shape", *entityType.Shape)
+			}
+		}
+
+		for _, name := range sortedKeys(definition.Actions) {
+			action := definition.Actions[name]
+			path := nsPath + "/actions/" + jsonPointerEscape(name)
+
+			for i, v := range action.MemberOf {
+				// Action groups declared with an explicit type may live in another namespace and are left to the service.
+				if v.Type != "" {
+					continue
+				}
+
+				if !c.declared(c.actions, namespace, v.ID) {
+					c.errorf(fmt.Sprintf("%s/memberOf/%d", path, i), "action %q is a member of undeclared action %q", name, v.ID)
+				}
+			}
+
+			if v := action.AppliesTo; v != nil {
+				for i, v := range v.PrincipalTypes {
+					c.checkEntityTypeReference(namespace, fmt.Sprintf("%s/appliesTo/principalTypes/%d", path, i), v)
+				}
+				for i, v := range v.ResourceTypes {
+					c.checkEntityTypeReference(namespace, fmt.Sprintf("%s/appliesTo/resourceTypes/%d", path, i), v)
+				}
+				if v.Context != nil {
+					c.checkType(namespace, path+"/appliesTo/context", *v.Context)
+				}
+			}
+		}
+	}
+
+	return c.errs
+}
+
+func (c *cedarSchemaChecker) checkEntityTypeReference(namespace, path, name string) {
+	if !c.declared(c.entityTypes, namespace, name) {
+		c.errorf(path, "undeclared entity type %q", name)
+	}
+}
+
+func (c *cedarSchemaChecker) checkType(namespace, path string, t cedarSchemaType) {
+	switch t.Type {
+	case "":
+		c.errorf(path, "missing required key %q", "type")
+	case "Record":
+		for _, name := range sortedKeys(t.Attributes) {
+			c.checkType(namespace, path+"/attributes/"+jsonPointerEscape(name), t.Attributes[name])
+		}
+	case "Set":
+		if t.Element == nil {
+			c.errorf(path, "Set type is missing required key %q", "element")
+		} else {
+			c.checkType(namespace, path+"/element", *t.Element)
+		}
+	case "Entity":
+		if t.Name == "" {
+			c.errorf(path, "Entity type is missing required key %q", "name")
+		} else if !c.declared(c.entityTypes, namespace, t.Name) {
+			c.errorf(path+"/name", "undeclared entity type %q", t.Name)
+		}
+	case "EntityOrCommon":
+		if t.Name == "" {
+			c.errorf(path, "EntityOrCommon type is missing required key %q", "name")
+		} else if !slices.Contains(cedarSchemaPrimitiveTypes, t.Name) && !c.declared(c.entityTypes, namespace, t.Name) && !c.declared(c.commonTypes, namespace, t.Name) {
+			c.errorf(path+"/name", "undeclared entity or common type %q", t.Name)
+		}
+	case "Extension":
+		if t.Name == "" {
+			c.errorf(path, "Extension type is missing required key %q", "name")
+		}
+	case "Boolean", "Long", "String":
+	default:
+		if !c.declared(c.commonTypes, namespace, t.Type) {
+			c.errorf(path+"/type", "unknown type %q, expected one of %s or a declared common type", t.Type, strings.Join(cedarSchemaPrimitiveTypes, ", "))
+		}
+	}
+}
+
+// declared returns whether the specified name, qualified or relative to the specified namespace, is in the set.
+func (c *cedarSchemaChecker) declared(set map[string]struct{}, namespace, name string) bool {
+	if _, ok := set[name]; ok {
+		return true
+	}
+
+	_, ok := set[qualifyCedarName(namespace, name)]
+
+	return ok
+}
+
+func (c *cedarSchemaChecker) errorf(path, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+
+	// Fall back to the closest ancestor that was located in the document.
+	for p := path; p != ""; p = p[:strings.LastIndex(p, "/")] {
+		if offset, ok := c.offsets[p]; ok {
+			msg = fmt.Sprintf("line %d: %s", lineAtOffset(c.data, offset), msg)
+			break
+		}
+	}
+
+	c.errs = append(c.errs, errors.New(msg))
+}
+
+// jsonDecodeError annotates JSON decoding errors with the line on which they occurred.
+func jsonDecodeError(data []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Errorf("line %d: invalid JSON: %w", lineAtOffset(data, syntaxErr.Offset), err)
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if typeErr.Field == "" {
+			return fmt.Errorf("line %d: schema must be a JSON object mapping namespaces to their definitions", lineAtOffset(data, typeErr.Offset))
+		}
+
+		return fmt.Errorf("line %d: %q must not be a JSON %s", lineAtOffset(data, typeErr.Offset), typeErr.Field, typeErr.Value)
+	}
+
+	return fmt.Errorf("invalid JSON: %w", err)
+}
+
+// jsonPathOffsets returns the input offset of every object key and array element in the JSON document, keyed by JSON pointer.
+func jsonPathOffsets(data []byte) (map[string]int64, error) {
+	offsets := make(map[string]int64)
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	var walk func(path string) error
+	walk = func(path string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if _, ok := offsets[path]; !ok && path != "" {
+			offsets[path] = dec.InputOffset()
+		}
+
+		delim, ok := tok.(json.Delim)
+		if !ok {
+			return nil
+		}
+
+		switch delim {
+		case '{':
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+
+				key, _ := tok.(string)
+				child := path + "/" + jsonPointerEscape(key)
+				offsets[child] = dec.InputOffset()
+
+				if err := walk(child); err != nil {
+					return err
+				}
+			}
+		case '[':
+			for i := 0; dec.More(); i++ {
+				if err := walk(path + "/" + strconv.Itoa(i)); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Consume the closing delimiter.
+		_, err = dec.Token()
+
+		return err
+	}
+
+	return offsets, walk("")
+}
+
+func jsonPointerEscape(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func lineAtOffset(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
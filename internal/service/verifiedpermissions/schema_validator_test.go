@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"testing"
+
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+)
+
+func TestValidateCedarSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema string
+		want   []string
+	}{
+		"empty": {
+			schema: `{}`,
+		},
+		"valid": {
+			schema: `{
+  "PhotoFlash": {
+    "commonTypes": {"Owner": {"type": "Entity", "name": "User"}},
+    "entityTypes": {
+      "User": {"memberOfTypes": ["UserGroup"], "shape": {"type": "Record", "attributes": {"tags": {"type": "Set", "element": {"type": "String"}}}}},
+      "UserGroup": {},
+      "Photo": {"shape": {"type": "Record", "attributes": {"owner": {"type": "Owner"}}}}
+    },
+    "actions": {
+      "Read": {},
+      "ViewPhoto": {"memberOf": [{"id": "Read"}], "appliesTo": {"principalTypes": ["PhotoFlash::User"], "resourceTypes": ["Photo"]}}
+    }
+  }
+}`,
+		},
+		"syntax error": {
+			schema: "{\n  \"PhotoFlash\": {\n    \"entityTypes\": {,}\n  }\n}",
+			want:   []string{`line 3: invalid JSON: invalid character ',' looking for beginning of object key string`},
+		},
+		"not an object": {
+			schema: `[]`,
+			want:   []string{`line 1: schema must be a JSON object mapping namespaces to their definitions`},
+		},
+		"missing actions": {
+			schema: "{\n  \"PhotoFlash\": {\n    \"entityTypes\": {}\n  }\n}",
+			want:   []string{`line 2: namespace "PhotoFlash" is missing required key "actions"`},
+		},
+		"unexpected key": {
+			schema: "{\n  \"PhotoFlash\": {\n    \"entityTypes\": {},\n    \"actions\": {},\n    \"entities\": {}\n  }\n}",
+			want:   []string{`line 5: unexpected key "entities" in namespace "PhotoFlash", expected one of: actions, annotations, commonTypes, entityTypes`},
+		},
+		"undeclared references": {
+			schema: `{
+  "PhotoFlash": {
+    "entityTypes": {
+      "User": {"memberOfTypes": ["Group"]}
+    },
+    "actions": {
+      "ViewPhoto": {
+        "memberOf": [{"id": "Read"}],
+        "appliesTo": {"principalTypes": ["User"], "resourceTypes": ["Photo"]}
+      }
+    }
+  }
+}`,
+			want: []string{
+				`line 4: undeclared entity type "Group"`,
+				`line 8: action "ViewPhoto" is a member of undeclared action "Read"`,
+				`line 9: undeclared entity type "Photo"`,
+			},
+		},
+		"invalid attribute types": {
+			schema: `{
+  "": {
+    "entityTypes": {
+      "User": {
+        "shape": {
+          "type": "Record",
+          "attributes": {
+            "groups": {"type": "Set"},
+            "manager": {"type": "Entity", "name": "Manager"},
+            "score": {"type": "Integer"}
+          }
+        }
+      }
+    },
+    "actions": {}
+  }
+}`,
+			want: []string{
+				`line 8: Set type is missing required key "element"`,
+				`line 9: undeclared entity type "Manager"`,
+				`line 10: unknown type "Integer", expected one of Boolean, Entity, EntityOrCommon, Extension, Long, Record, Set, String or a declared common type`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := tfverifiedpermissions.ValidateCedarSchema(testCase.schema)
+
+			if got, want := len(errs), len(testCase.want); got != want {
+				t.Fatalf("got %d errors (%v), want %d", got, errs, want)
+			}
+
+			for i, err := range errs {
+				if got, want := err.Error(), testCase.want[i]; got != want {
+					t.Errorf("error %d: got %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -37,6 +37,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourcePolicyTemplate,
 			Name:    "Policy Template",
 		},
+		{
+			Factory: newResourcePolicyTemplateLinkedPolicies,
+			Name:    "Policy Template Linked Policies",
+		},
+		{
+			Factory: newResourcePolicyTemplateLinkedPolicy,
+			Name:    "Policy Template Linked Policy",
+		},
 		{
 			Factory: newResourceSchema,
 			Name:    "Schema",
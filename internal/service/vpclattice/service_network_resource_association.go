@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_vpclattice_service_network_resource_association", name="Service Network Resource Association")
+// @Tags(identifierAttribute="arn")
+func resourceServiceNetworkResourceAssociation() *schema.Resource {
+	dnsEntrySchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrDomainName: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrHostedZoneID: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceNetworkResourceAssociationCreate,
+		ReadWithoutTimeout:   resourceServiceNetworkResourceAssociationRead,
+		UpdateWithoutTimeout: resourceServiceNetworkResourceAssociationUpdate,
+		DeleteWithoutTimeout: resourceServiceNetworkResourceAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_entry": dnsEntrySchema(),
+			"is_managed_association": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"private_dns_entry": dnsEntrySchema(),
+			"resource_configuration_identifier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentIDOrARN,
+			},
+			"service_network_identifier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentIDOrARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	ResNameServiceNetworkResourceAssociation = "Service Network Resource Association"
+)
+
+func resourceServiceNetworkResourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	in := &vpclattice.CreateServiceNetworkResourceAssociationInput{
+		ClientToken:                     aws.String(id.UniqueId()),
+		ResourceConfigurationIdentifier: aws.String(d.Get("resource_configuration_identifier").(string)),
+		ServiceNetworkIdentifier:        aws.String(d.Get("service_network_identifier").(string)),
+		Tags:                            getTagsIn(ctx),
+	}
+
+	out, err := conn.CreateServiceNetworkResourceAssociation(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionCreating, ResNameServiceNetworkResourceAssociation, "", err)
+	}
+
+	if out == nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionCreating, ResNameServiceNetworkResourceAssociation, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.Id))
+
+	if _, err := waitServiceNetworkResourceAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameServiceNetworkResourceAssociation, d.Id(), err)
+	}
+
+	return append(diags, resourceServiceNetworkResourceAssociationRead(ctx, d, meta)...)
+}
+
+func resourceServiceNetworkResourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	out, err := findServiceNetworkResourceAssociationByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPCLattice Service Network Resource Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionReading, ResNameServiceNetworkResourceAssociation, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.Arn)
+	d.Set("created_by", out.CreatedBy)
+	if out.DnsEntry != nil {
+		if err := d.Set("dns_entry", []interface{}{flattenDNSEntry(out.DnsEntry)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting dns_entry: %s", err)
+		}
+	} else {
+		d.Set("dns_entry", nil)
+	}
+	d.Set("is_managed_association", out.IsManagedAssociation)
+	if out.PrivateDnsEntry != nil {
+		if err := d.Set("private_dns_entry", []interface{}{flattenDNSEntry(out.PrivateDnsEntry)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting private_dns_entry: %s", err)
+		}
+	} else {
+		d.Set("private_dns_entry", nil)
+	}
+	d.Set("resource_configuration_identifier", out.ResourceConfigurationId)
+	d.Set("service_network_identifier", out.ServiceNetworkId)
+	d.Set(names.AttrStatus, out.Status)
+
+	return diags
+}
+
+func resourceServiceNetworkResourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Tags only.
+	return resourceServiceNetworkResourceAssociationRead(ctx, d, meta)
+}
+
+func resourceServiceNetworkResourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
+
+	log.Printf("[INFO] Deleting VPCLattice Service Network Resource Association %s", d.Id())
+
+	_, err := conn.DeleteServiceNetworkResourceAssociation(ctx, &vpclattice.DeleteServiceNetworkResourceAssociationInput{
+		ServiceNetworkResourceAssociationIdentifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameServiceNetworkResourceAssociation, d.Id(), err)
+	}
+
+	if _, err := waitServiceNetworkResourceAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameServiceNetworkResourceAssociation, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findServiceNetworkResourceAssociationByID(ctx context.Context, conn *vpclattice.Client, id string) (*vpclattice.GetServiceNetworkResourceAssociationOutput, error) {
+	in := &vpclattice.GetServiceNetworkResourceAssociationInput{
+		ServiceNetworkResourceAssociationIdentifier: aws.String(id),
+	}
+	out, err := conn.GetServiceNetworkResourceAssociation(ctx, in)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func waitServiceNetworkResourceAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkResourceAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(types.ServiceNetworkResourceAssociationStatusCreateInProgress),
+		Target:                    enum.Slice(types.ServiceNetworkResourceAssociationStatusActive, types.ServiceNetworkResourceAssociationStatusPartial),
+		Refresh:                   statusServiceNetworkResourceAssociation(ctx, conn, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkResourceAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureReason)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitServiceNetworkResourceAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkResourceAssociationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.ServiceNetworkResourceAssociationStatusDeleteInProgress, types.ServiceNetworkResourceAssociationStatusActive, types.ServiceNetworkResourceAssociationStatusPartial),
+		Target:  []string{},
+		Refresh: statusServiceNetworkResourceAssociation(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*vpclattice.GetServiceNetworkResourceAssociationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(out.FailureReason)))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusServiceNetworkResourceAssociation(ctx context.Context, conn *vpclattice.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findServiceNetworkResourceAssociationByID(ctx, conn, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
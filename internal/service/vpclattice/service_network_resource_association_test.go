@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vpclattice_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfvpclattice "github.com/hashicorp/terraform-provider-aws/internal/service/vpclattice"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Resource configurations are not yet supported by the provider so an existing one must be supplied.
+const envVarResourceConfigurationARN = "VPCLATTICE_RESOURCE_CONFIGURATION_ARN"
+
+func TestAccVPCLatticeServiceNetworkResourceAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v vpclattice.GetServiceNetworkResourceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceConfigurationARN := acctest.SkipIfEnvVarNotSet(t, envVarResourceConfigurationARN)
+	resourceName := "aws_vpclattice_service_network_resource_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceNetworkResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkResourceAssociationConfig_basic(rName, resourceConfigurationARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkResourceAssociationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "vpc-lattice", regexache.MustCompile("servicenetworkresourceassociation/.+$")),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_entry.0.domain_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_entry.0.hosted_zone_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_network_identifier", "aws_vpclattice_service_network.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkResourceAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v vpclattice.GetServiceNetworkResourceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceConfigurationARN := acctest.SkipIfEnvVarNotSet(t, envVarResourceConfigurationARN)
+	resourceName := "aws_vpclattice_service_network_resource_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceNetworkResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkResourceAssociationConfig_basic(rName, resourceConfigurationARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkResourceAssociationExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfvpclattice.ResourceServiceNetworkResourceAssociation(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCLatticeServiceNetworkResourceAssociation_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v vpclattice.GetServiceNetworkResourceAssociationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceConfigurationARN := acctest.SkipIfEnvVarNotSet(t, envVarResourceConfigurationARN)
+	resourceName := "aws_vpclattice_service_network_resource_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceNetworkResourceAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkResourceAssociationConfig_tags1(rName, resourceConfigurationARN, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkResourceAssociationConfig_tags2(rName, resourceConfigurationARN, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccServiceNetworkResourceAssociationConfig_tags1(rName, resourceConfigurationARN, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceNetworkResourceAssociationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckServiceNetworkResourceAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpclattice_service_network_resource_association" {
+				continue
+			}
+
+			_, err := tfvpclattice.FindServiceNetworkResourceAssociationByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Lattice Service Network Resource Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckServiceNetworkResourceAssociationExists(ctx context.Context, name string, v *vpclattice.GetServiceNetworkResourceAssociationOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VPCLattice, create.ErrActionCheckingExistence, tfvpclattice.ResNameServiceNetworkResourceAssociation, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VPCLattice, create.ErrActionCheckingExistence, tfvpclattice.ResNameServiceNetworkResourceAssociation, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VPCLatticeClient(ctx)
+		output, err := tfvpclattice.FindServiceNetworkResourceAssociationByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccServiceNetworkResourceAssociationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service_network" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccServiceNetworkResourceAssociationConfig_basic(rName, resourceConfigurationARN string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_resource_association" "test" {
+  resource_configuration_identifier = %[1]q
+  service_network_identifier        = aws_vpclattice_service_network.test.id
+}
+`, resourceConfigurationARN))
+}
+
+func testAccServiceNetworkResourceAssociationConfig_tags1(rName, resourceConfigurationARN, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_resource_association" "test" {
+  resource_configuration_identifier = %[1]q
+  service_network_identifier        = aws_vpclattice_service_network.test.id
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, resourceConfigurationARN, tagKey1, tagValue1))
+}
+
+func testAccServiceNetworkResourceAssociationConfig_tags2(rName, resourceConfigurationARN, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccServiceNetworkResourceAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_vpclattice_service_network_resource_association" "test" {
+  resource_configuration_identifier = %[1]q
+  service_network_identifier        = aws_vpclattice_service_network.test.id
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, resourceConfigurationARN, tagKey1, tagValue1, tagKey2, tagValue2))
+}
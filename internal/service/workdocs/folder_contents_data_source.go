@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workdocs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/workdocs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workdocs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Folder Contents")
+func newFolderContentsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &folderContentsDataSource{}, nil
+}
+
+type folderContentsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*folderContentsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_workdocs_folder_contents"
+}
+
+func (d *folderContentsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"documents": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[documentMetadataModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[documentMetadataModel](ctx),
+				Computed:    true,
+			},
+			"folder_id": schema.StringAttribute{
+				Required: true,
+			},
+			"folders": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[folderMetadataModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[folderMetadataModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FolderContentType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *folderContentsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data folderContentsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WorkDocsClient(ctx)
+
+	input := &workdocs.DescribeFolderContentsInput{
+		FolderId: fwflex.StringFromFramework(ctx, data.FolderID),
+	}
+	if !data.Type.IsNull() {
+		input.Type = data.Type.ValueEnum()
+	}
+
+	output, err := findFolderContents(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkDocs Folder (%s) contents", data.FolderID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, data.FolderID.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFolderContents(ctx context.Context, conn *workdocs.Client, input *workdocs.DescribeFolderContentsInput) (*workdocs.DescribeFolderContentsOutput, error) {
+	output := &workdocs.DescribeFolderContentsOutput{}
+
+	pages := workdocs.NewDescribeFolderContentsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output.Documents = append(output.Documents, page.Documents...)
+		output.Folders = append(output.Folders, page.Folders...)
+	}
+
+	return output, nil
+}
+
+type folderContentsDataSourceModel struct {
+	Documents fwtypes.ListNestedObjectValueOf[documentMetadataModel] `tfsdk:"documents"`
+	FolderID  types.String                                           `tfsdk:"folder_id"`
+	Folders   fwtypes.ListNestedObjectValueOf[folderMetadataModel]   `tfsdk:"folders"`
+	ID        types.String                                           `tfsdk:"id"`
+	Type      fwtypes.StringEnum[awstypes.FolderContentType]         `tfsdk:"type"`
+}
+
+type documentMetadataModel struct {
+	CreatedTimestamp      timetypes.RFC3339                                             `tfsdk:"created_timestamp"`
+	CreatorID             types.String                                                  `tfsdk:"creator_id"`
+	ID                    types.String                                                  `tfsdk:"id"`
+	Labels                fwtypes.ListValueOf[types.String]                             `tfsdk:"labels"`
+	LatestVersionMetadata fwtypes.ListNestedObjectValueOf[documentVersionMetadataModel] `tfsdk:"latest_version_metadata"`
+	ModifiedTimestamp     timetypes.RFC3339                                             `tfsdk:"modified_timestamp"`
+	ParentFolderID        types.String                                                  `tfsdk:"parent_folder_id"`
+	ResourceState         fwtypes.StringEnum[awstypes.ResourceStateType]                `tfsdk:"resource_state"`
+}
+
+type documentVersionMetadataModel struct {
+	ContentCreatedTimestamp  timetypes.RFC3339                               `tfsdk:"content_created_timestamp"`
+	ContentModifiedTimestamp timetypes.RFC3339                               `tfsdk:"content_modified_timestamp"`
+	ContentType              types.String                                    `tfsdk:"content_type"`
+	CreatedTimestamp         timetypes.RFC3339                               `tfsdk:"created_timestamp"`
+	CreatorID                types.String                                    `tfsdk:"creator_id"`
+	ID                       types.String                                    `tfsdk:"id"`
+	ModifiedTimestamp        timetypes.RFC3339                               `tfsdk:"modified_timestamp"`
+	Name                     types.String                                    `tfsdk:"name"`
+	Signature                types.String                                    `tfsdk:"signature"`
+	Size                     types.Int64                                     `tfsdk:"size"`
+	Status                   fwtypes.StringEnum[awstypes.DocumentStatusType] `tfsdk:"status"`
+}
+
+type folderMetadataModel struct {
+	CreatedTimestamp  timetypes.RFC3339                              `tfsdk:"created_timestamp"`
+	CreatorID         types.String                                   `tfsdk:"creator_id"`
+	ID                types.String                                   `tfsdk:"id"`
+	Labels            fwtypes.ListValueOf[types.String]              `tfsdk:"labels"`
+	LatestVersionSize types.Int64                                    `tfsdk:"latest_version_size"`
+	ModifiedTimestamp timetypes.RFC3339                              `tfsdk:"modified_timestamp"`
+	Name              types.String                                   `tfsdk:"name"`
+	ParentFolderID    types.String                                   `tfsdk:"parent_folder_id"`
+	ResourceState     fwtypes.StringEnum[awstypes.ResourceStateType] `tfsdk:"resource_state"`
+	Signature         types.String                                   `tfsdk:"signature"`
+	Size              types.Int64                                    `tfsdk:"size"`
+}
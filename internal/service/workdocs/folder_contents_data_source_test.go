@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workdocs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkDocsFolderContentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	organizationID := acctest.SkipIfEnvVarNotSet(t, envVarOrganizationID)
+	dataSourceName := "data.aws_workdocs_folder_contents.test"
+	usersDataSourceName := "data.aws_workdocs_users.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkDocsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderContentsDataSourceConfig_basic(organizationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "folder_id", usersDataSourceName, "users.0.root_folder_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "documents.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "folders.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFolderContentsDataSourceConfig_basic(organizationID string) string {
+	return fmt.Sprintf(`
+data "aws_workdocs_users" "test" {
+  organization_id = %[1]q
+}
+
+data "aws_workdocs_folder_contents" "test" {
+  folder_id = data.aws_workdocs_users.test.users[0].root_folder_id
+}
+`, organizationID)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workdocs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workdocs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workdocs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Users")
+func newUsersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &usersDataSource{}, nil
+}
+
+type usersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*usersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_workdocs_users"
+}
+
+func (d *usersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserFilterType](),
+				Optional:   true,
+			},
+			"organization_id": schema.StringAttribute{
+				Required: true,
+			},
+			"query": schema.StringAttribute{
+				Optional: true,
+			},
+			"users": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[userModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[userModel](ctx),
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *usersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data usersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WorkDocsClient(ctx)
+
+	input := &workdocs.DescribeUsersInput{
+		// Include the users' storage quota and utilization.
+		Fields:         aws.String("STORAGE_METADATA"),
+		OrganizationId: fwflex.StringFromFramework(ctx, data.OrganizationID),
+	}
+	if !data.Include.IsNull() {
+		input.Include = data.Include.ValueEnum()
+	}
+	if !data.Query.IsNull() {
+		input.Query = fwflex.StringFromFramework(ctx, data.Query)
+	}
+
+	users, err := findUsers(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkDocs Users (%s)", data.OrganizationID.ValueString()), err.Error())
+
+		return
+	}
+
+	output := &workdocs.DescribeUsersOutput{
+		Users: users,
+	}
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, data.OrganizationID.ValueString())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findUsers(ctx context.Context, conn *workdocs.Client, input *workdocs.DescribeUsersInput) ([]awstypes.User, error) {
+	var output []awstypes.User
+
+	pages := workdocs.NewDescribeUsersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Users...)
+	}
+
+	return output, nil
+}
+
+type usersDataSourceModel struct {
+	ID             types.String                                `tfsdk:"id"`
+	Include        fwtypes.StringEnum[awstypes.UserFilterType] `tfsdk:"include"`
+	OrganizationID types.String                                `tfsdk:"organization_id"`
+	Query          types.String                                `tfsdk:"query"`
+	Users          fwtypes.ListNestedObjectValueOf[userModel]  `tfsdk:"users"`
+}
+
+type userModel struct {
+	CreatedTimestamp   timetypes.RFC3339                                         `tfsdk:"created_timestamp"`
+	EmailAddress       types.String                                              `tfsdk:"email_address"`
+	GivenName          types.String                                              `tfsdk:"given_name"`
+	ID                 types.String                                              `tfsdk:"id"`
+	Locale             fwtypes.StringEnum[awstypes.LocaleType]                   `tfsdk:"locale"`
+	ModifiedTimestamp  timetypes.RFC3339                                         `tfsdk:"modified_timestamp"`
+	OrganizationID     types.String                                              `tfsdk:"organization_id"`
+	RecycleBinFolderID types.String                                              `tfsdk:"recycle_bin_folder_id"`
+	RootFolderID       types.String                                              `tfsdk:"root_folder_id"`
+	Status             fwtypes.StringEnum[awstypes.UserStatusType]               `tfsdk:"status"`
+	Storage            fwtypes.ListNestedObjectValueOf[userStorageMetadataModel] `tfsdk:"storage"`
+	Surname            types.String                                              `tfsdk:"surname"`
+	TimeZoneID         types.String                                              `tfsdk:"time_zone_id"`
+	Type               fwtypes.StringEnum[awstypes.UserType]                     `tfsdk:"type"`
+	Username           types.String                                              `tfsdk:"username"`
+}
+
+type userStorageMetadataModel struct {
+	StorageRule            fwtypes.ListNestedObjectValueOf[storageRuleModel] `tfsdk:"storage_rule"`
+	StorageUtilizedInBytes types.Int64                                       `tfsdk:"storage_utilized_in_bytes"`
+}
+
+type storageRuleModel struct {
+	StorageAllocatedInBytes types.Int64                              `tfsdk:"storage_allocated_in_bytes"`
+	StorageType             fwtypes.StringEnum[awstypes.StorageType] `tfsdk:"storage_type"`
+}
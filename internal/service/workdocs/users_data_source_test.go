@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workdocs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// WorkDocs sites cannot be created via the API, so acceptance tests run
+// against an existing site's directory.
+const envVarOrganizationID = "WORKDOCS_ORGANIZATION_ID"
+
+func TestAccWorkDocsUsersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	organizationID := acctest.SkipIfEnvVarNotSet(t, envVarOrganizationID)
+	dataSourceName := "data.aws_workdocs_users.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkDocsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_basic(organizationID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, organizationID),
+					resource.TestCheckResourceAttr(dataSourceName, "organization_id", organizationID),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "users.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.root_folder_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "users.0.username"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWorkDocsUsersDataSource_include(t *testing.T) {
+	ctx := acctest.Context(t)
+	organizationID := acctest.SkipIfEnvVarNotSet(t, envVarOrganizationID)
+	dataSourceName := "data.aws_workdocs_users.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkDocsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_include(organizationID, "ALL"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "include", "ALL"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "users.#", 0),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig_basic(organizationID string) string {
+	return fmt.Sprintf(`
+data "aws_workdocs_users" "test" {
+  organization_id = %[1]q
+}
+`, organizationID)
+}
+
+func testAccUsersDataSourceConfig_include(organizationID, include string) string {
+	return fmt.Sprintf(`
+data "aws_workdocs_users" "test" {
+  organization_id = %[1]q
+  include         = %[2]q
+}
+`, organizationID, include)
+}
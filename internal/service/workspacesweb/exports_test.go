@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+// Exports for use in tests only.
+var (
+	ResourceIPAccessSettings            = newIPAccessSettingsResource
+	ResourceIPAccessSettingsAssociation = newIPAccessSettingsAssociationResource
+	ResourceNetworkSettings             = newNetworkSettingsResource
+	ResourceNetworkSettingsAssociation  = newNetworkSettingsAssociationResource
+	ResourcePortal                      = newPortalResource
+	ResourceTrustStore                  = newTrustStoreResource
+	ResourceTrustStoreAssociation       = newTrustStoreAssociationResource
+	ResourceUserSettings                = newUserSettingsResource
+	ResourceUserSettingsAssociation     = newUserSettingsAssociationResource
+
+	FindIPAccessSettingsAssociationByTwoPartKey = findIPAccessSettingsAssociationByTwoPartKey
+	FindIPAccessSettingsByARN                   = findIPAccessSettingsByARN
+	FindNetworkSettingsAssociationByTwoPartKey  = findNetworkSettingsAssociationByTwoPartKey
+	FindNetworkSettingsByARN                    = findNetworkSettingsByARN
+	FindPortalByARN                             = findPortalByARN
+	FindTrustStoreAssociationByTwoPartKey       = findTrustStoreAssociationByTwoPartKey
+	FindTrustStoreByARN                         = findTrustStoreByARN
+	FindUserSettingsAssociationByTwoPartKey     = findUserSettingsAssociationByTwoPartKey
+	FindUserSettingsByARN                       = findUserSettingsByARN
+)
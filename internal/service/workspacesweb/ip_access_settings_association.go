@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="IP Access Settings Association")
+func newIPAccessSettingsAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &ipAccessSettingsAssociationResource{}, nil
+}
+
+type ipAccessSettingsAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *ipAccessSettingsAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_ip_access_settings_association"
+}
+
+func (r *ipAccessSettingsAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"ip_access_settings_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ipAccessSettingsAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.AssociateIpAccessSettingsInput{
+		IpAccessSettingsArn: aws.String(data.IPAccessSettingsARN.ValueString()),
+		PortalArn:           aws.String(data.PortalARN.ValueString()),
+	}
+
+	_, err := conn.AssociateIpAccessSettings(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web IP Access Settings Association", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ipAccessSettingsAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := findIPAccessSettingsAssociationByTwoPartKey(ctx, conn, data.IPAccessSettingsARN.ValueString(), data.PortalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web IP Access Settings Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ipAccessSettingsAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ipAccessSettingsAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DisassociateIpAccessSettings(ctx, &workspacesweb.DisassociateIpAccessSettingsInput{
+		PortalArn: aws.String(data.PortalARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web IP Access Settings Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// findIPAccessSettingsAssociationByTwoPartKey returns the portal if it is associated with the specified IP Access Settings.
+func findIPAccessSettingsAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, ipAccessSettingsARN, portalARN string) (*awstypes.Portal, error) {
+	portal, err := findPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(portal.IpAccessSettingsArn) != ipAccessSettingsARN {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("WorkSpaces Web Portal (%s) is not associated with IP Access Settings (%s)", portalARN, ipAccessSettingsARN),
+		}
+	}
+
+	return portal, nil
+}
+
+type ipAccessSettingsAssociationResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	IPAccessSettingsARN fwtypes.ARN  `tfsdk:"ip_access_settings_arn"`
+	PortalARN           fwtypes.ARN  `tfsdk:"portal_arn"`
+}
+
+const (
+	ipAccessSettingsAssociationResourceIDPartCount = 2
+)
+
+func (m *ipAccessSettingsAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), ipAccessSettingsAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.IPAccessSettingsARN = fwtypes.ARNValue(parts[0])
+	m.PortalARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (m *ipAccessSettingsAssociationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.IPAccessSettingsARN.ValueString(), m.PortalARN.ValueString()}, ipAccessSettingsAssociationResourceIDPartCount, false)))
+}
@@ -19,7 +19,59 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newIPAccessSettingsAssociationResource,
+			Name:    "IP Access Settings Association",
+		},
+		{
+			Factory: newIPAccessSettingsResource,
+			Name:    "IP Access Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "ip_access_settings_arn",
+			},
+		},
+		{
+			Factory: newNetworkSettingsAssociationResource,
+			Name:    "Network Settings Association",
+		},
+		{
+			Factory: newNetworkSettingsResource,
+			Name:    "Network Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "network_settings_arn",
+			},
+		},
+		{
+			Factory: newPortalResource,
+			Name:    "Portal",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "portal_arn",
+			},
+		},
+		{
+			Factory: newTrustStoreAssociationResource,
+			Name:    "Trust Store Association",
+		},
+		{
+			Factory: newTrustStoreResource,
+			Name:    "Trust Store",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "trust_store_arn",
+			},
+		},
+		{
+			Factory: newUserSettingsAssociationResource,
+			Name:    "User Settings Association",
+		},
+		{
+			Factory: newUserSettingsResource,
+			Name:    "User Settings",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "user_settings_arn",
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -0,0 +1,452 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trust Store")
+// @Tags(identifierAttribute="trust_store_arn")
+func newTrustStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &trustStoreResource{}, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *trustStoreResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store"
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associated_portal_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID:      framework.IDAttribute(),
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrCertificate: schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[trustStoreCertificateModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Required: true,
+						},
+						"issuer": schema.StringAttribute{
+							Computed: true,
+						},
+						"not_valid_after": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"not_valid_before": schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						"subject": schema.StringAttribute{
+							Computed: true,
+						},
+						"thumbprint": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	certificates, diags := data.Certificates.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandTrustStoreCertificateBodies(certificates),
+		ClientToken:     aws.String(sdkid.UniqueId()),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.TrustStoreARN = fwflex.StringToFramework(ctx, output.TrustStoreArn)
+	data.ID = data.TrustStoreARN
+
+	response.Diagnostics.Append(r.readTrustStore(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if _, err := findTrustStoreByARN(ctx, conn, data.ID.ValueString()); tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	response.Diagnostics.Append(r.readTrustStore(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	if !new.Certificates.Equal(old.Certificates) {
+		oldCertificates, diags := old.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		newCertificates, diags := new.Certificates.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldBodies := make(map[string]*trustStoreCertificateModel)
+		for _, v := range oldCertificates {
+			oldBodies[v.Body.ValueString()] = v
+		}
+		newBodies := make(map[string]*trustStoreCertificateModel)
+		for _, v := range newCertificates {
+			newBodies[v.Body.ValueString()] = v
+		}
+
+		input := &workspacesweb.UpdateTrustStoreInput{
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			TrustStoreArn: aws.String(new.ID.ValueString()),
+		}
+
+		for body := range newBodies {
+			if _, ok := oldBodies[body]; !ok {
+				input.CertificatesToAdd = append(input.CertificatesToAdd, []byte(body))
+			}
+		}
+		for body, v := range oldBodies {
+			if _, ok := newBodies[body]; !ok {
+				input.CertificatesToDelete = append(input.CertificatesToDelete, v.Thumbprint.ValueString())
+			}
+		}
+
+		if len(input.CertificatesToAdd) > 0 || len(input.CertificatesToDelete) > 0 {
+			_, err := conn.UpdateTrustStore(ctx, input)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(r.readTrustStore(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DeleteTrustStore(ctx, &workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// readTrustStore refreshes the trust store's computed attributes and certificates.
+// A certificate body that is already known keeps its configured value so that
+// differences in encoding between the configuration and the API don't cause a diff.
+func (r *trustStoreResource) readTrustStore(ctx context.Context, conn *workspacesweb.Client, data *trustStoreResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	arn := data.ID.ValueString()
+
+	trustStore, err := findTrustStoreByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+		return diags
+	}
+
+	bodies := make(map[string]string)
+	if !data.Certificates.IsNull() && !data.Certificates.IsUnknown() {
+		certificates, d := data.Certificates.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, v := range certificates {
+			if thumbprint := v.Thumbprint.ValueString(); thumbprint != "" {
+				bodies[thumbprint] = v.Body.ValueString()
+			}
+		}
+	}
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, arn)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", arn), err.Error())
+
+		return diags
+	}
+
+	var models []*trustStoreCertificateModel
+	for _, v := range certificates {
+		thumbprint := aws.ToString(v.Thumbprint)
+		body, ok := bodies[thumbprint]
+		if !ok {
+			body = string(v.Body)
+		}
+
+		models = append(models, &trustStoreCertificateModel{
+			Body:           types.StringValue(body),
+			Issuer:         fwflex.StringToFramework(ctx, v.Issuer),
+			NotValidAfter:  flattenTime(v.NotValidAfter),
+			NotValidBefore: flattenTime(v.NotValidBefore),
+			Subject:        fwflex.StringToFramework(ctx, v.Subject),
+			Thumbprint:     types.StringValue(thumbprint),
+		})
+	}
+
+	certificatesValue, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, models)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, trustStore.AssociatedPortalArns)
+	data.Certificates = certificatesValue
+	data.TrustStoreARN = fwflex.StringToFramework(ctx, trustStore.TrustStoreArn)
+
+	return diags
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := &workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStore(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) ([]awstypes.Certificate, error) {
+	input := &workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	var output []awstypes.Certificate
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CertificateList {
+			certificate, err := findTrustStoreCertificateByTwoPartKey(ctx, conn, arn, aws.ToString(v.Thumbprint))
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *certificate)
+		}
+	}
+
+	return output, nil
+}
+
+func findTrustStoreCertificateByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, arn, thumbprint string) (*awstypes.Certificate, error) {
+	input := &workspacesweb.GetTrustStoreCertificateInput{
+		Thumbprint:    aws.String(thumbprint),
+		TrustStoreArn: aws.String(arn),
+	}
+
+	output, err := conn.GetTrustStoreCertificate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Certificate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Certificate, nil
+}
+
+func expandTrustStoreCertificateBodies(tfList []*trustStoreCertificateModel) [][]byte {
+	var apiObjects [][]byte
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, []byte(v.Body.ValueString()))
+	}
+
+	return apiObjects
+}
+
+func flattenTime(t *time.Time) timetypes.RFC3339 {
+	if t == nil {
+		return timetypes.NewRFC3339Null()
+	}
+
+	return timetypes.NewRFC3339TimeValue(*t)
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListValueOf[types.String]                          `tfsdk:"associated_portal_arns"`
+	Certificates         fwtypes.SetNestedObjectValueOf[trustStoreCertificateModel] `tfsdk:"certificate"`
+	ID                   types.String                                               `tfsdk:"id"`
+	Tags                 types.Map                                                  `tfsdk:"tags"`
+	TagsAll              types.Map                                                  `tfsdk:"tags_all"`
+	TrustStoreARN        types.String                                               `tfsdk:"trust_store_arn"`
+}
+
+type trustStoreCertificateModel struct {
+	Body           types.String      `tfsdk:"body"`
+	Issuer         types.String      `tfsdk:"issuer"`
+	NotValidAfter  timetypes.RFC3339 `tfsdk:"not_valid_after"`
+	NotValidBefore timetypes.RFC3339 `tfsdk:"not_valid_before"`
+	Subject        types.String      `tfsdk:"subject"`
+	Thumbprint     types.String      `tfsdk:"thumbprint"`
+}
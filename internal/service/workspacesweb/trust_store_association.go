@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Trust Store Association")
+func newTrustStoreAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &trustStoreAssociationResource{}, nil
+}
+
+type trustStoreAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *trustStoreAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_workspacesweb_trust_store_association"
+}
+
+func (r *trustStoreAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"portal_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trust_store_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *trustStoreAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := &workspacesweb.AssociateTrustStoreInput{
+		TrustStoreArn: aws.String(data.TrustStoreARN.ValueString()),
+		PortalArn:     aws.String(data.PortalARN.ValueString()),
+	}
+
+	_, err := conn.AssociateTrustStore(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store Association", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := findTrustStoreAssociationByTwoPartKey(ctx, conn, data.TrustStoreARN.ValueString(), data.PortalARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	_, err := conn.DisassociateTrustStore(ctx, &workspacesweb.DisassociateTrustStoreInput{
+		PortalArn: aws.String(data.PortalARN.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// findTrustStoreAssociationByTwoPartKey returns the portal if it is associated with the specified Trust Store.
+func findTrustStoreAssociationByTwoPartKey(ctx context.Context, conn *workspacesweb.Client, trustStoreARN, portalARN string) (*awstypes.Portal, error) {
+	portal, err := findPortalByARN(ctx, conn, portalARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if aws.ToString(portal.TrustStoreArn) != trustStoreARN {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("WorkSpaces Web Portal (%s) is not associated with Trust Store (%s)", portalARN, trustStoreARN),
+		}
+	}
+
+	return portal, nil
+}
+
+type trustStoreAssociationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	PortalARN     fwtypes.ARN  `tfsdk:"portal_arn"`
+	TrustStoreARN fwtypes.ARN  `tfsdk:"trust_store_arn"`
+}
+
+const (
+	trustStoreAssociationResourceIDPartCount = 2
+)
+
+func (m *trustStoreAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), trustStoreAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.TrustStoreARN = fwtypes.ARNValue(parts[0])
+	m.PortalARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (m *trustStoreAssociationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.TrustStoreARN.ValueString(), m.PortalARN.ValueString()}, trustStoreAssociationResourceIDPartCount, false)))
+}
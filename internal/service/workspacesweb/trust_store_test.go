@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfworkspacesweb "github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWorkSpacesWebTrustStore_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate := testAccTrustStoreCertificatePEM(t, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "certificate.*", map[string]string{
+						"subject": "CN=example.com",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "trust_store_arn", "workspaces-web", regexache.MustCompile(`trustStore/.+$`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrCertificate},
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate := testAccTrustStoreCertificatePEM(t, "example.com")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfworkspacesweb.ResourceTrustStore, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkSpacesWebTrustStore_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrustStore
+	resourceName := "aws_workspacesweb_trust_store.test"
+	certificate1 := testAccTrustStoreCertificatePEM(t, "example.com")
+	certificate2 := testAccTrustStoreCertificatePEM(t, "example.org")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WorkSpacesWebServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustStoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreConfig_basic(certificate1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_two(certificate1, certificate2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccTrustStoreConfig_basic(certificate2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrustStoreExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "certificate.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "certificate.*", map[string]string{
+						"subject": "CN=example.org",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrustStoreDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_workspacesweb_trust_store" {
+				continue
+			}
+
+			_, err := tfworkspacesweb.FindTrustStoreByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("WorkSpaces Web Trust Store %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrustStoreExists(ctx context.Context, n string, v *awstypes.TrustStore) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WorkSpacesWebClient(ctx)
+
+		output, err := tfworkspacesweb.FindTrustStoreByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTrustStoreCertificatePEM(t *testing.T, commonName string) string {
+	t.Helper()
+
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+
+	return acctest.TLSPEMEscapeNewlines(acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, commonName))
+}
+
+func testAccTrustStoreConfig_basic(certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate {
+    body = "%[1]s"
+  }
+}
+`, certificate)
+}
+
+func testAccTrustStoreConfig_two(certificate1, certificate2 string) string {
+	return fmt.Sprintf(`
+resource "aws_workspacesweb_trust_store" "test" {
+  certificate {
+    body = "%[1]s"
+  }
+
+  certificate {
+    body = "%[2]s"
+  }
+}
+`, certificate1, certificate2)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// CapacityUnknown indicates that a capacity value is not available.
+	CapacityUnknown = -1
+
+	// capacityProgressInterval is the minimum interval between capacity progress events.
+	capacityProgressInterval = 30 * time.Second
+)
+
+// CapacityProgress describes the progress of a resource towards its desired capacity.
+type CapacityProgress struct {
+	Current  int      // Number of healthy, in-service units. CapacityUnknown if not available.
+	Desired  int      // Desired number of units. CapacityUnknown if not available.
+	Failures []string // Reasons that units are failing to become healthy.
+}
+
+// CapacityProgressFunc extracts capacity progress from the result of a retry.StateRefreshFunc.
+// It returns false if the result contains no progress information.
+type CapacityProgressFunc func(result any) (CapacityProgress, bool)
+
+// CapacityProgressWatcher emits structured progress events while waiting for a resource to reach
+// its desired capacity and records the most recent progress so that it can be reported as diagnostics.
+type CapacityProgressWatcher struct {
+	resource string
+	id       string
+	progress CapacityProgressFunc
+
+	emit func(context.Context, map[string]any)
+	now  func() time.Time
+
+	start    time.Time
+	lastTime time.Time
+	last     *CapacityProgress
+}
+
+// NewCapacityProgressWatcher returns a watcher for the specified resource type and ID.
+func NewCapacityProgressWatcher(resource, id string, progress CapacityProgressFunc) *CapacityProgressWatcher {
+	return &CapacityProgressWatcher{
+		resource: resource,
+		id:       id,
+		progress: progress,
+		emit: func(ctx context.Context, fields map[string]any) {
+			tflog.Info(ctx, "Waiting for capacity", fields)
+		},
+		now: time.Now,
+	}
+}
+
+// Refresh wraps a retry.StateRefreshFunc, emitting progress events on each refresh.
+// Progress is read, and an event emitted, on the first refresh and then at most every 30 seconds,
+// so a CapacityProgressFunc that makes API calls doesn't add calls to every refresh.
+// A nil watcher returns f unchanged.
+func (w *CapacityProgressWatcher) Refresh(ctx context.Context, f retry.StateRefreshFunc) retry.StateRefreshFunc {
+	if w == nil {
+		return f
+	}
+
+	w.start = w.now()
+
+	return func() (any, string, error) {
+		result, state, err := f()
+
+		if err != nil || result == nil {
+			return result, state, err
+		}
+
+		now := w.now()
+
+		if w.last != nil && now.Sub(w.lastTime) < capacityProgressInterval {
+			return result, state, err
+		}
+
+		p, ok := w.progress(result)
+
+		if !ok {
+			return result, state, err
+		}
+
+		fields := map[string]any{
+			"resource": w.resource,
+			"id":       w.id,
+			"state":    state,
+			"elapsed":  now.Sub(w.start).Truncate(time.Second).String(),
+		}
+		if p.Current != CapacityUnknown {
+			fields["current_capacity"] = p.Current
+		}
+		if p.Desired != CapacityUnknown {
+			fields["desired_capacity"] = p.Desired
+		}
+		if len(p.Failures) > 0 {
+			fields["failures"] = p.Failures
+		}
+
+		w.emit(ctx, fields)
+
+		w.lastTime = now
+		w.last = &p
+
+		return result, state, err
+	}
+}
+
+// Last returns the most recently observed progress.
+func (w *CapacityProgressWatcher) Last() (CapacityProgress, bool) {
+	if w == nil || w.last == nil {
+		return CapacityProgress{}, false
+	}
+
+	return *w.last, true
+}
+
+// Diagnostics returns a warning describing the most recently observed progress
+// if it reported any failures.
+func (w *CapacityProgressWatcher) Diagnostics() diag.Diagnostics {
+	p, ok := w.Last()
+
+	if !ok || len(p.Failures) == 0 {
+		return nil
+	}
+
+	var detail strings.Builder
+	if p.Current != CapacityUnknown && p.Desired != CapacityUnknown {
+		fmt.Fprintf(&detail, "%d of %d desired units are healthy.\n", p.Current, p.Desired)
+	}
+	fmt.Fprintf(&detail, "Failures:\n  %s", strings.Join(p.Failures, "\n  "))
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s (%s) reported capacity failures", w.resource, w.id),
+			Detail:   detail.String(),
+		},
+	}
+}
+
+// WithCapacityProgress wraps a retry.StateRefreshFunc, emitting structured progress events
+// while waiting for a resource to reach its desired capacity.
+func WithCapacityProgress(ctx context.Context, resource, id string, f retry.StateRefreshFunc, progress CapacityProgressFunc) retry.StateRefreshFunc {
+	return NewCapacityProgressWatcher(resource, id, progress).Refresh(ctx, f)
+}
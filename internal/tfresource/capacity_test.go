@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestWithCapacityProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result        any
+		state         string
+		err           error
+		progressOK    bool
+		expectedCalls int
+	}{
+		"progress": {
+			result:        "result",
+			state:         "PENDING",
+			progressOK:    true,
+			expectedCalls: 1,
+		},
+		"no progress": {
+			result:        "result",
+			state:         "PENDING",
+			expectedCalls: 3,
+		},
+		"nil result": {
+			state:         "",
+			expectedCalls: 0,
+		},
+		"error": {
+			result:        "result",
+			state:         "",
+			err:           errors.New("TestCode"),
+			expectedCalls: 0,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
+
+			var calls int
+			f := tfresource.WithCapacityProgress(ctx, "aws_test", "test", func() (any, string, error) {
+				return testCase.result, testCase.state, testCase.err
+			}, func(any) (tfresource.CapacityProgress, bool) {
+				calls++
+				return tfresource.CapacityProgress{Current: 1, Desired: 2, Failures: []string{"i-123456: Unhealthy"}}, testCase.progressOK
+			})
+
+			for range 3 {
+				result, state, err := f()
+
+				if result != testCase.result {
+					t.Errorf("result: got %v, expected %v", result, testCase.result)
+				}
+
+				if state != testCase.state {
+					t.Errorf("state: got %q, expected %q", state, testCase.state)
+				}
+
+				if !errors.Is(err, testCase.err) {
+					t.Errorf("error: got %v, expected %v", err, testCase.err)
+				}
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("progress calls: got %d, expected %d", calls, testCase.expectedCalls)
+			}
+		})
+	}
+}
+
+func TestCapacityProgressWatcher(t *testing.T) {
+	t.Parallel()
+	ctx := acctest.Context(t)
+
+	// Each step advances the clock and returns the given progress.
+	type step struct {
+		advance  time.Duration
+		progress tfresource.CapacityProgress
+	}
+	steps := []step{
+		{0, tfresource.CapacityProgress{Current: 0, Desired: 2}},
+		{10 * time.Second, tfresource.CapacityProgress{Current: 0, Desired: 2}},                                                                                        // Within interval, not read.
+		{10 * time.Second, tfresource.CapacityProgress{Current: 1, Desired: 2}},                                                                                        // Changed, within interval, not read.
+		{tfresource.CapacityProgressInterval - 20*time.Second, tfresource.CapacityProgress{Current: 1, Desired: 2}},                                                    // Interval elapsed.
+		{time.Second, tfresource.CapacityProgress{Current: 1, Desired: tfresource.CapacityUnknown, Failures: []string{"i-123456: Unhealthy"}}},                         // Changed, within interval, not read.
+		{tfresource.CapacityProgressInterval, tfresource.CapacityProgress{Current: 1, Desired: tfresource.CapacityUnknown, Failures: []string{"i-123456: Unhealthy"}}}, // Interval elapsed.
+	}
+	want := []map[string]any{
+		{"resource": "aws_test", "id": "test", "state": "PENDING", "elapsed": "0s", "current_capacity": 0, "desired_capacity": 2},
+		{"resource": "aws_test", "id": "test", "state": "PENDING", "elapsed": "30s", "current_capacity": 1, "desired_capacity": 2},
+		{"resource": "aws_test", "id": "test", "state": "PENDING", "elapsed": "1m1s", "current_capacity": 1, "failures": []string{"i-123456: Unhealthy"}},
+	}
+
+	var reads int
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var (
+		got []map[string]any
+		i   int
+	)
+	w := tfresource.NewCapacityProgressWatcher("aws_test", "test", func(any) (tfresource.CapacityProgress, bool) {
+		reads++
+		return steps[i].progress, true
+	})
+	w.SetClock(func() time.Time { return now })
+	w.SetEmitter(func(_ context.Context, fields map[string]any) {
+		got = append(got, fields)
+	})
+
+	f := w.Refresh(ctx, func() (any, string, error) {
+		return "result", "PENDING", nil
+	})
+
+	for i = range steps {
+		now = now.Add(steps[i].advance)
+
+		if _, _, err := f(); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected events (+wanted, -got): %s", diff)
+	}
+
+	if got, want := reads, len(want); got != want {
+		t.Errorf("progress reads: got %d, expected %d", got, want)
+	}
+
+	last, ok := w.Last()
+	if !ok {
+		t.Fatal("expected last progress")
+	}
+	if diff := cmp.Diff(last, steps[len(steps)-1].progress); diff != "" {
+		t.Errorf("unexpected last progress (+wanted, -got): %s", diff)
+	}
+
+	wantDiags := diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "aws_test (test) reported capacity failures",
+			Detail:   "Failures:\n  i-123456: Unhealthy",
+		},
+	}
+	if diff := cmp.Diff(w.Diagnostics(), wantDiags); diff != "" {
+		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCapacityProgressWatcherDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		progress      *tfresource.CapacityProgress
+		expectedDiags diag.Diagnostics
+	}{
+		"no progress": {},
+		"no failures": {
+			progress: &tfresource.CapacityProgress{Current: 2, Desired: 2},
+		},
+		"failures": {
+			progress: &tfresource.CapacityProgress{Current: 1, Desired: 2, Failures: []string{"i-123456: Unhealthy", "i-654321: Unhealthy"}},
+			expectedDiags: diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "aws_test (test) reported capacity failures",
+					Detail:   "1 of 2 desired units are healthy.\nFailures:\n  i-123456: Unhealthy\n  i-654321: Unhealthy",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
+
+			w := tfresource.NewCapacityProgressWatcher("aws_test", "test", func(any) (tfresource.CapacityProgress, bool) {
+				if testCase.progress == nil {
+					return tfresource.CapacityProgress{}, false
+				}
+				return *testCase.progress, true
+			})
+			w.SetEmitter(func(context.Context, map[string]any) {})
+
+			if _, _, err := w.Refresh(ctx, func() (any, string, error) {
+				return "result", "PENDING", nil
+			})(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(w.Diagnostics(), testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
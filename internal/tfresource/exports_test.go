@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"time"
+)
+
+// Exports for use in tests only.
+const (
+	CapacityProgressInterval = capacityProgressInterval
+)
+
+func (w *CapacityProgressWatcher) SetEmitter(f func(context.Context, map[string]any)) {
+	w.emit = f
+}
+
+func (w *CapacityProgressWatcher) SetClock(f func() time.Time) {
+	w.now = f
+}
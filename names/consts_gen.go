@@ -29,6 +29,7 @@ const (
 	Batch                        = "batch"
 	Bedrock                      = "bedrock"
 	BedrockAgent                 = "bedrockagent"
+	Braket                       = "braket"
 	Budgets                      = "budgets"
 	CE                           = "ce"
 	CUR                          = "cur"
@@ -110,6 +111,7 @@ const (
 	Glue                         = "glue"
 	Grafana                      = "grafana"
 	Greengrass                   = "greengrass"
+	GreengrassV2                 = "greengrassv2"
 	GroundStation                = "groundstation"
 	GuardDuty                    = "guardduty"
 	HealthLake                   = "healthlake"
@@ -124,6 +126,7 @@ const (
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
 	IoTEvents                    = "iotevents"
+	IoTFleetWise                 = "iotfleetwise"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
@@ -147,6 +150,7 @@ const (
 	MQ                           = "mq"
 	MWAA                         = "mwaa"
 	Macie2                       = "macie2"
+	MarketplaceCatalog           = "marketplacecatalog"
 	MediaConnect                 = "mediaconnect"
 	MediaConvert                 = "mediaconvert"
 	MediaLive                    = "medialive"
@@ -185,6 +189,7 @@ const (
 	ResourceExplorer2            = "resourceexplorer2"
 	ResourceGroups               = "resourcegroups"
 	ResourceGroupsTaggingAPI     = "resourcegroupstaggingapi"
+	RoboMaker                    = "robomaker"
 	RolesAnywhere                = "rolesanywhere"
 	Route53                      = "route53"
 	Route53Domains               = "route53domains"
@@ -234,6 +239,7 @@ const (
 	WAFRegional                  = "wafregional"
 	WAFV2                        = "wafv2"
 	WellArchitected              = "wellarchitected"
+	WorkDocs                     = "workdocs"
 	WorkLink                     = "worklink"
 	WorkSpaces                   = "workspaces"
 	WorkSpacesWeb                = "workspacesweb"
@@ -270,6 +276,7 @@ const (
 	BatchServiceID                        = "Batch"
 	BedrockServiceID                      = "Bedrock"
 	BedrockAgentServiceID                 = "Bedrock Agent"
+	BraketServiceID                       = "Braket"
 	BudgetsServiceID                      = "Budgets"
 	CEServiceID                           = "Cost Explorer"
 	CURServiceID                          = "Cost and Usage Report Service"
@@ -351,6 +358,7 @@ const (
 	GlueServiceID                         = "Glue"
 	GrafanaServiceID                      = "grafana"
 	GreengrassServiceID                   = "Greengrass"
+	GreengrassV2ServiceID                 = "GreengrassV2"
 	GroundStationServiceID                = "GroundStation"
 	GuardDutyServiceID                    = "GuardDuty"
 	HealthLakeServiceID                   = "HealthLake"
@@ -365,6 +373,7 @@ const (
 	IoTServiceID                          = "IoT"
 	IoTAnalyticsServiceID                 = "IoTAnalytics"
 	IoTEventsServiceID                    = "IoT Events"
+	IoTFleetWiseServiceID                 = "IoTFleetWise"
 	KMSServiceID                          = "KMS"
 	KafkaServiceID                        = "Kafka"
 	KafkaConnectServiceID                 = "KafkaConnect"
@@ -388,6 +397,7 @@ const (
 	MQServiceID                           = "mq"
 	MWAAServiceID                         = "MWAA"
 	Macie2ServiceID                       = "Macie2"
+	MarketplaceCatalogServiceID           = "Marketplace Catalog"
 	MediaConnectServiceID                 = "MediaConnect"
 	MediaConvertServiceID                 = "MediaConvert"
 	MediaLiveServiceID                    = "MediaLive"
@@ -426,6 +436,7 @@ const (
 	ResourceExplorer2ServiceID            = "Resource Explorer 2"
 	ResourceGroupsServiceID               = "Resource Groups"
 	ResourceGroupsTaggingAPIServiceID     = "Resource Groups Tagging API"
+	RoboMakerServiceID                    = "RoboMaker"
 	RolesAnywhereServiceID                = "RolesAnywhere"
 	Route53ServiceID                      = "Route 53"
 	Route53DomainsServiceID               = "Route 53 Domains"
@@ -475,6 +486,7 @@ const (
 	WAFRegionalServiceID                  = "WAF Regional"
 	WAFV2ServiceID                        = "WAFV2"
 	WellArchitectedServiceID              = "WellArchitected"
+	WorkDocsServiceID                     = "WorkDocs"
 	WorkLinkServiceID                     = "WorkLink"
 	WorkSpacesServiceID                   = "WorkSpaces"
 	WorkSpacesWebServiceID                = "WorkSpaces Web"
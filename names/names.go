@@ -29,6 +29,7 @@ const (
 	ACMPCAEndpointID                     = "acm-pca"
 	AMPEndpointID                        = "aps"
 	AppStreamEndpointID                  = "appstream2"
+	AppSyncEndpointID                    = "appsync"
 	ApplicationAutoscalingEndpointID     = "application-autoscaling"
 	AppIntegrationsEndpointID            = "app-integrations"
 	AppConfigEndpointID                  = "appconfig"
@@ -42,6 +43,7 @@ const (
 	BedrockAgentEndpointID               = "bedrockagent"
 	BedrockEndpointID                    = "bedrock"
 	BCMDataExportsEndpointID             = "bcm-data-exports"
+	BraketEndpointID                     = "braket"
 	BudgetsEndpointID                    = "budgets"
 	ChimeSDKMediaPipelinesEndpointID     = "media-pipelines-chime"
 	ChimeSDKVoiceEndpointID              = "voice-chime"
@@ -65,8 +67,10 @@ const (
 	EventsEndpointID                     = "events"
 	EvidentlyEndpointID                  = "evidently"
 	FMSEndpointID                        = "fms"
+	GreengrassV2EndpointID               = "greengrass"
 	IdentityStoreEndpointID              = "identitystore"
 	Inspector2EndpointID                 = "inspector2"
+	IoTFleetWiseEndpointID               = "iotfleetwise"
 	IVSChatEndpointID                    = "ivschat"
 	KendraEndpointID                     = "kendra"
 	KMSEndpointID                        = "kms"
@@ -87,6 +91,7 @@ const (
 	RedshiftEndpointID                   = "redshift"
 	RekognitionEndpointID                = "rekognition"
 	ResourceExplorer2EndpointID          = "resource-explorer-2"
+	RoboMakerEndpointID                  = "robomaker"
 	RolesAnywhereEndpointID              = "rolesanywhere"
 	Route53DomainsEndpointID             = "route53domains"
 	SchedulerEndpointID                  = "scheduler"
@@ -266,7 +266,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"augmentedairuntime",
 		"backupgateway",
 		"billingconductor",
-		"braket",
 		"chimesdkidentity",
 		"chimesdkmeetings",
 		"chimesdkmessaging",
@@ -298,7 +297,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"forecastquery",
 		"frauddetector",
 		"gluedatabrew",
-		"greengrassv2",
 		"groundstation",
 		"health",
 		"honeycode",
@@ -362,7 +360,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"rdsdata",
 		"rekognition",
 		"resiliencehub",
-		"robomaker",
 		"route53recoverycluster",
 		"sagemakera2iruntime",
 		"sagemakeredge",